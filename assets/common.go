@@ -29,6 +29,9 @@ var (
 type File struct {
 	Data       []byte
 	Mime, Hash string
+
+	// Shortcode of custom emoji. Empty for other files.
+	Name string
 }
 
 // FileStore stores board-specific files in memory
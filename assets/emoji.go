@@ -0,0 +1,66 @@
+package assets
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/bakape/meguca/util"
+)
+
+var (
+	// Custom emoji by board stored in memory
+	Emoji = EmojiStore{
+		m: make(map[string]map[string]File, 64),
+	}
+)
+
+// EmojiStore stores custom emoji by board and shortcode in memory
+type EmojiStore struct {
+	mu sync.RWMutex
+	m  map[string]map[string]File
+}
+
+// Set emoji stored for a certain board. File.Name is used as the shortcode.
+// Technically deleting a board would leak memory, but it's so rare and little.
+func (s *EmojiStore) Set(board string, files []File) {
+	m := make(map[string]File, len(files))
+	for _, f := range files {
+		f.Hash = util.HashBuffer(f.Data)
+		m[f.Name] = f
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(m) == 0 {
+		delete(s.m, board)
+	} else {
+		s.m[board] = m
+	}
+}
+
+// Get returns the emoji specified by board and shortcode. If none found,
+// ok == false. file should not be mutted.
+func (s *EmojiStore) Get(board, name string) (file File, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	file, ok = s.m[board][name]
+	return
+}
+
+// Has returns, if the board has an emoji with the shortcode
+func (s *EmojiStore) Has(board, name string) bool {
+	_, ok := s.Get(board, name)
+	return ok
+}
+
+// Names returns the sorted shortcodes of all emoji of a board
+func (s *EmojiStore) Names(board string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	names := make([]string, 0, len(s.m[board]))
+	for name := range s.m[board] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -13,7 +13,36 @@ type ModLogEntry struct {
 	ID      uint64    `json:"id"`
 	Created time.Time `json:"created"`
 	Board   string    `json:"board"`
-	IP      string    `json:"-"`
+	IPHash  string    `json:"-"`
+}
+
+// ModNote is a private staff note on a post or all posts by its poster's IP
+type ModNote struct {
+	ID      uint64    `json:"id"`
+	Post    uint64    `json:"post"`
+	ByIP    bool      `json:"byIP"`
+	Created time.Time `json:"created"`
+	Board   string    `json:"board"`
+	By      string    `json:"by"`
+	Body    string    `json:"body"`
+}
+
+// ModLogPageSize is the number of moderation log entries returned per page
+const ModLogPageSize = 100
+
+// ModLogFilter selects moderation log entries. Zero values match any entry.
+type ModLogFilter struct {
+	// Empty matches entries on all boards
+	Board string
+	Types []common.ModerationAction
+	By    string
+	Post  uint64
+	// Hex hash of the poster's IP as displayed in the log
+	IPHash string
+	// Entries created in the range [From, To)
+	From, To time.Time
+	// Zero-based page of ModLogPageSize entries, newest first
+	Page uint
 }
 
 // Ban holds an entry of an IP being banned from a board
@@ -29,15 +58,79 @@ type BanRecord struct {
 	Expires          time.Time
 }
 
-// Report contains data of a reported post
+// Report lifecycle states
+const (
+	ReportOpen      = "open"
+	ReportClaimed   = "claimed"
+	ReportResolved  = "resolved"
+	ReportDismissed = "dismissed"
+)
+
+// Report contains data of a reported post. Duplicate reports of a post in the
+// same state are grouped into one with the latest reason and a count.
 type Report struct {
-	ID, Target    uint64
-	Created       time.Time
-	Board, Reason string
+	ID, Target, Count uint64
+	Created           time.Time
+	Board, Reason     string
+	State             string
+
+	// Moderator the report is assigned to, if claimed
+	AssignedTo string
+
+	// Moderator, that resolved or dismissed the report, and their note
+	ResolvedBy, Resolution string
+}
+
+// ReportFilter selects reports to list. An empty State matches open and
+// claimed reports and "all" matches any state. An empty AssignedTo matches any
+// moderator.
+type ReportFilter struct {
+	State, AssignedTo string
+}
+
+// Ban appeal states
+const (
+	AppealPending  = "pending"
+	AppealAccepted = "accepted"
+	AppealRejected = "rejected"
+)
+
+// BanAppeal is a banned user's request to lift a ban
+type BanAppeal struct {
+	ID, ForPost        uint64
+	Created            time.Time
+	Board, Body, State string
+	Comments           []BanAppealComment
+}
+
+// BanAppealComment is a staff comment on a ban appeal
+type BanAppealComment struct {
+	By, Body string
+	Created  time.Time
+}
+
+// Review states of posts held for pre-moderation
+const (
+	PostPending  = "pending"
+	PostApproved = "approved"
+	PostRejected = "rejected"
+)
+
+// PendingPost is a thread or reply of a new poster held for review by staff.
+// OP is 0 for threads. PostID is the ID of the published post, once approved.
+type PendingPost struct {
+	ID, OP, PostID                 uint64
+	Sage, Spoiler                  bool
+	Created                        time.Time
+	Board, IP, Subject, Name, Trip string
+	Body, ImageToken, ImageName    string
+	State, ReviewedBy, Reason      string
+	Password                       []byte
+	Image                          *common.ImageCommon
 }
 
 // DisconnectByBoardAndIP disconnects all banned
-// websocket clients matching IP from board.
+// websocket clients matching IP or contained in network from board.
 // /all/ board disconnects all clients globally.
 func DisconnectByBoardAndIP(ip, board string) {
 	msg, err := common.EncodeMessage(common.MessageInvalid,
@@ -46,13 +139,7 @@ func DisconnectByBoardAndIP(ip, board string) {
 		log.Error(err)
 		return
 	}
-	var cls []common.Client
-	if board == "all" {
-		cls = common.GetClientsByIP(ip)
-	} else {
-		cls = common.GetByIPAndBoard(ip, board)
-	}
-	for _, cl := range cls {
+	for _, cl := range common.GetByIPAndBoard(ip, board) {
 		cl.Send(msg)
 		cl.Close(nil)
 	}
@@ -552,7 +552,19 @@ func HashToTitle(buf []byte) (title string,
 	h.Write(buf)
 	h.Write([]byte(config.Get().Salt))
 	digest := h.Sum(nil)
-	return titles[int(binary.LittleEndian.Uint64(digest)>>1)%len(titles)],
+	return digestTitle(digest), hex.EncodeToString(digest)
+}
+
+// HashTitle returns the title of a hex hash produced by HashToTitle or an
+// empty string, if the hash is invalid
+func HashTitle(hash string) string {
+	digest, err := hex.DecodeString(hash)
+	if err != nil || len(digest) < 8 {
+		return ""
+	}
+	return digestTitle(digest)
+}
 
-		hex.EncodeToString(digest)
+func digestTitle(digest []byte) string {
+	return titles[int(binary.LittleEndian.Uint64(digest)>>1)%len(titles)]
 }
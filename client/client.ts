@@ -5,7 +5,9 @@ import { posts, page } from './state'
 import { Post, FormModel, PostView, lightenThread } from './posts'
 import {PostLink, Command, PostData, ImageData, ModerationEntry, ClaudeState} from "./common"
 import { postAdded } from "./ui"
-import { decrementImageCount, incrementPostCount } from "./page"
+import {
+	decrementImageCount, decrementPostCount, incrementPostCount,
+} from "./page"
 import { getPostName } from "./options"
 import { OverlayNotification } from "./ui"
 import { setCookie }  from './util';
@@ -31,6 +33,7 @@ type CloseMessage = {
 // Message for inserting images into an open post
 interface ImageMessage extends ImageData {
 	id: number
+	position?: number // Non-zero for additional attachments
 }
 
 interface ModerationMessage extends ModerationEntry {
@@ -106,7 +109,13 @@ export default () => {
 
 	handlers[message.insertImage] = (msg: ImageMessage) =>
 		handle(msg.id, m => {
+			const { position } = msg
 			delete msg.id
+			delete msg.position
+			if (position) {
+				m.insertAttachment(msg)
+				return
+			}
 			if (!m.image) {
 				incrementPostCount(false, true)
 			}
@@ -178,8 +187,18 @@ export default () => {
 		handle(msg.id, m =>
 			m.applyModeration(msg))
 
+	handlers[message.prunePosts] = (ids: number[]) => {
+		for (const id of ids) {
+			handle(id, m => {
+				decrementPostCount(!!m.image)
+				m.remove()
+			})
+		}
+	}
+
 	handlers[message.stoleImageFrom] = (id: number) =>
 		handle(id, m => {
+			m.removeAttachments();
 			if (m.image) {
 				m.image = null;
 
@@ -218,13 +237,13 @@ export default () => {
 			console.log(`>binary claude error: ${id} ${response}`)
 		handle(id,(m) => m.claudeError(response))
 	}
-	interface TiktokState {
+	interface FetchState {
 		id: number;
 		state: number;
 	}
-	handlers[message.tiktokState] = ({ id, state }: TiktokState) =>{
+	handlers[message.fetchState] = ({ id, state }: FetchState) =>{
 		if(debug)
-			console.log(`>state tiktok ${id} ${state}`)
+			console.log(`>state fetch ${id} ${state}`)
 		handle(id, m => {
 			m.view.setShowLoadingBar(state ==1)
 		})
@@ -233,9 +252,10 @@ export default () => {
 	interface StolenImage {
 		id: number;
 		image: ImageData;
+		attachments?: ImageData[];
 	}
 
-	handlers[message.stoleImageTo] = ({ id, image }: StolenImage) =>
+	handlers[message.stoleImageTo] = ({ id, image, attachments }: StolenImage) =>
 		handle(id, m => {
 			if (m.image) {
 				m.image = null;
@@ -244,6 +264,8 @@ export default () => {
 				incrementPostCount(false, true);
 			}
 			m.insertImage(image);
+			m.attachments = attachments || null;
+			m.view.renderAttachments();
 		})
 
 	handlers[message.redirect] = (msg: string) => {
@@ -258,6 +280,25 @@ export default () => {
 
 	handlers[message.setCookie] = ({ key, value }: CookieMessage) =>
 		setCookie(key, value, 30)
+	interface PollVotes {
+		id: number;
+		votes: number[];
+	}
+
+	handlers[message.pollVote] = ({ id, votes }: PollVotes) =>
+		handle(id, m =>
+			m.updatePoll(votes))
+
+	interface ReactionMessage {
+		id: number;
+		reaction: string;
+		count: number;
+	}
+
+	handlers[message.reaction] = ({ id, reaction, count }: ReactionMessage) =>
+		handle(id, m =>
+			m.setReaction(reaction, count))
+
 	handlers[message.nekoTV] = (message : ArrayBuffer) => {
 		const msg = WebSocketMessage.fromBinary(new Uint8Array(message));
 		if (debug && msg.messageType.oneofKind !== "getTimeEvent") {
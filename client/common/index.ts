@@ -35,6 +35,13 @@ export interface ModerationEntry {
 	data: string
 }
 
+// Range of IPs around the poster's IP a ban applies to
+export const enum BanScope {
+	address,
+	subnet,
+	range,
+}
+
 // Possible staff access levels
 export const enum ModerationLevel {
 	notLoggedIn = - 1,
@@ -52,7 +59,9 @@ export interface PostData {
 	sage: boolean
 	sticky: boolean
 	locked: boolean
+	cyclic?: boolean
 	image?: ImageData
+	attachments?: ImageData[]
 	time: number
 	id: number
 	op: number
@@ -67,8 +76,12 @@ export interface PostData {
 	commands?: Command[]
 	moderation?: ModerationEntry[]
 	claude_state?: ClaudeState
+	reactions?: ReactionCounts
 }
 
+// Counts of reactions to a post by reaction
+export type ReactionCounts = { [reaction: string]: number }
+
 export interface ClaudeState {
 	status: string
 	prompt: string
@@ -93,7 +106,7 @@ export type TextState = {
 
 // Types of hash command entries
 export const enum commandType {
-	dice, flip, eightBall, syncWatch, pyu, pcount, autobahn,
+	dice, flip, eightBall, syncWatch, pyu, pcount, autobahn, claude, poll,
 }
 
 // Single hash command result delivered from the server
@@ -102,6 +115,43 @@ export interface Command {
 	val: any
 }
 
+// State of a #poll command
+export interface PollState {
+	expires: number // Unix timestamp
+	options: string[]
+	votes: number[]
+}
+
+// Result of a dice roll using extended notation, such as modifiers, keeping or
+// dropping dice, exploding or fudge dice and multiple terms. Plain NdM rolls
+// are delivered as an array of rolled numbers instead.
+export interface DiceRoll {
+	expr: string
+	total: number
+	terms: DiceTerm[]
+}
+
+// Single term of a dice expression. Either a set of dice or a constant
+// modifier.
+export interface DiceTerm {
+	negative?: boolean
+	fudge?: boolean
+	explode?: boolean
+	const?: number
+	count?: number
+	sides?: number
+	keep?: string
+	keepN?: number
+	dice?: Die[]
+}
+
+// Single rolled die of a dice term
+export interface Die {
+	val: number
+	dropped?: boolean
+	exploded?: boolean
+}
+
 // Data of an OP post
 export interface ThreadData extends PostData {
 	post_count: number
@@ -136,6 +186,12 @@ export interface ImageData {
 	sha1: string
 	name: string
 	codec: string
+	rendition?: fileTypes
+	peaks?: number[] // Normalized waveform amplitudes of audio-only files
+	// Dimensions of additional higher resolution thumbnails by the size of
+	// their bounding box
+	thumbs?: { [size: string]: [number, number] }
+	thumb_avif?: boolean // Thumbnails are also available in AVIF format
 
 	// Added client-side
 	expanded: boolean           // Thumbnail is expanded
@@ -143,6 +199,13 @@ export interface ImageData {
 	revealed: boolean           // Revealing a hidden image with [Show]
 }
 
+// File contained in an uploaded archive
+export interface ArchiveEntry {
+	page: boolean // Image page of a comic book archive
+	size: number
+	name: string
+}
+
 // Possible file types of a post image
 export enum fileTypes {
 	jpg, png, gif, webm, pdf, svg, mp4, mp3, ogg, zip, "7z", "tar.gz", "tar.xz",
@@ -16,9 +16,11 @@ export const enum message {
 	claudeAppend,
 	claudeDone,
 	claudeError,
-	attachTiktok,
-	tiktokState,
+	attachURL,
+	fetchState,
 	nekoTV,
+	pollVote,
+	reaction,
 
 	// >= 30 are miscellaneous and do not write to post models
 	synchronise = 30,
@@ -58,6 +60,17 @@ export const enum message {
 
 	// Set a cookie on the client
 	setCookie,
+
+	// Seconds the client has to wait before posting in a slow mode thread
+	// again
+	slowMode,
+
+	// IDs of posts removed from a cyclic thread
+	prunePosts,
+
+	// ID of a post held for review by staff or 0, if the post has to be
+	// submitted for review in one piece
+	pendingReview,
 }
 
 export type MessageHandler = (msg: {}) => void
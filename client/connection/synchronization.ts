@@ -5,7 +5,7 @@ import {
 } from "../posts"
 import { page, posts, displayLoading } from "../state"
 import { trigger, extend } from "../util"
-import { PostData, ModerationEntry } from "../common"
+import { PostData, ModerationEntry, ReactionCounts } from "../common"
 import { insertPost } from "../client"
 
 // Passed from the server to allow the client to synchronise state, before
@@ -19,9 +19,11 @@ type SyncData = {
 type PostState = {
 	hash_image: boolean
 	spoilered: boolean
+	attachments?: number
 	closed: boolean
 	body: string
-	pending_tiktoks: number
+	fetch_state: number
+	reactions?: ReactionCounts
 }
 
 // Send a requests to the server to synchronise to the current page and
@@ -60,7 +62,7 @@ async function syncRecentPost(id: number, p: PostState) {
 		// Don't rerender post form text
 		model.inputBody = model.body = p.body
 		model.view.onInput()
-		model.view.setShowLoadingBar(p.pending_tiktoks == 1)
+		model.view.setShowLoadingBar(p.fetch_state == 1)
 		return
 	}
 
@@ -70,6 +72,11 @@ async function syncRecentPost(id: number, p: PostState) {
 			model.view.renderImage(false)
 		}
 	}
+	const attached = model.attachments ? model.attachments.length : 0
+	if ((p.attachments || 0) !== attached) {
+		model.attachments = (await fetchPost(id)).attachments || null
+		model.view.renderAttachments()
+	}
 	if (p.spoilered && model.image && !model.image.spoiler) {
 		model.image.spoiler = true
 		model.view.renderImage(false)
@@ -77,8 +84,12 @@ async function syncRecentPost(id: number, p: PostState) {
 	if (p.body) {
 		model.body = p.body
 	}
+	if (p.reactions) {
+		model.reactions = p.reactions
+		model.view.renderReactions()
+	}
 	model.view.reparseBody()
-	model.view.setShowLoadingBar(p.pending_tiktoks == 1)
+	model.view.setShowLoadingBar(p.fetch_state == 1)
 }
 
 // Fetch a post not present on the client and render it
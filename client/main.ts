@@ -5,6 +5,7 @@ import { start as connect, connSM, connState } from './connection'
 import { open } from './db'
 import { initOptions } from "./options"
 import initPosts from "./posts"
+import { loadEmoji } from "./posts/emoji"
 import { postSM, postEvent, FormModel } from "./posts"
 import {
 	renderBoard, extractConfigs, renderThread, init as initPage
@@ -26,12 +27,16 @@ import {initNekoTV} from "./nekotv/nekotv";
 async function start() {
 	extractConfigs()
 
+	// Board emoji are needed to render open posts
+	const emoji = page.board !== "all" && loadEmoji(page.board)
+
 	await open()
 	if (page.thread) {
 		await loadFromDB(page.thread)
 	}
 
 	initOptions()
+	await emoji
 
 	if (page.thread) {
 		renderThread()
@@ -48,6 +48,8 @@ class AccountPanel extends TabbedModal {
 				new FormDataForm("/html/set-banners", "/api/set-banners")),
 			"#setLoading": this.loadConditional(() =>
 				new FormDataForm("/html/set-loading", "/api/set-loading")),
+			"#setEmoji": this.loadConditional(() =>
+				new FormDataForm("/html/set-emoji", "/api/set-emoji")),
 		})
 
 		if (position > ModerationLevel.notStaff) {
@@ -1,7 +1,7 @@
 import { View } from "../base"
 import { postJSON, toggleHeadStyle, getClosestID } from "../util"
 import collectionView from "../posts/collectionView"
-import { ModerationLevel } from "../common"
+import { ModerationLevel, ModerationAction, BanScope } from "../common"
 import {SetPlaylistLock} from "../typings/nekotv";
 import {page} from "../state";
 
@@ -22,12 +22,38 @@ type CensorData = {
 	};
 }
 
+// Private staff note on a post or its poster's IP
+type ModNote = {
+	id: number;
+	post: number;
+	byIP: boolean;
+	created: string;
+	board: string;
+	by: string;
+	body: string;
+}
+
+type BulkModerationData = {
+	id: number;
+	sameIP: boolean;
+	sameThread: boolean;
+	sameFile: boolean;
+	// Unix timestamps. 0 is unbounded.
+	from: number;
+	to: number;
+	action: ModerationAction;
+	reason?: string;
+	duration?: number;
+	scope?: BanScope;
+}
+
 type BanData = {
 	isSet: boolean;
 	global: boolean;
 	shadow: boolean;
 	duration: number;
 	reason: string;
+	scope: BanScope;
 }
 
 let displayCheckboxes = localStorage.getItem("hideModCheckboxes") !== "true",
@@ -46,6 +72,15 @@ export default class ModPanel extends View<null> {
 		document.getElementById("meidovision").addEventListener("click", () => {
 			this.viewAllByIP();
 		});
+		document.getElementById("view-mod-notes").addEventListener("click", () => {
+			this.viewNotes();
+		});
+		document.getElementById("add-mod-note").addEventListener("click", () => {
+			this.addNote();
+		});
+		document.getElementById("bulk-moderate").addEventListener("click", () => {
+			this.bulkModerate();
+		});
 
 		if (position == ModerationLevel.admin) {
 			document.getElementById("redirect-ip").addEventListener("click", () => {
@@ -107,12 +142,138 @@ export default class ModPanel extends View<null> {
 			return;
 		}
 
-		const posts = await res.json();
+		const { posts, notes } = await res.json();
+		this.renderNotes(notes);
 		if (posts) {
 			new collectionView(posts);
 		}
 	}
 
+	// Display staff notes on the selected post and its poster's IP
+	private async viewNotes() {
+		const checked = this.getChecked();
+		if (!checked) {
+			return;
+		}
+		const id = getClosestID(checked);
+
+		const res = await postJSON(`/api/mod-notes/${id}`, null);
+		if (res.status !== 200) {
+			this.el.querySelector(".form-response").textContent =
+				await res.text();
+			return;
+		}
+		this.renderNotes(await res.json());
+	}
+
+	// Attach a staff note to the selected post or its poster's IP
+	private async addNote() {
+		const checked = this.getChecked();
+		if (!checked) {
+			return;
+		}
+		const id = getClosestID(checked);
+
+		const text = document
+			.getElementById("mod-note-text") as HTMLInputElement;
+		const byIP = document
+			.getElementById("mod-note-by-ip") as HTMLInputElement;
+		const res = await postJSON("/api/mod-notes", {
+			id,
+			byIP: byIP.checked,
+			body: text.value,
+		});
+		if (res.status !== 200) {
+			this.el.querySelector(".form-response").textContent =
+				await res.text();
+			return;
+		}
+		text.value = "";
+		byIP.checked = false;
+		await this.viewNotes();
+	}
+
+	private renderNotes(notes: ModNote[]) {
+		const el = document.getElementById("mod-notes");
+		el.innerHTML = "";
+		for (const { post, byIP, created, by, body } of notes || []) {
+			const note = document.createElement("blockquote");
+			note.title = new Date(created).toLocaleString();
+			const author = document.createElement("b");
+			author.textContent = by;
+			note.append(
+				author,
+				// Notes on the poster's IP apply to all of their posts
+				`${byIP ? " (IP)" : ""} >>${post}: ${body}`,
+			);
+			el.append(note);
+		}
+	}
+
+	// Apply a moderation action to all posts related to the selected post
+	private async bulkModerate() {
+		const errlog = this.el.querySelector(".form-response");
+		errlog.textContent = "";
+
+		const checked = this.getChecked();
+		if (!checked) {
+			return;
+		}
+
+		const flag = (id: string) =>
+			(document.getElementById(id) as HTMLInputElement).checked;
+		const time = (id: string) => {
+			const t = (document.getElementById(id) as HTMLInputElement)
+				.valueAsNumber;
+			// Inputs are in local time, but valueAsNumber is in UTC
+			return t
+				? Math.floor(t / 1000) + new Date(t).getTimezoneOffset() * 60
+				: 0;
+		};
+		const data: BulkModerationData = {
+			id: getClosestID(checked),
+			sameIP: flag("bulk-sameIP"),
+			sameThread: flag("bulk-sameThread"),
+			sameFile: flag("bulk-sameFile"),
+			from: time("bulk-from"),
+			to: time("bulk-to"),
+			action: parseInt((document
+				.getElementById("bulk-action") as HTMLSelectElement)
+				.value) as ModerationAction,
+		};
+		if (!data.sameIP && !data.sameThread && !data.sameFile) {
+			errlog.textContent = "No bulk moderation filter";
+			return;
+		}
+
+		switch (data.action) {
+			case ModerationAction.purgePost:
+				data.reason = this.inputElement("purge-reason").value;
+				if (!data.reason) {
+					errlog.textContent = "Missing purge reason";
+					return;
+				}
+				break;
+			case ModerationAction.shadowBinPost: {
+				const ban = this.parseBan();
+				if (ban.err) {
+					errlog.textContent = ban.err;
+					return;
+				}
+				data.reason = ban.data.reason;
+				data.duration = ban.data.duration;
+				data.scope = ban.data.scope;
+				break;
+			}
+		}
+
+		const res = await postJSON("/api/bulk-moderate", data);
+		errlog.textContent = res.status === 200
+			? `${await res.json()} posts moderated`
+			: await res.text();
+		checked.checked = false;
+	}
+
 	// Redirect a poster to a specified URL
 	private async redirectIP() {
 		const checked = this.getChecked();
@@ -195,6 +356,7 @@ export default class ModPanel extends View<null> {
 			shadow: this.inputElement("shadow").checked,
 			duration: dur,
 			reason: r,
+			scope: parseInt(this.selectElement("ban-scope").value) as BanScope,
 		}
 
 		// Global checkbox doesn't always exist
@@ -256,6 +418,7 @@ export default class ModPanel extends View<null> {
 			switch (e.type) {
 				case "number":
 				case "text":
+				case "datetime-local":
 					e.value = "";
 					break;
 				case "checkbox":
@@ -266,7 +429,16 @@ export default class ModPanel extends View<null> {
 					break;
 			}
 		}
+		for (const e of this.el.querySelectorAll("select")) {
+			e.selectedIndex = 0;
+		}
 		this.el.querySelector(".form-response").textContent = "";
+		document.getElementById("mod-notes").innerHTML = "";
+	}
+
+	private selectElement(name: string): HTMLSelectElement {
+		return this.el
+			.querySelector(`select[name="${name}"]`) as HTMLSelectElement;
 	}
 
 	// Post JSON to server and handle errors
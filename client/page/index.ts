@@ -3,7 +3,8 @@ import * as watcher from "./thread_watcher";
 
 export { extractConfigs } from "./common"
 export {
-	incrementPostCount, decrementImageCount, default as renderThread,
+	incrementPostCount, decrementPostCount, decrementImageCount,
+	default as renderThread,
 } from "./thread"
 export { render as renderBoard } from "./board"
 export { watchCurrentThread } from "./thread_watcher";
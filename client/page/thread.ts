@@ -3,17 +3,16 @@ import {
     extractConfigs, extractPost, reparseOpenPosts, extractPageData, hidePosts,
 } from "./common"
 import { findSyncwatches } from "../posts"
-import { config } from "../state"
+import { config, boardConfig } from "../state"
 import { postSM, postState } from "../posts"
 
 const counters = document.getElementById("thread-post-counters");
 const threads = document.getElementById("threads");
 
-const bumpLimit = 1000;
-
 let image_count = 0,
     bump_time = 0,
-    isDeleted = false
+    isDeleted = false,
+    cyclic = false
 
 export let post_count = 0;
 export let subject = "";
@@ -31,6 +30,7 @@ export default function () {
     subject = data.subject;
     image_count = data.image_count
     bump_time = data.bump_time
+    cyclic = !!data.cyclic
     if (data.moderation) {
         for (const { type } of data.moderation) {
             if (type === ModerationAction.deletePost) {
@@ -60,7 +60,8 @@ export default function () {
 export function incrementPostCount(post: boolean, hasImage: boolean) {
     if (post) {
         post_count++
-        if (post_count < bumpLimit) {
+        // Cyclic threads prune old posts instead of reaching the bump limit
+        if (cyclic || post_count < bumpLimit()) {
             // An estimate, but good enough
             bump_time = Math.floor(Date.now() / 1000)
         }
@@ -71,12 +72,27 @@ export function incrementPostCount(post: boolean, hasImage: boolean) {
     renderPostCounter()
 }
 
+// Decrement thread post counters after a post has been removed from the thread
+// and rerender the indicator in the banner
+export function decrementPostCount(hasImage: boolean) {
+    post_count--
+    if (hasImage) {
+        image_count--
+    }
+    renderPostCounter()
+}
+
 // Increment thread post counters and rerender the indicator in the banner
 export function decrementImageCount() {
     image_count--
     renderPostCounter()
 }
 
+// Number of posts in a thread, after which it is no longer bumped
+function bumpLimit(): number {
+    return boardConfig.bumpLimit || 1000
+}
+
 function renderPostCounter() {
     let text = ""
     if (post_count) {
@@ -87,7 +103,8 @@ function renderPostCounter() {
             // Calculate expiry age
             const min = config.threadExpiryMin,
                 max = config.threadExpiryMax
-            let days = min + (-max + min) * (post_count / bumpLimit - 1) ** 3
+            let days = min
+                + (-max + min) * (post_count / bumpLimit() - 1) ** 3
             if (isDeleted) {
                 days /= 3
             }
@@ -0,0 +1,105 @@
+import { fetchJSON, escape, on } from "../util"
+import { page, posts } from "../state"
+
+// Custom emoji uploaded to a board
+interface Emoji {
+	name: string
+	url: string
+}
+
+// Loaded emoji lists by board
+const loaded: { [board: string]: Emoji[] } = {}
+
+// Pending emoji list requests by board
+const loading: { [board: string]: Promise<Emoji[]> } = {}
+
+// Fetch and cache the custom emoji list of a board
+export function loadEmoji(board: string): Promise<Emoji[]> {
+	if (board in loaded) {
+		return Promise.resolve(loaded[board])
+	}
+	if (!loading[board]) {
+		loading[board] = fetchJSON<Emoji[]>(`/json/emoji/${board}`)
+			.then(([res, err]) => {
+				delete loading[board]
+				return loaded[board] = !err && res ? res : []
+			})
+	}
+	return loading[board]
+}
+
+// Returns, if the board has a custom emoji by this name. Lists of boards not
+// loaded yet are fetched in the background and any open posts of that board
+// rerendered, once it arrives.
+export function hasEmoji(board: string, name: string): boolean {
+	const list = loaded[board]
+	if (!list) {
+		if (!loading[board]) {
+			loadEmoji(board).then(list => {
+				if (list.length) {
+					reparseOpenPosts(board)
+				}
+			})
+		}
+		return false
+	}
+	return list.some(e => e.name === name)
+}
+
+// Rerender open post bodies of a board, that may contain emoji shortcodes
+function reparseOpenPosts(board: string) {
+	for (const m of posts) {
+		if (m.editing
+			&& (m.board || page.board) === board
+			&& m.body.indexOf(":") !== -1
+		) {
+			m.view.reparseBody()
+		}
+	}
+}
+
+// Render a custom emoji. Must match the server-side templates/body.go.
+export function renderEmoji(board: string, name: string): string {
+	const url = escape(`/assets/emoji/${board}/${name}`),
+		code = escape(`:${name}:`)
+	return `<img class="emoji" src="${url}" alt="${code}" title="${code}" `
+		+ `loading="lazy">`
+}
+
+// Set up the emoji picker inside the post form controls. insert is called
+// with the shortcode of the selected emoji.
+export async function initEmojiPicker(
+	controls: Element,
+	board: string,
+	insert: (code: string) => void,
+) {
+	const button = controls.querySelector(".emoji-button") as HTMLElement,
+		picker = controls.querySelector(".emoji-picker") as HTMLElement
+	if (!button || !picker) {
+		return
+	}
+
+	const list = await loadEmoji(board)
+	if (!list.length) {
+		return
+	}
+
+	let html = ""
+	for (const { name } of list) {
+		html += renderEmoji(board, name)
+	}
+	picker.innerHTML = html
+	button.hidden = false
+
+	button.addEventListener("click", e => {
+		e.preventDefault()
+		picker.hidden = !picker.hidden
+	})
+	on(picker, "click", e => {
+		const alt = (e.target as HTMLElement).getAttribute("alt")
+		picker.hidden = true
+		insert(alt)
+	}, {
+		selector: "img.emoji",
+	})
+}
@@ -1,18 +1,32 @@
 import { Post } from "./model"
-import {fileTypes, ImageData, isCuck, isExpandable} from "../common"
+import {
+	fileTypes, ImageData, isCuck, isExpandable, ArchiveEntry,
+} from "../common"
 import { View } from "../base"
 import {
 	setAttrs, on, trigger, firstChild, importTemplate, escape, pad, makeEl,
-	HTML,
+	HTML, fetchJSON, makeAttrs,
 } from "../util"
 import options from "../options"
-import { getModel, posts, config } from "../state"
+import { getModel, posts, config, boardConfig } from "../state"
 import lang from "../lang"
 import { relativeTime } from "./render";
 
 // Expand all image thumbnails automatically
 export let expandAll = false
 
+// Format a file size in bytes for display
+function readableFilesize(size: number): string {
+	if (size < (1 << 10)) {
+		return size + ' B';
+	}
+	if (size < (1 << 20)) {
+		return Math.round(size / (1 << 10)) + ' KB';
+	}
+	const text = Math.round(size / (1 << 20) * 10).toString();
+	return `${text.slice(0, -1)}.${text.slice(-1)} MB`;
+}
+
 // Mixin for image expansion and related functionality
 export default class ImageHandler extends View<Post> {
 	// Render the figure and figcaption of a post. Set reveal to true, if in
@@ -63,47 +77,37 @@ export default class ImageHandler extends View<Post> {
 	// Render the actual thumbnail image
 	private renderThumbnail() {
 		const el = this.el.querySelector("figure a"),
-			{ sha1, file_type: file_type, thumb_type: thumbType, dims, spoiler } = this
-				.model
-				.image,
-			src = sourcePath(sha1, file_type)
-		let thumb: string,
-			[, , thumbWidth, thumbHeight] = dims
-
-		if (thumbType === fileTypes.noFile) {
-			// No thumbnail exists
-			let file: string
-			switch (file_type) {
-				case fileTypes.webm:
-				case fileTypes.mp4:
-				case fileTypes.mp3:
-				case fileTypes.ogg:
-				case fileTypes.flac:
-					file = "audio"
-					break
-				default:
-					file = "file"
+			{ sha1, file_type } = this.model.image
+
+		el.setAttribute("href", sourcePath(sha1, file_type))
+		el.innerHTML = thumbnailHTML(this.model.image)
+	}
+
+	// Render any additional file attachments below the post body
+	public renderAttachments() {
+		let el = firstChild(this.el, ch =>
+			ch.classList.contains("post-attachments"))
+		const { attachments } = this.model
+		if (!attachments || !attachments.length) {
+			if (el) {
+				el.remove()
 			}
-			thumb = `/assets/${file}.png`
-			thumbHeight = thumbWidth = 150
-		} else if (spoiler && options.spoilers) {
-			// Spoilered and spoilers enabled
-			thumb = '/assets/spoil/default.jpg'
-			thumbHeight = thumbWidth = 150
-		} else if (options.autogif && file_type === fileTypes.gif) {
-			// Animated GIF thumbnails
-			thumb = src
-		} else {
-			thumb = thumbPath(sha1, thumbType)
+			return
+		}
+		if (!el) {
+			el = makeEl(`<div class="post-attachments"></div>`) as HTMLElement
+			this.el.querySelector(".post-container").after(el)
 		}
 
-		el.setAttribute("href", src)
-		setAttrs(el.firstElementChild, {
-			src: thumb,
-			width: thumbWidth.toString(),
-			height: thumbHeight.toString(),
-			class: "", // Remove any existing classes
-		})
+		let html = ""
+		for (const img of attachments) {
+			const name = `${img.name}.${fileTypes[img.file_type]}`
+			html += HTML
+				`<a class="post-attachment" target="_blank" href="${getPlayableImageSrc(img)}" title="${escape(name)}">
+					${thumbnailHTML(img)}
+				</a>`
+		}
+		el.innerHTML = html
 	}
 
 	public renderSource(id: string, el : Element, postingTime: Element){
@@ -182,17 +186,7 @@ export default class ImageHandler extends View<Post> {
 			duration.remove()
 		}
 
-		const {size} = data;
-		let s: string;
-		if (size < (1 << 10)) {
-			s = size + ' B';
-		} else if (size < (1 << 20)) {
-			s = Math.round(size / (1 << 10)) + ' KB';
-		} else {
-			const text = Math.round(size / (1 << 20) * 10).toString();
-			s = `${text.slice(0, -1)}.${text.slice(-1)} MB`;
-		}
-		fileSize.insertAdjacentText('beforeend', s);
+		fileSize.insertAdjacentText('beforeend', readableFilesize(data.size));
 
 		const [w, h] = data.dims;
 		if (w || h) {
@@ -359,6 +353,15 @@ export default class ImageHandler extends View<Post> {
 				} else {
 					return this.expandImage(event, false)
 				}
+			case fileTypes.zip:
+			case fileTypes.cbz:
+			case fileTypes["7z"]:
+			case fileTypes.rar:
+			case fileTypes.cbr:
+			case fileTypes["tar.gz"]:
+			case fileTypes["tar.xz"]:
+				event.preventDefault()
+				return this.toggleArchive()
 			default:
 				if (!isExpandable(img.file_type)) {
 					// Simply download the file
@@ -371,6 +374,75 @@ export default class ImageHandler extends View<Post> {
 		}
 	}
 
+	// Toggle a listing of an archive's contents and a reader of its comic book
+	// pages, if any. Archives without an index are simply downloaded.
+	private async toggleArchive() {
+		const figure = this.getFigure(),
+			open = figure.nextElementSibling
+		if (open && open.classList.contains("archive-browser")) {
+			return open.remove()
+		}
+
+		const { sha1 } = this.model.image,
+			[entries, err] = await fetchJSON<ArchiveEntry[]>(
+				`/json/archive/${sha1}`,
+			)
+		if (err) {
+			return (this.el
+				.querySelector("figcaption a[download]") as HTMLElement)
+				.click()
+		}
+
+		const url = (i: number) => `/assets/archive/${sha1}/${i}`
+		let list = ""
+		for (let i = 0; i < entries.length; i++) {
+			const { name, size } = entries[i]
+			list += HTML
+				`<li>
+					<a href="${url(i)}" target="_blank">${escape(name)}</a>
+					<span> (${readableFilesize(size)})</span>
+				</li>`
+		}
+		const el = makeEl(HTML
+			`<div class="archive-browser">
+				<ul class="archive-entries">${list}</ul>
+			</div>`,
+		) as HTMLElement
+
+		// Pages are read in file name order
+		const pages = entries
+			.map((e, i) => ({ ...e, i }))
+			.filter(e => e.page)
+			.sort((a, b) => a.name < b.name ? -1 : 1)
+		if (pages.length) {
+			const reader = makeEl(HTML
+				`<div class="archive-reader">
+					<img loading="lazy">
+					<span class="archive-page"></span>
+				</div>`,
+			) as HTMLElement,
+				imgEl = reader.querySelector("img"),
+				counter = reader.querySelector(".archive-page") as HTMLElement
+			let current = 0
+			const show = (n: number) => {
+				current = Math.max(0, Math.min(n, pages.length - 1))
+				imgEl.src = url(pages[current].i)
+				counter.textContent = `${current + 1} / ${pages.length}`
+			}
+
+			// Clicking the left half of a page goes back and the right half
+			// forward
+			imgEl.addEventListener("click", (e: MouseEvent) => {
+				const { left, width } = imgEl.getBoundingClientRect()
+				show(current + (e.clientX - left < width / 2 ? -1 : 1))
+			})
+			show(0)
+			el.prepend(reader)
+		}
+
+		figure.after(el)
+	}
+
 	// Automatically expand an image, if expandAll is set
 	public autoExpandImage() {
 		if (expandAll && shouldAutoExpand(this.model)) {
@@ -478,7 +550,13 @@ export default class ImageHandler extends View<Post> {
 					class: cls,
 					loading: "lazy",
 				})
-				imgEl.replaceWith(el)
+				// Also drop any alternative thumbnail sources
+				const picture = imgEl.closest("picture")
+				if (picture) {
+					picture.replaceWith(el)
+				} else {
+					imgEl.replaceWith(el)
+				}
 		}
 	}
 
@@ -502,6 +580,92 @@ function imageRoot(): string {
 	return config.imageRootOverride || "/assets/images"
 }
 
+// Resolve the thumbnail source and dimensions of an image
+function thumbnail(img: ImageData): [string, number, number] {
+	const { sha1, file_type, thumb_type, dims, spoiler } = img
+	const [, , width, height] = dims
+
+	if (thumb_type === fileTypes.noFile) {
+		// No thumbnail exists
+		let file: string
+		switch (file_type) {
+			case fileTypes.webm:
+			case fileTypes.mp4:
+			case fileTypes.mp3:
+			case fileTypes.ogg:
+			case fileTypes.flac:
+				file = "audio"
+				break
+			default:
+				file = "file"
+		}
+		return [`/assets/${file}.png`, 150, 150]
+	} else if (spoiler && options.spoilers) {
+		// Spoilered and spoilers enabled
+		return ['/assets/spoil/default.jpg', 150, 150]
+	} else if (options.autogif && file_type === fileTypes.gif) {
+		// Animated GIF thumbnails
+		return [sourcePath(sha1, file_type), width, height]
+	}
+	return [thumbPath(sha1, thumb_type), width, height]
+}
+
+// Render the thumbnail of an image with any higher resolution and AVIF
+// alternatives
+function thumbnailHTML(img: ImageData): string {
+	const [src, width, height] = thumbnail(img),
+		attrs: { [key: string]: string } = {
+			src,
+			width: width.toString(),
+			height: height.toString(),
+			loading: "lazy",
+			draggable: "false",
+		}
+	if (src !== thumbPath(img.sha1, img.thumb_type)) {
+		// Placeholder, spoiler or animated GIF
+		return `<img${makeAttrs(attrs)}>`
+	}
+
+	const sizes = `${width}px`
+	if (img.thumbs && Object.keys(img.thumbs).length) {
+		attrs["srcset"] = thumbSrcset(img, img.thumb_type)
+		attrs["sizes"] = sizes
+	}
+	const el = `<img${makeAttrs(attrs)}>`
+	if (!img.thumb_avif) {
+		return el
+	}
+	const source = makeAttrs({
+		type: "image/avif",
+		srcset: thumbSrcset(img, fileTypes.avif),
+		sizes,
+	})
+	return `<picture><source${source}>${el}</picture>`
+}
+
+// Resolve the srcset of the standard and any additional thumbnails of an
+// image of a certain type
+function thumbSrcset(img: ImageData, thumbType: fileTypes): string {
+	const { sha1, dims, thumbs } = img
+	let srcset = `${thumbPath(sha1, thumbType)} ${dims[2]}w`
+	if (thumbs) {
+		const sizes = Object.keys(thumbs).map(Number).sort((a, b) => a - b)
+		for (const size of sizes) {
+			srcset += `, ${thumbVariantPath(sha1, thumbType, size)}`
+				+ ` ${thumbs[size][0]}w`
+		}
+	}
+	return srcset
+}
+
+// Get the path of an additional thumbnail of an image fitting a bounding box
+// of size
+export function thumbVariantPath(
+	sha1: string, thumbType: fileTypes, size: number,
+): string {
+	return `${imageRoot()}/thumb/${sha1}_${size}.${fileTypes[thumbType]}`
+}
+
 // Get the thumbnail path of an image, accounting for not thumbnail of specific
 // type being present
 export function thumbPath(sha1: string, thumbType: fileTypes): string {
@@ -513,6 +677,11 @@ export function sourcePath(sha1: string, fileType: fileTypes): string {
 	return `${imageRoot()}/src/${sha1}.${fileTypes[fileType]}`
 }
 
+// Resolve the path to the transcoded browser-playable rendition of an upload
+export function renditionPath(sha1: string, fileType: fileTypes): string {
+	return `${imageRoot()}/rendition/${sha1}.${fileTypes[fileType]}`
+}
+
 // Delegate image clicks to views. More performant than dedicated listeners for
 // each view.
 function handleImageClick(event: MouseEvent) {
@@ -609,7 +778,9 @@ export function getTokID(filename: string): string | null {
 
 export function getPlayableImageSrc(image: ImageData): string {
 	const tokID = getTokID(image.name)
-	if (isCuck && image.codec === "hevc" && tokID != null) {
+	if (boardConfig.serveRenditions && image.rendition) {
+		return renditionPath(image.sha1, image.rendition)
+	} else if (isCuck && image.codec === "hevc" && tokID != null) {
 		return `https://tikwm.com/video/media/play/${tokID}.mp4`
 	} else {
 		return sourcePath(image.sha1, image.file_type)
@@ -14,6 +14,8 @@ import initPosting from "./posting"
 import initMenu from "./menu"
 import initInlineExpansion from "./inlineExpansion"
 import initHover from "./hover"
+import initPolls from "./poll"
+import initReactions from "./reactions"
 
 export default () => {
 	initEtc()
@@ -21,5 +23,7 @@ export default () => {
 	initMenu()
 	initInlineExpansion()
 	initHover()
+	initPolls()
+	initReactions()
 }
 
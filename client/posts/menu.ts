@@ -73,6 +73,42 @@ class RedirectForm extends MenuForm {
 	}
 }
 
+// Form for thread moderation. Sends the post ID and the value of a single
+// text field, optionally parsed as an integer.
+class ThreadForm extends MenuForm {
+	private readonly apiPath: string;
+	private readonly key: string;
+	private readonly numeric: boolean;
+
+	constructor(parent: Element, parentID: number, apiPath: string,
+		key: string, placeholder: string, numeric = false,
+	) {
+		super(parent, parentID,
+			HTML`
+			<br>
+			<input type=text name=${key} placeholder="${placeholder}">`);
+		this.apiPath = apiPath;
+		this.key = key;
+		this.numeric = numeric;
+	}
+
+	protected async send() {
+		const val = (this.el
+			.querySelector("input[type=text]") as HTMLInputElement)
+			.value;
+		const res = await postJSON(`/api/${this.apiPath}`, {
+			id: this.parentID,
+			[this.key]: this.numeric ? parseInt(val) : val,
+		});
+		if (res.status !== 200) {
+			this.renderFormResponse(await res.text());
+			return;
+		}
+		this.closeMenu();
+		this.remove();
+	}
+}
+
 // Actions to be performed by the items in the popup menu
 const actions: { [key: string]: ItemSpec } = {
 	hide: {
@@ -126,6 +162,65 @@ const actions: { [key: string]: ItemSpec } = {
 			m.view.renderLocked()
 		},
 	},
+	toggleCyclic: {
+		text: lang.ui["toggleCyclic"],
+		shouldRender(m) {
+			return position >= ModerationLevel.moderator && m.id === m.op
+		},
+		async handler(m) {
+			const res = await postJSON("/api/cyclic-thread", {
+				id: m.id,
+				val: !m.cyclic,
+			})
+			if (res.status !== 200) {
+				return alert(await res.text())
+			}
+			m.cyclic = !m.cyclic
+		},
+	},
+	moveThread: {
+		text: lang.ui["moveThread"],
+		keepOpen: true,
+		shouldRender(m) {
+			return position >= ModerationLevel.moderator && m.id === m.op
+		},
+		handler(m, el) {
+			new ThreadForm(el, m.id, "move-thread", "board", lang.ui["board"])
+		},
+	},
+	mergeThread: {
+		text: lang.ui["mergeThread"],
+		keepOpen: true,
+		shouldRender(m) {
+			return position >= ModerationLevel.moderator && m.id === m.op
+		},
+		handler(m, el) {
+			new ThreadForm(el, m.id, "merge-threads", "into", lang.ui["thread"],
+				true)
+		},
+	},
+	splitThread: {
+		text: lang.ui["splitThread"],
+		keepOpen: true,
+		shouldRender(m) {
+			return position >= ModerationLevel.moderator && m.id !== m.op
+		},
+		handler(m, el) {
+			new ThreadForm(el, m.id, "split-thread", "subject",
+				lang.ui["subject"])
+		},
+	},
+	setSlowMode: {
+		text: lang.ui["setSlowMode"],
+		keepOpen: true,
+		shouldRender(m) {
+			return position >= ModerationLevel.moderator && m.id === m.op
+		},
+		handler(m, el) {
+			new ThreadForm(el, m.id, "slow-mode", "val", lang.ui["seconds"],
+				true)
+		},
+	},
 	redirectByThread: {
 		text: lang.ui["redirectByThread"],
 		keepOpen: true,
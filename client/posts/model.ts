@@ -8,12 +8,15 @@ import {notifyAboutReply} from "../ui"
 import {
     ClaudeState,
     Command,
+    commandType,
     ImageData,
     ModerationAction,
     ModerationEntry,
     ModerationLevel,
+    PollState,
     PostData,
     PostLink,
+    ReactionCounts,
     TextState,
 } from "../common"
 import {hideRecursively} from "./hide"
@@ -29,9 +32,11 @@ export class Post extends Model implements PostData {
     public sage: boolean
     public sticky: boolean
     public locked: boolean
+    public cyclic: boolean
     public seenOnce: boolean
     public hidden: boolean
     public image: ImageData
+    public attachments: ImageData[]
     public time: number
     public body: string
     public name: string
@@ -51,6 +56,7 @@ export class Post extends Model implements PostData {
     public links: PostLink[]
     public moderation: ModerationEntry[]
     public claude_state: ClaudeState
+    public reactions: ReactionCounts
 
     constructor(attrs: PostData) {
         super()
@@ -221,10 +227,39 @@ export class Post extends Model implements PostData {
         this.view.autoExpandImage()
     }
 
+    // Append an additional file attachment to the post
+    public insertAttachment(img: ImageData) {
+        if (!this.attachments) {
+            this.attachments = []
+        }
+        this.attachments.push(img)
+        this.view.renderAttachments()
+    }
+
     // Spoiler an already allocated imageThreadData
     public spoilerImage() {
-        this.image.spoiler = true
-        this.view.renderImage(false)
+        if (this.image) {
+            this.image.spoiler = true
+            this.view.renderImage(false)
+        }
+        this.spoilerAttachments()
+    }
+
+    private spoilerAttachments() {
+        if (this.attachments) {
+            for (const a of this.attachments) {
+                a.spoiler = true
+            }
+            this.view.renderAttachments()
+        }
+    }
+
+    // Remove all additional file attachments
+    public removeAttachments() {
+        if (this.attachments) {
+            this.attachments = null
+            this.view.renderAttachments()
+        }
     }
 
     // Close an open post and reparse its last line
@@ -258,6 +293,29 @@ export class Post extends Model implements PostData {
         this.view.claudeError()
     }
 
+    // Update the vote tallies of the post's poll
+    public updatePoll(votes: number[]) {
+        if (!this.commands) {
+            return
+        }
+        for (const c of this.commands) {
+            if (c.type === commandType.poll) {
+                (c.val as PollState).votes = votes
+                this.view.reparseBody()
+                return
+            }
+        }
+    }
+
+    // Set the count of a reaction to the post
+    public setReaction(reaction: string, count: number) {
+        if (!this.reactions) {
+            this.reactions = {}
+        }
+        this.reactions[reaction] = count
+        this.view.renderReactions()
+    }
+
     public applyModeration(entry: ModerationEntry) {
         if (!this.moderation) {
             this.moderation = [];
@@ -279,12 +337,14 @@ export class Post extends Model implements PostData {
                     this.image = null;
                     this.view.removeImage();
                 }
+                this.removeAttachments();
                 break;
             case ModerationAction.spoilerImage:
                 if (this.image) {
                     this.image.spoiler = true;
                     this.view.renderImage(false);
                 }
+                this.spoilerAttachments();
                 break;
             case ModerationAction.lockThread:
                 this.locked = data === 'true';
@@ -294,6 +354,7 @@ export class Post extends Model implements PostData {
                     this.image = null;
                     this.view.removeImage();
                 }
+                this.removeAttachments();
                 this.body = "";
                 this.view.reparseBody()
                 break;
@@ -0,0 +1,22 @@
+import { on } from "../util"
+import { getModel } from "../state"
+import { message, send } from "../connection"
+
+// Vote for the clicked option of an open poll
+function vote(event: Event) {
+	const el = event.target as HTMLElement
+	if (el.closest(".poll.closed")) {
+		return
+	}
+	const model = getModel(el)
+	if (!model) {
+		return
+	}
+	send(message.pollVote, {
+		id: model.id,
+		option: parseInt(el.getAttribute("data-option")),
+	})
+}
+
+export default () =>
+	on(document, "click", vote, { selector: ".poll-option" })
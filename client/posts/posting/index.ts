@@ -15,6 +15,8 @@ import {captchaLoaded, renderCaptchaForm} from "../../ui";
 import * as page from "../../page";
 import options from "../../options";
 import {initUpload} from "./upload"
+import {onCooldown, startCooldown, stopCooldown} from "./slowMode"
+import {holdForReview, isHeld, resetReview} from "./review"
 
 export {default as FormModel} from "./model"
 export {default as identity} from "./identity"
@@ -80,6 +82,14 @@ export const enum postEvent {
     captchaRequested,
     // Captcha successfully solved
     captchaSolved,
+    // Server notified of a slow mode cooldown. This rejects any pending post
+    // allocation request.
+    slowMode,
+    // Server requested to submit the draft for review in one piece. This
+    // rejects any pending post allocation request.
+    reviewRequired,
+    // Draft submitted and held for review by staff
+    held,
 }
 
 export const postSM = new FSM<postState, postEvent>(postState.none)
@@ -226,6 +236,21 @@ function updateIdentity() {
     }
 }
 
+// Update the post form on slow mode countdown ticks and resubmit the draft
+// post, once the countdown expires
+function onCooldownTick() {
+    if (!postModel) {
+        return
+    }
+    postForm.updateDoneButton()
+    if (!onCooldown() && postSM.state === postState.draft) {
+        const b = postForm.input.value
+        if (b) {
+            postModel.parseInput(b)
+        }
+    }
+}
+
 async function openReply(e: MouseEvent) {
     // Don't trigger, when user is trying to open in a new tab
     if (e.which !== 1
@@ -249,6 +274,23 @@ export default () => {
     // The server notified a captcha will be required on the next post
     handlers[message.captcha] = postSM.feeder(postEvent.captchaRequested);
 
+    // The server notified of the slow mode cooldown in the thread
+    handlers[message.slowMode] = (seconds: number) => {
+        startCooldown(seconds, onCooldownTick)
+        postSM.feed(postEvent.slowMode)
+    }
+
+    // The server held the submitted draft for review or requested the draft
+    // to be submitted for review in one piece
+    handlers[message.pendingReview] = (id: number) => {
+        if (id) {
+            postSM.feed(postEvent.held)
+        } else {
+            holdForReview()
+            postSM.feed(postEvent.reviewRequired)
+        }
+    }
+
     // Initial synchronization
     postSM.act(postState.none, postEvent.sync, () =>
         postState.ready)
@@ -319,8 +361,11 @@ export default () => {
     })
 
     // Reset state during page navigation
-    postSM.wildAct(postEvent.reset, () =>
-        postState.ready)
+    postSM.wildAct(postEvent.reset, () => {
+        stopCooldown()
+        resetReview()
+        return postState.ready
+    })
 
     // Transition a draft post into allocated state. All the logic for this is
     // model- and view-side.
@@ -357,6 +402,9 @@ export default () => {
         if (captchaLoaded()) {
             return postState.draft;
         }
+        if (isHeld() && postModel.submitForReview()) {
+            return postState.allocating;
+        }
         postForm.remove();
         return postState.ready;
     })
@@ -381,6 +429,29 @@ export default () => {
         return postState.alloc;
     })
 
+    // Posted too soon in a slow mode thread. The draft is resubmitted, once
+    // the cooldown expires.
+    postSM.act(postState.allocating, postEvent.slowMode, () => {
+        postModel.inputBody = "";
+        if (postForm.upload) {
+            postForm.upload.reset();
+        }
+        return postState.draft;
+    })
+
+    // Posts of new posters are reviewed by staff. The draft is submitted in
+    // one piece, once done.
+    postSM.act(postState.allocating, postEvent.reviewRequired, () => {
+        postModel.onReviewRequired();
+        return postState.draft;
+    })
+
+    // Keep displaying the held draft as a closed post
+    postSM.act(postState.allocating, postEvent.held, () => {
+        postForm.renderPending();
+        return postState.ready;
+    })
+
     // Attempt to resume post after solving captcha
     for (const s of [postState.draft, postState.allocating, postState.alloc]) {
         // Capture variable in inner scope
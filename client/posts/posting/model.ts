@@ -8,12 +8,16 @@ import {extend, modPaste} from "../../util"
 import {SpliceResponse} from "../../client"
 import {FileData} from "./upload"
 import {newAllocRequest} from "./identity"
+import {onCooldown} from "./slowMode"
+import {isHeld} from "./review"
 
 // Form Model of an OP post
 export default class FormModel extends Post {
 	public inputBody = ""
 	public view: FormView
 	public allocatingImage: boolean = false;
+	// Uploaded file of a draft, that is not yet allocated
+	private draftImage: FileData | null = null;
 	private static textEncoder = new TextEncoder();
 
 	// Pass and ID, if you wish to hijack an existing model. To create a new
@@ -90,7 +94,11 @@ export default class FormModel extends Post {
 
 		const lenDiff = val.length - old.length;
 		if (postSM.state === postState.draft) {
-			this.requestAlloc(val, null)
+			// Resubmitted, once the slow mode cooldown expires. Held drafts
+			// are submitted in one piece.
+			if (!onCooldown() && !isHeld()) {
+				this.requestAlloc(val, null)
+			}
 		} else if (lenDiff === 1 && val.slice(0, -1) === old) {
 			// Commit a character appendage to the end of the line to the server
 			const char = val.slice(-1);
@@ -281,7 +289,7 @@ export default class FormModel extends Post {
 			delete handlers[message.postID]
 		}
 	}
-	public attachTiktok(input: string, hd: boolean, rotation: string){
+	public attachURL(input: string, hd: boolean, rotation: string){
 		if(postSM.state == postState.draft) {
 			this.allocatingImage = true;
 			this.requestAlloc(this.trimInput(this.view.input.value, true),
@@ -306,7 +314,7 @@ export default class FormModel extends Post {
 			view.setUint8(strArray.length, 0);
 		}
         view.setUint8(strArray.length + 1, hd ? 1 : 0);
-        view.setUint8(strArray.length + 2, message.attachTiktok)
+        view.setUint8(strArray.length + 2, message.attachURL)
 		sendBinary(buffer)
 	}
 
@@ -318,7 +326,7 @@ export default class FormModel extends Post {
 			req["body"] = this.inputBody = body;
 		}
 		if (image) {
-			req["image"] = image;
+			req["image"] = this.draftImage = image;
 		}
 
 		send(message.insertPost, req);
@@ -326,6 +334,45 @@ export default class FormModel extends Post {
 		handlers[message.postID] = this.receiveID();
 	}
 
+	// Revert a rejected allocation request to a draft, that is submitted for
+	// review in one piece
+	public onReviewRequired() {
+		this.inputBody = "";
+		this.allocatingImage = false;
+		if (this.draftImage) {
+			this.holdImage(this.draftImage);
+		}
+	}
+
+	// Submit the draft for review by staff in one piece. Returns false, if
+	// there is nothing to submit.
+	public submitForReview(): boolean {
+		const body = this.trimInput(this.view.input.value, true);
+		if (!body && !this.draftImage) {
+			return false;
+		}
+		const req = newAllocRequest();
+		req["open"] = false;
+		if (body) {
+			// Displayed as the body of the post, once held
+			req["body"] = this.body = this.inputBody = body;
+		}
+		if (this.draftImage) {
+			req["image"] = this.draftImage;
+		}
+		send(message.insertPost, req);
+		return true;
+	}
+
+	// Keep an uploaded file to submit with a draft held for review
+	private holdImage(data: FileData) {
+		this.draftImage = data;
+		if (this.view.upload) {
+			this.view.upload.reset(data.name);
+			this.view.upload.hideButton();
+		}
+	}
+
 	// Handle draft post allocation
 	public onAllocation(data: PostData) {
 		extend(this, data);
@@ -340,7 +387,7 @@ export default class FormModel extends Post {
 
 	// Upload the file and request its allocation
 	public async uploadFile(file: File) {
-		if (!boardConfig.textOnly && !this.image) {
+		if (this.canAttach()) {
 			const pr = this.view.upload.uploadFile(file);
 			this.view.input.focus();
 			this.handleUploadResponse(await pr);
@@ -348,7 +395,7 @@ export default class FormModel extends Post {
 	}
 
 	public async uploadFileHash(hash: string) {
-		if (!boardConfig.textOnly && !this.image) {
+		if (this.canAttach()) {
 			const pr = this.view.upload.uploadFileHash(hash);
 			this.view.input.focus();
 			this.handleUploadResponse(await pr);
@@ -362,13 +409,23 @@ export default class FormModel extends Post {
 	}
 
 	private handleUploadResponse(data: FileData | null) {
-		// Upload failed, canceled or image added while thumbnailing
-		if (!data || this.image || this.allocatingImage) {
+		// Upload failed, canceled or image limit reached while thumbnailing
+		if (!data || !this.canAttach() || this.allocatingImage) {
 			return
 		}
 
 		switch (postSM.state) {
 			case postState.draft:
+				if (isHeld()) {
+					this.holdImage(data);
+					break;
+				}
+				if (onCooldown()) {
+					// Check back after the slow mode cooldown expires
+					setTimeout(this.handleUploadResponse.bind(this, data),
+						1000);
+					break;
+				}
 				this.allocatingImage = true;
 				this.requestAlloc(this.trimInput(this.view.input.value, true),
 					data);
@@ -402,6 +459,35 @@ export default class FormModel extends Post {
 
 		this.image = img
 		this.view.insertImage()
+		this.resetUpload()
+	}
+
+	// Insert an additional uploaded file into the model
+	public insertAttachment(img: ImageData) {
+		super.insertAttachment(img)
+		if (this.editing) {
+			this.resetUpload()
+		}
+	}
+
+	// Returns, if more files can be attached to the post
+	private canAttach(): boolean {
+		if (boardConfig.textOnly) {
+			return false
+		}
+		let n = this.attachments ? this.attachments.length : 0
+		if (this.image) {
+			n++
+		}
+		return n < (boardConfig.maxAttachments || 1)
+	}
+
+	// Allow uploading further files, if the board permits it
+	private resetUpload() {
+		this.allocatingImage = false
+		if (this.canAttach() && this.view.upload) {
+			this.view.upload.reset()
+		}
 	}
 
 	// Spoiler an already allocated image
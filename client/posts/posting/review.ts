@@ -0,0 +1,20 @@
+// Pre-moderation of posts by new posters. Held posts can not be allocated as
+// open posts and are submitted for review in one piece instead.
+
+// Posts of the client in the current thread are reviewed by staff
+let held = false
+
+// Returns, if posts of the client are held for review
+export function isHeld(): boolean {
+    return held
+}
+
+// Hold any further posts of the client for review
+export function holdForReview() {
+    held = true
+}
+
+// Reset the review status. Used during page navigation.
+export function resetReview() {
+    held = false
+}
@@ -0,0 +1,56 @@
+// Countdown of the slow mode cooldown in the current thread
+
+import lang from "../../lang"
+
+// Seconds left until the client can post in the thread again
+let remaining = 0,
+    timer = 0,
+    onTick: () => void
+
+// Returns, if the client has to wait before allocating another post
+export function onCooldown(): boolean {
+    return remaining > 0
+}
+
+// Append the remaining cooldown, if any, to a button label
+export function withCooldown(label: string): string {
+    return remaining ? `${label} (${remaining}s)` : label
+}
+
+// Start counting down the cooldown. fn is called on every tick and once more
+// after the countdown expires.
+export function startCooldown(seconds: number, fn: () => void) {
+    stopCooldown()
+    remaining = seconds
+    onTick = fn
+    if (!remaining) {
+        return
+    }
+    timer = window.setInterval(tick, 1000)
+    render()
+}
+
+// Cancel any running countdown. Used during page navigation.
+export function stopCooldown() {
+    if (timer) {
+        clearInterval(timer)
+        timer = 0
+    }
+    remaining = 0
+}
+
+function tick() {
+    if (!--remaining) {
+        clearInterval(timer)
+        timer = 0
+    }
+    render()
+}
+
+function render() {
+    const el = document.querySelector("aside.posting a")
+    if (el) {
+        el.textContent = withCooldown(lang.ui["reply"])
+    }
+    onTick()
+}
@@ -206,7 +206,7 @@ export default class UploadForm extends View<Post> {
                 headers["Authorization"] = "Bearer " + bypass;
             }
 
-            const res = await fetch("/api/upload-hash", {
+            const res = await fetch(`/api/upload-hash?board=${page.board}`, {
                 method: "POST",
                 body: sha1,
                 headers,
@@ -318,7 +318,7 @@ export default class UploadForm extends View<Post> {
 
         // Not using fetch, because no ProgressEvent support
         this.xhr = new XMLHttpRequest();
-        this.xhr.open("POST", "/api/upload");
+        this.xhr.open("POST", `/api/upload?board=${page.board}`);
         this.xhr.upload.onprogress = e =>
             this.renderProgress(e);
 
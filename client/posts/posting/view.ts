@@ -1,12 +1,15 @@
 import PostView from "../view"
 import FormModel from "./model"
 import { Post } from "../model"
-import { boardConfig } from "../../state"
+import { boardConfig, page } from "../../state"
 import { setAttrs, importTemplate, atBottom, scrollToBottom } from "../../util"
 import { postSM, postEvent, postState } from "."
 import UploadForm from "./upload"
 import identity from "./identity"
 import lang from "../../lang";
+import { withCooldown } from "./slowMode"
+import { isHeld } from "./review"
+import { initEmojiPicker } from "../emoji"
 
 // Element at the bottom of the thread to keep the fixed reply form from
 // overlapping any other posts, when scrolled till bottom
@@ -54,6 +57,9 @@ export default class FormView extends PostView {
                 ()=>{this.onAttachTiktokButton()});
         }
 
+        initEmojiPicker(this.el.querySelector("#post-controls"),
+            page.board, code => this.insertText(code))
+
         const bq = this.el.querySelector("blockquote")
         bq.innerHTML = ""
         bq.append(this.input)
@@ -81,7 +87,7 @@ export default class FormView extends PostView {
         templateEl.querySelector(".attach-tiktok-attach").addEventListener("click",()=>{
             const inputVal = input.value
             const rotation = templateEl.querySelector("select").value
-            this.model.attachTiktok(inputVal,true,rotation)
+            this.model.attachURL(inputVal,true,rotation)
             templateEl.remove()
             this.upload.attachTiktokButton.hidden = false;
         })
@@ -195,6 +201,23 @@ export default class FormView extends PostView {
         })
     }
 
+    // Insert text at the cursor position, padded with spaces from any
+    // adjacent words
+    public insertText(text: string) {
+        const el = this.input,
+            start = el.selectionStart,
+            end = el.selectionEnd,
+            old = el.value
+        if (start && !/\s/.test(old[start - 1])) {
+            text = " " + text
+        }
+        if (end < old.length && !/\s/.test(old[end])) {
+            text += " "
+        }
+        this.replaceText(old.slice(0, start) + text + old.slice(end),
+            start + text.length, true)
+    }
+
     // Transform form into a generic post. Removes any dangling form controls
     // and frees up references.
     public cleanUp() {
@@ -260,6 +283,14 @@ export default class FormView extends PostView {
         this.input.setAttribute("contenteditable", "false")
     }
 
+    // Mark the draft as held for review by staff
+    public renderPending() {
+        const el = document.createElement("b");
+        el.textContent = lang.ui["pendingReview"];
+        this.el.querySelector("header").append(el);
+        this.el.classList.add("pending-review");
+    }
+
     // Transition into allocated post
     public renderAlloc() {
         this.id = this.el.id = "p" + this.model.id
@@ -309,7 +340,9 @@ export default class FormView extends PostView {
                 disable = true;
                 break;
             case postState.draft:
-                text = lang.ui["cancel"];
+                text = isHeld()
+                    ? lang.ui["submitForReview"]
+                    : withCooldown(lang.ui["cancel"]);
                 break;
             case postState.alloc:
                 break;
@@ -0,0 +1,19 @@
+import { on } from "../util"
+import { getModel } from "../state"
+import { message, send } from "../connection"
+
+// React to a post with the clicked reaction
+function react(event: Event) {
+	const el = event.target as HTMLElement
+	const model = getModel(el)
+	if (!model) {
+		return
+	}
+	send(message.reaction, {
+		id: model.id,
+		reaction: el.getAttribute("data-reaction"),
+	})
+}
+
+export default () =>
+	on(document, "click", react, { selector: ".reaction" })
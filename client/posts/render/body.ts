@@ -1,9 +1,12 @@
-import {boardConfig, boards, config, posts} from '../../state'
+import {boardConfig, boards, config, page, posts} from '../../state'
 import {renderPostLink, renderTempLink} from './etc'
-import {commandType, PostData, PostLink, TextState} from '../../common'
+import {
+    commandType, DiceRoll, DiceTerm, PollState, PostData, PostLink, TextState,
+} from '../../common'
 import {escape, makeAttrs} from '../../util'
 import {parseEmbeds} from "../embed"
 import highlightSyntax from "./code"
+import {hasEmoji, renderEmoji} from "../emoji"
 
 // URLs supported for linkification
 const urlPrefixes = {
@@ -13,6 +16,16 @@ const urlPrefixes = {
     'b': "bitcoin",
 }
 
+// Regex source matching a single dice term, such as 4d6kh3, 3d6e or 4dF
+const diceTerm = "\\d*d(?:\\d+|F)e?(?:[kd][hl]?\\d+)?"
+
+// Matches hash commands in a single word
+const commandRegexp = new RegExp(
+    "^#(flip"
+    + `|${diceTerm}(?:[+-](?:${diceTerm}|\\d+))*`
+    + "|8ball|pyu|pcount|sw(?:\\d+:)?\\d+:\\d+(?:[+-]\\d+)?|autobahn|poll\\d*)$",
+)
+
 // Render the text body of a post
 export default function renderBody(data: PostData): string {
     const state: TextState = data.state = {
@@ -377,6 +390,15 @@ function parseFragment(frag: string, data: PostData): string {
 
         // Split leading and trailing punctuation, if any
         let [leadPunct, word, trailPunct] = splitPunctuation(words[i])
+
+        // Custom board emoji
+        if (leadPunct === ":" && trailPunct === ":"
+            && hasEmoji(data.board || page.board, word)
+        ) {
+            html += renderEmoji(data.board || page.board, word)
+            continue
+        }
+
         if (leadPunct) {
             html += leadPunct
         }
@@ -402,7 +424,7 @@ function parseFragment(frag: string, data: PostData): string {
                 if (data.state.quote) {
                     break
                 }
-                m = word.match(/^#(flip|\d*d\d+|8ball|pyu|pcount|sw(?:\d+:)?\d+:\d+(?:[+-]\d+)?|autobahn)$/)
+                m = word.match(commandRegexp)
                 if (m) {
                     html += parseCommand(m[1], data)
                     matched = true
@@ -553,6 +575,16 @@ function parseCommand(bit: string, { commands, state }: PostData): string {
         return "#" + bit
     }
 
+    // Protect from index shifts on disabled command toggles. Commands
+    // disabled at the time of posting have no result.
+    const [name, type] = identifyCommand(bit)
+    if (!commandEnabled(name)) {
+        if (commands[state.iDice].type === type) {
+            state.iDice++
+        }
+        return "#" + bit
+    }
+
     // Protect from index shifts on boardConfig.pyu toggle
     if (!boardConfig.pyu) {
         switch (commands[state.iDice].type) {
@@ -574,6 +606,9 @@ function parseCommand(bit: string, { commands, state }: PostData): string {
             inner = escape(commands[state.iDice++].val.toString())
             break
         case "autobahn":
+            if (commands[state.iDice].type === commandType.autobahn) {
+                state.iDice++
+            }
             return `<strong class=\"dead\">#${bit}</strong>`
         case "pyu":
         case "pcount":
@@ -596,13 +631,36 @@ function parseCommand(bit: string, { commands, state }: PostData): string {
                 }
                 return formatSyncwatch(bit, commands[state.iDice++].val, state)
             }
+            if (bit.startsWith("poll")) {
+                const poll = commands[state.iDice].val as PollState
+                if (commands[state.iDice].type !== commandType.poll
+                    || !poll
+                    || poll.options.length !== poll.votes.length
+                ) {
+                    return "#" + bit
+                }
+                state.iDice++
+                return renderPoll(bit, poll)
+            }
 
             // Validate dice
             if (commands[state.iDice].type !== commandType.dice) {
                 return "#" + bit;
             }
+
+            // Extended notation rolls are delivered as objects
+            const val = commands[state.iDice].val
+            if (!Array.isArray(val)) {
+                const roll = val as DiceRoll
+                if (!roll || roll.expr !== bit) {
+                    return "#" + bit
+                }
+                state.iDice++
+                return renderDiceRoll(roll)
+            }
+
             const m = bit.match(/^(\d*)d(\d+)$/)
-            if (parseInt(m[1]) > 10 || parseInt(m[2]) > 10000) {
+            if (!m || parseInt(m[1]) > 10 || parseInt(m[2]) > 10000) {
                 return "#" + bit
             }
             const sides = parseInt(m[2])
@@ -621,7 +679,8 @@ function parseCommand(bit: string, { commands, state }: PostData): string {
                 inner += " = " + sum
             }
 
-            formatting = getRollFormatting(rolls.length, sides, sum)
+            formatting = getRollFormatting(
+                rolls.length, rolls.length * sides, sum)
     }
 
     // Protect from various index shift attacks due to dynamic typing
@@ -640,20 +699,135 @@ function parseCommand(bit: string, { commands, state }: PostData): string {
     return `${formatting}#${bit} (${inner})</strong>`
 }
 
-function getRollFormatting(numberOfDice: number, facesPerDie: number, sum: number): string {
-    const maxRoll = numberOfDice * facesPerDie
-    // no special formatting for small rolls
-    if (maxRoll < 10 || facesPerDie == 1) {
+// Returns the name used for toggling a matched hash command on boards and its
+// result type
+function identifyCommand(bit: string): [string, commandType] {
+    switch (bit) {
+        case "flip":
+            return ["flip", commandType.flip]
+        case "8ball":
+            return ["8ball", commandType.eightBall]
+        case "pyu":
+            return ["pyu", commandType.pyu]
+        case "pcount":
+            return ["pcount", commandType.pcount]
+        case "autobahn":
+            return ["autobahn", commandType.autobahn]
+    }
+    if (bit.startsWith("sw")) {
+        return ["sw", commandType.syncWatch]
+    }
+    if (bit.startsWith("poll")) {
+        return ["poll", commandType.poll]
+    }
+    return ["dice", commandType.dice]
+}
+
+// Returns, if the named hash command is not disabled on the current board
+function commandEnabled(name: string): boolean {
+    const disabled = boardConfig.disabledCommands
+    return !disabled || !disabled.includes(name)
+}
+
+// Render an extended notation dice roll with every individual die
+function renderDiceRoll({ expr, total, terms }: DiceRoll): string {
+    let min = 0,
+        max = 0,
+        inner = ""
+    terms.forEach((t, i) => {
+        if (t.negative) {
+            if (i) {
+                inner += " "
+            }
+            inner += "- "
+        } else if (i) {
+            inner += " + "
+        }
+
+        const [tMin, tMax] = diceTermBounds(t)
+        min += tMin
+        max += tMax
+
+        if (!isDiceTerm(t)) {
+            inner += t.const || 0
+            return
+        }
+
+        const dice = t.dice || []
+        const group = dice.length > 1 && terms.length > 1
+        if (group) {
+            inner += "("
+        }
+        dice.forEach((d, j) => {
+            if (j) {
+                inner += " + "
+            }
+            if (d.dropped) {
+                inner += "<s>"
+            }
+            if (t.fudge) {
+                inner += d.val > 0 ? "+" : (d.val < 0 ? "-" : "0")
+            } else {
+                inner += d.val
+            }
+            if (d.exploded) {
+                inner += "!"
+            }
+            if (d.dropped) {
+                inner += "</s>"
+            }
+        })
+        if (group) {
+            inner += ")"
+        }
+    })
+    inner += " = " + total
+
+    return `${getRollFormatting(min, max, total)}#${escape(expr)} (${inner})`
+        + "</strong>"
+}
+
+// Returns, if the term consists of dice and not a constant modifier
+function isDiceTerm(t: DiceTerm): boolean {
+    return !!(t.sides || t.fudge || t.count)
+}
+
+// Minimum and maximum possible value of a dice term without explosions
+function diceTermBounds(t: DiceTerm): [number, number] {
+    let min = 0,
+        max = 0
+    if (!isDiceTerm(t)) {
+        min = max = t.const || 0
+    } else {
+        const kept = (t.dice || [])
+            .filter(d => !d.dropped && !d.exploded)
+            .length
+        if (t.fudge) {
+            min = -kept
+            max = kept
+        } else if (t.sides) {
+            min = kept
+            max = kept * t.sides
+        }
+    }
+    return t.negative ? [-max, -min] : [min, max]
+}
+
+// Returns the opening tag of a roll with special formatting for the minimum
+// and maximum possible results, lewd numbers and repeating digits
+function getRollFormatting(min: number, max: number, sum: number): string {
+    // no special formatting for small or constant rolls
+    if (max < 10 || min == max) {
         return "<strong>"
     }
 
-    if (maxRoll == sum) {
+    if (max == sum) {
         return "<strong class=\"super_roll\">";
-    } else if (sum == numberOfDice) {
+    } else if (sum == min) {
         return "<strong class=\"kuso_roll\">";
     } else if (sum == 69 || sum == 6969) {
         return "<strong class=\"lewd_roll\">";
-    } else if (checkEm(sum)) {
+    } else if (sum > 0 && checkEm(sum)) {
         if (sum < 100) {
             return "<strong class=\"dubs_roll\">";
         } else if (sum < 1000) {
@@ -684,6 +858,35 @@ function checkEm(num: number): boolean {
     }
 }
 
+// Render a poll with its vote tallies. Options of open polls can be clicked to
+// vote.
+function renderPoll(
+    bit: string,
+    { expires, options, votes }: PollState,
+): string {
+    const closed = Date.now() / 1000 >= expires
+    let inner = "",
+        total = 0
+    options.forEach((o, i) => {
+        if (i) {
+            inner += ", "
+        }
+        inner += `<span class="poll-option" data-option="${i}">`
+            + `${escape(o)}: ${votes[i]}</span>`
+        total += votes[i]
+    })
+    inner += `; ${total} votes`
+    if (closed) {
+        inner += ", closed"
+    }
+
+    const attrs = {
+        class: closed ? "poll closed" : "poll",
+        "data-expires": expires.toString(),
+    }
+    return `<strong ${makeAttrs(attrs)}>#${bit} (${inner})</strong>`
+}
+
 // Format a synchronized time counter
 function formatSyncwatch(bit: string, val: number[], state: TextState): string {
     state.haveSyncwatch = true
@@ -1,13 +1,14 @@
 import { Post } from './model'
 import {
-    makeFrag, importTemplate, getID, escape, firstChild, pad, on
+    makeFrag, importTemplate, getID, escape, firstChild, pad, on, makeEl,
+    makeAttrs,
 } from '../util'
 import { parseBody, renderPostLink } from './render'
 import ImageHandler from "./images"
 import { ViewAttrs } from "../base"
 import { findSyncwatches } from "./syncwatch"
 import lang from "../lang"
-import { page, mine, posts } from "../state"
+import { page, mine, posts, boardConfig } from "../state"
 import options from "../options"
 import countries from "./countries"
 import {relativeTimeAbbreviated, secondsToTime} from "../util/time"
@@ -53,9 +54,12 @@ export default class PostView extends ImageHandler {
             this.el.append(importTemplate("article"))
             this.render()
             this.autoExpandImage()
-        } else if (this.model.moderation) {
-            // Localize moderation log
-            this.renderModerationLog();
+        } else {
+            if (this.model.moderation) {
+                // Localize moderation log
+                this.renderModerationLog();
+            }
+            this.renderReactions()
         }
         this.#claudeResponse = null
     }
@@ -79,6 +83,43 @@ export default class PostView extends ImageHandler {
         if (this.model.image) {
             this.renderImage(false)
         }
+        if (this.model.attachments) {
+            this.renderAttachments()
+        }
+        this.renderReactions()
+    }
+
+    // Render reaction counts and buttons for reacting with the board's
+    // reaction set. Unused reactions are only shown on hover.
+    public renderReactions() {
+        let el = firstChild(this.el, ch =>
+            ch.classList.contains("post-reactions"))
+        const set = boardConfig && boardConfig.reactions
+        if (!set || !set.length) {
+            if (el) {
+                el.remove()
+            }
+            return
+        }
+        if (!el) {
+            el = makeEl(`<div class="post-reactions spaced"></div>`) as HTMLElement
+            const prev = firstChild(this.el, ch =>
+                ch.classList.contains("post-attachments"))
+                || this.el.querySelector(".post-container")
+            prev.after(el)
+        }
+
+        const counts = this.model.reactions || {}
+        let html = ""
+        for (const r of set) {
+            const n = counts[r] || 0
+            const attrs = {
+                class: n ? "reaction" : "reaction empty",
+                "data-reaction": escape(r),
+            }
+            html += `<a ${makeAttrs(attrs)}>${escape(r)}${n ? " " + n : ""}</a>`
+        }
+        el.innerHTML = html
     }
 
     // Get the current Element for text to be written to
@@ -27,9 +27,15 @@ export interface BoardConfigs {
 	forcedAnon: boolean
 	rbText: boolean
 	pyu: boolean
+	serveRenditions: boolean
+	maxAttachments: number
+	bumpLimit: number
+	imageLimit: number
 	title: string
 	notice: string
 	rules: string
+	disabledCommands: string[]
+	reactions: string[]
 	[index: string]: any
 }
 
@@ -0,0 +1,35 @@
+// Use only ES5
+(function() {
+    // Create an entry for the appeals table from server sent data
+    function processEvent(sseData) {
+        var n = sseData.Post
+        var tbl = document.querySelector("tbody");
+        var row = document.createElement("tr");
+        row.innerHTML =
+            '<td>' + sseData.ID + '</td>' +
+            '<td>' +
+            '<a class="post-link" data-id="' + n + '" href="/all/' + n + '#p' + n + '">>>' + n + '</a>' +
+            '<a class="hash-link" href="/all/' + n + '#p' + n + '"> #</a>' +
+            '</td>' +
+            '<td></td>' +
+            '<td>pending</td>' +
+            '<td>recently!</td>' +
+            '<td></td>';
+        // Appeals are written by banned users. Never insert them as HTML.
+        row.children[2].textContent = sseData.Body;
+        tbl.insertBefore(row, tbl.firstChild.nextSibling);
+    }
+
+	function loadScript(path) {
+		var head = document.getElementsByTagName('head')[0];
+		var script = document.createElement('script');
+		script.type = 'text/javascript';
+		script.src = '/assets/' + path + '.js';
+		head.appendChild(script);
+		return script;
+	}
+
+	loadScript("js/static/main").onload = function () {
+		window.sse(processEvent)
+	};
+})();
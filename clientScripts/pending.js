@@ -0,0 +1,20 @@
+// Use only ES5
+(function() {
+    // Display the new review status of the post
+    function processEvent() {
+        location.reload();
+    }
+
+	function loadScript(path) {
+		var head = document.getElementsByTagName('head')[0];
+		var script = document.createElement('script');
+		script.type = 'text/javascript';
+		script.src = '/assets/' + path + '.js';
+		head.appendChild(script);
+		return script;
+	}
+
+	loadScript("js/static/main").onload = function () {
+		window.sse(processEvent)
+	};
+})();
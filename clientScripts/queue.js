@@ -0,0 +1,56 @@
+// Use only ES5
+(function() {
+    // Create an entry for the review queue table from server sent data
+    function processEvent(sseData) {
+        var id = sseData.ID;
+        var n = sseData.OP;
+        var tbl = document.querySelector("tbody");
+        var row = document.createElement("tr");
+        var thread = "new thread";
+        if (n) {
+            thread =
+                '<a class="post-link" data-id="' + n + '" href="/all/' + n + '#p' + n + '">>>' + n + '</a>' +
+                '<a class="hash-link" href="/all/' + n + '#p' + n + '"> #</a>';
+        }
+        var image = "";
+        if (sseData.Thumb) {
+            image =
+                '<a href="' + sseData.Source + '" target="_blank">' +
+                '<img src="' + sseData.Thumb + '" class="catalog">' +
+                '</a>';
+        }
+        row.innerHTML =
+            '<td>' + id + '</td>' +
+            '<td>' + thread + '</td>' +
+            '<td></td>' +
+            '<td></td>' +
+            '<td>' + image + '</td>' +
+            '<td>recently!</td>' +
+            '<td>pending</td>' +
+            '<td>' +
+            '<form method="post" action="/api/queue/' + id + '">' +
+            '<input type="text" name="reason" placeholder="Reason" maxlength="100">' +
+            '<br>' +
+            '<button name="action" value="approve">Approve</button>' +
+            '<button name="action" value="reject">Reject</button>' +
+            '</form>' +
+            '</td>';
+        // Held posts are written by new posters. Never insert them as HTML.
+        row.children[2].textContent = sseData.Subject;
+        row.children[3].textContent = sseData.Body;
+        tbl.insertBefore(row, tbl.firstChild.nextSibling);
+    }
+
+	function loadScript(path) {
+		var head = document.getElementsByTagName('head')[0];
+		var script = document.createElement('script');
+		script.type = 'text/javascript';
+		script.src = '/assets/' + path + '.js';
+		head.appendChild(script);
+		return script;
+	}
+
+	loadScript("js/static/main").onload = function () {
+		window.sse(processEvent)
+	};
+})();
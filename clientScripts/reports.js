@@ -11,8 +11,12 @@
             '<a class="post-link" data-id="' + n + '" href="/all/' + n + '#p' + n + '">>>' + n + '</a>' +
             '<a class="hash-link" href="/all/' + n + '#p' + n + '"> #</a>' +
             '</td>' + 
+            '<td>1</td>' +
             '<td>' + sseData.Reason + '</td>' +
-            '<td>recently!</td>';
+            '<td>recently!</td>' +
+            '<td>open</td>' +
+            '<td></td>' +
+            '<td></td>';
         tbl.insertBefore(row, tbl.firstChild.nextSibling);
     }
 
@@ -2,8 +2,12 @@ package common
 
 import (
 	"bytes"
+	"crypto/rand"
 	"encoding/json"
 	"fmt"
+	"io"
+	"math/big"
+	"regexp"
 	"strconv"
 )
 
@@ -36,6 +40,9 @@ const (
 
 	// Claude
 	Claude
+
+	// Poll is the in-post poll command type
+	Poll
 )
 
 type ClaudeStatus uint8
@@ -126,6 +133,7 @@ func jsonEscape(str string) []byte {
 // SyncWatch: [5]uint64
 // Pyu: uint64
 // Pcount: uint64
+// Poll: *PollState
 type Command struct {
 	Type      CommandType
 	Flip      bool
@@ -133,6 +141,11 @@ type Command struct {
 	SyncWatch [5]uint64
 	Eightball string
 	Dice      []uint16
+
+	// Extended notation dice roll. Nil for plain NdM rolls.
+	Roll *DiceRoll
+
+	Poll *PollState
 }
 
 type PostCommand struct {
@@ -143,48 +156,16 @@ type PostCommand struct {
 
 // MarshalJSON implements json.Marshaler
 func (c Command) MarshalJSON() ([]byte, error) {
-	b := make([]byte, 0, 128)
-	appendStr := func(s string) {
-		b = append(b, s...)
-	}
-	appendByte := func(c byte) {
-		b = append(b, c)
-	}
-	appendUint := func(i uint64) {
-		b = strconv.AppendUint(b, i, 10)
-	}
-
-	appendStr(`{"type":`)
-	appendUint(uint64(c.Type))
-	appendStr(`,"val":`)
-
-	switch c.Type {
-	case Flip:
-		b = strconv.AppendBool(b, c.Flip)
-	case Pyu, Pcount, Autobahn:
-		appendUint(c.Pyu)
-	case SyncWatch:
-		appendByte('[')
-		for i, v := range c.SyncWatch {
-			if i != 0 {
-				appendByte(',')
-			}
-			appendUint(v)
-		}
-		appendByte(']')
-	case EightBall:
-		b = strconv.AppendQuote(b, c.Eightball)
-	case Dice:
-		appendByte('[')
-		for i, v := range c.Dice {
-			if i != 0 {
-				appendByte(',')
-			}
-			appendUint(uint64(v))
-		}
-		appendByte(']')
+	h := GetCommandHandler(c.Type)
+	if h == nil {
+		return nil, fmt.Errorf("unknown command type: %d", c.Type)
 	}
 
+	b := make([]byte, 0, 128)
+	b = append(b, `{"type":`...)
+	b = strconv.AppendUint(b, uint64(c.Type), 10)
+	b = append(b, `,"val":`...)
+	b = h.AppendJSON(b, c)
 	b = append(b, '}')
 
 	return b, nil
@@ -193,41 +174,161 @@ func (c Command) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON decodes a dynamically-typed JSON-encoded command into the
 // statically-typed Command struct
 func (c *Command) UnmarshalJSON(data []byte) error {
-	if len(data) < 18 {
-		return fmt.Errorf("data too short: %s", string(data))
+	var tmp struct {
+		Type CommandType     `json:"type"`
+		Val  json.RawMessage `json:"val"`
 	}
-
-	typ, err := strconv.ParseUint(string(data[8]), 10, 8)
+	err := json.Unmarshal(data, &tmp)
 	if err != nil {
 		return err
 	}
 
-	data = data[16 : len(data)-1]
-	switch CommandType(typ) {
-	case Flip:
-		c.Type = Flip
-		err = json.Unmarshal(data, &c.Flip)
-	case Pyu:
-		c.Type = Pyu
-		err = json.Unmarshal(data, &c.Pyu)
-	case Pcount:
-		c.Type = Pcount
-		err = json.Unmarshal(data, &c.Pyu)
-	case SyncWatch:
-		c.Type = SyncWatch
-		err = json.Unmarshal(data, &c.SyncWatch)
-	case EightBall:
-		c.Type = EightBall
-		err = json.Unmarshal(data, &c.Eightball)
-	case Dice:
-		c.Type = Dice
-		err = json.Unmarshal(data, &c.Dice)
-	case Autobahn:
-		c.Type = Autobahn
-	default:
-		return fmt.Errorf("unknown command type: %d", typ)
+	h := GetCommandHandler(tmp.Type)
+	if h == nil {
+		return fmt.Errorf("unknown command type: %d", tmp.Type)
+	}
+	c.Type = tmp.Type
+	if len(tmp.Val) == 0 {
+		return nil
+	}
+	return h.DecodeJSON(tmp.Val, c)
+}
+
+// CommandHandler implements a single hash command type. Commands are stored in
+// the database in the same JSON encoding they are sent to clients in, so
+// AppendJSON and DecodeJSON define both the persistence and wire formats.
+type CommandHandler interface {
+	// Type of Command produced by the handler
+	Type() CommandType
+
+	// Name of the command used to enable and disable it per board
+	Name() string
+
+	// Pattern is a regular expression matching the command text following
+	// the '#'
+	Pattern() string
+
+	// Parse a matched command and apply any side effects it might have.
+	// Errors wrapped with InvalidCommand cause the command to be ignored.
+	Parse(ctx *CommandContext, match string) (Command, error)
+
+	// AppendJSON appends the JSON-encoded value of c to b
+	AppendJSON(b []byte, c Command) []byte
+
+	// DecodeJSON decodes the JSON-encoded value of a command into c
+	DecodeJSON(data []byte, c *Command) error
+
+	// RenderHTML writes the HTML representation of c to w. Returns false
+	// without writing anything, if match can not be rendered as a command.
+	RenderHTML(w io.Writer, match string, c Command) bool
+}
+
+// CommandContext contains the post a hash command is being parsed for
+type CommandContext struct {
+	Board      string
+	Thread, ID uint64
+	IP         string
+
+	// Entire post body and the position right after the matched command in
+	// it. Used by commands, that consume text following them.
+	Body []byte
+	End  int
+
+	// Command types, that have already applied a once-per-post side effect,
+	// such as banning the poster
+	Applied map[CommandType]bool
+}
+
+type registeredCommand struct {
+	CommandHandler
+	pattern *regexp.Regexp
+}
+
+var (
+	commandHandlers = make(map[CommandType]registeredCommand)
+
+	// Handlers in registration order. Earlier handlers take precedence, when
+	// matching commands.
+	commandOrder []registeredCommand
+)
+
+// RegisterCommand adds a hash command handler to the registry. Must only be
+// called from init functions.
+func RegisterCommand(h CommandHandler) {
+	t := h.Type()
+	if _, ok := commandHandlers[t]; ok {
+		panic(fmt.Sprintf("hash command type %d registered twice", t))
+	}
+	r := registeredCommand{
+		CommandHandler: h,
+		pattern:        regexp.MustCompile(`^#(` + h.Pattern() + `)$`),
+	}
+	commandHandlers[t] = r
+	commandOrder = append(commandOrder, r)
+}
+
+// GetCommandHandler returns the handler of a command type or nil, if none
+// registered
+func GetCommandHandler(t CommandType) CommandHandler {
+	r, ok := commandHandlers[t]
+	if !ok {
+		return nil
+	}
+	return r.CommandHandler
+}
+
+// MatchCommand finds the handler of a hash command word, including the
+// leading '#'. Returns the command text without the '#' or a nil handler, if
+// the word is not a command.
+func MatchCommand(word string) (h CommandHandler, match string) {
+	for _, r := range commandOrder {
+		if m := r.pattern.FindStringSubmatch(word); m != nil {
+			return r.CommandHandler, m[1]
+		}
+	}
+	return
+}
+
+// CommandHandlerByName returns the handler of a command by its name or nil,
+// if none registered
+func CommandHandlerByName(name string) CommandHandler {
+	for _, r := range commandOrder {
+		if r.Name() == name {
+			return r.CommandHandler
+		}
+	}
+	return nil
+}
+
+// InvalidCommand marks a hash command as invalid. Invalid commands are left as
+// plain text instead of failing post parsing.
+type InvalidCommand struct {
+	Err error
+}
+
+func (e InvalidCommand) Error() string {
+	return e.Err.Error()
+}
+
+// WriteCommand writes a hash command in the standard "#command (result)"
+// format. open is the opening tag of the element.
+func WriteCommand(w io.Writer, open, match string, inner []byte) {
+	io.WriteString(w, open)
+	io.WriteString(w, "#")
+	io.WriteString(w, match)
+	io.WriteString(w, " (")
+	w.Write(inner)
+	io.WriteString(w, ")</strong>")
+}
+
+// RandInt returns a cryptographically secure pseudorandom int in the interval
+// [0;max)
+func RandInt(max int) int {
+	i, _ := rand.Int(rand.Reader, big.NewInt(int64(max)))
+	if i == nil { // Fuck error reporting here
+		return 0
 	}
-	return err
+	return int(i.Int64())
 }
 
 type MediaCommandType int
@@ -1,9 +1,11 @@
-package common
+package common_test
 
 import (
 	"encoding/json"
 	"testing"
 
+	. "github.com/bakape/meguca/common"
+	_ "github.com/bakape/meguca/parser" // Registers hash command handlers
 	. "github.com/bakape/meguca/test"
 )
 
@@ -20,6 +22,32 @@ func TestCommandsMarshaling(t *testing.T) {
 			Type: Pcount,
 			Pyu:  1,
 		}},
+		{"dice", Command{
+			Type: Dice,
+			Dice: []uint16{1, 6},
+		}},
+		{"dice notation", Command{
+			Type: Dice,
+			Roll: &DiceRoll{
+				Expr:  "4d6kh3+2",
+				Total: 13,
+				Terms: []DiceTerm{
+					{
+						Count: 4,
+						Sides: 6,
+						Keep:  "kh",
+						KeepN: 3,
+						Dice: []Die{
+							{Val: 1, Dropped: true},
+							{Val: 3},
+							{Val: 4},
+							{Val: 4},
+						},
+					},
+					{Const: 2},
+				},
+			},
+		}},
 	}
 
 	for i := range cases {
@@ -0,0 +1,518 @@
+package common
+
+import (
+	"encoding/json"
+	"html"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+const (
+	// Maximum number of dice in a single dice term
+	maxDiceRolls = 10
+
+	// Maximum number of terms in a dice expression
+	maxDiceTerms = 10
+
+	// Regex fragment matching a single dice term, such as 4d6kh3, 3d6e or 4dF
+	diceTermPattern = `\d*d(?:\d+|F)e?(?:[kd][hl]?\d+)?`
+)
+
+var (
+	ErrTooManyRolls = InvalidCommand{ErrInvalidInput("too many rolls")}
+	ErrDieTooBig    = InvalidCommand{ErrInvalidInput("die too big")}
+	ErrInvalidDice  = InvalidCommand{ErrInvalidInput("invalid dice expression")}
+
+	diceTermRegexp = regexp.MustCompile(
+		`([+-]?)(?:(\d*)d(\d+|F)(e?)(?:([kd])([hl]?)(\d+))?|(\d+))`,
+	)
+)
+
+// DiceRoll is the result of a dice expression using extended notation, such as
+// modifiers, keeping or dropping dice, exploding or fudge dice and multiple
+// terms
+type DiceRoll struct {
+	Expr  string     `json:"expr"`
+	Total int64      `json:"total"`
+	Terms []DiceTerm `json:"terms"`
+}
+
+// DiceTerm is a single term of a dice expression. Either a set of dice or a
+// constant modifier.
+type DiceTerm struct {
+	Negative bool   `json:"negative,omitempty"`
+	Fudge    bool   `json:"fudge,omitempty"`
+	Explode  bool   `json:"explode,omitempty"`
+	Const    uint16 `json:"const,omitempty"`
+	Count    uint16 `json:"count,omitempty"`
+	Sides    uint16 `json:"sides,omitempty"`
+
+	// Keep or drop mode, one of "kh", "kl", "dh" and "dl", and number of
+	// dice to keep or drop
+	Keep  string `json:"keep,omitempty"`
+	KeepN uint16 `json:"keepN,omitempty"`
+
+	// Every die rolled, including dropped and exploded ones
+	Dice []Die `json:"dice,omitempty"`
+}
+
+// Die is a single rolled die of a dice term
+type Die struct {
+	Val      int16 `json:"val"`
+	Dropped  bool  `json:"dropped,omitempty"`
+	Exploded bool  `json:"exploded,omitempty"`
+}
+
+// Returns, if the term consists of dice and not a constant modifier
+func (t DiceTerm) isDice() bool {
+	return t.Sides != 0 || t.Fudge || t.Count != 0
+}
+
+// Sum of all kept dice or the constant modifier of the term, with the term
+// sign applied
+func (t DiceTerm) sum() (sum int64) {
+	if t.isDice() {
+		for _, d := range t.Dice {
+			if !d.Dropped {
+				sum += int64(d.Val)
+			}
+		}
+	} else {
+		sum = int64(t.Const)
+	}
+	if t.Negative {
+		sum = -sum
+	}
+	return
+}
+
+// Minimum and maximum possible value of the term without explosions
+func (t DiceTerm) bounds() (min, max int64) {
+	if !t.isDice() {
+		min = int64(t.Const)
+		max = min
+	} else {
+		kept := int64(0)
+		for _, d := range t.Dice {
+			if !d.Dropped && !d.Exploded {
+				kept++
+			}
+		}
+		switch {
+		case t.Fudge:
+			min, max = -kept, kept
+		case t.Sides != 0:
+			min, max = kept, kept*int64(t.Sides)
+		}
+	}
+	if t.Negative {
+		min, max = -max, -min
+	}
+	return
+}
+
+func init() {
+	RegisterCommand(diceCommand{})
+}
+
+// Dice throw command
+type diceCommand struct{}
+
+func (diceCommand) Type() CommandType {
+	return Dice
+}
+
+func (diceCommand) Name() string {
+	return "dice"
+}
+
+func (diceCommand) Pattern() string {
+	return diceTermPattern + `(?:[+-](?:` + diceTermPattern + `|\d+))*`
+}
+
+func (diceCommand) Parse(_ *CommandContext, match string) (
+	com Command, err error,
+) {
+	com.Type = Dice
+
+	// Plain NdM rolls keep their legacy encoding
+	if !DiceRegexp.MatchString(match) {
+		com.Roll, err = parseDiceRoll(match)
+		return
+	}
+
+	rolls, sides, err := parseDice(match)
+	if err != nil {
+		return
+	}
+
+	com.Dice = make([]uint16, rolls)
+	for i := 0; i < rolls; i++ {
+		if sides != 0 {
+			com.Dice[i] = uint16(RandInt(sides)) + 1
+		}
+	}
+	return
+}
+
+// Parse and validate the number of rolls and die sides of a plain dice command
+func parseDice(match string) (rolls, sides int, err error) {
+	dice := DiceRegexp.FindStringSubmatch(match)
+	if dice == nil {
+		err = ErrInvalidDice
+		return
+	}
+
+	rolls = 1
+	if len(dice[1]) != 0 {
+		rolls, err = strconv.Atoi(dice[1])
+		switch {
+		case err != nil:
+			err = StatusError{err, 400}
+			return
+		case rolls > maxDiceRolls:
+			err = ErrTooManyRolls
+			return
+		}
+	}
+
+	sides, err = strconv.Atoi(dice[2])
+	switch {
+	case err != nil:
+		err = StatusError{err, 400}
+	case sides > MaxDiceSides:
+		err = ErrDieTooBig
+	}
+	return
+}
+
+// Parse an extended notation dice expression and roll all its dice
+func parseDiceRoll(match string) (roll *DiceRoll, err error) {
+	terms := diceTermRegexp.FindAllStringSubmatch(match, -1)
+	if len(terms) > maxDiceTerms {
+		return nil, ErrTooManyRolls
+	}
+
+	roll = &DiceRoll{
+		Expr:  match,
+		Terms: make([]DiceTerm, 0, len(terms)),
+	}
+	for _, m := range terms {
+		var t DiceTerm
+		t, err = parseDiceTerm(m)
+		if err != nil {
+			return nil, err
+		}
+		t.roll()
+		roll.Total += t.sum()
+		roll.Terms = append(roll.Terms, t)
+	}
+	return
+}
+
+// Parse and validate a single matched term of a dice expression
+func parseDiceTerm(m []string) (t DiceTerm, err error) {
+	t.Negative = m[1] == "-"
+
+	parseUint16 := func(s string, max int) (uint16, error) {
+		i, err := strconv.Atoi(s)
+		switch {
+		case err != nil:
+			return 0, StatusError{err, 400}
+		case i > max:
+			return 0, ErrDieTooBig
+		}
+		return uint16(i), nil
+	}
+
+	if m[8] != "" {
+		t.Const, err = parseUint16(m[8], MaxDiceSides)
+		return
+	}
+
+	t.Count = 1
+	if m[2] != "" {
+		t.Count, err = parseUint16(m[2], maxDiceRolls)
+		if err == ErrDieTooBig {
+			err = ErrTooManyRolls
+		}
+		if err != nil {
+			return
+		}
+	}
+	if m[3] == "F" {
+		t.Fudge = true
+	} else {
+		t.Sides, err = parseUint16(m[3], MaxDiceSides)
+		if err != nil {
+			return
+		}
+	}
+
+	t.Explode = m[4] != ""
+	if t.Explode && (t.Fudge || t.Sides < 2) {
+		err = ErrInvalidDice
+		return
+	}
+
+	if m[5] != "" {
+		t.Keep = m[5] + m[6]
+		if m[6] == "" {
+			// Keeping defaults to the highest and dropping to the lowest dice
+			if m[5] == "k" {
+				t.Keep += "h"
+			} else {
+				t.Keep += "l"
+			}
+		}
+		t.KeepN, err = parseUint16(m[7], maxDiceRolls)
+		if err != nil {
+			return
+		}
+		if t.KeepN > t.Count {
+			err = ErrInvalidDice
+		}
+	}
+	return
+}
+
+// Roll all dice of the term and apply explosions and keep/drop rules
+func (t *DiceTerm) roll() {
+	if !t.isDice() {
+		return
+	}
+
+	rollDie := func() int16 {
+		switch {
+		case t.Fudge:
+			return int16(RandInt(3) - 1)
+		case t.Sides == 0:
+			return 0
+		default:
+			return int16(RandInt(int(t.Sides)) + 1)
+		}
+	}
+
+	t.Dice = make([]Die, 0, t.Count)
+	for i := uint16(0); i < t.Count; i++ {
+		t.Dice = append(t.Dice, Die{Val: rollDie()})
+	}
+
+	// Each die rolling the maximum value adds another die. Explosions are
+	// capped to prevent infinite rolls.
+	if t.Explode {
+		extra := 0
+		for i := 0; i < len(t.Dice) && extra < maxDiceRolls; i++ {
+			if t.Dice[i].Val == int16(t.Sides) {
+				t.Dice[i].Exploded = true
+				t.Dice = append(t.Dice, Die{Val: rollDie()})
+				extra++
+			}
+		}
+	}
+
+	if t.Keep == "" {
+		return
+	}
+
+	// Sort indices of dice by value and mark dropped dice
+	order := make([]int, len(t.Dice))
+	for i := range order {
+		order[i] = i
+	}
+	for i := 1; i < len(order); i++ {
+		for j := i; j > 0 && t.Dice[order[j]].Val < t.Dice[order[j-1]].Val; j-- {
+			order[j], order[j-1] = order[j-1], order[j]
+		}
+	}
+	n := int(t.KeepN)
+	var drop []int
+	switch t.Keep {
+	case "kh":
+		drop = order[:len(order)-n]
+	case "kl":
+		drop = order[n:]
+	case "dh":
+		drop = order[len(order)-n:]
+	case "dl":
+		drop = order[:n]
+	}
+	for _, i := range drop {
+		t.Dice[i].Dropped = true
+	}
+}
+
+func (diceCommand) AppendJSON(b []byte, c Command) []byte {
+	if c.Roll != nil {
+		buf, err := json.Marshal(c.Roll)
+		if err != nil {
+			return append(b, "null"...)
+		}
+		return append(b, buf...)
+	}
+
+	b = append(b, '[')
+	for i, v := range c.Dice {
+		if i != 0 {
+			b = append(b, ',')
+		}
+		b = strconv.AppendUint(b, uint64(v), 10)
+	}
+	return append(b, ']')
+}
+
+func (diceCommand) DecodeJSON(data []byte, c *Command) error {
+	if len(data) != 0 && data[0] == '{' {
+		c.Roll = new(DiceRoll)
+		return json.Unmarshal(data, c.Roll)
+	}
+	return json.Unmarshal(data, &c.Dice)
+}
+
+func (diceCommand) RenderHTML(w io.Writer, match string, c Command) bool {
+	if c.Roll != nil {
+		if c.Roll.Expr != match {
+			return false
+		}
+		renderDiceRoll(w, *c.Roll)
+		return true
+	}
+
+	rolls, sides, err := parseDice(match)
+	if err != nil {
+		return false
+	}
+
+	var sum uint64
+	inner := make([]byte, 0, 32)
+	for i, roll := range c.Dice {
+		if i != 0 {
+			inner = append(inner, " + "...)
+		}
+		sum += uint64(roll)
+		inner = strconv.AppendUint(inner, uint64(roll), 10)
+	}
+	if len(c.Dice) > 1 {
+		inner = append(inner, " = "...)
+		inner = strconv.AppendUint(inner, sum, 10)
+	}
+
+	WriteCommand(
+		w,
+		getRollFormatting(int64(rolls), int64(rolls*sides), int64(sum)),
+		match,
+		inner,
+	)
+	return true
+}
+
+// Render an extended notation dice roll with every individual die
+func renderDiceRoll(w io.Writer, r DiceRoll) {
+	var min, max int64
+	inner := make([]byte, 0, 64)
+	for i, t := range r.Terms {
+		switch {
+		case t.Negative:
+			if i != 0 {
+				inner = append(inner, ' ')
+			}
+			inner = append(inner, "- "...)
+		case i != 0:
+			inner = append(inner, " + "...)
+		}
+
+		tMin, tMax := t.bounds()
+		min += tMin
+		max += tMax
+
+		if !t.isDice() {
+			inner = strconv.AppendUint(inner, uint64(t.Const), 10)
+			continue
+		}
+
+		group := len(t.Dice) > 1 && len(r.Terms) > 1
+		if group {
+			inner = append(inner, '(')
+		}
+		for j, d := range t.Dice {
+			if j != 0 {
+				inner = append(inner, " + "...)
+			}
+			if d.Dropped {
+				inner = append(inner, "<s>"...)
+			}
+			if t.Fudge {
+				switch {
+				case d.Val > 0:
+					inner = append(inner, '+')
+				case d.Val < 0:
+					inner = append(inner, '-')
+				default:
+					inner = append(inner, '0')
+				}
+			} else {
+				inner = strconv.AppendInt(inner, int64(d.Val), 10)
+			}
+			if d.Exploded {
+				inner = append(inner, '!')
+			}
+			if d.Dropped {
+				inner = append(inner, "</s>"...)
+			}
+		}
+		if group {
+			inner = append(inner, ')')
+		}
+	}
+	inner = append(inner, " = "...)
+	inner = strconv.AppendInt(inner, r.Total, 10)
+
+	WriteCommand(w, getRollFormatting(min, max, r.Total),
+		html.EscapeString(r.Expr), inner)
+}
+
+// Returns the opening tag of a roll with special formatting for the minimum
+// and maximum possible results, lewd numbers and repeating digits
+func getRollFormatting(min, max, sum int64) string {
+	// no special formatting for small or constant rolls
+	if max < 10 || min == max {
+		return "<strong>"
+	}
+
+	if max == sum {
+		return "<strong class=\"super_roll\">"
+	} else if sum == min {
+		return "<strong class=\"kuso_roll\">"
+	} else if sum == 69 || sum == 6969 {
+		return "<strong class=\"lewd_roll\">"
+	} else if sum > 0 && checkEm(uint64(sum)) {
+		if sum < 100 {
+			return "<strong class=\"dubs_roll\">"
+		} else if sum < 1000 {
+			return "<strong class=\"trips_roll\">"
+		} else if sum < 10000 {
+			return "<strong class=\"quads_roll\">"
+		} else { // QUINTS!!!
+			return "<strong class=\"rainbow_roll\">"
+		}
+	}
+	return "<strong>"
+}
+
+// If num is made of the same digit repeating
+func checkEm(num uint64) bool {
+	if num < 10 {
+		return false
+	}
+	digit := num % 10
+	for {
+		num /= 10
+		if num == 0 {
+			return true
+		}
+		if num%10 != digit {
+			return false
+		}
+	}
+}
@@ -22,6 +22,7 @@ var (
 	ErrBodyTooLong         = ErrTooLong("post body")
 	ErrContainsNull        = ErrInvalidInput("null byte in message")
 	ErrInvalidCaptcha      = ErrInvalidInput("captcha")
+	ErrInvalidBanTarget    = ErrInvalidInput("invalid ban target")
 	ErrInvalidCreds        = ErrAccessDenied("login credentials")
 	ErrBanned              = ErrAccessDenied("you are banned from this board")
 	ErrTooManyConnections  = ErrAccessDenied("too many connections")
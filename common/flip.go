@@ -0,0 +1,50 @@
+package common
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+func init() {
+	RegisterCommand(flipCommand{})
+}
+
+// Coin flip command
+type flipCommand struct{}
+
+func (flipCommand) Type() CommandType {
+	return Flip
+}
+
+func (flipCommand) Name() string {
+	return "flip"
+}
+
+func (flipCommand) Pattern() string {
+	return "flip"
+}
+
+func (flipCommand) Parse(_ *CommandContext, _ string) (Command, error) {
+	return Command{
+		Type: Flip,
+		Flip: RandInt(2) == 1,
+	}, nil
+}
+
+func (flipCommand) AppendJSON(b []byte, c Command) []byte {
+	return strconv.AppendBool(b, c.Flip)
+}
+
+func (flipCommand) DecodeJSON(data []byte, c *Command) error {
+	return json.Unmarshal(data, &c.Flip)
+}
+
+func (flipCommand) RenderHTML(w io.Writer, match string, c Command) bool {
+	s := "flop"
+	if c.Flip {
+		s = "flap"
+	}
+	WriteCommand(w, "<strong>", match, []byte(s))
+	return true
+}
@@ -1,5 +1,7 @@
 package common
 
+import "strconv"
+
 // Supported file formats
 const (
 	JPEG uint8 = iota
@@ -70,4 +72,45 @@ type ImageCommon struct {
 	MD5       string    `json:"md5"`
 	SHA1      string    `json:"sha1"`
 	Codec     string    `json:"codec"`
+	// File type of the transcoded browser-playable rendition. 0, if none.
+	Rendition uint8 `json:"rendition,omitempty"`
+	// SHA1 hash of the uploaded file before its metadata was stripped. Empty,
+	// if metadata was not stripped.
+	OriginalSHA1 string `json:"-"`
+	// Normalized waveform amplitudes of audio-only files
+	Peaks Peaks `json:"peaks,omitempty"`
+	// Dimensions of additional higher resolution thumbnails by the size of
+	// their bounding box
+	Thumbs map[uint16][2]uint16 `json:"thumbs,omitempty"`
+	// Thumbnails are also available in AVIF format
+	ThumbAVIF bool `json:"thumb_avif,omitempty"`
+}
+
+// Peaks contains audio amplitudes normalized to 0-255. Encoded as a JSON array
+// of numbers, instead of a base64 string.
+type Peaks []uint8
+
+// MarshalJSON implements json.Marshaler
+func (p Peaks) MarshalJSON() ([]byte, error) {
+	if p == nil {
+		return []byte("null"), nil
+	}
+	b := make([]byte, 1, 2+len(p)*4)
+	b[0] = '['
+	for i, v := range p {
+		if i != 0 {
+			b = append(b, ',')
+		}
+		b = strconv.AppendUint(b, uint64(v), 10)
+	}
+	return append(b, ']'), nil
+}
+
+// ArchiveEntry is a file contained in an uploaded archive. Entries are
+// addressed by their position in the archive's index.
+type ArchiveEntry struct {
+	// Entry is an image page of a comic book archive
+	Page bool   `json:"page"`
+	Size int64  `json:"size"`
+	Name string `json:"name"`
 }
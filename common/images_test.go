@@ -0,0 +1,40 @@
+package common_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestPeaksMarshaling(t *testing.T) {
+	img := ImageCommon{
+		Peaks: Peaks{0, 128, 255},
+	}
+	buf, err := json.Marshal(img.Peaks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, string(buf), "[0,128,255]")
+
+	var res ImageCommon
+	err = json.Unmarshal([]byte(`{"peaks":[0,128,255]}`), &res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, res.Peaks, img.Peaks)
+
+	// Omitted, if none
+	buf, err = json.Marshal(ImageCommon{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "" && json.Valid(buf) {
+		var m map[string]interface{}
+		json.Unmarshal(buf, &m)
+		if _, ok := m["peaks"]; ok {
+			t.Fatal("empty peaks not omitted")
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package common
 
+import "net"
+
 var (
 	modLevelStrings = [...]string{"", "janitors", "moderators", "owners",
 		"admin"}
@@ -29,6 +31,17 @@ const (
 	ConfigureServer
 	AdminNotification
 	PlaylistLock
+	AcceptBanAppeal
+	RejectBanAppeal
+	TriageReport
+	AddModNote
+	MoveThread
+	MergeThread
+	SplitThread
+	SetSlowMode
+	SetCyclic
+	ApprovePost
+	RejectPost
 )
 
 // Contains fields of a post moderation log entry
@@ -39,6 +52,53 @@ type ModerationEntry struct {
 	Data   string           `json:"data"`
 }
 
+// BanScope selects the range of IPs around the banned poster's IP a ban
+// applies to
+type BanScope uint8
+
+// All supported ban scopes
+const (
+	// Single IPv4 address or IPv6 /64 subnet. The latter is usually assigned
+	// to a single subscriber.
+	BanAddress BanScope = iota
+	// IPv4 /24 or IPv6 /48 subnet
+	BanSubnet
+	// IPv4 /16 or IPv6 /32 range
+	BanRange
+)
+
+// Prefix lengths of ban scopes for IPv4 and IPv6 addresses
+var banScopePrefixes = [...][2]int{
+	BanAddress: {32, 64},
+	BanSubnet:  {24, 48},
+	BanRange:   {16, 32},
+}
+
+// IsValid returns, if s is a supported ban scope
+func (s BanScope) IsValid() bool {
+	return int(s) < len(banScopePrefixes)
+}
+
+// Network returns the network in CIDR notation, that a ban of scope s on ip
+// applies to
+func (s BanScope) Network(ip string) (string, error) {
+	parsed := net.ParseIP(ip)
+	if parsed == nil || !s.IsValid() {
+		return "", ErrInvalidBanTarget
+	}
+	bits, prefix := 32, banScopePrefixes[s][0]
+	if v4 := parsed.To4(); v4 != nil {
+		parsed = v4
+	} else {
+		bits, prefix = 128, banScopePrefixes[s][1]
+	}
+	n := net.IPNet{
+		IP:   parsed.Mask(net.CIDRMask(prefix, bits)),
+		Mask: net.CIDRMask(prefix, bits),
+	}
+	return n.String(), nil
+}
+
 // ModerationLevel defines the level required to perform an action or the
 // permission level held by a user
 type ModerationLevel int8
@@ -81,4 +141,15 @@ var ActionPrivilege = [...]ModerationLevel{
 	ConfigureServer:   Admin,
 	AdminNotification: Admin,
 	PlaylistLock:      Moderator,
+	AcceptBanAppeal:   Moderator,
+	RejectBanAppeal:   Moderator,
+	TriageReport:      Janitor,
+	AddModNote:        Janitor,
+	MoveThread:        Moderator,
+	MergeThread:       Moderator,
+	SplitThread:       Moderator,
+	SetSlowMode:       Moderator,
+	SetCyclic:         Moderator,
+	ApprovePost:       Janitor,
+	RejectPost:        Janitor,
 }
@@ -0,0 +1,33 @@
+package common
+
+import "time"
+
+const (
+	// MaxPollOptions is the maximum number of options in a poll
+	MaxPollOptions = 10
+
+	// MaxLenPollOption is the maximum length of a single poll option
+	MaxLenPollOption = 100
+
+	// MaxPollDuration is the maximum number of minutes a poll can stay open
+	MaxPollDuration = 7 * 24 * 60
+)
+
+// PollState is the state of a #poll command. Options are read from the lines
+// following the command. Votes are cast through websockets.
+type PollState struct {
+	Expires int64    `json:"expires"`
+	Options []string `json:"options"`
+	Votes   []uint64 `json:"votes"`
+}
+
+// Closed returns, if the poll no longer accepts votes
+func (p PollState) Closed() bool {
+	return time.Now().Unix() >= p.Expires
+}
+
+// PollVote is a vote cast by a client for an option of a poll in a post
+type PollVote struct {
+	ID     uint64 `json:"id"`
+	Option uint8  `json:"option"`
+}
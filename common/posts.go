@@ -33,6 +33,7 @@ type Thread struct {
 	Abbrev     bool   `json:"abbrev"`
 	Sticky     bool   `json:"sticky"`
 	Locked     bool   `json:"locked"`
+	Cyclic     bool   `json:"cyclic"`
 	PostCount  uint32 `json:"post_count"`
 	ImageCount uint32 `json:"image_count"`
 	UpdateTime int64  `json:"update_time"`
@@ -61,6 +62,10 @@ type Post struct {
 	Commands   []Command         `json:"commands"`
 	Moderation []ModerationEntry `json:"moderation"`
 	Claude     *ClaudeState      `json:"claude_state"`
+	Reactions  map[string]uint64 `json:"reactions,omitempty"`
+
+	// Additional files attached to the post after Image
+	Attachments []Image `json:"attachments,omitempty"`
 }
 
 // Return if post has been deleted by staff
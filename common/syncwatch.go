@@ -0,0 +1,99 @@
+package common
+
+import (
+	"encoding/json"
+	"io"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+var syncWatchRegexp = regexp.MustCompile(`^sw(\d+:)?(\d+):(\d+)([+-]\d+)?$`)
+
+func init() {
+	RegisterCommand(syncWatchCommand{})
+}
+
+// Synchronized time counter command
+type syncWatchCommand struct{}
+
+func (syncWatchCommand) Type() CommandType {
+	return SyncWatch
+}
+
+func (syncWatchCommand) Name() string {
+	return "sw"
+}
+
+func (syncWatchCommand) Pattern() string {
+	return `sw(?:\d+:)?\d+:\d+(?:[+-]\d+)?`
+}
+
+func (syncWatchCommand) Parse(_ *CommandContext, match string) (
+	Command, error,
+) {
+	m := syncWatchRegexp.FindStringSubmatch(match)
+	var (
+		hours, min, sec, offset uint64
+		offsetDirection         byte
+	)
+
+	if m[1] != "" {
+		hours, _ = strconv.ParseUint(m[1][:len(m[1])-1], 10, 64)
+	}
+	min, _ = strconv.ParseUint(m[2], 10, 64)
+	sec, _ = strconv.ParseUint(m[3], 10, 64)
+	if m[4] != "" {
+		offsetDirection = m[4][0]
+		offset, _ = strconv.ParseUint(m[4][1:], 10, 64)
+	}
+
+	start := uint64(time.Now().Unix())
+	switch offsetDirection {
+	case '+':
+		start += offset
+	case '-':
+		start -= offset
+	}
+	end := start + sec + (hours*60+min)*60
+
+	return Command{
+		Type: SyncWatch,
+		SyncWatch: [5]uint64{
+			hours,
+			min,
+			sec,
+			start,
+			end,
+		},
+	}, nil
+}
+
+func (syncWatchCommand) AppendJSON(b []byte, c Command) []byte {
+	b = append(b, '[')
+	for i, v := range c.SyncWatch {
+		if i != 0 {
+			b = append(b, ',')
+		}
+		b = strconv.AppendUint(b, v, 10)
+	}
+	return append(b, ']')
+}
+
+func (syncWatchCommand) DecodeJSON(data []byte, c *Command) error {
+	return json.Unmarshal(data, &c.SyncWatch)
+}
+
+func (syncWatchCommand) RenderHTML(w io.Writer, _ string, c Command) bool {
+	b := make([]byte, 0, 128)
+	b = append(b, `<em><strong class="embed syncwatch" data-hour=`...)
+	for i, attr := range [...]string{
+		"", " data-min=", " data-sec=", " data-start=", " data-end=",
+	} {
+		b = append(b, attr...)
+		b = strconv.AppendUint(b, c.SyncWatch[i], 10)
+	}
+	b = append(b, `>syncwatch</strong></em>`...)
+	w.Write(b)
+	return true
+}
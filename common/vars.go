@@ -40,11 +40,22 @@ const (
 	MaxLenNotice       = 500
 	MaxLenRules        = 5000
 	MaxLenEightball    = 2000
+	MaxLenReaction     = 32
+	MaxNumReactions    = 20
 	MaxLenReason       = 100
+	MaxLenAppeal       = 2000
+	MaxLenModNote      = 1000
 	MaxNumBanners      = 100
+	MaxNumEmoji        = 100
+	MaxLenEmojiName    = 32
+	MaxNumAttachments  = 10
+	MaxSlowMode        = 3600 // Seconds
 	MaxAssetSize       = 300 << 10
 	MaxDiceSides       = 10000
-	BumpLimit          = 1000
+	BumpLimit          = 1000 // Default
+	MaxBumpLimit       = 10000
+	NewPosterPosts     = 2 // Default
+	MaxNewPosterPosts  = 100
 )
 
 // Various cryptographic token exact lengths
@@ -92,12 +103,10 @@ var (
 
 // Common Regex expressions
 var (
-	CommandRegexp = regexp.MustCompile(
-		`^#(flip|\d*d\d+|8ball|pyu|pcount|sw(?:\d+:)?\d+:\d+(?:[+-]\d+)?|autobahn)$`,
-	)
-	DiceRegexp      = regexp.MustCompile(`(\d*)d(\d+)`)
+	DiceRegexp      = regexp.MustCompile(`^(\d*)d(\d+)$`)
 	ClaudeRegexp    = regexp.MustCompile(`(?m)^#claude (\S.*?)$`)
 	MediaComRegexp  = regexp.MustCompile(`(?m)^\.(?:(play|remove|seek)\s+(\S+)|(seek|pause|unpause|skip|clear))$`)
+	EmojiNameRegexp = regexp.MustCompile(`^[a-z0-9_]{1,32}$`)
 	Float32Infinite = math.Float32frombits(0x7F800000)
 	MainJS          string
 	StaticJS        string
@@ -28,9 +28,11 @@ const (
 	MessageClaudeAppend
 	MessageClaudeDone
 	MessageClaudeError
-	MessageAttachTiktok
-	MessageTiktokState
+	MessageAttachURL
+	MessageFetchState
 	MessageNekoTV
+	MessagePollVote
+	MessageReaction
 )
 
 // >= 30 are miscellaneous and do not write to post models
@@ -73,11 +75,23 @@ const (
 
 	// Set a cookie on the client
 	MessageSetCookie
+
+	// Send the number of seconds the client has to wait before posting in
+	// a slow mode thread again
+	MessageSlowMode
+
+	// Send the IDs of posts removed from a cyclic thread
+	MessagePrunePosts
+
+	// Send the ID of a post held for review by staff or 0, if the post has
+	// to be submitted for review in one piece
+	MessagePendingReview
 )
 
 // Forwarded functions from "github.com/bakape/megucawebsockets/feeds" to avoid circular imports
 var (
-	// GetByIPAndBoard retrieves all Clients that match the passed IP on a board
+	// GetByIPAndBoard retrieves all Clients that match the passed IP or are
+	// contained in the passed network in CIDR notation on a board
 	GetByIPAndBoard func(ip, board string) []Client
 
 	// GetClientsByIP returns connected clients with matching ips
@@ -30,6 +30,7 @@ var (
 			BoardPublic: BoardPublic{
 				DefaultCSS: Defaults.DefaultCSS,
 				Title:      "Aggregator metaboard",
+				Reactions:  ReactionDefaults,
 				Banners:    []uint16{},
 			},
 		},
@@ -49,9 +50,12 @@ var (
 		MaxHeight:         6000,
 		MaxWidth:          6000,
 		SessionExpiry:     30,
+		ModLogExpiry:      7,
 		CharScore:         170,
 		PostCreationScore: 15000,
 		ImageScore:        15000,
+		PollDuration:      24 * 60,
+		ReactionScore:     1000,
 		EmailErrPort:      587,
 		Salt:              "LALALALALALALALALALALALALALALALALALALALA",
 		EmailErrMail:      "admin@email.com",
@@ -63,6 +67,9 @@ var (
 		CaptchaTags: []string{"patchouli_knowledge", "cirno",
 			"hakurei_reimu"},
 		OverrideCaptchaTags: map[string]string{},
+		FetchDomains: []string{"tiktok.com", "twitter.com", "x.com",
+			"reddit.com", "redd.it", "youtube.com", "youtu.be", "imgur.com",
+			"catbox.moe"},
 		Public: Public{
 			DefaultCSS:      "neko",
 			DefaultLang:     "en_GB",
@@ -84,6 +91,9 @@ var (
 		"Hell yeah, motherfucker!",
 		"Anta baka?",
 	}
+
+	// ReactionDefaults contains the default post reaction set
+	ReactionDefaults = []string{"👍", "👎", "❤️", "😂", "😮", "😢"}
 )
 
 // Default string for the FAQ panel
@@ -97,7 +107,9 @@ const defaultFAQ = `Supported upload file types are JPEG, PNG, APNG, WEBP, AVIF,
   ` + "``" + ` for programing code highlighting
 <hr>Hash commands:
 #d100 #2d100 - Roll dice
+#2d6+3 #4d6kh3 #4d6dl1 #3d6e #4dF #d20+d4-1 - Roll dice with modifiers, keep/drop highest or lowest, exploding, fudge dice and multiple terms
 #flip - Coin flip
+#poll #poll60 - Poll with options on the following lines, optionally closing after a number of minutes
 #8ball - An 8ball
 #sw24:30 #sw2:24:30 #sw24:30+30 #sw24:30-30 - "Syncwatch" synchronized time counter
 #steal - steal the image of the first post linked; does not work on OPs
@@ -37,6 +37,26 @@ type ServerConfigs struct {
 	Test struct {
 		Database string
 	}
+	// Backend for storing uploaded files and thumbnails
+	Storage struct {
+		// "local" or "s3". Defaults to "local".
+		Type string
+		S3   S3Configs
+	}
+	// Transcoding of videos with codecs unplayable in browsers with ffmpeg
+	Transcoding struct {
+		Enabled bool
+		// "mp4" for H.264/AAC or "webm" for VP9/Opus. Defaults to "mp4".
+		Format string
+	}
+	// Additional thumbnails for high density displays
+	Thumbnails struct {
+		// Bounding box sizes of thumbnails generated in addition to the
+		// standard 150x150 one. Defaults to 300 and 600.
+		Sizes []uint16
+		// Also encode thumbnails as AVIF with ffmpeg
+		AVIF bool
+	}
 	AnthropicApiKey      string   `json:"anthropic_api_key"`
 	DefaultGeneralThread *string  `json:"default_general_thread"`
 	YoutubeApiKey        *string  `json:"youtube_api_key"`
@@ -45,6 +65,19 @@ type ServerConfigs struct {
 	GeminiModel          *string  `json:"gemini_model"`
 }
 
+// Configurations of an S3-compatible object storage backend
+type S3Configs struct {
+	// Scheme and host of the service, like "https://s3.amazonaws.com"
+	Endpoint string
+	Region   string
+	Bucket   string
+	// Address objects as endpoint/bucket/key instead of bucket.endpoint/key.
+	// Most self-hosted services like MinIO need this.
+	PathStyle bool   `json:"path_style"`
+	AccessKey string `json:"access_key"`
+	SecretKey string `json:"secret_key"`
+}
+
 // Load configs from JSON or defaults, if none present
 func (c *ServerConfigs) Load() (err error) {
 	path := "config.json"
@@ -1,5 +1,7 @@
 package config
 
+import "github.com/bakape/meguca/common"
+
 // Configs stores the global server configuration
 type Configs struct {
 	Public
@@ -16,6 +18,8 @@ type Configs struct {
 	CharScore           uint   `json:"charScore"`
 	PostCreationScore   uint   `json:"postCreationScore"`
 	ImageScore          uint   `json:"imageScore"`
+	PollDuration        uint   `json:"pollDuration"`
+	ReactionScore       uint   `json:"reactionScore"`
 	RootURL             string `json:"rootURL"`
 	Salt                string `json:"salt"`
 	EmailErrMail        string `json:"emailErrMail"`
@@ -25,6 +29,16 @@ type Configs struct {
 	FAQ                 string
 	CaptchaTags         []string          `json:"captchaTags"`
 	OverrideCaptchaTags map[string]string `json:"overrideCaptchaTags"`
+
+	// Strip identifying metadata from all uploaded files
+	StripMetadata bool `json:"stripMetadata"`
+
+	// Domains, links to which can be fetched into post attachments.
+	// Includes subdomains.
+	FetchDomains []string `json:"fetchDomains"`
+
+	// Days to keep moderation log entries for. 0 keeps them indefinitely.
+	ModLogExpiry uint `json:"modLogExpiry"`
 }
 
 // Public contains configurations exposeable through public availability APIs
@@ -50,6 +64,20 @@ type BoardConfigs struct {
 	RandomNameHours bool     `json:"randomNameHours"`
 	ID              string   `json:"id"`
 	Eightball       []string `json:"eightball"`
+
+	// Strip identifying metadata from files uploaded to the board, even if
+	// not enabled globally
+	StripMetadata bool `json:"stripMetadata"`
+
+	// Hold threads, replies or replies with files of new posters for review
+	// by staff
+	PreModThreads bool `json:"preModThreads"`
+	PreModReplies bool `json:"preModReplies"`
+	PreModImages  bool `json:"preModImages"`
+
+	// Number of posts an IP needs to have made to no longer count as a new
+	// poster
+	NewPosterPosts uint32 `json:"newPosterPosts"`
 }
 
 // BoardPublic contains publically accessible board-specific configurations
@@ -66,10 +94,62 @@ type BoardPublic struct {
 	Notice     string `json:"notice"`
 	Rules      string `json:"rules"`
 
+	// Serve transcoded renditions of videos instead of the originals
+	ServeRenditions bool `json:"serveRenditions"`
+
+	// Maximum number of files, that can be attached to a post
+	MaxAttachments uint8 `json:"maxAttachments"`
+
+	// Minimum interval between posts of the same IP in a thread in seconds.
+	// Threads can override it with their own interval.
+	SlowMode uint32 `json:"slowMode"`
+
+	// Number of posts in a thread, after which it is no longer bumped. Cyclic
+	// threads instead start pruning their oldest replies.
+	BumpLimit uint32 `json:"bumpLimit"`
+
+	// Maximum number of files in a thread. 0 for no limit.
+	ImageLimit uint32 `json:"imageLimit"`
+
+	// Emoji users can react to posts with
+	Reactions []string `json:"reactions"`
+
+	// Names of hash commands disabled on the board. Public, so clients can
+	// skip them, when rendering post bodies.
+	DisabledCommands []string `json:"disabledCommands"`
+
 	// Can't use []uint8, because it marshals to string
 	Banners []uint16 `json:"banners"`
 }
 
+// CommandEnabled returns, if the named hash command is not disabled on the
+// board
+func (c BoardPublic) CommandEnabled(name string) bool {
+	for _, n := range c.DisabledCommands {
+		if n == name {
+			return false
+		}
+	}
+	return true
+}
+
+// AttachmentLimit returns the maximum number of files, that can be attached to
+// a post on the board
+func (c BoardPublic) AttachmentLimit() int {
+	if c.MaxAttachments == 0 {
+		return 1
+	}
+	return int(c.MaxAttachments)
+}
+
+// ThreadBumpLimit returns the bump limit of threads on the board
+func (c BoardPublic) ThreadBumpLimit() int {
+	if c.BumpLimit == 0 {
+		return common.BumpLimit
+	}
+	return int(c.BumpLimit)
+}
+
 // BoardConfContainer contains configurations for an individual board as well
 // as pregenerated public JSON and it's hash
 type BoardConfContainer struct {
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/bakape/meguca/auth"
@@ -13,14 +14,70 @@ import (
 
 // Write moderation action to board-level and post-level logs
 func logModeration(tx *sql.Tx, e auth.ModLogEntry) (err error) {
+	// Store the poster's IP hash, as the IP itself is removed after a week
+	var ipHash sql.NullString
+	if e.ID != 0 {
+		_, ipHash, err = getPostIPHash(tx, e.ID)
+		switch err {
+		case nil:
+		case sql.ErrNoRows:
+			err = nil
+		default:
+			return
+		}
+	}
+
 	_, err = sq.Insert("mod_log").
-		Columns("type", "board", "post_id", "by", "length", "data").
-		Values(e.Type, e.Board, e.ID, e.By, e.Length, e.Data).
+		Columns("type", "board", "post_id", "by", "length", "data", "ip_hash").
+		Values(e.Type, e.Board, e.ID, e.By, e.Length, e.Data, ipHash).
 		RunWith(tx).
 		Exec()
 	return
 }
 
+// Set the poster IP hashes of moderation log entries written by SQL functions.
+// Only entries created in the current transaction are updated, unless all.
+func hashModLogIPs(tx *sql.Tx, all bool) (err error) {
+	q := sq.Select("ml.id", "p.ip").
+		From("mod_log as ml").
+		Join("posts as p on p.id = ml.post_id").
+		Where("ml.ip_hash is null and p.ip is not null").
+		RunWith(tx)
+	if !all {
+		// Transaction start time
+		q = q.Where("ml.created = now() at time zone 'utc'")
+	}
+
+	var (
+		byIP = make(map[string][]uint64)
+		id   uint64
+		ip   string
+	)
+	err = queryAll(q, func(r *sql.Rows) (err error) {
+		err = r.Scan(&id, &ip)
+		if err != nil {
+			return
+		}
+		byIP[ip] = append(byIP[ip], id)
+		return
+	})
+	if err != nil {
+		return
+	}
+
+	for ip, ids := range byIP {
+		_, err = sq.Update("mod_log").
+			Set("ip_hash", hashPosterIP(ip)).
+			Where(squirrel.Eq{"id": ids}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Write redirects to db. /all/ seems like a good place for admin-only actions
 func Redirect(id uint64, act common.ModerationAction, url string) (err error) {
 	return InTransaction(false, func(tx *sql.Tx) (err error) {
@@ -40,12 +97,15 @@ func Redirect(id uint64, act common.ModerationAction, url string) (err error) {
 func PurgePost(tx *sql.Tx, id uint64, by, reason string, by_ip bool) (
 	err error,
 ) {
+	type Image struct {
+		SHA1                sql.NullString
+		FileType, ThumbType sql.NullInt64
+		Thumbs              []byte
+		ThumbAVIF           sql.NullBool
+	}
 	type Post struct {
 		ID    uint64
-		Image struct {
-			SHA1                sql.NullString
-			FileType, ThumbType sql.NullInt64
-		}
+		Image Image
 	}
 	var posts []Post
 	var board string
@@ -61,7 +121,8 @@ func PurgePost(tx *sql.Tx, id uint64, by, reason string, by_ip bool) (
 		return
 	}
 
-	getPosts := sq.Select("p.id", "i.SHA1", "i.file_type", "i.thumb_type").
+	getPosts := sq.Select("p.id", "i.SHA1", "i.file_type", "i.thumb_type",
+		"i.thumbs", "i.thumb_avif").
 		From("posts as p").
 		LeftJoin("images as i on p.SHA1 = i.SHA1").
 		RunWith(tx)
@@ -85,6 +146,8 @@ func PurgePost(tx *sql.Tx, id uint64, by, reason string, by_ip bool) (
 				&post.Image.SHA1,
 				&post.Image.FileType,
 				&post.Image.ThumbType,
+				&post.Image.Thumbs,
+				&post.Image.ThumbAVIF,
 			)
 			if err != nil {
 				return
@@ -98,8 +161,35 @@ func PurgePost(tx *sql.Tx, id uint64, by, reason string, by_ip bool) (
 	}
 
 	for _, p := range posts {
+		images := make([]Image, 0, 1)
 		if p.Image.SHA1.Valid {
-			img := p.Image
+			images = append(images, p.Image)
+		}
+
+		// Also remove any additional attachments
+		err = queryAll(
+			sq.Select("i.SHA1", "i.file_type", "i.thumb_type", "i.thumbs",
+				"i.thumb_avif").
+				From("post_attachments as a").
+				Join("images as i on i.SHA1 = a.SHA1").
+				Where("a.post_id = ?", p.ID).
+				RunWith(tx),
+			func(r *sql.Rows) (err error) {
+				var img Image
+				err = r.Scan(&img.SHA1, &img.FileType, &img.ThumbType,
+					&img.Thumbs, &img.ThumbAVIF)
+				if err != nil {
+					return
+				}
+				images = append(images, img)
+				return
+			},
+		)
+		if err != nil {
+			return
+		}
+
+		for _, img := range images {
 			_, err = sq.
 				Delete("images").
 				Where("sha1 = ?", img.SHA1.String).
@@ -108,11 +198,13 @@ func PurgePost(tx *sql.Tx, id uint64, by, reason string, by_ip bool) (
 			if err != nil {
 				return
 			}
-			err = assets.Delete(
-				img.SHA1.String,
-				uint8(img.FileType.Int64),
-				uint8(img.ThumbType.Int64),
-			)
+			err = assets.Delete(common.ImageCommon{
+				SHA1:      img.SHA1.String,
+				FileType:  uint8(img.FileType.Int64),
+				ThumbType: uint8(img.ThumbType.Int64),
+				Thumbs:    decodeThumbs(img.Thumbs),
+				ThumbAVIF: img.ThumbAVIF.Bool,
+			})
 			if err != nil {
 				return
 			}
@@ -176,6 +268,9 @@ func moderatePost(id uint64, entry common.ModerationEntry,
 func DeleteImages(tx *sql.Tx, id uint64, by string, by_IP bool) (err error) {
 	_, err = tx.Exec("select delete_images($1::bigint, $2::text, $3::boolean)",
 		id, by, by_IP)
+	if err == nil {
+		err = hashModLogIPs(tx, false)
+	}
 
 	castPermissionError(&err)
 	return
@@ -200,6 +295,9 @@ func ModSpoilerImages(tx *sql.Tx, id uint64, by string, by_IP bool) (
 		"select spoiler_images($1::bigint, $2::text, $3::boolean)",
 		id, by, by_IP,
 	)
+	if err == nil {
+		err = hashModLogIPs(tx, false)
+	}
 
 	castPermissionError(&err)
 	return
@@ -345,6 +443,9 @@ func castPermissionError(err *error) {
 func DeletePosts(tx *sql.Tx, id uint64, by string, by_IP bool) (err error) {
 	_, err = tx.Exec("select delete_posts($1::bigint, $2::text, $3::boolean)",
 		id, by, by_IP)
+	if err == nil {
+		err = hashModLogIPs(tx, false)
+	}
 
 	castPermissionError(&err)
 	return
@@ -360,48 +461,62 @@ func SetThreadSticky(id uint64, sticky bool) error {
 }
 
 // SetThreadLock sets the ability of users to post in a specific thread
-func SetThreadLock(id uint64, locked bool, by string) error {
+// Locking a thread also closes all of its polls.
+func SetThreadLock(id uint64, locked bool, by string) (err error) {
 	q := sq.Update("threads").
 		Set("locked", locked).
 		Where("id = ?", id)
-	return moderatePost(id,
+	err = moderatePost(id,
 		common.ModerationEntry{
 			Type: common.LockThread,
 			By:   by,
 			Data: strconv.FormatBool(locked),
 		},
 		&q)
+	if err != nil || !locked {
+		return
+	}
+	return ClosePolls(id)
 }
 
-// GetModLog retrieves the moderation log for a specific board
-func GetModLog(board string) (log []auth.ModLogEntry, err error) {
+// SetThreadCyclic sets, if the oldest replies of a thread are pruned, once it
+// reaches the bump limit of its board
+func SetThreadCyclic(id uint64, cyclic bool, by string) error {
+	q := sq.Update("threads").
+		Set("cyclic", cyclic)
+	return moderatePost(id,
+		common.ModerationEntry{
+			Type: common.SetCyclic,
+			By:   by,
+			Data: strconv.FormatBool(cyclic),
+		},
+		&q)
+}
+
+// SetThreadSlowMode sets the minimum interval in seconds between posts of the
+// same IP in a thread. 0 leaves only the slow mode of the board in effect.
+func SetThreadSlowMode(id uint64, seconds uint32, by string) error {
+	q := sq.Update("threads").
+		Set("slow_mode", seconds)
+	return moderatePost(id,
+		common.ModerationEntry{
+			Type: common.SetSlowMode,
+			By:   by,
+			Data: strconv.FormatUint(uint64(seconds), 10),
+		},
+		&q)
+}
+
+// GetModLog retrieves a page of moderation log entries matching filter, newest
+// first
+func GetModLog(filter auth.ModLogFilter) (log []auth.ModLogEntry, err error) {
 	log = make([]auth.ModLogEntry, 0, 64)
-	e := auth.ModLogEntry{Board: board}
 	err = queryAll(
-		sq.
-			Select(
-				"ml.type",
-				"ml.post_id",
-				"ml.by",
-				"ml.created",
-				"ml.length",
-				"ml.data",
-				"coalesce(p.ip::text, '')",
-			).
-			From("mod_log as ml").
-			LeftJoin("posts p on p.id = ml.post_id").
-			Where("ml.board = ?", board).
-			OrderBy("ml.created desc"),
+		selectModLog(filter).
+			Limit(auth.ModLogPageSize).
+			Offset(uint64(filter.Page)*auth.ModLogPageSize),
 		func(r *sql.Rows) (err error) {
-			err = r.Scan(
-				&e.Type,
-				&e.ID,
-				&e.By,
-				&e.Created,
-				&e.Length,
-				&e.Data,
-				&e.IP,
-			)
+			e, err := scanModLogEntry(r)
 			if err != nil {
 				return
 			}
@@ -412,6 +527,71 @@ func GetModLog(board string) (log []auth.ModLogEntry, err error) {
 	return
 }
 
+// StreamModLog passes all moderation log entries matching filter to fn, newest
+// first. filter.Page is ignored.
+func StreamModLog(filter auth.ModLogFilter, fn func(auth.ModLogEntry) error,
+) error {
+	return queryAll(selectModLog(filter), func(r *sql.Rows) (err error) {
+		e, err := scanModLogEntry(r)
+		if err != nil {
+			return
+		}
+		return fn(e)
+	})
+}
+
+func selectModLog(filter auth.ModLogFilter) squirrel.SelectBuilder {
+	q := sq.
+		Select(
+			"type",
+			"board",
+			"post_id",
+			"by",
+			"created",
+			"length",
+			"data",
+			"coalesce(ip_hash, '')",
+		).
+		From("mod_log").
+		OrderBy("created desc", "id desc")
+	if filter.Board != "" {
+		q = q.Where("board = ?", filter.Board)
+	}
+	if len(filter.Types) != 0 {
+		q = q.Where(squirrel.Eq{"type": filter.Types})
+	}
+	if filter.By != "" {
+		q = q.Where("by = ?", filter.By)
+	}
+	if filter.Post != 0 {
+		q = q.Where("post_id = ?", filter.Post)
+	}
+	if !filter.From.IsZero() {
+		q = q.Where("created >= ?", filter.From.UTC())
+	}
+	if !filter.To.IsZero() {
+		q = q.Where("created < ?", filter.To.UTC())
+	}
+	if filter.IPHash != "" {
+		q = q.Where("ip_hash = ?", strings.ToLower(filter.IPHash))
+	}
+	return q
+}
+
+func scanModLogEntry(r rowScanner) (e auth.ModLogEntry, err error) {
+	err = r.Scan(
+		&e.Type,
+		&e.Board,
+		&e.ID,
+		&e.By,
+		&e.Created,
+		&e.Length,
+		&e.Data,
+		&e.IPHash,
+	)
+	return
+}
+
 // GetModLog retrieves the moderation log entry by ID
 func GetModLogEntry(id uint64) (e auth.ModLogEntry, err error) {
 	err = sq.
@@ -424,3 +604,26 @@ func GetModLogEntry(id uint64) (e auth.ModLogEntry, err error) {
 			&e.Data)
 	return
 }
+
+// GetModLogEntries retrieves moderation log entries by ID in ascending order
+func GetModLogEntries(ids []uint64) (log []auth.ModLogEntry, err error) {
+	log = make([]auth.ModLogEntry, 0, len(ids))
+	err = queryAll(
+		sq.Select("type", "board", "post_id", "by", "created", "length",
+			"data").
+			From("mod_log").
+			Where(squirrel.Eq{"id": ids}).
+			OrderBy("id"),
+		func(r *sql.Rows) (err error) {
+			var e auth.ModLogEntry
+			err = r.Scan(&e.Type, &e.Board, &e.ID, &e.By, &e.Created,
+				&e.Length, &e.Data)
+			if err != nil {
+				return
+			}
+			log = append(log, e)
+			return
+		},
+	)
+	return
+}
@@ -3,7 +3,9 @@ package db
 import (
 	"database/sql"
 	"testing"
+	"time"
 
+	"github.com/bakape/meguca/auth"
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/test"
 )
@@ -187,10 +189,108 @@ func TestGetSameIPPosts(t *testing.T) {
 func TestGetModLog(t *testing.T) {
 	t.Run("ban_unban", TestBanUnban) // So we have something in the log
 
-	_, err := GetModLog("a")
+	log, err := GetModLog(auth.ModLogFilter{})
 	if err != nil {
 		t.Fatal(err)
 	}
+	if len(log) == 0 {
+		t.Fatal("no log entries")
+	}
+	ipHash := log[0].IPHash
+	test.AssertEquals(t, ipHash, hashPosterIP("::1"))
+
+	cases := [...]struct {
+		name   string
+		filter auth.ModLogFilter
+		empty  bool
+	}{
+		{
+			name:   "board",
+			filter: auth.ModLogFilter{Board: "all"},
+		},
+		{
+			name: "type",
+			filter: auth.ModLogFilter{
+				Types: []common.ModerationAction{common.BanPost},
+			},
+		},
+		{
+			name:   "moderator",
+			filter: auth.ModLogFilter{By: "admin"},
+		},
+		{
+			name:   "other moderator",
+			filter: auth.ModLogFilter{By: "nobody"},
+			empty:  true,
+		},
+		{
+			name:   "post",
+			filter: auth.ModLogFilter{Post: 1},
+		},
+		{
+			name:   "IP hash",
+			filter: auth.ModLogFilter{IPHash: ipHash},
+		},
+		{
+			name:   "other IP hash",
+			filter: auth.ModLogFilter{IPHash: hashPosterIP("203.0.113.1")},
+			empty:  true,
+		},
+		{
+			name:   "next page",
+			filter: auth.ModLogFilter{Page: 1},
+			empty:  true,
+		},
+		{
+			name: "date range",
+			filter: auth.ModLogFilter{
+				From: time.Now().Add(-time.Hour),
+				To:   time.Now().Add(time.Hour),
+			},
+		},
+		{
+			name: "past date range",
+			filter: auth.ModLogFilter{
+				To: time.Now().Add(-time.Hour),
+			},
+			empty: true,
+		},
+	}
+
+	t.Run("stream", func(t *testing.T) {
+		var n int
+		err := StreamModLog(auth.ModLogFilter{Page: 1},
+			func(auth.ModLogEntry) error {
+				n++
+				return nil
+			})
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, n, len(log))
+	})
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			log, err := GetModLog(c.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEquals(t, len(log) == 0, c.empty)
+			for _, e := range log {
+				f := c.filter
+				switch {
+				case f.Board != "" && e.Board != f.Board,
+					len(f.Types) != 0 && e.Type != f.Types[0],
+					f.By != "" && e.By != f.By,
+					f.Post != 0 && e.ID != f.Post,
+					f.IPHash != "" && e.IPHash != f.IPHash:
+					t.Fatalf("entry does not match filter: %#v", e)
+				}
+			}
+		})
+	}
 }
 
 func TestGetModLogEntry(t *testing.T) {
@@ -0,0 +1,214 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+)
+
+var (
+	// ErrAppealExists is returned, when the IP has already appealed the ban
+	ErrAppealExists = common.ErrInvalidInput("ban already appealed")
+
+	// ErrAppealResolved is returned, when resolving an appeal, that has
+	// already been accepted or rejected
+	ErrAppealResolved = common.ErrInvalidInput("appeal already resolved")
+)
+
+// InsertBanAppeal records an appeal of the ban on board issued for post forPost
+// by ip and returns its ID
+func InsertBanAppeal(board string, forPost uint64, ip, body string) (
+	id uint64, err error,
+) {
+	err = sq.Insert("ban_appeals").
+		Columns("board", "for_post", "ip", "body").
+		Values(board, forPost, ip, body).
+		Suffix("returning id").
+		QueryRow().
+		Scan(&id)
+	if IsConflictError(err) {
+		err = ErrAppealExists
+	}
+	return
+}
+
+// GetBanAppeal retrieves the appeal of a ban by ip including any staff
+// comments
+func GetBanAppeal(board string, forPost uint64, ip string) (
+	a auth.BanAppeal, err error,
+) {
+	err = sq.Select("id", "created", "body", "state").
+		From("ban_appeals").
+		Where("board = ? and for_post = ? and ip = ?", board, forPost, ip).
+		QueryRow().
+		Scan(&a.ID, &a.Created, &a.Body, &a.State)
+	if err != nil {
+		return
+	}
+	a.Board = board
+	a.ForPost = forPost
+	a.Comments, err = getBanAppealComments(a.ID)
+	return
+}
+
+func getBanAppealComments(id uint64) (c []auth.BanAppealComment, err error) {
+	err = queryAll(
+		sq.Select("by", "body", "created").
+			From("ban_appeal_comments").
+			Where("appeal_id = ?", id).
+			OrderBy("created"),
+		func(r *sql.Rows) (err error) {
+			var com auth.BanAppealComment
+			err = r.Scan(&com.By, &com.Body, &com.Created)
+			if err != nil {
+				return
+			}
+			c = append(c, com)
+			return
+		},
+	)
+	return
+}
+
+// GetBanAppeals retrieves all appeals of bans on a board with pending appeals
+// first. Pass "all" for appeals of global bans.
+func GetBanAppeals(board string) (appeals []auth.BanAppeal, err error) {
+	appeals = make([]auth.BanAppeal, 0, 16)
+	byID := make(map[uint64]int)
+	err = queryAll(
+		sq.Select("id", "for_post", "created", "body", "state").
+			From("ban_appeals").
+			Where("board = ?", board).
+			OrderBy("state = 'pending' desc", "created desc"),
+		func(r *sql.Rows) (err error) {
+			a := auth.BanAppeal{Board: board}
+			err = r.Scan(&a.ID, &a.ForPost, &a.Created, &a.Body, &a.State)
+			if err != nil {
+				return
+			}
+			byID[a.ID] = len(appeals)
+			appeals = append(appeals, a)
+			return
+		},
+	)
+	if err != nil || len(appeals) == 0 {
+		return
+	}
+
+	err = queryAll(
+		sq.Select("c.appeal_id", "c.by", "c.body", "c.created").
+			From("ban_appeal_comments as c").
+			Join("ban_appeals as a on a.id = c.appeal_id").
+			Where("a.board = ?", board).
+			OrderBy("c.created"),
+		func(r *sql.Rows) (err error) {
+			var (
+				id  uint64
+				com auth.BanAppealComment
+			)
+			err = r.Scan(&id, &com.By, &com.Body, &com.Created)
+			if err != nil {
+				return
+			}
+			a := &appeals[byID[id]]
+			a.Comments = append(a.Comments, com)
+			return
+		},
+	)
+	return
+}
+
+// GetBanAppealBoard returns the board of the ban an appeal is for
+func GetBanAppealBoard(id uint64) (board string, err error) {
+	err = sq.Select("board").
+		From("ban_appeals").
+		Where("id = ?", id).
+		QueryRow().
+		Scan(&board)
+	return
+}
+
+// CommentBanAppeal adds a staff comment to a ban appeal
+func CommentBanAppeal(id uint64, by, body string) error {
+	return InTransaction(false, func(tx *sql.Tx) error {
+		return commentBanAppeal(tx, id, by, body)
+	})
+}
+
+func commentBanAppeal(tx *sql.Tx, id uint64, by, body string) (err error) {
+	_, err = sq.Insert("ban_appeal_comments").
+		Columns("appeal_id", "by", "body").
+		Values(id, by, body).
+		RunWith(tx).
+		Exec()
+	return
+}
+
+// ResolveBanAppeal accepts or rejects a pending ban appeal with an optional
+// comment and records the decision in the moderation log. Accepting an appeal
+// lifts the ban.
+func ResolveBanAppeal(id uint64, by string, accept bool, comment string,
+) error {
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		var (
+			board, state string
+			forPost      uint64
+		)
+		err = sq.Select("board", "for_post", "state").
+			From("ban_appeals").
+			Where("id = ?", id).
+			Suffix("for update").
+			RunWith(tx).
+			QueryRow().
+			Scan(&board, &forPost, &state)
+		if err != nil {
+			return
+		}
+		if state != auth.AppealPending {
+			return ErrAppealResolved
+		}
+
+		state = auth.AppealRejected
+		typ := common.RejectBanAppeal
+		if accept {
+			state = auth.AppealAccepted
+			typ = common.AcceptBanAppeal
+		}
+		_, err = sq.Update("ban_appeals").
+			Set("state", state).
+			Where("id = ?", id).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		if comment != "" {
+			err = commentBanAppeal(tx, id, by, comment)
+			if err != nil {
+				return
+			}
+		}
+		if accept {
+			err = unbanTx(tx, board, forPost, by)
+			if err != nil {
+				return
+			}
+		}
+
+		// Not attached to the post, so the decision is not displayed on it
+		data := fmt.Sprintf(">>%d", forPost)
+		if comment != "" {
+			data += ": " + comment
+		}
+		return logModeration(tx, auth.ModLogEntry{
+			ModerationEntry: common.ModerationEntry{
+				Type: typ,
+				By:   by,
+				Data: data,
+			},
+			Board: board,
+		})
+	})
+}
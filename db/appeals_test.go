@@ -0,0 +1,152 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestBanAppeals(t *testing.T) {
+	prepareForModeration(t)
+	assertTableClear(t, "ban_appeals")
+
+	err := InTransaction(false, func(tx *sql.Tx) error {
+		return Ban(tx, "a", "test", "admin", time.Minute, 1, common.BanPost,
+			common.BanAddress)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id, err := InsertBanAppeal("a", 1, "::1", "I am innocent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = InsertBanAppeal("a", 1, "::1", "Really")
+	AssertEquals(t, err, ErrAppealExists)
+
+	err = CommentBanAppeal(id, "admin", "Why?")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := GetBanAppeal("a", 1, "::1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, a.ID, id)
+	AssertEquals(t, a.Body, "I am innocent")
+	AssertEquals(t, a.State, auth.AppealPending)
+	AssertEquals(t, len(a.Comments), 1)
+	AssertEquals(t, a.Comments[0].Body, "Why?")
+
+	board, err := GetBanAppealBoard(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, board, "a")
+
+	t.Run("pending appeals are kept", func(t *testing.T) {
+		assertExec(t, `update ban_appeals
+			set created = now() at time zone 'utc' - interval '8 days'`)
+		expireBanAppeals()
+		_, err := GetBanAppeal("a", 1, "::1")
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("accept", func(t *testing.T) {
+		err := ResolveBanAppeal(id, "admin", true, "Fair enough")
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = RefreshBanCache()
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = IsBanned("a", "::1")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		appeals, err := GetBanAppeals("a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, len(appeals), 1)
+		AssertEquals(t, appeals[0].State, auth.AppealAccepted)
+		AssertEquals(t, len(appeals[0].Comments), 2)
+
+		log, err := GetModLog(auth.ModLogFilter{Board: "a"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var logged bool
+		for _, l := range log {
+			if l.Type == common.AcceptBanAppeal {
+				logged = true
+				AssertEquals(t, l.Data, ">>1: Fair enough")
+			}
+		}
+		AssertEquals(t, logged, true)
+	})
+
+	t.Run("already resolved", func(t *testing.T) {
+		err := ResolveBanAppeal(id, "admin", false, "")
+		AssertEquals(t, err, ErrAppealResolved)
+	})
+
+	t.Run("expire resolved", func(t *testing.T) {
+		expireBanAppeals()
+		_, err := GetBanAppeal("a", 1, "::1")
+		AssertEquals(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestExpireBanAppeals(t *testing.T) {
+	prepareForModeration(t)
+	assertTableClear(t, "ban_appeals")
+
+	// Bans the poster's /64 network
+	err := InTransaction(false, func(tx *sql.Tx) error {
+		return Ban(tx, "a", "test", "admin", time.Hour, 1, common.BanPost,
+			common.BanAddress)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var mask int
+	err = sq.Select("masklen(ip)").From("bans").QueryRow().Scan(&mask)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, mask, 64)
+
+	_, err = InsertBanAppeal("a", 1, "::1", "I am innocent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertExec(t, `update ban_appeals
+		set created = now() at time zone 'utc' - interval '8 days'`)
+
+	t.Run("ban in effect", func(t *testing.T) {
+		expireBanAppeals()
+		_, err := GetBanAppeal("a", 1, "::1")
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("ban expired", func(t *testing.T) {
+		assertExec(t, `update bans
+			set expires = now() at time zone 'utc' - interval '1 minute'`)
+		expireBanAppeals()
+		_, err := GetBanAppeal("a", 1, "::1")
+		AssertEquals(t, err, sql.ErrNoRows)
+	})
+}
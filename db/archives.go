@@ -0,0 +1,59 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/bakape/meguca/common"
+)
+
+// WriteArchiveEntries writes the index of an uploaded archive's contents
+func WriteArchiveEntries(tx *sql.Tx, sha1 string,
+	entries []common.ArchiveEntry,
+) (err error) {
+	if len(entries) == 0 {
+		return
+	}
+
+	q := sq.Insert("archive_entries").
+		Columns("sha1", "position", "page", "size", "name")
+	for i, e := range entries {
+		q = q.Values(sha1, i, e.Page, e.Size, e.Name)
+	}
+	_, err = q.RunWith(tx).Exec()
+	return
+}
+
+// GetArchiveEntries retrieves the index of an uploaded archive's contents.
+// Returns nil, if the file is not an indexed archive.
+func GetArchiveEntries(sha1 string) (entries []common.ArchiveEntry, err error) {
+	err = queryAll(
+		sq.Select("page", "size", "name").
+			From("archive_entries").
+			Where("sha1 = ?", sha1).
+			OrderBy("position"),
+		func(r *sql.Rows) (err error) {
+			var e common.ArchiveEntry
+			err = r.Scan(&e.Page, &e.Size, &e.Name)
+			if err != nil {
+				return
+			}
+			entries = append(entries, e)
+			return
+		},
+	)
+	return
+}
+
+// GetArchiveEntry retrieves a single indexed archive entry and the file type
+// of the archive containing it
+func GetArchiveEntry(sha1 string, position int) (
+	fileType uint8, entry common.ArchiveEntry, err error,
+) {
+	err = sq.Select("i.file_type", "a.page", "a.size", "a.name").
+		From("archive_entries as a").
+		Join("images as i on i.sha1 = a.sha1").
+		Where("a.sha1 = ? and a.position = ?", sha1, position).
+		QueryRow().
+		Scan(&fileType, &entry.Page, &entry.Size, &entry.Name)
+	return
+}
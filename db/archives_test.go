@@ -0,0 +1,43 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/imager/assets"
+	"github.com/bakape/meguca/test"
+)
+
+func TestArchiveEntries(t *testing.T) {
+	assertTableClear(t, "images")
+	writeSampleImage(t)
+
+	sha1 := assets.StdJPEG.SHA1
+	std := []common.ArchiveEntry{
+		{Page: true, Size: 10, Name: "01.jpg"},
+		{Size: 5, Name: "readme.txt"},
+	}
+	err := InTransaction(false, func(tx *sql.Tx) error {
+		return WriteArchiveEntries(tx, sha1, std)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := GetArchiveEntries(sha1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, entries, std)
+
+	fileType, entry, err := GetArchiveEntry(sha1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, fileType, assets.StdJPEG.FileType)
+	test.AssertEquals(t, entry, std[1])
+
+	_, _, err = GetArchiveEntry(sha1, 2)
+	test.AssertEquals(t, err, sql.ErrNoRows)
+}
@@ -12,13 +12,13 @@ func loadAssets(table string,
 ) (err error) {
 	byBoard := make(map[string][]assets.File, 64)
 	err = queryAll(
-		sq.Select("board", "data", "mime").From(table),
+		sq.Select("board", "data", "mime", "name").From(table),
 		func(r *sql.Rows) (err error) {
 			var (
 				board string
 				file  assets.File
 			)
-			err = r.Scan(&board, &file.Data, &file.Mime)
+			err = r.Scan(&board, &file.Data, &file.Mime, &file.Name)
 			if err != nil {
 				return
 			}
@@ -45,22 +45,16 @@ func updateAssets(table string,
 	return func(board string) (err error) {
 		files := make([]assets.File, 0, 16)
 		err = queryAll(
-			sq.Select("data", "mime").
+			sq.Select("data", "mime", "name").
 				From(table).
 				Where("board  = ?", board),
 			func(r *sql.Rows) (err error) {
-				var (
-					data []byte
-					mime string
-				)
-				err = r.Scan(&data, &mime)
+				var f assets.File
+				err = r.Scan(&f.Data, &f.Mime, &f.Name)
 				if err != nil {
 					return
 				}
-				files = append(files, assets.File{
-					Data: data,
-					Mime: mime,
-				})
+				files = append(files, f)
 				return
 			},
 		)
@@ -77,6 +71,10 @@ func loadBanners() error {
 	return loadAssets("banners", assets.Banners.Set)
 }
 
+func loadEmoji() error {
+	return loadAssets("emoji", assets.Emoji.Set)
+}
+
 func loadLoadingAnimations() error {
 	return loadAssets("loading_animations", setLoadingAnimation)
 }
@@ -103,8 +101,8 @@ func setAssets(table, board string, files []assets.File) error {
 		}
 
 		sql, _, err = sq.Insert(table).
-			Columns("board", "data", "mime").
-			Values("?", "?", "?").
+			Columns("board", "data", "mime", "name").
+			Values("?", "?", "?", "?").
 			ToSql()
 		if err != nil {
 			return
@@ -115,7 +113,7 @@ func setAssets(table, board string, files []assets.File) error {
 		}
 		for _, f := range files {
 			if f.Data != nil {
-				_, err = q.Exec(board, f.Data, f.Mime)
+				_, err = q.Exec(board, f.Data, f.Mime, f.Name)
 				if err != nil {
 					return
 				}
@@ -132,6 +130,11 @@ func SetBanners(board string, banners []assets.File) error {
 	return setAssets("banners", board, banners)
 }
 
+// SetEmoji overwrites the custom emoji of a specific board in the DB
+func SetEmoji(board string, emoji []assets.File) error {
+	return setAssets("emoji", board, emoji)
+}
+
 // SetLoadingAnimation sets the loading animation for a specific board.
 // Nil file.Data means the default animation should be used.
 func SetLoadingAnimation(board string, file assets.File) error {
@@ -52,6 +52,48 @@ func TestBanners(t *testing.T) {
 	}
 }
 
+func TestEmoji(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+
+	std := []assets.File{
+		{
+			Name: "kek",
+			Data: []byte{1, 2, 3},
+			Mime: "image/png",
+			Hash: "Uonfc331cyb83SJZevsfrA",
+		},
+	}
+
+	err := SetEmoji("a", std)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = loadEmoji()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	emoji, ok := assets.Emoji.Get("a", "kek")
+	if !ok {
+		t.Fatal("emoji not saved")
+	}
+	AssertEquals(t, emoji, std[0])
+	AssertEquals(t, assets.Emoji.Names("a"), []string{"kek"})
+
+	err = SetEmoji("a", []assets.File{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = updateAssets("emoji", assets.Emoji.Set)("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assets.Emoji.Has("a", "kek") {
+		t.Fatal("emoji not deleted")
+	}
+}
+
 func TestLoaadingAnimations(t *testing.T) {
 	assertTableClear(t, "boards")
 	writeSampleBoard(t)
@@ -0,0 +1,92 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bakape/meguca/common"
+)
+
+// Select additional file attachments of posts in order of insertion
+func selectAttachments() squirrel.SelectBuilder {
+	return sq.Select("a.post_id", "a.name", "a.spoiler", "i.*").
+		From("post_attachments as a").
+		Join("images as i on i.sha1 = a.sha1").
+		OrderBy("a.post_id", "a.position")
+}
+
+// Run an attachment query and pass each scanned attachment and the ID of its
+// post to fn
+func queryAttachments(q squirrel.SelectBuilder,
+	fn func(id uint64, img common.Image),
+) error {
+	var (
+		id   uint64
+		img  imageScanner
+		args = append([]interface{}{&id, &img.Name, &img.Spoiler},
+			img.ScanArgs()...)
+	)
+	return queryAll(q, func(r *sql.Rows) (err error) {
+		err = r.Scan(args...)
+		if err != nil {
+			return
+		}
+		fn(id, *img.Val())
+		return
+	})
+}
+
+// Inject additional file attachments into the posts of a thread
+func injectAttachments(tx *sql.Tx, t *common.Thread) error {
+	byID := make(map[uint64]*common.Post, len(t.Posts)+1)
+	byID[t.ID] = &t.Post
+	for i := range t.Posts {
+		byID[t.Posts[i].ID] = &t.Posts[i]
+	}
+
+	return queryAttachments(
+		selectAttachments().
+			Join("posts as p on p.id = a.post_id").
+			Where("p.op = ?", t.ID).
+			RunWith(tx),
+		func(id uint64, img common.Image) {
+			if p := byID[id]; p != nil { // Not in abbreviated thread otherwise
+				p.Attachments = append(p.Attachments, img)
+			}
+		},
+	)
+}
+
+// Retrieve additional file attachments of a single post.
+// tx is optional.
+func getAttachments(tx *sql.Tx, id uint64) (
+	attachments []common.Image, err error,
+) {
+	q := selectAttachments().Where("a.post_id = ?", id)
+	if tx != nil {
+		q = q.RunWith(tx)
+	}
+	err = queryAttachments(
+		q,
+		func(_ uint64, img common.Image) {
+			attachments = append(attachments, img)
+		},
+	)
+	return
+}
+
+// ImageCount returns the number of files attached to a post
+func ImageCount(id uint64) (n int, err error) {
+	err = sq.Select(
+		`(sha1 is not null)::int
+		+ (select count(*) from post_attachments a where a.post_id = p.id)`,
+	).
+		From("posts as p").
+		Where("id = ?", id).
+		QueryRow().
+		Scan(&n)
+	if err == sql.ErrNoRows {
+		err = nil
+	}
+	return
+}
@@ -154,7 +154,7 @@ func TestGetBanRecords(t *testing.T) {
 	const length = time.Hour * 20
 	std := auth.BanRecord{
 		Ban: auth.Ban{
-			IP:    "::1",
+			IP:    "::/64",
 			Board: "a",
 		},
 		ForPost: 1,
@@ -163,13 +163,13 @@ func TestGetBanRecords(t *testing.T) {
 	}
 
 	err := InTransaction(false, func(tx *sql.Tx) error {
-		return Ban(tx, std.Board, std.Reason, std.By, length, std.ForPost, common.BanPost)
+		return Ban(tx, std.Board, std.Reason, std.By, length, std.ForPost, common.BanPost, common.BanAddress)
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	ban, err := GetBanInfo(std.IP, std.Board)
+	ban, err := GetBanInfo("::1", std.Board)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"net"
+	"strings"
+)
+
+// Binary prefix trie of banned networks. IPv4 networks are stored as
+// IPv4-mapped IPv6 networks, so both address families share the same trie.
+type banTrie struct {
+	root banTrieNode
+}
+
+type banTrieNode struct {
+	children [2]*banTrieNode
+	// Boards the network ending at this node is banned on
+	boards map[string]struct{}
+}
+
+// Parse a banned network as stored in the database. Single addresses are
+// stored without a prefix length.
+func parseBanNetwork(s string) (n *net.IPNet, err error) {
+	if strings.IndexByte(s, '/') != -1 {
+		_, n, err = net.ParseCIDR(s)
+		return
+	}
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, &net.ParseError{
+			Type: "IP address",
+			Text: s,
+		}
+	}
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+	}
+	return &net.IPNet{
+		IP:   ip,
+		Mask: net.CIDRMask(len(ip)*8, len(ip)*8),
+	}, nil
+}
+
+// Returns the bit at position i of an IP
+func ipBit(ip net.IP, i int) byte {
+	return ip[i/8] >> (7 - uint(i%8)) & 1
+}
+
+// Insert a network banned on board
+func (t *banTrie) insert(n *net.IPNet, board string) {
+	ones, bits := n.Mask.Size()
+	if bits == 32 {
+		ones += 96
+	}
+	ip := n.IP.To16()
+
+	node := &t.root
+	for i := 0; i < ones; i++ {
+		b := ipBit(ip, i)
+		if node.children[b] == nil {
+			node.children[b] = &banTrieNode{}
+		}
+		node = node.children[b]
+	}
+	if node.boards == nil {
+		node.boards = make(map[string]struct{}, 1)
+	}
+	node.boards[board] = struct{}{}
+}
+
+// Returns, if ip is contained in any network banned on board or globally
+func (t *banTrie) contains(ip net.IP, board string) bool {
+	ip = ip.To16()
+	if ip == nil {
+		return false
+	}
+
+	node := &t.root
+	for i := 0; ; i++ {
+		if node.boards != nil {
+			if _, ok := node.boards[board]; ok {
+				return true
+			}
+			if _, ok := node.boards["all"]; ok {
+				return true
+			}
+		}
+		if i == len(ip)*8 {
+			return false
+		}
+		node = node.children[ipBit(ip, i)]
+		if node == nil {
+			return false
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package db
+
+import (
+	"net"
+	"testing"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestBanTrie(t *testing.T) {
+	t.Parallel()
+
+	var trie banTrie
+	for _, b := range [...]struct {
+		network, board string
+	}{
+		{"1.2.3.4", "a"},
+		{"10.0.0.0/8", "all"},
+		{"2001:db8:1:2::/64", "a"},
+		{"2001:db9::/32", "c"},
+	} {
+		n, err := parseBanNetwork(b.network)
+		if err != nil {
+			t.Fatal(err)
+		}
+		trie.insert(n, b.board)
+	}
+
+	cases := [...]struct {
+		name, ip, board string
+		banned          bool
+	}{
+		{"address", "1.2.3.4", "a", true},
+		{"address on other board", "1.2.3.4", "b", false},
+		{"next address", "1.2.3.5", "a", false},
+		{"global range", "10.20.30.40", "b", true},
+		{"outside global range", "11.0.0.1", "b", false},
+		{"IPv6 prefix", "2001:db8:1:2:aaaa::1", "a", true},
+		{"outside IPv6 prefix", "2001:db8:1:3::1", "a", false},
+		{"IPv6 range", "2001:db9:ffff::1", "c", true},
+		{"IPv4-mapped IPv6", "::ffff:10.0.0.1", "a", true},
+		{"not in IPv4 space", "::a00:1", "a", false},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			AssertEquals(t, trie.contains(net.ParseIP(c.ip), c.board), c.banned)
+		})
+	}
+}
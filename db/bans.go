@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"net"
 	"sync"
 	"time"
 
@@ -12,8 +13,8 @@ import (
 )
 
 var (
-	// board: IP: IsBanned
-	banCache = map[string]map[string]bool{}
+	// Networks banned on each board
+	banCache = &banTrie{}
 	bansMu   sync.RWMutex
 	banTypes = map[common.ModerationAction]string{
 		common.BanPost:       "classic",
@@ -21,7 +22,17 @@ var (
 	}
 )
 
+// Write a ban of an IP address or network in CIDR notation
 func writeBan(tx *sql.Tx, ip string, entry auth.ModLogEntry) (err error) {
+	err = insertBan(tx, ip, entry)
+	if err != nil {
+		return
+	}
+	return logModeration(tx, entry)
+}
+
+// Insert a ban without logging it
+func insertBan(tx *sql.Tx, ip string, entry auth.ModLogEntry) (err error) {
 	_, err = sq.Insert("bans").
 		Columns("ip", "board", "forPost", "reason", "by", "type", "expires").
 		Values(ip, entry.Board, entry.ID, entry.Data, entry.By,
@@ -29,14 +40,11 @@ func writeBan(tx *sql.Tx, ip string, entry auth.ModLogEntry) (err error) {
 			time.Now().UTC().Add(time.Second*time.Duration(entry.Length))).
 		RunWith(tx).
 		Exec()
-	if err != nil {
-		return
-	}
-
-	return logModeration(tx, entry)
+	return
 }
 
-// Propagate ban updates through DB and disconnect all banned IPs
+// Propagate ban updates through DB and disconnect all banned IPs. ip can be an
+// IP address or network in CIDR notation.
 func propagateBans(tx *sql.Tx, board string, ip string) (err error) {
 	_, err = tx.Exec(`notify bans_updated`)
 	if err != nil {
@@ -75,10 +83,11 @@ func systemBanTx(tx *sql.Tx, ip, reason string, length time.Duration,
 	return
 }
 
-// Ban IP from accessing a specific board. Need to target a post.
+// Ban IP from accessing a specific board. Need to target a post. scope
+// selects the range of IPs around the poster's IP, that is banned.
 func Ban(
 	tx *sql.Tx, board, reason, by string, length time.Duration,
-	id uint64, banType common.ModerationAction,
+	id uint64, banType common.ModerationAction, scope common.BanScope,
 ) (
 	err error,
 ) {
@@ -93,6 +102,10 @@ func Ban(
 	default:
 		return
 	}
+	ip, err = scope.Network(ip)
+	if err != nil {
+		return
+	}
 
 	// Write ban messages to posts and ban table
 	writeBan(tx, ip, auth.ModLogEntry{
@@ -117,39 +130,43 @@ func Ban(
 
 // Unban lifts a ban from a specific post on a specific board
 func Unban(board string, id uint64, by string) error {
-	return InTransaction(false, func(tx *sql.Tx) (err error) {
-		_, err = sq.Delete("bans").
-			Where("board = ? and forPost = ?", board, id).
-			RunWith(tx).
-			Exec()
+	return InTransaction(false, func(tx *sql.Tx) error {
+		return unbanTx(tx, board, id, by)
+	})
+}
+
+func unbanTx(tx *sql.Tx, board string, id uint64, by string) (err error) {
+	_, err = sq.Delete("bans").
+		Where("board = ? and forPost = ?", board, id).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return
+	}
+	exists := false
+	err = sq.Select("true").
+		From("posts").
+		Where("id = ? and board = ?", id, board).
+		QueryRow().
+		Scan(&exists)
+	if err != nil && err != sql.ErrNoRows {
+		return
+	}
+	if exists {
+		err = logModeration(tx, auth.ModLogEntry{
+			ModerationEntry: common.ModerationEntry{
+				Type: common.UnbanPost,
+				By:   by,
+			},
+			Board: board,
+			ID:    id,
+		})
 		if err != nil {
 			return
 		}
-		exists := false
-		err = sq.Select("true").
-			From("posts").
-			Where("id = ? and board = ?", id, board).
-			QueryRow().
-			Scan(&exists)
-		if err != nil && err != sql.ErrNoRows {
-			return
-		}
-		if exists {
-			err = logModeration(tx, auth.ModLogEntry{
-				ModerationEntry: common.ModerationEntry{
-					Type: common.UnbanPost,
-					By:   by,
-				},
-				Board: board,
-				ID:    id,
-			})
-			if err != nil {
-				return
-			}
-		}
-		_, err = tx.Exec("notify bans_updated")
-		return
-	})
+	}
+	_, err = tx.Exec("notify bans_updated")
+	return
 }
 
 func loadBans() error {
@@ -186,14 +203,13 @@ func RefreshBanCache() (err error) {
 		return
 	}
 
-	new := map[string]map[string]bool{}
+	new := &banTrie{}
 	for _, b := range bans {
-		board, ok := new[b.Board]
-		if !ok {
-			board = map[string]bool{}
-			new[b.Board] = board
+		n, err := parseBanNetwork(b.IP)
+		if err != nil {
+			return err
 		}
-		board[b.IP] = true
+		new.insert(n, b.Board)
 	}
 
 	bansMu.Lock()
@@ -208,14 +224,13 @@ func RefreshBanCache() (err error) {
 func IsBanned(board, ip string) (bool, error) {
 	bansMu.RLock()
 	defer bansMu.RUnlock()
-	global := banCache["all"]
-	ips := banCache[board]
 	isGlobal := false
 
-	if (global != nil && global[ip]) || (ips != nil && ips[ip]) {
+	if parsed := net.ParseIP(ip); parsed != nil &&
+		banCache.contains(parsed, board) {
 		// Need to assert ban has not expired and cache is invalid
 
-		r, err := selectBans("board").Where("ip = ?", ip).Query()
+		r, err := selectBans("board").Where("ip >>= ?::inet", ip).Query()
 		if err != nil {
 			return isGlobal, err
 		}
@@ -264,16 +279,18 @@ func IsBanned(board, ip string) (bool, error) {
 	return isGlobal, nil
 }
 
-// GetBanInfo retrieves information about a specific ban
+// GetBanInfo retrieves information about the most specific ban of a network
+// containing ip
 func GetBanInfo(ip, board string) (b auth.BanRecord, err error) {
 	err = sq.Select("ip", "board", "forPost", "reason", "by", "expires").
 		From("bans").
 		Where(
 			`expires >= now() at time zone 'utc'
-					and ip = ?
+					and ip >>= ?::inet
 					and board = ?
 					and type = 'classic'`,
 			ip, board).
+		OrderBy("masklen(ip) desc").
 		QueryRow().
 		Scan(&b.IP, &b.Board, &b.ForPost, &b.Reason, &b.By, &b.Expires)
 	return
@@ -14,7 +14,8 @@ func TestBanUnban(t *testing.T) {
 	prepareForModeration(t)
 
 	err := InTransaction(false, func(tx *sql.Tx) error {
-		return Ban(tx, "all", "test", "admin", time.Minute, 1, common.BanPost)
+		return Ban(tx, "all", "test", "admin", time.Minute, 1, common.BanPost,
+			common.BanAddress)
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -36,6 +37,49 @@ func TestBanUnban(t *testing.T) {
 	}
 }
 
+func TestRangeBan(t *testing.T) {
+	prepareForModeration(t)
+
+	err := InTransaction(false, func(tx *sql.Tx) error {
+		return Ban(tx, "a", "test", "admin", time.Minute, 1, common.BanPost,
+			common.BanSubnet)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = RefreshBanCache()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := [...]struct {
+		name, ip string
+		banned   bool
+	}{
+		{"poster", "::1", true},
+		{"same subnet", "::ab:cd:ef:1", true},
+		{"different subnet", "2001:db8::1", false},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			_, err := IsBanned("a", c.ip)
+			if c.banned {
+				AssertEquals(t, err, common.ErrBanned)
+			} else if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+
+	rec, err := GetBanInfo("::ab:cd:ef:1", "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, rec.IP, "::/48")
+}
+
 func TestShadowBin(t *testing.T) {
 	assertTableClear(t, "accounts", "bans", "mod_log", "boards")
 
@@ -95,7 +139,8 @@ func TestShadowBin(t *testing.T) {
 	}
 
 	err = InTransaction(false, func(tx *sql.Tx) error {
-		return Ban(tx, "a", "", "user1", time.Hour, 1, common.ShadowBinPost)
+		return Ban(tx, "a", "", "user1", time.Hour, 1, common.ShadowBinPost,
+			common.BanAddress)
 	})
 	if err != nil {
 		t.Fatal(err)
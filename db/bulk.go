@@ -0,0 +1,286 @@
+package db
+
+import (
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+)
+
+// Maximum number of moderation log entry IDs in one post_moderated
+// notification. Notification payloads are limited to 8000 bytes.
+const maxNotifiedEntries = 256
+
+var (
+	// ErrNoBulkFilter is returned, when a bulk moderation request would
+	// match all posts on a board
+	ErrNoBulkFilter = common.ErrInvalidInput("no bulk moderation filter")
+
+	// ErrNoFile is returned, when matching posts by the file of a post
+	// without one
+	ErrNoFile = common.ErrInvalidInput("post has no file")
+
+	// ErrInvalidBulkAction is returned for moderation actions, that can not
+	// be applied in bulk
+	ErrInvalidBulkAction = common.ErrInvalidInput("invalid bulk action")
+)
+
+// BulkFilter selects posts on the board of an anchor post by their relation to
+// it. At least one of SameIP, SameThread and SameFile must be set.
+type BulkFilter struct {
+	ID                           uint64
+	SameIP, SameThread, SameFile bool
+	// Post creation time range [From, To). Zero values are unbounded.
+	From, To time.Time
+}
+
+// BulkModerate deletes, purges, spoilers or shadow bins all posts matching
+// filter in one transaction and returns the number of affected posts. Each
+// post gets a single moderation log entry and any reports of it are resolved.
+// reason applies to purges and shadow bins. length and scope apply to shadow
+// bins only.
+func BulkModerate(f BulkFilter, action common.ModerationAction,
+	by, reason string, length time.Duration, scope common.BanScope,
+) (n int, err error) {
+	switch action {
+	case common.DeletePost, common.SpoilerImage, common.PurgePost,
+		common.ShadowBinPost:
+	default:
+		return 0, ErrInvalidBulkAction
+	}
+	if !f.SameIP && !f.SameThread && !f.SameFile {
+		return 0, ErrNoBulkFilter
+	}
+
+	err = InTransaction(false, func(tx *sql.Tx) (err error) {
+		type post struct {
+			id uint64
+			ip sql.NullString
+		}
+
+		var (
+			board    string
+			op       uint64
+			ip, sha1 sql.NullString
+		)
+		err = sq.Select("board", "op", "ip", "sha1").
+			From("posts").
+			Where("id = ?", f.ID).
+			RunWith(tx).
+			QueryRow().
+			Scan(&board, &op, &ip, &sha1)
+		if err != nil {
+			return
+		}
+
+		q := sq.Select("p.id", "p.ip").
+			From("posts as p").
+			Where("p.board = ?", board).
+			OrderBy("p.id").
+			RunWith(tx)
+		if f.SameIP {
+			if !ip.Valid {
+				return ErrPosterIPUnknown
+			}
+			q = q.Where("p.ip = ?", ip.String)
+		}
+		if f.SameThread {
+			q = q.Where("p.op = ?", op)
+		}
+		if f.SameFile {
+			if !sha1.Valid {
+				return ErrNoFile
+			}
+			q = q.Where(
+				`(p.sha1 = ?
+					or exists (select
+								from post_attachments as a
+								where a.post_id = p.id and a.sha1 = ?))`,
+				sha1.String, sha1.String,
+			)
+		}
+		if !f.From.IsZero() {
+			q = q.Where("p.time >= ?", f.From.Unix())
+		}
+		if !f.To.IsZero() {
+			q = q.Where("p.time < ?", f.To.Unix())
+		}
+		switch action {
+		case common.DeletePost:
+			q = q.Where("not is_deleted(p.id)")
+		case common.SpoilerImage:
+			q = q.Where(
+				`(p.sha1 is not null
+					or exists (select
+								from post_attachments as a
+								where a.post_id = p.id))`,
+			)
+		}
+
+		var (
+			posts = make([]post, 0, 64)
+			ids   = make([]uint64, 0, 64)
+		)
+		err = queryAll(q, func(r *sql.Rows) (err error) {
+			var p post
+			err = r.Scan(&p.id, &p.ip)
+			if err != nil {
+				return
+			}
+			posts = append(posts, p)
+			ids = append(ids, p.id)
+			return
+		})
+		if err != nil || len(posts) == 0 {
+			return
+		}
+		n = len(posts)
+
+		// Feeds are notified in batches below
+		_, err = tx.Exec(`set local meguca.bulk_moderation = 'on'`)
+		if err != nil {
+			return
+		}
+
+		entry := common.ModerationEntry{
+			Type: action,
+			By:   by,
+		}
+		switch action {
+		case common.SpoilerImage:
+			for _, t := range [...]struct{ table, key string }{
+				{"posts", "id"},
+				{"post_attachments", "post_id"},
+			} {
+				_, err = sq.Update(t.table).
+					Set("spoiler", true).
+					Where(squirrel.Eq{t.key: ids}).
+					RunWith(tx).
+					Exec()
+				if err != nil {
+					return
+				}
+			}
+		case common.ShadowBinPost:
+			entry.Length = uint64(length / time.Second)
+			entry.Data = reason
+
+			// One ban per distinct network
+			banned := make(map[string]struct{}, 1)
+			for _, p := range posts {
+				if !p.ip.Valid {
+					continue
+				}
+				var network string
+				network, err = scope.Network(p.ip.String)
+				if err != nil {
+					return
+				}
+				if _, ok := banned[network]; ok {
+					continue
+				}
+				banned[network] = struct{}{}
+				err = insertBan(tx, network, auth.ModLogEntry{
+					ModerationEntry: entry,
+					Board:           board,
+					ID:              p.id,
+				})
+				if err != nil {
+					return
+				}
+			}
+		}
+
+		for _, p := range posts {
+			if action == common.PurgePost {
+				// Also deletes the files and logs the purge
+				err = PurgePost(tx, p.id, by, reason, false)
+			} else {
+				err = logModeration(tx, auth.ModLogEntry{
+					ModerationEntry: entry,
+					Board:           board,
+					ID:              p.id,
+				})
+			}
+			if err != nil {
+				return
+			}
+			err = ResolvePostReports(tx, p.id, by)
+			if err != nil {
+				return
+			}
+		}
+
+		return notifyBulkModeration(tx, ids, action, by)
+	})
+	return
+}
+
+// Notify feeds of moderation log entries created in the current transaction
+// by a bulk moderation with one notification per thread and batch of entries
+func notifyBulkModeration(tx *sql.Tx, ids []uint64,
+	action common.ModerationAction, by string,
+) (err error) {
+	var (
+		op, id  uint64
+		entries = make(map[uint64][]uint64)
+		ops     = make([]uint64, 0, 4)
+	)
+	err = queryAll(
+		sq.Select("p.op", "ml.id").
+			From("mod_log as ml").
+			Join("posts as p on p.id = ml.post_id").
+			Where(squirrel.Eq{
+				"ml.post_id": ids,
+				"ml.type":    action,
+				"ml.by":      by,
+			}).
+			// Transaction start time
+			Where("ml.created = now() at time zone 'utc'").
+			OrderBy("ml.id").
+			RunWith(tx),
+		func(r *sql.Rows) (err error) {
+			err = r.Scan(&op, &id)
+			if err != nil {
+				return
+			}
+			if _, ok := entries[op]; !ok {
+				ops = append(ops, op)
+			}
+			entries[op] = append(entries[op], id)
+			return
+		},
+	)
+	if err != nil {
+		return
+	}
+
+	var w strings.Builder
+	for _, op := range ops {
+		ids := entries[op]
+		for len(ids) != 0 {
+			batch := ids
+			if len(batch) > maxNotifiedEntries {
+				batch = batch[:maxNotifiedEntries]
+			}
+			ids = ids[len(batch):]
+
+			w.Reset()
+			w.WriteString(strconv.FormatUint(op, 10))
+			for _, id := range batch {
+				w.WriteByte(',')
+				w.WriteString(strconv.FormatUint(id, 10))
+			}
+			_, err = tx.Exec(`select pg_notify('post_moderated', $1)`,
+				w.String())
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,131 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestBulkModerate(t *testing.T) {
+	prepareForModeration(t)
+
+	hourAgo := time.Now().Add(-time.Hour)
+	for _, p := range [...]struct {
+		id   uint64
+		ip   string
+		time time.Time
+	}{
+		{2, "::1", time.Now()},
+		{3, "::2", time.Now()},
+		{4, "::1", hourAgo.Add(-time.Minute)},
+	} {
+		err := InTransaction(false, func(tx *sql.Tx) error {
+			return InsertPost(tx, &Post{
+				StandalonePost: common.StandalonePost{
+					Post: common.Post{
+						ID:   p.id,
+						Time: p.time.Unix(),
+					},
+					OP:    1,
+					Board: "a",
+				},
+				IP: p.ip,
+			})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assertDeleted := func(t *testing.T, std map[uint64]bool) {
+		t.Helper()
+		for id, deleted := range std {
+			var res bool
+			err := sqlDB.QueryRow(`select is_deleted($1)`, id).Scan(&res)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res != deleted {
+				t.Errorf("post %d: deleted=%t", id, res)
+			}
+		}
+	}
+
+	t.Run("no filter", func(t *testing.T) {
+		_, err := BulkModerate(BulkFilter{ID: 1}, common.DeletePost, "admin",
+			"", 0, 0)
+		AssertEquals(t, err, ErrNoBulkFilter)
+	})
+
+	t.Run("invalid action", func(t *testing.T) {
+		_, err := BulkModerate(BulkFilter{ID: 1, SameIP: true},
+			common.BanPost, "admin", "", 0, 0)
+		AssertEquals(t, err, ErrInvalidBulkAction)
+	})
+
+	t.Run("time range", func(t *testing.T) {
+		n, err := BulkModerate(
+			BulkFilter{
+				ID:     1,
+				SameIP: true,
+				From:   hourAgo,
+			},
+			common.DeletePost, "admin", "", 0, 0,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, n, 2)
+		assertDeleted(t, map[uint64]bool{
+			1: true,
+			2: true,
+			3: false,
+			4: false,
+		})
+	})
+
+	t.Run("already deleted", func(t *testing.T) {
+		n, err := BulkModerate(BulkFilter{ID: 1, SameIP: true},
+			common.DeletePost, "admin", "", 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, n, 1)
+		assertDeleted(t, map[uint64]bool{
+			3: false,
+			4: true,
+		})
+	})
+
+	t.Run("mod log", func(t *testing.T) {
+		log, err := GetModLog(auth.ModLogFilter{
+			Types: []common.ModerationAction{common.DeletePost},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// A single entry per post
+		AssertEquals(t, len(log), 3)
+	})
+
+	t.Run("shadow bin thread", func(t *testing.T) {
+		n, err := BulkModerate(BulkFilter{ID: 3, SameThread: true},
+			common.ShadowBinPost, "admin", "spam", time.Hour, common.BanAddress)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, n, 4)
+
+		// ::1 and ::2 are in the same /64 network, so only one ban is written
+		var bans int
+		err = sqlDB.QueryRow(`select count(*) from bans`).Scan(&bans)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, bans, 1)
+	})
+}
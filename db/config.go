@@ -8,9 +8,9 @@ import (
 	"github.com/Masterminds/squirrel"
 	"github.com/bakape/meguca/assets"
 	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
 	mlog "github.com/bakape/meguca/log"
-	"github.com/bakape/meguca/templates"
 	"github.com/bakape/meguca/util"
 	"github.com/lib/pq"
 )
@@ -65,6 +65,18 @@ func getBoardConfigs() squirrel.SelectBuilder {
 		"rules",
 		"eightball",
 		"randomNameHours",
+		"disabledCommands",
+		"reactions",
+		"serveRenditions",
+		"stripMetadata",
+		"maxAttachments",
+		"slowMode",
+		"bumpLimit",
+		"imageLimit",
+		"preModThreads",
+		"preModReplies",
+		"preModImages",
+		"newPosterPosts",
 	).
 		From("boards")
 }
@@ -86,7 +98,7 @@ func loadBoardConfigs() (err error) {
 }
 
 func scanBoardConfigs(r rowScanner) (c config.BoardConfigs, err error) {
-	var eightball pq.StringArray
+	var eightball, disabledCommands, reactions pq.StringArray
 	err = r.Scan(
 		&c.ReadOnly,
 		&c.TextOnly,
@@ -103,11 +115,41 @@ func scanBoardConfigs(r rowScanner) (c config.BoardConfigs, err error) {
 		&c.Rules,
 		&eightball,
 		&c.RandomNameHours,
+		&disabledCommands,
+		&reactions,
+		&c.ServeRenditions,
+		&c.StripMetadata,
+		&c.MaxAttachments,
+		&c.SlowMode,
+		&c.BumpLimit,
+		&c.ImageLimit,
+		&c.PreModThreads,
+		&c.PreModReplies,
+		&c.PreModImages,
+		&c.NewPosterPosts,
 	)
 	c.Eightball = []string(eightball)
+	c.DisabledCommands = nilIfEmpty(disabledCommands)
+	c.Reactions = nilIfEmpty(reactions)
 	return
 }
 
+// Decode an optional array, normalizing empty arrays to nil
+func nilIfEmpty(arr pq.StringArray) []string {
+	if len(arr) == 0 {
+		return nil
+	}
+	return []string(arr)
+}
+
+// Encode a string slice as a non-null array
+func nonNullArray(arr []string) pq.StringArray {
+	if arr == nil {
+		return pq.StringArray{}
+	}
+	return pq.StringArray(arr)
+}
+
 // WriteBoard writes a board complete with configurations to the database
 func WriteBoard(tx *sql.Tx, c BoardConfigs) error {
 	_, err := sq.Insert("boards").
@@ -128,6 +170,18 @@ func WriteBoard(tx *sql.Tx, c BoardConfigs) error {
 			"rules",
 			"eightball",
 			"randomNameHours",
+			"disabledCommands",
+			"reactions",
+			"serveRenditions",
+			"stripMetadata",
+			"maxAttachments",
+			"slowMode",
+			"bumpLimit",
+			"imageLimit",
+			"preModThreads",
+			"preModReplies",
+			"preModImages",
+			"newPosterPosts",
 		).
 		Values(
 			c.ID,
@@ -146,6 +200,18 @@ func WriteBoard(tx *sql.Tx, c BoardConfigs) error {
 			c.Rules,
 			pq.StringArray(c.Eightball),
 			c.RandomNameHours,
+			nonNullArray(c.DisabledCommands),
+			nonNullArray(c.Reactions),
+			c.ServeRenditions,
+			c.StripMetadata,
+			c.MaxAttachments,
+			c.SlowMode,
+			c.BumpLimit,
+			c.ImageLimit,
+			c.PreModThreads,
+			c.PreModReplies,
+			c.PreModImages,
+			c.NewPosterPosts,
 		).
 		RunWith(tx).
 		Exec()
@@ -156,20 +222,32 @@ func WriteBoard(tx *sql.Tx, c BoardConfigs) error {
 func UpdateBoard(c config.BoardConfigs) (err error) {
 	_, err = sq.Update("boards").
 		SetMap(map[string]interface{}{
-			"readOnly":        c.ReadOnly,
-			"textOnly":        c.TextOnly,
-			"forcedAnon":      c.ForcedAnon,
-			"disableRobots":   c.DisableRobots,
-			"flags":           c.Flags,
-			"NSFW":            c.NSFW,
-			"rbText":          c.RbText,
-			"pyu":             c.Pyu,
-			"defaultCSS":      c.DefaultCSS,
-			"title":           c.Title,
-			"notice":          c.Notice,
-			"rules":           c.Rules,
-			"eightball":       pq.StringArray(c.Eightball),
-			"randomNameHours": c.RandomNameHours,
+			"readOnly":         c.ReadOnly,
+			"textOnly":         c.TextOnly,
+			"forcedAnon":       c.ForcedAnon,
+			"disableRobots":    c.DisableRobots,
+			"flags":            c.Flags,
+			"NSFW":             c.NSFW,
+			"rbText":           c.RbText,
+			"pyu":              c.Pyu,
+			"defaultCSS":       c.DefaultCSS,
+			"title":            c.Title,
+			"notice":           c.Notice,
+			"rules":            c.Rules,
+			"eightball":        pq.StringArray(c.Eightball),
+			"randomNameHours":  c.RandomNameHours,
+			"disabledCommands": nonNullArray(c.DisabledCommands),
+			"reactions":        nonNullArray(c.Reactions),
+			"serveRenditions":  c.ServeRenditions,
+			"stripMetadata":    c.StripMetadata,
+			"maxAttachments":   c.MaxAttachments,
+			"slowMode":         c.SlowMode,
+			"bumpLimit":        c.BumpLimit,
+			"imageLimit":       c.ImageLimit,
+			"preModThreads":    c.PreModThreads,
+			"preModReplies":    c.PreModReplies,
+			"preModImages":     c.PreModImages,
+			"newPosterPosts":   c.NewPosterPosts,
 		}).
 		Where("id = ?", c.ID).
 		Exec()
@@ -184,7 +262,7 @@ func updateConfigs(_ string) error {
 	config.Set(conf)
 	mlog.Update()
 
-	return util.Parallel(templates.Recompile, auth.LoadCaptchaServices)
+	return util.Parallel(common.Recompile, auth.LoadCaptchaServices)
 }
 
 func updateBoardConfigs(board string) error {
@@ -193,7 +271,7 @@ func updateBoardConfigs(board string) error {
 	case nil:
 	case sql.ErrNoRows:
 		config.RemoveBoard(board)
-		return templates.Recompile()
+		return common.Recompile()
 	default:
 		return err
 	}
@@ -206,7 +284,7 @@ func updateBoardConfigs(board string) error {
 	case err != nil:
 		return util.WrapError("reloading board configuration", err)
 	case changed:
-		return util.Parallel(templates.Recompile, auth.LoadCaptchaServices)
+		return util.Parallel(common.Recompile, auth.LoadCaptchaServices)
 	default:
 		return nil
 	}
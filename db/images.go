@@ -2,12 +2,15 @@ package db
 
 import (
 	"database/sql"
+	"encoding/json"
 	"io"
 	"time"
 
 	"github.com/bakape/meguca/auth"
 	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/imager/assets"
+	"github.com/bakape/meguca/imager/metadata"
 	"github.com/bakape/meguca/util"
 	"github.com/lib/pq"
 )
@@ -22,6 +25,20 @@ var (
 	// non-existent token. The token might have expired (60 to 119 seconds) or
 	// the client could have provided an invalid token to begin with.
 	ErrInvalidToken = common.ErrInvalidInput("invalid image token")
+
+	// ErrImageLimit occurs, when inserting an image into a thread, that has
+	// reached the image limit of its board
+	ErrImageLimit = common.ErrInvalidInput("image limit reached")
+
+	// ErrAttachmentLimit occurs, when inserting an image into a post, that
+	// already has the maximum number of attachments of its board
+	ErrAttachmentLimit = common.ErrInvalidInput("attachment limit reached")
+
+	// ErrMetadataNotStripped occurs, when inserting a file, that did not have
+	// its metadata stripped, into a post on a board, that requires it
+	ErrMetadataNotStripped = common.ErrInvalidInput(
+		"file metadata not stripped")
+
 	insertImageStmt *sql.Stmt
 )
 
@@ -37,7 +54,9 @@ func prepareInsertImageStmt() (err error) {
         select insert_image($1::bigint,
                              $2::char(86),
                              $3::varchar(200),
-                             $4::bool)
+                             $4::bool,
+                             $5::bool,
+                             $6::smallint[])
     `)
 	return
 }
@@ -54,16 +73,33 @@ func writeImageTx(tx *sql.Tx, i common.ImageCommon) (err error) {
 	if codec == "" {
 		codec = nil
 	}
+	var original interface{} = i.OriginalSHA1
+	if original == "" {
+		original = nil
+	}
+	var peaks interface{}
+	if i.Peaks != nil {
+		arr := make(pq.Int64Array, len(i.Peaks))
+		for j, p := range i.Peaks {
+			arr[j] = int64(p)
+		}
+		peaks = arr
+	}
+	thumbs, err := encodeThumbs(i.Thumbs)
+	if err != nil {
+		return
+	}
 	_, err = sq.
 		Insert("images").
 		Columns(
 			"audio", "video", "file_type", "thumb_type", "dims", "length",
-			"size", "MD5", "SHA1", "Title", "Artist", "Codec",
+			"size", "MD5", "SHA1", "Title", "Artist", "Codec", "original_sha1",
+			"peaks", "thumbs", "thumb_avif",
 		).
 		Values(
 			i.Audio, i.Video, int(i.FileType), int(i.ThumbType),
 			pq.GenericArray{A: i.Dims}, i.Length, i.Size, i.MD5, i.SHA1,
-			i.Title, i.Artist, codec,
+			i.Title, i.Artist, codec, original, peaks, thumbs, i.ThumbAVIF,
 		).
 		RunWith(tx).
 		Exec()
@@ -104,12 +140,53 @@ func ImageExists(tx *sql.Tx, sha1 string) (exists bool, err error) {
 	err = sq.Select("1").
 		From("images").
 		Where("sha1 = ?", sha1).
+		RunWith(tx).
 		Scan(&exists)
 	if err == sql.ErrNoRows {
 		err = nil
 	}
 	return
 }
+
+// FindImage returns the SHA1 hash of a stored image, that either has the
+// passed SHA1 hash or had its metadata stripped from a file with it. If
+// stripped is true, only images with stripped metadata are matched. Returns
+// an empty string, if none found.
+func FindImage(tx *sql.Tx, SHA1 string, stripped bool) (
+	stored string, err error,
+) {
+	q := sq.Select("sha1").
+		From("images").
+		OrderByClause("sha1 = ? desc", SHA1).
+		Limit(1).
+		RunWith(tx)
+	if stripped {
+		// Also matches reuploads of already stripped files
+		q = q.Where(
+			"original_sha1 = ? or (sha1 = ? and original_sha1 is not null)",
+			SHA1, SHA1)
+	} else {
+		q = q.Where("sha1 = ? or original_sha1 = ?", SHA1, SHA1)
+	}
+	err = q.QueryRow().Scan(&stored)
+	if err == sql.ErrNoRows {
+		err = nil
+	}
+	return
+}
+
+// MarkImageStripped records, that a stored image, that was not stripped of
+// metadata on upload, is the stripped rendition of the file with the
+// original SHA1 hash
+func MarkImageStripped(tx *sql.Tx, SHA1, original string) (err error) {
+	_, err = sq.Update("images").
+		Set("original_sha1", original).
+		Where("sha1 = ? and original_sha1 is null", SHA1).
+		RunWith(tx).
+		Exec()
+	return
+}
+
 func GetImageFilename(sha1 string) (exists string, err error) {
 	err = sq.Select("imagename").
 		From("posts").
@@ -154,13 +231,92 @@ func AllocateImage(tx *sql.Tx, src, thumb io.ReadSeeker, img common.ImageCommon,
 
 // Delete any dangling image files in case of a failed image allocation
 func cleanUpFailedAllocation(img common.ImageCommon, err error) error {
-	delErr := assets.Delete(img.SHA1, img.FileType, img.ThumbType)
+	delErr := assets.Delete(img)
 	if delErr != nil {
 		err = util.WrapError(err.Error(), delErr)
 	}
 	return err
 }
 
+// SetImageRendition records the file type of the transcoded rendition of an
+// image
+func SetImageRendition(SHA1 string, fileType uint8) error {
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		_, err = sq.Update("images").
+			Set("rendition", int(fileType)).
+			Where("sha1 = ?", SHA1).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		return bumpImageThreads(tx, SHA1)
+	})
+}
+
+// SetImageThumbs records the dimensions of the additional thumbnails of an
+// image and, if they are also available in AVIF format
+func SetImageThumbs(SHA1 string, thumbs map[uint16][2]uint16, avif bool,
+) error {
+	enc, err := encodeThumbs(thumbs)
+	if err != nil {
+		return err
+	}
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		_, err = sq.Update("images").
+			Set("thumbs", enc).
+			Set("thumb_avif", avif).
+			Where("sha1 = ?", SHA1).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		return bumpImageThreads(tx, SHA1)
+	})
+}
+
+// Invalidate caches of threads containing an image, either as the main image
+// of a post or as an attachment
+func bumpImageThreads(tx *sql.Tx, SHA1 string) (err error) {
+	_, err = tx.Exec(
+		`select bump_thread(op)
+		from (
+			select op
+			from posts
+			where sha1 = $1
+			union
+			select p.op
+			from post_attachments as a
+			join posts as p on p.id = a.post_id
+			where a.sha1 = $1
+		) as t`,
+		SHA1,
+	)
+	return
+}
+
+// Encode the dimensions of additional thumbnails for storage as JSON
+func encodeThumbs(thumbs map[uint16][2]uint16) (interface{}, error) {
+	if thumbs == nil {
+		return nil, nil
+	}
+	buf, err := json.Marshal(thumbs)
+	if err != nil {
+		return nil, err
+	}
+	return string(buf), nil
+}
+
+// Decode the dimensions of additional thumbnails stored as JSON
+func decodeThumbs(buf []byte) (thumbs map[uint16][2]uint16) {
+	if len(buf) != 0 {
+		// Only ever written by encodeThumbs, so can not be malformed
+		json.Unmarshal(buf, &thumbs)
+	}
+	return
+}
+
 // HasImage returns, if the post has an image allocated. Only used in tests.
 func HasImage(id uint64) (has bool, err error) {
 	err = sq.Select("true").
@@ -180,15 +336,34 @@ func BumpThread(tx *sql.Tx, thread uint64) error {
 }
 
 // InsertImage insert an image into an existing open post and return image
-// JSON
+// JSON. Files with unstripped metadata are rejected, if the post's board or
+// the global configuration requires stripping. Files new to the post's board
+// must fit into its storage quota.
 func InsertImage(tx *sql.Tx, postID uint64, token, name string, spoiler bool,
 ) (
 	json []byte, err error,
 ) {
+	// Only files of these types can have their metadata stripped
+	strippable := make(pq.Int64Array, len(metadata.FileTypes))
+	for i, t := range metadata.FileTypes {
+		strippable[i] = int64(t)
+	}
+
 	stmt := tx.Stmt(insertImageStmt)
-	err = stmt.QueryRow(postID, token, name, spoiler).Scan(&json)
-	if extractException(err) == "invalid image token" {
+	err = stmt.QueryRow(postID, token, name, spoiler,
+		config.Get().StripMetadata, strippable).
+		Scan(&json)
+	switch extractException(err) {
+	case "invalid image token":
 		err = ErrInvalidToken
+	case "image limit reached":
+		err = ErrImageLimit
+	case "attachment limit reached":
+		err = ErrAttachmentLimit
+	case "metadata not stripped":
+		err = ErrMetadataNotStripped
+	case "storage quota exceeded":
+		err = ErrStorageQuota
 	}
 	return
 }
@@ -228,19 +403,31 @@ func GetImageByPost(id uint64) (img common.ImageCommon, err error) {
 	return scanner.Val().ImageCommon, nil
 }
 
-// SpoilerImage spoilers an already allocated image
+// SpoilerImage spoilers already allocated images of a post
 func SpoilerImage(id, op uint64) error {
-	_, err := sq.Update("posts").
-		Set("spoiler", true).
-		Where("id = ?", id).
-		Exec()
-	return err
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		_, err = sq.Update("posts").
+			Set("spoiler", true).
+			Where("id = ?", id).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		_, err = sq.Update("post_attachments").
+			Set("spoiler", true).
+			Where("post_id = ?", id).
+			RunWith(tx).
+			Exec()
+		return
+	})
 }
 
-// Try to transfer image from one post to another. Return image, if anything was
-// transferred
+// Try to transfer image and any additional attachments from one post to
+// another. Return image and attachments, if anything was transferred.
 func TransferImage(fromPost, toPost, thread uint64) (
 	transferred *common.Image,
+	attachments []common.Image,
 	err error,
 ) {
 	err = InTransaction(false, func(tx *sql.Tx) (err error) {
@@ -312,11 +499,33 @@ func TransferImage(fromPost, toPost, thread uint64) (
 			fromPost,
 			thread,
 		)
+		if err != nil {
+			return
+		}
+
+		// Replace any attachments of the target post
+		_, err = sq.Delete("post_attachments").
+			Where("post_id = ?", toPost).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		_, err = sq.Update("post_attachments").
+			Set("post_id", toPost).
+			Where("post_id = ?", fromPost).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		attachments, err = getAttachments(tx, toPost)
 		return
 	})
 	if err == sql.ErrNoRows {
 		err = nil
 		transferred = nil
+		attachments = nil
 	}
 	return
 }
@@ -365,14 +574,16 @@ func deleteUnusedImages() (err error) {
 
 	for r.Next() {
 		var (
-			sha1                string
-			fileType, thumbType uint8
+			img    common.ImageCommon
+			thumbs []byte
 		)
-		err = r.Scan(&sha1, &fileType, &thumbType)
+		err = r.Scan(&img.SHA1, &img.FileType, &img.ThumbType, &thumbs,
+			&img.ThumbAVIF)
 		if err != nil {
 			return
 		}
-		err = assets.Delete(sha1, fileType, thumbType)
+		img.Thumbs = decodeThumbs(thumbs)
+		err = assets.Delete(img)
 		if err != nil {
 			return
 		}
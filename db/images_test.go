@@ -108,9 +108,7 @@ func TestAllocateImage(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		if img != std {
-			test.LogUnexpected(t, std, img)
-		}
+		test.AssertEquals(t, img, std)
 	})
 
 	t.Run("get image", func(t *testing.T) {
@@ -214,6 +212,38 @@ func TestInsertImage(t *testing.T) {
 	}
 }
 
+func TestInsertImageStripMetadata(t *testing.T) {
+	assertTableClear(t, "images", "boards")
+	prepareThreads(t)
+	assertExec(t, `update boards
+		set stripMetadata = true, maxAttachments = 2
+		where id = 'a'`)
+
+	insert := func(SHA1 string) error {
+		token := newImageToken(t, SHA1)
+		return InTransaction(false, func(tx *sql.Tx) (err error) {
+			_, err = InsertImage(tx, 3, token, "foo", false)
+			return
+		})
+	}
+
+	// Files without strippable metadata are accepted as is
+	gif := assets.StdJPEG.ImageCommon
+	gif.SHA1 = test.GenString(40)
+	gif.FileType = common.GIF
+	err := WriteImage(gif)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = insert(gif.SHA1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = insert(assets.StdJPEG.SHA1)
+	test.AssertEquals(t, err, ErrMetadataNotStripped)
+}
+
 func insertSampleImage(t *testing.T) {
 	t.Helper()
 
@@ -247,6 +277,79 @@ func TestSpoilerImage(t *testing.T) {
 	test.AssertEquals(t, post.Image.Spoiler, true)
 }
 
+func TestAttachments(t *testing.T) {
+	assertTableClear(t, "images", "boards")
+	writeSampleImage(t)
+	writeSampleBoard(t)
+	writeSampleThread(t)
+	insertSampleImage(t)
+	assertExec(t, `update boards set maxAttachments = 2 where id = 'a'`)
+
+	std := assets.StdJPEG
+	std.SHA1 = test.GenString(40)
+	std.Name = "second"
+	err := WriteImage(std.ImageCommon)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Posts with an image get any further ones appended as attachments
+	token := newImageToken(t, std.SHA1)
+	var buf []byte
+	err = InTransaction(false, func(tx *sql.Tx) (err error) {
+		buf, err = InsertImage(tx, 1, token, std.Name, std.Spoiler)
+		return
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var res struct {
+		Position int `json:"position"`
+	}
+	err = json.Unmarshal(buf, &res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, res.Position, 1)
+
+	n, err := ImageCount(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, n, 2)
+
+	post, err := GetPost(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, post.Image.SHA1, assets.StdJPEG.SHA1)
+	test.AssertEquals(t, post.Attachments, []common.Image{std})
+
+	thread, err := GetThread(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, thread.Attachments, []common.Image{std})
+
+	err = SpoilerImage(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	post, err = GetPost(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, post.Attachments[0].Spoiler, true)
+
+	// The attachment limit of the board is reached
+	token = newImageToken(t, std.SHA1)
+	err = InTransaction(false, func(tx *sql.Tx) (err error) {
+		_, err = InsertImage(tx, 1, token, std.Name, std.Spoiler)
+		return
+	})
+	test.AssertEquals(t, err, ErrAttachmentLimit)
+}
+
 func TestVideoPlaylist(t *testing.T) {
 	std := assets.StdJPEG
 	std.FileType = common.WEBM
@@ -307,3 +410,149 @@ func TestImageExists(t *testing.T) {
 	}
 	test.AssertEquals(t, exists, true)
 }
+
+func TestFindImage(t *testing.T) {
+	assertTableClear(t, "images")
+
+	var (
+		original = test.GenString(40)
+		plain    = assets.StdJPEG.ImageCommon
+		stripped = assets.StdJPEG.ImageCommon
+	)
+	plain.SHA1 = original
+	stripped.SHA1 = test.GenString(40)
+	stripped.OriginalSHA1 = original
+
+	find := func(SHA1 string, strip bool) (stored string) {
+		t.Helper()
+		err := InTransaction(false, func(tx *sql.Tx) (err error) {
+			stored, err = FindImage(tx, SHA1, strip)
+			return
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	test.AssertEquals(t, find(original, false), "")
+
+	err := WriteImage(stripped)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, find(original, false), stripped.SHA1)
+	test.AssertEquals(t, find(original, true), stripped.SHA1)
+	test.AssertEquals(t, find(stripped.SHA1, false), stripped.SHA1)
+	test.AssertEquals(t, find(stripped.SHA1, true), stripped.SHA1)
+
+	// Exact matches are preferred, unless stripping is requested
+	err = WriteImage(plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, find(original, false), original)
+	test.AssertEquals(t, find(original, true), stripped.SHA1)
+
+	img, err := GetImage(stripped.SHA1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, img.OriginalSHA1, original)
+
+	// Unstripped files are only matched in strip mode after being marked as
+	// the stripped rendition of another file
+	other := test.GenString(40)
+	err = InTransaction(false, func(tx *sql.Tx) error {
+		return MarkImageStripped(tx, plain.SHA1, other)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, find(other, true), plain.SHA1)
+	test.AssertEquals(t, find(plain.SHA1, true), plain.SHA1)
+}
+
+func TestSetImageThumbs(t *testing.T) {
+	assertTableClear(t, "images")
+	writeSampleImage(t)
+
+	thumbs := map[uint16][2]uint16{
+		300: {300, 244},
+		600: {600, 489},
+	}
+	err := SetImageThumbs(assets.StdJPEG.SHA1, thumbs, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	img, err := GetImage(assets.StdJPEG.SHA1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, img.Thumbs, thumbs)
+	test.AssertEquals(t, img.ThumbAVIF, true)
+}
+
+func TestSetImageThumbsAttachment(t *testing.T) {
+	assertTableClear(t, "images", "boards")
+	writeSampleImage(t)
+	writeSampleBoard(t)
+	writeSampleThread(t)
+	assertExec(t,
+		`insert into post_attachments (post_id, position, sha1, name)
+		values (1, 1, $1, 'foo')`,
+		assets.StdJPEG.SHA1,
+	)
+	assertExec(t, `update threads set update_time = 0 where id = 1`)
+
+	err := SetImageThumbs(assets.StdJPEG.SHA1, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var updated int64
+	err = sq.Select("update_time").
+		From("threads").
+		Where("id = 1").
+		QueryRow().
+		Scan(&updated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated == 0 {
+		t.Fatal("thread of attachment not bumped")
+	}
+}
+
+func TestImageLimit(t *testing.T) {
+	prepareThreads(t)
+	assertExec(t, `update boards set imageLimit = 2 where id = 'a'`)
+
+	insert := func(id uint64) error {
+		token := newImageToken(t, assets.StdJPEG.SHA1)
+		return InTransaction(false, func(tx *sql.Tx) (err error) {
+			_, err = InsertImage(tx, id, token, "foo.jpeg", false)
+			return
+		})
+	}
+
+	// Counts the image of the OP
+	err := insert(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, insert(4), ErrImageLimit)
+
+	t.Run("cyclic", func(t *testing.T) {
+		assertExec(t, `update threads set cyclic = true where id = 1`)
+
+		// Oldest reply with an image is pruned to make room
+		err := insert(4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = GetPost(2)
+		test.AssertEquals(t, err, sql.ErrNoRows)
+	})
+}
@@ -144,7 +144,7 @@ func loadDB(connURL, dbSuffix string) (err error) {
 			tasks := []func() error{loadConfigs, loadBans, handleSpamScores, prepareUpdatePostsStmt, prepareInsertPostStmt, prepareLinkStatement, prepareInsertImageStmt, PrepareGetGeneralStatement}
 			if config.Server.ImagerMode != config.ImagerOnly {
 				tasks = append(tasks, loadBanners, loadLoadingAnimations,
-					loadThreadPostCounts)
+					loadEmoji, loadThreadPostCounts)
 			}
 			if err := util.Parallel(tasks...); err != nil {
 				return err
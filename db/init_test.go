@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/bakape/meguca/config"
+	_ "github.com/bakape/meguca/templates" // Sets common.Recompile
 )
 
 func TestMain(m *testing.M) {
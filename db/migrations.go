@@ -1728,6 +1728,425 @@ END;
 $$ LANGUAGE plpgsql;`)
 		return
 	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`ALTER TABLE boards
+				ADD COLUMN disabledCommands varchar(20)[] not null default '{}'`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`create table polls (
+				id bigint primary key references posts on delete cascade,
+				op bigint not null references threads on delete cascade,
+				expires bigint not null,
+				options varchar(100)[] not null
+			)`,
+			createIndex("polls", "op"),
+			`create table poll_votes (
+				poll_id bigint not null references polls on delete cascade,
+				ip inet not null,
+				session bytea not null,
+				option smallint not null,
+				unique (poll_id, ip),
+				unique (poll_id, session)
+			)`,
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		err = execAll(tx,
+			`alter table boards
+				add column reactions varchar(32)[] not null default '{}'`,
+			`create table post_reactions (
+				post_id bigint not null references posts on delete cascade,
+				ip inet not null,
+				reaction varchar(32) not null,
+				primary key (post_id, ip, reaction)
+			)`,
+		)
+		if err != nil {
+			return
+		}
+		_, err = tx.Exec(`update boards set reactions = $1`,
+			pq.StringArray(config.ReactionDefaults))
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`alter table banners
+				add column name text not null default ''`,
+			`alter table loading_animations
+				add column name text not null default ''`,
+			`create table emoji (
+				board text not null references boards on delete cascade,
+				name varchar(32) not null,
+				data bytea not null,
+				mime text not null,
+				primary key (board, name)
+			)`,
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`create table thumbnail_jobs (
+				id bigserial primary key,
+				priority smallint not null,
+				large bool not null,
+				state text not null default 'pending',
+				path text not null,
+				filename text not null,
+				size bigint not null,
+				tiktok_name text,
+				attempts int not null default 0,
+				next_attempt timestamptz not null default now(),
+				started timestamptz,
+				finished timestamptz,
+				error text,
+				created timestamptz not null default now()
+			)`,
+			createIndex("thumbnail_jobs", "state", "large", "priority", "id"),
+			createIndex("thumbnail_jobs", "finished"),
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`alter table images
+				add column rendition smallint not null default 0`,
+			`alter table boards
+				add column serveRenditions bool not null default false`,
+			`alter table thumbnail_jobs
+				add column transcode bool not null default false,
+				add column sha1 char(40)`,
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`alter table images
+				add column original_sha1 char(40)`,
+			createIndex("images", "original_sha1"),
+			`alter table boards
+				add column stripMetadata bool not null default false`,
+			`alter table thumbnail_jobs
+				add column strip_metadata bool not null default false`,
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		err = execAll(tx,
+			`alter table boards
+				add column maxAttachments smallint not null default 1`,
+			`create table post_attachments (
+				post_id bigint not null references posts on delete cascade,
+				position smallint not null,
+				sha1 char(40) not null references images on delete cascade,
+				name varchar(200) not null,
+				spoiler bool not null default false,
+				primary key (post_id, position)
+			)`,
+			createIndex("post_attachments", "sha1"),
+			`create or replace function cleanup_images()
+			returns table (sha1 char(40), file_type smallint, thumb_type smallint)
+			as $$
+			begin
+				create index posts_sha1_hash_idx on posts using hash (sha1)
+				where sha1 is not null;
+
+				return query
+					delete from images as i
+					where not exists (
+							select from posts as p where p.sha1 = i.sha1
+						)
+						and not exists (
+							select from post_attachments as a
+							where a.sha1 = i.sha1
+						)
+						and not exists (
+							select from image_tokens as it
+							where it.sha1 = i.sha1
+						)
+					returning i.sha1, i.file_type, i.thumb_type;
+
+				drop index posts_sha1_hash_idx;
+			end;
+			$$ language plpgsql`,
+		)
+		if err != nil {
+			return
+		}
+		return registerFunctions(tx,
+			"insert_image", "delete_images", "spoiler_images",
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`alter table images
+				add column peaks smallint[]`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`create table archive_entries (
+				sha1 char(40) not null references images on delete cascade,
+				position smallint not null,
+				page bool not null,
+				size bigint not null,
+				name text not null,
+				primary key (sha1, position)
+			)`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		return patchConfigsLegacy(tx, func(conf *config.Configs) {
+			conf.FetchDomains = config.Defaults.FetchDomains
+		})
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`alter table images
+				add column thumbs jsonb,
+				add column thumb_avif bool not null default false`,
+			`alter table thumbnail_jobs
+				add column regenerate bool not null default false`,
+			`drop function cleanup_images`,
+			`create function cleanup_images()
+			returns table (sha1 char(40), file_type smallint, thumb_type smallint,
+				thumbs jsonb, thumb_avif bool)
+			as $$
+			begin
+				create index posts_sha1_hash_idx on posts using hash (sha1)
+				where sha1 is not null;
+
+				return query
+					delete from images as i
+					where not exists (
+							select from posts as p where p.sha1 = i.sha1
+						)
+						and not exists (
+							select from post_attachments as a
+							where a.sha1 = i.sha1
+						)
+						and not exists (
+							select from image_tokens as it
+							where it.sha1 = i.sha1
+						)
+					returning i.sha1, i.file_type, i.thumb_type, i.thumbs,
+						i.thumb_avif;
+
+				drop index posts_sha1_hash_idx;
+			end;
+			$$ language plpgsql`,
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`alter table boards
+				add column storageQuota bigint not null default 0`,
+			`alter table thumbnail_jobs
+				add column board varchar(3) not null default ''`,
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`create index bans_ip_gist_idx on bans using gist (ip inet_ops)`,
+		)
+		if err != nil {
+			return
+		}
+		// Match bans by network containment
+		return loadSQL(tx, "triggers/posts", "triggers/bans")
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`create type ban_appeal_state as enum
+				('pending', 'accepted', 'rejected')`,
+			`create table ban_appeals (
+				id bigserial primary key,
+				board text not null,
+				for_post bigint not null,
+				ip inet not null,
+				body varchar(2000) not null,
+				state ban_appeal_state not null default 'pending',
+				created timestamp default (now() at time zone 'utc'),
+				unique (board, for_post, ip)
+			)`,
+			createIndex("ban_appeals", "board"),
+			createIndex("ban_appeals", "created"),
+			`create table ban_appeal_comments (
+				appeal_id bigint not null
+					references ban_appeals on delete cascade,
+				by varchar(20) not null,
+				body varchar(2000) not null,
+				created timestamp default (now() at time zone 'utc')
+			)`,
+			createIndex("ban_appeal_comments", "appeal_id"),
+		)
+	},
+	func(tx *sql.Tx) error {
+		return execAll(tx,
+			`create type report_state as enum
+				('open', 'claimed', 'resolved', 'dismissed')`,
+			`alter table reports
+				add column state report_state not null default 'open',
+				add column assigned_to varchar(20) not null default '',
+				add column resolved_by varchar(20) not null default '',
+				add column resolution varchar(100) not null default '',
+				add column resolved timestamp`,
+			createIndex("reports", "target"),
+			createIndex("reports", "state"),
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		return patchConfigsLegacy(tx, func(conf *config.Configs) {
+			conf.ModLogExpiry = config.Defaults.ModLogExpiry
+		})
+	},
+	func(tx *sql.Tx) error {
+		// Poster IPs are removed after a week, so notes are keyed by a salted
+		// hash of the IP instead
+		return execAll(tx,
+			`create table mod_notes (
+				id bigserial primary key,
+				board varchar(10) not null
+					references boards on delete cascade,
+				post_id bigint not null,
+				ip_hash text,
+				by_ip bool not null default false,
+				by varchar(20) not null,
+				body varchar(1000) not null,
+				created timestamp default (now() at time zone 'utc')
+			)`,
+			createIndex("mod_notes", "post_id"),
+			createIndex("mod_notes", "ip_hash"),
+		)
+	},
+	func(tx *sql.Tx) error {
+		return loadSQL(tx, "triggers/mod_log")
+	},
+	func(tx *sql.Tx) error {
+		return execAll(tx,
+			`alter table boards
+				add column slowMode integer not null default 0`,
+			`alter table threads
+				add column slow_mode integer not null default 0`,
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		err = execAll(tx,
+			`alter table boards
+				add column bumpLimit integer not null default 1000,
+				add column imageLimit integer not null default 0`,
+			`alter table threads
+				add column cyclic boolean not null default false`,
+		)
+		if err != nil {
+			return
+		}
+		err = registerFunctions(tx, "bump_limit", "image_count", "prune_thread",
+			"bump_thread", "insert_image")
+		if err != nil {
+			return
+		}
+		return loadSQL(tx, "triggers/posts")
+	},
+	func(tx *sql.Tx) error {
+		// Threads of new posters used to be dropped outright, so hold them for
+		// review by default
+		return execAll(tx,
+			`alter table boards
+				add column preModThreads boolean not null default true,
+				add column preModReplies boolean not null default false,
+				add column preModImages boolean not null default false,
+				add column newPosterPosts integer not null default 2`,
+			`create type pending_post_state as enum
+				('pending', 'approved', 'rejected')`,
+			`create table pending_posts (
+				id bigserial primary key,
+				board varchar(10) not null
+					references boards on delete cascade,
+				op bigint references threads on delete cascade,
+				ip inet not null,
+				subject varchar(100),
+				name varchar(50) not null,
+				trip varchar(10) not null,
+				sage bool not null default false,
+				body varchar(2000) not null,
+				image_token char(86)
+					references image_tokens on delete set null,
+				image_name varchar(200) not null,
+				spoiler bool not null default false,
+				state pending_post_state not null default 'pending',
+				post_id bigint,
+				reviewed_by varchar(20),
+				reason varchar(100) not null default '',
+				created timestamp default (now() at time zone 'utc')
+			)`,
+			createIndex("pending_posts", "board"),
+			createIndex("pending_posts", "created"),
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		err = dropFunctions(tx,
+			"insert_image(bigint, char(86), varchar(200), bool)")
+		if err != nil {
+			return
+		}
+		return registerFunctions(tx, "insert_image")
+	},
+	func(tx *sql.Tx) (err error) {
+		// Storage quotas are enforced, when attaching files to posts
+		_, err = tx.Exec(`alter table thumbnail_jobs drop column board`)
+		if err != nil {
+			return
+		}
+		return registerFunctions(tx, "board_files", "insert_image")
+	},
+	func(tx *sql.Tx) (err error) {
+		// Poster IPs are removed after a week, so moderation log entries store
+		// their hash
+		err = execAll(tx,
+			`alter table mod_log add column ip_hash text`,
+			createIndex("mod_log", "ip_hash"),
+		)
+		if err != nil {
+			return
+		}
+
+		// Configs are only loaded after migrations, but hashing requires the
+		// salt
+		var s string
+		err = tx.QueryRow("SELECT val FROM main WHERE id = 'config'").Scan(&s)
+		if err != nil {
+			return
+		}
+		var conf config.Configs
+		err = json.Unmarshal([]byte(s), &conf)
+		if err != nil {
+			return
+		}
+		config.Set(conf)
+		return hashModLogIPs(tx, true)
+	},
+	func(tx *sql.Tx) (err error) {
+		// So posters can reclaim and delete their posts, once approved
+		_, err = tx.Exec(`alter table pending_posts add column password bytea`)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		// Only files, that can have their metadata stripped, are required to
+		// have it stripped
+		err = dropFunctions(tx,
+			"insert_image(bigint, char(86), varchar(200), bool, bool)")
+		if err != nil {
+			return
+		}
+		return registerFunctions(tx, "insert_image")
+	},
+	func(tx *sql.Tx) (err error) {
+		// Enforce the attachment limit of posts atomically
+		return registerFunctions(tx, "insert_image")
+	},
 }
 
 func createIndex(table string, columns ...string) string {
@@ -0,0 +1,115 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+)
+
+// ErrPosterIPUnknown is returned, when attaching a note to the IP of a poster,
+// whose IP has already been removed
+var ErrPosterIPUnknown = common.ErrInvalidInput("poster IP no longer known")
+
+// Returns the salted hash of a poster IP, that notes and moderation log
+// entries are keyed by
+func hashPosterIP(ip string) string {
+	_, hash := auth.HashToTitle([]byte(ip))
+	return hash
+}
+
+// Read the board of a post and the hash of its poster's IP, if still known
+func getPostIPHash(tx *sql.Tx, id uint64) (
+	board string, ipHash sql.NullString, err error,
+) {
+	var ip sql.NullString
+	err = sq.Select("board", "ip").
+		From("posts").
+		Where("id = ?", id).
+		RunWith(tx).
+		QueryRow().
+		Scan(&board, &ip)
+	if err != nil {
+		return
+	}
+	if ip.Valid {
+		ipHash = sql.NullString{
+			String: hashPosterIP(ip.String),
+			Valid:  true,
+		}
+	}
+	return
+}
+
+// AddModNote attaches a private staff note to a post or, if byIP, to all posts
+// by the post's poster on the same board and records it in the moderation log
+func AddModNote(id uint64, by, body string, byIP bool) error {
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		board, ipHash, err := getPostIPHash(tx, id)
+		if err != nil {
+			return
+		}
+		if byIP && !ipHash.Valid {
+			return ErrPosterIPUnknown
+		}
+
+		_, err = sq.Insert("mod_notes").
+			Columns("board", "post_id", "ip_hash", "by_ip", "by", "body").
+			Values(board, id, ipHash, byIP, by, body).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+
+		// The mod log is public, so the note itself is not logged
+		return logModeration(tx, auth.ModLogEntry{
+			ModerationEntry: common.ModerationEntry{
+				Type: common.AddModNote,
+				By:   by,
+			},
+			Board: board,
+			ID:    id,
+		})
+	})
+}
+
+// GetModNotes retrieves notes attached to a post and to its poster's IP,
+// oldest first
+func GetModNotes(id uint64) (notes []auth.ModNote, err error) {
+	err = InTransaction(true, func(tx *sql.Tx) (err error) {
+		board, ipHash, err := getPostIPHash(tx, id)
+		if err != nil {
+			return
+		}
+
+		match := squirrel.Or{squirrel.Eq{"post_id": id}}
+		if ipHash.Valid {
+			match = append(match, squirrel.Eq{
+				"by_ip":   true,
+				"ip_hash": ipHash.String,
+			})
+		}
+		notes = make([]auth.ModNote, 0, 4)
+		return queryAll(
+			sq.Select("id", "post_id", "by_ip", "created", "by", "body").
+				From("mod_notes").
+				Where("board = ?", board).
+				Where(match).
+				OrderBy("created").
+				RunWith(tx),
+			func(r *sql.Rows) (err error) {
+				n := auth.ModNote{Board: board}
+				err = r.Scan(&n.ID, &n.Post, &n.ByIP, &n.Created, &n.By,
+					&n.Body)
+				if err != nil {
+					return
+				}
+				notes = append(notes, n)
+				return
+			},
+		)
+	})
+	return
+}
@@ -0,0 +1,88 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestModNotes(t *testing.T) {
+	prepareForModeration(t)
+	assertTableClear(t, "mod_notes")
+
+	for i, ip := range [...]string{"::1", "::2"} {
+		err := InTransaction(false, func(tx *sql.Tx) error {
+			return InsertPost(tx, &Post{
+				StandalonePost: common.StandalonePost{
+					Post: common.Post{
+						ID:   uint64(i + 2),
+						Time: time.Now().Unix(),
+					},
+					OP:    1,
+					Board: "a",
+				},
+				IP: ip,
+			})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := AddModNote(1, "admin", "on post", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = AddModNote(1, "admin", "on IP", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertNotes := func(t *testing.T, id uint64, std ...string) {
+		t.Helper()
+		notes, err := GetModNotes(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bodies := make([]string, len(notes))
+		for i, n := range notes {
+			bodies[i] = n.Body
+		}
+		AssertEquals(t, bodies, std)
+	}
+
+	t.Run("same post", func(t *testing.T) {
+		assertNotes(t, 1, "on post", "on IP")
+	})
+	t.Run("same IP", func(t *testing.T) {
+		assertNotes(t, 2, "on IP")
+	})
+	t.Run("different IP", func(t *testing.T) {
+		assertNotes(t, 3)
+	})
+
+	t.Run("IP removed", func(t *testing.T) {
+		assertExec(t, `update posts set ip = null where id = 2`)
+		err := AddModNote(2, "admin", "on IP", true)
+		AssertEquals(t, err, ErrPosterIPUnknown)
+		assertNotes(t, 2)
+	})
+
+	t.Run("mod log", func(t *testing.T) {
+		log, err := GetModLog(auth.ModLogFilter{
+			Types: []common.ModerationAction{common.AddModNote},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, len(log), 2)
+		for _, l := range log {
+			// Notes are private
+			AssertEquals(t, l.Data, "")
+		}
+	})
+}
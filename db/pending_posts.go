@@ -0,0 +1,247 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+)
+
+// Time the image token of a post held for review is kept valid for. Refreshed
+// periodically, until the post is reviewed.
+const pendingTimeout = 7 * 24 * time.Hour
+
+// ErrPostReviewed is returned, when reviewing a held post, that has already
+// been approved or rejected
+var ErrPostReviewed = common.ErrInvalidInput("post already reviewed")
+
+// HoldPost records a post of a new poster to be reviewed by staff and returns
+// its ID. The image token of the post, if any, is kept valid, until the post
+// is reviewed.
+func HoldPost(p auth.PendingPost) (id uint64, err error) {
+	err = InTransaction(false, func(tx *sql.Tx) (err error) {
+		var token interface{}
+		if p.ImageToken != "" {
+			var res sql.Result
+			res, err = sq.Update("image_tokens").
+				Set("expires", time.Now().Add(pendingTimeout).UTC()).
+				Where("token = ?", p.ImageToken).
+				RunWith(tx).
+				Exec()
+			if err != nil {
+				return
+			}
+			var n int64
+			n, err = res.RowsAffected()
+			switch {
+			case err != nil:
+				return
+			case n == 0:
+				return ErrInvalidToken
+			}
+			token = p.ImageToken
+		}
+
+		var op interface{}
+		if p.OP != 0 {
+			op = p.OP
+		}
+		err = sq.Insert("pending_posts").
+			Columns("board", "op", "ip", "subject", "name", "trip", "sage",
+				"body", "image_token", "image_name", "spoiler", "password").
+			Values(p.Board, op, p.IP, p.Subject, p.Name, p.Trip, p.Sage,
+				p.Body, token, p.ImageName, p.Spoiler, p.Password).
+			Suffix("returning id").
+			RunWith(tx).
+			QueryRow().
+			Scan(&id)
+		if err != nil {
+			return
+		}
+		_, err = tx.Exec(
+			`select pg_notify('pending_post', $1::text || ',' || $2::bigint)`,
+			p.Board, id,
+		)
+		return
+	})
+	return
+}
+
+// Keep the image tokens of posts held for review valid, until they are
+// reviewed
+func refreshPendingImageTokens() error {
+	_, err := sq.Update("image_tokens").
+		Set("expires", time.Now().Add(pendingTimeout).UTC()).
+		Where(`token in (
+			select image_token
+			from pending_posts
+			where state = 'pending' and image_token is not null
+		)`).
+		Exec()
+	return err
+}
+
+func selectPendingPosts() squirrel.SelectBuilder {
+	return sq.Select("p.id", "coalesce(p.op, 0)", "p.board", "p.ip",
+		"coalesce(p.subject, '')", "p.name", "p.trip", "p.sage", "p.body",
+		"coalesce(p.image_token, '')", "p.image_name", "p.spoiler", "p.state",
+		"coalesce(p.post_id, 0)", "coalesce(p.reviewed_by, '')", "p.reason",
+		"p.created", "p.password", "i.sha1", "i.file_type", "i.thumb_type").
+		From("pending_posts as p").
+		LeftJoin("image_tokens as t on t.token = p.image_token").
+		LeftJoin("images as i on i.sha1 = t.sha1")
+}
+
+func scanPendingPost(r rowScanner) (p auth.PendingPost, err error) {
+	var (
+		sha1                sql.NullString
+		fileType, thumbType sql.NullInt64
+	)
+	err = r.Scan(&p.ID, &p.OP, &p.Board, &p.IP, &p.Subject, &p.Name, &p.Trip,
+		&p.Sage, &p.Body, &p.ImageToken, &p.ImageName, &p.Spoiler, &p.State,
+		&p.PostID, &p.ReviewedBy, &p.Reason, &p.Created, &p.Password, &sha1,
+		&fileType, &thumbType)
+	if err != nil || !sha1.Valid {
+		return
+	}
+	p.Image = &common.ImageCommon{
+		SHA1:      sha1.String,
+		FileType:  uint8(fileType.Int64),
+		ThumbType: uint8(thumbType.Int64),
+	}
+	return
+}
+
+// GetPendingPost retrieves a post held for review
+func GetPendingPost(id uint64) (auth.PendingPost, error) {
+	return scanPendingPost(selectPendingPosts().
+		Where("p.id = ?", id).
+		QueryRow())
+}
+
+// GetPendingPosts retrieves all posts held for review on a board with
+// unreviewed posts first
+func GetPendingPosts(board string) (posts []auth.PendingPost, err error) {
+	posts = make([]auth.PendingPost, 0, 16)
+	err = queryAll(
+		selectPendingPosts().
+			Where("p.board = ?", board).
+			OrderBy("p.state = 'pending' desc", "p.created desc"),
+		func(r *sql.Rows) (err error) {
+			p, err := scanPendingPost(r)
+			if err != nil {
+				return
+			}
+			posts = append(posts, p)
+			return
+		},
+	)
+	return
+}
+
+// ApprovePendingPost marks a post held for review as published as post id
+// and records the decision in the moderation log. Must be called in the same
+// transaction, that inserts the published post.
+func ApprovePendingPost(tx *sql.Tx, pending, id uint64, by string,
+) (err error) {
+	board, err := lockPendingPost(tx, pending)
+	if err != nil {
+		return
+	}
+	_, err = sq.Update("pending_posts").
+		Set("state", auth.PostApproved).
+		Set("post_id", id).
+		Set("reviewed_by", by).
+		Where("id = ?", pending).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return
+	}
+
+	// Not attached to the post, so the decision is not displayed on it
+	return logModeration(tx, auth.ModLogEntry{
+		ModerationEntry: common.ModerationEntry{
+			Type: common.ApprovePost,
+			By:   by,
+			Data: fmt.Sprintf(">>%d", id),
+		},
+		Board: board,
+	})
+}
+
+// RejectPendingPost rejects a post held for review with an optional reason,
+// that is displayed to the poster, and records the decision in the moderation
+// log
+func RejectPendingPost(id uint64, by, reason string) error {
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		board, err := lockPendingPost(tx, id)
+		if err != nil {
+			return
+		}
+
+		// Free the image for cleanup
+		var token sql.NullString
+		err = sq.Select("image_token").
+			From("pending_posts").
+			Where("id = ?", id).
+			RunWith(tx).
+			QueryRow().
+			Scan(&token)
+		if err != nil {
+			return
+		}
+		if token.Valid {
+			_, err = sq.Delete("image_tokens").
+				Where("token = ?", token.String).
+				RunWith(tx).
+				Exec()
+			if err != nil {
+				return
+			}
+		}
+
+		_, err = sq.Update("pending_posts").
+			Set("state", auth.PostRejected).
+			Set("reviewed_by", by).
+			Set("reason", reason).
+			Where("id = ?", id).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+
+		return logModeration(tx, auth.ModLogEntry{
+			ModerationEntry: common.ModerationEntry{
+				Type: common.RejectPost,
+				By:   by,
+				Data: reason,
+			},
+			Board: board,
+		})
+	})
+}
+
+// Lock a pending post for the rest of the transaction and return its board.
+// Returns ErrPostReviewed, if the post has already been reviewed.
+func lockPendingPost(tx *sql.Tx, id uint64) (board string, err error) {
+	var state string
+	err = sq.Select("board", "state").
+		From("pending_posts").
+		Where("id = ?", id).
+		Suffix("for update").
+		RunWith(tx).
+		QueryRow().
+		Scan(&board, &state)
+	if err != nil {
+		return
+	}
+	if state != auth.PostPending {
+		err = ErrPostReviewed
+	}
+	return
+}
@@ -0,0 +1,155 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/imager/assets"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestPendingPosts(t *testing.T) {
+	prepareThreads(t)
+	assertTableClear(t, "pending_posts", "mod_log")
+
+	token := newImageToken(t, assets.StdJPEG.SHA1)
+	reply, err := HoldPost(auth.PendingPost{
+		OP:         1,
+		Board:      "a",
+		IP:         "::1",
+		Name:       "foo",
+		Body:       "bar",
+		ImageToken: token,
+		ImageName:  "foo.jpeg",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := GetPendingPost(reply)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, p.State, auth.PostPending)
+	AssertEquals(t, p.OP, uint64(1))
+	AssertEquals(t, p.Body, "bar")
+	if p.Image == nil {
+		t.Fatal("no image")
+	}
+	AssertEquals(t, p.Image.SHA1, assets.StdJPEG.SHA1)
+
+	// The image token must stay valid during the review
+	var expires time.Time
+	err = sq.Select("expires").
+		From("image_tokens").
+		Where("token = ?", token).
+		QueryRow().
+		Scan(&expires)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expires.Before(time.Now().Add(time.Hour)) {
+		t.Fatalf("image token expires too soon: %s", expires)
+	}
+
+	_, err = HoldPost(auth.PendingPost{
+		Board:      "a",
+		IP:         "::1",
+		ImageToken: "invalid",
+	})
+	AssertEquals(t, err, ErrInvalidToken)
+
+	thread, err := HoldPost(auth.PendingPost{
+		Board:   "a",
+		IP:      "::1",
+		Subject: "baz",
+		Body:    "spam",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("approve", func(t *testing.T) {
+		err := InTransaction(false, func(tx *sql.Tx) error {
+			return ApprovePendingPost(tx, reply, 2, "admin")
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		p, err := GetPendingPost(reply)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, p.State, auth.PostApproved)
+		AssertEquals(t, p.PostID, uint64(2))
+		AssertEquals(t, p.ReviewedBy, "admin")
+
+		err = RejectPendingPost(reply, "admin", "")
+		AssertEquals(t, err, ErrPostReviewed)
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		err := RejectPendingPost(thread, "admin", "spam")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		posts, err := GetPendingPosts("a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, len(posts), 2)
+		AssertEquals(t, posts[0].ID, thread)
+		AssertEquals(t, posts[0].State, auth.PostRejected)
+		AssertEquals(t, posts[0].Subject, "baz")
+		AssertEquals(t, posts[0].Reason, "spam")
+	})
+
+	log, err := GetModLog(auth.ModLogFilter{Board: "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, len(log), 2)
+}
+
+func TestExpirePendingPosts(t *testing.T) {
+	prepareThreads(t)
+	assertTableClear(t, "pending_posts")
+
+	pending, err := HoldPost(auth.PendingPost{
+		OP:    1,
+		Board: "a",
+		IP:    "::1",
+		Body:  "foo",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rejected, err := HoldPost(auth.PendingPost{
+		OP:    1,
+		Board: "a",
+		IP:    "::1",
+		Body:  "bar",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = RejectPendingPost(rejected, "admin", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertExec(t, `update pending_posts
+		set created = now() at time zone 'utc' - interval '8 days'`)
+	expirePendingPosts()
+
+	_, err = GetPendingPost(pending)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = GetPendingPost(rejected)
+	AssertEquals(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,234 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/lib/pq"
+)
+
+var (
+	errNoPoll          = common.ErrInvalidInput("post has no poll")
+	errPollNotInThread = common.ErrInvalidInput("poll not in thread")
+)
+
+// InsertPoll writes a new poll of a post to the database
+func InsertPoll(tx *sql.Tx, id, op uint64, p common.PollState) (err error) {
+	_, err = sq.Insert("polls").
+		Columns("id", "op", "expires", "options").
+		Values(id, op, p.Expires, pq.StringArray(p.Options)).
+		Suffix("on conflict (id) do nothing").
+		RunWith(tx).
+		Exec()
+	return
+}
+
+// Returns the poll parsed from the commands of a post, if any
+func commandPoll(com []common.Command) *common.PollState {
+	for _, c := range com {
+		if c.Type == common.Poll && c.Poll != nil {
+			return c.Poll
+		}
+	}
+	return nil
+}
+
+// Write the poll parsed from the commands of a post, if any. Must be called
+// after the post is inserted.
+func insertCommandPoll(tx *sql.Tx, id, op uint64, com []common.Command,
+) error {
+	if p := commandPoll(com); p != nil {
+		return InsertPoll(tx, id, op, *p)
+	}
+	return nil
+}
+
+// VotePoll casts a vote for a poll option in thread. Only one vote per IP or
+// session is counted. Returns the updated state of the poll.
+// Sets changed to false, if the vote was not counted, because the poll is
+// closed or the client has already voted.
+func VotePoll(
+	v common.PollVote,
+	thread uint64,
+	ip string,
+	session auth.Base64Token,
+) (
+	p common.PollState, changed bool, err error,
+) {
+	err = InTransaction(false, func(tx *sql.Tx) (err error) {
+		var (
+			op      uint64
+			locked  bool
+			options pq.StringArray
+		)
+		err = sq.Select("p.op", "p.expires", "p.options", "t.locked").
+			From("polls as p").
+			Join("threads as t on t.id = p.op").
+			Where("p.id = ?", v.ID).
+			Suffix("for update of p").
+			RunWith(tx).
+			QueryRow().
+			Scan(&op, &p.Expires, &options, &locked)
+		switch {
+		case err == sql.ErrNoRows:
+			return errNoPoll
+		case err != nil:
+			return
+		case op != thread:
+			return errPollNotInThread
+		case int(v.Option) >= len(options):
+			return common.ErrInvalidInput("poll option out of range")
+		}
+		p.Options = []string(options)
+		if locked || p.Closed() {
+			return
+		}
+
+		res, err := sq.Insert("poll_votes").
+			Columns("poll_id", "ip", "session", "option").
+			Values(v.ID, ip, session[:], v.Option).
+			Suffix("on conflict do nothing").
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		n, err := res.RowsAffected()
+		if err != nil || n == 0 {
+			return
+		}
+
+		p.Votes, err = getPollVotes(tx, v.ID, len(options))
+		if err != nil {
+			return
+		}
+		changed = true
+		return writePollCommand(tx, v.ID, op, p)
+	})
+	return
+}
+
+// ClosePolls closes all open polls in a thread
+func ClosePolls(op uint64) error {
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		now := time.Now().Unix()
+		r, err := sq.Update("polls").
+			Set("expires", now).
+			Where("op = ? and expires > ?", op, now).
+			Suffix("returning id, options").
+			RunWith(tx).
+			Query()
+		if err != nil {
+			return
+		}
+		type closed struct {
+			id      uint64
+			options pq.StringArray
+		}
+		var polls []closed
+		for r.Next() {
+			var c closed
+			err = r.Scan(&c.id, &c.options)
+			if err != nil {
+				r.Close()
+				return
+			}
+			polls = append(polls, c)
+		}
+		err = r.Err()
+		r.Close()
+		if err != nil {
+			return
+		}
+
+		for _, c := range polls {
+			p := common.PollState{
+				Expires: now,
+				Options: []string(c.options),
+			}
+			p.Votes, err = getPollVotes(tx, c.id, len(c.options))
+			if err != nil {
+				return
+			}
+			err = writePollCommand(tx, c.id, op, p)
+			if err != nil {
+				return
+			}
+		}
+		return
+	})
+}
+
+// Count votes for each option of a poll
+func getPollVotes(tx *sql.Tx, id uint64, options int) (
+	votes []uint64, err error,
+) {
+	votes = make([]uint64, options)
+	r, err := sq.Select("option", "count(*)").
+		From("poll_votes").
+		Where("poll_id = ?", id).
+		GroupBy("option").
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return
+	}
+	defer r.Close()
+	for r.Next() {
+		var (
+			i int
+			n uint64
+		)
+		err = r.Scan(&i, &n)
+		if err != nil {
+			return
+		}
+		if i < options {
+			votes[i] = n
+		}
+	}
+	err = r.Err()
+	return
+}
+
+// Replace the stored #poll command of a post with the current poll state, so
+// it is reflected in rendered posts, and bump the thread's update time to
+// invalidate caches
+func writePollCommand(tx *sql.Tx, id, op uint64, p common.PollState) (err error) {
+	var com commandRow
+	err = sq.Select("commands").
+		From("posts").
+		Where("id = ?", id).
+		Suffix("for update").
+		RunWith(tx).
+		QueryRow().
+		Scan(&com)
+	if err != nil {
+		return
+	}
+
+	i := 0
+	for ; i < len(com); i++ {
+		if com[i].Type == common.Poll {
+			com[i].Poll = &p
+			break
+		}
+	}
+	if i == len(com) {
+		// Post not closed yet
+		return
+	}
+
+	_, err = sq.Update("posts").
+		Set("commands", com).
+		Where("id = ?", id).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return
+	}
+	_, err = tx.Exec("select bump_thread($1)", op)
+	return
+}
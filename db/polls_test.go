@@ -0,0 +1,142 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestVotePoll(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+	writeSampleThread(t)
+
+	err := InTransaction(false, func(tx *sql.Tx) error {
+		return InsertPoll(tx, 1, 1, common.PollState{
+			Expires: time.Now().Add(time.Hour).Unix(),
+			Options: []string{"yes", "no"},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sessions [3]auth.Base64Token
+	for i := range sessions {
+		sessions[i][0] = byte(i + 1)
+	}
+
+	cases := [...]struct {
+		name    string
+		option  uint8
+		ip      string
+		session auth.Base64Token
+		changed bool
+		votes   []uint64
+	}{
+		{"first vote", 0, "::1", sessions[0], true, []uint64{1, 0}},
+		{"same IP", 1, "::1", sessions[1], false, nil},
+		{"same session", 1, "::2", sessions[0], false, nil},
+		{"second voter", 1, "::2", sessions[1], true, []uint64{1, 1}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			p, changed, err := VotePoll(
+				common.PollVote{ID: 1, Option: c.option},
+				1,
+				c.ip,
+				c.session,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEquals(t, changed, c.changed)
+			if c.changed {
+				AssertEquals(t, p.Votes, c.votes)
+			}
+		})
+	}
+
+	t.Run("option out of range", func(t *testing.T) {
+		_, _, err := VotePoll(common.PollVote{ID: 1, Option: 2}, 1, "::3",
+			sessions[2])
+		if err == nil {
+			t.Fatal("expected error")
+		}
+	})
+
+	t.Run("different thread", func(t *testing.T) {
+		_, _, err := VotePoll(common.PollVote{ID: 1}, 2, "::3", sessions[2])
+		AssertEquals(t, err, errPollNotInThread)
+
+		var n int
+		err = sq.Select("count(*)").
+			From("poll_votes").
+			Where("poll_id = 1").
+			QueryRow().
+			Scan(&n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, n, 2)
+	})
+
+	t.Run("closed on thread lock", func(t *testing.T) {
+		err := SetThreadLock(1, true, "admin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, changed, err := VotePoll(common.PollVote{ID: 1}, 1, "::3",
+			sessions[2])
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, changed, false)
+	})
+}
+
+func TestInsertThreadWithPoll(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+
+	p := Post{
+		StandalonePost: common.StandalonePost{
+			Post: common.Post{
+				Commands: []common.Command{
+					{
+						Type: common.Poll,
+						Poll: &common.PollState{
+							Expires: time.Now().Add(time.Hour).Unix(),
+							Options: []string{"yes", "no"},
+							Votes:   []uint64{0, 0},
+						},
+					},
+				},
+			},
+			Board: "a",
+		},
+		IP: "::1",
+	}
+	err := InTransaction(false, func(tx *sql.Tx) error {
+		return InsertThread(tx, "test", &p)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var op uint64
+	err = sq.Select("op").
+		From("polls").
+		Where("id = ?", p.ID).
+		QueryRow().
+		Scan(&op)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, op, p.ID)
+}
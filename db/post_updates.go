@@ -34,15 +34,16 @@ func prepareUpdatePostsStmt() (err error) {
 // ClosePost closes an open post and commits any links and hash commands
 func ClosePost(id, op uint64, body string, links []common.Link, com []common.Command, claude *common.ClaudeState) (cid uint64, err error) {
 	funcStart := time.Now()
-	// Hotpath for closing posts without links or Claude
-	if len(links) == 0 && claude == nil {
+	// Hotpath for closing posts without links, polls or Claude
+	poll := commandPoll(com)
+	if len(links) == 0 && claude == nil && poll == nil {
 		start := time.Now()
 		_, err = updatePostsStmt.Exec(false, body, commandRow(com), nil, nil, id)
 		log.Printf("updatePostsStmt.Exec took %v", time.Since(start))
 		if err != nil {
 			return
 		}
-	} else if claude == nil {
+	} else if claude == nil && poll == nil {
 		linksArray := make([]int64, len(links))
 		for i, link := range links {
 			linksArray[i] = int64(link.ID)
@@ -76,6 +77,13 @@ func ClosePost(id, op uint64, body string, links []common.Link, com []common.Com
 				}
 			}
 
+			if poll != nil {
+				err = InsertPoll(tx, id, op, *poll)
+				if err != nil {
+					return
+				}
+			}
+
 			err = writeLinks(tx, id, links)
 			return
 		})
@@ -140,6 +140,14 @@ func WritePost(tx *sql.Tx, p Post) (err error) {
 // Thread OPs must have their post ID set to the thread ID.
 // Any images are to be inserted in a separate call.
 func InsertPost(tx *sql.Tx, p *Post) (err error) {
+	err = insertPostRow(tx, p)
+	if err != nil {
+		return
+	}
+	return insertCommandPoll(tx, p.ID, p.OP, p.Commands)
+}
+
+func insertPostRow(tx *sql.Tx, p *Post) (err error) {
 	if p.ID != 0 { // OP of a thread
 		args := make([]interface{}, 0, 12)
 		args = append(args,
@@ -0,0 +1,85 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/bakape/meguca/common"
+)
+
+// ReactToPost adds a reaction to a post in thread op. Each IP can only react
+// once with the same reaction to a post. Returns the new count of the reaction
+// on the post and, if it changed.
+func ReactToPost(id, op uint64, ip, reaction string) (
+	count uint64, changed bool, err error,
+) {
+	err = InTransaction(false, func(tx *sql.Tx) (err error) {
+		res, err := tx.Exec(
+			`insert into post_reactions (post_id, ip, reaction)
+			select $1, $2, $3
+			where exists (select from posts where id = $1 and op = $4)
+			on conflict do nothing`,
+			id, ip, reaction, op,
+		)
+		if err != nil {
+			return
+		}
+		n, err := res.RowsAffected()
+		if err != nil || n == 0 {
+			return
+		}
+		changed = true
+
+		err = sq.Select("count(*)").
+			From("post_reactions").
+			Where("post_id = ? and reaction = ?", id, reaction).
+			RunWith(tx).
+			QueryRow().
+			Scan(&count)
+		if err != nil {
+			return
+		}
+
+		// Invalidate thread caches
+		_, err = tx.Exec("select bump_thread($1)", op)
+		return
+	})
+	return
+}
+
+// Inject reaction counts into the posts of a thread
+func injectReactions(tx *sql.Tx, t *common.Thread) (err error) {
+	byID := make(map[uint64]*common.Post, len(t.Posts)+1)
+	byID[t.ID] = &t.Post
+	for i := range t.Posts {
+		byID[t.Posts[i].ID] = &t.Posts[i]
+	}
+
+	return queryAll(
+		sq.Select("r.post_id", "r.reaction", "count(*)").
+			From("post_reactions as r").
+			Join("posts as p on p.id = r.post_id").
+			Where("p.op = ?", t.ID).
+			GroupBy("r.post_id", "r.reaction").
+			RunWith(tx),
+		func(r *sql.Rows) (err error) {
+			var (
+				id       uint64
+				reaction string
+				count    uint64
+			)
+			err = r.Scan(&id, &reaction, &count)
+			if err != nil {
+				return
+			}
+			p := byID[id]
+			if p == nil { // Not in abbreviated thread
+				return
+			}
+			if p.Reactions == nil {
+				p.Reactions = make(map[string]uint64, 4)
+			}
+			p.Reactions[reaction] = count
+			return
+		},
+	)
+}
@@ -0,0 +1,56 @@
+package db
+
+import (
+	"testing"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestReactToPost(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+	writeSampleThread(t)
+
+	cases := [...]struct {
+		name, ip, reaction string
+		count              uint64
+		changed            bool
+	}{
+		{"first", "::1", "👍", 1, true},
+		{"same IP", "::1", "👍", 0, false},
+		{"other reaction", "::1", "❤️", 1, true},
+		{"other IP", "::2", "👍", 2, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			count, changed, err := ReactToPost(1, 1, c.ip, c.reaction)
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEquals(t, changed, c.changed)
+			if c.changed {
+				AssertEquals(t, count, c.count)
+			}
+		})
+	}
+
+	t.Run("wrong thread", func(t *testing.T) {
+		_, changed, err := ReactToPost(1, 2, "::3", "👍")
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, changed, false)
+	})
+
+	t.Run("thread JSON", func(t *testing.T) {
+		thread, err := GetThread(1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, thread.Reactions, map[string]uint64{
+			"👍":  2,
+			"❤️": 1,
+		})
+	})
+}
@@ -36,7 +36,8 @@ const (
 		where t.id = posts.op
 			and posts.SHA1 is not null
 	),
-	t.update_time, t.bump_time, t.subject, t.locked, ` + postSelectsSQL
+	t.update_time, t.bump_time, t.subject, t.locked, t.cyclic, ` +
+		postSelectsSQL
 
 	getOPSQL = `
 	select ` + threadSelectsSQL + `
@@ -63,8 +64,12 @@ const (
 type imageScanner struct {
 	Audio, Video, Spoiler                 sql.NullBool
 	FileType, ThumbType, Length, Size     sql.NullInt64
+	Rendition                             sql.NullInt64
 	Name, SHA1, MD5, Title, Artist, Codec sql.NullString
-	Dims                                  pq.Int64Array
+	OriginalSHA1                          sql.NullString
+	Dims, Peaks                           pq.Int64Array
+	Thumbs                                []byte
+	ThumbAVIF                             sql.NullBool
 }
 
 // Returns and array of pointers to the struct fields for passing to
@@ -73,6 +78,7 @@ func (i *imageScanner) ScanArgs() []interface{} {
 	return []interface{}{
 		&i.Audio, &i.Video, &i.FileType, &i.ThumbType, &i.Dims,
 		&i.Length, &i.Size, &i.MD5, &i.SHA1, &i.Title, &i.Artist, &i.Codec,
+		&i.Rendition, &i.OriginalSHA1, &i.Peaks, &i.Thumbs, &i.ThumbAVIF,
 	}
 }
 
@@ -86,23 +92,35 @@ func (i *imageScanner) Val() *common.Image {
 	for j := range dims {
 		dims[j] = uint16(i.Dims[j])
 	}
+	var peaks common.Peaks
+	if i.Peaks != nil {
+		peaks = make(common.Peaks, len(i.Peaks))
+		for j, p := range i.Peaks {
+			peaks[j] = uint8(p)
+		}
+	}
 
 	return &common.Image{
 		Spoiler: i.Spoiler.Bool,
 		Name:    i.Name.String,
 		ImageCommon: common.ImageCommon{
-			Audio:     i.Audio.Bool,
-			Video:     i.Video.Bool,
-			FileType:  uint8(i.FileType.Int64),
-			ThumbType: uint8(i.ThumbType.Int64),
-			Length:    uint32(i.Length.Int64),
-			Dims:      dims,
-			Size:      int(i.Size.Int64),
-			MD5:       i.MD5.String,
-			SHA1:      i.SHA1.String,
-			Title:     i.Title.String,
-			Artist:    i.Artist.String,
-			Codec:     i.Codec.String,
+			Audio:        i.Audio.Bool,
+			Video:        i.Video.Bool,
+			FileType:     uint8(i.FileType.Int64),
+			ThumbType:    uint8(i.ThumbType.Int64),
+			Length:       uint32(i.Length.Int64),
+			Dims:         dims,
+			Size:         int(i.Size.Int64),
+			MD5:          i.MD5.String,
+			SHA1:         i.SHA1.String,
+			Title:        i.Title.String,
+			Artist:       i.Artist.String,
+			Codec:        i.Codec.String,
+			Rendition:    uint8(i.Rendition.Int64),
+			OriginalSHA1: i.OriginalSHA1.String,
+			Peaks:        peaks,
+			Thumbs:       decodeThumbs(i.Thumbs),
+			ThumbAVIF:    i.ThumbAVIF.Bool,
 		},
 	}
 }
@@ -236,6 +254,15 @@ func GetThread(id uint64, lastN int) (t common.Thread, err error) {
 			return
 		}
 
+		err = injectReactions(tx, &t)
+		if err != nil {
+			return
+		}
+		err = injectAttachments(tx, &t)
+		if err != nil {
+			return
+		}
+
 		// Inject  moderation into affected posts
 		moderated := make([]*common.Post, 0, 64)
 		filterModerated(&moderated, &t.Post)
@@ -270,7 +297,7 @@ func scanOP(r rowScanner) (t common.Thread, err error) {
 	)
 	args = append(args,
 		&t.Sticky, &t.Board, &t.PostCount, &t.ImageCount, &t.UpdateTime,
-		&t.BumpTime, &t.Subject, &t.Locked,
+		&t.BumpTime, &t.Subject, &t.Locked, &t.Cyclic,
 	)
 	args = append(args, pArgs...)
 	args = append(args, iArgs...)
@@ -337,6 +364,10 @@ func GetPost(id uint64) (res common.StandalonePost, err error) {
 		res.Image.Spoiler, res.Image.Name = post.Image()
 	}
 	res.Claude = claude.Val()
+	res.Attachments, err = getAttachments(nil, res.ID)
+	if err != nil {
+		return
+	}
 
 	if res.Editing {
 		res.Body, err = GetOpenBody(res.ID)
@@ -2,13 +2,22 @@ package db
 
 import (
 	"database/sql"
-	"fmt"
+	"errors"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
 	"github.com/go-playground/log"
 )
 
+// ErrNoActiveReports is returned, when triaging a post without any open or
+// claimed reports
+var ErrNoActiveReports = common.StatusError{
+	Err:  errors.New("no active reports on post"),
+	Code: 404,
+}
+
 // Report a post for rule violations
 func Report(id uint64, board, reason, ip string, illegal bool) error {
 	// If the reported content is illegal, log an error so it will email
@@ -26,30 +35,139 @@ func Report(id uint64, board, reason, ip string, illegal bool) error {
 	return err
 }
 
-// GetReports reads reports for a specific board.
+// Reports visible on a board's report list. "all" lists illegal content
+// reports from all boards.
+func reportScope(board string) squirrel.Eq {
+	if board == "all" {
+		return squirrel.Eq{"illegal": true}
+	}
+	return squirrel.Eq{"board": board}
+}
+
+// GetReports reads reports for a specific board matching filter. Duplicate
+// reports of a post are grouped.
 // Pass "all" for global reports.
-func GetReports(board string) (rep []auth.Report, err error) {
-	var where string
+func GetReports(board string, filter auth.ReportFilter) (
+	rep []auth.Report, err error,
+) {
 	rep = make([]auth.Report, 0, 64)
 	tmp := auth.Report{Board: board}
-	if board == "all" {
-		where = "illegal = true"
-	} else {
-		where = fmt.Sprintf("board = '%s'", board)
-	}
-	err = queryAll(
-		sq.Select("id", "target", "reason", "created").
-			From("reports").
-			Where(where).
-			OrderBy("created desc"),
-		func(r *sql.Rows) (err error) {
-			err = r.Scan(&tmp.ID, &tmp.Target, &tmp.Reason, &tmp.Created)
-			if err != nil {
-				return
-			}
-			rep = append(rep, tmp)
+
+	q := sq.Select(
+		"max(id)", "target", "count(*)", "max(created)",
+		"(array_agg(reason order by created desc))[1]",
+		"state", "assigned_to", "resolved_by", "resolution",
+	).
+		From("reports").
+		Where(reportScope(board)).
+		// Also separates reports resolved at different times
+		GroupBy("target", "state", "assigned_to", "resolved_by", "resolution",
+			"resolved").
+		OrderBy("max(created) desc")
+	switch filter.State {
+	case "":
+		q = q.Where(squirrel.Eq{
+			"state": []string{auth.ReportOpen, auth.ReportClaimed},
+		})
+	case "all":
+	default:
+		q = q.Where("state = ?", filter.State)
+	}
+	if filter.AssignedTo != "" {
+		q = q.Where("assigned_to = ?", filter.AssignedTo)
+	}
+
+	err = queryAll(q, func(r *sql.Rows) (err error) {
+		err = r.Scan(&tmp.ID, &tmp.Target, &tmp.Count, &tmp.Created,
+			&tmp.Reason, &tmp.State, &tmp.AssignedTo, &tmp.ResolvedBy,
+			&tmp.Resolution)
+		if err != nil {
 			return
-		},
-	)
+		}
+		rep = append(rep, tmp)
+		return
+	})
+	return
+}
+
+// Update the active reports of a post visible on board's report list
+func updateActiveReports(board string, target uint64, states []string,
+	set map[string]interface{},
+) (err error) {
+	res, err := sq.Update("reports").
+		SetMap(set).
+		Where(reportScope(board)).
+		Where(squirrel.Eq{
+			"target": target,
+			"state":  states,
+		}).
+		Exec()
+	if err != nil {
+		return
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return
+	}
+	if n == 0 {
+		err = ErrNoActiveReports
+	}
+	return
+}
+
+// ClaimReports assigns all active reports of a post to a moderator
+func ClaimReports(board string, target uint64, by string) error {
+	return updateActiveReports(board, target,
+		[]string{auth.ReportOpen, auth.ReportClaimed},
+		map[string]interface{}{
+			"state":       auth.ReportClaimed,
+			"assigned_to": by,
+		})
+}
+
+// ReleaseReports returns all claimed reports of a post to the open queue
+func ReleaseReports(board string, target uint64) error {
+	return updateActiveReports(board, target,
+		[]string{auth.ReportClaimed},
+		map[string]interface{}{
+			"state":       auth.ReportOpen,
+			"assigned_to": "",
+		})
+}
+
+// ResolveReports resolves or dismisses all active reports of a post with an
+// optional note
+func ResolveReports(board string, target uint64, by, note string,
+	dismiss bool,
+) error {
+	state := auth.ReportResolved
+	if dismiss {
+		state = auth.ReportDismissed
+	}
+	return updateActiveReports(board, target,
+		[]string{auth.ReportOpen, auth.ReportClaimed},
+		map[string]interface{}{
+			"state":       state,
+			"resolved_by": by,
+			"resolution":  note,
+			"resolved":    squirrel.Expr("now() at time zone 'utc'"),
+		})
+}
+
+// ResolvePostReports resolves all active reports of a moderated post
+// regardless of the board they are listed on
+func ResolvePostReports(tx *sql.Tx, target uint64, by string) (err error) {
+	_, err = sq.Update("reports").
+		SetMap(map[string]interface{}{
+			"state":       auth.ReportResolved,
+			"resolved_by": by,
+			"resolved":    squirrel.Expr("now() at time zone 'utc'"),
+		}).
+		Where(squirrel.Eq{
+			"target": target,
+			"state":  []string{auth.ReportOpen, auth.ReportClaimed},
+		}).
+		RunWith(tx).
+		Exec()
 	return
 }
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"database/sql"
 	"testing"
 
 	"github.com/bakape/meguca/auth"
@@ -14,15 +15,17 @@ func TestReports(t *testing.T) {
 
 	std := auth.Report{
 		Target: 1,
+		Count:  1,
 		Board:  "a",
 		Reason: "foo",
+		State:  auth.ReportOpen,
 	}
 	err := Report(std.Target, std.Board, std.Reason, "::1", false)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	res, err := GetReports(std.Board)
+	res, err := GetReports(std.Board, auth.ReportFilter{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -31,3 +34,103 @@ func TestReports(t *testing.T) {
 	std.Created = res[0].Created
 	AssertEquals(t, []auth.Report{std}, res)
 }
+
+func TestReportTriage(t *testing.T) {
+	assertTableClear(t, "boards", "reports")
+	writeSampleBoard(t)
+	writeSampleThread(t)
+
+	for _, reason := range [...]string{"foo", "bar"} {
+		err := Report(1, "a", reason, "::1", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assertReports := func(t *testing.T, filter auth.ReportFilter,
+		std ...auth.Report,
+	) {
+		t.Helper()
+		res, err := GetReports("a", filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, len(res), len(std))
+		for i := range std {
+			std[i].ID = res[i].ID
+			std[i].Created = res[i].Created
+		}
+		AssertEquals(t, res, std)
+	}
+
+	t.Run("grouped", func(t *testing.T) {
+		assertReports(t, auth.ReportFilter{}, auth.Report{
+			Target: 1,
+			Count:  2,
+			Board:  "a",
+			Reason: "bar",
+			State:  auth.ReportOpen,
+		})
+	})
+
+	t.Run("claim", func(t *testing.T) {
+		err := ClaimReports("a", 1, "admin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		std := auth.Report{
+			Target:     1,
+			Count:      2,
+			Board:      "a",
+			Reason:     "bar",
+			State:      auth.ReportClaimed,
+			AssignedTo: "admin",
+		}
+		assertReports(t, auth.ReportFilter{AssignedTo: "admin"}, std)
+		assertReports(t, auth.ReportFilter{State: auth.ReportOpen})
+	})
+
+	t.Run("resolve", func(t *testing.T) {
+		err := InTransaction(false, func(tx *sql.Tx) error {
+			return ResolvePostReports(tx, 1, "admin")
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertReports(t, auth.ReportFilter{})
+		assertReports(t, auth.ReportFilter{State: auth.ReportResolved},
+			auth.Report{
+				Target:     1,
+				Count:      2,
+				Board:      "a",
+				Reason:     "bar",
+				State:      auth.ReportResolved,
+				AssignedTo: "admin",
+				ResolvedBy: "admin",
+			})
+
+		err = ResolveReports("a", 1, "admin", "", true)
+		AssertEquals(t, err, ErrNoActiveReports)
+	})
+
+	t.Run("new report after resolution", func(t *testing.T) {
+		err := Report(1, "a", "baz", "::1", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ResolveReports("a", 1, "admin", "not a rule violation", true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertReports(t, auth.ReportFilter{State: auth.ReportDismissed},
+			auth.Report{
+				Target:     1,
+				Count:      1,
+				Board:      "a",
+				Reason:     "baz",
+				State:      auth.ReportDismissed,
+				ResolvedBy: "admin",
+				Resolution: "not a rule violation",
+			})
+	})
+}
@@ -0,0 +1,239 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/bakape/meguca/common"
+)
+
+// Files referenced by posts and the board and thread they are referenced in.
+// Files are deduplicated by their SHA1 hash, so a file referenced multiple
+// times within a thread is only listed once.
+const fileReferences = `
+	select p.board, p.op, p.sha1
+	from posts as p
+	where p.sha1 is not null
+	union
+	select p.board, p.op, a.sha1
+	from post_attachments as a
+	join posts as p on p.id = a.post_id`
+
+// ErrStorageQuota is returned, when attaching a file, that is not yet
+// referenced on a board, would exceed the board's storage quota
+var ErrStorageQuota = common.StatusError{
+	Err:  errors.New("board storage quota exceeded"),
+	Code: 413,
+}
+
+// StorageUsage is the number and combined size of stored files
+type StorageUsage struct {
+	Files uint64 `json:"files"`
+	Size  uint64 `json:"size"`
+}
+
+// BoardStorageUsage is the storage used by files referenced on a board
+type BoardStorageUsage struct {
+	StorageUsage
+	Board string `json:"board"`
+	// 0 means unlimited
+	Quota uint64 `json:"quota"`
+}
+
+// ThreadStorageUsage is the storage used by files referenced in a thread
+type ThreadStorageUsage struct {
+	StorageUsage
+	ID      uint64 `json:"id"`
+	Board   string `json:"board"`
+	Subject string `json:"subject"`
+}
+
+// StoredFile is a single stored source file and the number of posts
+// referencing it
+type StoredFile struct {
+	SHA1       string `json:"sha1"`
+	FileType   uint8  `json:"file_type"`
+	Size       uint64 `json:"size"`
+	References uint64 `json:"references"`
+}
+
+// StorageReport details the storage used by uploaded files. Files shared
+// between boards or threads count towards the usage of each of them, so the
+// usage of boards and threads does not add up to the total.
+type StorageReport struct {
+	Total   StorageUsage         `json:"total"`
+	Boards  []BoardStorageUsage  `json:"boards"`
+	Threads []ThreadStorageUsage `json:"threads"`
+	Largest []StoredFile         `json:"largest"`
+
+	// Files not referenced by any post or pending allocation token. These
+	// are removed on the next run of deleteUnusedImages.
+	Orphans       StorageUsage `json:"orphans"`
+	OrphanedFiles []StoredFile `json:"orphanedFiles"`
+}
+
+// SetStorageQuota sets the storage quota of a board in bytes. 0 removes the
+// quota.
+func SetStorageQuota(board string, quota uint64) (err error) {
+	_, err = sq.Update("boards").
+		Set("storageQuota", quota).
+		Where("id = ?", board).
+		Exec()
+	return
+}
+
+// GetStorageReport compiles a report of the storage used by uploaded files
+// with up to limit entries in each listing
+func GetStorageReport(limit int) (rep StorageReport, err error) {
+	err = InTransaction(true, func(tx *sql.Tx) (err error) {
+		err = tx.QueryRow(
+			`select count(*), coalesce(sum(size), 0) from images`,
+		).
+			Scan(&rep.Total.Files, &rep.Total.Size)
+		if err != nil {
+			return
+		}
+
+		rep.Boards = make([]BoardStorageUsage, 0, limit)
+		err = queryRows(tx,
+			`with refs as (
+				select distinct r.board, r.sha1
+				from (`+fileReferences+`) as r
+			)
+			select b.id, b.storageQuota, count(i.sha1),
+				coalesce(sum(i.size), 0) as used
+			from boards as b
+			left join refs as r on r.board = b.id
+			left join images as i on i.sha1 = r.sha1
+			group by b.id, b.storageQuota
+			order by used desc, b.id
+			limit $1`,
+			func(r *sql.Rows) (err error) {
+				var b BoardStorageUsage
+				err = r.Scan(&b.Board, &b.Quota, &b.Files, &b.Size)
+				if err != nil {
+					return
+				}
+				rep.Boards = append(rep.Boards, b)
+				return
+			},
+			limit,
+		)
+		if err != nil {
+			return
+		}
+
+		rep.Threads = make([]ThreadStorageUsage, 0, limit)
+		err = queryRows(tx,
+			`with refs as (`+fileReferences+`)
+			select t.id, t.board, t.subject, count(*), sum(i.size) as used
+			from refs as r
+			join threads as t on t.id = r.op
+			join images as i on i.sha1 = r.sha1
+			group by t.id, t.board, t.subject
+			order by used desc, t.id
+			limit $1`,
+			func(r *sql.Rows) (err error) {
+				var t ThreadStorageUsage
+				err = r.Scan(&t.ID, &t.Board, &t.Subject, &t.Files, &t.Size)
+				if err != nil {
+					return
+				}
+				rep.Threads = append(rep.Threads, t)
+				return
+			},
+			limit,
+		)
+		if err != nil {
+			return
+		}
+
+		rep.Largest, err = queryStoredFiles(tx,
+			`with refs as (
+				select sha1 from posts where sha1 is not null
+				union all
+				select sha1 from post_attachments
+			)
+			select l.sha1, l.file_type, l.size, count(r.sha1)
+			from (
+				select sha1, file_type, size
+				from images
+				order by size desc
+				limit $1
+			) as l
+			left join refs as r on r.sha1 = l.sha1
+			group by l.sha1, l.file_type, l.size
+			order by l.size desc, l.sha1`,
+			limit,
+		)
+		if err != nil {
+			return
+		}
+
+		// Must match the conditions of cleanup_images()
+		const orphans = `
+			with refs as (
+				select sha1 from posts where sha1 is not null
+				union
+				select sha1 from post_attachments
+				union
+				select sha1 from image_tokens
+			)
+			select i.sha1, i.file_type, i.size
+			from images as i
+			where not exists (select from refs as r where r.sha1 = i.sha1)`
+		err = tx.QueryRow(
+			`select count(*), coalesce(sum(o.size), 0)
+			from (`+orphans+`) as o`,
+		).
+			Scan(&rep.Orphans.Files, &rep.Orphans.Size)
+		if err != nil {
+			return
+		}
+		rep.OrphanedFiles, err = queryStoredFiles(tx,
+			`select o.*, 0
+			from (`+orphans+`) as o
+			order by o.size desc, o.sha1
+			limit $1`,
+			limit,
+		)
+		return
+	})
+	return
+}
+
+// Run a raw query and pass each row to fn
+func queryRows(tx *sql.Tx, q string, fn func(r *sql.Rows) error,
+	args ...interface{},
+) (err error) {
+	r, err := tx.Query(q, args...)
+	if err != nil {
+		return
+	}
+	defer r.Close()
+
+	for r.Next() {
+		err = fn(r)
+		if err != nil {
+			return
+		}
+	}
+	return r.Err()
+}
+
+// Query a listing of stored files
+func queryStoredFiles(tx *sql.Tx, q string, args ...interface{}) (
+	files []StoredFile, err error,
+) {
+	files = make([]StoredFile, 0, 16)
+	err = queryRows(tx, q, func(r *sql.Rows) (err error) {
+		var f StoredFile
+		err = r.Scan(&f.SHA1, &f.FileType, &f.Size, &f.References)
+		if err != nil {
+			return
+		}
+		files = append(files, f)
+		return
+	}, args...)
+	return
+}
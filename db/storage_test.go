@@ -0,0 +1,122 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/imager/assets"
+	"github.com/bakape/meguca/test"
+)
+
+func writeSampleStorage(t *testing.T) (orphan common.ImageCommon) {
+	t.Helper()
+	assertTableClear(t, "images", "boards")
+	writeSampleImage(t)
+	writeSampleBoard(t)
+	writeSampleThread(t)
+	insertSampleImage(t)
+
+	orphan = common.ImageCommon{
+		SHA1:     test.GenString(40),
+		MD5:      test.GenString(22),
+		FileType: common.PNG,
+		Size:     10,
+	}
+	if err := WriteImage(orphan); err != nil {
+		t.Fatal(err)
+	}
+	return
+}
+
+func TestStorageQuota(t *testing.T) {
+	orphan := writeSampleStorage(t)
+	assertExec(t, `update boards set maxAttachments = 4 where id = 'a'`)
+
+	insert := func(sha1 string) error {
+		token := newImageToken(t, sha1)
+		return InTransaction(false, func(tx *sql.Tx) (err error) {
+			_, err = InsertImage(tx, 1, token, "foo", false)
+			return
+		})
+	}
+	used := uint64(assets.StdJPEG.Size)
+
+	err := SetStorageQuota("a", used+uint64(orphan.Size)-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, insert(orphan.SHA1), error(ErrStorageQuota))
+
+	// Files already referenced on the board do not count again
+	if err := insert(assets.StdJPEG.SHA1); err != nil {
+		t.Fatal(err)
+	}
+
+	err = SetStorageQuota("a", used+uint64(orphan.Size))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := insert(orphan.SHA1); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStorageReport(t *testing.T) {
+	orphan := writeSampleStorage(t)
+
+	err := SetStorageQuota("a", 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rep, err := GetStorageReport(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	std := assets.StdJPEG
+	used := StorageUsage{
+		Files: 1,
+		Size:  uint64(std.Size),
+	}
+	orphaned := StoredFile{
+		SHA1:     orphan.SHA1,
+		FileType: common.PNG,
+		Size:     10,
+	}
+	test.AssertEquals(t, rep, StorageReport{
+		Total: StorageUsage{
+			Files: 2,
+			Size:  uint64(std.Size) + 10,
+		},
+		Boards: []BoardStorageUsage{
+			{
+				StorageUsage: used,
+				Board:        "a",
+				Quota:        1 << 20,
+			},
+		},
+		Threads: []ThreadStorageUsage{
+			{
+				StorageUsage: used,
+				ID:           1,
+				Board:        "a",
+			},
+		},
+		Largest: []StoredFile{
+			{
+				SHA1:       std.SHA1,
+				FileType:   std.FileType,
+				Size:       uint64(std.Size),
+				References: 1,
+			},
+			orphaned,
+		},
+		Orphans: StorageUsage{
+			Files: 1,
+			Size:  10,
+		},
+		OrphanedFiles: []StoredFile{orphaned},
+	})
+}
@@ -0,0 +1,326 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+)
+
+var (
+	// ErrSameBoard is returned, when moving a thread to the board it is
+	// already on
+	ErrSameBoard = common.ErrInvalidInput("thread already on board")
+
+	// ErrSameThread is returned, when merging a thread into itself
+	ErrSameThread = common.ErrInvalidInput("can not merge thread into itself")
+
+	// ErrNotReply is returned, when splitting a thread off at its OP
+	ErrNotReply = common.ErrInvalidInput("post is not a reply")
+)
+
+// ThreadRef identifies a thread on a board
+type ThreadRef struct {
+	ID    uint64
+	Board string
+}
+
+// MoveThread moves a thread and all of its posts to another board. Returns
+// the threads, whose cached pages are no longer valid.
+func MoveThread(id uint64, board, by string) (stale []ThreadRef, err error) {
+	err = InTransaction(false, func(tx *sql.Tx) (err error) {
+		old, err := lockThread(tx, id)
+		if err != nil {
+			return
+		}
+		if old == board {
+			return ErrSameBoard
+		}
+
+		ids, err := threadPostIDs(tx, id)
+		if err != nil {
+			return
+		}
+		stale, err = linkingThreads(tx, ids)
+		if err != nil {
+			return
+		}
+		stale = append(stale, ThreadRef{id, old}, ThreadRef{id, board})
+
+		_, err = sq.Update("threads").
+			Set("board", board).
+			Where("id = ?", id).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		err = reparentPosts(tx, ids, id, old, board)
+		if err != nil {
+			return
+		}
+
+		return logModeration(tx, auth.ModLogEntry{
+			ModerationEntry: common.ModerationEntry{
+				Type: common.MoveThread,
+				By:   by,
+				Data: old,
+			},
+			Board: board,
+			ID:    id,
+		})
+	})
+	return
+}
+
+// MergeThreads moves all posts of thread src into thread dst and deletes src.
+// The former OP of src becomes a reply in dst. Returns the threads, whose
+// cached pages are no longer valid.
+func MergeThreads(src, dst uint64, by string) (stale []ThreadRef, err error) {
+	if src == dst {
+		return nil, ErrSameThread
+	}
+
+	err = InTransaction(false, func(tx *sql.Tx) (err error) {
+		srcBoard, err := lockThread(tx, src)
+		if err != nil {
+			return
+		}
+		dstBoard, err := lockThread(tx, dst)
+		if err != nil {
+			return
+		}
+
+		ids, err := threadPostIDs(tx, src)
+		if err != nil {
+			return
+		}
+		stale, err = linkingThreads(tx, ids)
+		if err != nil {
+			return
+		}
+		stale = append(stale,
+			ThreadRef{src, srcBoard},
+			ThreadRef{dst, dstBoard},
+		)
+
+		err = reparentPosts(tx, ids, dst, srcBoard, dstBoard)
+		if err != nil {
+			return
+		}
+		// Notifies listeners of the deletion of src
+		_, err = sq.Delete("threads").
+			Where("id = ?", src).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		err = notifyPostCount(tx, dst)
+		if err != nil {
+			return
+		}
+
+		return logModeration(tx, auth.ModLogEntry{
+			ModerationEntry: common.ModerationEntry{
+				Type: common.MergeThread,
+				By:   by,
+				Data: fmt.Sprintf(">>%d", dst),
+			},
+			Board: dstBoard,
+			ID:    src,
+		})
+	})
+	return
+}
+
+// SplitThread moves a reply and all replies in its thread, that link to it
+// directly or through other such replies, into a new thread with the reply as
+// its OP. The subject of the original thread is used, if subject is empty.
+// Returns the threads, whose cached pages are no longer valid.
+func SplitThread(id uint64, subject, by string) (stale []ThreadRef, err error) {
+	err = InTransaction(false, func(tx *sql.Tx) (err error) {
+		var (
+			op         uint64
+			board, sub string
+		)
+		err = sq.Select("p.op", "t.board", "t.subject").
+			From("posts as p").
+			Join("threads as t on t.id = p.op").
+			Where("p.id = ?", id).
+			Suffix("for update of t").
+			RunWith(tx).
+			QueryRow().
+			Scan(&op, &board, &sub)
+		if err != nil {
+			return
+		}
+		if op == id {
+			return ErrNotReply
+		}
+		if subject == "" {
+			subject = sub
+		}
+
+		ids := make([]uint64, 0, 16)
+		err = queryRows(tx,
+			`with recursive subthread(id) as (
+				select $1::bigint
+				union
+				select l.source
+				from links as l
+				join subthread as s on s.id = l.target
+				join posts as p on p.id = l.source
+				where p.op = $2
+			)
+			select id from subthread`,
+			func(r *sql.Rows) (err error) {
+				var id uint64
+				err = r.Scan(&id)
+				if err != nil {
+					return
+				}
+				ids = append(ids, id)
+				return
+			},
+			id, op,
+		)
+		if err != nil {
+			return
+		}
+		stale, err = linkingThreads(tx, ids)
+		if err != nil {
+			return
+		}
+		stale = append(stale, ThreadRef{op, board}, ThreadRef{id, board})
+
+		_, err = sq.Insert("threads").
+			Columns("id", "board", "subject").
+			Values(id, board, subject).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		err = reparentPosts(tx, ids, id, board, board)
+		if err != nil {
+			return
+		}
+		for _, op := range [...]uint64{op, id} {
+			err = notifyPostCount(tx, op)
+			if err != nil {
+				return
+			}
+		}
+
+		return logModeration(tx, auth.ModLogEntry{
+			ModerationEntry: common.ModerationEntry{
+				Type: common.SplitThread,
+				By:   by,
+				Data: fmt.Sprintf(">>%d", op),
+			},
+			Board: board,
+			ID:    id,
+		})
+	})
+	return
+}
+
+// Lock a thread for the rest of the transaction and return its board
+func lockThread(tx *sql.Tx, id uint64) (board string, err error) {
+	err = sq.Select("board").
+		From("threads").
+		Where("id = ?", id).
+		Suffix("for update").
+		RunWith(tx).
+		QueryRow().
+		Scan(&board)
+	return
+}
+
+// Return the IDs of all posts in a thread
+func threadPostIDs(tx *sql.Tx, op uint64) (ids []uint64, err error) {
+	ids = make([]uint64, 0, 64)
+	err = queryAll(
+		sq.Select("id").
+			From("posts").
+			Where("op = ?", op).
+			RunWith(tx),
+		func(r *sql.Rows) (err error) {
+			var id uint64
+			err = r.Scan(&id)
+			if err != nil {
+				return
+			}
+			ids = append(ids, id)
+			return
+		},
+	)
+	return
+}
+
+// Return the threads with posts linking to any of ids. The thread and board
+// of a linked post are resolved, when reading the linking post, so the links
+// themselves stay valid, but any cached renders of these threads do not.
+func linkingThreads(tx *sql.Tx, ids []uint64) (threads []ThreadRef, err error) {
+	threads = make([]ThreadRef, 0, 8)
+	err = queryAll(
+		sq.Select("distinct t.id", "t.board").
+			From("links as l").
+			Join("posts as p on p.id = l.source").
+			Join("threads as t on t.id = p.op").
+			Where(squirrel.Eq{"l.target": ids}).
+			OrderBy("t.id").
+			RunWith(tx),
+		func(r *sql.Rows) (err error) {
+			var t ThreadRef
+			err = r.Scan(&t.ID, &t.Board)
+			if err != nil {
+				return
+			}
+			threads = append(threads, t)
+			return
+		},
+	)
+	return
+}
+
+// Move posts and any of their polls and open reports to thread op on board
+func reparentPosts(tx *sql.Tx, ids []uint64, op uint64, from, to string,
+) (err error) {
+	_, err = sq.Update("posts").
+		Set("op", op).
+		Set("board", to).
+		Where(squirrel.Eq{"id": ids}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return
+	}
+	_, err = sq.Update("polls").
+		Set("op", op).
+		Where(squirrel.Eq{"id": ids}).
+		RunWith(tx).
+		Exec()
+	if err != nil || from == to {
+		return
+	}
+	_, err = sq.Update("reports").
+		Set("board", to).
+		Where(squirrel.Eq{"target": ids, "board": from}).
+		RunWith(tx).
+		Exec()
+	return
+}
+
+// Propagate the post count of a thread to the post count cache
+func notifyPostCount(tx *sql.Tx, op uint64) (err error) {
+	_, err = tx.Exec(
+		`select pg_notify('new_post_in_thread',
+			$1::bigint || ',' || post_count($1::bigint))`,
+		op,
+	)
+	return
+}
@@ -0,0 +1,160 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestThreadModeration(t *testing.T) {
+	prepareForModeration(t)
+
+	err := InTransaction(false, func(tx *sql.Tx) error {
+		return WriteBoard(tx, BoardConfigs{
+			BoardConfigs: config.BoardConfigs{
+				ID:        "c",
+				Eightball: []string{"yes"},
+			},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = WriteThread(
+		Thread{
+			ID:      5,
+			Board:   "a",
+			Subject: "other",
+		},
+		Post{
+			StandalonePost: common.StandalonePost{
+				Post: common.Post{
+					ID: 5,
+				},
+				OP:    5,
+				Board: "a",
+			},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range [...]struct {
+		id, op uint64
+	}{
+		{2, 1},
+		{3, 1},
+		{4, 1},
+		{6, 5},
+	} {
+		err := InTransaction(false, func(tx *sql.Tx) error {
+			return InsertPost(tx, &Post{
+				StandalonePost: common.StandalonePost{
+					Post: common.Post{
+						ID: p.id,
+					},
+					OP:    p.op,
+					Board: "a",
+				},
+			})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 3 and 4 reply to 2 and 6 links to it from another thread
+	assertExec(t,
+		`insert into links (source, target)
+		values (3, 2), (4, 3), (6, 2)`)
+
+	assertParenthood := func(t *testing.T, board string, op uint64,
+		ids ...uint64,
+	) {
+		t.Helper()
+		for _, id := range ids {
+			b, o, err := GetPostParenthood(id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEquals(t, [2]interface{}{b, o}, [2]interface{}{board, op})
+		}
+	}
+
+	t.Run("split OP", func(t *testing.T) {
+		_, err := SplitThread(1, "", "admin")
+		AssertEquals(t, err, ErrNotReply)
+	})
+
+	t.Run("split", func(t *testing.T) {
+		stale, err := SplitThread(2, "", "admin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, stale, []ThreadRef{
+			// Linking to the split off posts
+			{1, "a"},
+			{5, "a"},
+			// Split from and into
+			{1, "a"},
+			{2, "a"},
+		})
+		assertParenthood(t, "a", 2, 2, 3, 4)
+		assertParenthood(t, "a", 1, 1)
+
+		valid, err := ValidateOP(2, "a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, valid, true)
+	})
+
+	t.Run("merge into itself", func(t *testing.T) {
+		_, err := MergeThreads(2, 2, "admin")
+		AssertEquals(t, err, ErrSameThread)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		_, err := MergeThreads(2, 5, "admin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertParenthood(t, "a", 5, 2, 3, 4, 5, 6)
+
+		valid, err := ValidateOP(2, "a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, valid, false)
+	})
+
+	t.Run("move", func(t *testing.T) {
+		_, err := MoveThread(5, "c", "admin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertParenthood(t, "c", 5, 2, 3, 4, 5, 6)
+
+		_, err = MoveThread(5, "c", "admin")
+		AssertEquals(t, err, ErrSameBoard)
+	})
+
+	t.Run("mod log", func(t *testing.T) {
+		for _, typ := range [...]common.ModerationAction{
+			common.SplitThread,
+			common.MergeThread,
+			common.MoveThread,
+		} {
+			var n int
+			err := sqlDB.
+				QueryRow(`select count(*) from mod_log where type = $1`, typ).
+				Scan(&n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEquals(t, n, 1)
+		}
+	})
+}
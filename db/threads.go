@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/bakape/meguca/common"
@@ -228,6 +229,82 @@ func CheckThreadLocked(id uint64) (locked bool, err error) {
 	return
 }
 
+// ThreadSlowMode returns the slow mode interval of a thread in seconds
+func ThreadSlowMode(op uint64) (secs uint32, err error) {
+	err = sq.Select("slow_mode").
+		From("threads").
+		Where("id = ?", op).
+		QueryRow().
+		Scan(&secs)
+	return
+}
+
+// SlowModeCooldown returns the minimum interval between posts of the same IP
+// and how much of it is still remaining for ip. threadInterval applies to
+// posts in thread op and boardInterval to posts anywhere on the board.
+// The returned interval is the stricter of the two.
+//
+// Must be run in the transaction inserting the post. Concurrent posts of the
+// same IP on a board are serialized with a transaction-scoped advisory lock,
+// so they can not all pass the check before any is inserted.
+func SlowModeCooldown(
+	tx *sql.Tx,
+	op uint64,
+	board, ip string,
+	threadInterval, boardInterval uint32,
+) (
+	interval, remaining time.Duration, err error,
+) {
+	if threadInterval == 0 && boardInterval == 0 {
+		return
+	}
+
+	_, err = tx.Exec(`select pg_advisory_xact_lock(hashtext($1))`,
+		"slow_mode:"+board+":"+ip)
+	if err != nil {
+		return
+	}
+
+	var inThread, onBoard sql.NullInt64
+	err = sq.Select().
+		Column(`(
+			select max(p.time)
+			from posts as p
+			where p.op = ? and p.ip = ?
+		)`, op, ip).
+		Column(`(
+			select max(p.time)
+			from posts as p
+			where p.board = ? and p.ip = ?
+		)`, board, ip).
+		RunWith(tx).
+		QueryRow().
+		Scan(&inThread, &onBoard)
+	if err != nil {
+		return
+	}
+
+	for _, c := range [...]struct {
+		secs uint32
+		last sql.NullInt64
+	}{
+		{threadInterval, inThread},
+		{boardInterval, onBoard},
+	} {
+		i := time.Duration(c.secs) * time.Second
+		if i > interval {
+			interval = i
+		}
+		if !c.last.Valid {
+			continue
+		}
+		if rem := i - time.Since(time.Unix(c.last.Int64, 0)); rem > remaining {
+			remaining = rem
+		}
+	}
+	return
+}
+
 func CheckIpPostCount(ip string) (count int, err error) {
 	err = sq.Select("count(*)").
 		From("posts").
@@ -129,3 +129,148 @@ func TestInsertThread(t *testing.T) {
 		t.Fatal(p.ID)
 	}
 }
+
+func TestSlowMode(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+	writeSampleThread(t)
+
+	// Thread on the same board without posts by the tested IP
+	err := WriteThread(
+		Thread{
+			ID:    2,
+			Board: "a",
+		},
+		Post{
+			StandalonePost: common.StandalonePost{
+				Post: common.Post{
+					ID:   2,
+					Time: time.Now().Unix(),
+				},
+				OP:    2,
+				Board: "a",
+			},
+			IP: "::3",
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert := func(
+		t *testing.T,
+		op uint64,
+		ip string,
+		board uint32,
+		interval time.Duration,
+		onCooldown bool,
+	) {
+		t.Helper()
+
+		thread, err := ThreadSlowMode(op)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var i, rem time.Duration
+		err = InTransaction(false, func(tx *sql.Tx) (err error) {
+			i, rem, err = SlowModeCooldown(tx, op, "a", ip, thread, board)
+			return
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, i, interval)
+		test.AssertEquals(t, rem > 0, onCooldown)
+		if rem > interval {
+			t.Fatalf("remaining cooldown exceeds interval: %s", rem)
+		}
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		assert(t, 1, "::1", 0, 0, false)
+	})
+	t.Run("board", func(t *testing.T) {
+		assert(t, 1, "::1", 60, time.Minute, true)
+	})
+	t.Run("board in other thread", func(t *testing.T) {
+		assert(t, 2, "::1", 60, time.Minute, true)
+	})
+	t.Run("other IP", func(t *testing.T) {
+		assert(t, 1, "::2", 60, time.Minute, false)
+	})
+
+	err = SetThreadSlowMode(1, 120, "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("thread", func(t *testing.T) {
+		assert(t, 1, "::1", 60, 2*time.Minute, true)
+	})
+	t.Run("thread only", func(t *testing.T) {
+		assert(t, 1, "::1", 0, 2*time.Minute, true)
+	})
+	t.Run("thread in other thread", func(t *testing.T) {
+		assert(t, 2, "::1", 0, 0, false)
+	})
+	t.Run("stricter board", func(t *testing.T) {
+		assert(t, 1, "::1", 300, 5*time.Minute, true)
+	})
+}
+
+func TestCyclicThread(t *testing.T) {
+	prepareForPostInsertion(t)
+	writeAdminAccount(t)
+	assertExec(t, `update boards set bumpLimit = 3 where id = 'a'`)
+
+	err := SetThreadCyclic(1, true, "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := make([]uint64, 0, 4)
+	for i := 0; i < 4; i++ {
+		p := Post{
+			StandalonePost: common.StandalonePost{
+				OP:    1,
+				Board: "a",
+			},
+			IP: "::1",
+		}
+		err = InTransaction(false, func(tx *sql.Tx) error {
+			return InsertPost(tx, &p)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, p.ID)
+	}
+
+	// Only the OP and the 2 newest replies remain
+	var res []uint64
+	err = queryAll(
+		sq.Select("id").
+			From("posts").
+			Where("op = 1").
+			OrderBy("id"),
+		func(r *sql.Rows) (err error) {
+			var id uint64
+			err = r.Scan(&id)
+			if err != nil {
+				return
+			}
+			res = append(res, id)
+			return
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, res, []uint64{1, ids[2], ids[3]})
+
+	thread, err := GetThread(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, thread.Cyclic, true)
+}
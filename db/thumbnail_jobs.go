@@ -0,0 +1,301 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bakape/meguca/common"
+)
+
+// Thumbnailing job priorities. Lower values are processed first.
+const (
+	UploadJobPriority uint8 = iota
+	TikTokJobPriority
+	TranscodeJobPriority
+	BackfillJobPriority
+)
+
+// Thumbnailing job states
+const (
+	JobPending   = "pending"
+	JobRunning   = "running"
+	JobDone      = "done"
+	JobFailed    = "failed"
+	JobCancelled = "cancelled"
+)
+
+// ThumbnailJob is a queued request to process an uploaded file
+type ThumbnailJob struct {
+	ID       uint64
+	Priority uint8
+	// Processed by the worker for large files
+	Large bool
+	// Path to the spooled file on disk
+	Path       string
+	Filename   string
+	Size       int
+	TikTokName *string
+	// Number of previous attempts to process the job
+	Attempts uint
+	// Transcode an already thumbnailed file instead of thumbnailing it
+	Transcode bool
+	// SHA1 hash of the file to transcode or regenerate thumbnails of
+	SHA1 string
+	// Strip identifying metadata from the file
+	StripMetadata bool
+	// Regenerate the additional thumbnails of an already stored file instead
+	// of thumbnailing a spooled one
+	Regenerate bool
+}
+
+// FailedThumbnailJob is a thumbnailing job, that failed permanently
+type FailedThumbnailJob struct {
+	ID       uint64 `json:"id"`
+	Filename string `json:"filename"`
+	Attempts uint   `json:"attempts"`
+	Error    string `json:"error"`
+	Finished int64  `json:"finished"`
+}
+
+// ThumbnailQueueStats contains the current state of the thumbnailing queue
+type ThumbnailQueueStats struct {
+	Pending struct {
+		Uploads    uint `json:"uploads"`
+		TikToks    uint `json:"tiktoks"`
+		Transcodes uint `json:"transcodes"`
+		Backfills  uint `json:"backfills"`
+	} `json:"pending"`
+	Running        uint                 `json:"running"`
+	RecentFailures []FailedThumbnailJob `json:"recentFailures"`
+}
+
+// InsertThumbnailJob adds a new job to the thumbnailing queue and sets its ID
+func InsertThumbnailJob(j *ThumbnailJob) error {
+	return sq.Insert("thumbnail_jobs").
+		Columns("priority", "large", "path", "filename", "size",
+			"tiktok_name", "transcode", "sha1", "strip_metadata", "regenerate").
+		Values(j.Priority, j.Large, j.Path, j.Filename, j.Size, j.TikTokName,
+			j.Transcode, sql.NullString{
+				String: j.SHA1,
+				Valid:  j.SHA1 != "",
+			}, j.StripMetadata, j.Regenerate).
+		Suffix("returning id").
+		QueryRow().
+		Scan(&j.ID)
+}
+
+// InsertThumbnailBackfillJobs queues regeneration of the additional
+// thumbnails of all thumbnailed images lacking them or, if all is true, of all
+// thumbnailed images. Images bigger than largeSize are processed by the worker
+// for large files. Returns the number of queued jobs.
+func InsertThumbnailBackfillJobs(all bool, largeSize int) (n int64, err error) {
+	res, err := sqlDB.Exec(
+		`insert into thumbnail_jobs
+			(priority, large, path, filename, size, sha1, regenerate)
+		select $1, i.size > $2, '', i.sha1, i.size, i.sha1, true
+		from images as i
+		where i.thumb_type != $3
+			and ($4 or i.thumbs is null)
+			and not exists (
+				select
+				from thumbnail_jobs as j
+				where j.sha1 = i.sha1
+					and j.regenerate
+					and j.state in ($5, $6)
+			)`,
+		BackfillJobPriority, largeSize, common.NoFile, all, JobPending,
+		JobRunning,
+	)
+	if err != nil {
+		return
+	}
+	return res.RowsAffected()
+}
+
+// ClaimThumbnailJob marks the next due pending job of the small or large file
+// queue as running and returns it. ok == false, if there are no due jobs.
+func ClaimThumbnailJob(large bool) (j ThumbnailJob, ok bool, err error) {
+	err = sqlDB.QueryRow(
+		`update thumbnail_jobs
+		set state = $1, started = now(), attempts = attempts + 1
+		where id = (
+			select id
+			from thumbnail_jobs
+			where state = $2 and large = $3 and next_attempt <= now()
+			order by priority, id
+			limit 1
+			for update skip locked
+		)
+		returning id, priority, large, path, filename, size, tiktok_name,
+			attempts - 1, transcode, coalesce(sha1, ''), strip_metadata,
+			regenerate`,
+		JobRunning, JobPending, large,
+	).
+		Scan(&j.ID, &j.Priority, &j.Large, &j.Path, &j.Filename, &j.Size,
+			&j.TikTokName, &j.Attempts, &j.Transcode, &j.SHA1,
+			&j.StripMetadata, &j.Regenerate)
+	switch err {
+	case nil:
+		ok = true
+	case sql.ErrNoRows:
+		err = nil
+	}
+	return
+}
+
+// FinishThumbnailJob marks a running job as successfully completed
+func FinishThumbnailJob(id uint64) error {
+	return setThumbnailJobState(id, JobDone, "")
+}
+
+// FailThumbnailJob records the failure of a running job. If retryIn is not
+// zero, the job is retried after the delay. Otherwise it fails permanently.
+func FailThumbnailJob(id uint64, reason string, retryIn time.Duration,
+) error {
+	if retryIn == 0 {
+		return setThumbnailJobState(id, JobFailed, reason)
+	}
+	_, err := sq.Update("thumbnail_jobs").
+		Set("state", JobPending).
+		Set("error", reason).
+		Set("next_attempt", time.Now().Add(retryIn)).
+		Where("id = ? and state = ?", id, JobRunning).
+		Exec()
+	return err
+}
+
+// Transition a running job into a final state
+func setThumbnailJobState(id uint64, state, reason string) error {
+	q := sq.Update("thumbnail_jobs").
+		Set("state", state).
+		Set("finished", time.Now()).
+		Where("id = ? and state = ?", id, JobRunning)
+	if reason != "" {
+		q = q.Set("error", reason)
+	}
+	_, err := q.Exec()
+	return err
+}
+
+// CancelThumbnailJob cancels a pending or running job. Returns, if the job
+// was cancelled.
+func CancelThumbnailJob(id uint64) (cancelled bool, err error) {
+	res, err := sq.Update("thumbnail_jobs").
+		Set("state", JobCancelled).
+		Set("finished", time.Now()).
+		Where("id = ? and state in (?, ?)", id, JobPending, JobRunning).
+		Exec()
+	if err != nil {
+		return
+	}
+	n, err := res.RowsAffected()
+	cancelled = n != 0
+	return
+}
+
+// GetThumbnailJobPath returns the path to the spooled file of a job
+func GetThumbnailJobPath(id uint64) (path string, err error) {
+	err = sq.Select("path").
+		From("thumbnail_jobs").
+		Where("id = ?", id).
+		QueryRow().
+		Scan(&path)
+	return
+}
+
+// RequeueStaleThumbnailJobs returns thumbnailing jobs left running for longer
+// than timeout and transcoding jobs left running for longer than
+// transcodeTimeout, such as after a server crash, to the pending queue.
+// Stale jobs, that have already been attempted maxAttempts times, fail
+// permanently instead, as they are likely to be crashing the server.
+func RequeueStaleThumbnailJobs(
+	timeout, transcodeTimeout time.Duration,
+	maxAttempts uint,
+) error {
+	now := time.Now()
+	stale := squirrel.Expr(
+		`state = ?
+		and started < case when transcode then ?::timestamptz
+			else ?::timestamptz end`,
+		JobRunning, now.Add(-transcodeTimeout), now.Add(-timeout),
+	)
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		_, err = sq.Update("thumbnail_jobs").
+			Set("state", JobFailed).
+			Set("finished", now).
+			Set("error", "processing did not finish").
+			Where(stale).
+			Where("attempts >= ?", maxAttempts).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		_, err = sq.Update("thumbnail_jobs").
+			Set("state", JobPending).
+			Where(stale).
+			RunWith(tx).
+			Exec()
+		return
+	})
+}
+
+// GetThumbnailQueueStats returns queue depth, running job count and up to
+// 20 most recent permanent failures
+func GetThumbnailQueueStats() (s ThumbnailQueueStats, err error) {
+	err = queryAll(
+		sq.Select("state", "priority", "count(*)").
+			From("thumbnail_jobs").
+			Where("state in (?, ?)", JobPending, JobRunning).
+			GroupBy("state", "priority"),
+		func(r *sql.Rows) (err error) {
+			var (
+				state    string
+				priority uint8
+				n        uint
+			)
+			err = r.Scan(&state, &priority, &n)
+			if err != nil {
+				return
+			}
+			switch {
+			case state == JobRunning:
+				s.Running += n
+			case priority == TikTokJobPriority:
+				s.Pending.TikToks += n
+			case priority == TranscodeJobPriority:
+				s.Pending.Transcodes += n
+			case priority == BackfillJobPriority:
+				s.Pending.Backfills += n
+			default:
+				s.Pending.Uploads += n
+			}
+			return
+		},
+	)
+	if err != nil {
+		return
+	}
+
+	s.RecentFailures = make([]FailedThumbnailJob, 0, 20)
+	err = queryAll(
+		sq.Select("id", "filename", "attempts", "coalesce(error, '')",
+			"extract(epoch from finished)::bigint").
+			From("thumbnail_jobs").
+			Where("state = ?", JobFailed).
+			OrderBy("finished desc").
+			Limit(20),
+		func(r *sql.Rows) (err error) {
+			var f FailedThumbnailJob
+			err = r.Scan(&f.ID, &f.Filename, &f.Attempts, &f.Error,
+				&f.Finished)
+			if err != nil {
+				return
+			}
+			s.RecentFailures = append(s.RecentFailures, f)
+			return
+		},
+	)
+	return
+}
@@ -0,0 +1,268 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/imager/assets"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestThumbnailJobQueue(t *testing.T) {
+	assertTableClear(t, "thumbnail_jobs")
+
+	tiktok := "foo"
+	jobs := [...]ThumbnailJob{
+		{
+			Priority:   TikTokJobPriority,
+			Path:       "tmp/1",
+			Filename:   "tiktok.mp4",
+			Size:       1,
+			TikTokName: &tiktok,
+		},
+		{
+			Priority: UploadJobPriority,
+			Path:     "tmp/2",
+			Filename: "upload.png",
+			Size:     1,
+		},
+		{
+			Priority: UploadJobPriority,
+			Large:    true,
+			Path:     "tmp/3",
+			Filename: "large.webm",
+			Size:     5 << 20,
+		},
+	}
+	for i := range jobs {
+		err := InsertThumbnailJob(&jobs[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stats, err := GetThumbnailQueueStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, stats.Pending.Uploads, uint(2))
+	AssertEquals(t, stats.Pending.TikToks, uint(1))
+
+	claim := func(large bool) ThumbnailJob {
+		t.Helper()
+		j, ok, err := ClaimThumbnailJob(large)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			t.Fatal("no job claimed")
+		}
+		return j
+	}
+
+	// Uploads take priority over TikToks
+	AssertEquals(t, claim(false), jobs[1])
+	AssertEquals(t, claim(true), jobs[2])
+
+	// Retry with backoff
+	err = FailThumbnailJob(jobs[1].ID, "foo", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	j := claim(false)
+	AssertEquals(t, j.ID, jobs[0].ID)
+	AssertEquals(t, *j.TikTokName, tiktok)
+	_, ok, err := ClaimThumbnailJob(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("job claimed before retry delay")
+	}
+
+	err = FinishThumbnailJob(jobs[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = FailThumbnailJob(jobs[2].ID, "bar", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancelled, err := CancelThumbnailJob(jobs[1].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, cancelled, true)
+	cancelled, err = CancelThumbnailJob(jobs[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, cancelled, false)
+
+	stats, err = GetThumbnailQueueStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, stats.Pending.Uploads, uint(0))
+	AssertEquals(t, stats.Running, uint(0))
+	AssertEquals(t, len(stats.RecentFailures), 1)
+	f := stats.RecentFailures[0]
+	AssertEquals(t, f.ID, jobs[2].ID)
+	AssertEquals(t, f.Error, "bar")
+	AssertEquals(t, f.Attempts, uint(1))
+}
+
+func TestRequeueStaleThumbnailJobs(t *testing.T) {
+	assertTableClear(t, "thumbnail_jobs")
+
+	j := ThumbnailJob{
+		Path:     "tmp/1",
+		Filename: "upload.png",
+		Size:     1,
+	}
+	err := InsertThumbnailJob(&j)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ok, err := ClaimThumbnailJob(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("no job claimed")
+	}
+
+	// Simulate a crash
+	err = RequeueStaleThumbnailJobs(0, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	claimed, ok, err := ClaimThumbnailJob(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("job not requeued")
+	}
+	AssertEquals(t, claimed.Attempts, uint(1))
+
+	// Jobs crashing the server on every attempt fail permanently
+	err = RequeueStaleThumbnailJobs(0, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ok, err = ClaimThumbnailJob(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("job requeued after max attempts")
+	}
+	s, err := GetThumbnailQueueStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, len(s.RecentFailures), 1)
+	AssertEquals(t, s.RecentFailures[0].Attempts, uint(2))
+}
+
+func TestTranscodeJob(t *testing.T) {
+	assertTableClear(t, "thumbnail_jobs")
+
+	j := ThumbnailJob{
+		Priority:  TranscodeJobPriority,
+		Large:     true,
+		Path:      "tmp/1",
+		Filename:  "upload.mkv",
+		Size:      1,
+		Transcode: true,
+		SHA1:      GenString(40),
+	}
+	err := InsertThumbnailJob(&j)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := GetThumbnailQueueStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, stats.Pending.Transcodes, uint(1))
+	AssertEquals(t, stats.Pending.Uploads, uint(0))
+
+	claimed, ok, err := ClaimThumbnailJob(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("no job claimed")
+	}
+	AssertEquals(t, claimed, j)
+
+	// Transcoding jobs have a separate, longer timeout
+	err = RequeueStaleThumbnailJobs(0, time.Hour, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ok, err = ClaimThumbnailJob(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("transcoding job requeued before timeout")
+	}
+}
+
+func TestThumbnailBackfillJobs(t *testing.T) {
+	assertTableClear(t, "thumbnail_jobs", "images")
+	writeSampleImage(t)
+
+	done := assets.StdJPEG.ImageCommon
+	done.SHA1 = GenString(40)
+	done.Thumbs = map[uint16][2]uint16{}
+	noThumb := assets.StdJPEG.ImageCommon
+	noThumb.SHA1 = GenString(40)
+	noThumb.ThumbType = common.NoFile
+	for _, img := range [...]common.ImageCommon{done, noThumb} {
+		err := WriteImage(img)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	queue := func(all bool, std int64) {
+		t.Helper()
+		n, err := InsertThumbnailBackfillJobs(all, 1<<20)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, n, std)
+	}
+
+	queue(false, 1)
+	// Already queued images are skipped
+	queue(false, 0)
+
+	stats, err := GetThumbnailQueueStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, stats.Pending.Backfills, uint(1))
+
+	j, ok, err := ClaimThumbnailJob(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("no job claimed")
+	}
+	AssertEquals(t, j.Regenerate, true)
+	AssertEquals(t, j.SHA1, assets.StdJPEG.SHA1)
+	err = FinishThumbnailJob(j.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	queue(true, 2)
+}
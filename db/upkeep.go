@@ -61,8 +61,12 @@ func runHalfTasks() {
 func runHourTasks() {
 	if config.Server.ImagerMode != config.ImagerOnly {
 		expireRows("sessions")
-		expireBy("created < now() at time zone 'utc' + '-7 days'",
-			"mod_log", "reports")
+		expirePendingPosts()
+		expireBanAppeals()
+		expireModLog()
+		expireBy("resolved < now() at time zone 'utc' + '-7 days'", "reports")
+		expireBy("not exists (select from posts as p where p.id = target)",
+			"reports")
 		logError("remove identity info", removeIdentityInfo())
 		logError("thread cleanup", deleteOldThreads())
 		logError("board cleanup", deleteUnusedBoards())
@@ -73,6 +77,7 @@ func runHourTasks() {
 	}
 	if config.Server.ImagerMode != config.NoImager {
 		logError("image cleanup", deleteUnusedImages())
+		expireBy("finished < now() - interval '1 day'", "thumbnail_jobs")
 	}
 }
 
@@ -93,6 +98,47 @@ func expireBy(criterion string, tables ...string) {
 	}
 }
 
+// Expire moderation log entries older than the configured retention period
+func expireModLog() {
+	days := config.Get().ModLogExpiry
+	if days == 0 {
+		return
+	}
+	expireBy(
+		fmt.Sprintf("created < now() at time zone 'utc' - interval '%d days'",
+			days),
+		"mod_log",
+	)
+}
+
+// Expire week old ban appeals, that have been resolved or whose ban is no
+// longer in effect. Pending appeals of active bans are kept until reviewed.
+func expireBanAppeals() {
+	expireBy(`created < now() at time zone 'utc' + '-7 days'
+		and (
+			state != 'pending'
+			or not exists (
+				select from bans as b
+				where b.board = ban_appeals.board
+					and b.forPost = ban_appeals.for_post
+					and b.ip >>= ban_appeals.ip
+					and b.expires > now() at time zone 'utc'
+			)
+		)`,
+		"ban_appeals",
+	)
+}
+
+// Expire week old reviewed posts held for review. Pending posts are kept and
+// their image tokens kept valid, until reviewed.
+func expirePendingPosts() {
+	expireBy(`created < now() at time zone 'utc' + '-7 days'
+		and state != 'pending'`,
+		"pending_posts",
+	)
+	logError("refresh pending post image tokens", refreshPendingImageTokens())
+}
+
 // Expire table rows by expiry timestamp
 func expireRows(tables ...string) {
 	expireBy("expires < now() at time zone 'utc'", tables...)
@@ -242,7 +288,7 @@ func deleteBoard(tx *sql.Tx, id, by, reason string) (err error) {
 
 // Delete stale threads. Thread retention measured in a bump time threshold,
 // that is calculated as a function of post count till bump limit with an N days
-// floor and ceiling.
+// floor and ceiling. The bump limit is that of the thread's board.
 func deleteOldThreads() (err error) {
 	conf := config.Get()
 	if !conf.PruneThreads {
@@ -258,12 +304,14 @@ func deleteOldThreads() (err error) {
 			toDel         = make([]uint64, 0, 16)
 			id, postCount uint64
 			bumpTime      int64
+			board         string
 			deleted       sql.NullBool
 		)
 		err = queryAll(
 			sq.
 				Select(
 					"threads.id",
+					"threads.board",
 					"bump_time",
 					`(select count(*)
 						from posts
@@ -279,13 +327,14 @@ func deleteOldThreads() (err error) {
 				Join("posts on threads.id = posts.id").
 				RunWith(tx),
 			func(r *sql.Rows) (err error) {
-				err = r.Scan(&id, &bumpTime, &postCount, &deleted)
+				err = r.Scan(&id, &board, &bumpTime, &postCount, &deleted)
 				if err != nil {
 					return
 				}
+				bumpLimit := config.GetBoardConfigs(board).ThreadBumpLimit()
 				threshold := min +
 					(-max+min)*
-						math.Pow(float64(postCount)/common.BumpLimit-1, 3)
+						math.Pow(float64(postCount)/float64(bumpLimit)-1, 3)
 				if deleted.Bool {
 					threshold /= 3
 				}
@@ -156,8 +156,17 @@ fail:
 // Split message containing a list of uint64 numbers.
 // Returns error, if message did not contain n integers.
 func SplitUint64s(msg string, n int) (arr []uint64, err error) {
+	if strings.Count(msg, ",") != n-1 {
+		return nil, ErrMsgParse(msg)
+	}
+	return SplitMinUint64s(msg, n)
+}
+
+// Split message containing a list of uint64 numbers.
+// Returns error, if message did not contain at least n integers.
+func SplitMinUint64s(msg string, n int) (arr []uint64, err error) {
 	parts := strings.Split(msg, ",")
-	if len(parts) != n {
+	if len(parts) < n {
 		goto fail
 	}
 	for _, p := range parts {
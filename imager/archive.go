@@ -1,10 +1,26 @@
 package imager
 
 import (
+	"archive/tar"
+	"archive/zip"
 	"bytes"
 	"compress/gzip"
+	"errors"
+	"image"
 	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/imager/assets"
+	"github.com/bakape/meguca/util"
+	"github.com/bakape/thumbnailer/v2"
+	"github.com/bodgit/sevenzip"
+	"github.com/nwaples/rardecode"
 	"github.com/ulikunitz/xz"
 )
 
@@ -13,6 +29,52 @@ const (
 	mime7Zip  = "application/x-7z-compressed"
 	mimeTarGZ = "application/gzip"
 	mimeTarXZ = "application/x-xz"
+
+	// Maximum number of files indexed per archive
+	maxArchiveEntries = 1000
+
+	// Maximum uncompressed size of a single indexed file
+	maxArchiveEntrySize = 64 << 20
+
+	// Maximum combined uncompressed size of all files read from an archive
+	maxArchiveSize = 1 << 30
+
+	// Maximum ratio of uncompressed to compressed size of larger ZIP entries
+	maxCompressionRatio = 100
+
+	// Maximum size of a comic book page read for thumbnailing
+	maxPageSize = 20 << 20
+
+	// Maximum number of remotely stored archives kept buffered in temporary
+	// files for serving their entries
+	maxCachedArchives = 8
+
+	// Time after which an unused buffered archive is removed
+	cachedArchiveExpiry = 10 * time.Minute
+)
+
+var (
+	errArchiveTooLarge = errors.New("archive contents too large")
+
+	// Remotely stored archives buffered to temporary files, so that
+	// consecutive requests for their entries do not download them again
+	archiveCache = struct {
+		sync.Mutex
+		files map[string]*cachedArchive
+	}{
+		files: make(map[string]*cachedArchive),
+	}
+
+	// Extensions of images inside archives. Excludes SVG, as it can contain
+	// scripts.
+	pageExtensions = map[string]bool{
+		".jpg":  true,
+		".jpeg": true,
+		".png":  true,
+		".gif":  true,
+		".webp": true,
+		".avif": true,
+	}
 )
 
 // Detect if file is a TAR archive compressed with GZIP
@@ -56,3 +118,494 @@ func detectTarXZ(buf []byte) (mime string, ext string) {
 	}
 	return
 }
+
+// Reader, that fails with errArchiveTooLarge after more than n bytes have been
+// read from r
+type boundedReader struct {
+	r io.Reader
+	n int64
+}
+
+func (b *boundedReader) Read(p []byte) (n int, err error) {
+	if b.n <= 0 {
+		// Only fail, if there actually is more data
+		var probe [1]byte
+		n, err = b.r.Read(probe[:])
+		if n != 0 {
+			return 0, errArchiveTooLarge
+		}
+		return
+	}
+	if int64(len(p)) > b.n {
+		p = p[:b.n]
+	}
+	n, err = b.r.Read(p)
+	b.n -= int64(n)
+	return
+}
+
+// Regular file contained in an archive
+type archiveFile struct {
+	name string
+	size int64
+	open func() (io.ReadCloser, error)
+}
+
+// Returns, if the contents of archives of file type t can be indexed
+func isIndexable(t uint8) bool {
+	switch t {
+	case common.ZIP, common.CBZ, common.TGZ, common.TXZ, common.SevenZip,
+		common.RAR, common.CBR:
+		return true
+	default:
+		return false
+	}
+}
+
+// Returns, if archives of file type t are comic books
+func isComicBook(t uint8) bool {
+	return t == common.CBZ || t == common.CBR
+}
+
+// IsArchiveImage returns, if an archive entry is an image, that can be
+// displayed inline
+func IsArchiveImage(name string) bool {
+	return pageExtensions[strings.ToLower(path.Ext(name))]
+}
+
+// Call fn on each regular file of archive r of file type t in archive order,
+// until it returns false or an error. A file can only be read during the call
+// of fn on it. Files exceeding size limits are skipped and iteration stops
+// after maxArchiveEntries files, so that the positions of files passed to fn
+// always match the index.
+func walkArchive(r io.ReaderAt, size int64, t uint8,
+	fn func(f archiveFile) (bool, error),
+) error {
+	switch t {
+	case common.ZIP, common.CBZ:
+		return walkZip(r, size, fn)
+	case common.TGZ, common.TXZ:
+		return walkTar(io.NewSectionReader(r, 0, size), t, fn)
+	case common.SevenZip:
+		return walk7Zip(r, size, fn)
+	case common.RAR, common.CBR:
+		return walkRar(io.NewSectionReader(r, 0, size), fn)
+	default:
+		return nil
+	}
+}
+
+func walkZip(r io.ReaderAt, size int64, fn func(f archiveFile) (bool, error),
+) (err error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return
+	}
+
+	var (
+		n     int
+		total uint64
+	)
+	for _, f := range zr.File {
+		if !f.Mode().IsRegular() {
+			continue
+		}
+		// Reads are additionally limited to the declared uncompressed size by
+		// archive/zip itself
+		s := f.UncompressedSize64
+		if s > maxArchiveEntrySize ||
+			(s > 1<<20 && s/(f.CompressedSize64+1) > maxCompressionRatio) {
+			continue
+		}
+		total += s
+		if total > maxArchiveSize || n == maxArchiveEntries {
+			return
+		}
+		n++
+
+		var ok bool
+		ok, err = fn(archiveFile{
+			name: f.Name,
+			size: int64(s),
+			open: f.Open,
+		})
+		if err != nil || !ok {
+			return
+		}
+	}
+	return
+}
+
+func walkTar(r io.Reader, t uint8, fn func(f archiveFile) (bool, error),
+) (err error) {
+	var dec io.Reader
+	if t == common.TGZ {
+		dec, err = gzip.NewReader(r)
+	} else {
+		dec, err = xz.NewReader(r)
+	}
+	if err != nil {
+		return
+	}
+
+	// Tarballs are read sequentially, so limit decompression of the entire
+	// stream, including any skipped files
+	tr := tar.NewReader(&boundedReader{r: dec, n: maxArchiveSize})
+	for n := 0; n < maxArchiveEntries; {
+		var h *tar.Header
+		h, err = tr.Next()
+		switch err {
+		case nil:
+		case io.EOF:
+			return nil
+		default:
+			return
+		}
+		if h.Typeflag != tar.TypeReg || h.Size > maxArchiveEntrySize {
+			continue
+		}
+		n++
+
+		var ok bool
+		ok, err = fn(archiveFile{
+			name: h.Name,
+			size: h.Size,
+			open: func() (io.ReadCloser, error) {
+				return io.NopCloser(tr), nil
+			},
+		})
+		if err != nil || !ok {
+			return
+		}
+	}
+	return
+}
+
+func walk7Zip(r io.ReaderAt, size int64,
+	fn func(f archiveFile) (bool, error),
+) (err error) {
+	zr, err := sevenzip.NewReader(r, size)
+	if err != nil {
+		return
+	}
+
+	var (
+		n     int
+		total uint64
+	)
+	for _, f := range zr.File {
+		if !f.Mode().IsRegular() {
+			continue
+		}
+		// Files in solid blocks are decompressed from the start of the block,
+		// so skipped files count towards the limit as well
+		s := f.UncompressedSize
+		total += s
+		if total > maxArchiveSize || n == maxArchiveEntries {
+			return
+		}
+		if s > maxArchiveEntrySize {
+			continue
+		}
+		n++
+
+		var ok bool
+		ok, err = fn(archiveFile{
+			name: f.Name,
+			size: int64(s),
+			open: f.Open,
+		})
+		if err != nil || !ok {
+			return
+		}
+	}
+	return
+}
+
+func walkRar(r io.Reader, fn func(f archiveFile) (bool, error)) (err error) {
+	rr, err := rardecode.NewReader(r, "")
+	if err != nil {
+		return
+	}
+
+	// Skipped files of solid archives are decompressed as well, so limit by
+	// the declared size of all files. Reads are limited to the declared size
+	// by rardecode itself.
+	var total int64
+	for n := 0; n < maxArchiveEntries; {
+		var h *rardecode.FileHeader
+		h, err = rr.Next()
+		switch err {
+		case nil:
+		case io.EOF:
+			return nil
+		default:
+			return
+		}
+		if h.UnKnownSize {
+			return
+		}
+		total += h.UnPackedSize
+		if total > maxArchiveSize {
+			return
+		}
+		if h.IsDir || !h.Mode().IsRegular() ||
+			h.UnPackedSize > maxArchiveEntrySize {
+			continue
+		}
+		n++
+
+		var ok bool
+		ok, err = fn(archiveFile{
+			name: h.Name,
+			size: h.UnPackedSize,
+			open: func() (io.ReadCloser, error) {
+				return io.NopCloser(rr), nil
+			},
+		})
+		if err != nil || !ok {
+			return
+		}
+	}
+	return
+}
+
+// Index the regular files of archive r of file type t
+func indexArchive(r io.ReaderAt, size int64, t uint8) (
+	entries []common.ArchiveEntry, err error,
+) {
+	err = walkArchive(r, size, t, func(f archiveFile) (bool, error) {
+		// Must be storable as Postgres text
+		name := strings.ToValidUTF8(strings.ReplaceAll(f.name, "\x00", ""),
+			"\uFFFD")
+		util.TrimString(&name, 1000)
+		entries = append(entries, common.ArchiveEntry{
+			Page: isComicBook(t) && IsArchiveImage(name),
+			Size: f.size,
+			Name: name,
+		})
+		return true, nil
+	})
+	return
+}
+
+// Call fn with a reader of the file at position i in the index of archive r
+func readArchiveEntry(r io.ReaderAt, size int64, t uint8, i int,
+	fn func(r io.Reader) error,
+) (err error) {
+	var n int
+	found := false
+	err = walkArchive(r, size, t, func(f archiveFile) (bool, error) {
+		if n++; n-1 != i {
+			return true, nil
+		}
+		found = true
+		rc, err := f.open()
+		if err != nil {
+			return false, err
+		}
+		defer rc.Close()
+		return false, fn(io.LimitReader(rc, f.size))
+	})
+	if err == nil && !found {
+		err = os.ErrNotExist
+	}
+	return
+}
+
+// Read the first page of a comic book archive by file name order. Returns nil,
+// if there are no pages.
+func readFirstPage(r io.ReaderAt, size int64, t uint8,
+	entries []common.ArchiveEntry,
+) (buf []byte, err error) {
+	first := -1
+	for i, e := range entries {
+		if e.Page && e.Size <= maxPageSize &&
+			(first == -1 || e.Name < entries[first].Name) {
+			first = i
+		}
+	}
+	if first == -1 {
+		return
+	}
+	err = readArchiveEntry(r, size, t, first, func(r io.Reader) (err error) {
+		buf, err = io.ReadAll(r)
+		return
+	})
+	return
+}
+
+// Thumbnail the first page of a comic book archive. Returns nil, if there is
+// no page, that can be thumbnailed.
+func thumbnailFirstPage(r io.ReaderAt, size int64, t uint8,
+	entries []common.ArchiveEntry, opts thumbnailer.Options,
+) (thumb image.Image, err error) {
+	buf, err := readFirstPage(r, size, t, entries)
+	if err != nil || buf == nil {
+		return
+	}
+	src, thumb, err := thumbnailer.Process(bytes.NewReader(buf), opts)
+	switch {
+	case err == thumbnailer.ErrCantThumbnail:
+		return nil, nil
+	case err != nil:
+		return
+	case !strings.HasPrefix(src.Mime, "image/"):
+		return nil, nil
+	}
+	return
+}
+
+// Open a stored source file for random access. Files not in local storage are
+// buffered to a temporary file. done must be called after use.
+func openStoredFile(SHA1 string, fileType uint8) (
+	r *os.File, size int64, done func(), err error,
+) {
+	src, err := assets.Store.Get(assets.SourceKey(fileType, SHA1))
+	if err != nil {
+		return
+	}
+	if f, ok := src.(*os.File); ok {
+		var s os.FileInfo
+		s, err = f.Stat()
+		if err != nil {
+			f.Close()
+			return
+		}
+		return f, s.Size(), func() { f.Close() }, nil
+	}
+
+	defer src.Close()
+	tmp, err := os.CreateTemp(jobDir, "stored-")
+	if err != nil {
+		return
+	}
+	done = func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}
+	size, err = io.Copy(tmp, src)
+	if err != nil {
+		done()
+		return
+	}
+	return tmp, size, done, nil
+}
+
+// Stored archive buffered to a temporary file
+type cachedArchive struct {
+	file    *os.File
+	size    int64
+	refs    int
+	used    time.Time
+	evicted bool
+}
+
+// Decrement the reference count and remove the file, if it is no longer in
+// the cache or use. Must be called with archiveCache locked.
+func (c *cachedArchive) release() {
+	c.refs--
+	if c.evicted && c.refs == 0 {
+		c.remove()
+	}
+}
+
+func (c *cachedArchive) remove() {
+	c.file.Close()
+	os.Remove(c.file.Name())
+}
+
+// Open a stored archive for concurrent random access. Remotely stored
+// archives are kept buffered in temporary files between calls. done must be
+// called after use.
+func openStoredArchive(SHA1 string, fileType uint8) (
+	r io.ReaderAt, size int64, done func(), err error,
+) {
+	if _, ok := assets.Store.(assets.LocalStorage); ok {
+		return openStoredFile(SHA1, fileType)
+	}
+
+	key := assets.SourceKey(fileType, SHA1)
+	archiveCache.Lock()
+	c := archiveCache.files[key]
+	if c != nil {
+		c.refs++
+		c.used = time.Now()
+		evictArchives()
+	}
+	archiveCache.Unlock()
+
+	if c == nil {
+		var (
+			f       *os.File
+			release func()
+		)
+		f, size, release, err = openStoredFile(SHA1, fileType)
+		if err != nil {
+			return
+		}
+
+		archiveCache.Lock()
+		if prev := archiveCache.files[key]; prev != nil {
+			// Concurrently buffered by another request
+			release()
+			c = prev
+		} else {
+			c = &cachedArchive{
+				file: f,
+				size: size,
+			}
+			archiveCache.files[key] = c
+		}
+		c.refs++
+		c.used = time.Now()
+		evictArchives()
+		archiveCache.Unlock()
+	}
+
+	done = func() {
+		archiveCache.Lock()
+		defer archiveCache.Unlock()
+		c.release()
+	}
+	return c.file, c.size, done, nil
+}
+
+// Remove expired and least recently used buffered archives over the limit.
+// Must be called with archiveCache locked.
+func evictArchives() {
+	cached := make([]string, 0, len(archiveCache.files))
+	for k := range archiveCache.files {
+		cached = append(cached, k)
+	}
+	sort.Slice(cached, func(i, j int) bool {
+		return archiveCache.files[cached[i]].used.
+			After(archiveCache.files[cached[j]].used)
+	})
+
+	now := time.Now()
+	for i, k := range cached {
+		c := archiveCache.files[k]
+		if i >= maxCachedArchives || now.Sub(c.used) > cachedArchiveExpiry {
+			delete(archiveCache.files, k)
+			c.evicted = true
+			if c.refs == 0 {
+				c.remove()
+			}
+		}
+	}
+}
+
+// CopyArchiveEntry writes the file at position i in the index of a stored
+// archive to w
+func CopyArchiveEntry(w io.Writer, SHA1 string, fileType uint8, i int) error {
+	r, size, done, err := openStoredArchive(SHA1, fileType)
+	if err != nil {
+		return err
+	}
+	defer done()
+	return readArchiveEntry(r, size, fileType, i, func(r io.Reader) error {
+		_, err := io.Copy(w, r)
+		return err
+	})
+}
@@ -1,6 +1,13 @@
 package imager
 
 import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -89,3 +96,259 @@ func TestProcessArchive(t *testing.T) {
 		})
 	}
 }
+
+// Build a ZIP archive in memory from name and content pairs
+func buildZip(t *testing.T, files ...string) *bytes.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for i := 0; i < len(files); i += 2 {
+		f, err := w.Create(files[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = f.Write([]byte(files[i+1]))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return bytes.NewReader(buf.Bytes())
+}
+
+// Build a gzipped TAR archive in memory from name and content pairs
+func buildTarGZ(t *testing.T, files ...string) *bytes.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	w := tar.NewWriter(gz)
+	for i := 0; i < len(files); i += 2 {
+		err := w.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     files[i],
+			Mode:     0600,
+			Size:     int64(len(files[i+1])),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = w.Write([]byte(files[i+1]))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, c := range [...]io.Closer{w, gz} {
+		if err := c.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestIndexArchive(t *testing.T) {
+	t.Parallel()
+
+	files := []string{
+		"02.png", "second",
+		"01.jpg", "first",
+		"notes/readme.txt", "hello",
+	}
+	cases := [...]struct {
+		name string
+		typ  uint8
+		r    *bytes.Reader
+		std  []common.ArchiveEntry
+	}{
+		{
+			name: "ZIP",
+			typ:  common.ZIP,
+			r:    buildZip(t, files...),
+			std: []common.ArchiveEntry{
+				{Size: 6, Name: "02.png"},
+				{Size: 5, Name: "01.jpg"},
+				{Size: 5, Name: "notes/readme.txt"},
+			},
+		},
+		{
+			name: "CBZ",
+			typ:  common.CBZ,
+			r:    buildZip(t, files...),
+			std: []common.ArchiveEntry{
+				{Page: true, Size: 6, Name: "02.png"},
+				{Page: true, Size: 5, Name: "01.jpg"},
+				{Size: 5, Name: "notes/readme.txt"},
+			},
+		},
+		{
+			name: "tar.gz",
+			typ:  common.TGZ,
+			r:    buildTarGZ(t, files...),
+			std: []common.ArchiveEntry{
+				{Size: 6, Name: "02.png"},
+				{Size: 5, Name: "01.jpg"},
+				{Size: 5, Name: "notes/readme.txt"},
+			},
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			size := c.r.Size()
+			entries, err := indexArchive(c.r, size, c.typ)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEquals(t, entries, c.std)
+
+			var buf []byte
+			err = readArchiveEntry(c.r, size, c.typ, 2,
+				func(r io.Reader) (err error) {
+					buf, err = io.ReadAll(r)
+					return
+				})
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEquals(t, string(buf), "hello")
+
+			err = readArchiveEntry(c.r, size, c.typ, 3,
+				func(io.Reader) error { return nil })
+			test.AssertEquals(t, err, os.ErrNotExist)
+		})
+	}
+
+	t.Run("first page", func(t *testing.T) {
+		t.Parallel()
+
+		r := buildZip(t, files...)
+		entries, err := indexArchive(r, r.Size(), common.CBZ)
+		if err != nil {
+			t.Fatal(err)
+		}
+		buf, err := readFirstPage(r, r.Size(), common.CBZ, entries)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, string(buf), "first")
+	})
+}
+
+func TestIndexSampleArchives(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name, file string
+		typ        uint8
+		std        []common.ArchiveEntry
+	}{
+		{
+			name: "CBR",
+			file: "sample.rar",
+			typ:  common.CBR,
+			std: []common.ArchiveEntry{
+				{Page: true, Size: 634670, Name: "sample.png"},
+			},
+		},
+		{
+			name: "7zip",
+			file: "sample.7z",
+			typ:  common.SevenZip,
+			std: []common.ArchiveEntry{
+				{Size: 436, Name: "sample.svg"},
+			},
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := test.OpenSample(t, c.file)
+			defer f.Close()
+			info, err := f.Stat()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			entries, err := indexArchive(f, info.Size(), c.typ)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEquals(t, entries, c.std)
+
+			var n int64
+			err = readArchiveEntry(f, info.Size(), c.typ, 0,
+				func(r io.Reader) (err error) {
+					n, err = io.Copy(io.Discard, r)
+					return
+				})
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEquals(t, n, c.std[0].Size)
+		})
+	}
+}
+
+func TestArchiveBombs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("compression ratio", func(t *testing.T) {
+		t.Parallel()
+
+		r := buildZip(t,
+			"bomb", strings.Repeat("\x00", 4<<20),
+			"ok", "fine",
+		)
+		entries, err := indexArchive(r, r.Size(), common.ZIP)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, entries, []common.ArchiveEntry{
+			{Size: 4, Name: "ok"},
+		})
+	})
+
+	t.Run("entry count", func(t *testing.T) {
+		t.Parallel()
+
+		files := make([]string, 0, (maxArchiveEntries+10)*2)
+		for i := 0; i < maxArchiveEntries+10; i++ {
+			files = append(files, strconv.Itoa(i), "")
+		}
+		r := buildZip(t, files...)
+		entries, err := indexArchive(r, r.Size(), common.ZIP)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, len(entries), maxArchiveEntries)
+	})
+
+	t.Run("decompressed stream", func(t *testing.T) {
+		t.Parallel()
+
+		r := &boundedReader{
+			r: bytes.NewReader(make([]byte, 10)),
+			n: 10,
+		}
+		_, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		r = &boundedReader{
+			r: bytes.NewReader(make([]byte, 11)),
+			n: 10,
+		}
+		_, err = io.ReadAll(r)
+		test.AssertEquals(t, err, errArchiveTooLarge)
+	})
+}
@@ -2,11 +2,10 @@
 package assets
 
 import (
-	"errors"
 	"io"
 	"os"
 	"path/filepath"
-	"syscall"
+	"strconv"
 
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
@@ -38,24 +37,15 @@ var (
 	}
 )
 
-// GetFilePaths generates file paths of the source file and its thumbnail
+// GetFilePaths generates file paths of the source file and its thumbnail in
+// local storage
 func GetFilePaths(SHA1 string, fileType, thumbType uint8) (paths [2]string) {
-	paths[0] = util.ConcatStrings(
-		"/images/src/",
-		SHA1,
-		".",
-		common.Extensions[fileType],
-	)
-	paths[1] = util.ConcatStrings(
-		"/images/thumb/",
-		SHA1,
-		".",
-		common.Extensions[thumbType],
-	)
-	for i := range paths {
-		paths[i] = filepath.FromSlash(paths[i][1:])
+	for i, key := range [...]string{
+		SourceKey(fileType, SHA1),
+		ThumbKey(thumbType, SHA1),
+	} {
+		paths[i] = filepath.Join("images", filepath.FromSlash(key))
 	}
-
 	return
 }
 
@@ -111,6 +101,31 @@ func ThumbPath(thumbType uint8, SHA1 string) string {
 	)
 }
 
+// ThumbVariantPath returns the path to an additional thumbnail of an image
+// fitting a bounding box of size
+func ThumbVariantPath(thumbType uint8, SHA1 string, size uint16) string {
+	return util.ConcatStrings(
+		imageRoot(),
+		"/thumb/",
+		SHA1,
+		"_",
+		strconv.Itoa(int(size)),
+		".",
+		common.Extensions[thumbType],
+	)
+}
+
+// RenditionPath returns the path to the transcoded rendition of an image
+func RenditionPath(fileType uint8, SHA1 string) string {
+	return util.ConcatStrings(
+		imageRoot(),
+		"/rendition/",
+		SHA1,
+		".",
+		common.Extensions[fileType],
+	)
+}
+
 // SourcePath returns the path to the source file on an image
 func SourcePath(fileType uint8, SHA1 string) string {
 	return util.ConcatStrings(
@@ -122,79 +137,62 @@ func SourcePath(fileType uint8, SHA1 string) string {
 	)
 }
 
-// Return free space on image storage device.
-// Image source file and thumbnail directories must be on the same drive.
-func freeSpace() (n uint64, err error) {
-	var stats syscall.Statfs_t
-	path, err := filepath.Abs("images/src")
-	if err != nil {
-		return
-	}
-	err = syscall.Statfs(path, &stats)
-	return stats.Bavail * uint64(stats.Bsize), err
-}
-
-// Write writes file assets to disk
+// Write writes file assets to storage
 func Write(SHA1 string, fileType, thumbType uint8, src, thumb io.ReadSeeker,
 ) (
 	err error,
 ) {
-	// Assert at least 100 MB of free disk space is available
-	if !common.IsCI {
-		var free uint64
-		free, err = freeSpace()
-		if err != nil {
-			return
-		}
-		if free < (100 << 20) {
-			return errors.New("not enough disk space")
-		}
-	}
-
-	paths := GetFilePaths(SHA1, fileType, thumbType)
-
 	// Don't write files in parallel to reduce the amount of threads the Go
 	// runtime needs to spawn.
-	err = writeFile(paths[0], src)
+	err = Store.Put(SourceKey(fileType, SHA1), src)
 	if err != nil {
 		return
 	}
 	if thumb != nil { // Archives, audio, etc. can be missing thumbnails
-		err = writeFile(paths[1], thumb)
+		err = Store.Put(ThumbKey(thumbType, SHA1), thumb)
 	}
 	return
 }
 
-// Write a single file to disk with the appropriate permissions and flags
-func writeFile(path string, src io.ReadSeeker) (err error) {
-	file, err := os.Create(path)
-	if err != nil {
-		return
+// Delete deletes file assets belonging to a single upload, including any
+// transcoded renditions and additional thumbnails
+func Delete(img common.ImageCommon) (err error) {
+	keys := []string{
+		SourceKey(img.FileType, img.SHA1),
+		ThumbKey(img.ThumbType, img.SHA1),
+		RenditionKey(common.MP4, img.SHA1),
+		RenditionKey(common.WEBM, img.SHA1),
 	}
-	defer file.Close()
+	keys = append(keys, ThumbVariantKeys(img)...)
 
-	_, err = src.Seek(0, 0)
-	if err != nil {
-		return
+	// Ignore somehow absent images
+	for _, key := range keys {
+		err = Store.Delete(key)
+		if err != nil {
+			return
+		}
 	}
-	_, err = io.Copy(file, src)
 	return
 }
 
-// Delete deletes file assets belonging to a single upload
-func Delete(SHA1 string, fileType, thumbType uint8) error {
-	for _, path := range GetFilePaths(SHA1, fileType, thumbType) {
-		// Ignore somehow absent images
-		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
-			return err
+// ThumbVariantKeys returns the storage keys of all additional thumbnails of
+// an image, including AVIF encodings of the standard one
+func ThumbVariantKeys(img common.ImageCommon) (keys []string) {
+	for size := range img.Thumbs {
+		keys = append(keys, ThumbVariantKey(img.ThumbType, img.SHA1, size))
+		if img.ThumbAVIF {
+			keys = append(keys, ThumbVariantKey(common.AVIF, img.SHA1, size))
 		}
 	}
-	return nil
+	if img.ThumbAVIF {
+		keys = append(keys, ThumbKey(common.AVIF, img.SHA1))
+	}
+	return
 }
 
 // CreateDirs creates directories for processed image storage
 func CreateDirs() error {
-	for _, dir := range [...]string{"src", "thumb"} {
+	for _, dir := range [...]string{"src", "thumb", "rendition"} {
 		path := filepath.Join("images", dir)
 		if err := os.MkdirAll(path, 0705); err != nil {
 			return err
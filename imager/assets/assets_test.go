@@ -87,7 +87,12 @@ func TestDeleteAssets(t *testing.T) {
 			}
 
 			// Delete them and check, if deleted
-			if err := Delete(c.name, c.fileType, c.thumbType); err != nil {
+			err := Delete(common.ImageCommon{
+				SHA1:      c.name,
+				FileType:  c.fileType,
+				ThumbType: c.thumbType,
+			})
+			if err != nil {
 				t.Fatal(err)
 			}
 			for _, path := range GetFilePaths(c.name, c.fileType, c.thumbType) {
@@ -103,9 +108,50 @@ func TestDeleteAssets(t *testing.T) {
 func TestDeleteMissingAssets(t *testing.T) {
 	resetDirs(t)
 
-	if err := Delete("akarin", common.PNG, common.PNG); err != nil {
+	err := Delete(common.ImageCommon{
+		SHA1:      "akarin",
+		FileType:  common.PNG,
+		ThumbType: common.PNG,
+		Thumbs:    map[uint16][2]uint16{300: {300, 200}},
+		ThumbAVIF: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDeleteThumbVariants(t *testing.T) {
+	resetDirs(t)
+
+	img := common.ImageCommon{
+		SHA1:      "sugiura",
+		FileType:  common.PNG,
+		ThumbType: common.WEBP,
+		Thumbs: map[uint16][2]uint16{
+			300: {300, 200},
+			600: {600, 400},
+		},
+		ThumbAVIF: true,
+	}
+	keys := ThumbVariantKeys(img)
+	test.AssertEquals(t, len(keys), 5)
+	for _, key := range keys {
+		err := Store.Put(key, bytes.NewReader([]byte{1}))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := Delete(img)
+	if err != nil {
 		t.Fatal(err)
 	}
+	for _, key := range keys {
+		_, err := Store.Stat(key)
+		if !os.IsNotExist(err) {
+			test.UnexpectedError(t, err)
+		}
+	}
 }
 
 func TestWriteAssets(t *testing.T) {
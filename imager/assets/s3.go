@@ -0,0 +1,308 @@
+package assets
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bakape/meguca/config"
+)
+
+const (
+	s3Algorithm       = "AWS4-HMAC-SHA256"
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+	s3DateFormat      = "20060102T150405Z"
+
+	// Longest expiry time of signed URLs accepted by S3
+	s3MaxExpiry = 7 * 24 * time.Hour
+)
+
+// S3Storage stores files in a bucket of an S3-compatible object storage
+// service, like AWS S3 or MinIO. Requests are signed with AWS Signature
+// Version 4.
+type S3Storage struct {
+	config.S3Configs
+	endpoint *url.URL
+	client   *http.Client
+
+	// For overriding in tests
+	now func() time.Time
+}
+
+// NewS3Storage validates the configuration and creates an S3 storage backend
+func NewS3Storage(c config.S3Configs) (s *S3Storage, err error) {
+	if c.Bucket == "" {
+		return nil, errors.New("s3: no bucket specified")
+	}
+	u, err := url.Parse(c.Endpoint)
+	if err != nil {
+		return
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("s3: invalid endpoint: %s", c.Endpoint)
+	}
+	if c.Region == "" {
+		c.Region = "us-east-1"
+	}
+
+	s = &S3Storage{
+		S3Configs: c,
+		endpoint: &url.URL{
+			Scheme: u.Scheme,
+			Host:   u.Host,
+			// Endpoints may be served under a path prefix, such as behind a
+			// reverse proxy
+			Path: strings.TrimSuffix(u.Path, "/"),
+		},
+		client: &http.Client{
+			Timeout: time.Minute,
+		},
+		now: time.Now,
+	}
+	return
+}
+
+// Put implements Storage
+func (s *S3Storage) Put(key string, src io.ReadSeeker) (err error) {
+	size, err := src.Seek(0, io.SeekEnd)
+	if err != nil {
+		return
+	}
+	_, err = src.Seek(0, io.SeekStart)
+	if err != nil {
+		return
+	}
+
+	req, err := s.newRequest("PUT", key)
+	if err != nil {
+		return
+	}
+	req.ContentLength = size
+	if size != 0 {
+		req.Body = io.NopCloser(src)
+	} else {
+		req.Body = http.NoBody
+	}
+	// Set content type, so signed URLs can be served directly to clients
+	if t := mime.TypeByExtension(path.Ext(key)); t != "" {
+		req.Header.Set("Content-Type", t)
+	}
+
+	res, err := s.do(req, key)
+	if err != nil {
+		return
+	}
+	return res.Body.Close()
+}
+
+// Get implements Storage
+func (s *S3Storage) Get(key string) (io.ReadCloser, error) {
+	req, err := s.newRequest("GET", key)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.do(req, key)
+	if err != nil {
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+// Delete implements Storage
+func (s *S3Storage) Delete(key string) error {
+	req, err := s.newRequest("DELETE", key)
+	if err != nil {
+		return err
+	}
+	res, err := s.do(req, key)
+	switch {
+	case os.IsNotExist(err):
+		return nil
+	case err != nil:
+		return err
+	}
+	return res.Body.Close()
+}
+
+// Stat implements Storage
+func (s *S3Storage) Stat(key string) (info FileInfo, err error) {
+	req, err := s.newRequest("HEAD", key)
+	if err != nil {
+		return
+	}
+	res, err := s.do(req, key)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+
+	info.Size = res.ContentLength
+	if lm := res.Header.Get("Last-Modified"); lm != "" {
+		info.ModTime, err = http.ParseTime(lm)
+	}
+	return
+}
+
+// SignedURL implements Storage by generating a presigned GET request URL
+func (s *S3Storage) SignedURL(key string, expiry time.Duration) (
+	string, error,
+) {
+	if expiry <= 0 || expiry > s3MaxExpiry {
+		return "", fmt.Errorf("s3: invalid signed URL expiry: %s", expiry)
+	}
+
+	u := s.objectURL(key)
+	now := s.now().UTC()
+	scope := s.scope(now)
+	query := url.Values{
+		"X-Amz-Algorithm":     {s3Algorithm},
+		"X-Amz-Credential":    {s.AccessKey + "/" + scope},
+		"X-Amz-Date":          {now.Format(s3DateFormat)},
+		"X-Amz-Expires":       {strconv.FormatInt(int64(expiry/time.Second), 10)},
+		"X-Amz-SignedHeaders": {"host"},
+	}
+	// S3 requires spaces to be encoded as %20
+	rawQuery := strings.ReplaceAll(query.Encode(), "+", "%20")
+
+	sig := s.signature(now, scope, strings.Join([]string{
+		"GET",
+		s3EncodePath(u.Path),
+		rawQuery,
+		"host:" + u.Host + "\n",
+		"host",
+		s3UnsignedPayload,
+	}, "\n"))
+	u.RawQuery = rawQuery + "&X-Amz-Signature=" + sig
+	return u.String(), nil
+}
+
+// Return the URL of an object
+func (s *S3Storage) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	if s.PathStyle {
+		u.Path += "/" + s.Bucket + "/" + key
+	} else {
+		u.Host = s.Bucket + "." + u.Host
+		u.Path += "/" + key
+	}
+	u.RawPath = s3EncodePath(u.Path)
+	return &u
+}
+
+func (s *S3Storage) newRequest(method, key string) (*http.Request, error) {
+	return http.NewRequest(method, s.objectURL(key).String(), nil)
+}
+
+// Sign and send a request. Responses with non-2xx status codes are converted
+// to errors and their bodies closed. 404 responses produce an error
+// satisfying os.IsNotExist.
+func (s *S3Storage) do(req *http.Request, key string) (
+	res *http.Response, err error,
+) {
+	s.sign(req)
+	res, err = s.client.Do(req)
+	if err != nil {
+		return
+	}
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode == 404 {
+		err = &os.PathError{
+			Op:   strings.ToLower(req.Method),
+			Path: key,
+			Err:  os.ErrNotExist,
+		}
+	} else {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		err = fmt.Errorf("s3: %s %s: %s: %s", req.Method, key, res.Status,
+			msg)
+	}
+	return nil, err
+}
+
+// Sign a request with the Authorization header. The payload itself is not
+// signed to allow streaming uploads.
+func (s *S3Storage) sign(req *http.Request) {
+	now := s.now().UTC()
+	date := now.Format(s3DateFormat)
+	req.Header.Set("X-Amz-Date", date)
+	req.Header.Set("X-Amz-Content-Sha256", s3UnsignedPayload)
+
+	const signed = "host;x-amz-content-sha256;x-amz-date"
+	scope := s.scope(now)
+	sig := s.signature(now, scope, strings.Join([]string{
+		req.Method,
+		s3EncodePath(req.URL.Path),
+		req.URL.RawQuery,
+		"host:" + req.URL.Host,
+		"x-amz-content-sha256:" + s3UnsignedPayload,
+		"x-amz-date:" + date + "\n",
+		signed,
+		s3UnsignedPayload,
+	}, "\n"))
+	req.Header.Set(
+		"Authorization",
+		fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+			s3Algorithm, s.AccessKey, scope, signed, sig),
+	)
+}
+
+// Return the credential scope of a request
+func (s *S3Storage) scope(t time.Time) string {
+	return t.Format("20060102") + "/" + s.Region + "/s3/aws4_request"
+}
+
+// Compute the hex-encoded signature of a canonical request
+func (s *S3Storage) signature(t time.Time, scope, canonical string) string {
+	hash := sha256.Sum256([]byte(canonical))
+	toSign := strings.Join([]string{
+		s3Algorithm,
+		t.Format(s3DateFormat),
+		scope,
+		hex.EncodeToString(hash[:]),
+	}, "\n")
+
+	key := []byte("AWS4" + s.SecretKey)
+	for _, part := range [...]string{
+		t.Format("20060102"), s.Region, "s3", "aws4_request",
+	} {
+		key = hmacSHA256(key, part)
+	}
+	return hex.EncodeToString(hmacSHA256(key, toSign))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// URI-encode an object path as required by S3. Slashes are not encoded.
+func s3EncodePath(p string) string {
+	var b strings.Builder
+	b.Grow(len(p))
+	for i := 0; i < len(p); i++ {
+		switch c := p[i]; {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,182 @@
+package assets
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+)
+
+var (
+	// Store is the backend uploaded files and thumbnails are persisted to
+	Store Storage = LocalStorage{Root: "images"}
+
+	// ErrNoSignedURLs is returned by storage backends, that can not issue
+	// signed URLs for direct client access to files
+	ErrNoSignedURLs = errors.New("storage does not support signed URLs")
+)
+
+// Storage is a backend for persisting uploaded files and their thumbnails.
+// Keys are slash-separated paths relative to the storage root, like
+// "src/<SHA1>.<ext>".
+type Storage interface {
+	// Put writes the contents of src to key, overwriting any existing file
+	Put(key string, src io.ReadSeeker) error
+
+	// Get opens the file at key for reading. Returns an error satisfying
+	// os.IsNotExist, if the file does not exist.
+	Get(key string) (io.ReadCloser, error)
+
+	// Delete removes the file at key. Deleting an absent file is not an
+	// error.
+	Delete(key string) error
+
+	// Stat returns information about the file at key. Returns an error
+	// satisfying os.IsNotExist, if the file does not exist.
+	Stat(key string) (FileInfo, error)
+
+	// SignedURL returns a URL for direct client access to the file at key,
+	// that is valid for the specified duration. Returns ErrNoSignedURLs, if
+	// not supported by the backend.
+	SignedURL(key string, expiry time.Duration) (string, error)
+}
+
+// FileInfo describes a file in storage
+type FileInfo struct {
+	Size    int64
+	ModTime time.Time
+}
+
+// InitStorage selects the storage backend from the server configuration and
+// prepares it for use
+func InitStorage() (err error) {
+	switch c := config.Server.Storage; c.Type {
+	case "", "local":
+		Store = LocalStorage{Root: "images"}
+		return CreateDirs()
+	case "s3":
+		Store, err = NewS3Storage(c.S3)
+		return
+	default:
+		return fmt.Errorf("unknown storage type: %s", c.Type)
+	}
+}
+
+// SourceKey returns the storage key of an uploaded source file
+func SourceKey(fileType uint8, SHA1 string) string {
+	return "src/" + SHA1 + "." + common.Extensions[fileType]
+}
+
+// ThumbKey returns the storage key of an uploaded file's thumbnail
+func ThumbKey(thumbType uint8, SHA1 string) string {
+	return "thumb/" + SHA1 + "." + common.Extensions[thumbType]
+}
+
+// ThumbVariantKey returns the storage key of an additional thumbnail of an
+// uploaded file fitting a bounding box of size
+func ThumbVariantKey(thumbType uint8, SHA1 string, size uint16) string {
+	return "thumb/" + SHA1 + "_" + strconv.Itoa(int(size)) + "." +
+		common.Extensions[thumbType]
+}
+
+// RenditionKey returns the storage key of the transcoded rendition of an
+// uploaded file
+func RenditionKey(fileType uint8, SHA1 string) string {
+	return "rendition/" + SHA1 + "." + common.Extensions[fileType]
+}
+
+// ReadFile reads the entire file at key from storage
+func ReadFile(key string) ([]byte, error) {
+	f, err := Store.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+// LocalStorage stores files on the local file system under Root
+type LocalStorage struct {
+	Root string
+}
+
+func (s LocalStorage) path(key string) string {
+	return filepath.Join(s.Root, filepath.FromSlash(key))
+}
+
+// Return free space on the storage device.
+// Image source file and thumbnail directories must be on the same drive.
+func (s LocalStorage) freeSpace() (n uint64, err error) {
+	var stats syscall.Statfs_t
+	path, err := filepath.Abs(s.Root)
+	if err != nil {
+		return
+	}
+	err = syscall.Statfs(path, &stats)
+	return stats.Bavail * uint64(stats.Bsize), err
+}
+
+// Put implements Storage
+func (s LocalStorage) Put(key string, src io.ReadSeeker) (err error) {
+	// Assert at least 100 MB of free disk space is available
+	if !common.IsCI {
+		var free uint64
+		free, err = s.freeSpace()
+		if err != nil {
+			return
+		}
+		if free < (100 << 20) {
+			return errors.New("not enough disk space")
+		}
+	}
+
+	file, err := os.Create(s.path(key))
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	_, err = src.Seek(0, 0)
+	if err != nil {
+		return
+	}
+	_, err = io.Copy(file, src)
+	return
+}
+
+// Get implements Storage. The returned file is an *os.File.
+func (s LocalStorage) Get(key string) (io.ReadCloser, error) {
+	return os.Open(s.path(key))
+}
+
+// Delete implements Storage
+func (s LocalStorage) Delete(key string) error {
+	err := os.Remove(s.path(key))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Stat implements Storage
+func (s LocalStorage) Stat(key string) (info FileInfo, err error) {
+	stats, err := os.Stat(s.path(key))
+	if err != nil {
+		return
+	}
+	info.Size = stats.Size()
+	info.ModTime = stats.ModTime()
+	return
+}
+
+// SignedURL implements Storage. Local files are served by the server itself.
+func (LocalStorage) SignedURL(string, time.Duration) (string, error) {
+	return "", ErrNoSignedURLs
+}
@@ -0,0 +1,487 @@
+package imager
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/go-playground/log"
+)
+
+const (
+	// Time limit for downloading a linked file
+	fetchTimeout = 5 * time.Minute
+
+	// Duration fetched links are cached for
+	fetchCacheTTL = time.Hour
+)
+
+var (
+	errFetchNotAllowed = common.ErrInvalidInput("link not allowed for fetching")
+	errNothingFetched  = errors.New("no file fetched")
+
+	// Extractors of linked files. The first one to match a link is used.
+	extractors = [...]extractor{
+		{
+			match: func(u *url.URL) bool {
+				return matchesDomain(u.Hostname(), "tiktok.com")
+			},
+			fetch: fetchTikTok,
+		},
+		{
+			match: func(u *url.URL) bool {
+				return directExtensions[strings.ToLower(path.Ext(u.Path))]
+			},
+			fetch: fetchDirect,
+		},
+		{
+			match: func(*url.URL) bool { return true },
+			fetch: fetchYtDlp,
+		},
+	}
+
+	// Extensions of links, that are downloaded as is
+	directExtensions = map[string]bool{
+		".jpg":  true,
+		".jpeg": true,
+		".png":  true,
+		".gif":  true,
+		".webp": true,
+		".avif": true,
+		".webm": true,
+		".mp4":  true,
+		".mov":  true,
+		".mp3":  true,
+		".ogg":  true,
+		".flac": true,
+	}
+
+	// Dialer, that refuses to connect to addresses not on the public internet
+	fetchDialer = &net.Dialer{
+		Timeout: 30 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+				return errFetchNotAllowed
+			}
+			return nil
+		},
+	}
+
+	// Transport for direct downloads and requests proxied for yt-dlp
+	fetchTransport = &http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		DialContext:         fetchDialer.DialContext,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+
+	// Client for direct downloads
+	fetchClient = &http.Client{
+		Transport: fetchTransport,
+	}
+
+	// Local HTTP proxy yt-dlp connects through, so that its connections pass
+	// the same address checks as direct downloads
+	fetchProxy struct {
+		once sync.Once
+		url  string
+		err  error
+	}
+
+	// Shared cache of fetched links. Also deduplicates concurrent fetches of
+	// the same link.
+	fetchCache   = make(map[string]*fetchCall)
+	fetchCacheMu sync.Mutex
+)
+
+// Resolves links of a certain kind into downloaded files
+type extractor struct {
+	match func(u *url.URL) bool
+
+	// Download the file linked to into directory dir. Must fail, if the file
+	// exceeds maxSize.
+	fetch func(ctx context.Context, u *url.URL, dir string, maxSize int64,
+	) (fetchedFile, error)
+}
+
+// File downloaded by an extractor
+type fetchedFile struct {
+	path, name string
+	author     *string // Optional
+}
+
+// Single fetch of a link and its cached result
+type fetchCall struct {
+	done    chan struct{}
+	expires time.Time
+
+	// SHA1 of the downloaded file, name to attach it by and error, if any
+	sha1, name string
+	err        error
+}
+
+// FetchURL downloads the file linked to in a post command and returns an
+// image allocation token for it and the name to attach it by. Only links to
+// domains whitelisted in the server configuration are fetched. Results are
+// cached, so repeated links are only downloaded once.
+func FetchURL(cmd *common.PostCommand, board string) (
+	token, name string, err error,
+) {
+	u, err := parseFetchURL(cmd.Input)
+	if err != nil {
+		return
+	}
+	strip := shouldStripMetadata(board)
+	key := fmt.Sprintf("%s %d %t %s", u, cmd.Rotation, strip, board)
+
+	for retried := false; ; retried = true {
+		c, owner := acquireFetch(key)
+		if owner {
+			token, c.sha1, c.name, c.err = fetchURL(u, cmd.Rotation, strip)
+			finishFetch(key, c)
+			return token, c.name, c.err
+		}
+
+		<-c.done
+		if c.err != nil {
+			return "", "", c.err
+		}
+		err = db.InTransaction(false, func(tx *sql.Tx) (err error) {
+			stored, err := db.FindImage(tx, c.sha1, strip)
+			if err != nil || stored == "" {
+				return
+			}
+			token, err = db.NewImageToken(tx, stored)
+			return
+		})
+		if err != nil || token != "" || retried {
+			return token, c.name, err
+		}
+
+		// Image was deleted since it was fetched
+		evictFetch(key, c)
+	}
+}
+
+// Parse a link and ensure it can be fetched
+func parseFetchURL(s string) (u *url.URL, err error) {
+	u, err = url.Parse(strings.TrimSpace(s))
+	if err != nil {
+		return nil, errFetchNotAllowed
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errFetchNotAllowed
+	}
+	host := u.Hostname()
+	for _, d := range config.Get().FetchDomains {
+		if matchesDomain(host, d) {
+			return
+		}
+	}
+	return nil, errFetchNotAllowed
+}
+
+// Returns, if host is domain or one of its subdomains
+func matchesDomain(host, domain string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	domain = strings.ToLower(domain)
+	return host == domain || strings.HasSuffix(host, "."+domain)
+}
+
+// Returns, if ip is routable on the public internet
+func isPublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast())
+}
+
+// Retrieve a cached or in-progress fetch of a link. If there is none, a new
+// one is created and owner is true. The owner must perform the fetch and close
+// the done channel.
+func acquireFetch(key string) (c *fetchCall, owner bool) {
+	fetchCacheMu.Lock()
+	defer fetchCacheMu.Unlock()
+
+	now := time.Now()
+	for k, c := range fetchCache {
+		if !c.expires.IsZero() && c.expires.Before(now) {
+			delete(fetchCache, k)
+		}
+	}
+
+	c = fetchCache[key]
+	if c != nil {
+		return c, false
+	}
+	c = &fetchCall{
+		done: make(chan struct{}),
+	}
+	fetchCache[key] = c
+	return c, true
+}
+
+// Mark a fetch as completed. Failed fetches are not cached.
+func finishFetch(key string, c *fetchCall) {
+	fetchCacheMu.Lock()
+	if c.err != nil {
+		delete(fetchCache, key)
+	} else {
+		c.expires = time.Now().Add(fetchCacheTTL)
+	}
+	fetchCacheMu.Unlock()
+	close(c.done)
+}
+
+// Remove a fetch from the cache, if it has not already been replaced
+func evictFetch(key string, c *fetchCall) {
+	fetchCacheMu.Lock()
+	defer fetchCacheMu.Unlock()
+
+	if fetchCache[key] == c {
+		delete(fetchCache, key)
+	}
+}
+
+// Download a link and pass the file to the thumbnailer
+func fetchURL(u *url.URL, rotation int, strip bool) (
+	token, SHA1, name string, err error,
+) {
+	maxSize := int64(config.Get().MaxSize) << 20
+	dir, err := os.MkdirTemp(jobDir, "fetch-")
+	if err != nil {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+
+	var f fetchedFile
+	for _, e := range extractors {
+		if e.match(u) {
+			f, err = e.fetch(ctx, u, dir, maxSize)
+			break
+		}
+	}
+	if err != nil {
+		return
+	}
+
+	if rotation > 0 {
+		err = rotateVideoFile(f.path, rotation)
+		if err != nil {
+			// Not critical for the fetch itself
+			log.Errorf("fetch: rotate %s: %s", u, err)
+			err = nil
+		}
+	}
+
+	file, err := os.Open(f.path)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+	stats, err := file.Stat()
+	if err != nil {
+		return
+	}
+	if stats.Size() > maxSize {
+		err = errTooLarge
+		return
+	}
+	SHA1, _, err = hashFile(file, sha1.New(), hex.EncodeToString)
+	if err != nil {
+		return
+	}
+
+	res := <-requestThumbnailing(context.Background(), file, f.name,
+		int(stats.Size()), f.author, strip)
+	return res.imageID, SHA1, f.name, res.err
+}
+
+// Download a file linked to directly
+func fetchDirect(ctx context.Context, u *url.URL, dir string, maxSize int64,
+) (f fetchedFile, err error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return
+	}
+	res, err := fetchClient.Do(req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		err = fmt.Errorf("fetch: status code %d", res.StatusCode)
+		return
+	}
+	if res.ContentLength > maxSize {
+		err = errTooLarge
+		return
+	}
+
+	f.path = filepath.Join(dir, "direct")
+	out, err := os.Create(f.path)
+	if err != nil {
+		return
+	}
+	defer out.Close()
+	n, err := io.Copy(out, io.LimitReader(res.Body, maxSize+1))
+	switch {
+	case err != nil:
+		return
+	case n > maxSize:
+		err = errTooLarge
+		return
+	}
+
+	f.name = path.Base(u.Path)
+	return
+}
+
+// Download a link to a page with embedded media using yt-dlp
+func fetchYtDlp(ctx context.Context, u *url.URL, dir string, maxSize int64,
+) (f fetchedFile, err error) {
+	proxy, err := startFetchProxy()
+	if err != nil {
+		return
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "yt-dlp",
+		"--proxy", proxy,
+		// External downloaders might not respect the proxy
+		"--downloader", "native",
+		"--no-playlist",
+		"--max-filesize", strconv.FormatInt(maxSize, 10),
+		"--format-sort", "ext:mp4:m4a",
+		"--merge-output-format", "mp4",
+		"--no-simulate",
+		"--print", "%(title)j",
+		"--print", "after_move:filepath",
+		"-o", filepath.Join(dir, "%(id)s.%(ext)s"),
+		"--", u.String(),
+	)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		err = fmt.Errorf("yt-dlp: %s: %s", err, stderr.Bytes())
+		return
+	}
+
+	// Nothing is moved into place, if the file exceeds the size limit
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(lines) < 2 {
+		err = errNothingFetched
+		return
+	}
+	f.path = lines[len(lines)-1]
+	var title string
+	if json.Unmarshal([]byte(lines[0]), &title) != nil || title == "" {
+		title = strings.TrimSuffix(filepath.Base(f.path),
+			filepath.Ext(f.path))
+	}
+	f.name = title + filepath.Ext(f.path)
+	return
+}
+
+// Start the local proxy for yt-dlp, if not yet running, and return its URL
+func startFetchProxy() (string, error) {
+	fetchProxy.once.Do(func() {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			fetchProxy.err = err
+			return
+		}
+		fetchProxy.url = "http://" + l.Addr().String()
+		go func() {
+			err := http.Serve(l, http.HandlerFunc(serveFetchProxy))
+			log.Errorf("fetch: proxy: %s", err)
+		}()
+	})
+	return fetchProxy.url, fetchProxy.err
+}
+
+// Forward a request from yt-dlp. HTTPS connections are tunneled with
+// CONNECT.
+func serveFetchProxy(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodConnect {
+		// Plain HTTP requests already carry the absolute URL
+		(&httputil.ReverseProxy{
+			Director:  func(*http.Request) {},
+			Transport: fetchTransport,
+		}).ServeHTTP(w, r)
+		return
+	}
+
+	upstream, err := fetchDialer.DialContext(r.Context(), "tcp", r.Host)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		upstream.Close()
+		http.Error(w, "hijacking not supported", http.StatusInternalServerError)
+		return
+	}
+	client, buf, err := hj.Hijack()
+	if err != nil {
+		upstream.Close()
+		return
+	}
+	deadline := time.Now().Add(fetchTimeout)
+	for _, c := range [...]net.Conn{client, upstream} {
+		c.SetDeadline(deadline)
+	}
+	_, err = client.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+	if err != nil {
+		client.Close()
+		upstream.Close()
+		return
+	}
+
+	// Closing both connections, once either side is done, also stops the
+	// other copy
+	done := make(chan struct{}, 2)
+	go func() {
+		io.Copy(upstream, buf)
+		done <- struct{}{}
+	}()
+	go func() {
+		io.Copy(client, upstream)
+		done <- struct{}{}
+	}()
+	<-done
+	client.Close()
+	upstream.Close()
+}
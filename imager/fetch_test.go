@@ -0,0 +1,145 @@
+package imager
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/test"
+)
+
+func TestParseFetchURL(t *testing.T) {
+	config.Set(config.Configs{
+		FetchDomains: []string{"tiktok.com", "x.com"},
+	})
+	defer config.Set(config.Configs{})
+
+	cases := [...]struct {
+		name, in string
+		ok       bool
+	}{
+		{"domain", "https://x.com/user/status/1", true},
+		{"subdomain", "https://www.tiktok.com/@user/video/1", true},
+		{"case insensitive", " HTTPS://VM.TikTok.com/abc ", true},
+		{"plain HTTP", "http://x.com/a.mp4", true},
+		{"not whitelisted", "https://example.com/a.mp4", false},
+		{"suffix of other domain", "https://notx.com/a.mp4", false},
+		{"whitelisted as subdomain", "https://x.com.evil.com/a.mp4", false},
+		{"scheme", "ftp://x.com/a.mp4", false},
+		{"no scheme", "x.com/a.mp4", false},
+		{"invalid", "%zz", false},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			_, err := parseFetchURL(c.in)
+			if c.ok {
+				if err != nil {
+					t.Fatal(err)
+				}
+			} else {
+				test.AssertEquals(t, err, errFetchNotAllowed)
+			}
+		})
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		ip     string
+		public bool
+	}{
+		{"1.1.1.1", true},
+		{"2606:4700:4700::1111", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.0.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"fd00::1", false},
+		{"0.0.0.0", false},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.ip, func(t *testing.T) {
+			t.Parallel()
+			test.AssertEquals(t, isPublicIP(net.ParseIP(c.ip)), c.public)
+		})
+	}
+}
+
+func TestFetchCache(t *testing.T) {
+	t.Parallel()
+
+	const key = "https://x.com/cache-test 0 false a"
+
+	c, owner := acquireFetch(key)
+	test.AssertEquals(t, owner, true)
+
+	// Concurrent fetches of the same link wait for the first one
+	waiter, owner := acquireFetch(key)
+	test.AssertEquals(t, owner, false)
+	test.AssertEquals(t, waiter, c)
+
+	c.sha1 = test.GenString(40)
+	finishFetch(key, c)
+	<-waiter.done
+
+	cached, owner := acquireFetch(key)
+	test.AssertEquals(t, owner, false)
+	test.AssertEquals(t, cached, c)
+
+	evictFetch(key, c)
+	c, owner = acquireFetch(key)
+	test.AssertEquals(t, owner, true)
+
+	// Failed fetches are not cached
+	c.err = errNothingFetched
+	finishFetch(key, c)
+	_, owner = acquireFetch(key)
+	test.AssertEquals(t, owner, true)
+}
+
+func TestFetchProxyRefusesPrivateAddresses(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.Write([]byte("secret"))
+		}))
+	defer srv.Close()
+
+	proxy, err := startFetchProxy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxyURL, err := url.Parse(proxy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyURL(proxyURL),
+		},
+	}
+
+	res, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	test.AssertEquals(t, res.StatusCode, http.StatusBadGateway)
+
+	// Tunneled connections
+	_, err = client.Get("https://" + srv.Listener.Addr().String())
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
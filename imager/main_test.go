@@ -24,6 +24,9 @@ func TestMain(m *testing.M) {
 	if err := assets.CreateDirs(); err != nil {
 		panic(err)
 	}
+	if err := StartScheduler(); err != nil {
+		panic(err)
+	}
 
 	code := m.Run()
 	err = close()
@@ -34,6 +37,10 @@ func TestMain(m *testing.M) {
 	if err != nil {
 		panic(err)
 	}
+	err = os.RemoveAll("tmp")
+	if err != nil {
+		panic(err)
+	}
 	os.Exit(code)
 }
 
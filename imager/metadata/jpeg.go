@@ -0,0 +1,140 @@
+package metadata
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+)
+
+// JPEG markers
+const (
+	markerSOI  = 0xd8
+	markerEOI  = 0xd9
+	markerSOS  = 0xda
+	markerAPP0 = 0xe0
+	markerAPP1 = 0xe1
+	markerAPP2 = 0xe2
+	markerAPPE = 0xee
+	markerAPPF = 0xef
+	markerCOM  = 0xfe
+)
+
+var exifHeader = []byte("Exif\x00\x00")
+
+// Strip all APPn segments except JFIF, ICC color profiles and Adobe color
+// transforms and all comments. The image data is copied verbatim.
+func stripJPEG(w io.Writer, r io.Reader) (err error) {
+	br := bufio.NewReader(r)
+	var soi [2]byte
+	_, err = io.ReadFull(br, soi[:])
+	if err != nil {
+		return
+	}
+	if soi[0] != 0xff || soi[1] != markerSOI {
+		return errInvalid
+	}
+	_, err = w.Write(soi[:])
+	if err != nil {
+		return
+	}
+
+	wroteExif := false
+	for {
+		var m byte
+		m, err = readMarker(br)
+		if err != nil {
+			return
+		}
+
+		switch {
+		case m == markerSOS || m == markerEOI:
+			// Everything after is image data
+			_, err = w.Write([]byte{0xff, m})
+			if err != nil {
+				return
+			}
+			_, err = io.Copy(w, br)
+			return
+		case m == 0x01 || m >= 0xd0 && m <= 0xd7:
+			// Standalone markers without a payload
+			_, err = w.Write([]byte{0xff, m})
+			if err != nil {
+				return
+			}
+			continue
+		}
+
+		var l [2]byte
+		_, err = io.ReadFull(br, l[:])
+		if err != nil {
+			return
+		}
+		n := int(binary.BigEndian.Uint16(l[:]))
+		if n < 2 {
+			return errInvalid
+		}
+		payload := make([]byte, n-2)
+		_, err = io.ReadFull(br, payload)
+		if err != nil {
+			return
+		}
+
+		switch {
+		case m == markerAPP1:
+			// Replace EXIF with only the orientation, if any
+			if wroteExif || !bytes.HasPrefix(payload, exifHeader) {
+				continue
+			}
+			tiff := minimalExif(exifOrientation(payload[len(exifHeader):]))
+			if tiff == nil {
+				continue
+			}
+			wroteExif = true
+			payload = append(append([]byte{}, exifHeader...), tiff...)
+		case m == markerAPP2:
+			if !bytes.HasPrefix(payload, []byte("ICC_PROFILE\x00")) {
+				continue
+			}
+		case m == markerCOM,
+			m > markerAPP0 && m <= markerAPPF && m != markerAPPE:
+			continue
+		}
+
+		err = writeSegment(w, m, payload)
+		if err != nil {
+			return
+		}
+	}
+}
+
+// Read the next marker, skipping any fill bytes
+func readMarker(r *bufio.Reader) (m byte, err error) {
+	m, err = r.ReadByte()
+	if err != nil {
+		return
+	}
+	if m != 0xff {
+		return 0, errInvalid
+	}
+	for m == 0xff {
+		m, err = r.ReadByte()
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func writeSegment(w io.Writer, marker byte, payload []byte) (err error) {
+	var h [4]byte
+	h[0] = 0xff
+	h[1] = marker
+	binary.BigEndian.PutUint16(h[2:], uint16(len(payload)+2))
+	_, err = w.Write(h[:])
+	if err != nil {
+		return
+	}
+	_, err = w.Write(payload)
+	return
+}
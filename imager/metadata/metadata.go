@@ -0,0 +1,106 @@
+// Package metadata strips location and other identifying metadata from
+// uploaded files, while preserving their display orientation
+package metadata
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/bakape/meguca/common"
+)
+
+// Orientation tag of the EXIF IFD0
+const orientationTag = 0x0112
+
+var errInvalid = errors.New("metadata: invalid file")
+
+// FileTypes are the file types metadata can be stripped from
+var FileTypes = [...]uint8{common.JPEG, common.PNG, common.WEBP, common.MP4}
+
+// Supported returns, if metadata can be stripped from files of fileType
+func Supported(fileType uint8) bool {
+	for _, t := range FileTypes {
+		if t == fileType {
+			return true
+		}
+	}
+	return false
+}
+
+// Strip writes a copy of r without any location or identifying metadata to w.
+// The orientation of images is kept. fileType must be Supported.
+func Strip(w io.Writer, r io.ReadSeeker, fileType uint8) (err error) {
+	_, err = r.Seek(0, 0)
+	if err != nil {
+		return
+	}
+	switch fileType {
+	case common.JPEG:
+		return stripJPEG(w, r)
+	case common.PNG:
+		return stripPNG(w, r)
+	case common.WEBP:
+		return stripWebP(w, r)
+	case common.MP4:
+		return stripMP4(w, r)
+	default:
+		return errors.New("metadata: unsupported file type")
+	}
+}
+
+// Read the orientation from TIFF-formatted EXIF data. Returns 0, if none.
+func exifOrientation(tiff []byte) uint16 {
+	if len(tiff) < 8 {
+		return 0
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:4]) {
+	case "II*\x00":
+		order = binary.LittleEndian
+	case "MM\x00*":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+
+	off := int(order.Uint32(tiff[4:]))
+	if off < 8 || off+2 > len(tiff) {
+		return 0
+	}
+	n := int(order.Uint16(tiff[off:]))
+	off += 2
+	for i := 0; i < n && off+12 <= len(tiff); i++ {
+		e := tiff[off : off+12]
+		off += 12
+		// Must be a single SHORT
+		if order.Uint16(e) != orientationTag || order.Uint16(e[2:]) != 3 ||
+			order.Uint32(e[4:]) != 1 {
+			continue
+		}
+		o := order.Uint16(e[8:])
+		if o < 1 || o > 8 {
+			return 0
+		}
+		return o
+	}
+	return 0
+}
+
+// Encode TIFF-formatted EXIF data containing only the orientation tag.
+// Returns nil, if the orientation is the default and need not be kept.
+func minimalExif(orientation uint16) []byte {
+	if orientation <= 1 {
+		return nil
+	}
+	b := make([]byte, 26)
+	copy(b, "MM\x00*")
+	binary.BigEndian.PutUint32(b[4:], 8) // IFD0 offset
+	binary.BigEndian.PutUint16(b[8:], 1) // Entry count
+	binary.BigEndian.PutUint16(b[10:], orientationTag)
+	binary.BigEndian.PutUint16(b[12:], 3) // SHORT
+	binary.BigEndian.PutUint32(b[14:], 1)
+	binary.BigEndian.PutUint16(b[18:], orientation)
+	// Next IFD offset is left zero
+	return b
+}
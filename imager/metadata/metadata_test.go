@@ -0,0 +1,263 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+// Encode TIFF-formatted EXIF data with an orientation and GPS IFD pointer
+func testExif(orientation uint16) []byte {
+	b := make([]byte, 8, 64)
+	copy(b, "II*\x00")
+	binary.LittleEndian.PutUint32(b[4:], 8)
+	b = binary.LittleEndian.AppendUint16(b, 2)
+	for _, e := range [...][3]uint32{
+		{orientationTag, 3, uint32(orientation)},
+		{0x8825, 4, 38}, // GPS IFD pointer
+	} {
+		b = binary.LittleEndian.AppendUint16(b, uint16(e[0]))
+		b = binary.LittleEndian.AppendUint16(b, uint16(e[1]))
+		b = binary.LittleEndian.AppendUint32(b, 1)
+		b = binary.LittleEndian.AppendUint32(b, e[2])
+	}
+	b = binary.LittleEndian.AppendUint32(b, 0)
+	return append(b, "GPS coordinates"...)
+}
+
+func strip(t *testing.T, fileType uint8, src []byte) []byte {
+	t.Helper()
+
+	var w bytes.Buffer
+	err := Strip(&w, bytes.NewReader(src), fileType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(w.Bytes(), []byte("GPS")) {
+		t.Fatal("metadata not stripped")
+	}
+	return w.Bytes()
+}
+
+func TestExifOrientation(t *testing.T) {
+	t.Parallel()
+
+	AssertEquals(t, exifOrientation(testExif(6)), uint16(6))
+	AssertEquals(t, exifOrientation(minimalExif(8)), uint16(8))
+	AssertEquals(t, exifOrientation([]byte("foo")), uint16(0))
+	if minimalExif(1) != nil {
+		t.Fatal("default orientation kept")
+	}
+}
+
+func TestStripJPEG(t *testing.T) {
+	t.Parallel()
+
+	segment := func(marker byte, payload string) string {
+		var b bytes.Buffer
+		writeSegment(&b, marker, []byte(payload))
+		return b.String()
+	}
+	var (
+		jfif  = segment(markerAPP0, "JFIF\x00")
+		icc   = segment(markerAPP2, "ICC_PROFILE\x00foo")
+		dqt   = segment(0xdb, "tables")
+		image = "\xff\xda\x00\x02scan\xff\xd9"
+		src   = "\xff\xd8" + jfif +
+			segment(markerAPP1, "Exif\x00\x00"+string(testExif(6))) +
+			segment(markerAPP1, "http://ns.adobe.com/xap/1.0/\x00GPS") +
+			icc +
+			segment(0xed, "Photoshop 3.0\x00GPS") +
+			segment(markerCOM, "GPS") +
+			dqt + image
+	)
+
+	res := strip(t, common.JPEG, []byte(src))
+	AssertEquals(t, string(res), "\xff\xd8"+jfif+
+		segment(markerAPP1, "Exif\x00\x00"+string(minimalExif(6)))+
+		icc+dqt+image)
+}
+
+func TestStripPNG(t *testing.T) {
+	t.Parallel()
+
+	chunk := func(typ, data string) string {
+		var b bytes.Buffer
+		writePNGChunk(&b, typ, []byte(data))
+		return b.String()
+	}
+	var (
+		ihdr = chunk("IHDR", "header")
+		idat = chunk("IDAT", "data")
+		iend = chunk("IEND", "")
+		src  = pngSignature + ihdr +
+			chunk("tEXt", "Comment\x00GPS") +
+			chunk("eXIf", string(testExif(3))) +
+			chunk("iTXt", "XML:com.adobe.xmp\x00GPS") +
+			idat + iend + "GPS"
+	)
+
+	res := strip(t, common.PNG, []byte(src))
+	AssertEquals(t, string(res), pngSignature+ihdr+
+		chunk("eXIf", string(minimalExif(3)))+idat+iend)
+
+	// Chunk CRCs must stay valid
+	c := res[len(pngSignature)+len(ihdr):]
+	n := binary.BigEndian.Uint32(c)
+	AssertEquals(t, binary.BigEndian.Uint32(c[8+n:]),
+		crc32.ChecksumIEEE(c[4:8+n]))
+
+	t.Run("oversized eXIf", func(t *testing.T) {
+		t.Parallel()
+
+		exif := string(testExif(3)) + strings.Repeat("\x00", maxPNGExifSize)
+		res := strip(t, common.PNG,
+			[]byte(pngSignature+ihdr+chunk("eXIf", exif)+idat+iend))
+		AssertEquals(t, string(res), pngSignature+ihdr+idat+iend)
+	})
+
+	t.Run("truncated eXIf", func(t *testing.T) {
+		t.Parallel()
+
+		// Declares a 4 GiB chunk without the data
+		var h [8]byte
+		binary.BigEndian.PutUint32(h[:], 1<<32-1)
+		copy(h[4:], "eXIf")
+		err := Strip(io.Discard,
+			strings.NewReader(pngSignature+ihdr+string(h[:])), common.PNG)
+		if err == nil {
+			t.Fatal("no error")
+		}
+	})
+}
+
+func TestStripWebP(t *testing.T) {
+	t.Parallel()
+
+	chunk := func(typ, data string) string {
+		b := []byte(typ)
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(data)))
+		b = append(b, data...)
+		if len(data)%2 != 0 {
+			b = append(b, 0)
+		}
+		return string(b)
+	}
+	riff := func(chunks string) string {
+		b := binary.LittleEndian.AppendUint32([]byte("RIFF"),
+			uint32(len(chunks)+4))
+		return string(b) + "WEBP" + chunks
+	}
+	vp8x := func(flags byte) string {
+		return chunk("VP8X", string([]byte{flags, 0, 0, 0, 1, 0, 0, 1, 0, 0}))
+	}
+
+	cases := [...]struct {
+		name        string
+		orientation uint16
+		out         string
+	}{
+		{
+			name:        "default orientation",
+			orientation: 1,
+			out:         riff(vp8x(0x10) + chunk("VP8L", "image")),
+		},
+		{
+			name:        "rotated",
+			orientation: 6,
+			out: riff(vp8x(0x10|webpFlagEXIF) + chunk("VP8L", "image") +
+				chunk("EXIF", string(minimalExif(6)))),
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			src := riff(vp8x(0x10|webpFlagEXIF|webpFlagXMP) +
+				chunk("VP8L", "image") +
+				chunk("EXIF", "Exif\x00\x00"+string(testExif(c.orientation))) +
+				chunk("XMP ", "GPS"))
+			AssertEquals(t, string(strip(t, common.WEBP, []byte(src))), c.out)
+		})
+	}
+}
+
+func TestStripMP4(t *testing.T) {
+	t.Parallel()
+
+	box := func(typ string, children ...string) string {
+		var b []byte
+		for _, c := range children {
+			b = append(b, c...)
+		}
+		h := binary.BigEndian.AppendUint32(nil, uint32(len(b)+8))
+		return string(h) + typ + string(b)
+	}
+	stco := func(offsets ...uint32) string {
+		b := make([]byte, 4, 8+4*len(offsets))
+		b = binary.BigEndian.AppendUint32(b, uint32(len(offsets)))
+		for _, o := range offsets {
+			b = binary.BigEndian.AppendUint32(b, o)
+		}
+		return box("stco", string(b))
+	}
+	co64 := func(off uint64) string {
+		b := make([]byte, 4, 16)
+		b = binary.BigEndian.AppendUint32(b, 1)
+		b = binary.BigEndian.AppendUint64(b, off)
+		return box("co64", string(b))
+	}
+	xmp := box("uuid", xmpUUID, "GPS")
+	trak := func(offsets string, meta bool) string {
+		c := []string{
+			box("tkhd", "matrix"),
+			box("mdia", box("minf", box("stbl", offsets))),
+		}
+		if meta {
+			c = append(c, box("udta", "GPS"), box("meta", "GPS"))
+		}
+		return box("trak", c...)
+	}
+
+	var (
+		ftyp = box("ftyp", "isom")
+		// Chunk offsets are patched in place, so fill in after measuring
+		moov = func(video, audio string, meta bool) string {
+			c := []string{box("mvhd", "header"), trak(video, meta)}
+			if meta {
+				c = append(c, xmp, box("udta", box("\xa9xyz", "GPS")))
+			}
+			c = append(c, trak(audio, meta))
+			if meta {
+				c = append(c, box("meta", "GPS"))
+			}
+			return box("moov", c...)
+		}
+		before = box("meta", "GPS")
+		mdat   = box("mdat", "videoaudio")
+	)
+
+	// Compute offsets of the chunks in the source file
+	srcLen := len(ftyp) + len(before) + len(moov(stco(0), co64(0), true))
+	src := ftyp + before +
+		moov(stco(uint32(srcLen+8)), co64(uint64(srcLen+13)), true) +
+		mdat + xmp
+
+	outLen := len(ftyp) + len(moov(stco(0), co64(0), false))
+	std := ftyp +
+		moov(stco(uint32(outLen+8)), co64(uint64(outLen+13)), false) +
+		mdat
+
+	res := strip(t, common.MP4, []byte(src))
+	AssertEquals(t, string(res), std)
+	AssertEquals(t, string(res[outLen+8:outLen+13]), "video")
+	AssertEquals(t, string(res[outLen+13:outLen+18]), "audio")
+}
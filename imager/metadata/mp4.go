@@ -0,0 +1,289 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+)
+
+// UUID of boxes containing XMP metadata
+const xmpUUID = "\xbe\x7a\xcf\xcb\x97\xa9\x42\xe8\x9c\x71\x99\x94\x91\xe3\xaf\xac"
+
+// Boxes to descend into, when patching chunk offsets
+var mp4Containers = map[string]bool{
+	"trak": true,
+	"mdia": true,
+	"minf": true,
+	"stbl": true,
+}
+
+// Top level box of an MP4 file
+type mp4Box struct {
+	typ          string
+	offset, size int64
+}
+
+// Byte range removed from the original file
+type removedRange struct {
+	offset, size int64
+}
+
+// Strip user data, metadata and XMP boxes from the top level, the movie
+// header and its tracks. Chunk offsets are adjusted for the removed bytes.
+// Orientation is stored in the track headers and is thus kept.
+func stripMP4(w io.Writer, r io.ReadSeeker) (err error) {
+	end, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return
+	}
+
+	var (
+		boxes   []mp4Box
+		removed []removedRange
+		moov    []byte
+	)
+	for off := int64(0); off < end; {
+		var b mp4Box
+		b, err = readMP4BoxHeader(r, off, end)
+		if err != nil {
+			return
+		}
+		off += b.size
+
+		switch b.typ {
+		case "udta", "meta":
+			removed = append(removed, removedRange{b.offset, b.size})
+			continue
+		case "uuid":
+			var xmp bool
+			xmp, err = isXMPBox(r, b)
+			if err != nil {
+				return
+			}
+			if xmp {
+				removed = append(removed, removedRange{b.offset, b.size})
+				continue
+			}
+		case "moov":
+			if moov != nil {
+				return errInvalid
+			}
+			buf := make([]byte, b.size)
+			_, err = r.Seek(b.offset, io.SeekStart)
+			if err != nil {
+				return
+			}
+			_, err = io.ReadFull(r, buf)
+			if err != nil {
+				return
+			}
+			moov, err = filterMP4Box(buf)
+			if err != nil {
+				return
+			}
+			removed = append(removed, removedRange{
+				b.offset,
+				b.size - int64(len(moov)),
+			})
+		}
+		boxes = append(boxes, b)
+	}
+	if moov != nil {
+		hdr := 8
+		if binary.BigEndian.Uint32(moov) == 1 {
+			hdr = 16
+		}
+		err = patchChunkOffsets(moov[hdr:], removed)
+		if err != nil {
+			return
+		}
+	}
+
+	for _, b := range boxes {
+		if b.typ == "moov" {
+			_, err = w.Write(moov)
+		} else {
+			_, err = r.Seek(b.offset, io.SeekStart)
+			if err != nil {
+				return
+			}
+			_, err = io.CopyN(w, r, b.size)
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Read the header of the box at off and return its type and full size
+func readMP4BoxHeader(r io.ReadSeeker, off, end int64) (b mp4Box, err error) {
+	_, err = r.Seek(off, io.SeekStart)
+	if err != nil {
+		return
+	}
+	var h [16]byte
+	_, err = io.ReadFull(r, h[:8])
+	if err != nil {
+		return
+	}
+	b.offset = off
+	b.typ = string(h[4:8])
+	b.size = int64(binary.BigEndian.Uint32(h[:4]))
+	switch b.size {
+	case 0: // Extends to the end of the file
+		b.size = end - off
+	case 1:
+		_, err = io.ReadFull(r, h[8:])
+		if err != nil {
+			return
+		}
+		b.size = int64(binary.BigEndian.Uint64(h[8:]))
+	}
+	if b.size < 8 || off+b.size > end {
+		err = errInvalid
+	}
+	return
+}
+
+func isXMPBox(r io.ReadSeeker, b mp4Box) (bool, error) {
+	if b.size < 8+16 {
+		return false, nil
+	}
+	_, err := r.Seek(b.offset+8, io.SeekStart)
+	if err != nil {
+		return false, err
+	}
+	var id [16]byte
+	_, err = io.ReadFull(r, id[:])
+	return string(id[:]) == xmpUUID, err
+}
+
+// Iterate the child boxes in buf. Passes the box type, header size and the
+// full box to fn.
+func eachMP4Box(buf []byte, fn func(typ string, hdr int, box []byte) error,
+) error {
+	for len(buf) != 0 {
+		if len(buf) < 8 {
+			return errInvalid
+		}
+		hdr := 8
+		size := uint64(binary.BigEndian.Uint32(buf))
+		switch size {
+		case 0:
+			size = uint64(len(buf))
+		case 1:
+			if len(buf) < 16 {
+				return errInvalid
+			}
+			hdr = 16
+			size = binary.BigEndian.Uint64(buf[8:])
+		}
+		if size < uint64(hdr) || size > uint64(len(buf)) {
+			return errInvalid
+		}
+		err := fn(string(buf[4:8]), hdr, buf[:size])
+		if err != nil {
+			return err
+		}
+		buf = buf[size:]
+	}
+	return nil
+}
+
+// Rebuild a moov or trak box without any metadata child boxes
+func filterMP4Box(box []byte) (out []byte, err error) {
+	hdr := 8
+	if binary.BigEndian.Uint32(box) == 1 {
+		hdr = 16
+	}
+	name := box[4:8]
+
+	var children bytes.Buffer
+	err = eachMP4Box(box[hdr:], func(typ string, hdr int, box []byte) error {
+		switch typ {
+		case "udta", "meta":
+			return nil
+		case "uuid":
+			if len(box) >= hdr+16 && string(box[hdr:hdr+16]) == xmpUUID {
+				return nil
+			}
+		case "trak":
+			b, err := filterMP4Box(box)
+			if err != nil {
+				return err
+			}
+			box = b
+		}
+		children.Write(box)
+		return nil
+	})
+	if err != nil {
+		return
+	}
+
+	size := uint64(8 + children.Len())
+	if size > 1<<32-1 {
+		out = make([]byte, 16, size+8)
+		binary.BigEndian.PutUint32(out, 1)
+		binary.BigEndian.PutUint64(out[8:], size+8)
+	} else {
+		out = make([]byte, 8, size)
+		binary.BigEndian.PutUint32(out, uint32(size))
+	}
+	copy(out[4:], name)
+	out = append(out, children.Bytes()...)
+	return
+}
+
+// Shift all chunk offsets in the children of a moov box by the amount of
+// bytes removed before them
+func patchChunkOffsets(buf []byte, removed []removedRange) error {
+	shift := func(off uint64) uint64 {
+		n := off
+		for _, r := range removed {
+			if uint64(r.offset) < off {
+				n -= uint64(r.size)
+			}
+		}
+		return n
+	}
+
+	return eachMP4Box(buf, func(typ string, hdr int, box []byte) error {
+		if mp4Containers[typ] {
+			return patchChunkOffsets(box[hdr:], removed)
+		}
+
+		var width int
+		switch typ {
+		case "stco":
+			width = 4
+		case "co64":
+			width = 8
+		default:
+			return nil
+		}
+
+		// Full box header and entry count
+		data := box[hdr:]
+		if len(data) < 8 {
+			return errInvalid
+		}
+		n := int(binary.BigEndian.Uint32(data[4:]))
+		data = data[8:]
+		if n > len(data)/width {
+			return errInvalid
+		}
+		for i := 0; i < n; i++ {
+			e := data[i*width:]
+			if width == 4 {
+				binary.BigEndian.PutUint32(e,
+					uint32(shift(uint64(binary.BigEndian.Uint32(e)))))
+			} else {
+				binary.BigEndian.PutUint64(e,
+					shift(binary.BigEndian.Uint64(e)))
+			}
+		}
+		return nil
+	})
+}
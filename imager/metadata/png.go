@@ -0,0 +1,84 @@
+package metadata
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+)
+
+const (
+	pngSignature = "\x89PNG\r\n\x1a\n"
+
+	// EXIF data is limited to 64 KiB by the specification. Larger eXIf chunks
+	// are dropped without being buffered.
+	maxPNGExifSize = 1 << 16
+)
+
+// PNG chunks, that can carry identifying information
+var pngMetadataChunks = map[string]bool{
+	"tEXt": true,
+	"zTXt": true,
+	"iTXt": true,
+	"tIME": true,
+	"eXIf": true,
+}
+
+// Strip textual, timestamp and EXIF chunks. Any data after IEND is dropped.
+func stripPNG(w io.Writer, r io.Reader) (err error) {
+	var sig [8]byte
+	_, err = io.ReadFull(r, sig[:])
+	if err != nil {
+		return
+	}
+	if string(sig[:]) != pngSignature {
+		return errInvalid
+	}
+	_, err = w.Write(sig[:])
+	if err != nil {
+		return
+	}
+
+	for {
+		var h [8]byte
+		_, err = io.ReadFull(r, h[:])
+		if err != nil {
+			return
+		}
+		n := int64(binary.BigEndian.Uint32(h[:4]))
+		typ := string(h[4:])
+
+		switch {
+		case typ == "eXIf" && n <= maxPNGExifSize:
+			buf := make([]byte, n+4)
+			_, err = io.ReadFull(r, buf)
+			if err != nil {
+				return
+			}
+			tiff := minimalExif(exifOrientation(buf[:n]))
+			if tiff != nil {
+				err = writePNGChunk(w, typ, tiff)
+			}
+		case pngMetadataChunks[typ]:
+			_, err = io.CopyN(io.Discard, r, n+4)
+		default:
+			_, err = w.Write(h[:])
+			if err != nil {
+				return
+			}
+			_, err = io.CopyN(w, r, n+4) // Including CRC
+		}
+		if err != nil || typ == "IEND" {
+			return
+		}
+	}
+}
+
+func writePNGChunk(w io.Writer, typ string, data []byte) (err error) {
+	buf := make([]byte, 8, 12+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	copy(buf[4:], typ)
+	buf = append(buf, data...)
+	buf = binary.BigEndian.AppendUint32(buf, crc32.ChecksumIEEE(buf[4:]))
+	_, err = w.Write(buf)
+	return
+}
@@ -0,0 +1,74 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+)
+
+// VP8X header flags
+const (
+	webpFlagEXIF = 0x08
+	webpFlagXMP  = 0x04
+)
+
+// Strip EXIF and XMP chunks and update the extended header flags
+// accordingly
+func stripWebP(w io.Writer, r io.Reader) (err error) {
+	buf, err := io.ReadAll(r)
+	if err != nil {
+		return
+	}
+	if len(buf) < 12 || string(buf[:4]) != "RIFF" ||
+		string(buf[8:12]) != "WEBP" {
+		return errInvalid
+	}
+
+	var (
+		out   = bytes.NewBuffer(make([]byte, 0, len(buf)))
+		flags = -1 // Offset of the VP8X flags in out
+		exif  bool
+	)
+	out.Write(buf[:12])
+	for off := 12; off+8 <= len(buf); {
+		typ := string(buf[off : off+4])
+		n := int(binary.LittleEndian.Uint32(buf[off+4:]))
+		end := off + 8 + n + n&1 // Chunks are padded to even size
+		if end > len(buf) || end < off {
+			return errInvalid
+		}
+		data := buf[off+8 : off+8+n]
+		chunk := buf[off:end]
+		off = end
+
+		switch typ {
+		case "VP8X":
+			flags = out.Len() + 8
+		case "EXIF":
+			data = bytes.TrimPrefix(data, exifHeader)
+			tiff := minimalExif(exifOrientation(data))
+			if tiff == nil || exif {
+				continue
+			}
+			exif = true
+			chunk = make([]byte, 8, 8+len(tiff))
+			copy(chunk, typ)
+			binary.LittleEndian.PutUint32(chunk[4:], uint32(len(tiff)))
+			chunk = append(chunk, tiff...) // Always of even length
+		case "XMP ":
+			continue
+		}
+		out.Write(chunk)
+	}
+
+	b := out.Bytes()
+	binary.LittleEndian.PutUint32(b[4:], uint32(len(b)-8))
+	if flags != -1 && flags < len(b) {
+		b[flags] &^= webpFlagXMP
+		if !exif {
+			b[flags] &^= webpFlagEXIF
+		}
+	}
+	_, err = w.Write(b)
+	return
+}
@@ -1,21 +1,59 @@
 package imager
 
 import (
+	"context"
 	"crypto/sha1"
 	"database/sql"
 	"encoding/hex"
+	"errors"
 	"hash"
 	"io"
 	"mime/multipart"
+	"os"
+	"path/filepath"
 	"runtime"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/db"
+	"github.com/go-playground/log"
+)
+
+const (
+	// Maximum number of times a job is attempted
+	maxJobAttempts = 3
+	// Maximum duration of a single attempt to process a job
+	jobTimeout = 5 * time.Minute
+	// Maximum duration of a single attempt to transcode a file
+	transcodeTimeout = 30 * time.Minute
+	// Files bigger than this are processed by a separate worker
+	largeJobSize = 4 << 20
 )
 
 var (
-	scheduleJob      = make(chan jobRequest, 128)
-	scheduleSmallJob = make(chan jobRequest, 128)
+	errJobTimeout = common.StatusError{
+		Err:  errors.New("thumbnailing timed out"),
+		Code: 500,
+	}
+	errJobCancelled = errors.New("thumbnailing job cancelled")
+
+	// Directory uploaded files are spooled to, until processed
+	jobDir = filepath.Join("tmp", "thumbnailing")
+
+	// Wake up idle small and large file workers on new jobs
+	wakeSmallWorker = make(chan struct{}, 1)
+	wakeLargeWorker = make(chan struct{}, 1)
+
+	jobsMu sync.Mutex
+	// Clients of this process waiting for job results
+	jobWaiters = make(map[uint64]chan<- thumbnailingResponse)
+	// Cancel jobs running in this process
+	runningJobs = make(map[uint64]context.CancelFunc)
+
+	// Number of workers and number of workers currently processing a job
+	workerCount, busyWorkers int32
 
 	// Pool of temp buffers used for hashing
 	buf512Pool = sync.Pool{
@@ -25,48 +63,380 @@ var (
 	}
 )
 
-type jobRequest struct {
-	file       multipart.File
-	filename   string
-	size       int
-	res        chan<- thumbnailingResponse
-	tiktokName *string
-}
-
 type thumbnailingResponse struct {
 	imageID string
 	err     error
 }
 
-// Queues upload processing to prevent resource overuse
-func requestThumbnailing(file multipart.File, filename string, size int, tiktokName *string) <-chan thumbnailingResponse {
+// QueueStats contains the state of the thumbnailing queue and the workers of
+// this process
+type QueueStats struct {
+	db.ThumbnailQueueStats
+	Workers     int32   `json:"workers"`
+	BusyWorkers int32   `json:"busyWorkers"`
+	Utilization float32 `json:"utilization"`
+}
+
+// Queues upload processing to prevent resource overuse. Jobs are persisted to
+// the database and resumed after server restarts. Cancelling ctx cancels the
+// job. If strip is true, identifying metadata is stripped from the file.
+func requestThumbnailing(ctx context.Context, file multipart.File,
+	filename string, size int, tiktokName *string, strip bool,
+) <-chan thumbnailingResponse {
+	res := make(chan thumbnailingResponse, 1)
+	ch := make(chan thumbnailingResponse, 1)
+	id, err := enqueueJob(file, filename, size, tiktokName, strip, ch)
+	if err != nil {
+		res <- thumbnailingResponse{"", err}
+		return res
+	}
+
+	go func() {
+		select {
+		case r := <-ch:
+			res <- r
+		case <-ctx.Done():
+			err := CancelJob(id)
+			if err != nil {
+				log.Errorf("thumbnailing: cancel job %d: %s", id, err)
+			}
+			res <- thumbnailingResponse{"", ctx.Err()}
+		}
+	}()
+	return res
+}
+
+// Spool the file to disk and insert a job into the queue
+func enqueueJob(file multipart.File, filename string, size int,
+	tiktokName *string, strip bool, res chan<- thumbnailingResponse,
+) (id uint64, err error) {
+	spool, err := os.CreateTemp(jobDir, "job-")
+	if err != nil {
+		return
+	}
+	defer spool.Close()
+	_, err = file.Seek(0, 0)
+	if err != nil {
+		return
+	}
+	_, err = io.Copy(spool, file)
+	if err != nil {
+		os.Remove(spool.Name())
+		return
+	}
+
 	// 2 separate queues - one for small and one for bigger files.
 	// Allows for some degree of concurrent thumbnailing without exhausting
 	// server resources.
-	ch := make(chan thumbnailingResponse)
-	req := jobRequest{file, filename, size, ch, tiktokName}
-	if size <= 4<<20 {
-		scheduleSmallJob <- req
-	} else {
-		scheduleJob <- req
+	j := db.ThumbnailJob{
+		Priority:      db.UploadJobPriority,
+		Large:         size > largeJobSize,
+		Path:          spool.Name(),
+		Filename:      filename,
+		Size:          size,
+		TikTokName:    tiktokName,
+		StripMetadata: strip,
+	}
+	if tiktokName != nil {
+		j.Priority = db.TikTokJobPriority
+	}
+
+	// Register waiter before the job can possibly be claimed
+	jobsMu.Lock()
+	err = db.InsertThumbnailJob(&j)
+	if err == nil {
+		jobWaiters[j.ID] = res
+	}
+	jobsMu.Unlock()
+	if err != nil {
+		os.Remove(spool.Name())
+		return
+	}
+
+	wakeWorker(j.Large)
+	return j.ID, nil
+}
+
+// Wake up the idle small or large file worker, if any
+func wakeWorker(large bool) {
+	wake := wakeSmallWorker
+	if large {
+		wake = wakeLargeWorker
+	}
+	select {
+	case wake <- struct{}{}:
+	default:
 	}
-	return ch
 }
 
-// Queue thumbnailing jobs to reduce resource contention and prevent OOM
-func init() {
-	for _, ch := range [...]<-chan jobRequest{scheduleJob, scheduleSmallJob} {
-		go func(queue <-chan jobRequest) {
-			runtime.LockOSThread()
-			for {
-				req := <-queue
-				id, err := processRequest(req.file, req.filename, req.size, req.tiktokName)
-				req.res <- thumbnailingResponse{id, err}
+// CancelJob cancels a pending or running thumbnailing job and releases its
+// resources
+func CancelJob(id uint64) (err error) {
+	jobsMu.Lock()
+	res := jobWaiters[id]
+	delete(jobWaiters, id)
+	cancel := runningJobs[id]
+	jobsMu.Unlock()
+	if res != nil {
+		res <- thumbnailingResponse{"", errJobCancelled}
+	}
+
+	cancelled, err := db.CancelThumbnailJob(id)
+	if err != nil {
+		return
+	}
+	if cancel != nil {
+		// Running job cleans up after itself
+		cancel()
+	} else if cancelled {
+		err = removeSpooledFile(id)
+	}
+	return
+}
+
+// Remove spooled file of a job by its ID
+func removeSpooledFile(id uint64) error {
+	path, err := db.GetThumbnailJobPath(id)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if os.IsNotExist(err) {
+		err = nil
+	}
+	return err
+}
+
+// GetQueueStats returns the state of the thumbnailing queue
+func GetQueueStats() (s QueueStats, err error) {
+	s.ThumbnailQueueStats, err = db.GetThumbnailQueueStats()
+	if err != nil {
+		return
+	}
+	s.Workers = atomic.LoadInt32(&workerCount)
+	s.BusyWorkers = atomic.LoadInt32(&busyWorkers)
+	if s.Workers != 0 {
+		s.Utilization = float32(s.BusyWorkers) / float32(s.Workers)
+	}
+	return
+}
+
+// StartScheduler starts the thumbnailing workers. Must be called after the
+// database is loaded.
+func StartScheduler() (err error) {
+	err = os.MkdirAll(jobDir, 0700)
+	if err != nil {
+		return
+	}
+
+	// Queue thumbnailing jobs to reduce resource contention and prevent OOM
+	for _, large := range [...]bool{false, true} {
+		atomic.AddInt32(&workerCount, 1)
+		go runWorker(large)
+	}
+
+	// Jobs of crashed processes
+	go func() {
+		for {
+			err := db.RequeueStaleThumbnailJobs(2*jobTimeout,
+				2*transcodeTimeout, maxJobAttempts)
+			if err != nil {
+				log.Errorf("thumbnailing: requeue stale jobs: %s", err)
+			}
+			time.Sleep(time.Minute)
+		}
+	}()
+	return
+}
+
+// Process jobs of the small or large file queue
+func runWorker(large bool) {
+	wake := wakeSmallWorker
+	if large {
+		wake = wakeLargeWorker
+	}
+	// Poll for jobs due for retry and jobs inserted by other processes
+	poll := time.NewTicker(5 * time.Second)
+	defer poll.Stop()
+
+	for {
+		j, ok, err := db.ClaimThumbnailJob(large)
+		if err != nil {
+			log.Errorf("thumbnailing: claim job: %s", err)
+		}
+		if !ok {
+			select {
+			case <-wake:
+			case <-poll.C:
 			}
-		}(ch)
+			continue
+		}
+		runJob(j)
 	}
 }
 
+// Run a claimed job and record its outcome
+func runJob(j db.ThumbnailJob) {
+	atomic.AddInt32(&busyWorkers, 1)
+	defer atomic.AddInt32(&busyWorkers, -1)
+
+	timeout := jobTimeout
+	if j.Transcode {
+		timeout = transcodeTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	jobsMu.Lock()
+	runningJobs[j.ID] = cancel
+	jobsMu.Unlock()
+	defer func() {
+		jobsMu.Lock()
+		delete(runningJobs, j.ID)
+		jobsMu.Unlock()
+	}()
+
+	token, transcodeSHA1, running, err := processJob(ctx, j)
+	if running != nil {
+		// Processing could not be interrupted. Keep the worker busy until it
+		// exits, so the number of concurrently processed files does not
+		// exceed the number of workers.
+		defer func() {
+			<-running
+		}()
+	}
+	var dbErr error
+	switch {
+	case err == nil:
+		dbErr = db.FinishThumbnailJob(j.ID)
+		if transcodeSHA1 != "" && queueTranscoding(j, transcodeSHA1) {
+			// Spooled file is now owned by the transcoding job
+			j.Path = ""
+		}
+	case ctx.Err() == context.Canceled:
+		// Already marked as cancelled
+	case isRetryable(err) && j.Attempts+1 < maxJobAttempts:
+		// Exponential backoff of 2, 4, 8, ... seconds
+		dbErr = db.FailThumbnailJob(j.ID, err.Error(),
+			time.Second<<(j.Attempts+1))
+		if dbErr == nil {
+			// Client keeps waiting for the retry
+			return
+		}
+	default:
+		dbErr = db.FailThumbnailJob(j.ID, err.Error(), 0)
+	}
+	if dbErr != nil {
+		log.Errorf("thumbnailing: job %d: %s", j.ID, dbErr)
+	}
+
+	if j.Path != "" {
+		rmErr := os.Remove(j.Path)
+		if rmErr != nil && !os.IsNotExist(rmErr) {
+			log.Errorf("thumbnailing: job %d: %s", j.ID, rmErr)
+		}
+	}
+
+	jobsMu.Lock()
+	res := jobWaiters[j.ID]
+	delete(jobWaiters, j.ID)
+	jobsMu.Unlock()
+	if res != nil {
+		res <- thumbnailingResponse{token, err}
+	}
+}
+
+// Queue transcoding of a processed job's spooled file into a browser-playable
+// rendition. Returns, if queued.
+func queueTranscoding(j db.ThumbnailJob, SHA1 string) bool {
+	t := db.ThumbnailJob{
+		Priority:  db.TranscodeJobPriority,
+		Large:     true,
+		Path:      j.Path,
+		Filename:  j.Filename,
+		Size:      j.Size,
+		Transcode: true,
+		SHA1:      SHA1,
+	}
+	err := db.InsertThumbnailJob(&t)
+	if err != nil {
+		log.Errorf("transcoding: queue %s: %s", SHA1, err)
+		return false
+	}
+	wakeWorker(true)
+	return true
+}
+
+// Process the spooled file of a job. Returns early, if ctx is done.
+// Thumbnailing itself can not be interrupted and its result is discarded in
+// that case. running is then closed, once thumbnailing exits. Returns the SHA1
+// hash of the file, if it should be transcoded.
+func processJob(ctx context.Context, j db.ThumbnailJob) (
+	token, transcodeSHA1 string, running <-chan struct{}, err error,
+) {
+	if j.Transcode || j.Regenerate {
+		run := transcode
+		if j.Regenerate {
+			run = regenerateThumbnails
+		}
+		err = run(ctx, j)
+		if err == context.DeadlineExceeded {
+			err = errJobTimeout
+		}
+		return
+	}
+
+	f, err := os.Open(j.Path)
+	if err != nil {
+		return
+	}
+
+	type result struct {
+		token, SHA1 string
+		transcode   bool
+		err         error
+	}
+	ch := make(chan result, 1)
+	exited := make(chan struct{})
+	go func() {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+		defer close(exited)
+		defer f.Close()
+
+		var r result
+		r.token, r.SHA1, r.transcode, r.err = processRequest(f, j.Filename,
+			j.Size, j.TikTokName, j.StripMetadata)
+		ch <- r
+	}()
+
+	select {
+	case r := <-ch:
+		if r.transcode {
+			transcodeSHA1 = r.SHA1
+		}
+		return r.token, transcodeSHA1, nil, r.err
+	case <-ctx.Done():
+		if ctx.Err() == context.DeadlineExceeded {
+			err = errJobTimeout
+		} else {
+			err = ctx.Err()
+		}
+		running = exited
+		return
+	}
+}
+
+// Errors caused by the uploaded file itself and timeouts are not retried
+func isRetryable(err error) bool {
+	if err == errJobTimeout {
+		return false
+	}
+	if err, ok := err.(common.StatusError); ok {
+		return err.Code >= 500
+	}
+	return true
+}
+
 // Hash file to string
 func hashFile(rs io.ReadSeeker, h hash.Hash, encode func([]byte) string,
 ) (
@@ -100,27 +470,32 @@ func hashFile(rs io.ReadSeeker, h hash.Hash, encode func([]byte) string,
 	}
 }
 
-func processRequest(file multipart.File, filename string, size int, tiktokName *string) (token string, err error) {
-	SHA1, _, err := hashFile(file, sha1.New(), hex.EncodeToString)
+// Returns the SHA1 hash of the stored file, which differs from the uploaded
+// one, if metadata was stripped
+func processRequest(file multipart.File, filename string, size int, tiktokName *string, strip bool) (token, SHA1 string, transcode bool, err error) {
+	SHA1, _, err = hashFile(file, sha1.New(), hex.EncodeToString)
 	if err != nil {
 		return
 	}
-	var exists bool
+	var stored string
 	err = db.InTransaction(false, func(tx *sql.Tx) (err error) {
-		exists, err = db.ImageExists(tx, SHA1)
+		stored, err = db.FindImage(tx, SHA1, strip)
 		if err != nil {
 			return
 		}
-		if exists { // Already have a thumbnail
-			token, err = db.NewImageToken(tx, SHA1)
+		if stored != "" { // Already have a thumbnail
+			token, err = db.NewImageToken(tx, stored)
 		}
 		return
 	})
 	if err != nil {
 		return
 	}
-	if !exists {
-		token, err = newThumbnail(file, filename, SHA1, tiktokName)
+	if stored != "" {
+		SHA1 = stored
+	} else {
+		token, SHA1, transcode, err = newThumbnail(file, filename, SHA1,
+			tiktokName, strip)
 	}
 	return
 }
@@ -0,0 +1,383 @@
+package imager
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"os"
+	"os/exec"
+	"sort"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/imager/assets"
+	"github.com/bakape/thumbnailer/v2"
+	"github.com/go-playground/log"
+)
+
+// Bounding box size of the standard thumbnail
+const thumbSize = 150
+
+// Thumbnail scaled to fit a bounding box
+type scaledThumb struct {
+	size uint16
+	img  image.Image
+}
+
+// Encoded thumbnail file
+type thumbFile struct {
+	size     uint16 // Bounding box size
+	fileType uint8
+	data     []byte
+}
+
+// Returns the bounding box sizes of additional thumbnails in ascending order
+func thumbnailSizes() []uint16 {
+	conf := config.Server.Thumbnails.Sizes
+	if conf == nil {
+		return []uint16{300, 600}
+	}
+	sizes := make([]uint16, 0, len(conf))
+	for _, s := range conf {
+		if s > thumbSize {
+			sizes = append(sizes, s)
+		}
+	}
+	sort.Slice(sizes, func(i, j int) bool {
+		return sizes[i] < sizes[j]
+	})
+	return sizes
+}
+
+// Returns thumbnailer dimensions fitting the largest thumbnail
+func maxThumbDims() thumbnailer.Dims {
+	max := uint(thumbSize)
+	if sizes := thumbnailSizes(); len(sizes) != 0 {
+		max = uint(sizes[len(sizes)-1])
+	}
+	return thumbnailer.Dims{
+		Width:  max,
+		Height: max,
+	}
+}
+
+// Fit w x h into a square bounding box of size max preserving the aspect
+// ratio. Never upscales.
+func fitDims(w, h, max int) (int, int) {
+	if w <= max && h <= max {
+		return w, h
+	}
+	if w >= h {
+		h = (h*max + w/2) / w
+		w = max
+	} else {
+		w = (w*max + h/2) / h
+		h = max
+	}
+	if w == 0 {
+		w = 1
+	}
+	if h == 0 {
+		h = 1
+	}
+	return w, h
+}
+
+// Scale the thumbnail of the largest size down to the standard size and any
+// additional sizes, the thumbnail is big enough for. The first returned
+// thumbnail is the standard one.
+func scaleThumbnails(thumb image.Image, sizes []uint16) []scaledThumb {
+	b := thumb.Bounds()
+	w, h := fitDims(b.Dx(), b.Dy(), thumbSize)
+	scaled := []scaledThumb{{thumbSize, resizeImage(thumb, w, h)}}
+	for _, s := range sizes {
+		sw, sh := fitDims(b.Dx(), b.Dy(), int(s))
+		if sw <= w && sh <= h {
+			// No higher resolution available
+			break
+		}
+		scaled = append(scaled, scaledThumb{s, resizeImage(thumb, sw, sh)})
+		w, h = sw, sh
+	}
+	return scaled
+}
+
+// Downscale an image to w x h by averaging the pixels of the source covered by
+// each destination pixel. Returns src, if already of that size.
+func resizeImage(src image.Image, w, h int) image.Image {
+	b := src.Bounds()
+	sw, sh := b.Dx(), b.Dy()
+	if sw == w && sh == h {
+		return src
+	}
+	s, ok := src.(*image.RGBA)
+	if !ok {
+		s = image.NewRGBA(b)
+		draw.Draw(s, b, src, b.Min, draw.Src)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		y0, y1 := y*sh/h, (y+1)*sh/h
+		if y1 == y0 {
+			y1++
+		}
+		for x := 0; x < w; x++ {
+			x0, x1 := x*sw/w, (x+1)*sw/w
+			if x1 == x0 {
+				x1++
+			}
+
+			var sum [4]uint32
+			for sy := y0; sy < y1; sy++ {
+				i := s.PixOffset(b.Min.X+x0, b.Min.Y+sy)
+				for sx := x0; sx < x1; sx++ {
+					for c := range sum {
+						sum[c] += uint32(s.Pix[i+c])
+					}
+					i += 4
+				}
+			}
+			n := uint32((x1 - x0) * (y1 - y0))
+			j := dst.PixOffset(x, y)
+			for c := range sum {
+				dst.Pix[j+c] = uint8((sum[c] + n/2) / n)
+			}
+		}
+	}
+	return dst
+}
+
+// Encode scaled thumbnails as files of type fileType
+func encodeThumbnails(scaled []scaledThumb, fileType uint8) (
+	files []thumbFile, err error,
+) {
+	files = make([]thumbFile, len(scaled))
+	for i, s := range scaled {
+		files[i] = thumbFile{
+			size:     s.size,
+			fileType: fileType,
+		}
+		files[i].data, err = encodeThumbnail(s.img, fileType)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Encode a thumbnail as a JPEG, WebP or AVIF file
+func encodeThumbnail(img image.Image, fileType uint8) (buf []byte, err error) {
+	switch fileType {
+	case common.JPEG:
+		w := bytes.NewBuffer(largeBufPool.Get().([]byte))
+		err = jpeg.Encode(w, img, &jpeg.Options{
+			Quality: 90,
+		})
+		buf = w.Bytes()
+	case common.AVIF:
+		buf, err = encodeAVIF(img)
+	default:
+		buf, err = EncodeWebP(img, 90)
+	}
+	return
+}
+
+// Encode an image as a still AVIF with ffmpeg
+func encodeAVIF(img image.Image) (buf []byte, err error) {
+	out, err := os.CreateTemp(jobDir, "thumb-*.avif")
+	if err != nil {
+		return
+	}
+	out.Close()
+	defer os.Remove(out.Name())
+
+	// ffmpeg expects straight alpha
+	b := img.Bounds()
+	nrgba := image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(nrgba, nrgba.Bounds(), img, b.Min, draw.Src)
+
+	var stderr bytes.Buffer
+	cmd := exec.Command("ffmpeg",
+		"-nostdin", "-y", "-loglevel", "error",
+		"-f", "rawvideo", "-pix_fmt", "rgba",
+		"-s", fmt.Sprintf("%dx%d", b.Dx(), b.Dy()),
+		"-i", "-",
+		"-frames:v", "1",
+		"-c:v", "libaom-av1", "-still-picture", "1", "-crf", "30",
+		"-f", "avif", out.Name(),
+	)
+	cmd.Stdin = bytes.NewReader(nrgba.Pix)
+	cmd.Stderr = &stderr
+	err = cmd.Run()
+	if err != nil {
+		err = fmt.Errorf("ffmpeg: %s: %s", err, stderr.Bytes())
+		return
+	}
+	return os.ReadFile(out.Name())
+}
+
+// Returns the storage key of an encoded thumbnail of an image
+func thumbFileKey(SHA1 string, f thumbFile) string {
+	if f.size == thumbSize {
+		return assets.ThumbKey(f.fileType, SHA1)
+	}
+	return assets.ThumbVariantKey(f.fileType, SHA1, f.size)
+}
+
+// Write all but the standard thumbnail of a newly allocated image to storage.
+// Deletes all files of the image on failure.
+func writeThumbVariants(img common.ImageCommon, thumbs []thumbFile) (
+	err error,
+) {
+	for _, t := range thumbs {
+		if t.size == thumbSize && t.fileType == img.ThumbType {
+			continue // Written along with the source file
+		}
+		err = assets.Store.Put(thumbFileKey(img.SHA1, t),
+			bytes.NewReader(t.data))
+		if err != nil {
+			delErr := assets.Delete(img)
+			if delErr != nil {
+				log.Errorf("thumbnails: clean up %s: %s", img.SHA1, delErr)
+			}
+			return
+		}
+	}
+	return
+}
+
+// Returns the dimensions of the additional thumbnails by the size of their
+// bounding box
+func variantDims(scaled []scaledThumb) map[uint16][2]uint16 {
+	dims := make(map[uint16][2]uint16, len(scaled)-1)
+	for _, s := range scaled[1:] {
+		b := s.img.Bounds()
+		dims[s.size] = [2]uint16{uint16(b.Dx()), uint16(b.Dy())}
+	}
+	return dims
+}
+
+// Regenerate the additional thumbnails of an already stored file from its
+// source and record them. The standard thumbnail is kept as is.
+func regenerateThumbnails(ctx context.Context, j db.ThumbnailJob) (err error) {
+	img, err := db.GetImage(j.SHA1)
+	if err != nil {
+		return
+	}
+	f, size, done, err := openStoredFile(img.SHA1, img.FileType)
+	if err != nil {
+		return
+	}
+	defer done()
+	_, err = f.Seek(0, 0)
+	if err != nil {
+		return
+	}
+
+	opts := thumbnailer.Options{
+		MaxSourceDims: thumbnailer.Dims{
+			Width:  uint(config.Get().MaxWidth),
+			Height: uint(config.Get().MaxHeight),
+		},
+		ThumbDims:         maxThumbDims(),
+		AcceptedMimeTypes: allowedMimeTypes,
+	}
+	_, thumb, err := thumbnailer.Process(f, opts)
+	switch err {
+	case nil:
+	case thumbnailer.ErrCantThumbnail:
+		// Same fallbacks as for uploads
+		err = nil
+		switch {
+		case img.Peaks != nil:
+			var bg color.Color = color.Transparent
+			if img.ThumbType == common.JPEG {
+				bg = color.White
+			}
+			thumb = renderWaveform(img.Peaks, int(opts.ThumbDims.Width),
+				int(opts.ThumbDims.Height)/2, bg)
+		case isIndexable(img.FileType):
+			var entries []common.ArchiveEntry
+			entries, err = db.GetArchiveEntries(img.SHA1)
+			if err != nil {
+				return
+			}
+			thumb, err = thumbnailFirstPage(f, size, img.FileType, entries,
+				opts)
+			if err != nil {
+				return
+			}
+		}
+	default:
+		return
+	}
+	if thumb == nil {
+		return fmt.Errorf("thumbnails: %s: source can not be thumbnailed",
+			img.SHA1)
+	}
+	if err = ctx.Err(); err != nil {
+		return
+	}
+
+	old := assets.ThumbVariantKeys(img)
+	avif := config.Server.Thumbnails.AVIF
+	scaled := scaleThumbnails(thumb, thumbnailSizes())
+	files, err := encodeThumbnails(scaled[1:], img.ThumbType)
+	if err != nil {
+		return
+	}
+	if avif {
+		var avifFiles []thumbFile
+		avifFiles, err = encodeThumbnails(scaled, common.AVIF)
+		if err != nil {
+			return
+		}
+		files = append(files, avifFiles...)
+	}
+	written := make(map[string]bool, len(files))
+	for _, f := range files {
+		key := thumbFileKey(img.SHA1, f)
+		err = assets.Store.Put(key, bytes.NewReader(f.data))
+		returnLargeBuf(f.data)
+		if err != nil {
+			return
+		}
+		written[key] = true
+	}
+
+	err = db.SetImageThumbs(img.SHA1, variantDims(scaled), avif)
+	if err != nil {
+		return
+	}
+
+	// Remove thumbnails of sizes or formats no longer configured
+	for _, key := range old {
+		if !written[key] {
+			err = assets.Store.Delete(key)
+			if err != nil {
+				log.Errorf("thumbnails: %s: %s", key, err)
+				err = nil
+			}
+		}
+	}
+	return
+}
+
+// BackfillThumbnails queues regeneration of the additional thumbnails of all
+// images lacking them or, if all is true, of all images. Returns the number of
+// queued jobs.
+func BackfillThumbnails(all bool) (n int64, err error) {
+	n, err = db.InsertThumbnailBackfillJobs(all, largeJobSize)
+	if err != nil || n == 0 {
+		return
+	}
+	wakeWorker(false)
+	wakeWorker(true)
+	return
+}
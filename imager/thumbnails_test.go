@@ -0,0 +1,124 @@
+package imager
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/bakape/meguca/test"
+)
+
+func TestFitDims(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name                  string
+		w, h, max, stdW, stdH int
+	}{
+		{"smaller", 100, 50, 150, 100, 50},
+		{"equal", 150, 150, 150, 150, 150},
+		{"wide", 1200, 900, 150, 150, 113},
+		{"tall", 900, 1200, 300, 225, 300},
+		{"extreme", 10000, 10, 150, 150, 1},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			w, h := fitDims(c.w, c.h, c.max)
+			test.AssertEquals(t, [2]int{w, h}, [2]int{c.stdW, c.stdH})
+		})
+	}
+}
+
+func TestResizeImage(t *testing.T) {
+	t.Parallel()
+
+	// Vertical black and white stripes
+	src := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 4; x++ {
+			c := color.RGBA{0, 0, 0, 255}
+			if x%2 == 0 {
+				c = color.RGBA{255, 255, 255, 255}
+			}
+			src.SetRGBA(x, y, c)
+		}
+	}
+
+	dst := resizeImage(src, 2, 1).(*image.RGBA)
+	test.AssertEquals(t, dst.Bounds(), image.Rect(0, 0, 2, 1))
+	for x := 0; x < 2; x++ {
+		test.AssertEquals(t, dst.RGBAAt(x, 0), color.RGBA{128, 128, 128, 255})
+	}
+
+	// Images of the requested size are not copied
+	test.AssertEquals(t, resizeImage(src, 4, 2) == image.Image(src), true)
+}
+
+func TestScaleThumbnails(t *testing.T) {
+	t.Parallel()
+
+	sizes := []uint16{300, 600}
+	cases := [...]struct {
+		name     string
+		w, h     int
+		stdSizes []uint16
+		stdDims  map[uint16][2]uint16
+	}{
+		{
+			name:     "large",
+			w:        600,
+			h:        450,
+			stdSizes: []uint16{150, 300, 600},
+			stdDims: map[uint16][2]uint16{
+				300: {300, 225},
+				600: {600, 450},
+			},
+		},
+		{
+			name:     "medium",
+			w:        400,
+			h:        200,
+			stdSizes: []uint16{150, 300, 600},
+			stdDims: map[uint16][2]uint16{
+				300: {300, 150},
+				600: {400, 200},
+			},
+		},
+		{
+			name:     "stops at source size",
+			w:        200,
+			h:        100,
+			stdSizes: []uint16{150, 300},
+			stdDims: map[uint16][2]uint16{
+				300: {200, 100},
+			},
+		},
+		{
+			name:     "small",
+			w:        100,
+			h:        100,
+			stdSizes: []uint16{150},
+			stdDims:  map[uint16][2]uint16{},
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			scaled := scaleThumbnails(image.NewRGBA(image.Rect(0, 0, c.w, c.h)),
+				sizes)
+			res := make([]uint16, len(scaled))
+			for i, s := range scaled {
+				res[i] = s.size
+			}
+			test.AssertEquals(t, res, c.stdSizes)
+			test.AssertEquals(t, variantDims(scaled), c.stdDims)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package imager
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 	"net/url"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -244,12 +246,12 @@ func GetTikTokMetadata(input string) (tokData *TWMTikTokData, err error) {
 	return
 }
 
-func runYtDlp(tokID, tempFilename string) (int64, error) {
+func runYtDlp(ctx context.Context, tokID, tempFilename string) (int64, error) {
 	// Format the target URL using the token data
 	url := fmt.Sprintf("https://www.tiktok.com/@/video/%s", tokID)
 
 	// Run yt-dlp and capture combined stdout/stderr
-	cmd := exec.Command("yt-dlp", url, "-o", tempFilename)
+	cmd := exec.CommandContext(ctx, "yt-dlp", url, "-o", tempFilename)
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		return 0, fmt.Errorf("yt-dlp error: %w, output: %s", err, output)
@@ -264,51 +266,34 @@ func runYtDlp(tokID, tempFilename string) (int64, error) {
 	// Return the size in bytes
 	return info.Size(), nil
 }
-func DownloadTikTok(input *common.PostCommand) (token string, filename string, err error) {
-	twmMutex.Lock()
-	twmRequestChannel <- input
-	tokData := <-twmResponseChannel
-	err = <-twmErrChannel
-	twmMutex.Unlock()
-	if err != nil || tokData == nil {
+
+// Download a TikTok video by its metadata retrieved from TikWM
+func fetchTikTok(ctx context.Context, u *url.URL, dir string, maxSize int64,
+) (f fetchedFile, err error) {
+	tokData, err := GetTikTokMetadata(u.String())
+	if err != nil {
 		return
 	}
-	if tokData.Images != nil {
-		err = errors.New("Image URL Unsupported")
+	if tokData == nil {
+		err = errNothingFetched
 		return
 	}
-	tmpFilename := fmt.Sprintf("tmp/%s.mp4", tokData.ID)
-	//var size int64
-	//urls := []*string{&tokData.HDPlay, &tokData.Play, &tokData.Wmplay}
-	//for _, url := range urls {
-	//	size, _, err = downloadFileWithSizeCheck(url, tmpFilename, 104857600)
-	//	if err == nil {
-	//		break
-	//	} else {
-	//		log.Error(err)
-	//	}
-	//}
-	size, err := runYtDlp(tokData.ID, tmpFilename)
-	if err != nil {
-		defer os.Remove(tmpFilename)
+	if tokData.Images != nil {
+		err = errors.New("Image URL Unsupported")
 		return
 	}
-	tmpFile, err := os.Open(tmpFilename)
-	defer tmpFile.Close()
-	log.Info("Rotation: ", input.Rotation)
-	if input.Rotation > 0 {
-		rotateVideoFile(tmpFilename, input.Rotation)
-	}
+	f.path = filepath.Join(dir, tokData.ID+".mp4")
+	size, err := runYtDlp(ctx, tokData.ID, f.path)
 	if err != nil {
 		return
 	}
-	filename = GetTikTokFilename(tokData.ID, strings.Trim(tokData.Title, " "))
-	res := <-requestThumbnailing(tmpFile, filename, int(size), &tokData.Author.UniqueID)
-	if res.err != nil {
-		err = res.err
+	if size > maxSize {
+		err = errTooLarge
 		return
 	}
-	return res.imageID, filename, nil
+	f.name = GetTikTokFilename(tokData.ID, strings.Trim(tokData.Title, " "))
+	f.author = &tokData.Author.UniqueID
+	return
 }
 
 func init() {
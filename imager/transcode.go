@@ -0,0 +1,125 @@
+package imager
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/imager/assets"
+)
+
+var (
+	// Video codecs playable in all major browsers
+	playableVideoCodecs = map[string]bool{
+		"h264": true,
+		"vp8":  true,
+		"vp9":  true,
+		"av1":  true,
+	}
+
+	// Audio codecs playable in all major browsers
+	playableAudioCodecs = map[string]bool{
+		"aac":    true,
+		"mp3":    true,
+		"opus":   true,
+		"vorbis": true,
+		"flac":   true,
+	}
+)
+
+// Returns the file type of renditions produced by the configured transcoding
+// format
+func renditionType() uint8 {
+	if config.Server.Transcoding.Format == "webm" {
+		return common.WEBM
+	}
+	return common.MP4
+}
+
+// Returns, if transcoding is enabled and the processed file has a video or
+// audio stream most browsers can not play
+func needsTranscoding(f io.ReadSeeker, img common.ImageCommon) (
+	bool, error,
+) {
+	if !config.Server.Transcoding.Enabled || !img.Video {
+		return false, nil
+	}
+	switch img.FileType {
+	case common.MP4, common.WEBM:
+	default:
+		return false, nil
+	}
+
+	if !playableVideoCodecs[img.Codec] {
+		return true, nil
+	}
+	if !img.Audio {
+		return false, nil
+	}
+	_, err := f.Seek(0, 0)
+	if err != nil {
+		return false, err
+	}
+	codec, err := getAudioCodec(f)
+	if err != nil {
+		return false, err
+	}
+	return !playableAudioCodecs[codec], nil
+}
+
+// Transcode the spooled source file of a job into a browser-playable
+// rendition and record it
+func transcode(ctx context.Context, j db.ThumbnailJob) (err error) {
+	typ := renditionType()
+	out, err := os.CreateTemp(jobDir, "rendition-*."+common.Extensions[typ])
+	if err != nil {
+		return
+	}
+	defer os.Remove(out.Name())
+	defer out.Close()
+
+	args := []string{
+		"-nostdin", "-y", "-loglevel", "error",
+		"-i", j.Path,
+		// Never carry over identifying metadata of the source
+		"-map_metadata", "-1",
+	}
+	if typ == common.WEBM {
+		args = append(args,
+			"-c:v", "libvpx-vp9", "-crf", "32", "-b:v", "0",
+			"-row-mt", "1", "-deadline", "good", "-cpu-used", "4",
+			"-c:a", "libopus", "-b:a", "128k",
+		)
+	} else {
+		args = append(args,
+			"-c:v", "libx264", "-preset", "veryfast", "-crf", "23",
+			"-pix_fmt", "yuv420p",
+			"-c:a", "aac", "-b:a", "128k",
+			"-movflags", "+faststart",
+		)
+	}
+	args = append(args, out.Name())
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
+	cmd.Stderr = &stderr
+	err = cmd.Run()
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return fmt.Errorf("ffmpeg: %s: %s", err, stderr.Bytes())
+	}
+
+	err = assets.Store.Put(assets.RenditionKey(typ, j.SHA1), out)
+	if err != nil {
+		return
+	}
+	return db.SetImageRendition(j.SHA1, typ)
+}
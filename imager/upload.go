@@ -5,17 +5,21 @@ import (
 	"bytes"
 	"context"
 	"crypto/md5"
+	"crypto/sha1"
 	"database/sql"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"gopkg.in/vansante/go-ffprobe.v2"
 	"image"
-	"image/jpeg"
+	"image/color"
 	"io"
 	"io/ioutil"
 	"mime/multipart"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -25,6 +29,7 @@ import (
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/imager/metadata"
 	"github.com/bakape/meguca/util"
 	"github.com/bakape/thumbnailer/v2"
 	"github.com/go-playground/log"
@@ -160,9 +165,9 @@ func validateUploader(
 
 // UploadImageHash attempts to skip image upload, if the file has already
 // been thumbnailed and is stored on the server. The client sends an SHA1 hash
-// of the file it wants to upload. The server looks up, if such a file is
-// thumbnailed. If yes, generates and sends a new image allocation token to
-// the client.
+// of the file it wants to upload. The server looks up, if such a file or a
+// copy of it with stripped metadata is thumbnailed. If yes, generates and
+// sends a new image allocation token to the client.
 func UploadImageHash(w http.ResponseWriter, r *http.Request) {
 	token, err := func() (token string, err error) {
 		bypass, err := validateUploader(w, r)
@@ -175,14 +180,15 @@ func UploadImageHash(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		sha1 := string(buf)
+		strip := shouldStripMetadata(r.URL.Query().Get("board"))
 
 		err = db.InTransaction(false, func(tx *sql.Tx) (err error) {
-			exists, err := db.ImageExists(tx, sha1)
+			stored, err := db.FindImage(tx, sha1, strip)
 			if err != nil {
 				return
 			}
-			if exists {
-				token, err = db.NewImageToken(tx, sha1)
+			if stored != "" {
+				token, err = db.NewImageToken(tx, stored)
 			}
 			return
 		})
@@ -304,29 +310,46 @@ func ParseUpload(req *http.Request) (string, error) {
 		return "", common.StatusError{errTooLarge, 413}
 	}
 
-	res := <-requestThumbnailing(file, head.Filename, int(head.Size), nil)
+	strip := shouldStripMetadata(req.FormValue("board"))
+	res := <-requestThumbnailing(req.Context(), file, head.Filename, int(head.Size), nil, strip)
 	return res.imageID, res.err
 }
 
+// Returns, if identifying metadata should be stripped from files uploaded to
+// the board
+func shouldStripMetadata(board string) bool {
+	return config.Get().StripMetadata ||
+		config.GetBoardConfigs(board).StripMetadata
+}
+
 // Create a new thumbnail, commit its resources to the DB and filesystem, and
-// pass the image data to the client.
-func newThumbnail(f multipart.File, filename string, SHA1 string, tiktokName *string) (token string, err error) {
+// pass the image data to the client. Also returns the SHA1 hash of the stored
+// file and, if the file should be transcoded into a browser-playable
+// rendition.
+func newThumbnail(f multipart.File, filename string, SHA1 string, tiktokName *string, strip bool) (token, storedSHA1 string, transcode bool, err error) {
 	var img common.ImageCommon
 	img.SHA1 = SHA1
 
 	conf := config.Get()
-	thumb, err := processFile(f, filename, &img, tiktokName, thumbnailer.Options{
+	thumbs, stripped, entries, err := processFile(f, filename, &img, tiktokName, strip, thumbnailer.Options{
 		MaxSourceDims: thumbnailer.Dims{
 			Width:  uint(conf.MaxWidth),
 			Height: uint(conf.MaxHeight),
 		},
-		ThumbDims: thumbnailer.Dims{
-			Width:  150,
-			Height: 150,
-		},
+		// Additional thumbnails are scaled down from the largest one
+		ThumbDims:         maxThumbDims(),
 		AcceptedMimeTypes: allowedMimeTypes,
 	})
-	defer returnLargeBuf(thumb)
+	defer func() {
+		for _, t := range thumbs {
+			returnLargeBuf(t.data)
+		}
+	}()
+	if stripped != nil {
+		defer os.Remove(stripped.Name())
+		defer stripped.Close()
+		f = stripped
+	}
 	if err != nil {
 		switch err.(type) {
 		case thumbnailer.ErrUnsupportedMIME, thumbnailer.ErrInvalidImage:
@@ -334,21 +357,58 @@ func newThumbnail(f multipart.File, filename string, SHA1 string, tiktokName *st
 		}
 		return
 	}
+	storedSHA1 = img.SHA1
 
 	// Being done in one transaction prevents the image DB record from getting
 	// garbage-collected between the calls
 	err = db.InTransaction(false, func(tx *sql.Tx) (err error) {
 		var thumbR io.ReadSeeker
-		if thumb != nil {
-			thumbR = bytes.NewReader(thumb)
+		if thumbs != nil {
+			thumbR = bytes.NewReader(thumbs[0].data)
+		}
+		// The stripped file might already be stored, for example by an
+		// earlier upload without stripping
+		exists, err := db.ImageExists(tx, img.SHA1)
+		switch {
+		case err != nil:
+			return
+		case exists:
+			if img.OriginalSHA1 != "" {
+				err = db.MarkImageStripped(tx, img.SHA1, img.OriginalSHA1)
+				if err != nil {
+					return
+				}
+			}
+			token, err = db.NewImageToken(tx, img.SHA1)
+			return
 		}
 		err = db.AllocateImage(tx, f, thumbR, img)
-		if err != nil && !db.IsConflictError(err) {
+		switch {
+		case err == nil:
+			err = writeThumbVariants(img, thumbs)
+			if err != nil {
+				return
+			}
+			err = db.WriteArchiveEntries(tx, img.SHA1, entries)
+			if err != nil {
+				return
+			}
+		case !db.IsConflictError(err):
 			return
 		}
 		token, err = db.NewImageToken(tx, img.SHA1)
 		return
 	})
+	if err != nil {
+		return
+	}
+
+	transcode, err = needsTranscoding(f, img)
+	if err != nil {
+		// Not critical for the upload itself
+		log.Errorf("transcoding: probe %s: %s", img.SHA1, err)
+		err = nil
+	}
 	return
 }
 
@@ -388,8 +448,10 @@ func getAudioCodec(file io.Reader) (string, error) {
 	return "", errors.New("no audio stream found")
 }
 
-// Separate function for easier testability
-func processFile(f multipart.File, filename string, img *common.ImageCommon, tiktokName *string, opts thumbnailer.Options) (thumb []byte, err error) {
+// Separate function for easier testability. If strip is true and supported
+// by the file type, returns a copy of the file without identifying metadata,
+// that the caller must close and remove.
+func processFile(f multipart.File, filename string, img *common.ImageCommon, tiktokName *string, strip bool, opts thumbnailer.Options) (thumbs []thumbFile, stripped *os.File, entries []common.ArchiveEntry, err error) {
 	jpegThumb := config.Get().JPEGThumbnails
 
 	resultCh := make(chan string, 1)
@@ -432,6 +494,15 @@ func processFile(f multipart.File, filename string, img *common.ImageCommon, tik
 
 	img.FileType = mimeTypes[src.Mime]
 
+	// Comic book archives not consisting purely of images are only identifiable
+	// by their extension
+	switch ext := strings.ToLower(filepath.Ext(filename)); {
+	case img.FileType == common.ZIP && ext == ".cbz":
+		img.FileType = common.CBZ
+	case img.FileType == common.RAR && ext == ".cbr":
+		img.FileType = common.CBR
+	}
+
 	img.Audio = src.HasAudio
 	img.Video = src.HasVideo
 	img.Length = uint32(src.Length / time.Second)
@@ -486,11 +557,80 @@ func processFile(f multipart.File, filename string, img *common.ImageCommon, tik
 		img.Artist = "@" + *tiktokName
 	}
 
+	// Audio-only files get a compact waveform for the client player and, if
+	// lacking cover art, a rendering of it as the thumbnail
+	if img.Audio && !img.Video {
+		img.Peaks, err = audioPeaks(f)
+		if err != nil {
+			// Not critical for the upload itself
+			log.Errorf("waveform: %s: %s", img.SHA1, err)
+			img.Peaks = nil
+			err = nil
+		}
+		if thumbImage == nil && img.Peaks != nil {
+			var bg color.Color = color.Transparent
+			img.ThumbType = common.WEBP
+			if jpegThumb {
+				bg = color.White
+				img.ThumbType = common.JPEG
+			}
+			thumbImage = renderWaveform(img.Peaks, int(opts.ThumbDims.Width),
+				int(opts.ThumbDims.Height)/2, bg)
+		}
+	}
+
+	// Index archive contents and thumbnail comic books lacking a thumbnail by
+	// their first page
+	if isIndexable(img.FileType) {
+		var size int64
+		size, err = f.Seek(0, io.SeekEnd)
+		if err != nil {
+			return
+		}
+		entries, err = indexArchive(f, size, img.FileType)
+		if err != nil {
+			// Malformed or oversized archives are still stored opaquely
+			log.Errorf("archive: %s: %s", img.SHA1, err)
+			entries = nil
+			err = nil
+		}
+		if thumbImage == nil && entries != nil {
+			thumbImage, err = thumbnailFirstPage(f, size, img.FileType,
+				entries, opts)
+			if err != nil {
+				log.Errorf("archive: thumbnail %s: %s", img.SHA1, err)
+				err = nil
+			}
+			if thumbImage != nil {
+				img.ThumbType = common.WEBP
+				if jpegThumb {
+					img.ThumbType = common.JPEG
+				}
+			}
+		}
+		_, err = f.Seek(0, 0)
+		if err != nil {
+			return
+		}
+	}
+
 	img.Dims = [4]uint16{uint16(src.Width), uint16(src.Height), 0, 0}
+	var scaled []scaledThumb
 	if thumbImage != nil {
-		b := thumbImage.Bounds()
+		scaled = scaleThumbnails(thumbImage, thumbnailSizes())
+		b := scaled[0].img.Bounds()
 		img.Dims[2] = uint16(b.Dx())
 		img.Dims[3] = uint16(b.Dy())
+		img.Thumbs = variantDims(scaled)
+		img.ThumbAVIF = config.Server.Thumbnails.AVIF
+	}
+
+	if strip && metadata.Supported(img.FileType) {
+		stripped, err = stripMetadata(f, img)
+		if err != nil {
+			return
+		}
+		f = stripped
 	}
 
 	img.MD5, img.Size, err = hashFile(f, md5.New(),
@@ -499,20 +639,48 @@ func processFile(f multipart.File, filename string, img *common.ImageCommon, tik
 		return
 	}
 
-	if thumbImage != nil {
-		if jpegThumb {
-			w := bytes.NewBuffer(largeBufPool.Get().([]byte))
-			err = jpeg.Encode(w, thumbImage, &jpeg.Options{
-				Quality: 90,
-			})
-			thumb = w.Bytes()
-		} else {
-			thumb, err = EncodeWebP(thumbImage, 90)
-		}
+	if scaled != nil {
+		thumbs, err = encodeThumbnails(scaled, img.ThumbType)
 		if err != nil {
 			return
 		}
+		if img.ThumbAVIF {
+			avif, avifErr := encodeThumbnails(scaled, common.AVIF)
+			if avifErr != nil {
+				// Not critical for the upload itself
+				log.Errorf("thumbnails: avif %s: %s", img.SHA1, avifErr)
+				img.ThumbAVIF = false
+			} else {
+				thumbs = append(thumbs, avif...)
+			}
+		}
+	}
+
+	return
+}
+
+// Write a copy of f without identifying metadata to a temporary file and
+// record the hashes of both the original and the stripped file
+func stripMetadata(f multipart.File, img *common.ImageCommon) (
+	stripped *os.File, err error,
+) {
+	stripped, err = os.CreateTemp(jobDir, "stripped-")
+	if err != nil {
+		return
 	}
+	defer func() {
+		if err != nil {
+			stripped.Close()
+			os.Remove(stripped.Name())
+			stripped = nil
+		}
+	}()
 
+	err = metadata.Strip(stripped, f, img.FileType)
+	if err != nil {
+		return
+	}
+	img.OriginalSHA1 = img.SHA1
+	img.SHA1, _, err = hashFile(stripped, sha1.New(), hex.EncodeToString)
 	return
 }
@@ -0,0 +1,162 @@
+package imager
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"io"
+	"os/exec"
+	"time"
+
+	"github.com/bakape/meguca/common"
+)
+
+const (
+	// Number of amplitude peaks stored for audio files
+	numPeaks = 100
+
+	// Sample rate audio is decoded at for waveform generation
+	waveformSampleRate = 8000
+
+	// Samples per window, the maximum amplitude is recorded for
+	waveformWindow = waveformSampleRate / 100
+
+	// Width of a single waveform bar and the gap after it in pixels
+	waveformBar, waveformGap = 2, 1
+)
+
+// Color of rendered waveform bars
+var waveformColor = color.NRGBA{R: 0x80, G: 0x80, B: 0x80, A: 0xff}
+
+// Decode the audio stream of f and return its peak amplitudes in numPeaks
+// evenly sized buckets, normalized to 0-255
+func audioPeaks(f io.ReadSeeker) (peaks common.Peaks, err error) {
+	_, err = f.Seek(0, 0)
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "ffmpeg",
+		"-loglevel", "error",
+		"-i", "pipe:0",
+		"-vn", "-ac", "1", "-ar", fmt.Sprint(waveformSampleRate),
+		"-f", "s16le", "pipe:1",
+	)
+	cmd.Stdin = f
+	cmd.Stderr = &stderr
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return
+	}
+	err = cmd.Start()
+	if err != nil {
+		return
+	}
+
+	var (
+		windows []uint16
+		max     uint16
+		n       int
+		sample  [2]byte
+		r       = bufio.NewReader(out)
+	)
+	for {
+		_, err = io.ReadFull(r, sample[:])
+		if err != nil {
+			break
+		}
+		s := int32(int16(binary.LittleEndian.Uint16(sample[:])))
+		if s < 0 {
+			s = -s
+		}
+		if uint16(s) > max {
+			max = uint16(s)
+		}
+		n++
+		if n == waveformWindow {
+			windows = append(windows, max)
+			max, n = 0, 0
+		}
+	}
+	if err != io.EOF && err != io.ErrUnexpectedEOF {
+		cmd.Wait()
+		return
+	}
+	if n != 0 {
+		windows = append(windows, max)
+	}
+
+	err = cmd.Wait()
+	if err != nil {
+		return nil, fmt.Errorf("ffmpeg: %s: %s", err, stderr.Bytes())
+	}
+	if len(windows) == 0 {
+		return
+	}
+	return downsamplePeaks(windows, numPeaks), nil
+}
+
+// Reduce window amplitudes to n buckets and normalize them to the loudest one
+func downsamplePeaks(windows []uint16, n int) common.Peaks {
+	var (
+		max    uint16
+		maxima = make([]uint16, n)
+	)
+	for i := range maxima {
+		start, end := i*len(windows)/n, (i+1)*len(windows)/n
+		if end == start {
+			end = start + 1
+		}
+		for _, w := range windows[start:end] {
+			if w > maxima[i] {
+				maxima[i] = w
+			}
+		}
+		if maxima[i] > max {
+			max = maxima[i]
+		}
+	}
+
+	peaks := make(common.Peaks, n)
+	if max == 0 {
+		return peaks
+	}
+	for i, m := range maxima {
+		peaks[i] = uint8(uint32(m) * 255 / uint32(max))
+	}
+	return peaks
+}
+
+// Render peaks as vertically centered bars on a background of color bg
+func renderWaveform(peaks common.Peaks, width, height int, bg color.Color,
+) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(bg), image.Point{},
+		draw.Src)
+
+	bars := width / (waveformBar + waveformGap)
+	if bars == 0 || len(peaks) == 0 {
+		return img
+	}
+	fg := image.NewUniform(waveformColor)
+	for i := 0; i < bars; i++ {
+		h := int(peaks[i*len(peaks)/bars]) * height / 255
+		if h == 0 {
+			h = 1
+		}
+		x := i * (waveformBar + waveformGap)
+		y := (height - h) / 2
+		draw.Draw(img, image.Rect(x, y, x+waveformBar, y+h), fg,
+			image.Point{}, draw.Src)
+	}
+	return img
+}
@@ -0,0 +1,64 @@
+package imager
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/test"
+)
+
+func TestDownsamplePeaks(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name    string
+		windows []uint16
+		n       int
+		peaks   common.Peaks
+	}{
+		{
+			name:    "more windows than peaks",
+			windows: []uint16{1, 4, 2, 8, 0, 0},
+			n:       3,
+			peaks:   common.Peaks{127, 255, 0},
+		},
+		{
+			name:    "fewer windows than peaks",
+			windows: []uint16{2, 4},
+			n:       4,
+			peaks:   common.Peaks{127, 127, 255, 255},
+		},
+		{
+			name:    "silence",
+			windows: []uint16{0, 0},
+			n:       2,
+			peaks:   common.Peaks{0, 0},
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			test.AssertEquals(t, downsamplePeaks(c.windows, c.n), c.peaks)
+		})
+	}
+}
+
+func TestRenderWaveform(t *testing.T) {
+	t.Parallel()
+
+	img := renderWaveform(common.Peaks{255, 0}, 6, 10, color.Transparent)
+	test.AssertEquals(t, img.Bounds(), image.Rect(0, 0, 6, 10))
+
+	// Full height bar
+	test.AssertEquals(t, img.NRGBAAt(0, 0), waveformColor)
+	test.AssertEquals(t, img.NRGBAAt(1, 9), waveformColor)
+	// Gap
+	test.AssertEquals(t, img.NRGBAAt(2, 5), color.NRGBA{})
+	// Minimal height bar for silence
+	test.AssertEquals(t, img.NRGBAAt(3, 4), waveformColor)
+	test.AssertEquals(t, img.NRGBAAt(3, 5), color.NRGBA{})
+}
@@ -45,14 +45,18 @@ func ParseBody(body []byte, board string, thread uint64, id uint64, ip string, i
 
 	start := 0
 	lineStart := 0
-	pyu := config.GetBoardConfigs(board).Pyu
+	conf := config.GetBoardConfigs(board).BoardConfigs
+	ctx := common.CommandContext{
+		Board:   board,
+		Thread:  thread,
+		ID:      id,
+		IP:      ip,
+		Body:    body,
+		Applied: make(map[common.CommandType]bool),
+	}
 
 	// Prevent link duplication
 	haveLink := make(map[uint64]bool)
-	// Prevent #pyu duplication
-	isSlut := false
-	// Prevent #autobahn duplication
-	isDead := false
 
 	for i, b := range body {
 		switch b {
@@ -97,21 +101,21 @@ func ParseBody(body []byte, board string, thread uint64, id uint64, ip string, i
 				}
 			}
 		case '#':
-			// Ignore hash commands in quotes, or #pyu/#pcount if board option disabled
-			if body[lineStart] == '>' ||
-				(len(word) > 1 && word[1] == 'p' && !pyu) {
+			// Ignore hash commands in quotes
+			if body[lineStart] == '>' {
 				goto next
 			}
-			m := common.CommandRegexp.FindSubmatch(word)
-			if m == nil {
+			h, m := common.MatchCommand(string(word))
+			if h == nil || !commandEnabled(h, conf) {
 				goto next
 			}
 			var c common.Command
-			c, err = parseCommand(m[1], board, thread, id, ip, &isSlut, &isDead)
-			switch err {
+			ctx.End = i
+			c, err = h.Parse(&ctx, m)
+			switch err.(type) {
 			case nil:
 				com = append(com, c)
-			case errTooManyRolls, errDieTooBig:
+			case common.InvalidCommand:
 				// Consider command invalid
 				err = nil
 			default:
@@ -32,6 +32,82 @@ func TestParseLine(t *testing.T) {
 	}
 }
 
+func TestDisabledCommand(t *testing.T) {
+	config.SetBoardConfigs(config.BoardConfigs{
+		ID: "a",
+		BoardPublic: config.BoardPublic{
+			DisabledCommands: []string{"flip"},
+		},
+	})
+	defer config.SetBoardConfigs(config.BoardConfigs{
+		ID: "a",
+	})
+
+	_, com, _, _, _, err := ParseBody([]byte("#flip #d6"), "a", 1, 1, "::1",
+		false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := len(com); l != 1 {
+		t.Fatalf("unexpected command count: %d", l)
+	}
+	if com[0].Type != common.Dice {
+		t.Fatalf("unexpected command type: %d", com[0].Type)
+	}
+}
+
+func TestParsePoll(t *testing.T) {
+	test_db.ClearTables(t, "boards")
+	writeSampleBoard(t)
+	writeSampleThread(t)
+
+	cases := [...]struct {
+		name, in string
+		options  []string
+	}{
+		{"valid", "#poll\nyes\n no \n\nignored", []string{"yes", "no"}},
+		{"with duration", "which? #poll60\nyes\nno", []string{"yes", "no"}},
+		{"too few options", "#poll\nyes", nil},
+		{"invalid duration", "#poll0\nyes\nno", nil},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			_, com, _, _, _, err := ParseBody([]byte(c.in), "a", 1, 1, "::1",
+				false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.options == nil {
+				if len(com) != 0 {
+					t.Fatalf("unexpected commands: %v", com)
+				}
+				return
+			}
+			if len(com) != 1 || com[0].Poll == nil {
+				t.Fatalf("no poll parsed: %v", com)
+			}
+			AssertEquals(t, com[0].Poll.Options, c.options)
+			AssertEquals(t, com[0].Poll.Votes, make([]uint64, len(c.options)))
+			if com[0].Poll.Closed() {
+				t.Fatal("poll closed on creation")
+			}
+		})
+	}
+
+	t.Run("post not inserted yet", func(t *testing.T) {
+		_, com, _, _, _, err := ParseBody([]byte("#poll\nyes\nno"), "a", 0, 0,
+			"::1", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(com) != 1 || com[0].Poll == nil {
+			t.Fatalf("no poll parsed: %v", com)
+		}
+	})
+}
+
 func TestParseBody(t *testing.T) {
 	test_db.ClearTables(t, "boards")
 	writeSampleBoard(t)
@@ -1,263 +1,276 @@
-// Hash commands such as #flip, dice and #8ball
+// Hash commands such as #8ball, #pyu and #autobahn, that depend on server
+// state. Commands without such dependencies are defined in the common package.
 
 package parser
 
 import (
-	"bytes"
-	"crypto/rand"
 	"database/sql"
+	"encoding/json"
+	"html"
+	"io"
+	"strconv"
+	"time"
+
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
-	"github.com/go-playground/log"
-	"math/big"
-	"regexp"
-	"strconv"
-	"strings"
-	"time"
 )
 
-var (
-	syncWatchRegexp = regexp.MustCompile(`^sw(\d+:)?(\d+):(\d+)([+-]\d+)?$`)
+func init() {
+	for _, h := range [...]common.CommandHandler{
+		eightballCommand{},
+		pyuCommand{},
+		pcountCommand{},
+		autobahnCommand{},
+	} {
+		common.RegisterCommand(h)
+	}
+}
 
-	errTooManyRolls = common.ErrInvalidInput("too many rolls")
-	errDieTooBig    = common.ErrInvalidInput("die too big")
-)
+// Returns, if a hash command is enabled on the board
+func commandEnabled(h common.CommandHandler, conf config.BoardConfigs) bool {
+	switch h.Type() {
+	case common.Pyu, common.Pcount:
+		if !conf.Pyu {
+			return false
+		}
+	}
+	return conf.CommandEnabled(h.Name())
+}
+
+// Select random string from the the 8ball answer array
+type eightballCommand struct{}
 
-// Returns a cryptographically secure pseudorandom int in the interval [0;max)
-func randInt(max int) int {
-	i, _ := rand.Int(rand.Reader, big.NewInt(int64(max)))
-	if i == nil { // Fuck error reporting here
-		return 0
+func (eightballCommand) Type() common.CommandType {
+	return common.EightBall
+}
+
+func (eightballCommand) Name() string {
+	return "8ball"
+}
+
+func (eightballCommand) Pattern() string {
+	return "8ball"
+}
+
+func (eightballCommand) Parse(ctx *common.CommandContext, _ string) (
+	com common.Command, err error,
+) {
+	com.Type = common.EightBall
+	answers := config.GetBoardConfigs(ctx.Board).Eightball
+	if len(answers) != 0 {
+		com.Eightball = answers[common.RandInt(len(answers))]
 	}
-	return int(i.Int64())
+	return
+}
+
+func (eightballCommand) AppendJSON(b []byte, c common.Command) []byte {
+	return strconv.AppendQuote(b, c.Eightball)
+}
+
+func (eightballCommand) DecodeJSON(data []byte, c *common.Command) error {
+	return json.Unmarshal(data, &c.Eightball)
 }
 
-// Parse a matched hash command
-func parseCommand(
-	match []byte,
-	board string,
-	thread uint64,
-	id uint64,
-	ip string,
-	isSlut *bool,
-	isDead *bool,
-) (
+func (eightballCommand) RenderHTML(w io.Writer, match string,
+	c common.Command,
+) bool {
+	common.WriteCommand(w, "<strong>", match,
+		[]byte(html.EscapeString(c.Eightball)))
+	return true
+}
+
+// Shared methods of pyu counter commands
+type pyuCounter struct{}
+
+func (pyuCounter) AppendJSON(b []byte, c common.Command) []byte {
+	return strconv.AppendUint(b, c.Pyu, 10)
+}
+
+func (pyuCounter) DecodeJSON(data []byte, c *common.Command) error {
+	return json.Unmarshal(data, &c.Pyu)
+}
+
+func (pyuCounter) RenderHTML(w io.Writer, match string,
+	c common.Command,
+) bool {
+	common.WriteCommand(w, "<strong>", match,
+		strconv.AppendUint(nil, c.Pyu, 10))
+	return true
+}
+
+// Increment pyu counter
+type pyuCommand struct {
+	pyuCounter
+}
+
+func (pyuCommand) Type() common.CommandType {
+	return common.Pyu
+}
+
+func (pyuCommand) Name() string {
+	return "pyu"
+}
+
+func (pyuCommand) Pattern() string {
+	return "pyu"
+}
+
+func (pyuCommand) Parse(ctx *common.CommandContext, _ string) (
 	com common.Command, err error,
 ) {
-	boardConfig := config.GetBoardConfigs(board)
-	log.Info("match: ", string(match))
-
-	switch {
-
-	// Coin flip
-	case bytes.Equal(match, []byte("flip")):
-		com.Type = common.Flip
-		com.Flip = randInt(2) == 1
-
-	// 8ball; select random string from the the 8ball answer array
-	case bytes.Equal(match, []byte("8ball")):
-		com.Type = common.EightBall
-		answers := boardConfig.Eightball
-		if len(answers) != 0 {
-			com.Eightball = answers[randInt(len(answers))]
+	com.Type = common.Pyu
+	board, ip := ctx.Board, ctx.IP
+
+	if !config.GetBoardConfigs(board).Pyu {
+		com.Pyu, err = db.GetPcount(board)
+		return
+	}
+
+	err = db.InTransaction(false, func(tx *sql.Tx) (err error) {
+		exists, err := db.PyuLimitExists(tx, ip, board)
+
+		if err != nil {
+			return
 		}
 
-	// Increment pyu counter
-	case bytes.Equal(match, []byte("pyu")):
-		com.Type = common.Pyu
+		if !exists {
+			err = db.WritePyuLimit(tx, ip, board)
 
-		if boardConfig.Pyu {
-			err = db.InTransaction(false, func(tx *sql.Tx) (err error) {
-				exists, err := db.PyuLimitExists(tx, ip, board)
+			if err != nil {
+				return
+			}
+		}
 
-				if err != nil {
-					return
-				}
+		limit, err := db.GetPyuLimit(tx, ip, board)
 
-				if !exists {
-					err = db.WritePyuLimit(tx, ip, board)
+		if err != nil {
+			return
+		}
 
-					if err != nil {
-						return
-					}
-				}
+		restricted, err := db.GetPyuLimitRestricted(tx, ip, board)
+
+		if err != nil {
+			return
+		}
+
+		if restricted {
+			com.Pyu, err = db.GetPcountA(tx, board)
+
+			if err != nil {
+				return
+			}
+
+			if !ctx.Applied[common.Pyu] {
+				ctx.Applied[common.Pyu] = true
+				err = db.Ban(
+					tx, board, "stop being such a slut", "system",
+					time.Hour, ctx.ID, common.BanPost, common.BanAddress,
+				)
+			}
 
-				limit, err := db.GetPyuLimit(tx, ip, board)
+			if err != nil {
+				return
+			}
+		} else {
+			switch limit {
+			case 1:
+				err = db.SetPyuLimitRestricted(tx, ip, board)
 
 				if err != nil {
 					return
 				}
 
-				restricted, err := db.GetPyuLimitRestricted(tx, ip, board)
+				fallthrough
+			default:
+				com.Pyu, err = db.IncrementPcount(tx, board)
 
 				if err != nil {
 					return
 				}
 
-				if restricted {
-					com.Pyu, err = db.GetPcountA(tx, board)
-
-					if err != nil {
-						return
-					}
-
-					if !*isSlut {
-						*isSlut = true
-						err = db.Ban(
-							tx, board, "stop being such a slut", "system",
-							time.Hour, id, common.BanPost,
-						)
-					}
-
-					if err != nil {
-						return
-					}
-				} else {
-					switch limit {
-					case 1:
-						err = db.SetPyuLimitRestricted(tx, ip, board)
-
-						if err != nil {
-							return
-						}
-
-						fallthrough
-					default:
-						com.Pyu, err = db.IncrementPcount(tx, board)
-
-						if err != nil {
-							return
-						}
-
-						err = db.DecrementPyuLimit(tx, ip, board)
-
-						if err != nil {
-							return
-						}
-					}
-				}
+				err = db.DecrementPyuLimit(tx, ip, board)
 
-				return
-			})
-		} else {
-			com.Pyu, err = db.GetPcount(board)
+				if err != nil {
+					return
+				}
+			}
 		}
 
-	// Return current pyu count
-	case bytes.Equal(match, []byte("pcount")):
-		com.Type = common.Pcount
-		com.Pyu, err = db.GetPcount(board)
+		return
+	})
+	return
+}
 
-	// Autobahn
-	case bytes.Equal(match, []byte("autobahn")):
-		com.Type = common.Autobahn
-		if !*isDead {
-			*isDead = true
-			err = db.InTransaction(false, func(tx *sql.Tx) (err error) {
-				return db.Ban(
-					tx, board, "brum brum", "system", time.Hour,
-					id, common.BanPost,
-				)
-			})
-		}
+// Return current pyu count
+type pcountCommand struct {
+	pyuCounter
+}
 
-	default:
-		matchStr := string(match)
+func (pcountCommand) Type() common.CommandType {
+	return common.Pcount
+}
 
-		// Synchronized time counter
-		if strings.HasPrefix(matchStr, "sw") {
-			com.Type = common.SyncWatch
-			com.SyncWatch = parseSyncWatch(matchStr)
-			return
-		}
+func (pcountCommand) Name() string {
+	return "pcount"
+}
 
-		// Dice throw
-		com.Type = common.Dice
-		com.Dice, err = parseDice(matchStr)
-	}
+func (pcountCommand) Pattern() string {
+	return "pcount"
+}
 
+func (pcountCommand) Parse(ctx *common.CommandContext, _ string) (
+	com common.Command, err error,
+) {
+	com.Type = common.Pcount
+	com.Pyu, err = db.GetPcount(ctx.Board)
 	return
 }
 
-func isNumError(err error) bool {
-	_, ok := err.(*strconv.NumError)
-	return ok
+// Self ban. brum brum
+type autobahnCommand struct{}
+
+func (autobahnCommand) Type() common.CommandType {
+	return common.Autobahn
 }
 
-// Parse dice throw commands
-func parseDice(match string) (val []uint16, err error) {
-	dice := common.DiceRegexp.FindStringSubmatch(match)
-
-	var rolls int
-	if len(dice[1]) == 0 {
-		rolls = 1
-	} else {
-		rolls, err = strconv.Atoi(string(dice[1]))
-		switch {
-		case err != nil:
-			if isNumError(err) {
-				err = common.StatusError{err, 400}
-			}
-			return
-		case rolls > 10:
-			return nil, errTooManyRolls
-		}
-	}
+func (autobahnCommand) Name() string {
+	return "autobahn"
+}
 
-	max, err := strconv.Atoi(string(dice[2]))
-	switch {
-	case err != nil:
-		if isNumError(err) {
-			err = common.StatusError{err, 400}
-		}
-		return
-	case max > common.MaxDiceSides:
-		return nil, errDieTooBig
-	}
+func (autobahnCommand) Pattern() string {
+	return "autobahn"
+}
 
-	val = make([]uint16, rolls)
-	for i := 0; i < rolls; i++ {
-		if max != 0 {
-			val[i] = uint16(randInt(max)) + 1
-		} else {
-			val[i] = 0
-		}
+func (autobahnCommand) Parse(ctx *common.CommandContext, _ string) (
+	com common.Command, err error,
+) {
+	com.Type = common.Autobahn
+	if !ctx.Applied[common.Autobahn] {
+		ctx.Applied[common.Autobahn] = true
+		err = db.InTransaction(false, func(tx *sql.Tx) (err error) {
+			return db.Ban(
+				tx, ctx.Board, "brum brum", "system", time.Hour,
+				ctx.ID, common.BanPost, common.BanAddress,
+			)
+		})
 	}
 	return
 }
 
-func parseSyncWatch(match string) [5]uint64 {
-	m := syncWatchRegexp.FindStringSubmatch(match)
-	var (
-		hours, min, sec, offset uint64
-		offsetDirection         byte
-	)
+func (autobahnCommand) AppendJSON(b []byte, c common.Command) []byte {
+	return strconv.AppendUint(b, c.Pyu, 10)
+}
 
-	if m[1] != "" {
-		hours, _ = strconv.ParseUint(m[1][:len(m[1])-1], 10, 64)
-	}
-	min, _ = strconv.ParseUint(m[2], 10, 64)
-	sec, _ = strconv.ParseUint(m[3], 10, 64)
-	if m[4] != "" {
-		offsetDirection = m[4][0]
-		offset, _ = strconv.ParseUint(m[4][1:], 10, 64)
-	}
+func (autobahnCommand) DecodeJSON(_ []byte, _ *common.Command) error {
+	return nil
+}
 
-	start := uint64(time.Now().Unix())
-	switch offsetDirection {
-	case '+':
-		start += offset
-	case '-':
-		start -= offset
-	}
-	end := start + sec + (hours*60+min)*60
-
-	return [5]uint64{
-		hours,
-		min,
-		sec,
-		start,
-		end,
-	}
+func (autobahnCommand) RenderHTML(w io.Writer, match string,
+	_ common.Command,
+) bool {
+	io.WriteString(w, `<strong class="dead">#`)
+	io.WriteString(w, match)
+	io.WriteString(w, `</strong>`)
+	return true
 }
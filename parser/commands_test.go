@@ -2,6 +2,7 @@ package parser
 
 import (
 	"database/sql"
+	"fmt"
 	"testing"
 
 	"github.com/bakape/meguca/common"
@@ -11,12 +12,32 @@ import (
 	"github.com/bakape/meguca/test/test_db"
 )
 
+func newCommandContext() *common.CommandContext {
+	return &common.CommandContext{
+		Board:   "a",
+		Thread:  1,
+		ID:      1,
+		IP:      "::1",
+		Applied: make(map[common.CommandType]bool),
+	}
+}
+
+// Parse a hash command without the leading '#'
+func parseCommand(ctx *common.CommandContext, match string) (
+	common.Command, error,
+) {
+	h, m := common.MatchCommand("#" + match)
+	if h == nil {
+		return common.Command{}, fmt.Errorf("not a hash command: %s", match)
+	}
+	return h.Parse(ctx, m)
+}
+
 func TestFlip(t *testing.T) {
-	var isSlut bool
-	var isDead bool
 	t.Parallel()
+	ctx := newCommandContext()
 
-	com, err := parseCommand([]byte("flip"), "a", 1, 1, "::1", &isSlut, &isDead)
+	com, err := parseCommand(ctx, "flip")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -26,24 +47,23 @@ func TestFlip(t *testing.T) {
 }
 
 func TestDice(t *testing.T) {
-	var isSlut bool
-	var isDead bool
 	t.Parallel()
+	ctx := newCommandContext()
 
 	cases := [...]struct {
 		name, in   string
 		err        error
 		rolls, max int
 	}{
-		{"too many sides", `d10001`, errDieTooBig, 0, 0},
-		{"too many dice", `11d100`, errTooManyRolls, 0, 0},
+		{"too many sides", `d10001`, common.ErrDieTooBig, 0, 0},
+		{"too many dice", `11d100`, common.ErrTooManyRolls, 0, 0},
 		{"valid single die", `d10`, nil, 1, 10},
 		{"valid multiple dice", `10d100`, nil, 10, 100},
 	}
 	for i := range cases {
 		c := cases[i]
 		t.Run(c.name, func(t *testing.T) {
-			com, err := parseCommand([]byte(c.in), "a", 1, 1, "::1", &isSlut, &isDead)
+			com, err := parseCommand(ctx, c.in)
 			if err != c.err {
 				t.Fatalf("unexpected error: %s : %s", c.err, err)
 			} else {
@@ -58,16 +78,114 @@ func TestDice(t *testing.T) {
 	}
 }
 
+func TestDiceNotation(t *testing.T) {
+	t.Parallel()
+	ctx := newCommandContext()
+
+	cases := [...]struct {
+		name, in string
+		err      error
+		terms    int
+		min, max int64
+	}{
+		{"modifier", `2d6+3`, nil, 2, 5, 15},
+		{"negative modifier", `d20-2`, nil, 2, -1, 18},
+		{"keep highest", `4d6kh3`, nil, 1, 3, 18},
+		{"keep highest shorthand", `4d6k3`, nil, 1, 3, 18},
+		{"drop lowest", `4d6dl1`, nil, 1, 3, 18},
+		{"drop shorthand", `4d6d1`, nil, 1, 3, 18},
+		{"fudge", `4dF`, nil, 1, -4, 4},
+		{"multiple terms", `d20+d4-1`, nil, 3, 1, 23},
+		{"too many dice in term", `11d6+1`, common.ErrTooManyRolls, 0, 0, 0},
+		{"die too big", `2d10001+1`, common.ErrDieTooBig, 0, 0, 0},
+		{"keep too many", `2d6kh3`, common.ErrInvalidDice, 0, 0, 0},
+		{"exploding fudge", `2dFe`, common.ErrInvalidDice, 0, 0, 0},
+	}
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			com, err := parseCommand(ctx, c.in)
+			if err != c.err {
+				t.Fatalf("unexpected error: %v : %v", c.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if com.Type != common.Dice {
+				t.Fatalf("unexpected command type: %d", com.Type)
+			}
+			r := com.Roll
+			if r == nil {
+				t.Fatal("no dice roll")
+			}
+			if r.Expr != c.in {
+				LogUnexpected(t, c.in, r.Expr)
+			}
+			if l := len(r.Terms); l != c.terms {
+				LogUnexpected(t, c.terms, l)
+			}
+			if r.Total < c.min || r.Total > c.max {
+				t.Fatalf("total out of range: %d", r.Total)
+			}
+		})
+	}
+}
+
+func TestDropShorthand(t *testing.T) {
+	t.Parallel()
+
+	com, err := parseCommand(newCommandContext(), `4d6d1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	term := com.Roll.Terms[0]
+	AssertEquals(t, term.Keep, "dl")
+
+	// The lowest die is dropped
+	var dropped []int16
+	min := term.Dice[0].Val
+	for _, d := range term.Dice {
+		if d.Dropped {
+			dropped = append(dropped, d.Val)
+		}
+		if d.Val < min {
+			min = d.Val
+		}
+	}
+	AssertEquals(t, dropped, []int16{min})
+}
+
+func TestExplodingDice(t *testing.T) {
+	t.Parallel()
+
+	com, err := parseCommand(newCommandContext(), `10d2e`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dice := com.Roll.Terms[0].Dice
+	if len(dice) < 10 || len(dice) > 20 {
+		t.Fatalf("unexpected number of dice: %d", len(dice))
+	}
+	exploded := 0
+	for _, d := range dice {
+		if d.Exploded {
+			exploded++
+		}
+	}
+	if exploded != len(dice)-10 {
+		LogUnexpected(t, len(dice)-10, exploded)
+	}
+}
+
 func Test8ball(t *testing.T) {
-	var isSlut bool
-	var isDead bool
+	ctx := newCommandContext()
 	answers := []string{"Yes", "No"}
 	config.SetBoardConfigs(config.BoardConfigs{
 		ID:        "a",
 		Eightball: answers,
 	})
 
-	com, err := parseCommand([]byte("8ball"), "a", 1, 1, "::1", &isSlut, &isDead)
+	com, err := parseCommand(ctx, "8ball")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -81,8 +199,7 @@ func Test8ball(t *testing.T) {
 }
 
 func TestPyu(t *testing.T) {
-	var isSlut bool
-	var isDead bool
+	ctx := newCommandContext()
 	test_db.ClearTables(t, "boards", "pyu", "pyu_limit")
 	writeSampleBoard(t)
 	writeSampleThread(t)
@@ -96,7 +213,7 @@ func TestPyu(t *testing.T) {
 		})
 
 		for _, in := range [...]string{"pyu", "pcount"} {
-			com, err := parseCommand([]byte(in), "a", 1, 1, "::1", &isSlut, &isDead)
+			com, err := parseCommand(ctx, in)
 			if err != nil {
 				t.Error(err)
 			}
@@ -131,7 +248,7 @@ func TestPyu(t *testing.T) {
 		for i := range cases {
 			c := cases[i]
 			t.Run(c.name, func(t *testing.T) {
-				com, err := parseCommand([]byte(c.in), "a", 1, 1, "::1", &isSlut, &isDead)
+				com, err := parseCommand(ctx, c.in)
 				if err != nil {
 					t.Fatal(err)
 				}
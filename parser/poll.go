@@ -0,0 +1,155 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"html"
+	"io"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+)
+
+var errInvalidPoll = common.InvalidCommand{
+	Err: common.ErrInvalidInput("poll needs 2 or more options"),
+}
+
+func init() {
+	common.RegisterCommand(pollCommand{})
+}
+
+// Poll with options on the following lines of the post
+type pollCommand struct{}
+
+func (pollCommand) Type() common.CommandType {
+	return common.Poll
+}
+
+func (pollCommand) Name() string {
+	return "poll"
+}
+
+func (pollCommand) Pattern() string {
+	return `poll\d*`
+}
+
+func (pollCommand) Parse(ctx *common.CommandContext, match string) (
+	com common.Command, err error,
+) {
+	// Only one poll per post
+	if ctx.Applied[common.Poll] {
+		err = errInvalidPoll
+		return
+	}
+
+	dur := config.Get().PollDuration
+	if dur == 0 {
+		dur = config.Defaults.PollDuration
+	}
+	if len(match) > len("poll") {
+		var n uint64
+		n, err = strconv.ParseUint(match[len("poll"):], 10, 64)
+		if err != nil || n == 0 || n > common.MaxPollDuration {
+			err = common.InvalidCommand{
+				Err: common.ErrInvalidInput("invalid poll duration"),
+			}
+			return
+		}
+		dur = uint(n)
+	}
+
+	options := parsePollOptions(ctx.Body, ctx.End)
+	if len(options) < 2 {
+		err = errInvalidPoll
+		return
+	}
+
+	p := common.PollState{
+		Expires: time.Now().Add(time.Duration(dur) * time.Minute).Unix(),
+		Options: options,
+		Votes:   make([]uint64, len(options)),
+	}
+	// Written to the database along with the post, as the post may not have
+	// been inserted yet
+	ctx.Applied[common.Poll] = true
+	com = common.Command{
+		Type: common.Poll,
+		Poll: &p,
+	}
+	return
+}
+
+// Read poll options from the lines following the command, until the first
+// empty line
+func parsePollOptions(body []byte, pos int) (options []string) {
+	i := bytes.IndexByte(body[pos:], '\n')
+	if i == -1 {
+		return
+	}
+	for _, line := range bytes.Split(body[pos+i+1:], []byte{'\n'}) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || len(options) == common.MaxPollOptions {
+			break
+		}
+		if utf8.RuneCount(line) > common.MaxLenPollOption {
+			line = []byte(string([]rune(string(line))[:common.MaxLenPollOption]))
+		}
+		options = append(options, string(line))
+	}
+	return
+}
+
+func (pollCommand) AppendJSON(b []byte, c common.Command) []byte {
+	buf, err := json.Marshal(c.Poll)
+	if err != nil {
+		return append(b, "null"...)
+	}
+	return append(b, buf...)
+}
+
+func (pollCommand) DecodeJSON(data []byte, c *common.Command) error {
+	return json.Unmarshal(data, &c.Poll)
+}
+
+func (pollCommand) RenderHTML(w io.Writer, match string,
+	c common.Command,
+) bool {
+	p := c.Poll
+	if p == nil || len(p.Votes) != len(p.Options) {
+		return false
+	}
+
+	open := `<strong class="poll" data-expires="`
+	if p.Closed() {
+		open = `<strong class="poll closed" data-expires="`
+	}
+	open += strconv.FormatInt(p.Expires, 10) + `">`
+
+	var total uint64
+	inner := make([]byte, 0, 128)
+	for i, o := range p.Options {
+		if i != 0 {
+			inner = append(inner, ", "...)
+		}
+		inner = append(inner, `<span class="poll-option" data-option="`...)
+		inner = strconv.AppendInt(inner, int64(i), 10)
+		inner = append(inner, `">`...)
+		inner = append(inner, html.EscapeString(o)...)
+		inner = append(inner, ": "...)
+		inner = strconv.AppendUint(inner, p.Votes[i], 10)
+		inner = append(inner, "</span>"...)
+		total += p.Votes[i]
+	}
+	inner = append(inner, "; "...)
+	inner = strconv.AppendUint(inner, total, 10)
+	inner = append(inner, " votes"...)
+	if p.Closed() {
+		inner = append(inner, ", closed"...)
+	}
+
+	common.WriteCommand(w, open, match, inner)
+	return true
+}
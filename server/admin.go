@@ -3,22 +3,29 @@
 package server
 
 import (
+	"bufio"
 	"database/sql"
+	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/bakape/meguca/pb"
 	"github.com/go-playground/log"
 	"google.golang.org/protobuf/proto"
 	"io"
+	"mime"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/bakape/meguca/auth"
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/imager"
 	"github.com/bakape/meguca/templates"
 	"github.com/bakape/meguca/websockets/feeds"
 )
@@ -26,6 +33,9 @@ import (
 const (
 	maxAnswers      = 100  // Maximum number of eightball answers
 	maxEightballLen = 2000 // Total chars in eightball
+
+	// Maximum number of entries in each listing of the storage report
+	storageReportLimit = 50
 )
 
 var (
@@ -35,12 +45,20 @@ var (
 	errRulesTooLong     = common.ErrTooLong("rules")
 	errReasonTooLong    = common.ErrTooLong("reason")
 	errTooManyAnswers   = common.ErrInvalidInput("too many eightball answers")
+	errAttachmentLimit  = common.ErrInvalidInput("attachment limit too high")
+	errSlowModeTooLong  = common.ErrInvalidInput("slow mode interval too long")
+	errBumpLimit        = common.ErrInvalidInput("bump limit too high")
+	errImageLimit       = common.ErrInvalidInput("image limit too high")
+	errNewPosterPosts   = common.ErrInvalidInput("new poster threshold too high")
 	errInvalidBoardName = common.ErrInvalidInput("invalid board name")
 	errBoardNameTaken   = common.ErrInvalidInput("board name taken")
 	errNoReason         = common.ErrInvalidInput("no reason provided")
 	errNoDuration       = common.ErrInvalidInput("no ban duration provided")
 	errAccessDenied     = common.ErrAccessDenied("missing permissions")
 
+	errInvalidModLogFilter = common.ErrInvalidInput("invalid mod log filter")
+	errInvalidExportFormat = common.ErrInvalidInput("invalid export format")
+
 	boardNameValidation = regexp.MustCompile(`^[a-z0-9]{1,10}$`)
 )
 
@@ -48,6 +66,12 @@ type boardActionRequest struct {
 	Board string
 }
 
+type storageQuotaRequest struct {
+	Board string
+	// In bytes. 0 removes the quota.
+	Quota uint64
+}
+
 type boardCreationRequest struct {
 	ID, Title string
 }
@@ -75,6 +99,7 @@ type banRequest struct {
 	Shadow   bool
 	Duration uint64
 	Reason   string
+	Scope    common.BanScope
 }
 
 // Set board-specific configurations to the user's owned board
@@ -175,6 +200,16 @@ func validateBoardConfigs(w http.ResponseWriter, conf config.BoardConfigs,
 		err = errRulesTooLong
 	case len(conf.Title) > common.MaxLenBoardTitle:
 		err = errTitleTooLong
+	case conf.MaxAttachments > common.MaxNumAttachments:
+		err = errAttachmentLimit
+	case conf.SlowMode > common.MaxSlowMode:
+		err = errSlowModeTooLong
+	case conf.BumpLimit > common.MaxBumpLimit:
+		err = errBumpLimit
+	case conf.ImageLimit > common.MaxBumpLimit:
+		err = errImageLimit
+	case conf.NewPosterPosts > common.MaxNewPosterPosts:
+		err = errNewPosterPosts
 	}
 	if err != nil {
 		return
@@ -189,6 +224,22 @@ func validateBoardConfigs(w http.ResponseWriter, conf config.BoardConfigs,
 	}
 	if !matched {
 		err = common.ErrInvalidInput("invalid default theme")
+		return
+	}
+
+	if len(conf.Reactions) > common.MaxNumReactions {
+		return common.ErrInvalidInput("too many reactions")
+	}
+	for _, r := range conf.Reactions {
+		if len(r) == 0 || len(r) > common.MaxLenReaction {
+			return common.ErrInvalidInput("invalid reaction: " + r)
+		}
+	}
+
+	for _, name := range conf.DisabledCommands {
+		if common.CommandHandlerByName(name) == nil {
+			return common.ErrInvalidInput("unknown hash command: " + name)
+		}
 	}
 	return
 }
@@ -216,6 +267,95 @@ func servePrivateServerConfigs(w http.ResponseWriter, r *http.Request) {
 	serveJSON(w, r, "", config.Get())
 }
 
+// Serve the state of the thumbnailing queue to the admin account
+func serveThumbnailQueue(w http.ResponseWriter, r *http.Request) {
+	err := isAdmin(w, r)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	stats, err := imager.GetQueueStats()
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	serveJSON(w, r, "", stats)
+}
+
+// Cancel a pending or running thumbnailing job as the admin account
+func cancelThumbnailJob(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		err = isAdmin(w, r)
+		if err != nil {
+			return
+		}
+		id, err := extractID(r)
+		if err != nil {
+			return
+		}
+		return imager.CancelJob(id)
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Queue regeneration of additional thumbnails for existing images as the admin
+// account. Only images lacking them are queued, unless the "all" query
+// parameter is set.
+func backfillThumbnails(w http.ResponseWriter, r *http.Request) {
+	err := isAdmin(w, r)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	n, err := imager.BackfillThumbnails(r.URL.Query().Get("all") == "true")
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	serveJSON(w, r, "", struct {
+		Queued int64 `json:"queued"`
+	}{n})
+}
+
+// Serve a report of the storage used by uploaded files to the admin account
+func serveStorageReport(w http.ResponseWriter, r *http.Request) {
+	err := isAdmin(w, r)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	rep, err := db.GetStorageReport(storageReportLimit)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	serveJSON(w, r, "", rep)
+}
+
+// Set the storage quota of a board as the admin account
+func setStorageQuota(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		err = isAdmin(w, r)
+		if err != nil {
+			return
+		}
+		var msg storageQuotaRequest
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+		if !config.IsBoard(msg.Board) || msg.Board == "all" {
+			return errInvalidBoardName
+		}
+		return db.SetStorageQuota(msg.Board, msg.Quota)
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
 func isAdmin(w http.ResponseWriter, r *http.Request) (err error) {
 	creds, err := isLoggedIn(w, r)
 	if err != nil {
@@ -306,11 +446,16 @@ func createBoard(w http.ResponseWriter, r *http.Request) {
 				Created: time.Now().UTC(),
 				BoardConfigs: config.BoardConfigs{
 					BoardPublic: config.BoardPublic{
-						Title:      msg.Title,
-						DefaultCSS: config.Get().DefaultCSS,
+						Title:          msg.Title,
+						DefaultCSS:     config.Get().DefaultCSS,
+						Reactions:      config.ReactionDefaults,
+						MaxAttachments: 1,
+						BumpLimit:      common.BumpLimit,
 					},
-					ID:        msg.ID,
-					Eightball: config.EightballDefaults,
+					ID:             msg.ID,
+					Eightball:      config.EightballDefaults,
+					PreModThreads:  true,
+					NewPosterPosts: common.NewPosterPosts,
 				},
 			})
 			switch {
@@ -438,6 +583,8 @@ func ban(
 		err = errNoReason
 	case msg.Duration == 0:
 		err = errNoDuration
+	case !msg.Scope.IsValid():
+		err = common.ErrInvalidBanTarget
 	}
 	if err != nil {
 		return -1, nil, err
@@ -454,7 +601,7 @@ func ban(
 		// Apply ban
 		return db.Ban(
 			tx, board, msg.Reason, by, time.Minute*time.Duration(msg.Duration),
-			id, banType,
+			id, banType, msg.Scope,
 		)
 	}
 
@@ -553,11 +700,19 @@ func getSameIPPosts(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		posts, err := db.GetSameIPPosts(id, board, uid)
+		var res struct {
+			Posts []common.StandalonePost `json:"posts"`
+			Notes []auth.ModNote          `json:"notes"`
+		}
+		res.Posts, err = db.GetSameIPPosts(id, board, uid)
 		if err != nil {
 			return
 		}
-		serveJSON(w, r, "", posts)
+		res.Notes, err = db.GetModNotes(id)
+		if err != nil {
+			return
+		}
+		serveJSON(w, r, "", res)
 		return
 	}()
 	if err != nil {
@@ -607,6 +762,45 @@ func setThreadLock(w http.ResponseWriter, r *http.Request) {
 	handleBoolRequest(w, r, common.LockThread, db.SetThreadLock)
 }
 
+// Set the cyclic flag of a thread
+func setThreadCyclic(w http.ResponseWriter, r *http.Request) {
+	handleBoolRequest(w, r, common.SetCyclic, db.SetThreadCyclic)
+}
+
+// Set the slow mode interval of a thread
+func setThreadSlowMode(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg struct {
+			ID  uint64
+			Val uint32
+		}
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+		if msg.Val > common.MaxSlowMode {
+			return errSlowModeTooLong
+		}
+
+		board, userID, err := canModeratePost(w, r, msg.ID, common.SetSlowMode)
+		if err != nil {
+			return
+		}
+		ok, err := db.ValidateOP(msg.ID, board)
+		switch {
+		case err != nil:
+			return
+		case !ok:
+			return common.ErrInvalidThread(msg.ID, board)
+		}
+
+		return db.SetThreadSlowMode(msg.ID, msg.Val, userID)
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
 func lockPlaylist(writer http.ResponseWriter, request *http.Request) {
 	log.Info("Locking playlist")
 	// Read the body of the HTTP request
@@ -724,21 +918,217 @@ func unban(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Serve moderation log for a specific board
+// Render the moderation log of a board
 func modLog(w http.ResponseWriter, r *http.Request) {
 	board := extractParam(r, "board")
 	if !auth.IsBoard(board) {
 		text404(w)
 		return
 	}
+	serveModLog(w, r, board, detectCanPerform(r, board, common.MeidoVision))
+}
 
-	log, err := db.GetModLog(board)
+// Render the moderation log of all boards for the admin account
+func globalModLog(w http.ResponseWriter, r *http.Request) {
+	err := isAdmin(w, r)
 	if err != nil {
 		httpError(w, r, err)
 		return
 	}
-	setHTMLHeaders(w)
-	templates.WriteModLog(w, log, detectCanPerform(r, board, common.MeidoVision))
+	serveModLog(w, r, "", true)
+}
+
+// Moderation log entry as exported in machine-readable formats
+type modLogExportEntry struct {
+	auth.ModLogEntry
+	Action string `json:"action"`
+	IPHash string `json:"ipHash,omitempty"`
+}
+
+// Serve moderation log entries of board matching the request's query
+// parameters as HTML or an exported file. An empty board serves entries of all
+// boards.
+func serveModLog(w http.ResponseWriter, r *http.Request, board string,
+	canSeeIPHashes bool,
+) {
+	err := func() (err error) {
+		q := r.URL.Query()
+		filter, err := parseModLogFilter(q)
+		if err != nil {
+			return
+		}
+		if filter.IPHash != "" && !canSeeIPHashes {
+			return errAccessDenied
+		}
+		filter.Board = board
+
+		format := q.Get("format")
+		switch format {
+		case "", "csv", "json":
+		default:
+			return errInvalidExportFormat
+		}
+
+		if format == "" {
+			var log []auth.ModLogEntry
+			log, err = db.GetModLog(filter)
+			if err != nil {
+				return
+			}
+			setHTMLHeaders(w)
+			templates.WriteModLog(w, log, board, filter, canSeeIPHashes)
+			return
+		}
+
+		// Exports contain all matching entries
+		name := "mod-log"
+		if board != "" {
+			name += "-" + board
+		}
+		head := w.Header()
+		for key, val := range vanillaHeaders {
+			head.Set(key, val)
+		}
+		head.Set("Content-Disposition", mime.FormatMediaType("attachment",
+			map[string]string{"filename": name + "." + format}))
+		if format == "json" {
+			head.Set("Content-Type", "application/json")
+			return writeModLogJSON(w, filter, canSeeIPHashes)
+		}
+		head.Set("Content-Type", "text/csv")
+		return writeModLogCSV(w, filter, canSeeIPHashes)
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+func newModLogExportEntry(e auth.ModLogEntry, withIPHash bool,
+) modLogExportEntry {
+	exp := modLogExportEntry{
+		ModLogEntry: e,
+		Action:      templates.ModerationActionLabel(e.Type),
+	}
+	if withIPHash {
+		exp.IPHash = e.IPHash
+	}
+	return exp
+}
+
+// Stream moderation log entries matching filter as a JSON array
+func writeModLogJSON(w io.Writer, filter auth.ModLogFilter,
+	withIPHashes bool,
+) (err error) {
+	bw := bufio.NewWriter(w)
+	sep := byte('[')
+	err = db.StreamModLog(filter, func(e auth.ModLogEntry) (err error) {
+		err = bw.WriteByte(sep)
+		if err != nil {
+			return
+		}
+		sep = ','
+		buf, err := json.Marshal(newModLogExportEntry(e, withIPHashes))
+		if err != nil {
+			return
+		}
+		_, err = bw.Write(buf)
+		return
+	})
+	if err != nil {
+		return
+	}
+	if sep == '[' { // No entries
+		err = bw.WriteByte(sep)
+		if err != nil {
+			return
+		}
+	}
+	_, err = bw.WriteString("]\n")
+	if err != nil {
+		return
+	}
+	return bw.Flush()
+}
+
+// Stream moderation log entries matching filter as CSV
+func writeModLogCSV(w io.Writer, filter auth.ModLogFilter,
+	withIPHashes bool,
+) error {
+	cw := csv.NewWriter(w)
+	row := []string{
+		"created", "board", "type", "action", "by", "post", "length", "data",
+	}
+	if withIPHashes {
+		row = append(row, "ipHash")
+	}
+	err := cw.Write(row)
+	if err != nil {
+		return err
+	}
+	err = db.StreamModLog(filter, func(l auth.ModLogEntry) error {
+		e := newModLogExportEntry(l, withIPHashes)
+		row = append(row[:0],
+			e.Created.UTC().Format(time.RFC3339),
+			e.Board,
+			strconv.Itoa(int(e.Type)),
+			e.Action,
+			e.By,
+			strconv.FormatUint(e.ID, 10),
+			strconv.FormatUint(e.Length, 10),
+			e.Data,
+		)
+		if withIPHashes {
+			row = append(row, e.IPHash)
+		}
+		return cw.Write(row)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// Parse moderation log filter query parameters. Dates are inclusive.
+func parseModLogFilter(q url.Values) (f auth.ModLogFilter, err error) {
+	for _, s := range q["type"] {
+		var t uint64
+		t, err = strconv.ParseUint(s, 10, 8)
+		if err != nil || t >= uint64(len(common.ActionPrivilege)) {
+			return f, errInvalidModLogFilter
+		}
+		f.Types = append(f.Types, common.ModerationAction(t))
+	}
+	f.By = q.Get("by")
+	if s := q.Get("post"); s != "" {
+		f.Post, err = strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return f, errInvalidModLogFilter
+		}
+	}
+	f.IPHash = strings.TrimSpace(q.Get("ip"))
+	if s := q.Get("from"); s != "" {
+		f.From, err = time.Parse(templates.DateFormat, s)
+		if err != nil {
+			return f, errInvalidModLogFilter
+		}
+	}
+	if s := q.Get("to"); s != "" {
+		f.To, err = time.Parse(templates.DateFormat, s)
+		if err != nil {
+			return f, errInvalidModLogFilter
+		}
+		f.To = f.To.AddDate(0, 0, 1)
+	}
+	if s := q.Get("page"); s != "" {
+		var p uint64
+		p, err = strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return f, errInvalidModLogFilter
+		}
+		f.Page = uint(p)
+	}
+	return
 }
 
 // Decodes params for client forced redirection
@@ -903,8 +1293,12 @@ func moderate(w http.ResponseWriter, r *http.Request) {
 					return
 				}
 			}
+			if len(queue) == 0 {
+				return
+			}
 
-			return
+			// The moderation handles any reports of the post
+			return db.ResolvePostReports(tx, msg.ID, creds.UserID)
 		})
 
 		return
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
@@ -193,6 +194,40 @@ func TestValidateBoardConfigs(t *testing.T) {
 			},
 			errTitleTooLong,
 		},
+		{
+			"attachment limit too high",
+			config.BoardConfigs{
+				BoardPublic: config.BoardPublic{
+					MaxAttachments: common.MaxNumAttachments + 1,
+				},
+			},
+			errAttachmentLimit,
+		},
+		{
+			"slow mode too long",
+			config.BoardConfigs{
+				BoardPublic: config.BoardPublic{
+					SlowMode: common.MaxSlowMode + 1,
+				},
+			},
+			errSlowModeTooLong,
+		},
+		{
+			"bump limit too high",
+			config.BoardConfigs{
+				BoardPublic: config.BoardPublic{
+					BumpLimit: common.MaxBumpLimit + 1,
+				},
+			},
+			errBumpLimit,
+		},
+		{
+			"new poster threshold too high",
+			config.BoardConfigs{
+				NewPosterPosts: common.MaxNewPosterPosts + 1,
+			},
+			errNewPosterPosts,
+		},
 	}
 
 	for i := range cases {
@@ -209,6 +244,76 @@ func TestValidateBoardConfigs(t *testing.T) {
 	}
 }
 
+func TestParseModLogFilter(t *testing.T) {
+	t.Parallel()
+
+	day := func(d int) time.Time {
+		return time.Date(2020, 1, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	cases := [...]struct {
+		name, query string
+		filter      auth.ModLogFilter
+		err         error
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:  "all fields",
+			query: "type=0&type=2&by=admin&post=5&ip=abc&from=2020-01-01&to=2020-01-02&page=2",
+			filter: auth.ModLogFilter{
+				Types:  []common.ModerationAction{common.BanPost, common.DeletePost},
+				By:     "admin",
+				Post:   5,
+				IPHash: "abc",
+				From:   day(1),
+				To:     day(3),
+				Page:   2,
+			},
+		},
+		{
+			name:  "invalid type",
+			query: "type=255",
+			err:   errInvalidModLogFilter,
+		},
+		{
+			name:  "invalid post",
+			query: "post=foo",
+			err:   errInvalidModLogFilter,
+		},
+		{
+			name:  "invalid date",
+			query: "from=yesterday",
+			err:   errInvalidModLogFilter,
+		},
+		{
+			name:  "invalid page",
+			query: "page=-1",
+			err:   errInvalidModLogFilter,
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			q, err := url.ParseQuery(c.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f, err := parseModLogFilter(q)
+			if err != c.err {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if c.err == nil {
+				test.AssertEquals(t, f, c.filter)
+			}
+		})
+	}
+}
+
 func disableCaptcha() {
 	conf := *config.Get()
 	conf.Captcha = false
@@ -0,0 +1,196 @@
+package server
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/templates"
+)
+
+const (
+	// Captcha service ID used by the ban appeal form. Captchas of this service
+	// can also be solved by banned users.
+	appealCaptcha = "appeal"
+
+	// Time a solved captcha is valid for submitting an appeal. Writing an
+	// appeal takes a while.
+	appealCaptchaTimeout = 10 * time.Minute
+)
+
+var (
+	errNotBanned       = common.ErrInvalidInput("not banned")
+	errNotAppealable   = common.ErrInvalidInput("ban can not be appealed")
+	errNoAppeal        = common.ErrInvalidInput("no appeal provided")
+	errAppealTooLong   = common.ErrTooLong("appeal")
+	errCommentTooLong  = common.ErrTooLong("comment")
+	errNoComment       = common.ErrInvalidInput("no comment provided")
+	errInvalidResponse = common.ErrInvalidInput("invalid appeal response")
+)
+
+type appealEvent struct {
+	ID, Post uint64
+	Body     string
+}
+
+// Appeal a ban from the ban page
+func appeal(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		r.Body = http.MaxBytesReader(w, r.Body, jsonLimit)
+		err = r.ParseForm()
+		if err != nil {
+			return common.StatusError{err, 400}
+		}
+		f := r.Form
+
+		ip, err := auth.GetIP(r)
+		if err != nil {
+			return common.StatusError{err, 400}
+		}
+		var session auth.Base64Token
+		err = session.EnsureCookie(w, r)
+		if err != nil {
+			return common.StatusError{err, 400}
+		}
+		has, err := db.SolvedCaptchaRecently(session, appealCaptchaTimeout)
+		if err != nil {
+			return
+		}
+		if !has {
+			return errInvalidCaptcha
+		}
+
+		board := f.Get("board")
+		if !auth.IsBoard(board) {
+			return errInvalidBoardName
+		}
+		body := strings.TrimSpace(f.Get("body"))
+		switch {
+		case body == "":
+			return errNoAppeal
+		case len(body) > common.MaxLenAppeal:
+			return errAppealTooLong
+		}
+
+		rec, err := db.GetBanInfo(ip, board)
+		switch err {
+		case nil:
+		case sql.ErrNoRows:
+			return errNotBanned
+		default:
+			return
+		}
+		if rec.ForPost == 0 {
+			// System bans can not be lifted individually
+			return errNotAppealable
+		}
+
+		id, err := db.InsertBanAppeal(rec.Board, rec.ForPost, ip, body)
+		if err != nil {
+			return
+		}
+
+		data, err := json.Marshal(appealEvent{
+			ID:   id,
+			Post: rec.ForPost,
+			Body: body,
+		})
+		if err != nil {
+			return
+		}
+		SSEBroker.Event <- ServerEvent{
+			Destination: "/html/appeals/" + rec.Board,
+			Data:        data,
+		}
+
+		back := "/"
+		if board != "all" {
+			back = "/" + board + "/"
+		}
+		http.Redirect(w, r, back, 303)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Render the queue of ban appeals on a board for authenticated staff
+func appealList(w http.ResponseWriter, r *http.Request) {
+	board := extractParam(r, "board")
+	if !auth.IsBoard(board) {
+		text404(w)
+		return
+	}
+	if !detectCanPerform(r, board, common.AcceptBanAppeal) {
+		httpError(w, r, errAccessDenied)
+		return
+	}
+
+	appeals, err := db.GetBanAppeals(board)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	setHTMLHeaders(w)
+	templates.WriteAppealList(w, appeals)
+}
+
+// Accept, reject or comment on a ban appeal
+func respondToAppeal(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		id, err := extractID(r)
+		if err != nil {
+			return
+		}
+		board, err := db.GetBanAppealBoard(id)
+		switch err {
+		case nil:
+		case sql.ErrNoRows:
+			return common.StatusError{err, 404}
+		default:
+			return
+		}
+		creds, err := canPerform(w, r, board, common.AcceptBanAppeal, false)
+		if err != nil {
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, jsonLimit)
+		err = r.ParseForm()
+		if err != nil {
+			return common.StatusError{err, 400}
+		}
+		comment := strings.TrimSpace(r.Form.Get("comment"))
+		if len(comment) > common.MaxLenAppeal {
+			return errCommentTooLong
+		}
+
+		switch action := r.Form.Get("action"); action {
+		case "accept", "reject":
+			err = db.ResolveBanAppeal(id, creds.UserID, action == "accept",
+				comment)
+		case "comment":
+			if comment == "" {
+				return errNoComment
+			}
+			err = db.CommentBanAppeal(id, creds.UserID, comment)
+		default:
+			return errInvalidResponse
+		}
+		if err != nil {
+			return
+		}
+
+		http.Redirect(w, r, "/html/appeals/"+board, 303)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
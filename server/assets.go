@@ -3,16 +3,22 @@ package server
 import (
 	"bytes"
 	"fmt"
+	"io"
+	"mime"
 	"mime/multipart"
 	"net/http"
 	"os"
+	"path"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/bakape/meguca/assets"
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/imager"
+	imgassets "github.com/bakape/meguca/imager/assets"
 	"github.com/bakape/thumbnailer/v2"
 )
 
@@ -23,11 +29,12 @@ var (
 		// it is a year or greater, so keep it a little below.
 		"Cache-Control": "max-age=30240000, public, immutable",
 	}
-
-	// For overriding during tests
-	imageWebRoot = "images"
 )
 
+// Expiry time of signed URLs clients are redirected to for images in remote
+// storage
+const signedURLExpiry = time.Hour
+
 type fileError struct {
 	name, msg string
 }
@@ -45,11 +52,30 @@ func newFileError(h *multipart.FileHeader, msg string) error {
 
 // More performant handler for serving image assets. These are immutable
 // (except deletion), so we can also set separate caching policies for them.
+// Clients are redirected to signed URLs, if the storage backend supports them.
 func serveImages(w http.ResponseWriter, r *http.Request) {
-	path := extractParam(r, "path")
-	file, err := os.Open(cleanJoin(imageWebRoot, path))
+	key := strings.TrimPrefix(path.Clean("/"+extractParam(r, "path")), "/")
+
+	url, err := imgassets.Store.SignedURL(key, signedURLExpiry)
+	switch err {
+	case nil:
+		// Cache the redirect for less time than the URL is valid
+		w.Header().Set("Cache-Control", "max-age=1800, public")
+		http.Redirect(w, r, url, 302)
+		return
+	case imgassets.ErrNoSignedURLs:
+	default:
+		httpError(w, r, err)
+		return
+	}
+
+	file, err := imgassets.Store.Get(key)
 	if err != nil {
-		text404(w)
+		if os.IsNotExist(err) {
+			text404(w)
+		} else {
+			httpError(w, r, err)
+		}
 		return
 	}
 	defer file.Close()
@@ -59,7 +85,49 @@ func serveImages(w http.ResponseWriter, r *http.Request) {
 		head.Set(key, val)
 	}
 
-	http.ServeContent(w, r, path, time.Time{}, file)
+	if rs, ok := file.(io.ReadSeeker); ok {
+		http.ServeContent(w, r, key, time.Time{}, rs)
+	} else {
+		head.Set("Content-Type", mime.TypeByExtension(path.Ext(key)))
+		io.Copy(w, file)
+	}
+}
+
+// Serve a single file from an uploaded archive. Only images are displayed
+// inline. Anything else is served as a download, so that files inside archives
+// can not execute scripts on our origin.
+func serveArchiveEntry(w http.ResponseWriter, r *http.Request) {
+	sha1 := extractParam(r, "sha1")
+	i, err := strconv.Atoi(extractParam(r, "entry"))
+	if err != nil || i < 0 {
+		text404(w)
+		return
+	}
+	fileType, entry, err := db.GetArchiveEntry(sha1, i)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+
+	head := w.Header()
+	for key, val := range imageHeaders {
+		head.Set(key, val)
+	}
+	head.Set("X-Content-Type-Options", "nosniff")
+	head.Set("Content-Length", strconv.FormatInt(entry.Size, 10))
+	if imager.IsArchiveImage(entry.Name) {
+		head.Set("Content-Type", mime.TypeByExtension(path.Ext(entry.Name)))
+	} else {
+		head.Set("Content-Type", "application/octet-stream")
+		head.Set("Content-Disposition", mime.FormatMediaType("attachment",
+			map[string]string{"filename": path.Base(entry.Name)}))
+	}
+
+	err = imager.CopyArchiveEntry(w, sha1, fileType, i)
+	if err != nil {
+		// Headers already sent
+		logError(r, err)
+	}
 }
 
 func cleanJoin(a, b string) string {
@@ -152,6 +220,72 @@ func setBanners(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Set the custom emoji of a board. Shortcodes are taken from the file names
+// without extensions.
+func setEmoji(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		board, err := parseAssetForm(w, r, common.MaxNumEmoji)
+		if err != nil {
+			return
+		}
+
+		var (
+			opts = thumbnailer.Options{
+				MaxSourceDims: thumbnailer.Dims{
+					Width:  128,
+					Height: 128,
+				},
+				ThumbDims: thumbnailer.Dims{
+					Width:  128,
+					Height: 128,
+				},
+				AcceptedMimeTypes: map[string]bool{
+					"image/png":  true,
+					"image/gif":  true,
+					"image/webp": true,
+				},
+			}
+			emoji = make([]assets.File, 0, common.MaxNumEmoji)
+			names = make(map[string]bool, common.MaxNumEmoji)
+			files = r.MultipartForm.File["emoji"]
+			file  multipart.File
+			h     *multipart.FileHeader
+			out   assets.File
+		)
+
+		for i := 0; i < common.MaxNumEmoji && i < len(files); i++ {
+			h = files[i]
+			name := strings.ToLower(strings.TrimSuffix(h.Filename,
+				filepath.Ext(h.Filename)))
+			switch {
+			case !common.EmojiNameRegexp.MatchString(name):
+				return newFileError(h, "invalid shortcode")
+			case names[name]:
+				return newFileError(h, "duplicate shortcode")
+			}
+			names[name] = true
+
+			file, err = h.Open()
+			if err != nil {
+				err = newFileError(h, err.Error())
+				return
+			}
+
+			out, err = readAssetFile(w, r, file, h, opts)
+			if err != nil {
+				return
+			}
+			out.Name = name
+			emoji = append(emoji, out)
+		}
+
+		return db.SetEmoji(board, emoji)
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
 // Parse form for uploading file assets for a board.
 // maxSize specifies maximum number of common.MaxAssetSize to accept.
 // If ok == false, caller should return.
@@ -282,6 +416,16 @@ func serveAssetFromMemory(
 	w.Write(f.Data)
 }
 
+// Serve board-specific custom emoji files
+func serveEmoji(w http.ResponseWriter, r *http.Request) {
+	f, ok := assets.Emoji.Get(extractParam(r, "board"), extractParam(r, "name"))
+	if !ok {
+		text404(w)
+		return
+	}
+	serveAssetFromMemory(w, r, f)
+}
+
 // Serve board-specific loading animation
 func serveLoadingAnimation(w http.ResponseWriter, r *http.Request) {
 	serveAssetFromMemory(w, r, assets.Loading.Get(extractParam(r, "board")))
@@ -0,0 +1,82 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+)
+
+type bulkModerationRequest struct {
+	ID                           uint64
+	SameIP, SameThread, SameFile bool
+	// Unix timestamps of the post creation time range. 0 is unbounded.
+	From, To int64
+	Action   common.ModerationAction
+	Reason   string
+	// Shadow bin duration in minutes
+	Duration uint64
+	Scope    common.BanScope
+}
+
+// Delete, purge, spoiler or shadow bin all posts related to a post by IP,
+// thread or file within a time range
+func bulkModerate(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg bulkModerationRequest
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+
+		switch msg.Action {
+		case common.DeletePost, common.SpoilerImage:
+		case common.PurgePost, common.ShadowBinPost:
+			switch {
+			case len(msg.Reason) > common.MaxLenReason:
+				return errReasonTooLong
+			case msg.Reason == "":
+				return errNoReason
+			}
+			if msg.Action == common.ShadowBinPost {
+				switch {
+				case msg.Duration == 0:
+					return errNoDuration
+				case !msg.Scope.IsValid():
+					return common.ErrInvalidBanTarget
+				}
+			}
+		default:
+			return db.ErrInvalidBulkAction
+		}
+
+		_, by, err := canModeratePost(w, r, msg.ID, msg.Action)
+		if err != nil {
+			return
+		}
+
+		f := db.BulkFilter{
+			ID:         msg.ID,
+			SameIP:     msg.SameIP,
+			SameThread: msg.SameThread,
+			SameFile:   msg.SameFile,
+		}
+		if msg.From != 0 {
+			f.From = time.Unix(msg.From, 0)
+		}
+		if msg.To != 0 {
+			f.To = time.Unix(msg.To, 0)
+		}
+		n, err := db.BulkModerate(f, msg.Action, by, msg.Reason,
+			time.Minute*time.Duration(msg.Duration), msg.Scope)
+		if err != nil {
+			return
+		}
+		serveJSON(w, r, "", n)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
@@ -39,13 +39,16 @@ func listenToThreadDeletion() error {
 			return
 		}
 
-		// Clear all cache records associated with a thread
-		for _, i := range [...]int{0, 5, 100} {
-			cache.Delete(cache.ThreadKey(id, i))
-		}
-		cache.DeleteByBoard(board)
-		cache.DeleteByBoard("all")
-
+		clearThreadCache(id, board)
 		return nil
 	})
 }
+
+// Clear all cache records associated with a thread
+func clearThreadCache(id uint64, board string) {
+	for _, i := range [...]int{0, 5, 100} {
+		cache.Delete(cache.ThreadKey(id, i))
+	}
+	cache.DeleteByBoard(board)
+	cache.DeleteByBoard("all")
+}
@@ -23,7 +23,8 @@ func (e errCaptchasNotReady) Error() string {
 // Authenticate a captcha solution
 func authenticateCaptcha(w http.ResponseWriter, r *http.Request) {
 	err := func() (err error) {
-		if !assertNotBanned(w, r, "all") {
+		if extractParam(r, "board") != appealCaptcha &&
+			!assertNotBanned(w, r, "all") {
 			return
 		}
 		err = r.ParseForm()
@@ -71,7 +72,7 @@ func authenticateCaptcha(w http.ResponseWriter, r *http.Request) {
 func serveNewCaptcha(w http.ResponseWriter, r *http.Request) {
 	httpError(w, r, func() (err error) {
 		b := extractParam(r, "board")
-		if !assertNotBanned(w, r, "all") {
+		if b != appealCaptcha && !assertNotBanned(w, r, "all") {
 			return
 		}
 
@@ -261,6 +261,11 @@ func bannerSettingForm(w http.ResponseWriter, r *http.Request) {
 	templates.WriteBannerForm(w)
 }
 
+func emojiForm(w http.ResponseWriter, r *http.Request) {
+	setHTMLHeaders(w)
+	templates.WriteEmojiForm(w)
+}
+
 func loadingAnimationForm(w http.ResponseWriter, r *http.Request) {
 	setHTMLHeaders(w)
 	templates.WriteLoadingAnimationForm(w)
@@ -11,6 +11,7 @@ import (
 	"github.com/bakape/meguca/auth"
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/imager"
 	"github.com/bakape/meguca/imager/assets"
 	"github.com/bakape/meguca/lang"
 	mlog "github.com/bakape/meguca/log"
@@ -55,7 +56,7 @@ func Start() (err error) {
 	mlog.ConsoleHandler.SetDisplayColor(config.Server.Debug)
 	websockets.InitGemini()
 
-	err = util.Parallel(db.LoadDB, assets.CreateDirs)
+	err = util.Parallel(db.LoadDB, assets.InitStorage)
 	if err != nil {
 		return
 	}
@@ -67,11 +68,12 @@ func Start() (err error) {
 	// Depend on configs
 	var tasks []func() error
 	if config.Server.ImagerMode != config.ImagerOnly {
-		tasks = append(tasks, templates.Compile, listenToThreadDeletion)
+		tasks = append(tasks, templates.Compile, listenToThreadDeletion,
+			listenToPendingPosts)
 		go ass.WatchVideoDir()
 	}
 	if config.Server.ImagerMode != config.NoImager {
-		tasks = append(tasks, auth.LoadCaptchaServices)
+		tasks = append(tasks, auth.LoadCaptchaServices, imager.StartScheduler)
 	}
 	tasks = append(tasks, feeds.Init)
 	err = util.Parallel(tasks...)
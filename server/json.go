@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/bakape/meguca/assets"
 	"github.com/bakape/meguca/auth"
 	"github.com/bakape/meguca/cache"
 	"github.com/bakape/meguca/common"
@@ -190,6 +191,42 @@ func serveBoardList(res http.ResponseWriter, req *http.Request) {
 	serveJSON(res, req, "", config.GetBoardTitles())
 }
 
+// Serve a JSON array of a board's custom emoji for the emoji picker
+func serveEmojiList(w http.ResponseWriter, r *http.Request) {
+	board := extractParam(r, "board")
+	if !auth.IsBoard(board) {
+		text404(w)
+		return
+	}
+
+	type emoji struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	}
+	names := assets.Emoji.Names(board)
+	list := make([]emoji, len(names))
+	for i, n := range names {
+		list[i] = emoji{
+			Name: n,
+			URL:  "/assets/emoji/" + board + "/" + n,
+		}
+	}
+	serveJSON(w, r, "", list)
+}
+
+// Serve the index of an uploaded archive's contents
+func serveArchiveIndex(w http.ResponseWriter, r *http.Request) {
+	entries, err := db.GetArchiveEntries(extractParam(r, "sha1"))
+	switch {
+	case err != nil:
+		httpError(w, r, err)
+	case entries == nil:
+		text404(w)
+	default:
+		serveJSON(w, r, "", entries)
+	}
+}
+
 // Serve map of internal file type enums to extensions. Needed for
 // version-independent backwards compatibility with external applications.
 func serveExtensionMap(w http.ResponseWriter, r *http.Request) {
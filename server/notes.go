@@ -0,0 +1,69 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+)
+
+var (
+	errNoNote      = common.ErrInvalidInput("no note provided")
+	errNoteTooLong = common.ErrTooLong("note")
+)
+
+// Attach a private staff note to a post or its poster's IP
+func addModNote(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg struct {
+			ID   uint64
+			ByIP bool
+			Body string
+		}
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+		msg.Body = strings.TrimSpace(msg.Body)
+		switch {
+		case msg.Body == "":
+			return errNoNote
+		case len(msg.Body) > common.MaxLenModNote:
+			return errNoteTooLong
+		}
+
+		_, by, err := canModeratePost(w, r, msg.ID, common.AddModNote)
+		if err != nil {
+			return
+		}
+		return db.AddModNote(msg.ID, by, msg.Body, msg.ByIP)
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Serve staff notes attached to a post and its poster's IP
+func getModNotes(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		id, err := extractID(r)
+		if err != nil {
+			return
+		}
+		_, _, err = canModeratePost(w, r, id, common.AddModNote)
+		if err != nil {
+			return
+		}
+
+		notes, err := db.GetModNotes(id)
+		if err != nil {
+			return
+		}
+		serveJSON(w, r, "", notes)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
@@ -0,0 +1,189 @@
+package server
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/imager/assets"
+	"github.com/bakape/meguca/templates"
+	"github.com/bakape/meguca/websockets"
+	"github.com/bakape/meguca/websockets/feeds"
+)
+
+var errInvalidReview = common.ErrInvalidInput("invalid review response")
+
+type pendingEvent struct {
+	ID, OP        uint64
+	Subject, Body string
+	Thumb, Source string
+}
+
+// Notify staff viewing the review queue of a board of newly held posts. Posts
+// can be held by both the HTTP and websocket APIs, so this is propagated
+// through the database. Requires a ready DB connection.
+func listenToPendingPosts() error {
+	return db.Listen("pending_post", func(msg string) (err error) {
+		board, id, err := db.SplitBoardAndID(msg)
+		if err != nil {
+			return
+		}
+		p, err := db.GetPendingPost(id)
+		if err != nil {
+			return
+		}
+
+		e := pendingEvent{
+			ID:      p.ID,
+			OP:      p.OP,
+			Subject: p.Subject,
+			Body:    p.Body,
+		}
+		if p.Image != nil {
+			e.Thumb = assets.ThumbPath(p.Image.ThumbType, p.Image.SHA1)
+			e.Source = assets.SourcePath(p.Image.FileType, p.Image.SHA1)
+		}
+		data, err := json.Marshal(e)
+		if err != nil {
+			return
+		}
+		SSEBroker.Event <- ServerEvent{
+			Destination: "/html/queue/" + board,
+			Data:        data,
+		}
+		return
+	})
+}
+
+// Redirect the poster to the review status page of a held post
+func redirectToPending(w http.ResponseWriter, r *http.Request, id uint64) {
+	http.Redirect(w, r, "/html/pending/"+strconv.FormatUint(id, 10), 303)
+}
+
+// Render the review status of a held post to its poster
+func pendingPostStatus(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		id, err := extractID(r)
+		if err != nil {
+			return
+		}
+		ip, err := auth.GetIP(r)
+		if err != nil {
+			return common.StatusError{err, 400}
+		}
+		p, err := db.GetPendingPost(id)
+		switch {
+		case err == sql.ErrNoRows || (err == nil && p.IP != ip):
+			text404(w)
+			return nil
+		case err != nil:
+			return
+		}
+
+		setHTMLHeaders(w)
+		templates.WritePendingPostStatus(w, p)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Render the queue of posts held for review on a board for authenticated
+// staff
+func reviewQueue(w http.ResponseWriter, r *http.Request) {
+	board := extractParam(r, "board")
+	if !auth.IsBoard(board) {
+		text404(w)
+		return
+	}
+	if !detectCanPerform(r, board, common.ApprovePost) {
+		httpError(w, r, errAccessDenied)
+		return
+	}
+
+	posts, err := db.GetPendingPosts(board)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	setHTMLHeaders(w)
+	templates.WriteReviewQueue(w, posts)
+}
+
+// Approve or reject a post held for review
+func reviewPendingPost(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		id, err := extractID(r)
+		if err != nil {
+			return
+		}
+		p, err := db.GetPendingPost(id)
+		switch err {
+		case nil:
+		case sql.ErrNoRows:
+			return common.StatusError{err, 404}
+		default:
+			return
+		}
+		creds, err := canPerform(w, r, p.Board, common.ApprovePost, false)
+		if err != nil {
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, jsonLimit)
+		err = r.ParseForm()
+		if err != nil {
+			return common.StatusError{err, 400}
+		}
+		reason := strings.TrimSpace(r.Form.Get("reason"))
+		if len(reason) > common.MaxLenReason {
+			return errReasonTooLong
+		}
+
+		state := auth.PostApproved
+		switch r.Form.Get("action") {
+		case "approve":
+			var (
+				post db.Post
+				msg  []byte
+			)
+			post, msg, err = websockets.ApprovePendingPost(id, creds.UserID)
+			if err != nil {
+				return
+			}
+			if msg != nil {
+				feeds.InsertPostInto(post.StandalonePost, msg)
+			}
+		case "reject":
+			state = auth.PostRejected
+			err = db.RejectPendingPost(id, creds.UserID, reason)
+			if err != nil {
+				return
+			}
+		default:
+			return errInvalidReview
+		}
+
+		// Let the poster's status page reload
+		data, err := json.Marshal(state)
+		if err != nil {
+			return
+		}
+		SSEBroker.Event <- ServerEvent{
+			Destination: "/html/pending/" + strconv.FormatUint(id, 10),
+			Data:        data,
+		}
+
+		http.Redirect(w, r, "/html/queue/"+p.Board, 303)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
@@ -37,6 +37,11 @@ func createThread(w http.ResponseWriter, r *http.Request) {
 		}
 
 		post, err := websockets.CreateThread(req, ip)
+		if held, ok := err.(websockets.PendingReviewError); ok {
+			redirectToPending(w, r, held.ID)
+			incrementSpamscore(ip, req.Body, session, true)
+			return nil
+		}
 		if err != nil {
 			// TODO: Not all codes are actually 400. Need to differentiate
 			return common.StatusError{err, 400}
@@ -171,7 +176,17 @@ func createReply(w http.ResponseWriter, r *http.Request) {
 			return common.StatusError{errors.New("Posting via direct POST requests is not allowed for new users. Please post once via a WebSocket connection."), 403}
 		}
 
-		post, msg, err := websockets.CreatePost(op, board, ip, req)
+		post, msg, _, err := websockets.CreatePost(op, board, ip, req)
+		switch err := err.(type) {
+		case websockets.SlowModeError:
+			w.Header().Set("Retry-After",
+				strconv.FormatUint(err.Seconds(), 10))
+			return common.StatusError{err, 429}
+		case websockets.PendingReviewError:
+			redirectToPending(w, r, err.ID)
+			incrementSpamscore(ip, req.Body, session, false)
+			return nil
+		}
 		if err != nil {
 			// TODO: Not all codes are actually 400. Need to differentiate
 			return common.StatusError{err, 400}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/bakape/meguca/auth"
@@ -12,6 +13,11 @@ import (
 	"github.com/bakape/meguca/templates"
 )
 
+var (
+	errInvalidReportState = common.ErrInvalidInput("invalid report state")
+	errInvalidTriage      = common.ErrInvalidInput("invalid report action")
+)
+
 type reportEvent struct {
 	Post   uint64
 	Reason string
@@ -117,11 +123,77 @@ func reportList(w http.ResponseWriter, r *http.Request) {
 		text404(w)
 		return
 	}
-	rep, err := db.GetReports(board)
+
+	q := r.URL.Query()
+	filter := auth.ReportFilter{
+		State:      q.Get("state"),
+		AssignedTo: q.Get("assigned"),
+	}
+	switch filter.State {
+	case "", "all", auth.ReportOpen, auth.ReportClaimed, auth.ReportResolved,
+		auth.ReportDismissed:
+	default:
+		httpError(w, r, errInvalidReportState)
+		return
+	}
+
+	rep, err := db.GetReports(board, filter)
 	if err != nil {
 		httpError(w, r, err)
 		return
 	}
 	setHTMLHeaders(w)
-	templates.WriteReportList(w, rep)
+	templates.WriteReportList(w, rep, board, filter,
+		detectCanPerform(r, board, common.TriageReport))
+}
+
+// Claim, release, resolve or dismiss the active reports of a post
+func triageReports(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		board := extractParam(r, "board")
+		creds, err := canPerform(w, r, board, common.TriageReport, false)
+		if err != nil {
+			return
+		}
+		target, err := extractID(r)
+		if err != nil {
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, jsonLimit)
+		err = r.ParseForm()
+		if err != nil {
+			return common.StatusError{err, 400}
+		}
+		note := strings.TrimSpace(r.Form.Get("note"))
+		if len(note) > common.MaxLenReason {
+			return errReasonTooLong
+		}
+
+		switch action := r.Form.Get("action"); action {
+		case "claim":
+			err = db.ClaimReports(board, target, creds.UserID)
+		case "release":
+			err = db.ReleaseReports(board, target)
+		case "resolve", "dismiss":
+			err = db.ResolveReports(board, target, creds.UserID, note,
+				action == "dismiss")
+		default:
+			return errInvalidTriage
+		}
+		if err != nil {
+			return
+		}
+
+		// The list's filter is passed through in the query string
+		back := "/html/reports/" + board
+		if r.URL.RawQuery != "" {
+			back += "?" + r.URL.RawQuery
+		}
+		http.Redirect(w, r, back, 303)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
 }
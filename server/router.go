@@ -89,6 +89,12 @@ func createRouter() http.Handler {
 		api.POST("/create-reply", createReply)
 
 		assets.GET("/images/*path", serveImages)
+		assets.GET("/archive/:sha1/:entry", serveArchiveEntry)
+
+		// Thumbnailing queue administration
+		api.POST("/thumbnail-queue", serveThumbnailQueue)
+		api.POST("/thumbnail-queue/cancel/:id", cancelThumbnailJob)
+		api.POST("/thumbnail-queue/backfill", backfillThumbnails)
 
 		// Captcha API
 		captcha := api.NewGroup("/captcha")
@@ -123,10 +129,15 @@ func createRouter() http.Handler {
 		html.GET("/assign-staff/:board", staffAssignmentForm)
 		html.GET("/set-banners", bannerSettingForm)
 		html.GET("/set-loading", loadingAnimationForm)
+		html.GET("/set-emoji", emojiForm)
 		html.GET("/bans/:board", banList)
+		html.GET("/mod-log", globalModLog)
 		html.GET("/mod-log/:board", modLog)
 		html.GET("/report/:id", reportForm)
 		html.GET("/reports/:board", reportList)
+		html.GET("/appeals/:board", appealList)
+		html.GET("/queue/:board", reviewQueue)
+		html.GET("/pending/:id", pendingPostStatus)
 
 		// JSON API
 		json := r.NewGroup("/json")
@@ -145,6 +156,8 @@ func createRouter() http.Handler {
 		json.GET("/extensions", serveExtensionMap)
 		json.GET("/board-config/:board", serveBoardConfigs)
 		json.GET("/board-list", serveBoardList)
+		json.GET("/emoji/:board", serveEmojiList)
+		json.GET("/archive/:sha1", serveArchiveIndex)
 		json.GET("/ip-count", serveIPCount)
 		json.POST("/thread-updates", serveThreadUpdates)
 
@@ -165,19 +178,34 @@ func createRouter() http.Handler {
 		api.POST("/configure-board/:board", configureBoard)
 		api.POST("/config", servePrivateServerConfigs)
 		api.POST("/configure-server", configureServer)
+		api.POST("/storage-report", serveStorageReport)
+		api.POST("/storage-quota", setStorageQuota)
 		api.POST("/create-board", createBoard)
 		api.POST("/delete-board", deleteBoard)
 		api.POST("/notification", sendNotification)
 		api.POST("/assign-staff", assignStaff)
 		api.POST("/same-IP/:id", getSameIPPosts)
+		api.POST("/mod-notes", addModNote)
+		api.POST("/mod-notes/:id", getModNotes)
 		api.POST("/sticky", setThreadSticky)
 		api.POST("/lock-thread", setThreadLock)
+		api.POST("/slow-mode", setThreadSlowMode)
+		api.POST("/cyclic-thread", setThreadCyclic)
+		api.POST("/move-thread", moveThread)
+		api.POST("/merge-threads", mergeThreads)
+		api.POST("/split-thread", splitThread)
 		api.POST("/unban/:board", unban)
 		api.POST("/set-banners", setBanners)
 		api.POST("/set-loading", setLoadingAnimation)
+		api.POST("/set-emoji", setEmoji)
 		api.POST("/report", report)
+		api.POST("/reports/:board/:id", triageReports)
+		api.POST("/appeal", appeal)
+		api.POST("/appeals/:id", respondToAppeal)
+		api.POST("/queue/:id", reviewPendingPost)
 		api.GET("/sse", sse)
 		api.POST("/moderate", moderate)
+		api.POST("/bulk-moderate", bulkModerate)
 		api.POST("/lock-playlist", lockPlaylist)
 
 		redir := api.NewGroup("/redirect")
@@ -187,6 +215,7 @@ func createRouter() http.Handler {
 		// Assets
 		assets.GET("/banners/:board/:id", serveBanner)
 		assets.GET("/loading/:board", serveLoadingAnimation)
+		assets.GET("/emoji/:board/:name", serveEmoji)
 		assets.GET("/*path", serveAssets)
 	}
 
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/websockets/feeds"
+)
+
+var errSubjectTooLong = common.ErrTooLong("subject")
+
+// Move a thread to another board
+func moveThread(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg struct {
+			ID    uint64
+			Board string
+		}
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+		if !config.IsBoard(msg.Board) || msg.Board == "all" {
+			return errInvalidBoardName
+		}
+
+		// Need moderation rights on both boards
+		_, by, err := canModeratePost(w, r, msg.ID, common.MoveThread)
+		if err != nil {
+			return
+		}
+		_, err = canPerform(w, r, msg.Board, common.MoveThread, false)
+		if err != nil {
+			return
+		}
+
+		stale, err := db.MoveThread(msg.ID, msg.Board, by)
+		if err != nil {
+			return
+		}
+		applyThreadChanges(stale, msg.Board, msg.ID)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Merge a thread into another thread
+func mergeThreads(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg struct {
+			ID, Into uint64
+		}
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+
+		// Need moderation rights on both boards
+		_, by, err := canModeratePost(w, r, msg.ID, common.MergeThread)
+		if err != nil {
+			return
+		}
+		board, _, err := canModeratePost(w, r, msg.Into, common.MergeThread)
+		if err != nil {
+			return
+		}
+
+		stale, err := db.MergeThreads(msg.ID, msg.Into, by)
+		if err != nil {
+			return
+		}
+		applyThreadChanges(stale, board, msg.ID, msg.Into)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Split a reply and the replies linking to it off into a new thread
+func splitThread(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg struct {
+			ID      uint64
+			Subject string
+		}
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+		if len(msg.Subject) > common.MaxLenSubject {
+			return errSubjectTooLong
+		}
+
+		board, by, err := canModeratePost(w, r, msg.ID, common.SplitThread)
+		if err != nil {
+			return
+		}
+		_, op, err := db.GetPostParenthood(msg.ID)
+		if err != nil {
+			return
+		}
+
+		stale, err := db.SplitThread(msg.ID, msg.Subject, by)
+		if err != nil {
+			return
+		}
+		applyThreadChanges(stale, board, op)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Clear the caches of threads changed by a thread move, merge or split and
+// redirect clients synced to any of the threads in redirect to board, so they
+// resync to the changed threads
+func applyThreadChanges(stale []db.ThreadRef, board string,
+	redirect ...uint64,
+) {
+	for _, t := range stale {
+		clearThreadCache(t.ID, t.Board)
+	}
+	for _, id := range redirect {
+		for _, c := range feeds.GetByThread(id) {
+			c.Redirect(board)
+		}
+	}
+}
@@ -153,6 +153,17 @@ func assertNotBanned(w http.ResponseWriter, r *http.Request, board string,
 	rec, err := db.GetBanInfo(ip, board)
 	switch err {
 	case nil:
+		var appeal *auth.BanAppeal
+		a, err := db.GetBanAppeal(rec.Board, rec.ForPost, ip)
+		switch err {
+		case nil:
+			appeal = &a
+		case sql.ErrNoRows:
+		default:
+			httpError(w, r, err)
+			return false
+		}
+
 		w.WriteHeader(403)
 		head := w.Header()
 		for key, val := range vanillaHeaders {
@@ -160,7 +171,7 @@ func assertNotBanned(w http.ResponseWriter, r *http.Request, board string,
 		}
 		head.Set("Content-Type", "text/html")
 		head.Set("Cache-Control", "no-store")
-		templates.WriteBanPage(w, rec)
+		templates.WriteBanPage(w, rec, appeal)
 		return false
 	case sql.ErrNoRows:
 		// If there is no row, that means the ban cache has not been updated
@@ -0,0 +1,12 @@
+-- Returns the deduplicated SHA1 hashes of files referenced by posts on a board
+create or replace function board_files(board varchar(10))
+returns table (sha1 char(40)) as $$
+	select p.sha1
+		from posts p
+		where p.board = board_files.board and p.sha1 is not null
+	union
+	select a.sha1
+		from post_attachments a
+		join posts p on p.id = a.post_id
+		where p.board = board_files.board;
+$$ language sql stable;
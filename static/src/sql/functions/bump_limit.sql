@@ -0,0 +1,9 @@
+-- Returns the bump limit of the board of a thread. The default must match
+-- common.BumpLimit.
+create or replace function bump_limit(op bigint)
+returns bigint as $$
+	select coalesce(nullif(b.bumpLimit, 0), 1000)
+		from threads t
+		join boards b on b.id = t.board
+		where t.id = bump_limit.op;
+$$ language sql stable;
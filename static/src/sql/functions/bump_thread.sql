@@ -7,7 +7,9 @@ begin
 	update threads
 		set update_time = now_unix
 		where id = op;
-	if bump_thread.bump_time and post_count(bump_thread.op) < 1000 then
+	if bump_thread.bump_time and post_count(bump_thread.op)
+			< bump_limit(bump_thread.op)
+	then
 		update threads
 			set bump_time = now_unix
 			where id = bump_thread.op;
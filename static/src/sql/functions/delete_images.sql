@@ -2,7 +2,7 @@ create or replace function delete_images(id bigint, account text, by_ip boolean)
 returns void as $$
 declare
 	target_board text;
-	post_id bigint;
+	target_id bigint;
 	target_ip inet;
 	ids bigint[];
 begin
@@ -20,22 +20,30 @@ begin
 						where p.ip = target_ip
 							and post_board(p.id) = target_board
 							-- Ensure not already deleted
-							and p.sha1 is not null);
+							and (p.sha1 is not null
+								or exists (select
+											from post_attachments a
+											where a.post_id = p.id)));
 	else
 		-- Still need to check if the targeted post has an image to delete
 		ids := array(select p.id
 						from posts p
 						where p.id = delete_images.id
-							and p.sha1 is not null);
+							and (p.sha1 is not null
+								or exists (select
+											from post_attachments a
+											where a.post_id = p.id)));
 	end if;
 
 	-- Delete the images
-	foreach post_id in array ids loop
+	foreach target_id in array ids loop
 		update posts as p
 			set sha1 = null
-			where p.id = post_id;
+			where p.id = target_id;
+		delete from post_attachments as a
+			where a.post_id = target_id;
 		insert into mod_log (type, board, post_id, "by")
-			values (3, target_board, post_id, account);
+			values (3, target_board, target_id, account);
 	end loop;
 end;
 $$ language plpgsql;
@@ -0,0 +1,12 @@
+-- Returns the number of files in a thread including any additional attachments
+create or replace function image_count(op bigint)
+returns bigint as $$
+	select count(p.sha1) + (
+			select count(*)
+				from post_attachments a
+				join posts ap on ap.id = a.post_id
+				where ap.op = image_count.op
+		)
+		from posts p
+		where p.op = image_count.op;
+$$ language sql stable;
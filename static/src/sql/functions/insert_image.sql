@@ -1,27 +1,100 @@
--- Inserts image into existing post and return image json
-create function insert_image(post_id bigint, token char(86), name varchar(200),
-	spoiler bool)
+-- Inserts image into existing post and return image json. If the post already
+-- has an image, the new one is appended to the post's attachments, unless the
+-- attachment limit of the board is reached. Cyclic
+-- threads are pruned to make room for the file, other threads reject it, once
+-- the image limit of the board is reached. Files, that did not have their
+-- metadata stripped, are rejected, if the board or the global configuration
+-- requires it and metadata can be stripped from files of their type. Files not yet referenced on the board must fit into its storage
+-- quota.
+create or replace function insert_image(post_id bigint, token char(86),
+	name varchar(200), spoiler bool, strip_metadata bool,
+	strippable smallint[])
 returns jsonb as $$
 declare
 	image_id char(40);
+	has_image bool;
+	thread_id bigint;
+	max_images bigint;
+	max_attachments bigint;
+	attached bigint;
+	strip bool;
+	board_id varchar(10);
+	quota bigint;
+	pos smallint := 0;
 	data jsonb;
 begin
-	update posts
-		set sha1 = use_image_token(insert_image.token),
-			imageName = insert_image.name,
-			spoiler = insert_image.spoiler
-		where id = post_id
-		returning posts.sha1 into image_id;
-	if image_id is null then
+	select p.sha1 is not null, p.op into has_image, thread_id
+		from posts p
+		where p.id = insert_image.post_id
+		for update;
+	if has_image is null then
 		raise exception 'post not found';
 	end if;
 
+	perform prune_thread(thread_id, true, insert_image.post_id);
+	select b.imageLimit, greatest(b.maxAttachments, 1),
+			b.stripMetadata or insert_image.strip_metadata, b.id, b.storageQuota
+		into max_images, max_attachments, strip, board_id, quota
+		from threads t
+		join boards b on b.id = t.board
+		where t.id = thread_id;
+	if max_images != 0 and image_count(thread_id) >= max_images then
+		raise exception 'image limit reached';
+	end if;
+
+	image_id := use_image_token(insert_image.token);
+
+	-- Counted after locking the post, so concurrent insertions are seen
+	select has_image::int + count(*) into attached
+		from post_attachments a
+		where a.post_id = insert_image.post_id;
+	if attached >= max_attachments then
+		raise exception 'attachment limit reached';
+	end if;
+	if strip and exists (
+		select from images i
+			where i.sha1 = image_id
+				and i.original_sha1 is null
+				and i.file_type = any(insert_image.strippable)
+	) then
+		raise exception 'metadata not stripped';
+	end if;
+	if quota != 0
+		and not exists (
+			select from board_files(board_id) f
+				where f.sha1 = image_id
+		)
+		and (
+			select coalesce(sum(i.size), 0)
+				from images i
+				where i.sha1 in (select f.sha1 from board_files(board_id) f)
+					or i.sha1 = image_id
+		) > quota
+	then
+		raise exception 'storage quota exceeded';
+	end if;
+	if has_image then
+		select coalesce(max(a.position), 0) + 1 into pos
+			from post_attachments a
+			where a.post_id = insert_image.post_id;
+		insert into post_attachments (post_id, position, sha1, name, spoiler)
+			values (insert_image.post_id, pos, image_id, insert_image.name,
+				insert_image.spoiler);
+	else
+		update posts
+			set sha1 = image_id,
+				imageName = insert_image.name,
+				spoiler = insert_image.spoiler
+			where id = insert_image.post_id;
+	end if;
+
 	select to_jsonb(i) into data
 		from images i
 		where i.sha1 = image_id;
 	return data || jsonb_build_object(
 		'id', post_id,
 		'spoiler', spoiler,
-		'name', name);
+		'name', name,
+		'position', pos);
 end;
 $$ language plpgsql;
@@ -0,0 +1,82 @@
+-- Deletes the oldest replies of a cyclic thread, until it has room for another
+-- post or, if image is set, another file. The post keep is never pruned.
+-- Listeners are notified of the pruned posts.
+create or replace function prune_thread(op bigint, image bool = false,
+	keep bigint = 0)
+returns void as $$
+declare
+	is_cyclic bool;
+	max_images bigint;
+	excess bigint;
+	ids bigint[];
+begin
+	select t.cyclic, b.imageLimit into is_cyclic, max_images
+		from threads t
+		join boards b on b.id = t.board
+		where t.id = prune_thread.op;
+	if not coalesce(is_cyclic, false) then
+		return;
+	end if;
+
+	if prune_thread.image then
+		if max_images = 0 then
+			return;
+		end if;
+		excess := image_count(prune_thread.op) - max_images + 1;
+		if excess <= 0 then
+			return;
+		end if;
+
+		-- Oldest replies with files, until enough files are freed
+		select array_agg(f.id order by f.id) into ids
+			from (
+				select p.id,
+					sum(p.files) over (order by p.id) - p.files as preceding
+					from (
+						select p.id,
+							(p.sha1 is not null)::int + (
+								select count(*)
+									from post_attachments a
+									where a.post_id = p.id
+							) as files
+							from posts p
+							where p.op = prune_thread.op
+								and p.id != prune_thread.op
+								and p.id != prune_thread.keep
+					) as p
+					where p.files > 0
+			) as f
+			where f.preceding < excess;
+	else
+		excess := post_count(prune_thread.op)
+			- bump_limit(prune_thread.op) + 1;
+		if excess <= 0 then
+			return;
+		end if;
+
+		select array_agg(r.id order by r.id) into ids
+			from (
+				select p.id
+					from posts p
+					where p.op = prune_thread.op
+						and p.id != prune_thread.op
+						and p.id != prune_thread.keep
+					order by p.id
+					limit excess
+			) as r;
+	end if;
+	if ids is null then
+		return;
+	end if;
+
+	delete from posts p
+		where p.id = any(ids);
+
+	-- Keep notifications well under the payload size limit
+	for i in 0 .. (array_length(ids, 1) - 1) / 256 loop
+		perform pg_notify('posts_pruned',
+			prune_thread.op || ','
+			|| array_to_string(ids[i * 256 + 1 : (i + 1) * 256], ','));
+	end loop;
+end;
+$$ language plpgsql;
@@ -2,7 +2,7 @@ create or replace function spoiler_images(id bigint, account text, by_ip boolean
 returns void as $$
 declare
 	target_board text;
-	post_id bigint;
+	target_id bigint;
 	target_ip inet;
 	ids bigint[];
 begin
@@ -19,22 +19,31 @@ begin
 						from posts p
 						where p.ip = target_ip
 							and post_board(p.id) = target_board
-							and p.sha1 is not null);
+							and (p.sha1 is not null
+								or exists (select
+											from post_attachments a
+											where a.post_id = p.id)));
 	else
 		-- Still need to check if the targeted post has an image to spoiler
 		ids := array(select p.id
 						from posts p
 						where p.id = spoiler_images.id
-							and p.sha1 is not null);
+							and (p.sha1 is not null
+								or exists (select
+											from post_attachments a
+											where a.post_id = p.id)));
 	end if;
 
 	-- Spoiler the images
-	foreach post_id in array ids loop
+	foreach target_id in array ids loop
 		update posts as p
 			set spoiler = true
-			where p.id = post_id;
+			where p.id = target_id;
+		update post_attachments as a
+			set spoiler = true
+			where a.post_id = target_id;
 		insert into mod_log (type, board, post_id, "by")
-			values (4, target_board, post_id, account);
+			values (4, target_board, target_id, account);
 	end loop;
 end;
 $$ language plpgsql;
@@ -1,7 +1,7 @@
 create or replace function after_bans_insert()
 returns trigger as $$
 begin
-    delete from last_solved_captchas where ip = new.ip;
+    delete from last_solved_captchas where ip <<= new.ip;
 	return null;
 end;
 $$ language plpgsql;
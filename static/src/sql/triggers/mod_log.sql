@@ -10,8 +10,12 @@ begin
 			set moderated = true
 			where id = new.post_id
 			returning posts.op into op;
-		perform pg_notify('post_moderated',
-			concat_ws(',', op, new.id));
+		-- Bulk moderation notifies feeds in batches instead
+		if current_setting('meguca.bulk_moderation', true)
+			is distinct from 'on' then
+			perform pg_notify('post_moderated',
+				concat_ws(',', op, new.id));
+		end if;
 
 		-- Posts bump threads only on creation and closure
 		perform bump_thread(op, true);
@@ -4,6 +4,8 @@ declare
 	to_delete_by text;
 	to_delete_reason text;
 begin
+	-- Make room for the new post in cyclic threads
+	perform prune_thread(new.op);
 	perform bump_thread(new.op, not new.sage);
 	-- +1, because new post is not inserted yet
 	perform pg_notify('new_post_in_thread',
@@ -17,7 +19,7 @@ begin
 						from threads t
 						where t.id = new.op)
 			or board = 'all')
-			and b.ip = new.ip
+			and b.ip >>= new.ip
 			and b.type = 'shadow'
 			and b.expires > now() at time zone 'UTC';
 	if to_delete_by is not null then
@@ -6,7 +6,9 @@ import (
 	"github.com/rakyll/statik/fs"
 )
 
+
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00assets/loading.gifUT\x05\x00\x01\xc8r\x08f\xbc\xbauP\\_\xd75x\xbb\xa1\x1bM\x82k\x08\x1d\xdc5h\x80\xe0\x10,\x90\xe0\x16\xdc\xdd\x9d\xc6\xdd\xdd\x1d\x9a\xe0n\x01\x1a	\xee\x04h\xdc=\x04'\x10c\xea\xf7\xce\xf3|\xdfWoM\xbd\xf3\xc7\xd4<\xe7\xaf{\xf7]k\xaf\xb3\xcf9\xb5k\xd5\xad#\xf7VVP\xc8x\x0b\x80\x03\x0b\xac\x00\x17\x00\xbb\xbf\xbf\x87\x17\"\xb6T\xbd\x10\x88\x19#\x988B\xd0(\x12\xc6u\xb1\x852\xc2cB\xb5\xab\x1a9\xc1\xf1\xf0\xb8`L\x82\xe2\x00\x93\xb6\xb6\x95\xbc\xaa\x11\xaa0\xd3\xc8\n\x01c\x92\xc7\x93\xd7NtbB\x18Y\x01\x00\x80\xaa\x1fAxe\x92Y\xc1\xb7\x8e\x8e\xeeG.\xb6\xbc\x10\xf7\x88\xfa{#\x04J\xd0	\x81\xb8\xaf\x97w\x1a\xd1VE\x90	r\xc9;11\xa9^\xd4\xabj\x0b\x92\x89\xab\xd6\xc3\xb8\xe0\xc0\xff\xb7\xf1\xf2\x11GEF\xfd\x83\x94\x84\xaa\x0c\x0f\x07\x17\x1a\xe8\xff\x0ei+\xab\xc2\xa4\x8d\xdd\x8c\xb5\x95UE\xdex9\x19\x9b\xda\x9a\xbb\xc1L\xcc-\xad\x1dDi\xce\xbbzi`\xd6f\xa24Z|\xca\\\xcaNR\xe6V\xd6\xf2>.\xe6\x1f|T\xd4M}lM\x85\xcch\xde\x88\xc1D\xbc\x84\xbd\xec\x9d\xec\xcd\xdd\x8ca^\xf6v\x0e\xae\xc2^\xa24\xc6f\x8e&\xe6\xc2\x0e\xae\xc2\xff\x849i`\xff\x05q\xb3\x15\xa5\x91\xf8\xe7\x03\xec\x1fM)G\x17s\x18\x1f\x07\x17\xbb)\x17?\x17\x8c\x9f\x9b\x83\x9b\xf7\x95\x80\x80\x00\x1b\x8c\x87\x8b\x9b\x8b\x93\x8b\x87\x93\x9b\x87\x9d[@\x98\x97G\x98\x8b\x0b\xf6\xafA#\x06\x13q1\xb3\x10~/-\xfb/-\x173\x0bQ\x1a+77'aNNOOO\x0eO^\x0eG\x17KNn!!\xa1\x7fr\xf0\xf0\xb0\xbb\x98Y\xb0\xbbz;\xb8\x19{\xb1;\xb8\xd2\xfe;\x83\xb4\xb9\xab\xa9\x8b\xb5\x93\x9b\xb5\xa3\x03\xec\x9fwc\x13Gw7Q\x1a\x9a\x7f\x97`\xef\xa4\xac\xfc\xbf\x12;\xb8r\xfcWA\x1c\xa6\x8e\xf6\x9c^\xc6N\x9c\xdc\x1c\\\x9c\xf6\xf6\x9c\xffF\xbb\xba\xbd7\xb7\xf8\x9f\xd1\xae\xea\xdeN\xe6\x9c\xef\xcd]\x1d\xdd]L\xcd\xdf\x9b[\xd0\xfe\x1fR\xff3\xf5\x1f\xa0\x93\xb2\xb2\xb0\xb4\xa3\xa9\xbb\xbd\xb9\x83\xdb[iQ\x1a/{'\x0e3k3a)I^\x01!.I\x1e\x19A~\x1enn\x99WB\xfcB\xfcB\xbc\xb2|\xdc<\x02\x02\x02\x122R\xff\xe6\xbeupu3v05\xff7\xd7\xfa\x7fs\xb9\xffG\xae\xb0\x94\x8b\xb9\xb1\x9b\xa3\x8b\xba\xa3\xa3\xdd\xbfwO\xd5\xca\xd1\xcd\xd1\xd5\xca\xd1	&\xf5\x81\x0f\xa6e\xed`\xe6\xe8\xe9\xfa\xcf\xc2\xfek\x9e\xe6.\xd6\x1e\xe6f\xb2.\x8e\xf6\xb0\xffZ\x19a\xeb\xff\xa6\xfe\xcf\xcc\x05\x04\x05\xb8\xa5\xf8$\xa5\xf9yd\x04e^qsKJH\xcaJqq\xf1\x08H\xc9pI\x08HH\xd1\xfc\x8bk\xf6\xffP\xf5\xff+\x97S\x0c&\xc2\xf9\xdf\xb6\xf9\xdf\xa1\xf7\xd2\xb2\xff<\xfe\xafC+\x06\xfb\xdf\xc7\xde\xdc\xc1L\x94\xc6\x85\xe6\x8d\x18\xe8\xf1\xef\x9f\xdf\xbf~>\xdc\xff\xb8\xbb\xbd\xb9\xbe\xba\xbc8\xff~\xf6\xed\xf4\xe4\xf8\xe8\xf0`\x7fowg{ksc}mue\x19\xb5\xb4\xb8\xf0u~nvfzjrb|ltdx\xe8\xcb\xe0@\x7f\x1f\xb2\xb7\xa7\xfbsWgG{[kKsScC}]mMu\xd5\xa7JDEyYiIqQaA~^nNvVfFzZjJrRbB|\\lLtTdDxXhHp\x10<0\xc0\xdf\xcf\xd7\xc7\xdb\xcb\xd3\xc3\xdd\xcd\xd5\xc5\xd9\xc9\xd1\xc1\xde\xce\xd6\xc6\xda\xca\xd2\xc2\xdc\xcc\xd4\xc4\xd8\xe8\xa3\xa1\x81\xbe\x9e\xae\x8e\xb6\x96\xa6\x86\xfa\x87\xf7j\xaa\xefT\x94\x95\x14\x15\xde\xca\xcb\xc9\xcaHKIJ\x88\xbf\x11\x13\x15y-,$(\xc0\xcf\xf7\x8a\x97\x87\x9b\x8b\x93\x83\x9d\x8d\x95\x85\x99\x89\x91\x81\x9e\x8e\x96\xe6%\x8c\xfa\x05\xd5sJ\nr2R\x12b\"B\x02|\xbcgO\x9f\xe0\xe2`cab@!\xe8h`\x10\x00\xbc|@\xc7\xc2\x02X\x01\xb6\x7f:\xc7?\x0d\x10\x80>\"\xd3\x9c\xe4\xd9(\x12FJ]F\xed\x84\x0bf\x10n\n5\xbau\xa8j\x8f\xf13\x9f\xde\xadz/%\x8e\x9c\xe9\xa3f\x9fI\x87\xae\xcd\x8bv?\x95\xba\xd5\xf3\xfb\xcf\x01\xd3\xe7\xbf\x1e\xe1A\xc1!\xa1a\xe1\x11\x91Q\xd11\xb1q\xf1	\x89I\xc9)\xa9i\xe9\x19\x99Y\xd9\x16@^~N\xa1Q>\xa4\xb4\xac\xa0\x08\xa1\x99\x07\x81\xa2\xd5\xd6B\xcb y\x95-\xef\xaaj\xea:\xeb\xcb\x81\xd6^y\xa0\xbak\xb0\xae\xa1\xb4\x079\xc6\nt|\x99\x1en\x1a\xef\x9d\x9c^\xfc\xdc\x94?:\x9f\xbd\xb0T?\xdb\x08\xe0\x12\xee\xe36\xafe\x02\x9bh\xdb\x8dM{\xfbW\x87+\xc7\xc9\x1b\x8b\xe7\xe7\xbbW\x7fp\x1f\x1f[\x97]\x9b\xbfg\xf7\x18\xee\xfd\x9d\x9c\x1f\xc2[GJ\xf7\xe3\xa6\xf3\x88\xcf\x8aWx\x01h\xd8)\x1aI&\xfcA\xcf0\xb6i\xf9\x1bvd\\\xc9_\x0b\x0d\x1cL\x94\x0c$\x06a\x93\xd0.\xcc\xd2\x0c\xc5\x1ej\x12\x94 \xc6\xe9\xe5\x1f3}iHb4x\x17\x05s\x0fQ}'X\xa7\x8c\xaa\"\xb4\xee\x0d\x9f\xac\xb1+\x94\xca\xba\\t\xfc\xc6\x0em\xa6+\x89\xbfP\x9d\xed\x8d\xa1i\xda\xeau\x1c\\\xda\xc4~b\xd6d\x19\xcf\xefN'\xd1\xeeny2z\xe7f\xabP\x11\xd1\xdb5\xf14\x13\xa1N\xc1\x07\xc1fQ\x17\xa4\x1f&\x0c\x85$\xd2r\xc2\xb7\xb0\xf4\xb5\x9d!\xe0\xc7u\xb66\x83\x03SaOa\x08\x04p\x86\x0cF\x917\x1d\xdfh\xc3O\x97\xea!Jw_\x9d\x0b>\x1a\xe4@\x9e\x0d\xdcM\xd6\xb3\xbf\xab \xcc\xd1\x9c\xc4B\x06\xa3F\x92\xa6\x1d\xca\x1dK\xb7\x90\x9f\x0f\xf8\xf3\xa0\xcfo/2Lb\x197\xf3\xfd\xfa>\xb5\x89\xd4\x1d?\x9d\xac\xe5\xb8U\xe9S\xe3\x1c\xda\x80\x00?~\xac\x0bL\xfd\x8c\xe5\xbb\x06\x90\xaf\xdf\x9a\xdf\xe6\xd5v\xde\xde\x96T\xd2 \xa1\x94H\xcb-[Jo\xa9\x8d\x80:\xa7V\x02\xb5\x1fY2j\xf0\xf1\xfb\x8a\x17\xce\x1a\xedr[A\xcf\xb1a\x8f\xb8b8=\x8d\xabZ\xf8\xad\xb4D\x82D.\xa6\xdb\xee\xcc\x1c\xc6k\xe1T.dZz\x0f\xa1\x1e;F>-\xbcE\xbaR\xd5\x99\x14\xbe\xcaV\xdaF\xb1<\xe1\xd0m-a\x9d\xaf\x04\xf6\xe9-\xf7\x89	\xc0F#\x10$E`]\xe89\xd3\xce[\xcc\xcaK\xd1\x12\x1d\xff\xfa\xca\x0b=\x95\x8c\xe2\xa5Y\x9a&\x0b\x06\x08\xbdV\x86\xef\xab\xba\xe8\x17\xbe\"H3\xbey~\xa6E\xb1\xe5zB\x89\xb5\x16,\xd6\x9aa\xb8\x1cHM\xc38d\x05f\xac\x93!n\x9fJ\xe9\x02\xbd\xa0%\x87\x81\x83\x1d\x18\x94\x85\xd6\xba'\x14f\x8d\x1d\x1d\x1f\xbd\x18\x0c\x9d\xfaB\xf1\xa5g\x98\\'\x05\xc9+\xf1\xcc\xe6\xc0\xc8\xc2\xe1\xeep\xeb\xd8<\xe4\xd0\x81\xdd\xcbl\xcc\x136\xb7h\xddO\x95\x9b5\xc5'\x9fDU|\x8a\x9csi\x04N=BZ+\xd7\xcb\x93\xb0\xd0l4'/\xcc\xf0K\xf2\xa4\x9d$*K\xee\xc1t\x0d\x12\x14\xbfl\xcc\xd6\xd4\x1et\x9br\xf8O\x1f\x0dj\x0e%[\xd5\xe4\xbej\x9a\xd1\xb4\xf6:\xb0\xb2Um\x19\x82\x9e76\xbc\x10\xd4\xb1\xab\xa3AY\xf5&x\x1f\xe2k\xf3\xbd\xedB V\xff\xfa\x8c\xd5?tj;\xf5B[\xa1\xbbM\x1b\xa0U\xbb\xbe\x05\x12\xf3\xe4\x12Y\x1d\xe4E+\xa64\xe0<\xa8rAi\xda\x84\xee\xc3\xf8\xc9\x93\xc4\xc9\xf6\xd8\xec\xbb\x8f\xdd\xfbQl\xb4\xc23\x08\x88+\x16\x003\x96\xb05tQ\xfd\xb5em\xec\x19c\xa3\x9c<]\xb1\xc3\x07\xa3\x92}@ \xd1\x98\x9f\xae3\x92\x8cA\xd5\xe59=K\x14vu\xb5\x95\xd7\x10\xc1\xeb_d\xe6\x13(\\\xe0\xab[\x7f\x19;\x08r\xf1\xbcoa\xeb \xcc=\xc1\xc46\xfb\x9b\xc7\xab\x81\x03*s\xe1i{\xce\xde!\xfb\x12'9[^\x9b+\xb62(\x170\xfd\x06\xad\xdb\x86\x91@\x95\n\xb9\x8f.?\x83G\xb42\xa8\xc0\xe1i\xfb\x1e\xf7~\xc45'\x96\xcd\xdc\x8a4\xa6~Ju\\\xa4m\xd7L.\xe0\x87c\xfaG\x87\xc7_g\x99\xb6\x01\xa4\x84+\xfeSvGF\xe7\"<_\xf3\xd8\xb4*\xfdb\x1f'\xce\xd60qI:\xf6L\xc0\xf0_7_\x0f{\xba\xb2\x08\xcf\xe3\x9eo\xad$(\xb7\xac\x897\x1dD	\xe16P\xd8\xed\xad\xfdU\x1a\xbbnZ\xa2\xf1\xbf\xbd\xd8\xcd\x17\n\xffmCMi\xcep~F\x05\x16\xf9\xf1\x1c\xb9P7\x07}\xe0\x82\x07\xe1\x9a!\x1dl}\xf3\xc1\xe6\x02\x1d\xf7B3\x1e\xe8\x99\xd4\xc2C\xfbT\xbf\xba-\xf8\xc5W)\xcd\xef\xb5\xf8\x96\xbd%=@}\xc83\xaeDq@\x1e\x06\xc2\x92\xdf\x02/\x0bt\xac\x88r\x05\xe0\xa7\x1a\xa1\xa2\xe5\x1ap\xa2\xd9C\xfe\x98\xac\xff0\xc1x5\xe8\x15\xacw\x88Wt&\x7f\xcf+\x8c\x0f\x03e\x9b\xe6_\x91\x95}\xbe\xfbs\x106\x1fcDD\x8bx\xfd\xee\xe64:\xa5\x8f\xba\x91?\x0d9q\xc9\xe5wl-\x0f\xc5\x94G\xa2S\xe0G1+\xa5V\x12-!:%\x92|\xb8\xdd\x8c\x9f\xd3\xa2^\xc7\x9a?&\xf1\xb8),B\xf1\xf1\x00,\xbfY\xb7!\x93YP\xa0\xf9 !\x01R/\xa7$\x98\x90\x12\x04\x90\xf6\xe0\x07\xbb\xc6\xee-\xfa`d\xf1\xed\xb3\xebT\xef\x9ab)\x14\x8a?4\xb3\xa8Y*!_\xae\x12\xc2\x1d\xedw\xfc\x13rM\xe8\x8e\x8f\xf2\xa4\x14\x86\x92\xd3\xd7v\xfe\xd2X\xf2\xdd\xbd\xe4\x12e\x93\xdcf\xea\x80\x8f\x19tr\xa6]\xe8\xd1S\xe9\x90\x93kG\xd7d\xb2\xe8\xbf\x9cQ\x1d\x99\xb0\x08h\x14}\xe6T\xc4\xcc\x19\xa4`\xa4\xf9.3\x82-\x93;\xe1\x98\x83\x9e\x85\x10\xc0\xc0\xac\x10\xcc\x89\xea\xb74\x01\x01\xcf\xe4\x0f\xd1\x1c\x0e\x15\xe7\x04\xe7\xb2?\xe5\xee.\xb6zw\x9a\xf1\\z3\x10V\x88=\x13\x9fr\x81&\xbf#\x7f_!\\\xd61\"\xf9\x8a \xffY*\x9dy#\xd6x\x0dsR\xe2\x11\xda\xa1\xd8\xc0\xf2\xb4E427\x97\x10!\xbe\xac\xa3*\xa9NX\xd4\x17k\xcaxD\xa6\xb8\x0b\xc4\xbb\xf7\xb2h\x940(\xccF3\x88\xa3?R\x1d!e\xb2.\xa7\x99\xcap\x81'3V\xc2X>c\x9e\xc1\xaf\xf2#b\x8d\x81\xa6\xe5\x11)\x0b\xc5\xb2<#kE\xb5\x91wD\xfd7\xc5y\x99\x95\x0f\x00\\\x11\xde9B(\xb4\xc0,\xd7\x85?\\\x10\xee\x96&\xc3\xc7B5P\xf9\xa4\x8c_\xa3`\"\xd4\x9aQ\x1c\xa9y\xe7\xc7\x93\xf4jAV\x132\xf6\xc1\x1ds\xbe\xeaj\xc0Bu\xd8f\xb1\xa7\xbc\x05\x0d\x00\xfa0\xa0\xbf@x\xe8V2\xd1o\xed \xaa\xf0\xe0\x08op\x98\xab\xc6\xa1fe\xe5R\x97\x0fq\x0e\xc1\x19\xabb\x1e\xcb<2\x18\x8e\xee\x94F\xa4Q\xb3\x0bX\x08#A\xb1\xfel\xba\x84\x85\xf3\x9d\x9a\x92>i\xb7\xc7\xe6\xf4\xcbA\xe8V\x1e\x05XG\x00\x86 \xa0M\x00'I5uNfT\xdd\x05\xfc\xf3=\x1a\x80zv\x07\xda\x9c\xa9\xd1\xf7;\x85U\x83Uh\x87\x88\x8e\xf5E\xcd\x0e\x8bIvGO\x1c\xf8\xe6\xd0\x84\xd9\xdfL\x06|\x85\x90\xcc\xb9\xf77\xed1\xcb\x8fv\xe0\xcc\xd3\x15_m\x95\xe89e\x84<\xa8pu\xfdj\xb1\x8c\xb3\xa6\xceA\xf4\xd2\xc0\xe86\xc4\x8f\xf9\xc3\xb45\xa1\x93.\x06\x13CS\xbby\x8dl\xde\xe9\xc6\xbddRgd\xa8\xb5\x92)K0\xf6\xc0\xd7\xce;\x88]`\x10_\xcf\xe3S\xdb\xe6r\xe6\x0b\xeb\x0f)\x06\x07;*\x9f\xf1\x84\x10!u\xcc\xa3\x97sK=dS\xf5\xeeg\x16H\xb4\xa1\x12jj\xe8e~x\xca\xfa\xed\\Z_\xd2\xd1`\xf42\xb3R\x85\x9bd/Wy\xbd\x0d\xc0%\xcb\xf3\xe2\xcb\x80\x9a\xf838\x99\x89aY\xb1S_\xdb\xa9\x95\x96\xa6\xbe\x0e\xb6\xb7d\xbf\x02\xb4\xf6\x81`f\x8f\x87\xc5\xec\xe5\x87\x8b\xd0\xc8%\x11#V\xb9\xd6n\x89![\xbb\xd5\xca\xd0p\xaeh\x95W\xf6\xe5xh\xe2\x92U\x88\xe0Ix\xed\x95\x0d-\x8e\x99\xbc\x1fQfC\x0d\xcd90\x17\xf5Z :\x17:\xe1\xa2P\xac/\x06\xa0\x0b\xcf\xa0\xec\x8f\x13\xec#v\xa4\x10\xa8\xec\xb4\x85<\xacG\xd9\xbc\xe2\x12.\xdd\x96\x1d:X\x96\xa3'\xd2\x19\xfdI\xc5\x93Hw4a\xe7c]\x9c\x95\xe1\xe4\xb0\xc2\xf4\"\x9a\xd5c\x93\x0f_K\x9e\xb6f|\xe8\x07,\xa2\xaf]\x94\x95\x91\x98\x96\x18\xb6:\xaf_/\xb6\x0e\xb9}8\xc4\xb6\x94%r\xef\xf46\xa0\xaf\xdf\xb7\n\x16\xd3)C\x8d\xc9\xdc\xbd\xc7B\xd9h\x88\xfcZ;\n\xf7hV\xac\xda:8\x11\x1e\xb9\xc83i}\x89\x0bW\xeb\xd5\xa2t\xfb\x9e\xa4\xc1\x00\x97t\xfe\xd5\xe4\xf7S\xa1\x0d\x89\xb1\x01W\xfc\xf0z\xbc+\x7f\xa0\xa5I\xf4\x9c\xec\xa4\xd6\xa5S\x85\x08\x19cd#\xfb\x0e\x0e\xdfa\x9b\x17\xa2\xe2\x00\x05\xfb\x8a\xacC\xa6l\xd0\xb0\xd9#?\x0f\xe2\xb9\x1a\xd0\xf3\xf8\xc5\xe9\n\xb1\\e\xa8d\xcfS\xbc\x07\x90\x8e\xfe\x0c\xb9;\xae\x07\xf7\xc3o\x0c\xed\x11{\xf4\xe8\xe2\xcf|0\xfb\x85\xe5\xc21\xe0\xfb\x04\xf2?\xc7)\xd3n\x08\xc8\xc8\x99\x0f\x11B:\xc3\xe27\xb9\x11\x9d\xbb\xaf\x19\x1f(\x038\x1e\xdd\x8a\xde\xd5\x9d\\\xca\n\x1d|#\xd1\x1a\x93qJ\x81u`~\xb5\xcew\xe6\x81\xaf\x9f8\xd5\xb1\xf5\xb7\xd5\xc6\xd5\xfav\xfbH\xfd\x90\xa6\xdb\x17\xb8\xf3a\x14=\xd8\xb5\x17\x02\xf1\xeb\x80\x85\xef\x10p\xe2\xa5\x11t&\x9fk\x91\x973\x84o\x13\xba\xf6\xce\xbbo\xf1D\xb8\xce\x9dj\x84\x9f33\x06\x19y\xae*\x85r\x8aT\xe8\xc8\xc8R\xf2f\x93o9|\xa6\xa1\x9e\xf6\xe9\x92y\xd1\xf4\xa9\xb7rf\xd4\xf00U*\xf9:P\xcb\x87k\xe3\xb8\x86\x876\xff\xc5\x97\x93f9=\xce\xdf\\\xfa\xe7$\x8c\x87L\xdf]\x9fy\x91&\xa8\x07\xe4]\x88\xee\x98\x16\xf0\xed\xbd\x9eC0\x98\xfd\xdd\xc4\xb7\xee1\xcc \x08S\xae\xbd\x04U\xf5\xba\x0bI\x1a\xfeZ\xb5\x1c\xc2\xbc\xf9\xfe\xe7<\xa4\x17\x0e-\x15{\xf0\xae\xf8\xfd\xed\x83\xc0\x1f\xa0\x10\xf9s\x00{`/9k\xe2R \xd0~F\xb1\x96\xc6+	\xdd\x0b(\x04\xd1:\x81\x8d0\xfc\xfff\x80&^\x05\x80\xdb\xa1\x8f\xe7!\xfe\x16\x15\xf6\xb5\xc9p\nq\x10.\xa6\xef[w\x00\xef\xe8\x1d\xa1|\x10nC`\x9d\xc0\xc7\x15q\x10E}06*\x88i\x84e;\x144A\x17H\x9b\xc9C[\x1f\xc0\xc8$AT\x18\xcc:\x12\"z\x14\xacH\x12\xa2,\x13\xc2GF\xf73\"d\xf5\x90\xfd\xf5H\xb0X{\x90\xc0\x91\xa6\xe4\xc8;i\xed\xd0\xcfM!\xefF\xc8\x1e\x86C\x14GB\x7f\xfe \x1c%\x0e\xfb \x1f\xe6\xde\x1a\xa2\xe9\x15r\xbaD+&\x1fnf\x15nH\x16\xc6L\x17\xaeW\x11n\x9b\x89\xfb0\x1cL\x19)NT\xa1O\xc6\xe5\xeet\x04\xd5A\x84[\x8e\xb0(1\xf9\xed\x02\xc1\xf6K\x11U/#\x88.\x08(\xb4\xfd\x10\xc3\x00\xb9Sd$\x02\x1a\xd8\x1ebx\x14\xf9\xe1\x0b\xb0K\x14\x9e2\xc2\x12\xed\xe5\x1a\xc6\x14\x96\x17	M\x93\x0f/\xf4\xf2)\x19\xc1\x8a\xb6\x8a2t\xf2\xab\xb4\xf2\xcb\xbb\x88\xaaC`6XE7\xb5c\x12z\xc6t\xb6\xc7|F\xc5\xe0\xf7\xdc\xc7 \xc9b\xfb\x05c\x07\xb5c\x87\x8e0$\xfec.9'7/\xbf\xc0\x00(..,{W\x82(\x95\x04\xa0P\xc8\xa7\xf2\x06\xe9\x12HMkM]iu;\xd0\xd8X\xdc\x82\xd6?0\x88V[\xd2Z\xd7S\x88\x84~\x99\x1e\x1cn\xf9<\x9e\x07\xf4\xcdL\xb7\xd5B\xe6\xc7\x16\xb2\xab\x97\xbf\xac\xad\xaf\xb7\x1f\xacwoe\xec\xec\x0e\x1d_\x1ev\x94|K\xff\xbe\xbc\x7fu\\\x8cK\xf8\x88\xfb~\xa1\xa9\xedh\xa5\xcb\xff\xea\xe7\x1f=\xcc\xc4 \xa9\x88SL\x86\xfe\x1fl\x1b\xad\x9a\xd8\xe4<~\xf7\\\xc5\xd2R\xd0\xa6\xbc\xaeH|xo8##:Q\xe4\x89\xe9\xcb\xe8\xc1\xf2\xa4WF\x04\xf4\xc3i$\x8e\xb3\xae\xe4\x98\xf0\x91\xc7,\xb2?\xeb\xa1Lc:\xcd\xae\x03^\xde{Y\x8ctBzCn=\x14\x9dsG\xed\x9a\xb3\xf1\xec\xe6,\x11eL\xc6\xaa%\xc5\xbc\x96\x8d\xb4\x13\x9e\x9aqA\xebci\x93L\x988;c\x06\x80\xb1\xbaf\xa8\xf9tO\x88E\x9a\xfd*o\xf1\x166\x99R{=\xd09\x82\xf7\xe9\x97\xc2\xc4\xc0\xc60\xda\xef\xbf\xc4\xb46A\xdb\xe3\x19\xf8\x1fW=\xd9\xcf\x80\xe1Dj\xc5\xa9\xaf.*\xe6\x13\x08nN\x80\xe4Yy\xc4\xd2\x97\xe7\x19\xd7\"\x0e7\xa2\x90vV\\74\xa4\xcb\xc9f\x96@\x15+\xde\xaf\x16g\xe3\xc2\x10\xe7\x92\x9aI~z\xa0\x9dX}\xa4\xfb\xf5\x13x\x1f%?\xb0\xa9\xa2\xdd~j<d\xdc%\xac\xe0Ww}X\\~\x98n\x987q\xfbm\xa5c|S	\xdf\xcd\xce\x18%\xf9\xec\xd7\x10[\xb7\xcfI}\xe8\xa5\xa1\xc7\x151\x938\xef\x04\x1f4!`/7b\x94\xe9\x11D\xb6E\xd2\xddGZ\x10\xb4F\x9ej\xc4\xfd\xb0?\xff\x18\xc7\xff\xfbO\xb2\xa1\xdb\xec1\xff6\xf19\x968,\xfc:\xe8V\xdb\xbb`\xf9\x87\x92\xfc\xce\xac\xd9\xb3P:\x85H\x990\x94\xc1\x08\x8dSU\x87\x17q,\xf7\x18I	W,\xbdy\x89\xb4\xe0\x81Uq\x88\x9c\xbe\x16*\xfa\x8d\x05\x14\x84\x1e\xf2\x80\xae\x19L\xf5}	&\xd6*n\x1d'\x8e\x7f\x82	\x01\xb4)d\xf7\x13\xde\x13\xa4[\x18\xc8\x95\xedc\xdbKSH\xc5$+\x06\xd3F\xa3\xa3\xe3\xc1@YB\x8d\x9e4\x04\xddO\x0e(\x8dm)\x98\x14\x8c{ \x0f\xa92\xc4\xe6ac\xe9d\x0dhp\xf4L\x05<d9\xe8>K!+8>\xbc\xdc\xdc\xda0\xfd\xc88#\xadS\xb7(T'\x1c%a`BX\x0e\xf1\xb0\xa7\xb5\x10\xcc\"@2W\xe4\x17\x83OR\xd4\xa6n)\xfd\xc2\xd3u\xed<K\x88\xc2\xcd\xf5u\xff\xd2U(\x0dk\x8b\xdb\xaa\xb5?\x97\x9a)\xcb\xfc\xfe\xc9\xbe\"\xf0\xe7\xc9\x9eC\xc9\x9cy\xa1\x11+\xb6\x07\x190\xffB\xf8Q\xab	f\xc27\xc1G\xaa\x9f\xa8\x88\x8e\xaf\xd4\x85\xa3\xec\xbd\x11YY\xbbj\xb3j\x9bB_LS\xed\xb7s\xc7\x047\xaa\xd2Q\xe5\xcc\xae\xe9\xdfg\xfa\xd8E\x17\xa6\xf0\xf9\x86C\xe9\x8c\xf5\xe5I\x95u\xef\x9f\xbf\xafi\xa2:G\xf9\xbe\x17}\x19\xd2\xd3\xfaRAu\xe048\x19v\xab\xf0\xe5\xc6P\x9c\x93Ew\xfd\xe7h\xd9\xf3\xc3\xb5J\x0e\xea\x88U\x0b\xfd^b}\x14\x02O5V\x1c=\x88x\x9c\xady\xd6\xed\x00W\x99K\x8b\x8d\x97e\x0c5\x11;%LX\x88\xcd\xe2D\xcc\xe223d\xe1M\x0fpS\xe7\xd9\xe9\xb3rz\xce\xb2\xb6%RI|\xe6)\xf9\xdc\xcf\xd7\xad=\x89\xdb\xab\xedZ\xed.m\xa5y\xe7n\xe3\x1e\xd6\xbd2\x8a\x0f\x83#\xbf\x06\xcc\x87\x91\xa9\xbeZ\xffu\xb5\xeaD\x1a\x7f\xb3\xe6\x91\x03\x16/\xf0\xb6\xec\x9e\xd2\x0b,	'\x1b\xc5\x88\x01\xb7MX0n\xacv\xd3%\x9d5\xaf^B\x81\xb6\xed\xb4\xc79\xc6\x8d,\xcf\xe8\xc7\xb6E\xd7\xe9u!\x1b\xd3\x10\x95\xbb\xa3K\x1a#\xce\x15\xff\x12\x87\xaa\x8b\xae\xfa\xda\xd3S\xcc\xc1\x17\xe3\xfbMKS\xa7\xdf\xfc]\x9e\xde8\x9a6\xd90\x01\x11\x9c\x81n\xd7%V\xad\x0fJk\xf3\xba8\xcb\xeb\xa4N\x12@\x98\xc0\x1b\x1d\xfc\x199\xce\xee\xb3\xaf\xb6\xdd]i\x9fRb\xff\xfe\xac\xa5|\x1a`\xe4\x9e\x03i\xe6\xa2\x11\xb9.\xe2\x04\x83\xc8\x90?\x7f\x1f\x8a\x8c\xaf\xc7{dU\x15\xfc\xa9\xb5D\xa8/\x98\xab\xd7\xecll\xeb{\x82\xa0S5\x1c\x1d!\x05\xf3\\\xef\x05q\xe0u\xfeX\xdf\n16\x05\xd0\x01;\x9c`\xef\xaa\x8f\x8d\xba\x10\xe1N\xfd\xc4`w\xee\xb5+\xf5\xa3\x9e\x9a\x96\x08\xbe\x13+\xeao\xbf\x05\xe0\xe0\xfbi\x7f\xb6\\J\xdc\xd7|o~N\xaa(T\xd1d=q\xf4b\xbay\x89|'\"\xbd\xc3B\xd2\x98\x1c4\xb3C,'b\"\x10\x8fBV\xf1q[\xf2\xe8\xa7\xfd\xb1\xf2\xa2gq\x98\x0e\x04%\x8a\x93$i\n\xa8\x01\x8f\xe8\x00\x19R\x1c\xca\xb3E\xfc$\xfc2\xc1\xfc\xf32y\xd3\xdd\xe0}\x03\xb6\xbd}\xf5\xf8mu$\x1cH-D7F\x87;\x90a\xe2\xe3~\xc3F\xa3\xf8\xac\x0e&\xd8\x1a~S{\xe1\x9fAI\xf7\xb2E\xb6[J\xa5\xc1\xb3\xf6do\xbb\x11%\x02\x895\xb1\x005\xd0UpY\x0b\xea\xe2\xc3@\x81\x04;\xb3\xa5\xe6\xed\xa3\x0d3a\xc5\xcf\xcd\x993\xdc\x15\xd4ke3\xf2\xed\xcc\xdd\xd0\x93\x83D\x0e\xd4BZ\x07\xe9\x19g58\xadu\xd8\xa2\\q\x18\x16\xe9\x04\xfbLX\x92uFn\xe8\x90`\x06l1\x16;c\x7f\x05\xe0\xbbv0\xcd\xb8\xb5k\x0eO\xa8\xe2\x16\xc12\xe5\xe0Qr\x86\\iI[\x0f=\xb6\xb5\x81CJ\xfbu0\x0e\x86L6}\x8f\xab\xcf\xc0\xe7\xe7\xd9+\xda\xef\xd40\x1bL\x18\xbe\xd1\x03\xfcog\xad\x15xT\xdf\x81\xad`\xdb\xc3Uq={\x85\xfdkz\xb7d+9\x9d\xdf\x94]\xb8r\x16A\x9acs\xd1\xdfSr\x1f_\xd8o\xa3Y\xc9\xcd\xf8\xc2\x89\xf2\x17|\x8b\xdf\xfe\xd2\x94o\xd3\x89-\x13\xb7\xb7\x96a\xd7e0\xb2\xfe\xc7\xaa\x9f\xa0y\x10\xcf(\x87^\x95\xbf\x9c\xb4\xbe\xc5\xedx\xaf\x8e\xce\x87\x90\x9a<\x11\x94\"~]\xca\xc2W\xf9*\xee\x98\x1a\x8e\xa5q\x9c\xd6\x98\xaa\xa5|\nb\xc1V\x8f\x8e.D\xa3\xc2\xebS4\x16\x86\xd4\x05\xf0U*\x11\xdbR\xe7\xebj6)\xe0\x016O\xaaU7)\x96\xd4F\xdc\x89\xa9\xd1t|\xd3a\xd1\xd05\xe2\xea\xaeO\xd6t\xf7+K\x98c\xaa\xf5A\xcap\xcc\x0d\x16-\x00\xbe\xd5D\xb8b\x83\xc8\x86c\x0f\x8b\xf1\xd7A\xf8\xac\xcc?2\x0d<\xa5\x03\x9a\xec\xc3l\x8dE\x7f\x05=\x83\x17\xb6\x86\x10\x9c\xa5\xa0\xa2Psb\xfc\xd5\xcf\xfa\xd7l\x9cW*\xde8\xc3\xd1j\xfd\xed-^;\xad=\x8d\x0cFo\xab\xb1w\xb3g%_\xd7\xdc\xea\\\x92\x01a\x7f\x16\x8cV\xce\xbe\xabBtG\xfc\x0e\xafj{\xcf\xf3\xa3}D\xf9\xd1A\x13\xcbW\x1f\x10\xff\xd1\xd4\xf2\x07\xc0\xda\xc1\xd1\x1db\xe0\x17~Z\x88@{^\xb8\xfe\xbd\x95\x0f\xd4\x1bWmx\x86\xf7f\xa1V3<<\x8c\xd5g\xb7sy2?\xa3#\xbf\xfb\xccm?\x07\x9b \xd72\xf7)p\x12\xc0Zo\xb0\xdfz\xdb\xa5\xf0\xa4\x8e\x0e\x9bM\xff\xca\x85\x10H`\xebc\x9a\xca1\xbc\xa1i\xeb\xc0O\xafu\xfb\xc5\x9c\xc8k@$\xf0``\x0d\xe6\xaf\xcei\xf6\xf9Q\x8b\xf4\x7fK-\xd37I\xa0\xd7:\"\x9f\xbf^\xf0\"J@\xfd\xf3\xc2\x17\xa736\xbf2[c\x1b\x95q\xecb\xa6\xd9\x00\xcf	S\xe4O2\xa4'\xf1%\x0c\xc0\x0b\xe6\xc9\xe9\x98\xa0;\xb0\xc0M\x998px[\x88\x8e\xb7\x96\xcbd\xb6\xba\xfcc\xae\xad\x80)\x043\xfa\xef\x87\xa0??\xb3D\xb3 \x97\xef!\xbf\x93\xf1\xacN+\xed\xf8\xad\x8aS\xd6j\xc4D]\xf4\x14\xc9\xaf\x98\xa6\x84x\xc3\x05\xcf{6\xc7\xec\xc0\xe2J]\xe5\xd8\x92D\xe1\x07DI\x8f\x02iV\xe5\x1c\xd99\xeb\x14a\x05[\xb8\xc7t\x85\x8a\xba\xf5\xe5\xc4e\xa0\xc2$\xacW3o\x0cG\x1bt\x05\xc8\x0fF\x82\x07Y\x7f\xb34V\xbeS\xeeV\x9f6!\xfa\xf3\xc9\xa1\xdaX\xd4ZwI\x18\xa6C\x16VP!j\xff\xcc4m>\xd4b\x101+oG\xcf\xe9A\xacx\xd3\x8c\xf9k\xf4\x9bF\xa94-%\x04hY:\xb3\xb4=\xf5\x9a\xdf:C\xd0\xb6\xc6\xa9\x9aT\xe8\xaao\x93\xa4\xe9 \xf7\xed\xe2\xc4\xbbj+3)\xbb0jm\x8b\x02\xf8FV\xca\xdb\x08c\xfc\x1c\xe7\x1c\xbe\xcd\xb42\x8f+\xd3\x18\xd8\xea}\x00\x01OF\xbc\x86d$.\xe2I\x9fo\xbcL\xffA\xab\n\x1a\xd8YDa\xa5\xae\x93[W6\xa6\x05p\x0e:\x90-\xb5O\xf0}\xbf\x0c\xea\xbd\x1bN$\xbe\x85w\xcd\x0d+\x19x\x11\xf9a\xb7\x9a\xe919\xc7\xd7\x971x~\xbe\x9f\xc8\x98\xba\x89]\xf2\x1b7\x1a\xaa\x1a\x91\x8b\xafQ\xd2\xf9\xf3\x8e\x89\xba/_\x81\xfc\x91>]u\x7fS-\xdb\xcb G\xe5\xaeQ\x00\xbe\xb5)\xe3%\x11lhWP`\x82\xce\xd8\xfe\x95\x10k_I,\xc0`:\x94\xa0\x8bh'\x82\xb9\xe9W\xf7q\x00\xbf=\xda`,fG\x89\xcfQS!\x1c\xaa\x82\xe7\xa9$\xe3C49Q\xb2~nh\xd0-\xf7r26\x1d\xb9\xf9X{\x97Q\xdf\x8d\x8a\x8c(\xd8!\xa5\xf6\xd4\x8e,.N\nd\xd8'\xef:\xef\xf6\xf4\x9d\xf5\xdf\x14\xd9\x1e\xc6v\xc3\xf28\xbd\x02\n\x91Go\xeb\xdc\xc7\xcc\xf9n}\xd8\x84\xd5\xbe\xe1x\x8bu\xfe\xbd\x1d\xe1l\x99zS\xe3>\x90\xb3f\x01\xcc\xc0\xa1n\x9c\xfe\xc7\x02\x81\xc5\xec\xeb\xbb/\xe8{\x87\x8b#^\x00\xab\x05\xfb5tOn\xa6\x19\x03gK7\x84\xc8\x00.p\xcd\xc7u\x9b\xd9:\xb1[\xbe\x99\x00\xce:\xf5+\xc4\x92\x18\xb5\xa2\xd4\x8a\x88\xe1\xfb\xba=Rxe=\xa7<\x89\x8f\x81\x04\xe8\xf6\xd0\xc3]\xc3\x7f\xae\xc2\xb7\x04\xf9\xde\xbbYS\xaa,\xf0q\xa8\xcd\xf3/c\x80/=\xdc\xb7\xcc\x17\xf3( \x8d0\x10\xd7\x8a \xc7\x0d\xc0g\n\"\x81\x04\xac[\xc2/\\\x83@x~(7\xef\xe7\xa8 \x162z\\\x04\xe8\x8fj0\x81\x95\xcf\xa6\x076Q\xfb\xdb\x936\xdc\x0e\xd2\x10\x9eH\x9a\x97L\xc1\xec|!\x96\x8do\xc9\xc5\xd5\xde\xdc\xe3H\"|\xbb\xdbh\xaf,C5d\xc8\x15FB9\xe5u\xdfE\xc2\x83R}\x17\x96\x9eiX\x85\xe5\x84\x82l\x17B\xd3\xafC\xe5G\xb4?Z\x11\x18[\x85\xd1!L-Fp%1\xe1\x08$ v\xf4bL&\x1c\x03/\xc2e$H\xb9=\x04|\xab\xeb\xd3\x1e\xa13\x82.!\x0f\xf2\xc3$c%\x06\xd8\xd2\xc2\xed\x9d##Q\x98\xa8\xe1H9\xcc\xc8\xb7\xe8QQVP\xb8L\x94vZ\xd4\xb3\x9d\x88k\xfep-\x88G\x98\x97]\xfc\x08\x06!_$\xc6\xc5\xb3|\xedh'.5\xac\xfa\xd0jTt\xed=\xb4>\x13`U\x8b\xc9M$\xed<\xc2zQ\x81\xfa?\\\xb2W\xecHf\xecX{\xec\x04*v\xea>V\x1a\x0f\xfd?b\x99\xe3\x81\xec\x1c 3\xdf\"\x1b\x02-)\x81\xe4\x14 \x0c\x81b\xb4\x9a\xda\x1ahy^e\xb3&\x00\xad\xeb\xa8m\xc8\x96njA\xbe\x05:\x07\xbb\xca%\xdbz\xfb\xc6Y\xb3\xbfL\xa3}n\xfb<\xd1\x97\xdd\xdeQ\nY]E\x0d\xadC\xc7\x16\xf2\x97:K\x0f\xd7\xd66\x1a\xd6\x87w\xf76\xba\x0e\xaf\xbf\x1d\xa3\xbe\x95\xec\x9c\xa7\x0c,\xdf<\xde~M#\xd8w\xa0~\xe3Z\x82\xf7Wq)\xbeP,$\xeegw\xb4\x1c.L*x\xf5\x85\xa4:\x1d\xbenC\x18)\xaf\x83\x9f\xdb\xf8\xae\x1c\xfcV\xf0\xd6f\xc8z\xaf(\x9e\xc1\xb8h\xa2\"\xaa/4\x07\x97\x9f\x1b\x955o^\xf4\x98\xcc\xbd\x1f\x96\nA\x1eL\x96\x08z_}=r}\xa6\x94\x00\"_\x94V\xe0\xab\x9c\xcbW\x06\xd1\x7f\xe8`^U\xd5\x91e\xd4\x17\xcdN\xb1\x1d\xafV\x11\x90\xd0F\xb6\x9e\x811\x0d \xb2\xec,w's\xaaw3y\x92\x8arg`\x10\x1c\x0d\x07\xfa\xdbc^\xbc\xd2\xe3\xbc1\x1b\xa5|\xa4\xd0\xe3\xf0\xaa\x86J\xa9\xc3e[\xe3e\x8c!;\x06{\x91iy\xe2\x17\xab\x9fz\x1dn\xc7\x10\xafy\xeb\x8dQ!\x8b\xcf\xbe\x85jy\xfeh\xf0\x9b\xf0'\xe5\xae\xcfd\xe3\xb2\xf4f\x17%&;|pq\xe1\xc1\xbb\xc4\x91U\x95\xec[\xfc\x0e\xeb3h%\x9aO\xbe\xa6ElM\xf7\xb3\xf3\xc6\xebK\\\xa1\x8c2_'\xa8\x88q\x1dh\x10\xd5\x99F@{\x92\xcb\xb1\x89\xe3}7_K\x9b\x93^\xb6\x8d\x9f\x92\xd6\x7f\xf2a}\x06\x1c\xb8\x18\x88\x125\x0cO\xf8\xaeO\x0ec\x81@\xaf\xe4\x01\xffVq\x00\xe5\xf5+\xad-\x1a\x0eX\xdc\x7fd\x85 \xe7\x1e3\xdf\xaePE\x16\xfaa\x03\xb0\xbe(\x82W\x88\x9d\x901\x99\x97\x86\xae\x0f\x1a\xf2/\x94N\x9e$\x1a\xc3\x1b\xb8\x16\x8b\xde\x9e%\x85l10\x95#v\xa2\xf1\x84A\xf0\xd0\xe0/L\xb4n\x08\x1d\xb9X\x9f\x98\x1a\x01q\x89s;\x82\x02\x06F\x1e\xc1\xbdD6\xc9\xbe5#\x05\x0ef\x94^\xca\xe5\xe1,\xfc\xb5\x02\xb9rB\xf3o\xaf\x9dt'\x8f\xd1\xc1\xa4\xd2\xa0\x87\xb5d\xe0[8\x9d>\x03;I\xa1\xd0\xaa\xba4\xbd4\x8b5\x16c\x06;\x89\x9a^^j\xd8h\xa9\xb776\xbfNJ^\x99\xf5n\xc1\xa5\xfe\xfd&\xfc%\x7fKTQ\x04\xc1\xe8\xd7\xe29\x8d\x03\x04 \xaf\xc0\xb2ne\xaa\xa0M\xf6J\xed\xa0\xd1\x14\x8e\x94\x99\xb7\xd6\x04\xf0\x00\x1b\xc4\xd3V\xf9\xc3\x8f\x8e\xfb\xb2\xdbf\xdcP\x1c2\xc6\xd8\xce\xe8l\xcb\xce\xc1\x93\xe82[\x1b\x16B>\xbf\xfd\xb1\xd3\xa2g,'\x9a\x9f\x94\xca\xb373\x102\xf5\xa3\x99\xf6\xb7\x84\xe3\xb63.\xf7\x8f\x14\x8c\xa7\x89\xfd\x07A\x9d\x86\xbeG\xa61\xc5\xe5\x1f\xb3\xaa\xc08\xe2\xe8\xf8\xdaH\x1b#\xdbn\x96\x14\xd1\xc9))u\xa6\x17\x9d8:\xb5\x97c]\xe6\xad\x93\xf5\xc4\xcc\xdeC\xd8\x1e{\x10\xd2o\x94 \xf0\xce\x0bf\xeb\x8e\xe0\xf7n\x0e\xcc\xd9\xda[\x8eZ\xb6,\xfa5\xb5]\xc2\xcf\xc5[[\xbc\x95\xc8\xf4:\xb6\x15QIN\xc6\xb1\xc0pN\xe2\x1d\xc9\x08\xbcV\x91\x07 \xb9\xf1m\xe4}\x10l\xbe\x18\x7f\xd2\xc5vb\xe5 a\xc9\x1b\xe8d\x1d\x8b\x84\x88(z\xf0\xce\x18\xb6ta\x0f\xc9\\\xafrv\xcd\xadEW\x85\xb0wM\x14\xeb\xc5\xe6\x0f\xd5\x00\x9f\xc3\xba\xaf\x17\xa8l\xc1\x026\xb6\x97\xd6OU\xa5\x08\xe3\x1aPr\xef\xe6\xdf\x06\x16\x81\xeaMVP4\x14|n\x1f%\xc78\x87\x02-\x9f\xb8/\x8ck\xbe\x8a\xab\x91\x8e\xf9#\xd8\xdb\xad\xef\xaaT,F\xdeS<\xafT\xa5}\x1e\x1b\x1b\xee\xcb\xb7'\x8c\xc1\xd1\xc0\xec\x94\xc7;\xfb\x8a\xce\x96\xe3\xf1\x0eBE{\xfd\xd0\xb5\xa0\xfdZ\xf8\x98\x0dC\x9c\xd1\xdb\x89\x1e\x079E\xca\x15y;\x94\xb7\xa4\xd9rXL\x16k(f,U\xfb\xc0YXB/|\xfc\xac\xe9w\x9d\x9b}\x89\xf0go\xca\x8f\xeb\xb0\xc5\xd7un{[\xcd\x9cn\xcabmz\xd3\xbc<E\xe4\xdb\xaf\x7f\xac\x9f\n_\xaeoK\xa9\xfa\xe5!\xb2V!\xe0\xc2\xa6\x9b\x05\x0c_\xdeeR\x1f\xa5\xcbv\x90\xe4\x14\xfc\xf6u\xff\xddEF\xaf\xfc\xa5E \xfcr\xf3q\x82W\x00\x9d\xe9\x99?ff\x98\xd8\x8a@\xc2\xee\x03\xebs\x99\xae\x15\x8c\xd9\xe1\xae$\xa5\xc4\xe0\x08_<\xc7\x9b\n\xc6\xc7$l\x87\xe1\x1cJ\x10\x1d\xd0\x8f\xc7\x12\x14\xfa]\x12\xdeK\x16)\x02&2\x82\xef\xdbn\xcb\x1abJ5[\xf7\xfbV\xceoA\xbf,\xf5\x01\x17\xf5!;?\x8do\xa6\x8c\xfbS\xd2\xfe\xcb\xab\x03\x87\xe5\xd2\x92|\xdc*l!\xc5n\xa5\xed}\x80f\x03:\xfdQ\xe1\xb3@A\xd1{\xacG\xee\xd0})q2_m\xd9\x90\xb5\xf3X+E\xda\xf6\x91\xd2>\x9f>\x99\xf8\xaa\x14S\xdf\xf7R\x837\xeeN\nT\xdfJ\xa8\xf1#z_\x9d<\xd3\x0ee3\xeb\xc0\x1b\x1d\x15zQ/\x9f3\"\xcf\xd8\x86?\x8aI\x13d\x9c\x8a\x9e\x0fC\x1b\x92\xa5thL\x89\xbe\x81\xe8L\xf4\x13k\xa7\xcf\xa5\x12(\xd1\xc2\xb2\xa02\xed\xcd.j\x11\xa9%\xa3{\xda\x02\xe9\x00\xc0\x08 \xe1<\xbc\xf5u7oa \xac\x91\"vz\xa1\xb7\x8a`\x97\xb4\x8e\xfe\xac'\xc7\xcdXlW\xe3`WBR\x97\xd2\xca,\xb3O\x98hB7\xa4 \x02X\x90E\xb8k\xe6\xf5@\xd9\x1f\xb6\xe5Wd\xd8s\xbe\x1a\xf3\xd4\xfa7\x19R\xabylb\xc3B\x1b\xa8\x80\x90 \xd7r^\xado\xb1\x16\x07J\x9a\xcd)\xa3\x1f\x82\xf5\xe7\xf6\xc9\x8f\x1f`\x12\xc5\xc92\x14\x94\x08\x85\xda\xc14\\\xaf\xd4,\xe3\x0f\x87\xc2\xc6Ci\x11\x07\x04z}9L2\xdd\x8c\xf2fv\xe0\xecG\xae\xb0\x83\xc8\xbd\xeb\xbcdn\xa2\",x\xe6\xdb\x1c\xe4\xe0\xef3\x9eb\"\x7fk\xf0S\xf1\xaf\xc5\x04\xc4\xe5\x9a4I\xc1pt\xe5\xbc\xefn**U\x87\x1c!\xc2\xea%\xba-\x08ne\x96(\x9b1\x15\x1b\x12\x8e\xb47\xdd$\xa2 \xa7\x85\xc6\xa1WU\xbcT\x96w\xcd\xf5\x8bJ\x99_H\x8d\xedw\xb4\xc7\x15\x96J\xf25\x8a\x00\x80IyU\x172{\xe9\x8e\x0f`\x06\x19at\x15\xb6\xaf\xcc'\x9b\xd5T\xa9\x99\xb2\x80\xf1\xdd\xb6\x1a\xe2\xcf\xe0\x98s\x9d\xea\x1cJ3\x95\xbf1\x83\xa2\xa1\x94\xc1\xf9.\xd3\xd9\x05-f\xaf\x8b\xdeje\xf2\xdf5$\xe6U+PT-t\xffu\xc7\x02\xbc\x80\xdc\xcf?1\x94\xad\x16\xdd\x8c\xf6\x17\x14>\x9cp\xcd\x82a-qO\x1c\xcc\x84\xc4\xd7\x9e\x12y\x12\xd2\x01A.o\xc5\x83\xbb\x9b5k\xccc\xcf\xd0^\xd4W-1\x04\xa7\x07\x12\x9d:h}\xd2Ko!\x04\x06\x9fpb\xc4R\x1a^\xc5\xb7\xa1\x8d@\x1e\xfb0\xe4/\x82\xee\x1aI\xd1'5\x86\\\x89\xf7\x8a\x8fW\xb4Z#\x15 Zs\xad\xeb\x7f\xef~t\x01LA\x0c\x13\x8aF#.4\x1d'\xee\x8e\xbeK\xab\x1b\xfb\xf1\\u\n5\x95I\xaf\x9c?\x9e8\x8cv&\xdd\x13Q\xfc9\xdd$d\x8f\x04\xe8\xb7\x18=@p\xa3}\x86\xf6>\x1c\x15.\x17j\x9e\x8fX\xf3I\xfd\xc4\x01d\xf6uU\xdbd.\x82q/6\xd0\x0f\xc9\xd0Mh\x96\x13\x06\x194\xbf\x07\xbfq2\xa1\x19\xf6\xe4'(\xb5SV\xb4\x99PVo\xef\xe4=\x90\x8f\xb3W\x9a}nK\x93 b\x8b\xf7\xfc\xfa\x97)\xfa\xb3\xac\x11f\x0e\x9du\x92,\xcd\xfb\xcc\x0e0\x93x\xd0\xcfW\xa0y2\xd7\x0e\x850\xd1\xdc\xb4\xe3\x12S\xe7K\xc1AR\x12hb\xc8\xb7\xad	\xe7\xda\xb0\x1aw@\x89j\x0b\xfe\xaai\xf9\x13\x96\x97K\xbe\x81\xbcb\xf8O\xd6Q\xb6\xd5\xab\xe2[\xbb\xf4z\xe7\xef\x0c\xb3\xfb\xf1\xe0\xe7F\xea\x92%B\x0d1\xca7\xfe\x8f\x9c\xcf\xf7q\xdb\xe6g\x19\xd0&\x19.D\x8e\xa9\x85W\xa63\xc4\x14\xa8\xf9\xd8\x8fJ|\x84\x85,4\x9a\x13\x0c\x98\x820\x8a\xb2'\x9a\xf6\x8f\xeb\x06<\x94>M\xe8\x8a\x85\x863\x14QqQ7E\xaf\xf4\x94\x1a\x16c\xddg\x9d\xbc\xe0\x9e]\x19\xe0\xf0\xd0\xb0x2\x83\xcd\xe6\xbb<\xe9q\xe7/\x11\xb6\xbc\x91e\xb4\xf2Z\xba\x91\xab\xb0\xdaA\xa6\xe8\xa7\x99F_3;\xac\xfak\xf7\xe2\xed\xf2|\xdc\x9d\xa0\x12]\x90\xeb\x0f\x9f\x88\xb3\xdc\x94\xc4\xc8P\x8d\xcf\xf1^\x84\x0b\xf1\x02\x9a?}.>E\xaf\xf6\xecq\xdc*\xcey9\x10zeV,|\x817\x15U*\xe1r\xb9\xf2\x99\x93\xeb\x7f.\xc1\x85;\xe6\xacmk}\x11\x08P\xd6\xcae\x8c\xbe[[\x8c\xbf\x07	\xc7\x07u1\xc0\x0f&\x9ac0\xdb\xba\xc3\x92s~\x1f2q\xde\xb7G\x84\x1a3\xe7X\xb5\x81\x0e\xad\xeb\xb0zP	\xc2T\x870\xcb'<\xfd4\xe9\xef\x7f\xfd>\x14\x7f'\xd4\xb3\x89\xfb\x18\xf5\xc1h\xb4\xd7\xae\xf3(\x04\xdb\xe1\x1a\xdd\x0c~\xa0\x96\xe1\xc3\xeb\x16\x00\xa95\xfas6*{3\xa3\xccx)\x04\xc0\xc1\xb4\xf7\xc2\xa8\xeeP\xd3\xc69\x9e\xf2\x17\x06\x16+\xbe\xc9\x1eu\xe0BP\xe4;\x91\x9d\xedx\xf7T)\xd7\xaf]\x16\x83\xeb\x97S\xe70\xf3\xca\xf3w@\xf2\x8fbo\x05 s\xfd8\xc4\x92zu\xb5\xf6~.'\xff\xd2\xf9\xcd\xc9\x1a\xa5\xfa\x0f~\xab`p\xfdt\xd7\xbe\xe5G:T<\xec\xfc\xb7\x03+\x90\x9b\xef\xdazn\xc8h\xde\xd7\xbf\xad\xfc\xee\xf7N\xaf\xffL\xa2\xa5\xff\xe9x!\x01\xea\xf5\xc7\xbb\xf4\xb6\x04\xa2\xefb\xc1\x84\xb9\x85\xeb\xd1s\x83r\xb6\xc5\xa9}y\xeb\xbb\xd4\x9c\x9d\xe81\xef\xfe^q\x06,\x8f\x85{\xed\xb5\xec\xfc\xbe\x1b\xee\xb9j)\x10\x83\xbc*\x0c:)\x80\x9d\x13\x96\xd3\xf3\xa4v\xd0\xbba\x7fl&0\n\x00{\xf9<\x01\xec\xddH1S\x0e\x03\x1a\"|\xe9\x04\x02o*@\xa9\xc3\x9a\xbf\x05\xe8\x1e'n\x02\x05\xc2MA\x82\xf0rK\xe3\xee\xfe\x00\xec{\xbf{K\x9dg\x98A/\x99\xfc,\x97l\xfc\xdd\x83X\x10\xac\x8c\x92\x01\xd2m~\x1f\x13\xe1S\x8c\xfe\xe9\xfb\xf0[9\x97\x02-\xd5\xea\x0c\x08\xad\x877+\"\xf8\xb4\x02\x83\x87)\x80c.@\x13C\x99\xfc\xc2\x9b\x98\x89\x02c\x98X\x0c3\xf4\xf5\x08\x06\x16f\xc8\xed6\xbc\xde3\xd4\"B\xddc&T\xe5\xdeQW2\xd4\x8a\xcb[]\x9b\x9a\x163\xc8\xc8\xea\x8d<\xd3\x87W\xe5\x81\xdex\x81\xdc\xda\xe17r Kmj\x81\x8a\xb0w\xf4\xa1\xfc\xda\xe1\xe1\x9e\xae\x18HoO\xa6\xd0\xdc\xc4\x08_2\xc0_0<\xd0J\x07%\xe0\x8f%\x1e\x19\xe0\x15\xb9\x07(\xc7ZE\xc6\xb7\x87%\xca\xab'\x0bF\xf9\xabE\xa5GFely\xfbi\xabg\x8cD5\xf79k\xf2E\x143\x85)l;\xc5yE;\xb4`\xc978U3a)\x91\xc44k\xc7\xb4z\xc5\xb4g\xfe\xeb\xc72\xb4\xe7>&\xe2?b\x8d5\x81\xcc\xac,\xe9\x9c\xf4|\xa3L\x08\x14\xad\xb4\x14\n\xc9\x03\x10@A\x8d.PR\xd6XV\x91\xc9\xfa	Q\xdb\xa9\x9a]\xdf\xd4\xdb\\U\x0f\xad\xee\x1a\x92\xcd.\x86\x8e\x8f#\xa7Z&\xbe\x0cwe\x15O5O@VV\x1a\x9a\x07\x06\xe7k\x8b\xd6\x97\x1a'\x96W\xd7+\xc6;\xb6\xd32\xf7\xf6\x91\x87\xdf\x8e\xcb\xc7\xe6\xbe'\xf5\\\xfdA\xbb\xa9\xf8Vc[q\x8e\xff&\xf0\x0f\x00\xdd\x1bb\xff\xd8'\x83\x81\xbf\xa8X\xf1t\x8b_m\xf5\xb9\x98\x88[\xfdru\xd8\xd8\x91\x01n\xa5S)\x7f\x1c%\xd6\xe55Yl\xb1\xa4\x1b\xe9\xc7l\xe9,\x9e\xc4Ojl\x8f_\xfct\xe6J\xbf<&\xbd\xb2\xfd\xf9P\xeeP!\x9f\xb2\xc6\x10/\x92\xad\x86\x18K\xfc\xa1\x1f,\xad\xd0b\xc3\xa9,\xe5#\xb4p\xad\\1\xfb\xc9\xa8\xd2\xc2\x84\xab\xa8z\x19\x0bw\x88G\x9b\xd2\xed4'\x87\x8b<<\xcf\x17\x9fp\xb9\xc9k\xbcv=g\xf1\x8c\xaa\xd8\x0d\x8d\x16\xe2\x94\xe1h\xd8\xed\xf7\xebg\x19\x9a\xe7'\xa3\xbeP\xc2s\xbe\xf1;\x96\xc5/N=\xa2\x1b\xfaZW\x1bf\xb2\xcb\xee\xfd\xd5P\x0e\x83\xf1\"\x98b-\xc9\xb4\xed\x17\x8d\xd2\x86f\x19\x06\xec%%e<\x92\xa3\x8dn@\xb2e\xbe\xc0\x91\x81\x8e\xd1\xc7\xe3\x1b}k6B!\x0f\xff\x83\x03h\xa6D1a\xe3W\xdb\x82R\xcd>\xbe\x80\xf9oA\x02\x80\xa0S\xef\xe3\xad_\xe7\xc3\xf5\x82\xfd\xb3\xa9\xf8\x19\xfd\xca\xbd\x0do|\xae)l\xd2r\xc8\xe1\xf5)i=\xdc{}\xf1E\xb3\xe7\x13\xc1KQ\xd0\x86\xdfXQ0\xb5\x1b\xa5]\xfdf\xc8\xd7\x07R|f\x10\xb6\xb6\x8a'\x04\x1b$\x0e\xec\x80\xf0\x1e\x0dB\x9eJ2\xd1\x04\xb6\x82.b0\x98\xa6\xde\x17\x89b\x1ako\x85c\xd2\x0c\xc3\"i\x17\x99\x90.\x10D\xe4N<Qrr\x89\xed_\xbc\xcc\"\x02J\x17\xa6\x12\x00\x16n\xb5\x1by\x81`\x1a\x8f\x07\xfdE%\xbfA\x7f\x12\x85\x0b\x02\xa1\xe3\xed\xe2\x03[\xa4&!\x8a+dja*\xba\xa6E\x02_c\x87d\x92t\xe6\xd0\x01\xf4\xf9 r\x8b8\xc2|/\xec0\xb3V\xad\x18A\xec\x1b\x9d\xa0\xecF\xf3\xe5\x03O\x1c\x05\xc6\xc3\\\xbc\xbb\xa5\x84,\xb4\x8b\xfb$\xfek\xccc,\xc0\x9fE5\xa8 C\x03\x04\xa4\x07;d\x11\xe7\x1eRE\x1f\xb9u\x07\x9d\xe8	y>e\x8aa)+m\x8f\x8d\x895z\xdfW\x16`\x82\xfd\x91\xc8\x0e\xfb\x93Z\xc6\xcf(\x8a\xf2\x88\xf9\xa0\x15j_4.\x9b\xa7\xa5qPT\xa9\x90\xefI\xf1hu\xda\n\xb6mm\x9e\xf0 \xab[\x18\xc5\xa7\xd2\xb8? E\xaa\x80\x85}\xb7iN\xd0\x17)\xdc\xaa	\x85\xecG\x06\xa0Y\xf6\x17c\xa1\x8c\x11\xcd\xd1\xe7\x90\x80N\xa3\xd6\x83\x87\xb1\x02\xef\xc2\x8d#\xfd\xfa\xdf\xcc\x9f>\x08\x0c\xfdd\xfa\xde\x91YZ\xf5\x9d;\xab\xf9\xb46;\xcbI\xc1\xb1E\xe7l\xf1<\xe0\x156\xf0)w\xff\x96\xb4\xafwv\xbf<'\new\x1d\xc3\xd3\xa7\xaf1[\xc5,JY\xd3\x9c\xa3\xc2\xd6\x94Dq\xe2q\x19I*\x80\xa2l\x07\xa4/.\xfd7W\x94\xae\x1a\x0e\x80\xf4(\x88\xbc\x96\xe9\x98P}4\xed\xe98\xf7\xb4\x88+\xb7Az\x84}\xb1\xc0&\x93\xcb4>\xcc\x0842\x13\xc5V\xcff!;\x8f3'&w\xc1\xe7\x81\xd6\xf4Mk\x00[\x9a\xfd\xf6k\xdb\xfd]M\xa4\xa6\xe9\xaf\x03\x08\xe9},\x00~\xe3[u\xe9 \xb5\x19\xf2\x81\x1f\x80M\xb6A\xac\xab\xec\xc7\x05\x044\x1eZ?\xbbN\xe7a\xb5wF\xa1r\xbc\x1dM\x08@\x9b\x81\x18\x86\x08TX\xdb\xa4\xdbn\xc1\x8f\xb3\xeb\x95\xb6\xad\xa6\\O\x17\xad\x0d\xeb\x16\x83\xef\xe1\x8f\xbc=\xeb<\x1b|`R ?\xaa\xbe\xf4\x8d\xc2\xb3%D\xe0wW\x16\xdf\x8dh\x9do\x8d\x96\xa3\xcc\xa0\xebe\x15\x9b\x00\x1d\xdf\x9e_\x9b\x9f1\xa6N\xa7hy\x07\xd6\x8f\xbfT\xac\x0b\xf8\xd5m\x12P_O\xdaV\x9a\xdf\xdc?\x8d\xdf\x98|\x816%\xb0i\xf0\xe8\x87\xb3\xdd\xf1 K\xc0m\xf1\x8b\xfd\xcd\x1f\xeb\xae\xb4\xab\xf8\x90\x9e\x03\x89g>\xe2)S\x07S\xae5G7\xdf\x1c\xafs\n\x96^\xb4\xb5L1\x9b\x91e!7\x1e\x8f\x0e\xbb\xbct\x8eI\x08\x14\x0b\xae\x9f2\xb5;\xb4$H/\x9f<\xbb\xe3\x80\x97m\xb8\xb3\x13\x1f\x07a\x88\x1b\x05E'X':\x82\x12a\xe0\xec\xd6\xcfx\xd1\x89\xa1O\xd7R\xb7Jws\xecD\xf1\x82uN\xd67G\x0e)\x9ft\xa3\x93D]S\xa3\xebavR\x05\\\xbc\x16{\x05\x03\xcbA\xa5\x03\xba+UY\xf4\x8d\x9f\xa6\xb5\x0f\xf8%\x9e\x07#\xc4\xb3\xa1\xderp\xb0>\x0c\xe8\xda}i\x8d\xad-\x84n\xf2\x18\x1cz\x16\"\x8e\x8f\xe3>\xd8\xab\x86\xef\xbb\x18\xbd\xf4\xd7\x06!\xfbD\x16\x06\xa0y\xd1\xc2\xea2\xd1\x85\x15\xce\xe0\x89\xb9\xcf*o\xda\xe5\xbak\x92\x00\x02X	q\xd2|&\x85\x8f}\x1c\xa6x)\xc3\xbe\xa0\xdcQ\x94Sh\xd2M\xfa$\x93\xce\xeb\xa3\x9bKG\xd0H\xe9\xcb\xd7\xea\x19\xa75\x0d\xda\x14t\xe6\xcf\x94\x1f\x06\xd4\xfbGMR\xab\xcd\xc8\xf8\xc8\xe0\x04\xa9Fq\x1c?\x9f\x00\xe2\x0f\x02\xf6{\xc9I\xda\"[\xcc\x1fFs\x8cN\x1a<.\xd1\xcc\x89I\x97\xe5\xbf\x9a%f\x8d\x84\x1e\xa4\xd1\x08)\x8b\xd4\xcee\xa3\xfc\xe8\x053\xee\xa9\x003\x00\x8dl\x04\x89\xdf\x97\x02Ggs\xcd\xfbF\xc4\xc8H3:~\x93t\x91=\x85eq\xe6g\xaa\xe8\xf7\x9d\x85\x90\x0c\x8f\x08\xfa\xfd\x81\x0fL\x07\x13iU\xcf\x81HV(\xb6\x11E\x15\xe8\xe4\xe6P	\xf0Q\xbb\x1d\xbc\xcb3\xcc9b\x9b\xf4\xca\xc0\x02`E \xed\xa3\xcd\x1e\x12x\xc4\no\xf4\xa3\xbc\xe9\xf3\xd3\x9b\xca\x97>\x97\xd7\xa9\xcf\x83\xf7r\xfcJ\xa2\xfeNT$X\x13V\x9e\xb3\x99\x93w\xb7\xf3\xa2]\xe9S\xc5\x12\xc0a\xe33\xafXyi\x19U\xf2H\x1b\x02]n*nm\xd7V\x0ce\xbf\xeej@	\xb9\xd7UEE\xd3^z\xbd\x0c\xd1\xe5\x0f\xdbS?\xfc2]\xe5\xf0\n\xa1e\xbd$\xea\x1f\x81\x85\x15\xcaH\xc8nn#\x93f\x1a\xf5$\x0cP\xcd\x1e9\x1a\xfc\xda\xa9\xd9jrS\xc1\xf9E\x1a,=\x134\x14]h\xec\xbc\x10j@\xf5s\xa6\x9b\xc5\x12\x0e\xc5\x12\xc7\xe4\xf7%\xee\xb3i.\x08\x93)Sy&\xbe\x82\xee\x06GC\xd8\xb3\xd9|\xc0\x11\xa8\xed\xc1\x01n\x9b\xd9\x089X\xf4\xc4\x88\xac\x9b\xda\xffTY\x9eb\xae\xf4\xb7\xde6W\x9e\xc1\xcd\xe1\xe3\xa0\xed\xd6;\xe3\xcc3\xc9\xdadJV\x1bg\xfe\xe6\xd3\xd5\x9ao\x01\xabA\xc3,\xad\xf9(e6SJ\xbd\xf5]\xcd\x93\x96\x96\xb8%4IyMy3\x1a\xf4k\xc9GV\x95\xb2\x8eE\xf9\x0dbB\x9aHG\xbf\x86\x18,\x02\x94\x16\xe1\xea\xd9\xf7y}[Me\xd1\xad\xde\x1b\x13\x07Z\x9fK\xad#\n\xb2\xe4\xf3\xb4\xba\xe0\x1a\xdbM\xe8\xebv\xd2+\xf7\xe5qC\xd6\x9cG\xdd\xcc\xcf`\xfe\x11\xaa'\xcf\xd77d\x89}<'\xb1\x01\x12\\\x80\x00\xbe5\xc8\xc4\xef\x1c-\xe1\xb4\xf9\x93\x1f\xd5\xc5U\x02\x06\xd8\x94Lq_\x8f'}\x16e\xf3\x95\xa141K$\xfb\xc2\xc6\xd0\x87\x11.b\xf6\x924k\x84\xf9{\xd8@\xcf\xea\xa6\xc7T\xe6\x10\xc9S'/P\x83q\xe0\xc9\xfd\x18\x8c\x84\xcb\x8f\xf6\xb9\x19\x1e\x9e\xf7\x88!Cc\xd2\x1b\xa4Id_&\x82|1\x91\xa1\xf0\xf7G\xcaMm\x93\xc2/\x8d\xb9\xdcY\x16|\xae\x0f\xe3\xf2\xb2.\x99\x12\x89\xdb\xbc!\x19\xf2\xd9\xf2@\x0e5l\xffU\x9f\xf7\x94KO\x15\x96R\xe2\x84a(\xf9\xc4G1n,\xe4\xcf\x96\xf1\xc6\xf1\x96\xad\x851v.5]	\xa9\xe5I\x81t\xca\xe2\xc7\x87\x07CB\xb2\xf9\xcc\xd2\x89\xd0\xd2\x14\xa5\xe3\x16>\x9f\xde\xcd\xbc\xb4j\xcb\xb4(\xf4\xc4\xbb\xc5\xfc\xbfn>\x15l\x91\x91\xe4$\xe3\xe7\xfb\xf4 \x15\x83\x8d&\xec\x99\x85\xec\xc8\xe3\x15\x14O\xd8\xae0r\xb1\xcc\x1d\xa8\x019\xd9\xea\x1ff\x0f\xd9\xd54\xd1\x81s\x16\xd1z\x96x\xa6\x15y\x8dMr\xbe\xed\xf8\xb3[G\xcd\xee\x07\x14\xc4\xd9b\xc8SB\xbe\xd6zT,\xe3\x9eX{][\xbd\x9dgi\xcc\xe3\xfa\xfem\x88\xc4}$\x1e\xf7#Kt\x94X\xc7\xeb\xae\xef\xe5-\x9fk&\x0f$\x97\xe8\x9c\xc7\xfe\xeaJ\x9c\x0d;Ba\xc4\x957\x0b\xeb\x8d\xf3\xc1\xeaA\xbc\xbf\xb03j\xe1]r\xdf\x1d\x8a9r\xb7\xf9\xa6\xee\x06h\xf3\x1d\x87\xd7\xf1\xa5\xfe\x0c}\xff\xb2/D\xd2\xf4|a\x97\xba\x17\xbd\xac}l/\xa5\xe4w\x7f\x97\x83\xce\xa0\xefK\xeeL^\xe4\xe62\xbd\x92\xa1CO\xb6\xd6\x1ae\xab\xd8\xb0\xfb\x00\xf3]bUhE\xc1\x00\x07\xc8?>\xfe\xf1\xa6\xf4\x90\x9e]p\xfa\x81\x04\x10\xbfj\x94Z=\xbc\xfd\xdaR\xeb\xd5\x1d\xa1\xe4\xdc_\xeb\xd4v\xaa\x11\xd5}[\x8a\xb1\x92O\xdf\xe2\x8d\"n*o\xfc\xf5g\xcb\xaa\x97\xbf\xc8Z`k\xdc(\x18\x1cI\x0dR@\xa2\xfd\x901\x0dF\xdd\xd2\x10,\xa5UD\x95\xad\x9ao\x15\xdew\x1dL\x1b\x9e5\xa3\xe5\x9dD\x03\xc0\x0e~\xe0\xfd,\xeb_\x16\n\xdd\xe6\x13\xf2\xb8\xc5\xa7-\x10@Q\xcb5\xc5\xbc\xe4a\xa5Io#\xdf\xd1u\x86\xf0\xe5\xcf\xd9_b\x97\x04N\x8e\x0c;\x1c?\x16{i\xce\xfd0\xbeh\xd8M\xfbM\xe1\x18\xeeG\x1e\xfd9W\xa6\xf6\x8b%\xe8\xbd'L\xa9A\xda\xab@\x81W[\xbf\xb6\x9e\xf5\xde\x0fl\xfe\xb6\xaa\xf0;\x0bO\xfc{\x13\xf4\xd1\xff\xfd@\xf7\x18h\xf1ac9>\xf6r\xf5\xf1\xef=\xa3\xb7(\x15\xdc~\xe6\x87\xcf\x9f\x1e\x01\xb0\x93\xa5\xbf\xcb\xa1\xce	\xc3\xbb|\x89\xc0\xdb\x88@\\\x14\xfc\xfe\xc7\xc7'\xf5\xbe\xfc\xdb\xbe\x8fYx\xf0\xe4}\xc3mc\xa1l\xf0;\xccHo\xf3-\xcd\x92\xf0\xb7\x04[p\xdcL{l2h\xb7\x9cXv\xabu\xac\x16\\\xad\xcc\xe1\x02\xfd\xdd\x0b\xcf\xe0]\xa2`~&\xcb\xbf\x96z+\x0c&\x9e\xa92o\xca\x98\xcfC\xdfc\xb6\x87\xe8\x00\xc1\x02^\xa1Z\xb2\xa1\xdd\x1e>\xce\xe5\xa1#\x17*\xd74\xef\xaeB\xa1\xef\xb4\xc24\xbd\xc2\x10\x96\x86\xb4N`\xd8Q\xe8\x88\xea;rqN\xaa\x1f\xea.\x00@\xe1\x04\xd6\xbf\x0f\xa8\x03\xc2\x8d\x11\xaa\xaf\xe4\xc3\xa1\x92 \n\xa7p}A_(8\xdc(\x93\x1e\x07\x12\xe1l\x14\xee*\x1fQ\xa1\x15\x8a\x01\x07y\xfc\x08\xf7\x1bq\x0cD\x80\xa2*\xfcK\xc3@\x18xz\x11\xda\xe1.\xf7\x11Dx\x910K[\x90\xaan8Y\xd4o~\x1fW\xed\xa8X/ \xcb\xea].S\x14\xd1Q\xd8epTiKT\x99\xa0^\x8a\xbc\x8fiE4\x062\xbaF\x9e.\n\x11\x89\x18\xc1\xba\xdd\x8bl\x93\x8d\x91i\x19\xa1o\xbb\x8f\xea\xf0\xc2\xe9br\xec\xb9\x8f\xc9\xe5\x8a\xea\x93w\xf2s\xf6(\x8d\x8c\xb5\x9e\xc1\x19C\x80\xd0\xb5\xbcS\x9dcg\xc88\x1d\x18)Y3\xe36\xee\xe3\xb6\xc8\xe2w\x04\xe3\xf7\xb4\xe3\x0f\xbc\xe2\x8f2\xe3\x15\xff\xffw\xd6\xf6@J\xba\x17\x90\x96\x91c\x90\x95_\x90\xfd\x16\x80d\xe5\x96k\xe4C\xa0h\xd55h\xd0|\xf9\x12hiQcCEn\xd6'hwOUms\xbb4\xd0\xd3\xd2*\xd9\xd5\xdd\xd2\x912\xd6;\xd3S[7,\xd5\xd4;\x9c\x8f\xac\xe9\x1f\x99\x8c^\x9a\x9d\xaf\xeb\xde]O\xdb\x9e]=(\xdd\x8c]\x82\\^\xed\x1f\x9c\xf6-\x7f\x9e\xd9\xdd=\xfb\x1e\xd9t\x05\xc6nns\xa4\xa8\x81\x9f<\x1d\xd2c\x9c\xd8,2\xa9DWP\xd7\xa8\xa7\xa9\nE\x91\xb8\x9c\x1e&\xaa\"\x0c\x081tq\xc3\xd5\xd6E4/?\xbb\xdf\x08:\x93\xf7'\x91*v\xa6\"T\xf7\x15$Jt)\xe6\x05],F\x1e\x0b\x98\xa3\xd2:\xb1\x98,Gb!F\x86\xe8,x\xea\x95J\xb8d(:\x9f\xe1\xa3wV\x97\xa9\x1b)\xf7Q}\xa1u\x1f\xcbd\xe3\x84\xd3X\xa8\xaa\xc4K\xe8\x17N\xb0S\xb5\xea\x9d\xfa\xe2\xe9m5\x9f\xe9\x96?\xa4\xe3?\xc7\xda\xb2_\xeb5>L\xaf9=\xe0\xd8\x08\xfb\x89\xba\xcb\x1b'\xabU\xaf(\xc0\x9c\xe6\xf6#k0\xac\xa9'\xe3\xfd\xfb\no\x95k\xd4\x96I+\xff\xd0\xe5\xbc\xfbKvZC	Y\n\xb9\x15_\xb1\\\x8a\xa6\xbc\xea\xcb\xca\xc9/Fs\xb6\xa27-Yj\x85f8\x87\xc2\xfc)\xc7\xc1\xb8\x9cO\xef&!:\x83\xdb\xfd-\x0d5\xed5\x02\xae\xa8\xe2\x97!\xcf\xf3|\xb3#\x8eG2>>k\xfaC\x18\n9\x9a\xe7\x84_<\xb7\xf2\xd4\xda\xec\x8b\xa0~\xc7<\xef\x0d\xba\xbf>\x1d\xee\xed\x11]r~\x81~D\xa5 r\xcc\xf0\xc2\x7f\x12\xe4\xb4\x19\x80\xcb\x83*0.\xf1\"\xdd\n\xad\x1e\x94\xc3\xe1\xab\x8e\x1b\xc4\x0f61k|\x14\x0f\x81\x85\xb7#=\x98\x97	?\x86\x11=M\xdc\x96\x80\xba\x08B\x01j\xec\xfa>\xe8\xcb\xc5\xf6\xcdH!r\x8cM1\x92Z\xfa\xcdp\x0e\xfe\x88\xcd\xd8W+Vf\x01\x82\x86\xbc\xef\xfd\xc8\x831\x14\x13\x0d\x01\x18m\x92\x9cf\xd6&G\x1d\x93\xfb6\x00\xae]T\xc8x\"Q\x19\x96*3e\xb5\x9f\xb5N\xb5\xa4\x10\xce\xacB\xf8\xf2\xed\xbb\xe8\xa8\xb0\xd4\x9fY\xde\xe5i\x80S\x89\xb1\x84\xb7\xcbC^\xfc\xda\x8d\x0b\xccjQB\xe20\xc0c\xc3z/2\x88\xe6\xd0T\x0fj\xdcq\xc0\x13\xb2\x08\x11\x07\xd2t]M\x03dXl\xe8|\x7f\xa0\xe6\xe9X\xfd]\xaeG\xf9\x1f\xcc\xb2\xe5\xcd\x10\xe8pk\xa2\x174\xe4\xb4\x15\xf9\xc1\xed/\xd0\xc1\\6\x89\xf6	\xc9\x9d\x93BC\xa0\x0f\xb8e\xe5@!\xb2\x81\xb8\xfe\xe7`Y-\x81S\xa1\xffI\xcd\x92Q\xb1\xe7\x8bl\xeaZ0\xfc\xe4\xa8\xa4\xef=6\x1cBR\xfa^\xa5\xed\x13\x85	[\xe30K\xe2\xe37\xcb\xc6\xf6\x93\xefM\x87\x95\x11\xec\x91__D\xed\x96w\xa4\xdbL\xf9\xd6\xc1S\xa3 \xf8\xd2x\xb5\xfc\xc1\xb5\x80jG\x98\xd7{\x89N\x1c\xda*6n\xf4\xf6\xd3\x93\xc2\x99x/\xba\xb4\xfbI\xdf\xb3\xb4;\xfb\x95\x8f^N`\xa7>\x08\x80\xc1\xc6e	\xa1\xc4\xd5\xe7\xc5\x16\xc8\xad\x1d\x18\xe0bmh\n\xb7\xd8\xa9l\xf6P\xc8qD\xa7\xfa\x98\x93\xdfS\x9bH\xc0\xc4\xc6\x90`w\x85\xdcYy\x7f\xc4\\\xd0\xfe\xbb\xb13_\x9a\xedr\xb0~\xb1\xb30\x0b{\xe7\x07\xfbt\xc3\xd5\x8fkC\xfc\xbf`\xf8\xa8\x0c\x06nt\x9f8\xc1\xc2\xcd\xfc\xf0\x98\xc8m\x952\xca\xafiH7P\xd0\x04\x89f\xfb\x9c\xd3w\x8e	c\x12e\x96Q\xf3m.\x9de\xad\xf5\xab\xe3\x87I\xf4X\xcd\xe9\xb1\x82\x11R\xbb8`)\xd6\xf2\xb7\xaba\xfd\x1e\xc4\x0bI\xfbQ\x9c_X\x81\xf6\xfe\xed\xce\x07B\x15%\x9f\x9fB/h\xd6\x97\xfb1\x83fS|\x83\x0d\xd7\x8f.\xed\x1f'\xea\xfba\x85\xbf\xf6\xf2|_t\xf5\xb90\x84\x08\x1eO\x8a\xf9\xf94\xdf5\xd6\xc2|s\x87\x0d\x1b\x80\x17\xc5\x11\xbe\x07\x87\xdf\xe2\x91# \xb7\xda\xd7\xfd\xe9\x04\x05\xedMC\x94\xd5\x86ii\xb4\x95\xb0\xadN\xdf\xbf\xa3\xd3\xd0\x02K\xdfx=9\xa3?\x10|\xdb\x04\xe0\x87E\xc7\xd9\x9f\x89D\x9d\x12\x9f8\x92\xd5\x04F\x1f\xc67c\xcc\xf2>\xa3\x82\xd4lb/\xb9p\xb4\xcf}\xd0\x99\x90\x100\xac\xf7\x17d\x9a\xe2\x17\xed\x0b\x10]!\xd7\xf2\xf2'\x89o\x1d\xb3(\x1f\x01\x1et\xd8\x13]j\xacEn\x8a{$&\xba\x11@\xb8F\xf5\x17Q\xdc\x8b;\x15\x0bP\xde\xc00)_\xd1,\x80\xe2\x03\x96s\xd6\xfe\xd0\xb8\x8a\x15\xed\x85\x10]\xb9n\xa1}\xf4\x02\x9dN\xaa\xda\xf3\xe7.?q\x1e\xe9\x83d=\x93~\xa9\xb4\x8e\xaa=\xfc\xc4\xe0\xc0i\xb1\x01\x9b%\xa1F\xbc&\x16\xba\x0c\x1e\x8d\xdcy\xb2\x1e6\xc0\xafY\xfdF;u5\x8dE\xb0\x8f\xc0\xe1\xf1\x9d\xf9`\xbbL\xfc\x08\xa2\xcb+\x17?\x0e 3$ZEU\xf3\xef\xe0\x11i\x9b%\x0c\xc96w\xfd\xf4\x9e\x0d\x1e\x9f}\n\xdc\xcb\x0f1J\xaa\xc5\xd9\xbd*eX\xcf\x94\xbf\x9b8q\x88KE\xd2\xb2\xb7\x0b\xe2\x1a\x19\xc5\x00\x89\xe2`\x02\xae\xb7\ncxq\xfd\x03f\x0c\x02\xa3o1$\xb6\xd2\xf3\xf7\x9e\xf9V\x93\x02j\xba	\x96\n#\xe8P}w\x05B(\x0f\xe8yu\xe1w\xed\xfb\xd7\xda\x14J\x89!\xa5eM\xeaZ\xaf\xf5_pi\x15\xae\x19\x00\n\xaa\xc1\x18*\xe6n &\x8bv0\\I\xd3\xe1\x89>@\x99\xc9[\x03R\xa6\x9c#\x90\x9d\xfd\xb4~%\x92\x95o 6\x13_'\x02\x06\xf6\xc0\x00\xae\x99\x91\xdd.\x9d8\xd8j\xb1\xd7]R)\xbd\xc9\xcd\xf4\x0b\x8aw\x1aml>m\xe3+\xad\xce_\xacZ\xc5\x9ad\x0b\xa8\xc1\xf3\xa7\xf8\xd9\xaaa+\x18\x197\xdf\x12x^\n\x0ff\x8e^p\xe7\xc3\x0e\xc5\xd9\xc9K\xc5[?\xc4=\x9e\xe7V\xd0\xec\x0b\xc9\x9e\x06U\x85\x1d\x06g32\x08pa\xdd\x13ET\xed\x19\xc9f\xc8\xc8\xc7\xab\xcc\x96PO\x0e\xbe\xb4\xf5i^\xbf\x9c\xb0\xc6\xfd5\xc8R!\xac\x91>4\xe9\xb6\xe9t,\x1b\xe7\xa3^\xea\xc0C\x08\xa1[EN`\x01\x08\xd9\x05B\x0d\x99\x812\xa4'a\x0dIZ\x15U\xda\xc1{\x01\x01\xcdO\xc9-\xd5v!\xe4\x9a%\xa1\x82\xad?\x07j\xb2COW\xf7|F\x9e:m\x11R\xc9\xdb\x99hy\xc3\x87j]\xec\xbd\x0d\x96\x0c\x9b\x008\x06\xeet]\x87\xd3\xf1W0+j\xb2'\x05\xadP\xa3\xdaX\x82r\xe5)]aC\xbc\x7f\xf56\x0de\x8f\x90\xe8VK\xfa~\x81\xc6\x9fB\x9d9\xf2\xc6&\x83<V3iX\xf0`\xf8]3\xa2\x85\xd5l\x99A']\x93\xa4\xb5\xae\xfb\x9b\xd9\xe6\xe5\xfbi~\xd2\xa2\x8f\x93\xe8\x8e\x15\xe3z\xefb\xef\xda\xd4\xf7\xedMd\xb6\x88\x9aN\xd2|\xb3\xc49\\\xd2l9\xde\xe6\xb3'f\xfa=\xb2j\xf5f\x8b\x9f\xb8d\xa0\xd3%\xd6\x9a\xbc\xadB\x9d\x80a=\xc42\xcc\x86:]\xfa\xb4~\x92\x9f\xeb\xd9\xd7\xed\xe6\x91\xd5xd\xf7H\xcc\xa7A\xef\x9dq\x0c\xa4\x841\x80\x05HP\xe8\xf6i\xd4\xc6\xe2\x12!\xd1\x14\xa7\x03\xcf\xf6\xfa\x8e\xd8i/\xa0\xf9\x1d>\x0d\xc0\xf1\x129\xfa\xf8\xcc.\xfe>\xf7\xc3\xc1\n\x81\xa3s\x94s1m\xbd\xdev\x0cS\xe4\xfdB\xfe}^D_5\x0dd\xf4	\x88g\xa7\xfce\x1b\xa1D\xe6\x15\xc8\xacy\xf9\x03\xa1\x9d\xae\xd4\xae\xddO\x1dy\x15j\x8d\x0bf^\xf52\x12L\x8fV\x15|\xbe\x8c\xed\xf50\xfe1\x00\xdb\xe9\x03\xa5\xd9\xd5\xd3\xfe\xcf\x85x\xdf\x18\xecWe\x9fFc\x00\x1a\xf1\x8d\xa9\xbbl\xe6B\xc3K\x84\xe4\xf7\x8c\xf1\xf6\xc5\xbb\xa6B\xf0OO\x9d\xe0\x19\xbc\xdc\x07[\x0c\x85\x13\x04\xb4WYK\x8a(\xe5\xceeU\xe7\xf0\xc6\xdc7\xe2`\xa1\x10qB`\xc45_M*\xe3\x8b\xb3\x90A\xe0S\x87\xc2GV8\x18(\xbc\xfb\x8a\xa1m\x9eh\x8e\x13EgY?\x1b\xee\xd7L\x94+r\xcc4\x80\x1a[\x1cib\xbe];67\x07\x7f5UA\"R\xc2N>T\xe9~\xa6\xf1@\xf71\xc7\xb2eh\x1a\xc7\xda\xbc\x92.\xdd\xf6=\xd4?\xf5Y\xf2\x079\x0e\x80\x9fXI\x10v\xa1\xc2\x9fr?\xa6\xae\x1b\xf5wv\x98\xedGob-\xa7\x1d\xe3f^\xad?~\x06g\xb0\xe2\x1d\xf9`P\xd8l\xf1\xdf\x15%\xe5\x1d\xe1\x93\x1et\x84\xf0	h'\x94[\xd9\x0e>sdyp\x06\xaf\xfdq\x08\xd4\xbdZ\xf2v\xd7.\xea\xf3\xffv\xf9ty\xd3\xce\xc9\x1dYvl\xd1`!\x89\x08\xa7\x19_\xfe\xfdz\xc16\xc7\xa5\xd5\n*\xde\xcd\x18\x7f\x12=\xd0ZG\x16\xa1\xfc\xca\x9a\xd9\x962p-.\xfcgL\xfa\xdb\x9e\x0b\x0d\x1b\xe1\x03\x96\xb6\xb6\x99\xb3I\x17\x9e\x98\xb6\xcdG'FK\xeeSS\xac\xe6e\xf4\xb4\x15K}\x0f\x15\xdc\x02\x1c\xd2#\xa9\xe9\xce\x19\x9d\xef\x8f./1\xe0\xc7\x82:\x97s\x19\xe9c\x159\xc0\x89P\x82\"Y\x8d\xcaB\xa6\x9e\xd4\xa9\x13cg\xcf\x83\x81H[ (W\xb1\xe7l)/Y\xfc]\xee\x9fs\xb12\xd4\xc7\xdc\x01\x8f\x85d\x0c\"\xe7w\xbf\xc7\xe2\xfe^\xbd<\x07\x11\x89\xddZo\xb4uz\x02\xa9\x00@T\x88\xc4\x14\xc1\x7f@6	8\x04`\xc5\xccaO\x8f\x91\xf2m\xdd \xdeu\x9fh\xd5y\xc1\xf4\xfcL<\xda$Y^?\xcekU|\xd5\xc9Z\xfa\xfd\xcd\xfa\xf1\x03	'\x88\xa5\xa8\x00>G\x11\x13\xd4\x9a\x02\x02\xaa\xde\xf5\\e\xf4\xfa\xa1\xf8\xb7v4\xb6\xbb\xd1?c\x88\xb5\x19\x8b\xfd\xc8\xc9\x088w\xe6\xc0V\xf9\x15v\xf2F\x0c\x80\xbe\xf7_AQ\xc6>\x1c\xbd\x0b\xbc\xa6\x04\\\xaf\x07\xa2\xeffrOWk\x13\xfe\xac+n\xfe\xf8\xb9\xe7nS\xdd\x07G\x8f\xa5n\xf9\x1e\xe1w\"\x00G\x8f\xf4\xe1\xd22\xeb\xcex\xeb5\xfc\xa6C+pE\xcew\xd3\xcd\xc5W\x16\xf7\x117\x114D\x02\x9fL\x0f\xa2\x90W<\xdf	\xe8Y|3\xce`\xf4$\xd2?'\xc3\xa5\x18p\xdc8|\xfe\xc7\xd3\xe6\x05\xa66\xe5}\xf0\xa4\xa6\xa7\x7f\xa2\xc9\x8d\x1c(?\xd1\x9b\xb6\xde[=\xd1\xfaI\x04E\xe8<i\xdfB09\xca\x9bY+T\xf6\xdek^K\xb8c\x89\x92V\xc0\x16WNi\x99\x01\x1b\x17\x80\xdb\x98\x87\xd6\x94\xe1\x14\xc0L\xb2\x04\x8c\xb0\x05\x94\xbe\xbb(l6\x07cl\x05q\x80\x01\xfa#7\xb6;;\xd7\x1d\xd7\x0f\xae\n}_\x03\xcb\x04\xd4S\xf9\xc0\x8c\xaa\x1ek\x18P\xec\x0c\x10\xcea\x98\xd3\xbc?\x841\xa2\xaf\x14\xc4\xa8\x1a\x91t\xe8\xb1]\x11\xf1\xc1\xdd\x05\x8e\xd0$*\x04\x05kG\xd8\x082T\xf0\x05h\xdcF\xc409\xc6\x1fE\x86d\x86\x88\xcb\xf9\xed\xc9\x85gd\xe2f\x1dEvF8\x97{\x00\xa0-\xedB\xb2h(o4E\xa1\xaf\x17\xa6~\xa5`x\xb5\xbcQ\x87\\t\x12\x93c,_\x8c\xb3v\x8cI\xefe\xb4\"\xeae\x17J\xbbj$\x1aH\x8c\xd2c\x8a\xfd+\x17\xd6#oRk\x15RNF\xfc\x98a\xf3\xc5+j\xf8(\xba\xce**\x9f\xc1\xddz!\xd6\x1c\xee\x8d:\x8a\x14\x1f\x8e\xeb>\x8a\x8b\xf0\x0c)\xb1\xf2Dj\xc5\xb5\xa0B9\x8b\xe3\x0e\xc8\x12\xae\x04\x13n\xb4\x13\xee\xbc\x12\xee3\x13~\xb6'\xfc&\x83\xfe\x87\xafG\xab\x03\xc5%\xa5\x85\xe5j\xa5\x10hUu\x15\xa4\xa4\xa2A\xae\xe4\x13Zk[;Zu]qcCqKGG\xcd\xe0\xe7\x9e|$\xb4\xbf\x1d\n\x99\x9a\x9e\xae\xa9\x1b\xce\x01\xfa\xc6'gVfJ\x01\xe9\xfa\xaf\x89\xc0\xd8x\xe7\xea\xe1g\\\xc2S\xdcR\xdc\xb3\xed\xf8\xe2\xfd\xce\xe5\xb9\xd5\xe2\x93\xd3_\x84\xe7\x7f/.	\xa2h\x86\x95\xd2\xaaLV\xae.\xf5\xee\xcf\xf3\xda\xe0\x11C\xf8\xae\xe6N\xda\xad\x96\xd8\xa4\xdfm\x97j(\xa5\x85\x9e\x15\xc9\x7f>\x8a5\x92\x88\x05n\xf8\xcc0pu\xb9\x9d\xa8\x97o$\xcb&\xf7F\x93\x99\x7f\xf1\xb9	:h\x8f>\xea\xd5Z\x0c)\xf1r\xec\xa5\x98\xdd\xc8\xd7*t\xa4\x94(\xc7LdcQ\xe9\xca\x16\xcf2\x88\xa6\xc86\xd1\xbb[j\xfa\x88g9w+\xf0\xc5\xb6\x94\x99Y\x82\x00\xdfY3\xad\xc0k\xe4\x18\xbd`\xcb\xb7\x8c&\x89Sl\xf2\xdbT\xdb*s\xb2\xbc\x9d+X[}P\xb3G\x8b6\xaf|C	\xdf\xb0\xf3\xa1\xf0nN\x8c\x93\xf1@$\xe1G\x9d\x89\xfbc&L\x1c\xc9\x18C\xc0\x98H;\xf6\x9d(\xa2V\xc4\xe8t\xdf:\xbb\xcb\xa9\xb6\xcd\x06y\x08\x01\xbb\x9cn\xb0\xc3\x9b\x8f\xf5\xc9p0?(\xaf\xf1l[\x1c\xd4\xd2O>\x7fM\xd4\xdc\x00\x88_\xe7\x8b\xad\xff\xd0\xd5[{\xff\x8ca#\xdb\xa8\xb5\xbf>\xc7r[\x84\x1cp89\xaa\xe4\xb4\xd2\xd9(7_\xac\xe1\xc4y\xcd\x16\x80cy}|]\xf2\xb1\x97\xccq{\xb7\x7f\x14\xe3\xb9\xeb-\x15_\x96\x03\xa5]\xe1fpg\xcd\x8fh'\x7fP\xf9\x84\xcd\xb5\x90+N\xd8\x0dqd\x18S\x07=\xe2\x11\x16\x02\xc3\xe6\xc2\xd3\xaa3\x90\x7f\xc9\xbb8!W\x18\xae\x18;g\x1c\xfcr\xe8^#T\x1c\"8\x01TQy\x18H\xb06F\xa2G\x80\xbd\xee\x8dcqkI\x89E\xc7\x8cI\x17\"\xc5\xd9\xc3p\x95\xa4\xdd\xefi\x92^\xa6\x1f\xcc\x7f\xe0_D\xd1q:\x08\x8db\x01\xd8\xa1:\x12\xcc\x16\x00\x17U\xac:I\x87\xa9\xfa*&!.k\xf3W\xb2\xaf\x19\xc6,\xaa\x12y\xa6\x92\xc7aY_\x8b\xb2\xca}\xf0\xc4a\xf04;\x1dm<n@\x1b\xfe\xf5\xbdk\x00\x968\xc43:\x8a1\xeb\xee\xd2c#50Z0\xafP\x96\xc4f^\xfe\xbdN\xe1\x91\xb8D%A~	P\x08X\xe7U\x1fTf\x10\xdd\xef\xcb\xea\x16H\x11`\x99\x85\xb4\xe3\xeb\xaa\xf02\xe8\xb8\xf6AJ\xb4\xf1\xfa\x10\xb4\xde\xf3\x9b\xcc\xa5\xd2c'\xa5>\xc2\xc6\x1bV~\xc5\x87\x15\x0e\xc1W\x9a\xf6\x8e\xc5\xfa9\x94\x15\xb6\x9f\x8e\xa8\x98{\xb1_\xb3\xb1\xbf?sgi,a\xeaz\xac\xceKm\x15\\\xf1\xc1\xf5\x91?s\x9bM.fok1NY0\xde\x91\xdd:\x87\xfc\xf5H\xd96\x14\xf5H\xfe\xd69\xd1\xf3\xf3	sF\xb8 k\xf6\xc5A:\"\xa6\xfd\xe7\x1d)G\x1d\x13mZ\xef\xf3GJ	\x81C\x12\xdc\xb1>\xcc\xa6U;\xfc\xd9!&\x83DlYFV`wh\x85\xd2\xea\xa9\x07\x94\xd14\xea\xef/\xdb\xe1\xa1;}#\x81\x93\xd6\x95\xef\x86\x816\xac\x97u\xa7Q\xacce\xa5aP\x96\xe2.d\xac8z\xefw\x91[)\xed_\xf2\xf5L\x12\x8cv\xdf\n\x9a\xbe\xb7\xbe\xb0z\x8d\xf1\xad\xb1\xfaU\x06?~\x89\xa2\x0c\x1c\x00\xf7BUoWi\x1es\x1dFM\xf9\xd9XCx\x14\x9f\x1bX\xab\x7f\xa4\xa2rP\x89(\xbf\x93&W\xbc\xe5_C\x1f\xf9\xc3\xba\xaeK\xed\xee\x997\xcd_\xfd#\xc0?\x86\x8cj\xc2\x01M$\xda\xaa,\xc8C\xb0\xb8\x1c\\/\xb8\x0b\xd1\xc7\x83\xafb~\xa5zXL`Q\xed\xb0\x82\xa5[<V2i\xa0\xb1y\xed\x0fA\xa3\xb5X\xff\xe4\x89\xb9\x03h\x88\x9e\xf6\xd3:\xce\xdf\xdeV-\xab=\x94\x13\x1doT\xaa\x8f\xb43\xd2'&\xcbq\x12\x80K\xd2\xdcx\xbf+Y\x11\xbd<\x9f\xe5+\xa8\xff\xeez\xd7\xfa\xa4\x0f\x0ea,\xc1\xe9J\xdb\x81\xbf?\xb6^\x92\xb7\xe3Q\xdf\xda\x0f\x1f'\x0c\xd8\x8f\xcb\xbb\xceh5\xabK\xec\xb3\xeeI\xf2k\xdb\xa7\xc0\xef\x1f\xddt\xb95\x12\xac]\xeb\xc3\x13KB\xc3T\x8e\x90nU2\xb3FK$\xba\x0d\x14\x8835BS\xe3\x17\x1cHK\x10^T$\x7f\xc4\x83\xf7|\x97x\x1a\xe2\x8bE\x0dp\xe1\x01\xb8JJ`,y\x00\xe35J\x18\xf2\xf3<\x02]P'F\xf6eP<:\xbeh\x8foa\xa4\xb6\x1c\x80\xd5~\x11\xf6\xf49\xe4\x89\xfd=7\x98\xae0\xf2\"'\x1b\xb1q\xd7\x80\x82_D\x18\xe1\x8a\xe3\x85EJ\xbc\x97\x17\x07\xa3\xcb\x97P,x\xbd\x99\xa3K\xb2~\x8e\x0b'|\xf7\x83{\x10x\x84\xd3\xe3\x94\xee\x80\xeb\x10\x03\xb9Q\n\xda%W\xc6d\x18YCO\xf2\x9d\xe3\"\xa5\xcd\xc6|v\x87\x04zp\x03@L\x12X\xa1?\x10\x1e\x1dh\x00\xf9\x08\xfc\xef`&\x1c\x96>K\xbb\xa3\xb3\x05\x93{;\xc6\xc5\xf0>I\x89W\xbf\xf0\xf91\xe5\xb6\xe8\x94\xaf$-\x04\x90)B\x00{3)\xa3\x84\xe6\xcc\x9e\x1d\xe3\xd2SO\xdd\xaan\xa8&\x17S\xbe<\x0d\xc626\x9d3\x11\xa8F\x8d\xe2\x87\xf7g\xc1g\xe89+t\xc6$\xd3g|\xfan\xa8\x8e<F\xa4\x92\xd5\xa6\xb8\x05?\xd3\xbc\xaa\x00\xfa\x7f\xb2Kf\x7fU\xaax\xf4\x13\x8a\x86Y4\xbdb`; 9\xc6\x84?\xbfK\xb2\xf5\xa2\xd4\xd5\x80[\xa9H\xfb\xa9\xe5\x19)\xed2\xbd\xf3\x1e\xeb\xa5O\xf2)\x86S\x0f\xb3\xd5\xb7'Pm\x15\xe1M\x16	d\xec\x7fr\x16\x1eO%\x99D\x13\x06)\xcc\xc4\xb3$\x13}g{BY\xb6\xd8\x90)qS\xfa\xac\xe5P\xd44B\xc9\x7f\xe5\x10\xf4\xf8\x0cs\xfb\xd9/\x9d\xc8@\xf0\xab\xc2\x17\x93\xc7\x14\x02?\xd4\xa6\xb2\x88\xca\xf05*U\x19)\x14\xb3\x8b\xc1\xe5\\i\x95dq,\x91q\x1bD\x15\xc2\xecL\x98\xc4\xcc\xb3j\x00\x1f\xe2N\xa4R\xa1\x81\xbf\xc3]\xc3MJ\xfeI\xd5\xfba\x14f\x8e\xd8\xff\xc5\xbb}xS\x1d8\xfe\x1f\x7f\xdf\xcbu]\xd9\xf3\x1aE\xa8.\x92\xbd\xa3K\x19\x99\x11\xd7\xde\x9b\x84\xec}\x87q	![\xd9{\x8f\xb2B\xd7\x9e\x15\xe1\xba\x11\xd9.2\x12%\x8a\xdf\xf9\x9e\xef9\x9fs>\x7f\xc0\xef\xfb_<\xcf\xe3\xbc^'\xe5\x86\x8bBP\xe2<\x86\xc7\xe6\xa4NH\x0fM\xad8	b\xa2n\xaca\x0d\x10B\x01\x8c\xc4F\x877\xb5\xda\xca5vs\x0f\x1f\x01\x8d\x01u<n\"V\x86\xc2s\x9d\xc9}\xf5\xf7\xfa<\x0d\xd5\x89I=7e\x12uOp\xe6P%\xd2\x03\xf6\xd4z\xbc\xfa\x13k\x96C\"O \xba\x0e\x000\x1e\xee\xda\xc6\x0dt\xa9\xac\xb0\xc6'\xba\x14\xb9\xe6*\x00\x90\xf4\xea\x83\x97\x01\xaf\xd2\xdct\xb3	\xa5\xda\xc9\x9e\xbbR\xca<\x03\xfc7\x05_a\xadS\xf7&\x1c\x9b\xfa\x0bPH\xdf3\x93\xe2\x82\x1aR\xca\xb6\xf1\x9882\xa9\x13\xac\xf7\x93\xfb\xda\xe2\xd8\xea\xdc\xf6\xaa\xe7\xd7\xb8\xe4:\x1a-.\xeb_\xf3\x0d.\xd1\x14\x02\x90\xe2\xe0z\x94\xa3\xa7\xf9\x84\x11\x07\xfd\xe3\xc1\xfd\xc7Ag\xa3\xfb\xa1\xa6\xac\xddtu>\xea \xf0:;\xbc\xc3\xe9\xab\x88\x8a\xf9\x06\x00\x1d\x91\x03\xd1\xa3\xc5\x80z\xae\xc5\x1e\xfd\xdf=O\xb3w\x02N,?H\xb3\xfb\xda/B\x00\x98\xd6\xa1u\xab\"j`\xd3Z4\xf6\xdd\xfc2T\x0e\xdeSV\xd6`\xe6\xfe\xdc>\xa76\xb5\xbfk\xbd\x81\xb6[\x07<\x9b\xd6\xce\x0d\xb9\xd9\xeb\xfb2\x0f\xa1\xbe\x18S\xc6\xe6\xd6\xa4\xcc}\xf7f;*x\xe8\xef\xf1-.\xef\xc45L\xa8\x19\x0eh\xac\xc1\x9b\x8c;_/\x98k\x7f#\x88J\x82\xb1W	\xca\x9dN\xd0\xbb5\xbftN4>\xdf\xb7\xc7\xf1\xe99e\x14q=`	\x97o.!KPw\x85\xdb0?\x17\xe6\xa5g\xea\xc7Z\n\xdb\xd2\xb4\x84\xb4\xfe\xe5i\xd1\x92\x85c5\x94\xce\xa6\x92zDU\xb7\xba\x1e\xb3d\x17\xa6h#\x9a\x11\xc7\xdf\xd7\xc4\xa56x\x13\xae\x81_]\xaa\xfbn\xaaI\xfd\x9a\x9c\x8e\xe8\x07_\xff\xb0c\xf44\xb3\x91[\xc3\xb3L\xec\xc3\xd0H\x82\xce\x04\xa7\xb1\xf1c\xb5\xab*\xd2\xe7\xf3s\xe5\xc951\xbdf\x0b\xcaUR\x9f\xf7^I1\xb2\xb9\xbb\xdb~\xc4\n(p\xcf\xc9\xe52\x93%\xff\x9d\xcd\xbb\xc9\x9e\xbc\xb4\xd7^\x93M\xec\x14(\xceD\xbaN+j())\x85sOmUp\xf7y\xa6\xdb\x9f/\x12\xd8e\x8d\x1e_\xd5\xebM\xe3\xceJC\xad\xdf\x96\x063\xa6\x1b\xbe[\\D\xb7\x11Z\xe8\xec\x11\xcf\x0f\x19\x1a\xf2ov\x1c$\xf6bc\x0dx\xff\x92\xdb\xdewKR\x01\xd2\xcb\xab\xf0O\xedCR*\x87R\x02oA\x927j\xbc\x06_[\xbff\x97\x10\xe4Rqb\x19}\xa6\x99$\xee\xba8+;>\xe4\xaa\xff3\xd7\x9b\xa7\x9c\x83!\xa4iny\x86~\xebx\xfd\xdb\xe1!g\\\xf2\x91!\xf2\xeeb\xce\xd2\xdbB\xaf\x8f\xc7\xe5s\xafZ\xbd\x93e\xaeG\xd9p<dxM.\x98)\xbdg\xf5\xf1\x922['\xdc@\xc6\xf4O\xf7\x81\xf2t\xe0\xc2\x8bu\x85}\xf2\xe8\xf4\xb3\x85\x9a\x02_\xd6\x9c\x99\xb3{\xfc=\xfc\x0d?-\xfa\x1d?\x85i\xceu\xfc\xac^n\xcd2\xb6\xf1\xf87\xbf\x1fh\xe8\x04P\xe0\xb3\xce\xbf\x9e\x1b\x8ag\x7f\xb3>\x08\x89:o\xfb@_#uf\xb5\xa5&\xed\xb8B\xf7\"x Ly\xd3:\x8e\x16~\x18\xa9=j\x109\x13\xedv>\x03\x19\x0b\xda]}\xc6.\xc08\xb8\xe9\x17|\xca+\x9c\x7f+m\xdciK\xf9\xde\xd9(\xf7\x13r\xf7\x93\xad\x0c)e,\xc9V\xb9\xe7ME\xd1\x9ejHz\xfb\xc1R\xec\xb2'\xd3!L\xe8\xaf\xc8\xfbW`VD\xe4\xc2\xae\xfb\xa7v\x1c\x13E.m\xcc\xcf \x83\x10[\xc6k{\"\x1c\x10\x8d\xdd\xe4\x7f\xdf\x87Uz|\x0f\"W\xcf\xefx,\xdeR\xf9;u95;]\xf0\xedA\\\xcf?\x92ix\x93\xab\x8eZ\x06\x90+\xad\x9f\xcd\x1a\xc5\x9c\x11\xd5\xd2\x185\xbb\x15e\x0dE\x03\xa7\x91\x97\x93\xce\x06\xa1\x10\x11Nd\x18\xfb\x08\x1a\xe1\x17\x0e\xa4D\xdcB\x07\xe9\x9e`\x98\xe1\xe8&S\x0c\x0c\x05\xf9\x9a\x85\xb92\x82\x19\x13\xa0a-\x04q\xf9\x80\xaf\x9f\x06\xf2\x1bbY\xedL\"\x04\xdd\xb8H\xd8;p\x15	\x04N:\x1b\x8bx\x8ce\xa12Lo\xc3\x96\x9aD\x0e#\xb1\x92\x08\x1c\x98\xac(H\x8a\xd4\x0c\x066*\xa2'\x07\xa3\xc9\xfc8\xd5\x91\x98\x03\xa8\x08\x10\x1cmaf\xc0y\xfa\xc8B^\xd2\x1a\x1fa#\xaf\xf68\x1b\xe7:\x12\x01GG\xbb\xba\x89\x1bT\x82\x98\xc9\xba^\xf2q\xf0t\xc0\x17n\xc8Qy\x1dx\x14\x17\xa8\x15\xcd\x84\x8f\x08\xa5\x8ec-\x8f\xcbb\xc4\x1b\x04\xdbF\x8d\xe0\"\xf0F\xb6\x1c\n\xbc$|b#\xf6\xd6[\xfa\x04<,\x11\x81\xcbk\xbf\xa5\\\x19_\xd0\x8e\xb7\x96O\xb0\"D\xc0\xd0a\xf7\xf0\xaa\x99\xc1\xfa>M	\xc5\xbf\xf0z\xeaQ\x8d\xff\xbd\xf5\xa0\xfd\xcf\x89\xd0\xe4\xff\xbfK\xffW \xa3\x80\xe2\x92\x92\xc2r\xe3\xe2\xff	d*HYE\x93vU\xdd\xdb\xda\x06\xa0\xb9\xf3\xfe\x1b\x8a\x9e\xf7=\xf5\xd5m\x1d\xef\x9a\x80j\xc2\xd8\xfb\xbe\xfe\x86\xa1r\x80j|\xa6\x172W\xfdi\xf250;\xf6\x81\xd4\xde\xb8\x90\xb3\xb8D\xb1\xbc\xd2@\xcb\xb2G[\xba\xb6\xfebsv{\x07\xf8\xbewF\xfb\xf7\xdf\xe0QR\xa7^Iy=\x9fJ\x04\xf5\xb9\xd0_\xa8x*\xc1\x03r\xd0\xb4\xd6\xc6\x90?\x18\x8b\xefdC\xc3\x99\x16Tc\x0eRbjn\xd0\xaf\xf1\xb9s\xc3l\xde\xb1\xed\xaf\xf5a&P\x92~7\xc6\xd6y\xd3\xc51uN\xdb\xbe\xab:\x97\xde5\xe8\xba>\xe7\x9f\xc9\x83\x86\xe3i\xe6\x9e\xdb\xd3\xb6\x1fC\xd5p\x12\xb7w\\X\xa3!f-w\xd0h\x7f\xe4X\x99\xb2\xeaQI\xd0S\xce\x89*\xe3i|\xa7pa\xddB\x1b\xb85P\x9d\x83\x89w\xe4\xe9\xb5\xb1\x1d\xf5+\xc2\xd7x\x0c\xaf,\xd3\xb0\xdf\xe6(\xeb\x0d\xb8\xa0Z\x9f}g\xca\x0e@R</\xe8\xee\xb6K\xae\x18\xffF}\x19\xdc\xb5\xff\xd1\xd0E\xca'\xae\x8doK\xac\xd3\xb0\xfd-\x06\xd9u\x9c,C\xf8\x1b\xae;\xde\xd0\xf6\xddZ\xe8a\xf7f\xbd\xa9(\xb4\xadW\xfc\xe8>*\xc5\xe2\x88\x93\xc8-\xdbn\xb3/\xdb\xfa\xc69\xdf+\xa8\x9c\xfb\xe7\xae\x9bu\xafA\x01\xea\x1f\xa4\xe3\xd9\xe6\xb2\xba\n\x94\xfd_\x8bXYb\x94\xbb\xdf\xab\x08y\xdf}\x8b.\\\x81\x18\x1a\xe5\xed\xdc9\x1du\xbe\xd8\xf6\xefp\xf5\xaf\xde\xcab\xd0\xb1D\x1c\xad\xe2\x99\x97\xf4k\xf3\xb9\xf5\xd6l\x10Hc\xed[\xe0P\xccg\xd43Jv2\xd7\x93^\xf1\xcd\xcb\x04\x1c\x83\xe4\xfdL\x19\xa6|Im\xc8~\xb7\xa9=k-\xac\xd0\xea\x19\x17v\xd8$\xce\xb9\xa8\"\x1f\xc7\xa8Rfn\xc8bd\xc6\xcf$\xda\x03qLlP\x05T\xad\x8e)7\x92\x02\x88\xd4\x01\xcb\xba\x8c\xe5\x01\x0e	\xc2\x99p'\xab\xa7t\x0f\xd73\xc0<_n\x98\xd7\x1f\x0f\x95\x9a\x8aO9]\xf5r\xff\xf1\xd91\xe3!e|\x86\x9d\x05;b\xcc2\xdd\xf74IY7\x12N\x1b\xfdv\xaf\xc31\x17Y\x0f\xb3c\x02\xdc66\xec|-\x02\xdd\"\x00\x9e\xb3\xf2\xd7[\xc8\x11'\xc97\xe6|c\xd2\xf6\x170D\x116\xd3\xc8\x0d\xf5\x1c\x15[dYD\xdcb\x7f\xe2\x97\xc65\x97\x98\xaaRuM\x82\xddL8\xc9\xe5\xfe\xbf\x11\xc7\x8a\xc2gB%\xcf)\xa9F\xd9\xec\xaa\x1dd\x8a\xcd\xd7 ~\xbd\x90v3FL\xed[\x8f\xce\xed\xdb\x172\x1e\x82\x8d\x90\xa7\x01E\xcf\x1ae8<KJ]d\xbeG*<\xa4 4\x8d\xdd	\x13*\xa8&\x9d]~O/A\x14\x8a\xf8=\x99\n\xb9\x9d\xc6\xf4\x80\xe0ei\n\xab\x11\x94QH\xa0Fvp\xcc(\xd1\xe8\x89\xf0\x95\xec\xb7\xa8\xe9n?M,\xe3\x12\x02:\xc0\xe7;{\xe2\xe7\xd5r\xd7\n6\x88\xdc\x87\x84\xc1Z\xbc8k\xa9\x9b\x9b\xa8U\xd2\x18[cwL\xfd\x8e\xa8w\xeba\xbcP4;OX3\x01\xd2\x10t8\x1c\xad(\xecX\xd9\xa8W\xf3}\xa8\xa0/\x96\xcdTtZ\xd6\xb2Nb\x9c\x07\x04\xfe\xc5\x1e.\xf5Q\xab4\xf1F\x97\xd1K\xc5\xfa\xcf\xba\x147\xb3z\xd4\xef\xbby\x8b\\^\xe3FOP\xf3MJ\xcc\x98\x97\xfe\xdd|6mD|5\xe14.\xfcdt\xab\x95\x16\xfde\xe0\x81\x0f\x08\xe4\xc8k\xf9k\xda\x8d\xd7\xf0h\xde\xdfF\xc3\xcb\xb2qOYv\xda\x85b\xc8\xa4\xf7\xc5'\x8f\xf1\xc54\x8a\xc6\xe3%\x88\x15#\xe1\xb3\x0b4?\x10\x15M\xe7\xf2g\xe5d\xfb*a5\x8a\x82\xf8\xe3\x9e&.IN\xcfY\x93\x91\x00\xe4f-^vl\x19\x11^6\xd8\xfd\xe8\x194Y{\xda\x1f\x8b\xde\x8c\xbc\xa4S\xd8\xc5\x18}\x97\xd1\x0eZP9\x87\xb4~KVzS\xaa\x85$\x9c\x94\xb4/\x12\xbb\xfd/l\x9ag\xa9\x0f\xad\xc3\xb7\xae\xf9\xcd\xa7\xf5Ne\xed\x99\x1c\xa3u\x14\x12N*\x86y	'$\xa8\x81b\x8f\xd5\x17\x9d\xf6\x8d\x15\x1d\xa0{\xcf\xd5\xb1\x0f\xe1\xd4\xff\xfe\xd8\x1a\x171}\x85\x158\xbd\xf9\x9ej\xec\x05\xa0\xca\x02\xa1\x84\xad\xb5\x18\\\xaf\x0f\x01\x1d\xda\x1e?\xd2\xe8.a\x15\x8f\xd8\xf6\xe4Ej\xf4\"\xa1\xb9\xe2\x91\xa5\x95\xbcV\xad\x0e\xa5\xb6u{A\xd4\xd4H\x986c\xdf\xaa\x8f6+\x9c\x1a\xa0\xba\xed*\x06\x13\xe4\x03}\xa0\xb8\x0eV\xbe\xd5\xcb\xc2\x95\x1a\xa9U\xca\x9b\x15KT\xab\xaf\xdd\xc3\xad\x9d\xe7\xbdxE\x85\x0e\x04\x96\xfd\x95&\xb8@\xcc|\x18\x06IQT\x13K\x91D\x82\xdb\x03\xe8\xcd\x1f\x89$%6\x96<7\xd5\x15\x18\x13ZH\x9b\x0e\x18.\xee\x7f\xf7\xe5R\x04\xb0\xa2\\}\x9f\xc9&\xe2\x17\xef\xcbq\xe5v	\xe7T\xf0P/\xa6:\xde\xeb\n-\xed7\x92<P\x88\x90lzN0\x93L\x01\x8d\x9b{D\xc2\xd0|l\x0fU\x1f\xa8\xca\x8a\xc7kF\x17\x16\x11\xfb\x86\xc2{c\xfd\xa8\x815*\xd3-\xad\xa0\x10\x1fK\xcb\x89u^\x89g\xea\x06C\x07\xa1\xadw_\xab\xf3\x8d\x0c\xf5n\x88\xa5\x8d7[O\xcf\x946^v\x1d\xc4Y\xeb\xf2\x15=\x0b\xe9d\x96\xfc\x99I\xca#\x82u\x86\xd8\xa8\xef\xd4\xd9\x90\xd2\xd9\xeeF\x8e|p8s\xcb\xa4\xab\xda\x90\x1avWc\xd6h\xc9edU\x97U\xff\xd1O\xd1\xfe\xf35t\xd9E.L\xbaR2\xfd\x05\xf3\xaa`\x85\ntt\x1c\xe2\xf0\x89\xff\x90\xb6\x12\xb9Q5\x8c\xea\xac\xc8\xb7kwP\x94H\xfd\x14\xa1x\x8ca6v\x00SjO\xa1\x99\xd3\x0b\x97\x9c^r\x0cT\x8djK\x1f\x17\xf1\xbd\xb6e\x06=\x9aJ\xb3\xf1/\xde\x9fq\xec#\xc3xA\xca\x84\x9c\xcb\xdf\xb4x\xa9\xfb;\x93j\x99\xfe\x85_K\xc9\x0cS\x9f\xab\xb2U@\x00\x03\xb5\xf0\x03e.\xad\\\"k\xbcuW\x95\xd1\xb7\x1c\x80\xc9\x1fIY:Z\xa9\xe1\xc71S\xdd\xc7\xfa*\x8cO@a;\xc8\xf8\xecF@\x95\x9c\x97\x07T\x91\x8f\xa6\xd2\xe8 \x15z\xe2>S=F,b>\xc9\xb1\x8a@\x18\x8d\xcf\xeb7ZH\x83\x1co\x88P#\x0b\x89\x19\\3\xd9\x11'\"\x96u\xc2\xef	\x8a\x03/\xb5(\xb7\xad\xc6sM\x99fL\xaa\xee\xf9\x0bC\xe5\x0b\xe7\x18\xcc2\x1b\xee9WY\xacH\x98\x15-1\xd7\x98+_Gl\xec\x98\xac\xe1\x8b)^\xeayA\xf5\xc7\xf4f\x8c\x02\x9bC!\x18g\xb1\xb0\xc6D0\x19\x91\xc7\xa3\xee\xda\xb0c\xe8\x9a\x1cX\xf0\xf0{\xf1\xb0\xfe\x95\x85\xa6\xf2\xcc\xe6\xfa\x08Q\xe7\"\x1a\xe0\xcb~@+/\xe1\xc9>9>w\x07\x05@\xd2\xeco\xc9)\xed\x12\x1f\x9e\xfelk\x15tr\x07\xd9a\x8f\xe8\xb3\xda\x87\xf5.\xeb\x9f\x8d\xce[1O\xa0Z\xa7\xbc\xdcY\xba\xb6\xd1g\xf3\x1c\x10\x12\x04\xd0\xce\xe9\\\xe8\xc3\x12\x11D\x9d\xdbR\x83:\xc0J\xae],\xb5\xac\xaa\xb1\xe6u\xf3Y\xc9\xb6\x8e\xcc\x001/\xba\xdd\xe8@\xd5!\xd0\xb9\x1e\x9a\xe2\xe8\xf9\xe3\xfdH\xe2;\xf0\xc7\xb2`\xba\xc4e\xe6\xf4\xc6\x12/E\x1a\xd8r\xd8\x97\xd3\x8bq\xa3EN\xfa\xd8\x9cN\xfb\xef;\xd2 \xdcF\x9d\xe1\xdd\x11G\x86\xa1\x00\x82\xab\xb4O\xc8\xf8x\x9b\x7f\x02\x7f\xce$?&\xad\xe0\x8a5\x87\xf7\x15\xdeK=\x92\xc0\xae\x88\x01\xb40\xb6Tq\xcf[\xaa\x84\xa7\x801\xf8\xcf\xb8\x1d}Kv\xf7\xf7O\xbeT\xe6\xac\xf7o\x1e\xfdM\xbe\xfd\x95\x90\xa06\xaa\xb3$\x02{`/\xa4#Z\xaaT^\x85\x8e\xd8\x0b\xdbH\xb8\x98\xec\xef\\\x97d\x07\x18\xb1\x11` \xd2E\x83?\xef\x06\xc1\xed\xc6\xb5\xb3\xd0\xcd\xb2C\x91\x7f\x08v\xd5\xbcX\xed\x85/\x07\xd6~\x7f\xed\x95\xf0}\xe3\xce\x82Rw/}`\xa6\xea\xef\xbd\x9b\x8eE\xc4J\nZ\x87CGw\xae:\x84h\xaa\x85\xd1\x02\xc2\xe7\x9c\x93 \xc6\xe3Bw\xeb\"Mo\x93\xf8\xa6P\xc9\xbb`]\xa7\x1b4x\xd6?\x8d\xe3\xd6#\x8fv\x95\x95\x16\x88y.\x9e0\xa1WP\xdb\xac\\o\xb1\x8f\xd9\x1d(a\x8cC\x96l\xd0\x1f-|\xf3\xc1\xedX\x97{\xe7_r\xf5\x85\x92\x17:7\xee\x9bq\x8ftDJ\xc8Z\x7fH\xc1'\xbf\xe9;D\xe2U\x86\xf6\x18\\\n\xce\xbfI\xc5nu\xc2\xb8\xdd\xee\xb3\x9a\x0c\x90b5\x1b\xed\x9fD\x1fDr[p\x12\xd0\x03\"U\xdeA\x87\x96Dky\x1a\x82\\\xfe\n&,\xf0C\x8c\xbc\xb4\x95\x9f\xb5\xf8\xe3\xfe\xe1\x15\xd2\xf3\\\x04\xff\x1d_Z\xe34\xfe\xb3\xd8\x93f\xa9%t\xee\xc2\xdfM\xeeK9\xedK\xdd\xc3`\x96\xd5\x12M\xc1\x90^\x17\xf2B%\xfbg\xdcW\xa7\x8e\x81\x0ck\xdf\x9c\x97yW\x80\xf6\x8e\x99\x8ad\xbf\xe4\xe1i\x10n\xef\x0d\x1f:\xf2R\x8d\x98\x91\x8dy\xaf\x1a\xc2a\x9ew\xdc'\xfc\xe0k\xc6>\xfd\xec\xdc\xc7\xc0\xbc\x12\xcb\xfe\xcf\x1aE\xf5\xe3I\xa4P\xdb\x86\x81\x1bx\x0f4\xe7\xe2 \xec\xf4O\x1c\xa7xX\xadz\xea\xaf$*s\xb0\x97\xc1\xdd\x85\x9ay\xa0\x8e\x02\xbb\x8b8\xde\xedU\xbd\xf6v\xf7G\xf7\x01\xd8K\xe1\x06\xc5\x8b>m\x8a\xc2\xb4\xb5Vi\x0cl\xc2m\xd0@\xb92)\xeb\x9c\xe8zf^\x17it\x00\xd9|\x87\xf8\xbb\xff\xf1\x94)\xfdg\x05\x85\xf2]Y\x86\xb3&A\xffc\x02o\xc4v\xd5\xd2b\x9e\xae\xcad\xad\xd8\x93\xbc\xdbb\xe7\xb0\xf5C\x7f\xa1\xc5\x9b7n-\xed~x\\0\xcf\xb7\xe6[\x82\xfb\x14!}\x16\xb4\xeb\x14]\xf8\xfb\xaaA%\xa9\xa13\x01l;\xa5\x8e\x1e8\xcf|\x17\xbe/\xbcu\xd2H\xf15\xc2\xa8\x84t*$\xe8\xf4\xae\xc4\xa5{\xfc\xd3\xc5k\x92\xa98q+2c\x06z$\x17%\x00\x892\x05\xae\x00H\x10\xab8\x18\x11\xa7\xa1\x12\xe0vya*\xfe\x06*\x7f\x96\x01h\xb7\xf9\xa7L:\x06O\xa2\xe9\x11\xff\xd3\xc2\x0cpL2\xfbuVD\x18\xa4\x1f@\x1aC8\xb3A\\Z\x11c\x94\x92\x02\x95 A7\x1c\xab\x0f\xd8\xe1\x96\xed\x801\xc6\x98\x95\x06-g@\xa3\xa5#\x13\x8c\x13\x0c\x063\x9a\xd9\xdd\xd5B0fcAp\x19\xd5\xf6\xc7RZ8u3\x1c}%\xce\x80\x1a\x10h\x8c)\x050\xee%\x98E\xe9\x18i7\x9c\xa0[\x8c%9\x06\xbc\x8af\x08\x8eukG\xdb!b\x1c\xe4m\xd5\xcdb\xe9I\xd1\x06f\xe2\x1e\xd9q^d\xac\xa1|\xf4M\x10\xe0\xa4\x85=\x14w\x0b\xa9\x8c\x0d\xc3\xd3D\xc0\xe3\xd8bq:#\xfc1\xa4h\\&\x00\x07\xe2\x12\x10\xc8\xa4\xec\xd8\xd0SMN<\xe8\xc7z\\F;%\x1f\x1c\x1bD-xo \x1e\xba\x1c_@\xba\x19#\xaf\x93G\x8d\xae2c\x8b\xd1\x02\x95d\x8b\x97Q\x1bT\x9e& \x99\x9f\x17\x8f\xc4\xa5U\xbaK\xb6\xe0\x9fgW>\xdf\x93z\xca\x19\xf8\xbc\x9d\x9a\xa1\x86\x8c\x1dA1\x11HT88\xef'R\xe2g\xf9\xa4\x19\xb3$bp\x12);i\xbe=\xe9+\\\xfe\x7f\x9d\x19\xf2\x7f\x95\xd1\x8f\xff\xd7\x99\x8dJ\xfe\xe3\xcc\x0f\x8b\xab\xebZ\xeb\x1a\x80\xe6wj@5\x05\xa1\xb7\xb7\xfem}qW\x05@\xd571A\x05\x99\xacm\x18)\x18\xfb0\xd7?9\xf5\xa9s&\x8fH\xea\x1b\x98_\x9f\\\\\xca\xeaY\xf9\xb2\xb1Q\xbaEN'\x8e\xcf}\xda[/\xa6e9\xa7\x1d\x16i\xfc\xc1\xf5\xc7\"f/_)\xe2\x9cY\x9f\x0cEc\xeeG\x81X\x84\x04H\xbay\xdd\x81tl\xc2\xfe#\xa9\x02]\xf8h\xaa\x0b\x87\xd6\x0fv\x1a\xc90\xce\x1e\x11H\xf3\xe4\x00\x98\xadlQ\xe9K\xaa\xbd\x82e\x87J\xeb\xf7\x12Z\xae\x87\x97v\x1c\x12wja\xc9W\xbbAC\xfe\xfb\x03\x02G\x08\xa3@\xf8	S\xc0\xce\x84\xfb\xe7\xb2@Tr1\xd7\xc4rM\xd1\xb5\x0e\xd9\x16\x1ac!\x94L	\xdfm\xb3\xf1@P\xb4\xe1G]m\xbaww\xbe\x1a\xb6\xe4\x9b}f*C\xa1\xbf\xbdGC\xdac\x94\x08\x9e\xf7\x8b)\xee\xceX'\xfe\xce\x03C\xd8\x84\x81p\x01\x0f\xd5\xe5\xf1,\xe8y\xae\x82p\xed\xea`\x00\x9d\xf4G\xcae\xdf\x98\xe1\x94[%o\x13;\x02\\,\xf6=R\x8f\x90y9\x0e\xcf\x0b5_\xb2\x07p5\xbb\xcf:\x03\xed\xd7\x92Cz]\xfc\xc4\xbd\xa6m\xe8u\x7fH\x95N\xed\xe8/\xc8T\xcby\x8c2\x0eR\x7fk\xc4\xb4\xff~?\xfc\x9c\xea\xfc\xeb\xab?Z\xe4\xd1\xa6\xf8_\xa8\xab'\x07\xdf\xad[\x83.\xf5\xa3ZN\x07\xe6T*\xa0tO\x14\xc3\x96\xf6\xcb\xa8x\xd9\x8f\xa6\\\x0b\x817\x8f\xde$\xfb\x9d\xffh\xb3y\xb0\xd7\x11\xf7\xfa\xa9\x1e`\xc7\x19\x04\xfd\xec\xca\xa3\xeb`\xfd\xf3R\xc7\xcb\xf1Oz\xb6\x1f\xeb\x18\xf3J\x00A\xfd\xfa\xb7p\xceP\xa8~\xdc\xbd\x0c\xcd|q`\xc0\x84?\x00\xd0\xce\xe6g\xe9\x83\x11\xae>\x17u\xa1\x02Q\x82\xbbdt\xdd\xe8\xae\x99[&\xad\xde\x0d&\xdeS(\x96\xe7cf?#s\xc4\xaa\xb6j\x94\xfa\xea\xc5\xfa\xe5\x8b\x9f\xc7\x07\xb2\x8a\xc8\x15\xfb\xad\x86\x95\x9cP\xc33\xcd\xab\x85\xb2m5\x12~\xde\xf0\xb7\xeaV+QD\xd6\x19\xb9 ]i*\x8d3\xa2\x9a6\x05R\xa0	\xe0lg\xabd6c\x7f\xbb\x04IZ\xfb\xba\xef0\xa3\x02\x16zP\xa5-\xf6bp\xd3\xe7\x01\x16\x8a\xac\xf0\xbe\x99Pj\x92\x02\xb6\xc3\x94\xb4ku\xb2\x94\xb9\xc3\xe69\\\xee]@\xaak1\xc9j\xbbv\xcf\x95\xd7\xd2\xab*\xd4\\\xab\x11\xf5\xe4?\x82\xc2\x86*\x81U\x96\\\xcc\xf17\x9a#\xffUof\x16\xc7JM\x95pX\x8935\x10\x12\xb6\xbf5\n\x83\xdd\xae\x17?u\x1e\x13\xf2\x84\xf7xXQV4\x97_\x12-~+H\x89\x1a\x95k0\xaa\xbem(\x14I\x13\xab~\xa6\xf3\xc0\xd9K\xe2|\xb1\xf6\x03\xca\xf3\x15\xe4(\x92\xe6\x96%E\x00\xe2\xa6r\xf1(7F\x8c\x83\xf5+K\xcf\x99\xcd\x0f/\xcb\xa7\xcc[b\xe5\xdfP\xbb\x8dE\xea\xfc]IXg\xa6\x81\x0f\xc3\xc0\xc4\xb9\x8f\xfe\x1f\xd4\x93\xed\n\xcf\xee\x8c\x1f>T\x14\xbf2-\xca5\xfe6\xfa\xac\xd0kL\x88\x86\xe6\xf2x\xbfU\xd4\xf9\xda<\xaeQ\xeb\xb4\\\xf6\xe1(\xa3y\xeco}\x9e\xf8d\xa8~iE\xd7\x03\xcdDB\xbf\x16N\xa2\xc5\xd3;^D\x9b\xc8\xf4\xe4CC\x8c\xe4\xe3\x88\xb7\xb3t\xa6\xdc\xc0\x145\x9f\x8f\xff\\\xcb\xa4\x9c\xe0\xf4\x90\xb3\xb5(\xb8\xa2:\xc0O\xee\xc9\x02\x18\xfd\xf53m$\x08\xe4} *\xb7h\x13\x1e\x913\xd1 \xab$\xb6\xca\xf1DTn\xbe\xa0\x8fE>\xe7\xd98\xea\xd7\n\xc4\x8a\x11\xf9\xe5\xb9\xd4E4J;*\xeb\xa8\x0d]v\xfbl\xc1w\\\xf4'\xfa\xb1_\xe4\xe5\xce\xac%T\x1cMn\xfa\xd4\xbd\xa3\xc4\xcc\xc8\xf7\xf7p\x80e9\xd8\xce{\xb4\x1bUG\x8d\xaf?\xdd`\xe5M\xe5\xcbqf\x90\x0e\xfe\xdai\xba\xf4n[=\x859\x1cX\x8ec\x88\xfc\xd1\xcf\xeb\xfds\xb9\xb6_A~c\xde\xb6\xa7\xeexx\xf6\xdd\xd1\x89/\x07{\xd8v\xf9\x8b\xa3\xee\xd3\xbf\xbfm{\x16k,\xf3\xd7\x19\x91\x00\xec\x8d\xc1\xd3\x8cPN\x1d#C1\xc3\x94;O'\xdb\x0b\x0e|(\x81\x81\x93\x03,\\z\xe9\xfdR\xda\x1d\"\x1c\x1aF\xd5\xf5\xad\x7fR:!\x02\xe0\xa3\xa8\xb4\\\xfc\x87\x82\xb7\xc1n\x96`\xe1\x89E\xa7	\xae\"\xfa\x8b\xa2\x11\xa3\xf7\x91\x80\xf6#,[\xe6\x90\xf2\xb1\x12- \x8eV\xc3\x82\x90`\x98\x16r\xcc\x8c|?+\xbe\x0f+9\xc1\xf7\xe7\x02zm\x9dn\xfb)\xd8\xca\xe1k\xfe\\\x1f\x8fu\x83\xdd\xbf\x11\x80	\x1a$\x0c\x16D\x82-\x12\xb9;o\x17jplB\x12\x0c\x8f\xbf\xb1\xba\x93\xa4El.S\x03x\x9dV\x7f\x15\xa6\xab\x19\x16\xa6x\x03\xd4\x04\xce\x0c\xb8\xd8\x15\xf8g\x9b\xa7\xdf\x1f\xf0\xad!\xd4W\xac\x0f\xa3\xd8\xfc\xa7\xd9\x0e\xda\xbbxv\x98\xa2\xba\x11\x99\x05cn\x1ak?\x0c\xc5\xbeZ\x08\nl\xc05WL\x1e\xe1|r\x05\xd9E_j\xdc\xafO\xf1<\xa4@\x07L\xa6\x03\x06\xc9\xf6\xdaG\xf0\x0d\x81\x00\x05\x15\xe3#\xe7\xb4p{\x8em\x9e\xa1!\xd9>\xb1\xf8\xe8tg4s\xe5\xd5\xb5\x1bi\x0c\xe2\xebe\xd2b\xf24\x8f\x97&\xd3\xbf[\x14\xf7\xaf\x07\x08\xbf\xc6\xf3\x83\xa0\xf2\x80\xe4\xcb\x11a+fDxk-\xa5\xd0\xb2\xbc\x9e;sC\xee\xc6t\"E\x07\xac\xc0\x10-\xf5\xea\x94uK~\xfc\x8f\xae!\xf6\xd3\xab\x14Fu%\xe8\xd8=\xae\x9e\xc1\xcc\x08J$\xf3\xcf\x9cO\xbe\xfb/\x0b\xcc\x01\x02\xc55`\xd8\xbf\x1cW\x08\xdf+\x13\x12p\xff\x14\xb6\xca\x92C\xeb\x85\xd0:\xa9R\xd0\x93\x94bey\x8d\xb8\x04\xce\xa8\xa3\\\xfcs/7\xa46\xd4\xee+N\xa7\xc4\xfa\x95\xca\xc9\\y`\x92\xfe9\x1du\x9c3\x19\xbe\x8d\x1c\x85\x02y\\\x19E\xf2\x1f\xb6\x99\x03-\xae\xc7\xff2.[u\x14\x12(\x1e\xd5\xd2\xa4y\x1b\xecvw\xc7\xf0e\xe8c\xa6t|U\x0b|\xf0\xb1\x90\xe2\xfd\xc2ML\xf5\x0b\x9e\xfcGS\n]\xb5\x1e\x19\xe5\xd7\xd4\xab\xa9\xbb\xe6E\x9dw\x1019\xac\xbbf\xa6\x1e\xc4Z\xb3\x8c\xbaA\xcb\x1a\x8d\xa9\xca\xc96.\xf6\x02~2!9\xd7cn\xf7\xc1f\x13\x1b\x12sOQ\x91XP\xed\x97\xeca-\xf2\x83z\x01C?s\xdc\xa8\xb1w\x1b@\xf2\xcd\x96i\x8c\x82*\xf5\xea(\x1e\xf1})N\xf0o0\xdc\xdb\xe5\xd1W\x18L\xd4Om\xec\x04\x90\xaeJ|\xd8v\xec\xaf\x92bH/T\xab&\x86G\x81P\x02Czy\xae\x9c\x19\x8fk\xac\xe2A\xd4\xb7u%,\x91\xb3\x00\x9a\x82\xb4\x81v\x8d\xf0$\xd1\xdb\x05\xbd\xe9\xfb\x87\xe4W\xff\x91\xbd\x16i\xdc\x91\xf6\xf6\xd2\x9d\xb9`ln\x8b\x7f\xbfa\x7f\xe0\xb6\xfd\x0d\xf4\xd0\xfe\x8d\xc0\xb6\xae\x7f\xb5\x86,\x93\xe6\xf4\x89\xbf(\x04O	>\xe7\xe6Kk\xfc,oS}E\xf5\xf2,\x89hm\xbe\xce\xfb\xaf\xd7\xc2}\xcf\x99\x0c\x83\xe5\xde\x91\x16\xebC\xc5jI\x87i@\xfa\xa0\xb2\x1e\xc48o\x9e\xf2@\x0eDKo\xeb\x0e\xc1\xa08\x9bYZD\x0cA\x80\xee\xe1\xadE\x97\xb6\xf7\xf3\x01\x95Ab\xb4K\x0c\x8e\xe3\xb5\xb93LA\xde	\xab\xf9\xb2$&\xd1%g\xd5\n-\x1a\xb6\xd2\xe5Q\xa1\xec&}\x90\x8f-\xad'G\xff\xdd\x9b\x98\x17\xdbB\x0b\x06\xc5\xf4o\xef\xb3\xbb&\\~1\xca\xfa\x98\xf2z\xca\xb9\x19F\xd4]\x0b\xaa\xe5\x14c\x1f\x92\xcc\x0c\xd1\xa1\xc1\xee}\xbf\xee\x04\x00\xf1\xbfi]6\xb9\xcc\x07\xad\x9f\xe2r\xa6_\xa1\xb8\x9as\xe2\xf0\xfao`;\xdc(a[\xf9~\x9bA\xf6^\xfd\x98\x14\x18e'G\xf8\xc77\xdes\xbb\x8f\xfdmB\xe9\xee\xc4\x88\xd9]~{\xf8\xcd(\x8b\xabgv#5F=oA\x91\x02)\xf0\x8fZ\xbf\xdc\xf5\xea\x1c\x7f\x85t+N\xab\x12\x12oJ>\n\x11\x0b^/9pp\x1c\x1d\xa6\x85[\xb2rI\xacp\xbc\x91\xdcu\x11\x98#:]\xfdV\xc1\x99\xf7\xc80zy\x9e\xf41\x90\xdaM\xc4#\xe9l>e\xf1\xbb#\xa2'\x07\x90pX\xfe\x8a\xd0\x95M\xe1&\xec\x07\xbe\xcb[\xfc\xb0\xf4SY\x81n6J?\xacj\xfec[\xab\xe3\xc8\x9e\x06\xff\x9d\xb6\xe5\xe49\xd9=g\x8b\x19\x85\x99\xaf\xe4\x8f#\x1ah\x1at\x90\xd2\xdf\x95\xc3\xc0V\xf9\xab\x93\xee\xee\xc3 \xe0\xfa\x08\x99IJd8J\xc4'\xdf\x8a\xca\xed\xfe\xf1\xf9\x94\xa0\x0b2\x1c.?\xd1\xc7\x0d\xfd\x91\xf9\xae\x0b\x94s\xd1\xd2\xbdb\xb7\x97\xf8\xfb`~V\xc5Y\x80\xa2\xb0\xc3\xe8R>7\xc9\x1do\xf8I\xef\x81c\x89\xd5\x86\x9d\xfd\xe9x\xd6G\xff@\xd3\xbb\x9d\xbfQjj!3{6G]aO\xea7\xb5\xcbT.1\xe1	/F6TB\xf5\x1c\x16\xe5\xffh\x17n\xbb\x15\xb8Q\xf8\xec1K[\xfe3\xf0\xbeiS\x8c[:9\x8cg\xcd\xb9\xc5\xc0K\x13\xbf*\xb3s\xf6\xba\xf38\xe2O\xaa\xdf^ \xf5\xee\xccV\xfc\x1d\xd5?\x95\xd2\x18\xbf(\xd6\x80!\xfdS\xed>\xc2\xde{\xcb\x8b#\x9e\xad\x90\x996\xc0\xfa\xd2\xfa\xb56^\xb3E\xff]\xf2'\xa1\xbaR\x01\xcc\xc7\x0e\x04\xf9\xf9\x1f\xa2\xe5lq$9\xfcR\x98\xf6y\x06>\xffGY\xc5\xe7\xa6\xe1\x9e\x90\xaa\x1a\x95\xad\xda[\xbc3a\xde\x12\x1e\x81\xde\x94\x02f\x15\x15\xae/\xce)\xc83\xa4w\x9a\x1195txv\xb1\x14\xb0\x80<\xe6\xe3Z\xe5c*\x84\xe1\xefI\xde\x12\xcd\xf6\xb6\xefP\x8a\\0\x99\x03r&T\x18\xb1\xad\xf2l\xcc\x87>\xaaoT\xff\xa2Y\x87\x1b`\x9dD_\xfcl\x00\xfd2\xedYh\x0cR\xbc\xa9\xe9\x0f3\x8d\xb2q\x8c\xec\x18\x8c\xfa\xd7\x16u+%|F\xe6\xc9]\x87g\xd4AO?\x0fT\x88qWD\xd2\x8cD\x91\xe6\"\xb9O\xe8\xd9\x1d\x9fQha\xa8*\xaf\xc2*\xa3\xfeU`.\xa0\x98\"\x00\x1b\x9c\xf5L X\xe7\xd4\x15{\x0b\x1f1\x12\x17N;\x8c]/\xc5\x8ai\xf1Ij\x81\x82\x07!4&O\xcf\xdf\xe0\\\x00\xe0\xfc\x16\xcd\xadIt3[4\\<\xfa\x01\x9c\xa7\xa41J\x96,\xf30\x1a\xf4\xe2wtoS\xa4Ev\xb8\xb1Y\x8cvLLHy\x0cy8\xc6\xda-f\xc5\x18s\x12\x17\xe5@\xc6\xa5\xc7\xe9\xba\x90\xc3\xed\xe0\xb1\x9e\xed\xd1&dC7\xadH\x0f\xf98\x9b5F\xbdl\x83\xa0\xec{pD,2\x93a)(\xc6\x97\x1a\x87\xad\xbcJ\x9f\xca\x1c\x95\x1d\xcb\xb7)s-\x18\x9f&\x1f\x9fn\x16\x9f\x19\x1c\x9f\x9d\x1d\x9f\xdb\x1e\xff\x8a\x14o\xc1H	(\xfd\xbf\x00\x00\x00\xff\xffPK\x07\x08]12\x95\xaeZ\x00\x00\x8f^\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/en_GB/common.jsonUT\x05\x00\x01\xc8r\x08f\x8cVAo\xdb\xbe\x0e?'\x9f\x820P\xec\xff\xc7\x82\xee\xbe[\xda\xb8k\x86\xb4	\x12o\xc5\xf0\xf6\x0e\x8a\xcd\xc4Zd\xc9O\x92\x9b\x05\xc3\xbe\xfb\x83H\xc9v\x8a\x1dv2\xf9\xe3\x8f\x12E\x93\x94~M'\xd9\xc1\xd8F\xf8\xec#\xfc\x9aN&\xd9^h\x8dU\xf6\x11\xb2\xbb\xf9\xf3s\xbe\x80\xbbo\xf0\xee\xc6\xbd\x83\x87\xf5\x16n\x1c}\xbeg7\xee{\x96\xcd\x02\xbfB\x85\x9e\x1d\x16\xf9*/\x06\x8f+\xfb\x16\x853\xfa\x0f,Zp\xa0\xcbF\x1cq1\xac\xb9|\x9a\x7f\xca\xe1\x8f+\x13u\xd7\x1a\xa9\xd0\x8e\xc9\xbb\xcdz\xb9\xca\xb7o\xe9\x1a\xcf\x1b\xe3\xbc[\xea\xa2\xb6(\xc8\xe1\xa6\x02\x8dgh\x8d\xf3\xff\xb8\x7fAj\xf0d\xbbe\x97\x80\xbb\xb9\xae\x96a'\xb7n\xa4\x8fQ\xddT\xbd\x8f\xd0\x15\xdcT@\xc1\x04\xddD\xd6\xb0\xc0\x9f\xfd\xaey\x9d=b\x15\xc2\x0b\xac\xcdzW\xc0\xe6\xcb\xf6\xd3\x9b,\x8d\xd3n\xb1\x92\x16K\xbf\xdc$\x8f|\x0b\xdb|\xb1\xdc\xe6\xf7!S\xc5:\xd2\xaf\x93\x90\xdc\x86\x14\x14\x8f\xdb|\xbe\xf8\x0bWW\x8b\xca\x9c\xefd*\x8f\xdd\xe3|\xb1~\x81\xbb\xe5\xdfT	\xa7ue\xcaSa\x8eG\x85\xe3\xado\xdc\xf5F\x9d\x1ej\xf0\xcb\xf3u\x152\xe3U\xe2\x19\xab\x9dhp8\xfe\x0e\xd6\x0fP<\xe6\xb0\x9b?\xe5\xb0\xdc\xc0K\xbe\xcd\xe1\xeb2\x7f\x19yO'\xbfg\xd3I\xd6\xaa\xce\n\xe5R\xc9W\xe2\x92}\x84\xffL'Q\x9e%\xc9e\xd3\xc9\xe4\xbf\xb4em:\xdb\x93H\x99\xf5\xe2\x88\xd6H\xddy\xec\x89Q\x9d\x8d\x941\xd9h_\x0f\\\xd2f\x83<b:,\x8d\xaezjTg#eD\xbe\xa0\x18B%e\xd6\x8bLKi\x08\xd5\x99\x92 \xaaFR\x7f\xceI\xa0\x95\xc4\xd1\x04$|X\xd7\xdc\xc2sm\xf4\xa51\x9dc\xb84\xda[QR\xed\xde'\xf9\xca\xc2\x0d4\xb6C\x84\x88\x86?[A\xc7\xcbr\x96F\xe8\xe0\xca\xb6+\xc7ZV!\xd9\xd9c\xf8\xf2P\xa0\x00\xd3\x01~\x08-\xbd\xb1\xe4\xfe\x84\xb2\x8a\xe7\xf8\xd19\xffl\xce\x01\x0d\"hsf\x832\xe5\x89\x0b/J\xfc\x9f\x84;\xd1\n\xc2\x9d\xe0 \x15j\xd1\xe0`*\x8a`\xdcb\xabD\x89d\x7f\xe7 P\xe0,}\x0d\xd2;\xa8\x85\xab\xe1U\xa8.\xb9\x99\n\xad\xb8\x8a\xec\xfd{6\x99\xb3F\x86\x1fQT0\x8a\xda!\xce\x95\xa2\xd6C\x04\xa1T\xeaK>\xc9\xaeN\xa7p<\x13	\x8c\"7!\xb5\xde\xce\xcb\xf2t\xa1\xfe#\x1d\x1c\x03\xb1\xf7\x86\x14\xf42W\x95\xe9\x02\xf8\xcf7\xd3\xfd\x9b\xfa\xc8]t\x19+-\xab\xa4+\x8d\xd6X\xf6C-\xaaR\x1fcX\x17]^)\x89Xa\xd4\xa6T\xc1\x99\x97\x0d\xa6\xb2,\x85B]\x8d\n\xfa\xb3\xd0\xb1\x9e\x1fp\x1f\xa5\xa7\xbe\xc6\xe7\xad\xed\xb1\xd4\xc8\x9f;\xddK*\xf1\xbac\x94v\xd8Fi\x1d\xabv\x92=\x9b\xd7(-\xb0\x1c\x1a\xeb\x8cx\xea\xe3\xd8\xf5\xab>\x99$\x15]\xea\xf5\x17L\xedY\xd4]\x8a\xd7\xca\xb4\xa7\xf0\xe3>\xecd\xdf\x84m\xab.\xf1'\xcf\x83\x0c\xde\x84?\x0d\xdc\xaa\x14\xc5\xde\x08\xcb\xf73	\x11\xf3\xde4\x0c\x92\xc4?@\xe8\x12i\xad{\x96\"\xea\x852G\x86Yd\\\xc90\x9d\xef\x07\xa7\x00\x84\x00\xca\x917_\xe7w)\x06\xbe\xa9a?\x84R\x19M-\xb9\x08_BBG\x14\xc6\xac\x84=\x92\xe5A*\x04o\x0c(B\"GKWs\xdd=$\x99,GcB\xd1\x1a}\xbc\x8a\x89ap&\x15\x94lZc\xfd\xbd\xb1\xb6ki\x12-	\x80\x83\x90\n\xab[\xa0]K6\xdf\x8e]\x161\xe0\xc8w]Y\xa2s\x87N\xddBQ#\xb4\xe2\x18\x1aY\xa90%\xc0\xa22\xfd\xf3@\xb6\x8f\xc2\xd5\xe4\xbb\xa1\x16gX	\xbe\xc6W\xe1\xcb\x88)\x85\x97<;WIN\x96\xd3\xe8.\xe6\x86\xe4\xcb\x12\x82m`aU\x98\xbb\xfe7\xaf\x08	\x89\xd8\x8f~x#\xeci\x87\xa8yX\xd9\x13\x08\x07.\xe8l\x0d\xa3\xe4\xabt\xd2\xe8\xf4\xd2\xa0\xe9\x02\xaf\x84\x8dH\x0c\xf0\\\xfb*\xf1<\x94 \x1c\xaci\xc0\xd7\x08.\x0c\xb8\xe5&lA**j}\xa2\xc5\xa5:\xe7\x9f\x84/)C\x1b\xe1\xdc\xd9\xd8\xcaA\x80\xa1!\x9ch\x1a\xcfC\x06\x9e\xf1\x1c\x8f\xcf\xc6\xd6H\xedCQ\xf65\xbb	H8\xf8U\xf1Rp\xc3UA\x0f\xbd\x0f\xac\x7f(\x8aU$\x85W\xd6\xf0\x10e	\x0e\xc6\x02Y\x98\xf4\xbf\xce\xc4\xc7\xda7\xd3A-^\x11\xf6\x88\x1a\"N\x1c\xfbf\x8d\x84\xf2\xdb\xea\xee2\x9cg\x1b1\xca\xe0\xferu\xb6\xc4\x0f\xc1\xf2\xb4\xee\xd9-CW\xb4t\xcd\xb0\xe6\xc0\x87\xee\xa1LP\xfe[,\xe5Ab\x05\x9dU\xc9\xf1`\x91\xebs\x1b\xc5\x88\xb7\xea\x92\xc5+\xeb\xd2c\xc6\xfa\x08\x06)\xa2\xbe\xb3\xf1\x9c$1\xda)\xcer\xb8q`K\x1a\x19\x1c\n\xcb\x7f{\xc7RD]\xa8\xa6\xfcg+\xe33}e\x8eRC\xc4\x01\xa3\x81\xc9\xb59?\x1b/Kj\xc9(\xb1\xc5\x0b\xdf\xf1\xb6,1\xda\xed\x1bIq\xefX\"\xd4\x0f\x1d5\xca\xb7\xaf\xbbf\xaf\x85T\x92gI1\xd2oocO{\xd3r'\xf2\xad\x90u:\x8d\xa6\xd43}Y\x08\x0d\x83uT\xf7\x9d>\xa7\xaa\xff\x12\xc51>\x14G\xb4^\x15E\xd7\x86\x01\x13\x86\x151H\xa37\xc5\xd8\xbc\xb1\xe6h\xd1Q6\x18\xc1\xaa?\xc1\x9bM^\xc6[L'\xbf\xa7\xbf\xa7\xff\x0f\x00\x00\xff\xffPK\x07\x08\xb8\x0cr\xe9a\x05\x00\x00\xf5\x0d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xfa\x0b\xa4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/en_GB/server.jsonUT\x05\x00\x01	\x905f\xbc;]o#7\x92\xcf3\xbf\xa2\xa0C\xce/\x8a\xedl\xf2\xb0\x98\x87\x00\xfe\x18\xcfxc{\x1c\xcb\x93\xc1\"\xc8\x02TwI\xcd5\x9b\xec\x90l\xcb\xca\"\xff\xfdPUd7[\xd2\xcc\x06w\xd9{\xb2\xba\x8a\xac\"\x8b\xf5M\xfa_\xaf_\xcdV\xce\xb7a\xf6\x06\xfe\xf5\xfa\xd5\xab\xd9\xd5\xd9\x8f\xb37\xf0\xf3\xebW\xaf^\xcd\xae-\xa1T\xd4\xceB\xa7,\x1a\x88\xf8\x12gsF\xbe\xb5\xd1k\x0c\xb0r\x1eb\x83\xb0T\xd6\xa2?\np\xe5\xf1\xd7\x1em4[8\x0bOX\xc3\x8f=\x06\xa2\x11\xc0\xe8\x10A\xd9\x1atA\xb9u\xb52\xb3\xd7\xaf^\xfdB\x84g\x7f\xbb\x7f\xfb\xee\xb1\xe9\xdb\xa5U\xda\x84a-\x04\x868\xc2e\x11-\xae\xfbJA\x1f0\xc0'\\\xde\x17#`\xb9\x85\x1aW\xaa7\xf1\x18\xdeZ\xb54\x08\xb1\xd1\x01\xf4\n\xb6\xae\x87\x8d\xb2\x11\xa2\x83\xd0w\x9d\xf3\x11\x9c\xa9\xd1\xc3\xd2\xbbM@\x1f\xe6\xb0P+\xe558\x0fg]g\x10j|\xd6\x15\x06P\x91\xb7\x8b/\x1d\xda\x80\xe0V`\xdd\xc8\x16\xa2W6t\xca\xa3\xad\xb6\xc7\xe3\xae\xee\x16W\x9f\x86\xbd\xdc\xb9H\xe4\x11\xae\x9c\x87O\xce?\xa5\xdd\x9c;\xe5kP\xc6\xb8M\x80VE\xf4Z\x19\x88\x8d\x8a\xa0\x03X\x17!\xd0\xac\xe8`\x89\xf0\xacq\x83$IP\xb0q\xfe	\xd0>k\xefl\x8b6\x8e|\x95\xd9\xa8m\xb8q\xd5\xd3\xc0\xfd\x8cA@0\"u\xeebtmZ\x01\x03C\xe5\x9d1\xda\xae	\xdd\xa95\xc2\x92\xc7\x00>\xa3\x85M\x83\x16\xa2Z\xd2\x92\x1a]\xd7h\x0bn\xd6\xd9m\xab\x03\x8e\xcc\x06\x880\xb8\xd4\xa13jK\x9b\x84\xce\x85\x88>\x80\n \x13]\x1f\nZ}\xad\xddO\xce\xf4mA\x8d`\xf0,@!(#\xe8\x18x\x02\xc9\xa3\xed\x83\xaeX\xcd\x9eu\x8d\x0e\x88!\xfap\\\xd2\x8en\xadW\xc5*5\x89\xbb\x86w\xd7W\xf0\xb8\xabd	\xcb\xc8B\x03\x07j\xcb\xf5m\x1f\xc7E\xd2\x07\x9c\xab\xeai\xed]ok\xf8\x89\x16\x91H1N\xace\xc0\xf3\"Kj2!\x93\xfb\x0c\xa5\xb3\xba\xd5\xf6\xeb\xd0a\xa5W\x1akh\xb1\xc5\x00\x9d\xee\xb0\x86\x10\xbd\xd2\xeb&\x82\xb6\xd1\x91\xb2{h\x9d\xd5\xd1\xf9\x82\x0d\xe9\xda\xdb\x97N\xfb\xed\xc8\x8a\xf5\x0f\x19\x08Q\x0f2\xbe\xeb\xdb%z\x92qM\x9a\xb3\xd1\xb1q}\x04\x8b\x1b>\xc3\x00K\\9\x8f\xa0\x80\xa9\x92f\xd4h0b\xbd\xc3\xefN\x15\x87)\xdc\xac\x1a\xd9(9H\x92\x0f\xd1\xe6)\xc7p\xa1,T\xceF\xa5-\xf4\x1d\xe9\xe47\xa7`\xdc\x06}\xa5\x02\xc2\x8d\x8a\xda\x82\xc1\xc8\xba\xe4<X^my\xdcL\xe8QG\xb3\xcb<2L\xb83~\x8f\xfd\x1cB_5\xa4\xa2G\xa4\x06=\xfc7\xdc*\xbbvG\xc7\xf01\xad\xe5\x14\xaaFyU1\x7f\xe3\xecz\xc2\xb9.\xc5[o33|\x89@\xc8\xcc\x90\xe48N\xabT\x17\xabF\x0d3/\xd2w:\xfa\xf0D\x1e\xcf\x07\xe2_\xb9\xb6#Q\x83\x824\x8b=r\x85\x9e\x05\x16Ux\"\xbf\xfb\x84\xe0q\xadI3\xd8\xe9\x92u\xc4\xc6\xa3\xaa\xa1\xf2\xc8\xb0=\xf6\x8fj\x1dv\x97\x00\x91\x80i\x13j\xcdK\x08\xae\xf7\x15\n\xdb4*8\xd3\xb3\xcb?\x86\x0f\x16i\x12)\x85W\xb6v\xad\xd9B\xd5\xb8\x80\x96g\xa0\xaa\x1aX\xa3E\xcf\x06\x98\x08\x1c\xc3\x03\xfe\xdak\x8f\xb5\xecQN_E0\xa8B\x84o\xa1\xd6\xab\x15z$\x17\xae\xd6\xe5Y\xd3Y,*\xe7\xc7\xa3\xbe\xc8\xa7\x03\xa1S-\x04Ff\xcb\x8e\x9a\x81\x1bd{q+\x8aFz\xb5%\xe7\xa7\xc6s\x15/Kgt\x0cg+\x02\xe0K\x85X\xb3\x8fl\x10\x8cn\xb5\x84\x05:\x17\xd8hc\xc0\xa2,>8\xf3\\\x9cN\xb9\xd4>D\xd7^,\x16\xc3R\xd3\xb2>\x06\xf4_\xd7\xb8\xd2\x16k\xb8X,\xc0\xf7\x06I\xb7T\x8d58\x0b\xd1uYq\x02\x1a\xacHr\xb1\xc1\x16\x0f\x10\x7ft\xebu\xa1\xf8\x17\x0c'\xaa\xf9\x14\x19O\x0b'\x92\xd5\x88\x1eH\xa5(Z.\xf4R@\x89i\"D\xbfi\xc7\xb4\xce\"\xfa\xce%dXg\x91\x94 `$$\xad\xbd2z\x12\xac\xd2\x84\x1be\xd7{\x9c\x8c\xb2\xeb^\xad3\xb3\x9b\xf4	\x9d\x920\xb6\xc3\xb4$\x1az\xe5\xabF?cAt\x84	\xbdb\xd4\xb1\xf3k\xd0-\x11\x0fH\xb0\x82\x96\x0e\x94G<\xb8\xa5\x8b\xa3i\xdc{\n\x8c\x11*\xaf6\x06}6\x8f\x0c\xa6`#\xd1e\x83\xcb\xa0#\x0e\x03G\xe7\"\x8c\x00\xedZ\x93\x94l\x8d/\x8c_y\xd7\x82\xaa*\x0cAtM\x87\xe4\x16\xf7\x16EJ\xc3^m\\\xd8\xa5`H\xf4_+\x8a\x17\xc9?\x0f\x16?Y(E\xe1-1s=\xad\xbaS>\n\x7f:\xaa#\x9e\x7f4\xa0\x19!t\xeczt\x97ET\xac\x9d\x1d\x05~\xa5\xad\x0e\x0d\xdc\x93\x97\x13\xa6\x17\xc6\x91\xc6uhw|\x1fRF\x91\xe7\xbd\xad\xd1\xc2\x83\xaau\x0e{#\x00\xac\xdbph\xa7\x05H\xf2Y\x10!s^*c\x06J\xff\xf5W\xfa\x04e\xc3f<\xa2\x1b\xcaG)u\x10\xe8\x90\xcb\xa6\xc1\x8d\n\x0d9\xd8V\xd9\xcf\xc4\xa1\xd3a*9\xd4\xbf\x9cN\x83AtQ\x99\xe2\xa4\xb0U\xda\xbc\xf5~\xdc\x1e\x01\x00\xbdw\xc3\x92\x929\xf2P\xda\x99 \x0f\x10\xb9U\xdaL	e\x11\xd1\x0c!p`\xda\xbd\n\x85\xe6\xaa\x106\xce\xd7\xfb3\xa1K\xa8C$\x9c\x8f;[\x08\xe8\x9f\xd1\x03%\xd2\x07h\x15\xd8\x03\xe4\x16\xfd\xf2 \xb5\xcf\x13\n\xfd\xb2v\xad\xd2\xb6\xa4\xf6\xd2 \x9d\xcdH\xea\xe5\xbd\x00\x84L\xc6\x7f\xc1\xba\xf1\xa5S\xb6>+\x94\xe6-C\xe0\xcc\x18\xb8\xa6)\xf9\x90\x12\x9ct\x84I\x85c\xaa>n\xe7p\x7fy5\x87\xdb\xfboY\x1d8\xf1r\x1b\x9b\x92$\xaa	\x8e\"\xa8\xd5\x8a\x1d\xf61\xdc\xa5\xfc)\xd1\xa0\x01\xa0Lp \x0b\xc1\x89\xec_\xba\x89\xd4_JQ\xbfH\x01\xd3I\x91\x15\x1d\xac\xb4)B\xc1\n\xb1\xa6|S\x94d0\xc9\x04\x15\xd1\x16\x91\x07V\x13\x0c\xd1\xabS\xd6N	E\x83\x1c~v-ne&\xd9\x029	\xbf\x05\x81\xce\xb37b\"\x92\xf6CU\x0e\xa1\xa0\xc6R*\x08:_aM\xf5\xc3\xb8d\x06\xc1\xd9P,\xcc'n\x8ec/\xe5\x93a\x0e\xd1\xeb\xaer5\x8aa\xf2F\x0e1Y+c\xd0oo]=\xfa\xaaw\x02\x03\x06\n\x87\x0f\xd6l!4.\xe7\xbb\xc9\x0d\x90}\xca\xd9\x15\x14\x8d[*s\xf9\x87\x02\x05\xc8`\xb3\xfd\x0fG\x8cD\xa5P\xa7\xb5seR\xf0N>\xe7\xc5\xc7gl$U|y\xe2{\xfe|\x03\xa7\x83WG\xe5SY\xb8+\xebF\xd7x\xae\xad\xc5\xba\x9c\x8ep)\xa5\x02\x07\x87|\xa4\x8c\xc85b\x90\x12\xb8Q\xcf\x08KD\xbb_\\\x10\xe9I\x85\xcd\xf3	Bg~\xa2\x8c9)	K\xa8\xa2\xccq\x8d5	s(\xad)\x00p1=\x04>\x9e\x0c-F\xc5\xb3\xa6<\x1f\xb0\xea}\xd0\xcfh\xc6,\x7f\x80\x89m7\x9a\xb2\xc4/l\xcbcg\xb4\xa4\x8a\xaa\x94\x1c\xf8\x82\xf8\x84+\x17\xa8a\xba\xd7\xbd\xce\xc8Ph\xc3\xcf\x8b\xc6m~\x81e\x1f\xa3\xb3d\xc2\x9dQU\xaat\xf6\x8b\xd9\xc6y\xfd\x1b\xa9\xb7\xb9s\x9b{	\xaf#\xab\x01y \xfa\xeer>0\x02\xb45\xa4\xae^\xc5\x06)\xd4*\x0b!\xaa\xeair\x98\x03\x13\xd2\x88\xcf\xb0\xcf(a\xf9\xed_+\"e]\x88\xca\xac\xb5\x1a\x89\xe9Q\xd9n\xdcZ\xe7\x94\xa7\x18@j\xfe\xdeQ\xbc\xc9\x03\xd9\xd5\x03\xc3\x80=}\x18s\xa5\xbc9\xb1\x8e\xce\xe3\xb3\xc6\x0d{\x96\x86i\x0ct\x95\xf7n\xf3\x03n\xc3\x9dz\xd6kU\xf4\x07\xce\x08\x03O\xb8\x0d`\x057\x92\xcf\x83I\x1b\xa4\x0e}\x89T\xd32#\xd7\x07\xd1\x8c>\xe4\xc2C\x0d\xb4vv\xf4\xe0\\\xfc\xf0\x8c\xde\xeb\xc2\xb3\xc9\xc6\xbcs\x11\\\xc6	\xdf\xeb\xd4\x13\xa3$-:h\x8a\xa8$\x0e\x05\x02v\x8aJ40\xae\x92\x12\x92\x8bJ\x05\x17\x97ws@\x1bQR\xa7Uo\x8c\xb0Pu\xed1\x04h\xd0\xe31\xdc \x99/\xb6]\xdc\x12\x07*7hxJ\xd7\xf3\xe0cx\xfb\xa2\xa8\xa0}\x03GM\x8c]xsr\x92\"\xac\xb4\xfa(|\x1f\xed\xecuZ\xee\xc9&\xffH\xa9\xd7\x89\x0e\x81\xb2\xb2\xd7?\xb3\xb6\xd3\xed$^_\xb7E\xbc\x96\x8f!^\xb3\x80\xa7\x11[\xcc\xe4J\xc7i&R\xe8a\xca@\xd2\x19i\x1b\xc4	P=\xc4U1\xeb	\x8d\xf0\x18\xf4o\xc8y\xbb\xafSO/`d\xe3\x19\xf9\xfdZ\x8f9\xd8\xf5\x8f\x97\xe7\x89\x0b\xc1\xbf\x900\xfdSqS\xa9h\xd2f\xc0\xbc\xfc\x1c\x8a\x86\xeb\xcbp\x0cy\x0cT\xca\x82\xa3\xc0*\xee\\|b!B\xa3\xedS(\x04\x10\xd1[e@\xc0\xe9P\xebz\x0e\x1e[\xf7\x8cd%X\xeb\x08\x98\x07~\xff\xfd\xf7'\xdf\x91_8\xf9:n;\x04\x8f\xdc1\xa8\xcah\xdd\xaaP\xb9Q\xca\xb7\xf2)\xc4S&.Cr\n\xb4;\x95\xb5m\x98?\x1c0I+i\xb9\n|.\x89S1=\xf6\x85\xd6\xde\xf2''\x16T\x12':\xdc%\x1fu\x8f~\x0c\x19\x81\xa4 \xdc'.f\x92'Zi\x1f\"<\xeb\xa0'\xfc^\xde\xb3\xe6\xef\x18J#\xe6\xc0Z\x9e\xd8\xde\xaa\x17\xdd\xf6mF\xb9\x15\xf4]\xea=\xecf;\xadzY\xe8\xdf\xf6\x8c\x8f4\xee\x10EF\xec\xd3\xa3\xa0t{>\xa1\xfaI\xd7\xb1\xd9!\xbb!\xd8A\xba\x82\xf9\xe2Bq\xdd?\xfe4\x8a[>\x85\nE\xb9\xd4\x9b\x92\xd4 \xb7U+\xe9\xcd\xf2\xfa\xc8ar\xf6\xca\xcd\xe4]\xc2\x8b\xc6\xf9X\xf5\xf1?\xc1\xc0\xd5\xe8\xd5\xc4\xccnG\xd0|\n\x98\x9a\xda8\x8e\x8d\xad\xb4\xb39\x85\xe4\xb1\x03okRpr\n=\x85\x80\x0c\x97>\x8d\xf6\xd0Zl\x9d\xd5\x15\xd3\x1d\xd7f\xf1\xc9\x1d\xd8\xfc\x1d\x83\x8b\xcd\x07\x08[[5\xdeY\xfd\x1b\xd6y\xd3\x94lQ2\xc4\xbd\xcc\x82h\xd9$\xbe\xdbi\x0fS\x96\x14\xa3\xaa8L\x0d\x0d\xe8rE\x9b\xa1\xa8\x1d\x97\xb3\x19\xca\xd9\xbd\xf8O\x13\\\x88\x93\xc1E\x9b\xe2C\x87v\xe0S\xccrQW\xc5*\xe5S\xa6\xb08\xca\x1b.e\xa0\xc5\x10H\x85\x93\x17\xa1\xac3\x0e\xa5Tn\x01\x93'\x99pXi	\xb5\x03\x9fK\x0cO4\xe3\xae@f\x1dx\x87\x11\xea\x84/'\x07i\xbf\xfd\xda;J\xb2I\xe4|\x18\x1b\x15\xab\x06t\x00\xb5t\xbd\\\x84E\xe5\x8b-:S\xefI\xf2\x83\xa9?/\xc9\x9cR\\\x1c\xe8\x1e\xe7T\x04r\x1b\xf9q\xac\x0boU\xd7Q`r\xab!1w\xdc\xd5\xdd\xf1\xa0\xb9\xa7\xcc\x0dP\xfa\x1e\xee>v;bn\xc3\xe9\xe8\xc0|Sd\xa7\xfc15\x13\xb9\x0b\x90IRep\x12#E\x14k\x9c1\xb9s\xe6l\xf4\xce\x90m\xf5!\xee7\xa6\x9d-\xab\xacnW~\xf7\x9f\x93\x1di\xd7E\xea\xcaM\xd3\x19\xd2\xc5\xa1a\xf7\x87\xd2\x9a\xa1+\xa7\x06\xc5\xfd33\x1b\xa2w\xcd\xf9\x89\xe4 \xa3\x93\x96\xdc\x9e\x17|\xa3\xed\xd3^\xee\x9c\x06P\x00\xc7:e\xb2\xb6Nq\x8d?	E\xc7[\x19]=\x1d\xc3'R\xdc\xd4\xde\xac\xe79[\xc6\x90\xb3\xe3\x92\x92\xb6!\xa2*\xb5\xa0\xf3\xbd\xc5\x9d\x8e\xe8=\xc1r\xabr\x9el\x8a\xbdbV\xbd\xa1\xd2\xa4\x9a\xb0Q57E\xc7\x9b.\xf2Yw|\x0d\xb6\xc3\xe8\x91/Sv9\xc5\x04\x9d\xb0J\xc0\xcf\xf0\x12>\xda\xee\xb3\xd9\xf6\x03\xf5\x85\xe9# _j\xe7>\xd9\xa5\xe3\xeeRx\x1agxn\x9c\xe69\x0f'\xeadl\xa4\xa6\xaf/vQ%d\x91\xdf}\xef\xfa\xc2\xa0\x1e$\x94\x91\xa3\x86\x861\xf3\x02n\xb6\xe0l\x85\x80\xcf\xe8\xb7\xf0\xcd_d\x08\xa8\x10\xf4\xda\x92R\xd24\xbe\x7f\xe2\x1b\xb7\xe8\x86p\xc3\xb7,\"\x9db\x11\xcbG|\x19\xfd\xf3\x03\xd6'\xe7\xa6Gx\x1c\x9f\"\xe4\xa2\xcc\x93_\xb55,	\x1f\xa9t\xd2+\x107\xcc\xcd\x14\x1d\x1b8\xfa\x87?\"/x\xf4\x8feQG\x10\xcb\x0fvR\xc5\xab\x9a\xb3\xd3\x91\x05\xf7\x98\xba\xd2\x1c\xcb\xf9kM{(\xe6'\xc0\xae\xa5{4*\xeag|\xd4E\x94{H@ h\x88\xaa\xed\x06\x91f\x0c3\x8e\x03\x9a\n\xa5\xe37p\xf4\x0dK\x17\xd4\xdaMv\xd3\xa1*E\xc6\x9f\xfb+\xe9\xcc\xf6a\x92\x12\xfe\xfc@\xb0_\xc8\x9f	\"yh\xca\xaf\x19\x95\xbb\x08\xc9\x00=\xfb\x1c\xae=\x0e\x061\xaa\x03?>\xdc\x8c+\xa1\xba\x90\x00\xf3\xc9g\x9e\xccI[\xba\xf5\x1d\xee\xff\xc8\xe2\x82k\xa7\x0d)\xe8\xbc\xa3,B\xee@7\xce?\x15\x86\xcf\xb7e#O\xfe\x12\x86\xe2\xe9\xe56m\xbf\xadIpy\x8d\x02\x1bmk\xb7\x19I\x862\xcb_\x8c\xd7P\x97\x8emw\xd9\xb7\x9d\x84s\xb9RM\xe2)\x82\xbb\x84\x8f\xd4\x80\x1c\x14\xe8\xb8\xe4`\xc6sX`E9=\xc3\xe6\x89\xa7\x89\"\nA\xe5\xfe&\xeb\xfb\x90\x9d\xa5{T\xa2\x0c\x0fX\xb9\xb6E[\x8bC_\xe2\x18\xa5\xbe\xfb\xd2\x95uP}\x85V\xb9b\xbb}\x85wg\x1f\xd2J2\xfe\xb8r\xedg\xea\xc2\x80\x81\xdc\xfe\xce\xeb\x82\xb3\x14s\x136\xbd3HTI\xf1\xe9\x1c\xf8\x81Ao\xa3\x96\xb40\x07\xea\xd4\x1c\x97\xbe\xa8\xae\x941[0\x8e\xdbw\xae/\x92\x96\xd0\xa8VO\xdd\xdeb\x04\xcdw\x00_t~\xa1s\xda\x94i\x84\x14!\x8b\x0c.\xbdn\x1a\xbbWu\x84\xa8V\xab\xe9\xc3\x83\x05\x81\xe0\xb1xx\x90\xbd\x17_\x17\xf0\x8c\xe4\x19\x93ZJ\x1b\x11U]\xae\x8e\xbc\xdb\xc4a\xf1\x8b\x82\x03\x0ek\xa5\x0d\xa6\xb2\xa8X\x98\xdc\xdb\x0es\x8b[\xdc\x05\xdf'\xf3\xad\xf3\xce\x8d\xb2\xe8\xb6\x1c\xea\xadz\x19fs\x11\xc6\xef\x94X\xf7\xff/\xcfG\x12\x89C\xefG&\xdc\xf5\x98\x12\xdfj\xfb\xff\xc1\xfd\xdf<\x1e\x91\x9c\xbf\xc6Py\xdd\xb1\xbf\x8e\xe5s\x92\x9d\xd6q\xe4\xceB\xd2\xa4\xc3\xfa\x95\xcdBz\x10\x83~\x89F\x1c\xb85\x8d^U\xd8\xbaq\x8d\x0c8&\xc8|\xfa\x0d\xca\x92\xad\x85\xca#\xda\xd0\xb8\xb8g\xbedx\xe7\xef\x06J\xe9\xc5\xc0\xf8\x0ei\xba\xb6\xf4b@\xde\x89\x8dcv\xa8\xfd\xe1>\xc9!\x12\xf2\xe0\xebc7\xccO\x8f\xbe>v\x99\x90%\x8f\x1a\x10Z\xac\xb5\xca\x0f\xc4v\xe6_\xba\x8d\xdd\xa5\xc0\xb0d0\xf8E\x1a\\/\xa5$\xef\x83\xe5`8\x10\xfb\xc4\xb5TN\xeb\x9c\xe5\x9e~6\xc4\xb3\x89\xd3Ru]\x04	&\x8a\xf5\xfe\xd4\x91-.\xdbiw\xfa\x13.o\xffMs\x9a\x87\xec\xf5\xa6\x87\x98\x1a\xe0`\x87;\xe5\x93e\nM\x81ur7\xf6\xc9\xf9'\n\x91Y\xab\xde\xeb\x1a\xc3p\x7f)]\x04\"\x12\xc4{\x1f:\xccLs\xe7]\xca\x9f@y\xabl\x8d\xa3_\xfa\xbb|\xce\x8b\x8f\xfdx\xf5\xfa\xd5\xef\xf3\xd7\xaff\xa9\x17\x9b\x1f\xc1Zy\xbb \x7f\x99\xb6\x98\x0b\xc1VZ\xaae\x01\xc8\x96R\xb3*\xe3\xe4;\x91\x0e\xceG\xdao\x0e$\xb3sN\x16\xb2\x83\x9a\xdd\xa8 \x97@\x85\xd7\x9a\xe5Z\xb0\x00I\xfe\xc5\xd1P\x00W\xe4\xd9\xe5\xfb5K`\x16\xd5p-4{\xc7\xa9\x80\xdc\xeb\xce\x16q\x9b\\U\xf62\"\x01\xa1\xd3\xa7m\xe4>N\x18\xe8a\xdb\x19*\xb8\xb2\\\x96\xca\xde\x97v\xfcw\xd7\x17\xd7r\x1cAk\xe9g\x9f|\x15N`\xb9\x85\xaf\xc6G\x15+g\x8c\xdbP\xa8%;s\xf6M>\x1a\x8a{Ke\xa5\x00e\x07\x8e\xa4\xb4_\xf1k>M?\x8e\xb3-\x95]l\x0e\x97\xfc `.\xb1\xf3\xfa\x9e|\xf7W\xa9A\x95\xc4\xdf\xeb\xe9\xcb\xe6\xf2Q\xb3l;\xa5\x18\x84\xcb)\n\x17\x11\xec\xe4[e\xd5\x1a\xdb\xdc\x93\x9d\xa9\xba\xe6\x81u\x9d\xbe\xb9\xaa\xe1\xa0\xcep)r8\x8a\xcb\x80\xa5b\xad9Wv\xf8\xb6\xe8\x17\x1dV!q\xc4.\x86\xe2A\xc9\xdf\xee\xdf\xbe\x9b\xc3\xfd\xdd\xbb9?8u^\xcc\x99\xe2\xb8D/hS\xd7\xb3\xd6-\xb2\xe5\x06\x8a3\xdf\x9e\x9e\xbe|sz:\x1f\xd0\x1c\xf9s\xc7\xf5\xdb\xd3S\xf8\xe1\x9c7f\xf9\xc5\x9e\xad\x8f\xd3\x82\xb6\xbc>qW\xc5\xcb\xc3\xc9\xa3\xc3Y\xd5(\xbb\xc6\xa2!4\xbb`\xc8\xb4\x1d\xc4\xaf\xef.\x06i\xb6,L\xb7*\xb3\xcd2\xa9Y\xe6\x07\x91\xb3\xca\x90 +\xa6\x9b~&8*/P\xfa!0gWz\xdd{\xa9\xed\x19\x99!)\xd0N\x87-\xe45\xc9d\\\xf1\xc2d\xc6\xc9xA\x8c?KJ\xb5\x8a,\x90K\xfa+\x10\xce\x0f\x86)e\x0b\xa1\x1c\x90\x83^\x1e\xc0\xde\xa7\x1c\x90:\x8f\x19\xdf\xe5\xbe\xe3\xac\xee}\xee\xfc\xcd.\xf3o\xc6\x88}\xb0\xee\xbcM?\x19\x9e\x1fm\x10\"?\xedH\x98\xbez\xfa \xeay\xf5\xf1\xe2\x07\xf8pu%\x08y}@\xf0w\xf2\x8b\xa1|c:\xbb\xbe\xcc_h\xa3\x8e\xac#\xd7\xf9\xb7`\x8c\xc1\xb5L\xbf\x96\x9f\x93\xdb\x8b\x19e\x9c\x17\x856\x19]<\xd4\xabJ\xdd\"\x88\xb6\xeb=\x9bP{\x06\xf0%\xfd\xff\xee\xf4\xf4\xe5\xbb\xff\x85\xfe\x1b\xb7v\xdc\xbb\x9e\xdd\xc8\xaf\x02*o\x81\x08Ae\x06\xf7\x01\xd3\xbf\x01\xc8\xa8\x9d&\xed\xac\xec\xcb\xee\x8fx|\xe43p\xbe\x92G\x00ak+\xac\xc7\xa7\xbc\x9c\x15p\xa9\xbb\xec\x8d	M\xba\xe6(\x82\xd3\xecC7\xb6|gnc\xef\xdc\xd0\xe0bg\\s9\xe26\x96[IE\x9f\x8b\xdbv4j\xe8m\xcf\xba\xde\xaf\x07\x0d\xbc\xa7\x0fV\xc0\x93BK=\xd6\xdac\x15\xaf\xefi\xccC\xfa\"\xbf~}?\x1d!\x99\xd1\xee\xa8\xd4\xcb\xe1\x91\x12t\x1f\x9d3Qw,\x08m\xe4\xd2Xr\x9f\xe5\x16B\xbf\xfc'Vq\x9e^F\xfb\xe4\x83\xb9a\x84\xb6RA\xfe\xdf\x81T;\x18\x15\x1a\x0c\xc7\x90\x95\xc5\xe3\xba7\xcaS\xfc\xf0R`\xa6\xa81\x0b\x18\xcf\xd3\x03\x8570[`\x9c\xbcW \xf4\x8d(`F'}\xe4D\xb98\xca\xd0\xa8\xdamx\x90\xfc*\xa0\xe7\\\x91$\x04,u\x9e\x92b?\xe3\xa8B\x187\x9b\x06Hb?8\x8aEYH\xa6!\"\x14\xc6\xa6\x9f\x02\xdfZ\xf1\x97\xceZ\xac\xa4;\x1cU\xec\xc3\x88\x1e<\xf1G\xab\x7f\xed\xf9\x8e\xd0\xcacaUQ\x91r\xc2\x0f\x14)n\x16iE\x94n\xdbl\xe8\xaf\xcd\xa2\xb4\xaa\xb8FO\x90m'\x10\xfa\xcb\x90\xde\xa6@\xf7\x91\x7f0L\xb2[\xf6\xbc\xa2\x1e\xf0)A^\xbf\xfa\xfd\xf5\xef\xaf\xff'\x00\x00\xff\xffPK\x07\x08\xeb\xec\xd7\x86\x07\x12\x00\x00|4\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/es_ES/common.jsonUT\x05\x00\x01\xc8r\x08f\x8cV\xcdn\xe38\x12>\xdbOQ\x10\x10\xf4\x0c`d\xee}\xb3ce\xe2\x85\x13\x1b\xb6:\xc1`g\x0f\xb4T\xb6\xd8\xa6XZ\x92\x8a\xc7\x18\xf4\x8b\xeck\xec#\xf4\x8b\x0dX$%9\xe8C\x9fT?_\x91\xc5\xd2WE\xfe=\x9ddG2\x8dp\xd9g\xf8{:\x99d\x07\xa15V\xd9g\xc8\x16\xf3\x97\x97|	\x8b?\xe0\xd3\x9d\xfd\x04\x8f\x9b\x1d\xdcY\xfe\xfc\x99\xdd\xd9?\xb3l\xe6\xf1\x15*t!`\x99\xaf\xf3b\x88\xb8\xf1\xefPX\xd2?@\xf1\x82\x03\\6\xe2\x84\xcba\xcd\xd5\xf3\xfc\xf7\x1c~\xb82C\xf7-I\x85f\x0c\xdeo7\xabu\xbe\xfb\x08\xd7x\xd9\x92uv\xa5\x8b\xda\xa0\xe0\x80\xbb\n4^\xa0%\xeb~\xb1\xbf\x82\xd4\xe0\xd8w\x1fB\xbc\xdd\xceu\xb5\xf2;\xd9M#]\xcc\xea\xae\xeac\x84\xae\xe0\xae\x02N\xc6\xeb\x14Q\xc3\x02?\x8e\xbb\xc5u\xe6\x84\x95O\xcf\xa3\xb6\x9b}\x01\xdb/\xbb\xdf?Ti\\v\x83\x954X\xba\xd56E\xe4;\xd8\xe5\xcb\xd5.\x7f\xf0\x95*6\x11~[\x84\x146\x94\xa0x\xda\xe5\xf3\xe5O\x84\xdaZTtY\xc8D\x8f\xfd\xd3|\xb9y\x83\xc5\xeagX\x12\xca\xba\xa6\xf2\\\xd0\xe9\xa4p\xbc\xf5\x9d\xbd\xdd\xa8\xd3\x03\x07\xbf\xbc\xdc\xb20 \xde%^\xb0\xda\x8b\x06\x87\xe3\xefa\xf3\x08\xc5S\x0e\xfb\xf9s\x0e\xab-\xbc\xe5\xbb\x1c^W\xf9\xdb(z:\xf96\x9bN\xb2VuF(\x9b(_\x89k\xf6\x19\xfe=\x9dDy\x96$\x9bM'\x93\xff\xf0\x965u\xa6\x07\xb12\xeb\xc5\x11\xac\x91\xbas\xd8\x03\xa3:\x1b)c0iW\x0fX\xd6f\x83<BZ,IW=4\xaa\xb3\x912\x02_Q\x0c\xa9\xb22\xeb\xc5\x00Ke\xf0\xecLE\x10U#\xb9?\xe7,\xf0J\xe2D\xde\xe2?A\xd7\xa1\x85\xe7\xfa\xfb\xff\xb5l\xa2\xb5$\xed\x8c(\x99\xba\x0fI\xbe\xf1\x84\xfe\x19\xfb!\x9a\x18\x86\x7f\xb5\x82O\x97\xcd\x9bV\xc9\x98p4\x0f\xb19\xeb7\x91\xb5\xac|\xb1\xb3'\xff\x0dC\x81\x13L\x07\xf8*\xb4td8\xfc\x19e\x153\xfe\xdaY\xf7B\x17>[MF@#m:\x8d\xa2\xf2\x1c\xb8\x17\xa5\xf0\xab\x84=\xf3\"\xc2\x9e\xe1(\x15j\xd1\xe0\xe0*\n\xef\xdca\xabD\x89\xec\xffd\xc1C\xe0\"]\x0d\xd2Y\xa8\x85\xad\xe1]\xa8.\x85Q\x85F\xf4\xc9%-8\xe9\xa218\x16$L\x05\x1b\xaf\xc76D\x9c+\x15b\xac3\xc2\x80\xa3\x8al\xea\xd1p\xaa\xe8\x8a\xc60\"\xb9g\xa3\x18z\x92;q\xefdy\xber;\xb2\x0e6\x18b+\x0e\xe5\xe8\xe5@2\xea\xbc\xf1\x97\xa2\xfb5u\x95\xbd\xea2\xf2.\xab\xa4-Ik,\xfb\x11\x17U\xa9O1\xab\xab.o\x94\x04\xac0jS\xe6s\xe6d\x83\x89\xa4\xa5P\xa8\xab\x11\xbds\x9d\xba\xeb\x11\x0fQz\xee\x19??\x98\xde\x96\xda\xfa_\x9d\xee%\x95p\x91\xe0\x93l\x8fm\x946\x91\xc4\x93\xec\x85\xde\xa3\xb4\x94\xe5\xd0f\x17\xc4s\x9f\xc7\x92\x9a\x88Y\xf7\xeb\x0fy<K\xec\xf7L\xd2ko\xdb\x8b\xc3\xb8+;\xd9\xb7d\xdb\xaak\xfc\xd9s/\x83#\x10JAh\\\xce\xe2\xe0\xf9\xd1\x13%\xd9\x9c\xa3\x86\xa3\x0e\xe2k\xeaR\xa1K\xe4\xa5\x1eXJ\xec(\x85\x13\x8aN\xc1\x11\xc4`W\xd2\x0f\xeb\x87!\xcc\x1b|\x06q\xa5\xf8\xb3\xfcM\xbdHI\x84\x8b\x1b\x0eC.\x15i\xee\xd0%\xc5?\x95\xf9\xee(\x88\xd6\xc2\x9c\xd8\xf3(\x15\x82#\x02\xc5\x96\x88\xd1\xd2\xd6\xf1\x96@\xd3H-R\xef\x9e\x88<kI\x87\x94='\xa1\x15F@p\x08\x03Jp\x8a\x92\xe2\x10\x90MK\xc6=\x901]\xcbCj\xc5\x068\n\xa9\xb0\xba\x07N\xa0\x0c\xee\xfbq\xc82\xe6\x1e\xf1\xb6+K\xb4\xf6\xd8\xa9{(j\x84V\x9c|\x7f+\x05\x9a.`PQ\xffp\x90\xed\x93\xb05\xc7n\xb9\xf3\x83Y\x89p\xc1\x7f\xff\x9fr\xb2IM\xab\xa8\x14N\x86\xc9\xbaNr\xf2\x9cG7u\xe8\xcfp\x95\x82\xf7\x0d(\xac\nZ\xf4\xbf}\x8b'Qy\xaa\xc0\x91t*\\#\xccy\x8f\xa8\xc3\x183g\x10\x16\xac\xd7\x83\xd7O\xc7Wi%\xe9\xf4\x0c\xe1\x81	\xefl\x1b\x81\x82!L\xbcW\x89\x97\x81\x91p4\xd4\x80\xab\x11\xac\x1f}\xab\xad\xdf\x82UT<	\x18\x16\x97\xea\xac{\x16\xae\xe4\"m\x85\xb5\x172\x95\x05o\x86\x86\xed\x0c\xd3x\x19\n\xf0\xd2\xe1;\xc1\x93T\xf1D-I\xed<E{\x06o\xbd\xc5s\xf4\x86\xca\x9c\xdcp\x8f\xf0+\xf0\xb7\xa0\xffV\x14\xeb\x08\xf2O\xb0\xe1\x95\x1a$8\x92\x01\xf6\x04\xd0\x7f;\x8a/\xb9'_=_\x9fR\xba\x9e\x9b\xe6Cx\xb2\x867\xd7\xe2:\x1ce\x17m\\\xbc\xc35\xfe\xd4[\xbc\xcf3\x8c\xed\x1e\xdd\x06\xd3\x0d,\xdd=A\xb3\xe0|\x1bq\x11\xb8\xf4-\x96\xf2(\xb1\x82\xce\xa8\x14x4\x18\xd8\xb9\x8bb\xb4\xb7\xea\x1a\xac\xb6\xed\xd0:\xd1\xdb\xc9\xb8\xe0\xf0\x92\xe8\x13p\x9d\x89\xa7=\x19\xb4\xbd\xbdS\xa1\xd0;\x16\xd8fQ\x98\xf0\xaf\x17\x9d-\xfb\x9b	\xad\xe7R\xfeW+\xe3\x0b~M'\xa9!\xda\x01\xa3#\x80k\xba\xbc\x90\x93%\xf7d\x94\x82\xc7	\xd7\xf1\x8e\xfb \x05kwh$\xa7\xbd\x0f\x12[\xdd\xd0N\xa3\x92\xbb\xbak\x0eZH%\xc3X)F\xfa\xfd}ljG\xad\xf7\xcd\x8d\x91\x87X\x9aN\xa7I\x95\x9a\xe6\x0f\xea\xa0\x16\xef\x08B\xc3\xe0\x1d\x11\xbf\xd3\x97D\xfb/Q\x1c\xdb\x07\x8aD\xef\x0d5\xba\xd6\x0f\x19?\xb0\x18\xc1\x1a?7\xc6\xee\xad!\xff;\xb8 \xc1\x82U\x7f\x88\x0f\x9b\xbc\x8d\xb7\x98N\xbeM\xbfM\xff	\x00\x00\xff\xffPK\x07\x08\xba\xd8\xb6\xc1\x91\x05\x00\x00\x13\x0e\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/es_ES/server.jsonUT\x05\x00\x01\xc8r\x08f\xbc;Mo\x1c7\xb2g\xf9W\x14\xe6!O\x97\xc9H\xd9\xe4\xb0\xf0!\x80>\x1d\xedJ\xb2\xa2\x91c,\x82,P\xd3\xac\x99\xa1\xc5&\xdb$[\xa3\xf1\"?f\x8f\xef\xf8\x90\xdb\xbb\xfa\x8f=T\x91\xdd\xcd\x1e\xc9\x8e\xb1o\xf3N\x1a\x16\x8bUl\xb2\xbeY\xfa\xc7\x8b\xbd\xc9\xd2\xf9:L^\xc2?^\xec\xedM\xce\x8f~\x9c\xbc\x84\x9f_\xec\xed\xedM.,Oa\xd4\xceB\x83\x96\x0cDz\x8c\x93\xa9L\x9e\xd9\xe85\x05X:\x0fqM\xb0@k\xc9\xef\x078\xf7\xf4\xbe%\x1b\xcd\x16\x8e\xc2=)\xf8\xb1\xa5\xc04\x02\x18\x1d\"\xa0U\xa0\x0b\xca\xb5Sh&/\xf6\xf6~a\xc2\x93\xbf\xdc\x9c\xbd\xba[\xb7\xf5\xc2\xa26\xa1\xdf\x0b\x83!\x0e\xf0\xb4\x89\x9aVm\x85\xd0\x06\n\xf0\x96\x167\x05\x06,\xb6\xa0h\x89\xad\x8938\xb3\xb80\x04q\xad\x03\xe8%l]\x0b\x1b\xb4\x11\xa2\x83\xd06\x8d\xf3\x11\x9cQ\xe4a\xe1\xdd&\x90\x0fS\x98\xe3\x12\xbd\x06\xe7\xe1\xa8i\x0c\x81\xa2\x07]Q\x00\x8c\xf2\xb9\xf4\xd8\x90\x0d\x04n	\xd6\x0dl!z\xb4\xa1AO\xb6\xda\xce\x86\xaf\xba\x9e\x9f\xbf\xed\xbf\xe5\xdaE&Op\xee<\xbcu\xfe>\x7f\xcd\xb1C\xaf\x00\x8dq\x9b\x005F\xf2\x1a\x0d\xc45F\xd0\x01\xac\x8b\x10xUt\xb0 x\xd0\xb4!>I@\xd88\x7f\x0fd\x1f\xb4w\xb6&\x1b\x07\xbeh6\xb8\x0d\x97\xae\xba\xef\xb9\xcf5\xd5\x8d'X\x18\xf7\xbe%\xf4\x80`\x10\x1a\xf4\x91\xf8^\xc8k\xe7\xbb\x0d%\x14\x08\x95w\xc6h\xbbz\x0e\x17\x14%\xe0J[\x04m+\xd3\x06\x07U\x8bV9\x99\xa0\x10\xf1\xe3\x7f#\xf0_\xa0P9\xab\xb4\xc2b\x8f\xd6\xd9m\xad\x03\xf5[<\xb2\xce\xeaZ\x7f\xc0n#W,B\x1e!:\xe5\x02\x18\x17\xa0q!\x92\x0fP\xb9\xda\x01\xda\x8f\xbfY]\xbb\x82f\xab\xb4\xfb\xc9\x99\xb6.\xa82\x0c\x1e\x120\xd1M\x18|\x89\xb2\x80O\xb3n\x83\xaeDH\x1f\xb4\"\x07\x8d\xc1-\xf9P\x92\x8en\xa5\x97=\xd9^X\xf9 ^]\x9c\x03Z]\xa3r\x99\xc5\x11\x8fJ\xb9LX\x03\xbd\xc5\xea\xaa\x8d\xc3.y\x00\xc7X\xdd\xaf\xbck\xad\x82\x9fx\x17\xfd1D\xca\xca\xd6\xcf\xcb.KjiAG\xee\x13\x94\x8eT\xad\xed\xd7\xa1\xa1J/5)\xa8\xa9\xa6\x00\x8dnH\x01\x9f\xb4^\xad#h\x1b\x1d\xeb\x8a\x87\xdaY\x1d\x9d/\xd8\xb0\xa8\x9e=6\xdao\x07V\"\xbe$@\x88\xba?\xe4\xeb\xb6^\x90\xe7CV\xb8\x0d\xb0\xd1q\xed\xda\x08\x966r\x8b\x01\x16\xb4t\x9e\x00A\xa8\xb2\xac+2\x14I\xed\xf0\xbb\xc6\xe26\x137\x8b\x03\x1bL7\xc9\xe7\xc3\xb4e\xc9\x0cN\xd0B\xe5lDm\xa1mXy\xbe9\x04\xe36\xe4+\x0c\x04\x97\x18\xb5\x05CQ\xa4\xc9y\xb0\xb2\xdb0\xdb\xe1}\xa7\xa3\xd9e\x1e\x05\x96\xb8\xcb\xfc\x13\xf6S\x08m\xb5\x06\x0c\xb0\xcfr\xd0\xc2\x7f\xc2\x15\xda\x95\xdb\x9f\xc1\x9b\xbc\x97C\xa8\xd6\xe8\xb1\x12\xfe\xc6\xd9\xd5\x88\xb3*\x8fWm;f\xf4\x18\x81';\x86|\x8e\xc3\xb2\n\x9bX\xad\xb1_y\x92\xc7\xf9\xea\xc3=\x1bL\x1f\x98\x7f\xe5\xea\x86\x8f\x1a\x10\xf2*1\xe8\x15y9\xb0\x88\xe1\x9e\xcd\xf6=\x81\xa7\x95f\xc9\x10\x9b\xcd\xea\x11\xd7\x9ePA\xe5I`O\xd8\xdf\xe1*\xecn\x01\"\x03\xf3G\xe0J\xb6\x10\\\xeb+Jl3Vp\xa6\x15\x8f1\x83\xd7\x96x\x11\x0b\x85g\x8bR\x9b-Tk\x17\xc8\xca\n\xc2j\x0d+\xb2\xe41\x92\xea\x08\xcc\xe0\x96\xde\xb7\xda\x93J\xdf\x98n\x1f#\x18\xc2\x10\xe1[Pz\xb9$O\xec\x01pU\xde5\xdf\xc5\xbcr~\xb8\xea\x93\xeev 4XC\x90\xc9N\xb5\xa3\x16\xe0\x86D_\xdc\x92\x9d\x99^n\x93\xa5\xec\xef5\x19i\xbe\xa3\x19\x1c-\x19@\x8f\x15\x91b4\xbe=\xa3k\x9d\xbc\n\xdf\x0bl\xb41`)m>8\xf3P\xdcN\xb9\xd56DW\x9f\xcc\xe7\xfdV\xf3\xb6\xde\x04\xf2_+ZjK\nN\xe6s\xf0\xad!\x96-T\xa4\xc0Y\x88\xae\xe9\x04'\x90\xa1\x8aO.\xae\xa9\xa6g\x88\xdf\xb9\xd5\xaa\x10\xfc\x13\x813\xd5\xee\x16e\x9e7\xce$\xaba\xba'\x95\x9dp\xb9\xd1\xd3\x04\xcaL3!\xfe\xcd_\xcc\xfb,\x9c\xf7\x146k\xb2`\x9d%\x16\x82@\x91'y\xef\x95\xd1#_\x97\x17\\\xa2]=\xe1d\xd0\xaeZ\\u\xcc.\xf3\x10\x1a\xac\xee\x9faZ\x12\x0d-\xfaj\xad\x1f\xa8 :\xc0\x12\xbd\x02k\xe6\xfc\n\x16\x1f\xff'\xbcoI![{]\x7f\xfc'\x8bh\xe1C\x94\x0e\x1c\x90\xdc\xba\x85\x8b\x83\x92\xdcxz`\x99\xac<n\x0c\xfb\x9c\xe9\x08\xcc\x8e\xa7\x161\xdf\xd0\"\xe8H=\xe2`f\x02\xf16\x80\xecJ\xf3yYE\x8f2\xbf\xf4\xae\x06\xac*\n!I\x9d\x0e\xd9@>\xd9\x14\x8b\x8f\xd8\xb7ac\xa7i\x86/\xe1kd\xcf\x91-u\xaf\xfb\xa3\x8d\x06@\xbbef\xae\xe5]s\xb4\x90\xf8\xf3\xa5\xed\xcb\xfa\xfd~Z&\x12\x1d\xbb\x1a\x0cgyV\xce\x16\xf2\xa7\xc9\xfb\xa4K\x99i\x86\x90\x11 \xe0B\x93\x8f\x85C$E\xb6_~\xa6\xc8\xc2-*\xdd\xf9\xc1\x01\x00\xd6m\xc4\xd9\xf3>R0[\x10a\xfd^\xa01=\xa5\xff\xf83\x0f\x01m\xd8\x0c7u\xc9\xf1-\x07\x13	\xda\xc7\xc6\x19y\x8da\xcd\x16\xb7F\xfb	\xc7t\xd8/e\x0b\xfb\xa7\xc3\xb1w\x88.\xa2).\x8cj\xd4\xe6\xcc\xfb\xe1\xf3\x18\x00g\xde\xbb~KY?\x05\x95\xbf\x8cd\xf2\x19\"W\xa8\xcd\x98PwD\xbc\"\x11xf\xd9\x0d\x86B\x801\x84\x8d\xf3\xea\xe9Jh\xf2\xd4s$\x9c\x8f;\x9f\x10\xc8?\x90\x07\x0e\xcc\x9f\xa1U\xcc>Cn\xde.\x9e\xa5\xf6iB\xa1](W\xa3\xb6%\xb5\xc75\xf1\xdd\x0c\xa4\x1e\x7fH\x80D\xa6\x9b\xff\"u\xa7\xc7\x06\xad:*\xc4\xe7L \xdasD\x8b\x01\x0c\x86b\xdd\xb4@\xa1\x11\x06\n\xc2\x8cs\x9d\xab)\xdc\x9c\x9eO\xe1\xea\xe6[\xd8Bl\xa1\xf1\xae\xd1.\x87T\x96=\x87\x05\\R\x15Q\xb90\x83\xeb\x96\x1er\xdc\x1c b\xbd\xd0\x1f\xff\xcb\nR\xda\x9df\xac\xd1\x96G\xd7\"\xc3>\x1e\xef\x86\xe0\x9aJ;\xcbi\x11$\xfbWh\xde\x92Hq\xa0\x9a\x84\xa9\xa3t\x9e\xa1\xe9\n\n\x97\x05\xcb\xd1\x0c+\x84\xd2\x815\x92\xfd'\xab\x11\xfb\xad]\xcd\\\x9aQ\x98\xc16\xc5o!A\xa7\x9d\xf1\x12\")c\x80\xaaDao('R\x10t\xbe\"\xc5\x19\xc8\xb0e\x01\xc1\x91\xe4)\xae-\x08\x8bU\x14\xa7\xcd\x81h\x98B\xf4\xba\xa9\x9c\xa2\xa4\xc0\xf2!\xcf1Y\xa11\xe4\xb7WN\x0d\xa6\xedU\x82\x81\x00\x13\x87\xd7\xd6l!\xac]\x17(gs\xc1z,\xb2PR4n\x81\xe6\xf4\x8b\xfc\n$d\xb3\xfd\x83\x1dL\xa6RH\xd5\xca\xb92\x9ax\x95\x86\xd3b\xf0\xbb\xba\xb4\xd6jd\xd1s>\xe9^\xc2ao\x85\xebF\xa3\xcfg\xd6%\x9cnL\x83\x8e\xb5\xb5\xa4z:?hEp\x9a\xd2\x0e\xb8\x91\xdb\x9a\x0e\x13l\xbd\xb3\xe6p6\xbe\xc6\x07\x82\x05\x91}\x9a\xa80\xe9Q\xb2/\xeb\x19\xc2bp\x80\xc6\x1c\x94\x84\x93\xb3\xe3(tE\x8a\xcf\xb7\xcf\xf2\xd9wH^\xdf\xbbNY\x0c5E\x94Uc\x9e\xb7T\xb5>\xe8\x072C\xc6\xd0\xc3\x92k\\k\x8e8?\xf3Y\x9e\x1a\xa3S\xd8\x89\x90\xce9\xad\xf4\x05\xf1\x11W\xc9}\xc3\xf8[\x9f\x14i:\x05D\xf8y\xbev\x9b_`\xd1\xc6\xe8,kuc\xb0\xcaYS\xbfh`\xe0\xbc\xfe\xc0\x12o\xae\xdd\xe6&y\xe6\x81U?\xf9\x8c\xe3\xde\xe5\xfc\x0c\x06hkX\x82=\xc65\xb1\x97F\x0b!bu?\xba\xcc\x9e	KD\xc9>\x8fa\xc0\xc8,\xaf]\x88hVZ\xc4\xf7\xdb?Wk,2#=\xc8\xdb\xa5[\xe9.n*\x10X\xab\x7fp\xec\xadF\x9e\"p\xd2%\xfa\xc0\x1e\x00P\xfc)z\x8ez<\xc6\x8f\xbfu\x94\xba:Ik\x11\x1aO\x0f:\xb4h\xf4\x07\xac\xf4\xc7\xdfl.\xd4\xec\xd0\xd8a~\xeb\\|\xfd@\xde\xeb\xc24]\xf0\x0cx\xe7\"\xb8n.1\xbc\xc8\xc54\x1d\xd6,6k\x96\x96d\x9a\xb2E\x80@\x0drr\x06\xc6U)y\x94t\x12\xe1\xe4\xf4z\nd#\xa5\x18i\xd9\x1a\x93X\xa0R\x9eB\x805y\x9a\xc1%\xb1\xb2Q\xdd\xc4-s\xe0D\x83\xd1s\xa0\xde!\xcf\xe0\xec\x119\x95}	\xfb\xeb\x18\x9b\xf0\xf2\xe0 \xedc\x96j\x84\xec\xa7\xf7w\xbeu\x9c\xe8\xa5\x8f\xfc\x92$\xaf\xc9\x97\x8f6}\xeb\xbf3\xab\xd3\xf5\xc8\xef^\xd4#\xbf\xdb\x0d\x07\xbf\xab(\xb0\"\xef\xfa\xde$\xdd\xe7:>\x95\xa3Qx\xd1]\x96\xb6!\xe9.\xa7D\x92\x18\xa7h\xda*\xf0\x14\xf4\x07\x92\x80\xdd\xa7\x8fs\x9c\x85\x89:\x0c\xfc\xde\xab!\xea\xba\xf8\xf1\xf48sa\xf8\x17\x85H\xefP*LE\xc1\xb7\x03L\xcba\x9f7\\\x9c\x86\x19t8P\xa1\x05\xc7\xce2\xd9\xe3d\xd4\x8aS5\xda\xde\x87\xe2(\"y\x8b\x06\x128\xdf\xb3RS\xf0T\xbb\x07\x02\x0e\x10\x95\x8e@\x1d\xe2\xf7\xdf\x7f\x7f\xf0\x1d\xeb\xf2\xc1\xd7q\xdb\x10x\x92\xf2AU~A\x8d\xa1r\xc3y_\xa5a\"\x9e\xa3\xf0\x84\xd2\x855\xbbKE\x00\xfb\xf5\xfd\x8d\xb3Tf\xc1\xc7 7\x949\x15\xcbc[\x08\xf2\x95\x0c%X\xe0\xfc8\xd3\x91\x8a\xfb \x8e\xfc\xa3\xf7\xf2)\xac\x90\x9as\xb1\x92\x1d\xd6R\xfb\x10\xe1A\x07=\xe2\xf7\xf8\x83(\xc3\x8e\xee\xac\x93\x86\x88\xe0w\x06	\x1fu\xdd\xd6\xdd\x94[B\xdb\xe4B\xc4n\x04S\xe3\xe3\\\x7fx\xa2\x8f,{\xcfQ\x94\x89\xa7\xf4\xd8\xab\\\x1d\x8f\xa8\xbe\xd5*\xaew\xc8n\x18\xf6,\xdd4\xf3\xd9\x8d\xd2\xaa\xbd\xfbi8\xee4LT\xd8M\xe5BU\xf2\xed]\x8d\xb5J\x85Z\xd9\x1f\xdbP\x89H\xa5\xb4\xbcKx\xbev>Vm\xfc#\x188E\x1eGjv5\x80\xa6c\xc0X\xd5\x06<Q\xb6R\xcf\xa6\xecS\xfb\x82<\x9b\x0c\xa5\xc5@\xb6\xec\x15:x*\xdah\x0f\xb5\xa5\xdaY]	\xddao\xb6,\xee^\xef\x94u9\"\x89\x11+q2}\xe1\xb8XL\x9b>\xf7\xeci0\xda8!\x1d/pa8c\xc9\x8e\xca\xb2\xc2\xd1\xc2\x13\xd8\x01:\xactQW\xc5N\xd30-\x92\xab+\x1f\xb7\xd0@M!\xb0\xc4e\xa5\xe7(/\xf6\xd9LW\xbee\xc5\x1fqX\xea\xe4,G|\x18\xd8\x9b}\x0el=[\xbf\xbe\x96qK\x95^\x10\xd8\xcf`v\xcf2\xe4)@\xa595\x04\x07\xad\x85\xb0\xb5\xd5\x06#G\xf6!\" 4\xad\xe5\x04\x8c\x8dAM\xf6C\x19'8\xa3\x9e\x1c\xf6k\xa3>}\xd8]\xccp\xf2La\xb8\x8b5\xa0\xab\x10\xdf\x0d\x99\xdb\x156\x0d;\x1c\xb7\xec\xe3d'\x05\xdb\x1d{\xd8\x95\x8b\xa5\xb6\xc9\xe3\xfeYc\xb7\xc4\xe56\x12\x1d\xf6\xcc7E\xb0(\x83\xb1\xd0\xa72\x7fZ\x94\x82~\x89RR\x9a#BiLW\ns6zgXS\xda\x10\x9f\xd6\x9c\x9d-\xf3\xa0f\xf7\xfcn>uv,|'\xb9\xcc6\x8eWX~\xfb\n\xdc\x17\xc5-}\x99\x0d{\x1d\xfaw\x86.L\xefB\xe2\x8e\x14[\x0c&7\x85\xda\xb2\xe1Km\xefa7\x16\xc9\x08\xec\x8eI\xa5\xc8\xa3\xb5*{)\x19\xf2\x14_oetu?\x83\xb7k\xce\xbdR\xb2\xab\xa6`\xf1A\xaf0R\x12\x90\xf5\x98\x92\xb6!\x12\x96R\xd0\xf8\xd6\xd2N\x89\xf3\x86a]\xed1\xed*e\x83\xbd\xe8\xf5\x89\x1f\xa7hkTR\xe5\x1c\x1e\xb18_\xbb\x96\x17\xae\x1dFw\xf2N\xb2\xcb)f\xe8\x88U\x06~\x82W\xe2\xa3\xedS6\xdb\xb6\xa7>7m\x04\x92\xe7\xee.h<uv?\x02\x86\xfba\x85\x97\x12h\xb7\xe6\xf6\x00\x0f\x86\x92h\x1e}\xb6\x1e\x9a\x1c\x10\x9b\xe6\x1f\\[(\xd4mrLl\xcba-3\xd3\x02n\xb6\xe0lE@\x0f\xe4\xb7\xf0\xcd\x9f\x00C\xd0+\xcb\xe2\xd8\x19\xf9\xaeJ#/&\xe98\n\xae\x8b;z\x1cl\xf6-\xa9\x83c\xd3\x12\xdc\x0d]	]\xc6\xe7\xd9\xceZ\x05\x0b\x9e\x8f\xf4\x18A/!\x99e\xa9o\xe8\xb8\x86\xfd\xbf\xfb}\x0e\xf5\xf6\xff\xbe(2\x03f\xf9\xda\x8e\xb2hT\x12\\\x0e,\xa4\xec\xd3\x94\xfaW\xae_i\xfe\x84b}\x06\xec\xaa\xb6'\x83Q?\xd0\x9d.<\xdfY\x88X7(v;j\xaa\x1b\x07\x19\x0f\xfb\xc2`\x892\xc6\xe04h\xf6\x12\xf6\xbf\xe1\xc4\x14\x01\xa3\xff\xf8\xcf0\xfa\xb8\x86\xb0<A\x19>\xddXc\xb6\xb7\xa3\x00\xef\xe7[\n\x8dc\x9d\xfc%\xf5\x00(\xf24\xbc)^\xb5\xc4I\x1a\x1bC\xceF\xa1\xc7\x1e!\x8f:\x05\nv\xce\xc57\xb7\x97\xc3\xae8	d\xc0t4\xec\x9c\xa5\x84c\xf9q\xb7\x7f\xe6c\xed\x0b\xae\xce\xb3]\x95\xaa\xf1\x8e\x83\xa2\xf4\xd4\xb9q\xfe\xbe0\x02\xf2(6\xf0\x94Qb\x98\xac~z4{Z\x84dx\xeaY\x81\x8d\xb6\xcam\x06\x92\xa1\x8c\xdf\xe7\xc3k\xd3\xa9\x13=^\xb4u\x93<\x7fz9\xcd\xb6\xaa\x88\x03\x92+\xc9\xe5\xc2^\xb6f%\x073\xdc\xc9\x9c*\x8e\xd6\x056\xcd<MLG\x91\xa6\xbaj\xa4\xa8B\x1fw\xe5\xe7R\xa6\x0c\xb7T\xb9\xba&\xab\x92q_\xd0\xe0\xb1\xbe\xfb\xdc\xcbt\xc0\xb6\"\x8b\xae\xf8\xdc\xb6\xa2\xeb\xa3\xd7y'\xdd\xfc\xac\xe28\xf5wr\xbf@\x81\x9d\xc1N;\xc1Q\xf6\xc4y67\x16d\xfa\xac3|#\xd2Q\xd0\xda\xa8MrU\xd9}\x07@O]=SWh\xcc\x16\x8c\x93\x1a\x9bk\x8b`.\xac\xb1\xd6cc8\x1f@\xd3\x1d\xc0gMbh\x9c6ep\x91\x12\x8dy\x07.mq\xc6}\x92Y\x84\x88\xcb\xe5\xb8\xd3`\xce \xb8+:\x0d:\x13'\x8d\x19\xb2\"5\"t\x02\x9aj}\x84\xaa\xdc\x1d\x9b\xc0\x91U\x93\x16\x82g\xac\xdaR\x1b\xca\xa9O\xb1\xb1\xf4P;\xac\xad;\xc5\x9f\x93\xa1\x8a#MdS$\x17<z\xfcM\xa2\x9en\xf6\n\x1f{\x12\x92mIs\x93\xa8\xc2\xff\xa5i$\x93x\xaekd\xc4]\x0f\xc1\xf4\x95\xb6\xff\x1f\xdc\x7f\xa7e$e\x0bJ\xa2\xf3\x86\x1dA\xbe\xc7\xd2\x18\x14\xd4\xa4\x84\x90\xc5i\x10\x8f,I}m\xb0\x8b\xfe*\xf6\x07\xbd\xa0\x91\xfd\xf4\x03i\xf4XQ\xed\x86\x9d\n`\xc6\x90\xe9x,\x9dM\x04\xa1\xf2D6\xac]\xccvv\xa0\xc5:x\xfc\xaa\xa7t\xee8\xd9h\xc8\x07N\x85\xf4\x87\xa2+*\xedo)\x08\x8a\xba\xee\xb11\xea\x0e\xd9O\xd7G,4\xe8\x11\xda\x80>5\x84-\x9fa\xdcS\x93<'Ge\xaf\xed-;\xbb\x9e\xea[\xc9\x81\xba8\xccY\xa9\x89w:r4\xb2'\xa8Ta\xc9\x85(\xa9\xa7K\x07\xb6\xb4\xa8\x9f-\xed\xe6\xd2\xec[Z\\\xfd\xeb\x95]3^\x9f]#\xb1\x13`s\x0f_VF\x06\x94{Q\xae0\xf5\xec7G\x0fUW.\xddY\xf4\xb8\xc0w\xfdK\xba\xbc\xb3\xd0\xf8)\x13\xb6,\xdfk\\h\xa3\xa3<\xd2?s3\xcf\xf0\xda\xe9:\xf9\x039n\xd1*\x1a\xec\xd2\xdf\xd2pZ\x0cF!\x85,|\xb1\xf7\xeb\xf4\xc5\xde\xc45\xd2\xa3\xd4u\xce\xda\xd4\xa70\xb1\xda\xaeZ\x9b69I\xba\xc2\xe0\xa5N\xed\xa6	\x90\xbe6\x17\xa6&\xf8\xae\x0dQ:1\xd1V\xeb\xd6sp$,\x82\xf3\x91\xcf\xa3\xf3*\x93c\x89!:C5\xb9\xc4\x90\x9em\n\xeb5\xe9\xd2\xc5\x02$B\x9e\x9e>\x13\xe0\x9c\xcd|\x1a\xbf\x90\x93\x98D\xec\x1fr&\xaf$BHO\x1a\x1cnj\x93?\xe7\xb8\xf3\xe4y\xcaFO\x91\xac\xae5\xd9\x98q\x8e\"\xbe\x93\xc7\xb6D\x96\xea\xc6pj\xd6\x1d\xd3\x02\xedM\xa9\xc7\x7fsm\xf1\x9e&^U\xa5\xb7\x83\x83\xaf\xc2\x01,\xb6\xf0\xd5\xd0H\xb1t\xc6\xb8\x0d\xbb_O\x18\x9c}\xd9\xdd\x14\xfb\xc2\x05\xda\x94\xaa\x8a='V\xc1\xaf\xa4\xa5O\xf3\x8fYoy\x8a:\xb6\xb8Pi\x02\x98&\x7fzq\xc3\xa6\xfc\xab\\\x98\xca\xb7\xd0\xeaqwt\xd9\x18\x9d\xbe9\x87\x1d<\xd7\x85-\x92}\x88\xcd\xaf\xd1\xe2\x8a\xea\xae\x16;A\xa5\x04Q\xa9<\x96,H\x1c\xbd\xc0SR$\x9e=!,P$\xe8\x18m?\xb6\xe4\xe7\x0dU!s\xa4&\x86\xa2\x89\xe4/7g\xaf\xa6ps\xfdj*\x0d\xa9\xce'\xe3\xc0\xbe=93\xa8s\xb5S\xe9\x9a\xc4,\x04v;\xdf\x1e\x1e>~sx8\xed\xa7%\x1a\xe8*\xad\xdf\x1e\x1e\xc2_\x8f\xe5\xc3\xa4\xbd\xa0\xb5j\x967\xb4\x95\xfd%;Y\xb4\x1f\x8e:\x0f'\xd5\x1a\xed\x8a\x8a\xd2\xd1\xe4D \xe3\xc2\x91\xb4\xe0\x9d\xf4\xa7Y\xcba\xbae\x19\x8b\x96\x81\xce\xa2\xeb\x8a\x9cT\x86\x0f\xb2\x12\xba\xf9g\x86\x13\xfa\x04\xa5\xfc\x902\xa9\x9c]\xeaU\xebS\x15@&;H\xf6\xbbc\xb4y\xea \x19\xe1\x15]%\x13	\xd5\x0bb2,))\x8cr \xa7\xfc7A$\\\xe8\x97\x94\xc5\x86\x12\xa1sz\x1d\x82\x18\xa3\x12!\x971\xbb\xf9\xbe\x849Q\xad\xefJ\x88\x93\xd3\xee\xb7\xcc$\xfd\x10\xd99\xcb?\x05\xde5`\xf0D\xd7\xa6\x91g\xda\xea\xfeu\x12\xcf\xf37'\x7f\x85\xd7\xe7\xe7i\"u\x120\xfcU\xfa%Py\xe7\x9c\\\x9cv#\xb2QG\x91\x91\x8b\xeew\x9a1\x86Vi\xf9E\xfa9z\xb5\x98p\x14zRH\x93\xd1E\xb7^U\xca\x16C\xb4]=\xd1	|\xa2\x00\x9f\x93\xff\xef\x0e\x0f\x1f\xbf\xfb\x17\xe4\xdf\xb8\x95\x93\x82\xfd\xe42\xfd*\xa0\xa9\xeb\x87'8\xf5\x90\x8aa\xfeW\x82\x84\xb5S\xed\xed\x0b\xbd\xc3u\x95\x18wwr\x07\xceW\xe9\xf5>lmEj\xe8\xe7\x95pD\x12\xe1EkLX\xe7\xe7\x8d\xc2WM^\xe7\x9f	\xbe\xb1\xd7\xae/\x85\x891V\x92\xa2\xb8\x8d\x95\xa2SQ\x11\x93\x02\x1fc\xdd\xf42\xd6\xb4~\xd5K\xe0\x0d\x0fD\x00\x0f\n)\xf5\xa4\xb4\xa7*^\xdc0\xcem\x1e\xb1]\xbf\xb8\x19c\xa4\x90l\x17+\x17\x81\x043\xf9\xe0;\xe7L\xd4\x8d\x1c\x846\xe9\xfd8\x05]\x8b-\x84v\xf1\x8e\xaa8\xcd\xed\xd1>\xdb`)0\x91\xad0\xa4\xff\x99`\xd1\x0e\x06\xc3\x9a\xc2\x0c:a\xf1\xb4j\x0d\x87C\x8f\x8dOIg\xf6\x1a\x93@\xf18w\x16\xbc\x84\xc9\x9c\xe2\xa8\xd1\x80\xa7/\x93\x00v\xd3Y\x1e\xd3\xff\x02\x0cW\x19\xd6\xa8\xdcF\x90\xd2\xaf\x02z,	J\x9e\x80\x85\xee\x96\xe4\x10@\xe68a\x18>6#\xa4\x18\xbf7\x14\xf32\xb9\xcc(\xe9Pd\xb6}G\x9d\xa7f\xe1\x99$\x9bf\xa9Je\xe4\x88\xb1\x0d\xc3to\x88\xdfX\xfd\xbe\x95\xa7A\x9b\x1a\x86%R\xa4\x03\xe9Id\xb7Y\x04\x171U\xe9&}]n\x12S\x89K\xd2\xf6\x0c\xd96	\xc2\x7f\x05\xd2\xda\xec\xe7\xde\xc8\x0f\x81\xa5\xa8Z\x0co\x92\x0ex\x9b!/\xf6~}\xf1\xeb\x8b\xff\x0d\x00\x00\xff\xffPK\x07\x08\x97\xd3;\x8fp\x12\x00\x00\xbf4\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/fr_FR/common.jsonUT\x05\x00\x01\xc8r\x08f\x8cW\xcdn#\xc9\x0d>KOA4`x\x17\x18x\xefs\x93my\xad\x81d\x0b\x92\xc6\x83 \x9b\x03\xddMIeW\x17;\xf5#\x8d\xb2\x98\x07\xc8[\xe4\x18\xe5\x15r\xec\x17[\xd4_wkv\x0e{\x12\x9b\xfc\x8ad\xb1\xf8\xb1J\xbf\x8fG\xc5\x96u\x8d\xb6\xf8\x08\xbf\x8fG\xa3\xe2\x15\x95\xa2\xaa\xf8\x08\xc5\xed\xe4\xe9iz\x0f\xb7\x7f\x83\xeb+s\x0d\x0f\xcf+\xb82\xe1\xe7\xb7\xe2\xca\xfcV\x14\x1f<\xbe\"I6.\xb8\x9f\xce\xa7\x9b~\xc5\x85}EhX\xfd\x00\x15\x1c\xf6pQ\xe3\x8e\xee{\x9f\xb3\xc5\xe4\xd7)\xfc\xd0s\x80\xae\x1b\x16\x92\xf4\x10\xbc^>\xcf\xe6\xd3\xd5\xf7pE\xc7%\x1bkfj\xb3\xd7\x84a\xc1U\x05\x8a\x8e\xd0\xb0\xb1?\x99\x9fA(\xb0\xc1v\x13\x97x\xbd\x99\xa8j\xe6#\x99\xe7Z\xd8\x94\xd5U\xd5\xadAU\xc1U\x05!\x19\xff\xcd	\xd5;\xf8\xf1\xbaK\x9c\xd3;\xaa|z\x1e\xb5|^o`\xf9y\xf5\xebwU\x1a\x96]S%4\x95v\xb6\xcc+\xa6+XM\xefg\xab\xe9\x9d\xaf\xd4\xe69\xc1/\x8b\x90\x97\xf5%\xd8<\xae\xa6\x93\xfb\xbf\xb0\xd4\xec\xb1\xe2\xe3\xad\xc8\xed\xb1~\x9c\xdc?\x7f\x81\xdb\xd9_\xe9\x92X\xd69\x97\xef\x1b\xde\xed$\x0dC_\x99\xcb@N\xf5=\xf8\xf9\xe9\xb2\x0b#\xe2 \xe8H\xd5\x1ak\xea\xb7\xbf\x86\xe7\x07\xd8<Na=YLa\xb6\x84/\xd3\xd5\x14^f\xd3/\x83\xd5\xe3\xd1\xb7\x0f\xe3Q\xd1H\xa7Q\x9a\xdc\xf2\x15\x9e\x8a\x8f\xf0\xf7\xf1h4*\xde\xd8\xe9\x10$\x8a\xa6\x18\x8fF\xff\x08A\xf7\xde\x92a{r\x9a\x12.\xc8\x03`-\x94\xb3\xd4A\xd3\xe7\x87\xc1\xc7\x10\xcc\xca\xee{,\x0b\x93\x91^\xec`\x86JVU\x87K\x9f\x1f\x06\x1f\x03\xf0\x89\xb0\xcf\x14\x95j\xcf9|\xfc\x88\xd0\\\x0b\xdf\xa2\xb9\x12X\xd5\"\x90t\xe2\x05a\xacFK\xa9 \x05\xee\xd8\x9b\x84\x84\x13`R\xa9\xc8\xe9\x89bu\xaac\x94\xa2de5\x96\xa1\x95\xef\xb2|a\x89|\xf2\xf6U{\xae\x9c\xd0\x04\x92L\xe4P\xdc\x7fA_\x1b\x0c\x1b.V\xf4\xc6BU\xa9\xdc\xc9\xd0{h\xffm\xc9[\xff\xe4a/*\n)`\xb9\xa7\xb4\x85\xb8\xb9\nU\xc2\xbc\xa1\x12\x96\xb5I\xa9\x96\x82\xf4.\xc5ys\xc6>\xf11\x84\xf8\x0f\xc8k\xa1\x8cE\x956\"\xb9|\x8f\x0d\x9a\xa4x\x96h\xde\xbdn\x81\xe6\x1d\xb6B\x92\xc2\\\x13o\xdal\xe2v\x1a\x89%\x05\xfb\xb5\x01\x0f\x81\xa3\xb0{\x10\xd6\xc0\x1e\xcd\x1e\x0e(]^\xc6\x15i\xcc\x19.\xb8j\xcf\xc3\x13\xe1\xa3\xa2hZjn\xb4h\xcf\x16E.\x94!\x9aH\x19x\xc6\xce\xc2\x81\x85\xceD\x8e\xdb\x9al\xb7\xa2/\x8d\x89\x8340;\x89\x91\xb9\x81\xafk+\xca\xf7S\xacw#\xd4\xae3;\xd5\xd7\xa2\x93c\x17\xb2\xf3\xca\x9f^\xd8\x99\x9f3\xf3\xccI\x95\xa95\x8b\xaa=\x97\xac\x14\x95\xb6=w\xfd\xa1\xe8\xab`\x95R:\xa9r\xaf\xf9\xe6&\x8d\xe38\xba\x92<\\<\x0e\x8d_XQS\xee\xe4\x12%\xa9j\xc0\x83O\xa8\x12	\x1e\xda\xf3!\x89\x0b\xccd\x9f\x1ct\xa7\x13I\xfa\xe4\x84\xeaE\x99\x91\xdc\xfe?\x89kj\x92\xf4\x9c\xf2\x1a\x15O\x9c\x9d\xdf\xb7\xe7\xb2'\xe5\x91\xe8\xbdK\xe6^\xd4	4w\xeaO\xb9,(K\x9f\xc8%\xe9\x852n\x8d\xf5\x90\xbfNt\xe4m\x1ayJG>\xf12X\x06\x94\x12\"\xc5C\x16\xaf\x8c:^\xeeAH:k\xb9\x0eJL\xb0\x12UI\xd1\x91R\xae;\xec\x12-J\xdeEV\x0517j)\x85\x1f\xedw\xdd\xba;\xaf\xf0	$W\xf1\xd0\xc2\xbd~\x9bsX\xbb\xa6\xd1\xa2&\x0dN\x114\x12U\xb9O\xfe*V\x81\xbc\x1b\xd2\xb5P9\xbe\xe7\xcc\x86y\x8e\x9e\xa5\x1f\xa1x\x10\x92\xc02\x83\xc4\x8e\xb7[?\xb7\xf6\xe9\x82	\xabsw\xed\x98}'\xb3\xda]$\x18\xd5`\xbc>\xe0D\xdd\xb0\xb6w\xac\xb5k\xc2\x10\x9b_G\x15Z\xc1\n\x10\xdas\xb9g\xd7\x9e\xa1a\xa7\xa1Dg\x08*\xcf\xe8r/HC\xc9Zs\xdd\xb8\x9b\xa1\xbb\xfb\xb4\xa1\xef}\xe9\xf6\xec\x8c\x1170GhpGp@\xa8Q(K\n\x95\x85\xf6\x7fV\x13h*\xf7\xa8w\xed\x99\xb2\xcf\xe6\x11\x8d\xbf6\x8a\xd92\x0c\x8d\xa8\x96\x18\x1f\x10\x15i%\xfcd\x88Z.C\xb4\x10>\xcb\xd9\xf2\xde\xbf\x04^HkvBv\xc7\x1d\xd9\xbc\xe1\xdb\xaeA\x1e\xc4\xd7\xf6\x0c\xe8\xe05wJ\x8d\xfa}M\xa4\xe2\xdc\xd3\xef\x80\x06\x0c\xa5f-j\x12\x15\xbf\x08#X\xe5\xc7\xcd\xc2\xab\xe0\x10t\x03PT\xc4\x11\xf9\"\xe8\xd87.l5\xd7`\xf7\x04\xc6\xcf\xca\xd9\xd2\x87\x08\x9f$\xa9\xb4\x14\x9fT\xc9\x953v\x81\xb6\x0c\xa5\x99\x93\x81\x9a\xad\xf1g\xd3\xa0\xf1\x87\xc4\xe2@\xca\x86\x13\"\xd3p\x7f\xab(:\xf6\x85xbw t`\xdc\x1b%\xc7\x0d\x0be}\x7fw\x04x!m@\x12\x94\x97D\x08)\xf7\xb7\xd3\x82\x8c\xf12\xfc\x02Q	\xbf\xc0f3OX\xff\xea\xeb\x1f\xc6Q\x82-k\x08\x96\x08\xfa\xa7\xe3\xf4x\xf4\x83\x14\xf0@\xff\x82\xf6l\xdb3\x94\xa2\x1b\x9c\xbaw\x82\xc2\xe4\xc2\xe6\xc7\xde\xed\xa9\xdf\xdb*\xe9B}_O\xe9\xbd{\x89\xf7G\x15o\x82\x0e\xddD\xd5\x05,\xdfg\xf1\xcb\x80\xf5$\xb4\x9eR\xe1t\x1a*\xc5VP\x05N\xcb\xbcp\xab)\xb6\xed\xa4\xb4\x0e\xa50\xbd\xcfF\x86\xebe\xd5\x9e\x87\xe7\xa2\xc9\x93%\x0c\x0b\xb1S({\xbcu:U\xcd\xe6'[\xa1\x9d\xcc\xcf\x8a\xff\xeed~\x08\x18B\x1d;\xe2nOzp\xe3\x91\xf1=7\xfd\xda\x88\xf4\xffa\x8e\x90\x94\x80@^\x9f\x0b\xec\xef\xcc'\xb6\xa2\x0c$\x9e\xa9-g\xe7\x16\xad\x0b1\xd7Q\x8aZ\xf7Z\x8b\x90\xf6T\x1d\xf8\xd4\xdd\xa5\xfd\xab{Pv\xbbw\xf5\xabB!E\x9cL\x0b\xa1\x04Z\xa7\x85	T\xed\xae?\xcb\x8d7?\xa2KM\xe9T\x1ev\x99\\}\x8b8\x05u\xec=\x10\xca\xbf\x80\x0ey+N\x1d3C>'q\xa8\xef[%Y/Z\xc45\x92\xb1\xf2s7 \xc2Wx\xca\x0c\xcdK\xcd;M&T\xc5\xb6g\xe9\xe7e\x18_\xddV\xbe\x8b\xf4e\x18g<\xfa6\xfe6\xfe#\x00\x00\xff\xffPK\x07\x08\x05t\xaf\xb8(\x06\x00\x00\x99\x0e\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/fr_FR/server.jsonUT\x05\x00\x01\xc8r\x08f\xc4[\xdd\x8e\x1b\xb7\x92\xbe\xb6\x9f\xa2\xa0E\xa0\x04\x98\xcc8\xe7\xe4ba`\x03xflg\xb2\xfe\x99\x8c&6\x16A\x0e@u\x97$\xdal\xb2\xcd\x1f\x8d\x94\x83<@\xde\xe2`\xaf\xac\xbcF\xbf\xd8\xa2\x8ad7[\x92'\xc9\xee\x9e]\xc0\x17#\xfeTU\x93U_\xfd\xb0\xfc\xf7\x87\x0f&\x0bc\x1b7y\x0c\x7f\x7f\xf8\xe0\xc1\xe4\xd9\x93\xef'\x8f\xe1\xc7\x87\x0f\x1e<\x98\\\x0b\xadQ\x04\xa8\xa7R\xd3\"\xe1\xa5\xd1\x93\x13\x9e{\x89\xce\x89%B\xf7\x0f\x10\x8b\x85\xacVh\xa1\x16\xda\x81\x12\xf0\xccH\x8b\xf0$l\xe0\xfb\x80\x8e6\xb9\xc9\xc3\x07\x0f~\xa2\x9d\x93\xef\xae\x9f>\xbf]\x85f\xae\x85T\xae\xe7E\xc3\xe0\x87\xf1\xc8\xa5\xc1e\xa8\x04\x04\x87\x0e\xde\xe2\xfc\xbaX\x01\xf3-\xd4\xb8\x10A\xf9Sx\xaa\xc5\\!\xf8\x95t \x17\xb05\x01\xee\x84\xf6\xe0\x0d\xb8\xd0\xb6\xc6z0\xaaF\x0bsk\xee\x1cZw\x023\xb1\x10V\x82\xb1\xf0\xa4m\x15B\x8dkY\xa1\x03\xe1\xc1\xaf\x10p\xd3\xa2v\x08f\x01\xda\x0cl\xc1[\xa1]+,\xeaj{:|\xd5\xab\xd9\xb3\xb7\xfd\xb7\xf0\x8f\xf8\x01\x17\xe8=B\xab\x84\xaeV\x08\"xc\xa5C\xa8\x03TF{\xd4\x01>\x04	z\x8a\xceC+\x1cX\xacL\xd3\x08]w\xbbx\x9cA\x03\xea\xb5\xb4FklP{\xa8\x91dX\x0b\xa9\x06\xeeB\xdd\x89\xad{a\xaa\xf7\xbd\x0c\xb7&\xbc3\xc1:p\x08\x0b\xb9A\x0b\"\xc0\\\xe4\x83}\x83\xd6\x04\xa9\x14\x02}z\xb7[H\x15\xc9\xc7U\xc4E	h\xe9\x86\x9b\xee\xb7\x06\xc1IPS\xa3\x97\n=\x90\xb0\x95\xa8V\xdd\xae\x10A\x1b\xbdm\xa4\xc3^\x82'y\xc4\xe6\xb3\x10t\x06\nA\x9b\x86?\xc3\x04\x07\n\x1d\x04/\x95t\xc2c\xb0\x85\xa2\x88PK\xf3\xc6\xa8\xd0\x144i\x0c\xd6q0}	\xff\xa0\x13uFCk\x82\x85&8\xf9! \xa0\x07\x85\x15\x91\x85\xb5\xac\xbb\x9d)\x89{\xb3\x94\x8b\x9e\xf0\x1b\xb9\xd4tU\x0e\x9e_=\x03\xa1e\xd3\xed0\x1f\xd6\x13-\x1bdIi\xb2\x91Z\n\x1f\xact\xdd\xae\x10w\xbe|\x19\xfc )\xfd\x80sQ\xbd_Z\x13t\x0dod\x8d\xa6\xb7\x1d\x0f\xa8\xc1\x99`k\xa9\xf9\xa4\xa3|PO\x85\xb5\xb2\xfbh\xf1K\xd2\x99\x92z$\x90\xc9\x7f\x8ar\xf7\xb1A\x07\x8d	\xaa\xdb92N%@\x99@\x07\xdf\n\x0bj*\xeaF\xea\x13PrmiE--\x9dP\xd4,\xd2\xb7\xb5\xf1\x16\xa11Z\xd2\xb9\x15\x02\x18a\xeb\xa7\x9bV\xdam/\x04\xffdP\x80z\x1at\xaf\xe7I\x98W\xa6\x99[2,H\x9aH\xf4\xb5	k\x14a\x03M\x04\x10\x07bM\x96\xaa\x04[\xaaE\xe7\x0e\xe9\x8d\xa5x%\n\x8dx\x15u\x89\x94u\xc4\xfc\x1a\x83O6&-\xbc\x0b\xeeC\x98v\xff\x80\xaf\x1eA\xb5\x92\x8b\x85E\x07&\x80B\xef\xe9\xcfF\xea\xe0\xaa@W\xfc9n\xb0!@x\x0c\xadQ_\xec\xf1\xbe\x95^\x0d\xcco\xa5\x8f\x1f\xf8\xc7\xd9?\x82JXQy\xba\xe3\x11\xb3k\xa3\xa4'\xb5\x1d\xb1\xac\x87\xd3N\x88\xdb\xab=\xdfS\x1a\xebwT\xa2\xf5\xd5J\xf4\x9b.\xd2\xef\xb8\xe9\x12	Z\x08\x876#\xa3\xa3O\xa8L\xd3\xaan\xe7\xd1\x12\xe6$:\xd1\x9e*\xb4^H\x8d\x0e|\xf7\x9f\xd5\n\x1d-&\x8b\x98\xa2\xb6\xb8\x94\xce\xdb\xa8At\xa2\x02*\xdb\xed\x06\xad\x00\x17\xde\xa1?\x90\xf0V,\xdd\xbe\x94\xe0i0\x8aJ\xf3\xa4\xbfQL\xb4Q\x12\xba gT`\x9f\xc2R\xc7\x9d\xa7\xf0\x83\xa6\xdd\x11\x9aVF:	B\x91\x18\xe4\x8aX\xb6\xf8%+A\xc0\x90\xbfn\xd9\xedt\xb7\xb3\xdd\xee\x14.\x8c\xf6\x92\xd6\xe9nW\xd1\xa9\xe6}\"@c\xa4v\xf0W\x16\xaf\xc0\xfcj%\xec\xac2v\xd0\x07\xfeEb\xb9V4lp\xc3eg\xc50\xb2v \xb4\x97\xbcF\x19\x9b\xc1\xb61\xb5\\\xc8\xaa8\xb9as\xef\x0b\xd2\x85\x9f\xc2\x93\xd6v\x1f\xc9x\x8c\xb4\x80\x9b\xaa\xdb\x91\xcb k\x97\x8d\xf4x\x02jZ\xdc0y7+\xc0\x92\xb2\xc9\xf2\x82\xcb\xcf	\xce\x9b\xe6b6\xeb?'\x89\xfc\x0c\xa3\xa7\xa0\xef\xf2[\x85\xd0\xa2uFk\xa1\x08\x029\x04\xa0\xa3X\xd2%	b\xe5\x1c\x03;\xf8\x15\xc1\x11\xb8nG0,\x8d\xd6\xa5\xc7\xe8\xf9\xdd\x9a\xe5\xb20\xaa\x8b\xd9l\xcc!\xa3p\xe5\xe5\x9aOjq\xbf@\x03\x8b\x14\x1f\x94\xdft\xcb2e%\x8b\x02\xee\x7f@\xb7[\x88\xe0\xa3\xcb+\x0fQOQ\x83\x18\x7f\x0f\x88P\x05}\xc0\xf2\x85\xd0\xcb\x9e'\xfd\x08X\xd2N\xfc\xd3\xc4q\xfe%M\x17\x84\xadVr=\x9c\xd2e1\x16\x89\xdd`\xb5\xa2\x7f\x14\xae5\xe4\xb8\x8bm\xa7\xc6.\x0bz\xd2Q\xb8tc\xe6\xc6\x0fFx\xae\xcc\x87\x80\xd1\xc6l\x9c\x8a\x84\x9f6m\xf7[t\xddy\x06\xea)nZez\xf4\x17\x15i \xda\xe4r\x0e\x80;\xb1\xfc\xc1\xa1='\x1c\x1d\xd8^\xc7\xa5\xae\xf4O\x89\xf1\x0c\x83\xcaC\x042I\x95\x038IA\x15C\xac\xedv\x14ybt,\x8aDL\xcc\x0b\xdf\\\x1b]\x806\xdaFj\xfe\xd0\x1e?O\xca\x99b\x02\x88\xa8-\xae\x02k\xd4\x83\xff\xabQ\xc3\x8d\xa8ev\xbf\xc3\x00hsG\x82l\xa5^\xc2\x9c\xe2\xe8\xc2\x97\xa2\\\xae\xfc\\(\xd5S\xea\xe3d\xf8\x97\x7f\xe5\x89c^\x84\x9c\xc7\x87~!z2co\xbcP\xf4\xf9\x7fy4v-\x05\xb7FH\xf5\xd4\xda\xc1\xc0L\xb0V\xa2\xa2K\xb4\x96\xbc|i`Q\x01\xaa\xb4\xc6Q\xa0R\xc5\x80pn\x96\x01O\x0f	\xbf\xa4X\xb4\x8f\xd0jr\xe1\xf9D\xd3\xaf\x9e\\\xcf\xf2\x08\x99k\xe1\x06\xadxi8\xd2m\xc5@\xab\x1cb\xc8\x9c\x8a=\xea\xc7\x88\x1a\xeb\x07U\xa3<\xc0\xa1]\x93\x1a\xe5M=.[\xcf\xaa\x95\xa6\xeb?&\xf4,\xcc\x07\xf4?Nyf\x82\xfb\xb26\x0dy\xd0?\xc3a\xb3B\xed\x85,\xa2\xado\xe3@a\xee\xb6\xb4\xf7\xbccl\xec\xb8i\x85\xae\x9f\x14\xca\xd6\xfd\xeaQ\xd76\xda3o\xcd\x96\x1eg(0\xa7H\xb8\x98.\xa9\x95'\xfa\x94\x7f\xf6\x11\xfem\xb7S\xdd.b\x19Y\x11\xe5\x85\x92\xcd\x93\xcd[4\xddG?\xd2\xcd\x05b=\x17\xd5\xfb\xa7M\xa9C\x17\xe3\x03\xccJ\xc4\x07\xa6\xbd\xa8\n{\\\xa82\x8e\xb8\xb4\xa2E\x11\xf2\xbe\x98\x97r\x86\x13'\xe8\xf8[\xb1\x8d\x1e\xb7\x84\xf6\x82\xa0\xb1\x15\xd6\x94\xbb\xec%2\xc2\x03\xcd\xf5\xee\xe8\xb2\xdb9\x91\\\x12\x1d\xd4\x90!\xbb\xec\x8f\"\x1c\xf1\xc7\x87\xb9J\xae\xbd\xf8\xfa\xa5P\n\xed\xf6\xa5\xa9\x8b\xbc\xc1\xd4\x08K\xa1\xd0J\xdc\xfb\x8e\xa0)<\xe4\xa0D\xed\xd1\x04\xb1\xc6\x8a9\xed_\xd8R\x99\xb9P\x97G\x11\xff\xda\xe2\x9a\xa8UV\xdc)\xb4\x0e\xe2b\xb5\xcd6\x91\xe6)Yj\x84\xc7\x1a\xeep\xce\xd8\x9bw\x9c\x80\x0b\xd5\n\x04\xa5\x99\xe4j\x00\xf5\x92\xd4\\\xea\x1a7<\xbf\xb0\xa6\x01QQHEP\xc8\x19z\xa2R(\xfb\xd2\x982\x04x\x1e\x7f~J\xd1\xd3t\xbf{%\xeb\x12\x96/85\x85\xc7\xf0\xa8\xa7\xd0\xa0'\xcf\xf4s\xb7\xb3&\x06I\xce\xb3\x83\x1c\xd2\x90\x98\xd0\xba\x11U<\x97Zc\xddS\xfeV\xd6\x08\x97\xa8\x90\xce\xe2\xda\xb8\xdeE\xf2\x84P\nZ\x1a\x04\xbf\x12\x1eVb\x8d0G\xd4P\xc7\x1dc\xd2\xa3\x8a\x01\xa7\xc6\xec\x91h\x18\\\xb0p&\x94:K\xe4s\xe6<\xf8\xb6t\xe1C\x19\xa1\xc50\x14\x0f\xb8\x182\x10\x19\xf1\xbd\xc1*X'\xd7\xa8\xb6\xfb\xecm\xb7Ks\xa4dY\xcf\xa5s\xb2	\nKX\xb0\xdd\xae5\xda\x91vO\x87\xa8\xf4\xa0(@\xfc\xb8\xd6\xe3\x0e\xbft\x0fzn(4\x15U	J\x1c\x11\x04\x0ds\x13\xbc\xd1\xf0c\xb2\x03\xfbS\xc1\xc0X\xf93a\x82ze\xee\xae\xa3\xbb\x1dn\xab\x9f<\xe2\x8d\xdd\xf0y4ql\x05H\xadH\x99\xad\xf0$\xb2_	\x0d\xce\x8b\xea\xfd\xe8*{&\xa4\x0f%\xfb\x19\xe5=\x0e\xfa\x05as\x80K.&G\xecd)\xa4\x0c\x16\x87\xf5\xa5\x86\xcbA\x07\xafj\xd4^.\xa4\xe8o\xa8XF\xe7\xf6\xadY\xe3\xe0\xec\xaf\xd8bD`\xbf\x19\xa3B\xce\xb5M\xb0\xd2\x1d\x00\x0cBk\xbb\xddZ\xba \x18\x1a9\xb8#\xac\x94\xf7\x92\x19\x0bpc\x8c\x7f\xbdFke\x81kO\xe3\xf5\xa6\x12\xd6\xde\xe5_Sn\xb6\xeavs\xe4 \xb8P\x81\x1a\xdb c\x15\xac$ \x9d\x17\xda\xc3\xe7k\xb2\xbb\x7f\x83\xb5P\xe4Q\x8b\xe0\xf9\x8b=\x99\xc6yZ<\x14N\xc0\x1cOd1\x8e\xe4f\x01<{\xb55Z\x97\xb8sm\x82\xc9\xfe3\xf20\xd9\x8c\\\xecU3r\xb1\x17\xd1\xb9\x06]8\xd7\xe3\xbe5j\xef3Yzk\x8f\xda\x155\\\xa1e\xcc.\xa7\x94\xf8\xef\xc5\x04\x1c\xcaO\xa5\xf6\xdd\xce\xca\x14xgC\xe7\x02h\xe1\x86\xe5\x87z\x88\x83\xae\xbe\xbf<\xff$v\xd3\xcaq\x80\xf2Nh\xe9\x8d- \xc2\xe8J\x92&\xb82\x0cv\x18R\x89\x92\xebD\xb2I\x8a\x92\x01| \xa8\xa4~?P{!\x91\xa2\xe5\x8dG\xab\x87\x8a\xde;\xc22{R\xd02\x01\xba]-}\"\xabx[\x8d\xe0\xb7-\xc27\xdf|s\xf6u\xb5\x12\xba\x00\xd3F\xb8\xca\x0c\x87\xfb\x92\x7f\xfa\x83d41\xec\x93\xd7\xe6\x13\xeb\xf6\xe8\xb2\x8a\xf6\xc4\x13\x95\xa8\xb7eU\x045,\x83tH\x89AO\xba\xa4E\x98R\x9elt\x16do\xa2\x0e\xcag\xf9\xae8|\xc1\x83r,\xd3\x8d5\xc1\xe8\xf8s\x99\xa4t=\x03\xb5\xa1\x9e\xc1\xf6h\xb1\x89\n\xb6\x96\xb4\xbb\x94k\xf3-gA\x03X\x8b\xc0\x06\x12\xcd&\xbb\xd54\xd8\x88\x8dl\x84\xc2\x029\x92Ur\xac\xc9\xa5\xda\x92\xf6L\xfe\\\xe4{\"\xd6\xb9K\xc2i\x0c5\xbc<\xff\xd3\xd4\xdf\xca\xda\xaf\x06\xfd\"{\xdc\x17<\x0f\xfe9\xd2\xb8\x0c\xb7o\x06\x85\x8a?#\xc1\xefL\x88Db\xa1\xd8\x15\xd5-N\x05]\xdb\xed*\xb9\xa0\x00\xd1\x8ds\xf0\xbet\x94K\xe1.\xb4h[\xe3JG\x1dY\xcfV\xc6\xfa*\xf8\xff\x1f\x11L\x8dV\x8c\x80\xe0\xa5\xa9\xbb\x9dM\xef\x02%\x14\x1c\x05\x80\x13\xf6\xdb\x84\xc2\xd1AH\xbd\xcc\xe5\x8c\xe3\x0f\x0cz\xaf\x8e\x9cX\xbc2\x0d\x89\xff\xceH\x06D\x11\x0e\xab\xac\x1a\xef([\xbd3\xb6.\x08pa\x1b\x9a\xc3\xccu\xbc\xd18_\x18d\xd3\xa0\xaeb\xcdu\\\x8c\xc8S4\xa33\xed\x03A\x8c\x97U\xe1\xd7\xf4\xc2\x0c'\xe5\xa5\xef\x01{\xf4\x84\x97^\xec\xba\x1d\x19\xc0J\x04_<\xf7\x8ci\xe7zd\xf1\x95\xc3\xe0\x10\xbaU\xc8\xee\x8f\x94\xa3\xdc\xe5\x18\x0e\"\x82\x04\x07\xddo\x14>V\xd2\x13c\x0fs\x89\x1aZ\x15(\xfaa'\xdcs6\xaa>8\xde'\xe4\x13\xf4\xfd\xa7kR\xd0qq\xa4\xc4\xfc:x\x8b\xbc-\xaa\x84\x17\xcb\xb2\x80\x9c\x0fMH\x9bJgE\xa8\x1d6qy	\xbc\xb1\x02\x9ek\xd1e)\xba\x90\xe7\x8e\x03\xcd,\xc2\xb55\xad\x95\xdd\xce\x0b2\x99>\xe6p\xae\xfbX\xe7\xe8\x1e%\xa5\x8f&\xd0y\x16\xaa\xda\xee\x1f\xc7\x91\xfaH\xb1\xda8\x7fa\x91\xef`\x1c\xf8\x90\xf2A\x95\xa6~7\x00\x8aZ\xb1W\xce\xdf\xd3\xc5\x7fF\x04D\xf2_q\xf4\xf2\x94\x8b\x17\xbd\xf8E\xe5b\xcfZ\x8e\xcc$M\x13\x01\x1cJ\x9d\x9e1\x93\xa2\x0f\xa9s\xc1\xd5\x06\x8d{\xc5\xc9\x19!\xcd)'\xc0#\x9dH\\g	\x88r\x86<\xce\xcf\xb3\xfe|\xe2\xddk\x08m\xd3\xfb\n\xe8\xf1\x83\xd9\x9eh\xb7+\x8b\xe2\x1e\xd9b*\xf1G$KI\xc7\xff\x82\\\xdb0\x88\xa3\x82\x07\xe4wx;\xe86\xab(\xed\xfcp\xd8	`\xb9r\x9a\xf7\xdf\x9c\x89\xb3\xa1\x92\x9a~\xdd[F\xb5B\xd7\xa6y%\x1a\xfc\x96\xc5\xea)\xf18\x10\xbc\xc3\xca\x0c\xbe#\x8e\xab-\x18\x82U\\\xa3\xdd\xc2W\x7f\x01\xe1\x9c\\j\x10q\x837 8\x8bG\x0b\x92F=\x1f{\xc1u~\x8b\x9b\x01\xc1o\xb0>;W\x01\x81G#\xa3\x9cOQ\xee@\x81'\xc1\xac5\x81\xcb\xc9\xf4\xf7\\a\x00'a\x1ec\xbf\x98\xcbO\xfff\xa74?\xfd\xdb|ZpCQ\xbf\xd6E\xaa\xfe\"\xa5\x89\x1c\x0e'~e)jd\xafxx\xbd%\xe9\xa5\xa4\xcf\x1cn\xb0x\xce\xb3\x07\xa0bQ	bq+\x9b\xb2\x96\xde\xb4\x0e\xba]\xc5\x0f\xce\xbd?\xc8\xa9\xbc\x80Z\xf8\xf4\xf2\x8c\xe0G\x8b\xb3\x9e\x8d\xcd\xb1|\x11\x95\n\xb6 \xe0+Xa\xb0\xe5\xb3\xa8\xc5\x16E\x91\xd6\xe8\x02\xc2F\xab\xd4\xf6f\x14d\xfex\xc3u\x8b\xda\xe2O\x04\xe6\xb55C\xc8v\xd9\xedr\x01\"W\x1c8\xb0\xb5y\xcb\xb0#\xa6\xc4\xdd\xae\xb2\xe5\x13\xbd5\xc6\xffp\xf3\xa2gF\x7fg\xc5\xabr\xedw\x14\x05\xdb\xa0\xb0P\xdb\xee\xe3R\xf5\xd0\xf2\xa2\xafP\xd98\x0e\xe3g\xe6\x9e\x88+\xf3\x84\xd9\x80\x88\xd7h\x1bd'\xa11\xb5\x964\x14\xb03\xe62\x02\xa4`\x9d\xb3\xd9\\\xd0)\xe9*_\xd0U\xc7([\xd4\\/\xb5\\\x0b\xe0\xea\x11c\xee\xe7\x8a2\x85\x1a\xa16\xd2\xc3\x82\xfc\xdd\xf0\x8e\xfa\xf5\xe8\x9d\xe2\x8b\x92c\xa8P\x0bSp\x0d\x15\xbez\xf2z\x883\xf6\x92\xc9\xbc\xe3\xb42MA'\xf6\x10\xfc^\xb3BZ6D}\xa3f\x85\xbe+\xa1&\x85\xd5\x1a7\x1c8\x0dh\x1a\xbd\xe1\xd1\x1a\xb2[\x89F\x8e\xd1m6\x0c\x9d\xec\x0d\xdc\x8bq\xae5R\x95A\xc4,\x0ed\xa5\xed\x8bsE\xde\xcep\xa2\xa6\xa6MN>n\x18Hz\xb1X\x8c\xfb\x18\x9e[Q\xefW\x9bc\xd2\xb7\xa4\x99\xd4\xd85E\xfd\xa5\xa7 \xae\xa8\x04\x0cd	\xe9FHu\xcb\xd0G8u\x04\xa6\xf0\xb0\xaa\xd2?\x17\x14\x18\xe5WX\xa2M\xf9v{\xb1\x12:\xbe2\xa4g\xe6\xa3O\xa7\x11\xbd\xa3*\xbc\x14\x9b\x9e\xd4\x1b\x89Er6\xb4)\x1cW\x86?\xdb\xb9\xb2\xdf\xf30\x92B\xea\xb1\x14R\xff_Iq\xd8\xbb\x928\x1d\xebc\x19\xb6\xf1\xf3|\xd6\xbb\xbc\xbd\xd7<\x9b\xab\x83Y\x7f\xd2\x0d\xef\xe9_Y=\xda\x7fT\xf5VT\xd8\x98A6\x1e8\xa5\x91\x03\xcb\x8fqv\xb0\\z\x8e\x10\x0c\xf5Tp\x87\xd6\xb0\xad'\x1d\x1c\xda\xf3\xe7=\xe1gF\xd7\xf76\x17 ,\x0e\x97\xecQ\xfb\xef\x94f\x88\xea@\xe7N\xf8j\x95\xe2\xb9\xd7\xfa\x86\x1cUO\xef-\xcd\xa5\xa8\xc3\x81\xd1\xc0~,\x8b\x19\xebVG\xa2:XH\xc5\x99\xcaB*\x8a\xf0\xe4Z:\x1014G\xbd6\xf2\x93@\x7f\x87\xf3f\\3~\x8b\xf3\x97\xff\xb3\x92q\x80\xfbh\xc0\xe7\xa9\xc9\x86|i\xaf(uH\xad#\x85K\xb83\xf6\xfd\xe1\xf3X\xee\x7fL@\xc0\x8f#\xf7\xbcYf\"{}&\x7f\x9c\x14W\x14F\xe0u\x9f\x92l\x85\xaeq@\x9a\xff\x88?O\x8a\x1f\x90\xbc\x17?\x99\xf1\xc6\x87\x0f~9y\xf8`\x12O\xa2\xef\xc5\xd5\xb1ma\xc2-&\xd1\x16&\xae\xb2\xc8o]\x93\xbeG/~d*JMT,=M\x12Ig\xac\xa7/\xcd\x1e\xa4@\x95\x18\x10\xf4}\x8e\x93\x9b\xfeQ\xc7\xd2\xf5h\x9f'.E\x8cy\xfa\xc82\x0e\x17\x94\xf2\xce\xfd\x89\x02\xd1\xe9p&^\xf4OA\x93\xe7\xb1\xf9J\xc4\xc3\x9f\xcc\xfc6\x05\xb4\xc9\x96f\xf1xx\xe4YH<oDU\x99`+9\x90\xe4h\xd3c\x7fjs\xa1\xafK\x03}c\x02\xfb\xc4\x9f\xa1\xdbQp\xc2U\"\x12\xee\xec3w\xc6^\xe33\x97\xba\xcb\x04X!\x1d\x81V\x90\x84\xaa\x08\x8f\xb3fp\x1f\xaf\x13\x9a\xfb~\xa0\xb5\x94m\nXHM\xea\xf0\x99;\x81\x8a{x)F\x94\xb9_\xeb3w\x9a\xbd=*Cy\x14\xd7Q\xe3\xfb\xffI\xf2\xb0\xb9\x89\xe1\xea\x9a\x9b\xd7>K\x1df\xe9\xfa\x82\x1c7f\xa7Bmq\x0b| \x9aB\xb5\xc9\x85i\xda\x14\xcfNDMis\x06\x8c4\xc6\x99\xce\x8c\xfc?\xcdu\xbf~\x08\xb2M\xeb\xe7\x82\x95\xea\x9cKh\xfd\x90F;k\xb1rL\xaa\xaa\xb0\xf58nf\xcc\x17\x0c\xdf]?}~\x02\xd7\xaf\x9e\x9fp\x93\xacI\x08\xc0>\x8bN\xf4\xf3Z6\xf1\xf1\x01\x1e\xc3_\x1f=\xda|\xf5\xe8\xd1	\xf8X\x87\xe5\x11\xf8\xf7\xf3/N\x13g\x82\xc3\xc9\xb5H\x82\x0cM\x8d\xa3~\xc6I\xc5!@Q*:,\x8bp\x93\xdeE>\xa0'\x0d\xfd\x05f\xc1\xfdV\xa2\xf2\xf1\x95\x8f\x1b\xbe)\xe7\x03n\xb8\x8c\xfb\x14\x9dV\xc5<\xd3\x9fi\x1c\x05!\xe5\xe4\x8d\xac\xf3\xb9VF/\xe42\xd8X=\x887\x91F,\xec\xf7\xc5\x0e\xab\xb9_\xc4\xee-W\xbd\x82\xa4\xd5\x16\x85/\x08\xc7~\xa7\x03\xa2\xb5\xf0|>\x97\xdc\x94\x96\x0d1><\xf7\x9bg}\xdd\xf4p?\xaf\xcc\x9e\xadXY:\xf7\xb4*U0\xcbE\xe3r\xcc\xa4\x0e6\x97\x0d'\x97\xc1\xe6g\x90	R\x04\xc4\x96\x1a\xc3\xf14\x9c\xbb@\xe2Q\x14\xbd\x1f\x93E\xa8\xde\xbf\x8e\xdaz\xf1d6{\n\xb7\xaf\xaf\xe2LlU\xa0\x89\xe7\xf1/\x1e\xe5w\xd2\xc9\xd5e\xfe\x85\xdaK\xcf\xca\x14\x9fM}r\xf8\x13R\xbbe\xdc\x9f\x1fE\xa4R\xddn\x99))#\xea\x8bB\xefR\x7f\x9e\x1aW\x0cy\x99\xd4\xcb\x03#!\xe7\xd1\xdb\xc6\xef\x1b\xc4\xd7\x8f\x1em\xbe\xbe\xc7 \x94Y\x1a\xae\xcbS\x10\xdd\xa7#\xe5\\l\xf0\x19M\xa7v\x8et\xc8{\xe5\xdcQ%\xf7p\xc5\xed-\xadyF\xc9\x1d\xf75\xb8\xad\xae\xb0\x06\n\x80\x1cx\x03\x14\x9d\x803\x0d\xc2<(\xe5V2\x06\xad\x85\x07#\xf3\xed\x1f%\xe3\xdc\x9d~e\xfa\x02[\xc4e\xcd\x86\xe7\xba]\x8d?\xc7V\xca=\xc5l\x93\xb2\x95\xdd\xcf\x936\xd8e\xaf\x86\xdd\xafJ\xc6\x86\xbe\xa4\x83g\x85\xc6Z\x8c\x1d\xeeW\xd7\xb4\xf4&\xfd\x82\xf9\x16\xae\xae\xc7+b8\xb6\xbf*U\x7fxe\xf4\xd7\xb7\xc6(/[>\x1e\xa9\xbc\x1d\xbd\xe3\x937\xf1\x14L\x9f\x14\xc1n\xfe\xff\x0e\xf95\xa4(q\x9c\xb5F\x9d}\x91\xc9\xfb\xf3\xd4\x9a\x90\x81\xb8\xef\x1b\xa6\xc9\x17Q\xd5X\x99\x9b\x14R\xc5V\xab\xbe[\x832\xcf\xda\xdc\xb1q\xc6\xbf\x8a\xd1sN<\xd2\x04\xccS\x97e\x1f \xd0\xdc\xad\x95\xa9<\xceQd\x9b\x018\xc5\xf0=<|\"\xf8\x9f\xb80\x7f\x87\x15\xdf\xca\x90^LHw\x98\xb3\x17>\xbe5\xeci0-\xe81:\x07\x0f\xd3\xabk\x97Z\x9e\\\xdcPq\xac\x021\nsp\xd6\xd7%y)wBFz>\x16\xe8b\x06\x9a\x86b\xd5\x8aC\x994\xb2m\xe37o\xb3\x0b\x0c:9\xc1\xcbn7/\xfc G\xeb\x11\xa8\xa3\x92\xc0\xdb4\xf2\xf0\xc1/\x0f\x7fy\xf8_\x01\x00\x00\xff\xffPK\x07\x08V_2\xc0@\x12\x00\x00D5\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/nl_NL/common.jsonUT\x05\x00\x01\xc8r\x08f\x8cW\xcdn\xe38\x12>\xdbOA\x08\x08z\x06\x18d\xee}\xb3#\xa5[i\xb7m\xd8\xea\x04\x93\x9d=\xd0bYbD\xb1\xb4\xfc\x89\xc7\x18\xf4\xbb/\xf8'\xc9\xd9=\xcc\xc9\xc5\xaa\xaf\xc8RU}E\xfa\xef\xe5\";\xa3\xea\xa9\xc9>\x93\xbf\x97\x8bEv\xa2R\x02\xcb>\x93\xec\xb98\xacW\xdbm\xb1%\xf9nw \x9f\xee\xf4'\xf2\xec\xa4;\x1d~\xff\xcc\xee\xf4\x9fY\xf6\x9bsc \xc0\x8c~/\xe5S^\x1cr\xefsc?\x00\xd5(\x1d*/6EU\xe4d\xfdG\xd8\xf91\x1e\x11\xe0\xbc\xa7\x0d\xe4\xd3\x9e\xab\xc7uQl\xf2r\xfb\x85\xcc\xb6\x1f\xe3\x9a9\x1d\x07\xe4\x02Tp+\xbf\xaf\xbe\x14\xe4\xb8\xdf\x95\x9b\xe2P\xfc\x8f\x83\x84\xcb\x1e\xb5\xd1\xa5\xacZ\x05\xd4\xbb\xdc1\"9\\\x80\x9c@\xf1\xba5 	\x97\xc4\xe0\xc0\xeb\xfb\xe058\x97\x95d\xa5;N\xefznb\x90w\x8c8\xdb/\xfaWB%#w\x8c\xf8\x88\xdc\x1a#j\xda\xe0\xff\xfb\xdd\xe2\xacj\x80\xb9\x08\x1dj]\x1c\xca\x87\xaf\x15\xf9QV_\x8a\x97\xf2X},\xcb\xbc\x1c\n\x18WP\x9br\xef\\\xf7\xbbcU\x1c\xc8\xa1\xc8\xcbC\xf1\xe0\xb2^\xed\"<\xe5\xff\xd6mJG\xf5\xf5P\xac\xf2\x7f\xe0\xaa[\xca\xf0\xb2\xe6\xa9{\x8e_W\xf9\xee\x85\xac\xcb\xed\xf6C\x95\xef\xb4\xff\x99\x87k\xfcy\x1b\xac\xbb\n\x9bF\x84\x1d\xaa\xdd\xbe|p\xe8\x0fU\xb3r\xea\xd1\x1f[\xdf\xa2\xf9m,\xef\xae\x80\xecH{\x08\xdf\x1fSWl\xc9\xf3jK\xf2\xe2\xb5\xd8<\xe6\x05)\xf7\xe4\xb5|\xda\x92u\xf1\xad\xf86o\xf3l\xb9\xf8\xf9\xdbr\x91\x0d\xc2**t\xa2\x06\xa3\xd7\xec3\xf9\xd7r\xe1\xe5\xc6\x9f\xe5%\x90\xd9r\xb1\xf8\xb7?\xbbE\xabF\x94\xb5*\xa2\xac\x9a\x83z.\xad\x81\x11\xe6\x96\xd6Dd\xb0\xcd\xc1(M;a)\x95,A\x9d<\x87j\xa8Q\xb2\x11\x1b\x96\x10\xd1q5\x83_\x81N\xb1\xbeQ\x9a\x82}\xa3)\xda\x94\x08\xd7\xb1)\x0d\x94\xf5\xdcSx\xe5\x05\xbf\x13m\xd0i\x1a\x10\xe0N\x08:\x19\x98\xbe\x92(9\xf4AY\xa34\x8a\xd6\xbe\xa3\x1f\x92|c	\xb4\x9a\xdbITy\x18\xfc5P\x19j\xcf\xcdI\x01\x1f\xcf\x0b\x96\xc9}u>\x01\x08\xc6e\x03\x92\xd8\x0f\xe0\x963\xf0mZ\xb7\x96\x8b8?|\xb8\xe9\x93\xde\xa8\xe4\x06\x95\xdf\xea;p\x86Qm\xb5\xd9\xe2\xc5i\xa5\x0d*\x81u\x17\xda\xb1\x01-\xd0\xa43z\xaa;\xefMuG\xce\\\x80\xa4=L\xa6\xaar\xc6\x03\x0c\x82\xd6\xe0\xed\x9f4q\x10r\xe1\xa6%\xdch\xd2R\xdd\x92w*lrC\x06\x8a\x8eQ\xa5U0\xe2EB0\x14\xbc\x01\x99\xca\x99i\x80\x95\x10\x9e\x07\xd0\xf1\xb7\x8eP!R6u\x1b>\xa5B\x99\xa2\xd6a\x80\xfa\xe4D1p\xd43\xf3hx\xdd]\x83\x8b[\x13\x1d\x14\x91\x99S*P\x9aF\x81d \xe24\xbb\xa2u\xfa_\xfe@\xfbk\"\x98\xbe\xca:6`\xf6\x0e\xea\xc4\xa5+\x17q\xa2\xc2.E\x14-c\x80WY\xb7\n%\xd7\xa0\x92\xae\x81\x99\x16T<\x92y-\xb0l\xe9\x1b>3\xbc\x87\xd4\xc55\x15 \xd9\xac\xff\x9f\xa8\x8c\xed\xff\x08\xa7(}\x1f)\xb1\x1a\xd4\xa8\xbbF\xe9\xc9\xcaQ\x12	g\xd3\\8\xc2\x10\xa5]\x9d\xb8\xbd\xc5\xf7(\xe5PO<\xbc\x00tc\x1c\xaf(\xc7\x93\x92\x94\xf3(\xbc`bs\x8eQxV\xfc-\x8a\xaf\xd4\xccYk\xf9H\xd9a\x10\xd7\xd8\x04+'\x13\x83\xae\x0dH \xb6\x0f\xe2\x84T\xf9\xca\xad\xbd\x10u\xc6`\x1f\x94l\xa40\x955\x84\xad\xa4\xb4b*BM\x0d\x15\xd8x\xeaF1\xe8\x05w\x83\xfda\xf4{p\n\x82=1@\xe8\xed\x1e\xe1\x9d\xb0N\xb1<\x83\xba\xf07\x06\x8a\x9c\xa6\xa8\x18J\xcf\xddob\xecq\xc7\x9e\nqCU\x03\xa1\xd3\xb5qw0\xd7\xee\x8cF!\x9a\x04\x94\\\xb7\xa1Eg\xfe\x0d\xa2kn\x94\xcdM|\x82\x03{\x03\xbf\x85\x07\xc4 y?\xa02\x0f\xa8\x94\x1d\xfc$+\xbd\xc2}\x05\xe9\xb9\x16\xb63\xf7$\x85P\x07\xd8\xfd\xdc5\x8f_0\xf3\xd3\xb6\xaeA\xbf\xa3\xb8'9\x90\x816\\RrA\xc5\x0c\x91\x96\xe0 9\xd8\x0bi@P\x062m6|\xa5\xba\xf5\x1b\xed\xfd\xac\x08jA\xc3\x8baC\xa9\xd1&\x8e\x0e\x8155<L\xe4M\x92\x93\xa5\x9b.\xfcc\xdd\xd2\x0eDx\xf18&F\x16;^\xf2\x99\x07\xb0\n\xd7c{|\x89\x83\x8f\xb8\xb1CN \xa7k\xa0\xa7\xaa;\x02\xc80	UG\xa8&\x1aF\xab\x9b\xac\xcf\\s\x94\xe9\xa1\xe3\x87-y\xf7\xba\x19((\xc2\xd0|\xe6p\x99\xfa\x97\x9c\x95\xeb\xa5\x16\x88v\xd3\xb3\xdc\xbb#\xfc\x12\x04\xd4\x06\xc2\xf3*ne\xb5\xf9NM\xed\xb3\xf6B\xeb\xd6\\\x10\x15s\x85Cp\x1f\x80\xef\xa0\x00d\x87}\nQ\xc2eJ\xcf\xd6\x95\x01Bv\x82y@.\x8d\xeb\xee\xb1\xf9\xf7N\xe3\x18v\xc3\x02\x1f\xeat;\xf9\x87\xe7\xefa\xfd{Um\"\xc8=\xf9\xa6Gr\x90\xc8\x19\x15\xf1\x96\x00\xfa\x8f\xc5\xf8r|r\xcfTiH\x0357\xd3\xdcS\xb3\x0d\xc6:\xa4\xb7\xdd\xfa:}\xcd!\xea|.OW\x12\xdea\xb7x\x17h\xb8\x0eF\xf4\x10T7\xb0t\x9b\x85\x95&\xc6Q\xd1g\xc1Wb\x80\x9a\x9f90b\x95H\x8eg\x05\xa1{\x0fQ\x8c\xfaA\\\xb3x3^G\x1d*\x13\x95\x892\xc9d\xac\xf2\xdfZ\x81\xb2M7\xb3X\x11R\x1d\xaf\xbd\x034 \xd2\xbd\x07T\x85\x1exE\x18/\x1a\x0d\xda\xf5X\xf1\xd7\xc0\xe3\x1f\x88\x0d6\\\x12\xaf\x07G\x06\x81\xc3\x08n\xf1\xb2E\xc3k\xcf\xe4\xdd\xd0\x83\x9a62\xd4X\x7f\xf61HAkO=\xf7_\xb1\x17\x8e\x9a	m\xa6\xd7\xf6,\xff\xa6\xb5\xfdIR\xee\x98\x17l\xd3\xfa\xfe>N\x00\x83\x83\xb7a\xb8k2+\xd3\x80Klz\x02\xd2\xc2\xc9\x10p\xbd-\xe9\xb9\x81\x9e\xd2\xce\xc0\x8c\x13V^\x12#~Dq\xae\x9f\xda%Z\xe7\xddo\x07\x81\x94=r\xe1\xb3\xf0\xc3\xaf\xc8)\x8c\xbe9b\xaf\xb0Q\xa0}N\x82\x06\xd8\xf8\x15\x1f\x8e\x89\xf5\n\xa7,\x17?\x97?\x97\xff\x0d\x00\x00\xff\xffPK\x07\x08\x0d\x04\xf70 \x06\x00\x00\xa8\x0e\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/nl_NL/server.jsonUT\x05\x00\x01\xc8r\x08f\xbc[\xcdr\x1c9\x8e>\xdbO\x81\xa8\x8d^_\xa4\x92{\xba\x0f\x13>L\x84\xe4\xbf\xd6\xb4%kT\xb2\x1d;\x133\x11\xccLT&UL2\x97dV\xa941\x0f4\xa7}\x81\xbd\xf5\x8bm\x00$\x93\xccR\xb5\xbd\x1b\xbb\xbd\x17\xab\x12$A\x90\x04\x81\x0f \xfc\xf7\xe7\xcf\x16kc{\xb7x\x05\x7f\x7f\xfe\xec\xd9\xe2\xdd\xf9\x9f\x16\xaf\xe0/\xcf\x9f={\xb6\xb8\xd4\xd4$\xbcD\x18\x84F\x05\x1e\x1f\xfc\xe2$\xb6m\x0dZ\xd8\x1ac\xa1AP\xf2\xdey\xe8\xd1\xc3\x16Q\xb5\xe8<\xaa\x06akE\x8b\x1aPS\x1f\x99\xd9\xf5\xa6\x11j\xf1\xfc\xd9\xb3\xbf\x12\xb7\xc5\x1fo\xde\xbe\xbf\xeb\xc6\xbe\xd2B*7\xcdOd\xf0\x99\x1ef\xee\xb1\x1dk\x01\xa3C\x07_\xb0\xba)z@\xb5\x87\x06\xd7bT~	o\xb5\xa8\x14\x82\xef\xa4\x03\xb9\x86\xbd\x19a'\xb4\x07o\xc0\x8d\xc3`\xac\x07\xa3\x1a\xb4PY\xb3sh\xdd	\xac\xc4ZX	\xc6\xc2\xf90(\x84\x06\xb7\xb2F\x07\xc2\x83\xef\x10\xf0a@\xed\x10\xcc\x1a\xb4\xc9\xd3\x82\xb7B\xbbAX\xd4\xf5~\x99Wu\xbdz\xf7eZ\xcb\xb5\xe4\xbd\x91J\xb6a\xd3vh7qE\x17\xc66\xe0\xbc\x10\x1ez\xe1\xd1J!\x14x\x83\xd0\x08\x0f\xba\x18(\x1d\x98\x1e<\xc2\xce\xd8\x065T\xb8\xc1\x0dj\x90\x1a\x105\xb34}\x8b[\xa9\xdb,\x86P;\xb1w\x1fL\xbd\x99\x849g\x12\x10\x8d\xb6\xe3\xc2xo\xfa(\xccg\xb4\xadE\xdd\xa0\x02W[\xa3\x14j\xd0BX\x18D+\xb5\x00\xa3\x1b\xb4B\xe8\x13xD\xb5v \x94\x83\x0e=xQUJ4\xb0E[\x19K\xa7.]!\x846z\xdfK\x87Y\x06m\xb4$\x8aE\x1d\xa7\xbe3F\x83P\na0\xce\x07\xd64Pb_p\x1a\x1bi>\x1b5\xf6\x05/\xa2\xc16\x10\xe32\xf8\x03\xb6B\x03\x8f\xa0=\xea\xc7G\x89\x9bSR\xc7\xadl\xd0\xb8\x01\x15Z\xb7,\x99{\xd3\xcau!\xa4\xa4\x13i\xe0\xfd\xe5;\xb8;\xd4\xc4\xd8\xca\x8d\x85\x9aN\xdc\xaa\xf6j\xf4YJ\xfa\x80\xf3\xba\xf3\xb4\xc3F7\xf0\x99\x84\x88\xbc\xb8\xb1A\x10E;\x0bY\xb2\x0b\x03\x12\xbf\x0bQoZk\xc6\x03N\xe7M/\xf5\xa9\x1b\xb0\x96k\x89\x0d\xf4\xd8\xa3\x83A\x0eHJf\x85l;\x0fR{CW\xc2Bo\xb4\xf4\xc6\x16\xd3\x18a\x9b\xb7\x0f\x83\xb4\xfb<\x15\xd1\xe8h\xb7B5\xc2\x8fIY\xce\x85\xf6BA\xc3\xb7\xfc\x91U\x83\x14v\xdc!Th%\xadE\xb3\xba\x93&\x93\x8aV\xa4\xea\xd2\x11\xab\x9d\xbco\xd06\x07\x13_\x8b\xe2\\\xc3\xb4Z\x884\xdf\xb5\x10=\x9f)i\x1c\xf7_\xc2\xcfB\x837\x1e\xbe\x7f	\x1f\x84\x97\xf7tC\x15z\x8f\xd6\xd1M\xad\xe5\xfd\x9a~J\x0d\x1b\x85R\xe7\xc6\n\xb7\xc2{\xd4\xcb\x03\x11\xee\xa4W\x872x\xe9Q%5\xa5\xdf\x93\x14i\xb9\xd4\xed\x044zV\xdb\x17\xa4\x1b#\xfc+\\	\xdd\x9a\x17K\xb8c	_\x82\xa7\x0b\xeb@	\xdd\xce\xe6m\xca\xcdn\xf6i*|\xa0u6{\x9e\xae	\x17#\x0f\xab\xc5\xe0\xebNL#_\xc7\xefx\x05\xac\x10-\xb4X\xd9Qnh\xc1t\x00q\x08\x19\x91\xadQ\xde\x18\x19O\x08*\x1c\x84 \xa3\xed\xc5\x06\xe9z\x1bZ\x88\xc5V\x92\xd6\x90\xd5\xc6\xb0\xe2\x9e\xdaY \xdfY\x14\x8d{\"\xd0\x9dh\xdd\xa1P\xe0\x89\x18\x97%Z\x07\x8d\x9c\xecX\x94\xd1\x07A\"\x93S3(\xe3\x9c\xd4-\xea%\xbc\xfd\xe5\x9f\x9aX\xf0\x10\x0f;\xa9\x14np\xb4\x92V\xb81\x8fi\x15\xa86\x08-\xb6\xa8\xd1\"\xda\x06\x13\xbb%|F\x8b\xd2y2\xa1\xbd\xa4\xab+\x14\xfc@\x9a\xe8\xea\x8e\xd8i^{\xebho\x8e\xe8F\xdd	\xbb\xaa\x8d\xcd\xaa\xf1\xb3\xb0b\xe3\xd1\x82\x1bD\x0f\x8e\xdb\x92e\xf0\x92\x89IKv\xf2\xfeQ\xb6q\xdb\xe8\x1c|i\xb8\xe3]Y\xc2\xb5\x00\x13\x04\xb2\xf2\xbe\x91\xbaM\xe7\xaedO~\xa07\xe8\xe9s:\xd4\xd9\x99\x86\x0d\x9b\x0b=:o\xfa\xd7\xab\xd5$t\x94\xf0Mt\xdc\x99S\x8b\x0d\xae\xa5\x96a\xd7^\xafV\xa7\x16[T\xe1\xa0*\xb3\x8d\xa7\xdf\xa2C\x85\xb5\x0f\xdd|\x87\xbd\x80GyO\x87\xa8D\x83\xfa\xc8\xdcw\xa6m\x8b\x1b\xf5\x9a\xe94\xc3d\xf7\xa9\x9d<:_\xd8\xdc<\xb1\x8aN\xbd\\\xc7\xca\x0b\xdd\x08\xbe\x9b$Cb\xc5\xf2\x90\xb5qS{\xd0\x98(\xde	\x81\x00\x8d\xb4s\xb4dvS@:\xc6\x88\x05\x9a\xb8-\xb5\x92\xa8\xfd\x13\x01>\x08\xdd\x1e\x93@\x88\xc98\x90V\x0db\xb3!\x03\xb5/\xa48\xd8\x9c\x06\xdd(l\xdd\xc9m\xde\x987\x05-p+z-\x8dmA\xac+D\xc5\x8a\xf1hH\x83\n\x86\xd2\x11\xde\xb95\x95\xf1\xc5\xe5\xb3bG>\x8e/\xc7\xc6\xf4\x93\xaf\xfd\x9c\xbey\xb3Z$\xcfG\xd8\xcc\x85s\xdda\xe5\xa4\xc7\xd3:\x8eO\xe6@\xea\x06\x1f\xb8K\xe4\xc9r\xf4\xa2\xee\xa4FwB\xa8\xa5\x15\xba\x85\x8d\x95\xf7\xa4\xeed\x98\x1b\xe9am\xec\xd8/\x9fH\xfb\xc9\xa1e\x03\x9b%^\xd5\x9d\xd8\xa0:\xb04\x84\x82N+\xec\xe2\xd4U\xb0\x1b\xa3\xf4\xf3\xe5xH\x8eF\xb0/\x12umFM\xd4\xa0\xbc/\x049\xc7\x17\x89\\\xdan\x07\xbd\x10\x9b\xf2\xc8\x8d\xceG\xf39\xd8\xcatS\xe3\xac\x1f\x87|y\xc1\xa9Q\xfa\xf2D\x90\x0e<1xK\xf2\xde\x8aF&7\x9d	\xa0\xcd\x0e\x06%\xf6t\xac\x15ig\xe1\x8d\x91\xdcu%\x94\x9a8\xfd\xcb\xef\xe9\x13\x84\xf6;\xc3\xdb\x10\x19~\x98 xn\x9a\xf0y\x1c\xd4	\xd7Am\xfa^\xe8\xe89{\xf1\x10,!9\xa7b j\xf8\xdd\xcb\xec\xaf\x8e\xd8C\xec\x85To\xad\xcdK$\x02\xac\xcd\xe8\x0bD\xc77\x9b\xbb\xd2\xeaB\xe3\x11&WB\xaa9\xa3\xc8\xe1\x9d\x19}\x18\x7fd\xd4\x8dpYq\xbe\x10tb\xf1\x9f\x0c\x85\xdd\xd4v\x8c\x8b\xb1\xfe`\x11\x0e\xed\x16-P\x90\xf0\x94Y\xd1x\x84\xdbj\xac\x8e2\xfbU>n\xac\x1a\xd3\x0b9\xdb\x96\x87\x0e\xb5\x172sz\xf8)\x10\x02\x97\xd4\xfe-\xa3\x80\x0f\x83\xd0\xcdy\xa1=\xe7\x04\xaf\xf3\x88p\x89*\x8b2+\xd2\xd7\xba,)\xe4\xba:\x81\x9b7\xefN\xe0\xea\xe6\x07\xd2\x93{\x04d\xdf\x96nB\xf4\xea\x1c\xb9T\xf8\xcb\x7f\xe8\xad2\xd8,\xe1z\x86\x0c\xe7S\xc41\xc6lh6\xf2J(g\x87\xf50\xcc\x8e\x89?\x8b\xd8!\x12\xd0\x82\x19\xbcD\x17b\x96\n\xd9\x00g6k\xc4\xa6\x12\xf5&\xa8X\xe2\xf6.R\xc3\xc1$\x8e\xa7|J=\xfb\xbc\x84\xa1\xd2x\x02\x11\x92=3{FG\x90A\xa8\x0d\xecH\x02Onu[\x9e\xc3Z\x95\x88\xe8\x03\x19'\x0d[%\xdav\x1e\xfc\x10\xbcCK\xe8\xb0I\xcd`\x86\x8c\xa5\x0b\x8e\xc6\xd6\xd8P\x105\xb1}\x8fLD\xdb\xc0y\x8c\x9aNf&5/C\x8b\x9e\\\xa1\xb7r\xa8M\x83\x8e\x8e\x11y\xbdn6\x1f[\xd8i\xce\x96b3\xbb\xbf2M6\x8c\xef\x85B+\xef\x81\x89\xc5J\xa8kV	\xd4\xc10\x15'^\xb0U\xa6\x12\xea\xcdQ\xffuci#=$?\x04\xa1\xb3J\x009\xb5G\xf7\x85M\xf2\\\x90=\x97\x1b\xeb\x0e\x84\x03\x87\xe4J\x01uKQ@\xf0d\xd4\xbe\xb6\xa6'O\x81\x0c6C\xc2 r)\x14\xb05\xa6\x041\xef\xc3\xe7I\xf1\xf1\xb5\x8b\xd8\xc9\xa6\xf4\x06\x9fS\x90\xfc\n^&\x0f\x96\"\xa2\"\x82>r\xee\x9dl\xf0Bj\x8d\xcd\xc4\xec'\xd9 \xbcA\x85\xb4\xfc\x1b\x8a\x9c#Kn \xb3\x1f\xc2i\xdf	\x0f\x9d\xd8\xd2\x0d\xe4\x8c\x0c\x8f\x98\xb3\x9e\xe7,V\xef\xbe\xb04h\xa3\"\x9e\xf1\xb9:8\xcbBSc\xf2\xa0\x84\x14	%\x949\x8b\x88\x0d{a7\xac\x9aS\xf2\x83\xfc\xfa(\x19\xceNlIK\x043\x9b\x8bu\x8b\xf5h\x9d\xdc\xa2\xcaaR\xa4\xe16DEY\xd0\x03\xd98\xa1\x90\xf5\x90d\xb4q\xe8\x9a\xd6D{\xf1d\xcb\xa1\xc3\xaa\xe2\xc8$\xf9\xc4\xb9@\x9c\x0b\xc8j\x9aS\x03O\xc4x\x8f\xb8\xe69\xfe\xb2\xea\xcc\xee\xaf\xb0\xd1f\x08fBj\xf2\xfa\xc2\xbb\x18K=M t\xc6\xcaGC!\xf6\xb5\xd9\xdd\x04\x84\x90\x8f}j<\x02 \x92\n\xbc\x91\x8e\x1a\x8e\xf5\x00\xa9\x15]\x04+|GF\xab\x13\x9a\xe0j\xbd\x99\xe9\xc44	)\xd6\xd1\xe9\x85\x82\xd4\x16\xe6\xfc\xe1\xf75\xf1\xd2\xc6y\xa1Z)27\x99\x95\xf6R+SX\xbf\xa2O/Z\xfc\x89\xa2\xa0\xa9\xef\x05\xdd\xaa-Z\xc5W\xb7\x85O\xd1\x1d\xe5)y\x93\x07\x8b[\x89\xbb\xb0\x9d|\x13\xcb\x8b\xc3|o\x8d\xf1\x1f\xb7h\xad,L\xd8y\xbe\xb6\xd6\x18_F`\x85Ot0\xce=V\x8a\xe4\x18i\"(Ss\x90\x1cp25\xbc~sMq\xaa\x87\x8e\xac\xba>\x81\xadA\xeba\x84N\xa2e8\xba5Ja#[\xe4\x89OEc\x91\x8ee	\x1f\x84\xf0\xa0\x10[\xf23\xd43\xc7\x11\xa1\x93\xcf\x91\x9b^\x02\xa1_\x96\xec\x15\xbc\xe8\xbc\x1f\xdc\xab\xb33^\xaf[\x86l)\xe1\x84\x17p\xb0\x19\xf3`\xf6\xbc\\\xdb\xb7\x03\xda\xa0\xb9\xe56L\xe3\x7f\x830V\xf63\xef\x7f\xd9\x1fx\xffH\xc8\xde?\xa8\xc0\x81\xf3\x0f\n\xffNfFO5\xe9\x82>\x9f\xa0\x1f\xba\xab!\xe5\xb4E\xad$)n\x93\x81\x0c\xc1\x17\x8e\xef\xb9\x0f'\xb9\x05\x1fnK\xd0\x993\xdf\x14]\xaa\x03\xbf'\xff\xbd\xc9P\xf1\xf2Oo.\xa2\x08D\xff\x16\xb0\xbb\x17\x9c\xb5+r\xe5\x89pR~N\x01\xd0\xe5\x1b\xb7\x84\xd4\x076\xa3\xd6\x85\xad\x8b\xbez\xca\xc9\xcd\xb6^I\xbdq\x05\xee\xf2h5\x1d$Q\xc3\\w\x06\xb7\x06[\xd2\xf0\xcf\x99\x05\x85\xf2\x15Z\xb6\xf4\x18G\xfd\xe1\x0f\x7f8\xfb\x91\x8c\xc3\xd9\xa9\xdf\x0fd\x87\xd7\xd4\x95N,O\xd8\x0bW\x9b|DW\xe13M\xc5\xf1D\xe8\x02M0n\x87C/I\xb9\xa7\xf1I\x81\xf3^N\x17g\x8a\x8e\xe2\x94\x05\x1f?\xdaY\xf0\xb7\x13\xa4\x91 ug\xc6\x14_\x84\xe7\x11R\xba\x99*S\x04\x9a#h\xf0lFB\x8e\x1c\xd12RD:\xce\xc8\x8c\x81\x11o\xff4\xcb&\xe5\x958\xea*\xc5z\xf8\x89\xe3\xc1c\xa6\xab\xe3\x96x\xc3\xa2\x84W)\xb8\x8b\x8dt+Z\xfc\xe5?\x07eD\xf3+h\xac\x17\x0f+\xf9x\xd4:\xb6d\xa5<\xfe\xca\x1c\xa9\x95\xc0Ty}<\xc2\xc8\x13\x86|\xd7\xd5\xc5l\xae/\xb2\xf1\xdd\xb1\xc9v\xd4\xf0+S\x85\xb6\xff\xd6j\xb0\x1d\xef>ge\n\x9f\x81\xddj@Te&\x119=\x9d2\xe7\x1b\x0c	\xf8\x17.\"}2iJ\xecO\xc3\xb3\xc1\xe1\x1c\xab\xceX_\x8f\xfe7\x9e\xcb4h\xc5\xec\xe6_e\xd2\xc9\x9c0\xbf\xfd\xb9\xdf\xc1\xfd\x9f_\xfd\x93\x18\x848\xb2{1\xa7A\x16.Q9\xc9\xef\xea\x8e\xc3\xc1\x90/\xebF\xcdSd1u\x99\xc5\xbf>\xc8\xdf3J\x0c9\x9d\xf9C\x01\xbb\x85\x18\x08z\xb2(dV\n\xfb\xadqG\x11?\xf5\xc8\xcc%\xee\xb0\x88\xef\x9f\xe0	\x1ad\x9c/\x07\x14\xb3\xc6\xee\x9c\xc8\x99\xcbSp0^\xd6\xc5r\x8c\x97^&\xc7\xf83\xf9\x9d\xe2i\x93\xd0\xb51\x8d\xab;1\x04w!b\xe6\x9e\x9f\xcf\xe6\\\xd7\x920C\x11\xc2\xbdA\xb7\xf1f\x80\xeb\xa9\x11\xd3\xa9~\xd4~+\x08\xbd\x8d\x16\xc5X)\xd1\xf49x\x8e)\xcd\x06\x1f\x8b\x9cz-9IK\x86\x98\xec\x8b\xdb\xebz'|\xddAK\xee\xa9\xc2\x96\xc2\x88\xe2\xae\x18\xd5<\xd9\xde\x8fc\xf3\xb5\xcd5\x11K\xbd\xfeJ\xde\x9f\x93\xfd\x1f\x8f`\xaa+1\x0ct\xd3\xc9Q\x84\xf8\xc1\x9b\x98\x807\x9c\x0c\x16\x8e\x9f]\x9dQ#\xed\x12\x18\x1d\xbe\xa7\xa7\xad\xf0\x02T\x88\xb3c\xe8;9+\xd9\xd2\xf6O\x17#}\xcf\xef\xc5\xd4+a\xfe\x8c\xcaR6\xd3\x1b\x9f\xc2\x08\xe7G+u\xebPa\x8f\xda\x87\xb0(@\x03\xdb\xd0%C\x0f\xac\xcb\xec\xf3\xe1\x97\x7f\xfe\xf2O},{6\x1c\xee\xf4\x93\xf4U\xd1\xd78\xff\xda\"\xab\xca\x1c\xb4\x91f\xd7\xdc\x82\x8c\xd9\x8eB\xb6J\xde\x1fdS1i\xfbo\xf8\xf2@B_2\xe0z\xcbI\xa8\x12\xf9S\xd81f\xe8\x15\xd1\x1aK\xb21\xc3\x80*\xe3\xb1\xd8\xbb\xc5\xd0\x10\x9f\xc0\x82\x15\x82\x8c\xc1\x08\x8f\xd0Il\x94\xdc,\xe1K\xbc\x0f\x9cIr!\xfb\xd1\x9c\x80\x16[\xd9b\xc0\xe0\xac\x07\xe1=c\xe2\x9c6\xa3X\x84\x1d5\x1e$\xa8\xc3'\xf1\xdeIZs\x144\xc7\xef19Mf\xee:>\x8d\xb6\xf8\xc4\xbe\xf0sJ\x8c1;\xd1\x1c\xccy\x17_\xd6\xd2\xa4wf\x90\xf5\xd7&\xf5\xa1\x03M\xdab3\xf2\x0b\xfe|\xfao\xce\xbb\x1f\xa7\xe9V\x8a@	\x17P\xa4\xc4exM\xa4\xc8>\x0f\xb1\x9c\xcfN\x83n\xcf\xc4Y\xceo\xc7\xaf\xaf&\xb7\xad\xd0\x8d\xe9\xafE\x8f?\x99\xb1\xb8\xb8\xb7L\x07\xf2\"\xd0q\xcbIAW{0\xbaF\xc0-\xda=|\xff;\x10\xce\xc9V\x83\x08\x03\xbc\x01\x91\x12i\x92\xa8\xe1\x95\xb2\x98\xb5\xba\xc3\x87\xec\x10n\xb19\xbbP#\xc2]\xaen\xb95\x0d?wVJ\xd0\x91y\xdc8\xcfA;\xe7\xf58\xc7\xc1\xb6V:0C\x8b\xfcr\xc0@\xee\xc5\xdf\xec\x0b2h/\xfeV\xbd(fD\xd1|\xd4E\xee\xe2< n\x85\x8f\xd3Q\x1e\x7f\xf7\xf8\x95L\\x\x95-B\xe4\xdb\xf0L[\x84Deg%\xbc\xdc\xe2\x9d,\x9c\xf2-\x13\xc9]\x11@u\x1e\xfb\x01\xa7\xc2\x86\xdc\x98B\x84\xb2\xd3\x12\xb6\xd5\xf2\x15\xbc\xf8\x1e\xc6\xd1B\x8b\n\x1b\xd4\xb3\xd5\x0e(\xf2\xfe\xfe\x84\xb6\xcb\xefc\xb3nj\x7f;\x83\xb3\x7f\xb9%\xda_\x19\x1d\xdcb\xddM\x99\xac\xcfhc\xaa$\xa5c8\x8d\x12]\xabE.\x9a\xd8\x08\xed\xa7w\xf2\x03oK\xd8\xf4\xd3\xed\x87\xbc|\n\xf4\x89p2\xfbL\xc39>\x8e\xf5\x05\xe9\xd9\x98\x83\x05g\xfa\x9e<\x03w\x88\x19\xc5\xd3\xc1\x1aBov\xaa\xceA\xbb\x99\xd9B;*t\xe5Y\xe5\xad\x0e\xe5\x05\xf1\xa5\xd5\xf4\xf3\x04r\n;\xb9\xb97\x0da\xdf\x90m..\x91+\xa3\x9d\x15}D\xcec?\xd0Z\xd82\x84\x94\x9c\x19\xa6\x8c\xf5\xb4YS%\xc5\x11?\x11\xad\xed\xb2\x9cL\xe5\xe3Za=Z\x04\xa6E%\x16*nT\xc8\xfe\xe1\x94b\xa6\xdb\xd4k\xec\x8d\x965\xf0\xeb<i\xd8\x12\xce\x85\xaepk\x149\xd2\xe2]\xfe\xc7Y\xa5\x04m\xc8\xa3\xbc\xd73A\xc6\x1a\xb50\xc5\xca\xc7\x1a\xaf\xcf?FIR\xfb\xb2\x9e\xc5#O\x02i\x87\xceI\xa3\x0f\xea]\xce#@\xe0V\xe4\x1a\x01o\xe5\xfd&g\xeb\xe5}\xc3P]\xc4W\xce\xf0\x8a\x9a\xf2\xeb\x11`8\x98\x9eU\xeb.\x013\xb1n\x91\xa0[!C'z9\xb7\xa5\xabL:9 |\xd5\xa2\xba\xc1HUb\xa0\"\xa2Z\xa5\xb6\xc81|\xce\x83\xb5\xb9}w^\xac\xd7\xf3r\x98\x15\x91\xe0\xae,\x871\x865\xc5Oe1\xe3\x0e\x06\xb4\xceh\x8ay\xe2+\xc9\xe4\xdd3w\x8f\x0f~f\x15\xb9\xda%$#\"\xef\xf8(\x90R9d\x069\xda+\xd2\x05\xbe\xc3\xc2\xac\xdd\x15\xb5\x00?Kt\xf0z\xb5\x8a\x05\x02\xc5\x08\xb2\xc7\xe1\xc4\xaf\xc4\xc34v\n/\xc3\x8d	\xd5Nl\xf9\x12\xa2\xfa\x9fV;\x05F\xbfR\xee4\x13C\xe6\x18\xe0*\xdd\x81\xff'1\xbeQ\xed\x14\x02\x9c\n\x032\xdc\x86*\x99Y\x05\xd4A\xbe\xdes\x8e&j\xd7W\xf4pR\x1fN\xe9D\xc5M\xe1\xef\x80:\x01\xc3\x02\xbb\x14\x93XQco\xb2\xe0LX\x12\xe5d\xfe\x0dB\xcb\x1e\xc1\xd5\x16Q\xbb\xce\xf8'&`th/\xde\xcf\xa3\x86\xb2No.hx\xea\x8c\xc5\x90E\xb5\xde!\xbfoe\xa1\x82\xab\xc8\xb9(.v\xcc\xec\nS\xc7A[\x04\x84\x1f5\xbb\xc8|^\xb8\x91\xf7\x9b\x84\xfe\xcc\x00\xe4Us\xd0x^\x98\x1fo\x86ZRl\x15\xb3u\xec=\xdbXA\xdaHW\x8fd\xebfL\xb2\x08X\xf5\xf3\x9c\xfc\x17\xac\xae\x80I%\x92\x8f\xd3R\xe3\xe96%\xa5\xb925A'\x8a\x96hT\xf6\xab\xbcQ\x91U\x91\x8f\x0dE9\x11\xbb\x97\xbba\xecf\xf6\x02\xf9\xc5\xd8\x0d\xf4\xf9\xf9qz\xf7)\xed\x1a\xbfw\x89\x9a`\x10\xe1\xff\x1c\xc5\x1c=\xc14\xc7A\xe1\xd2\x17\xfc?\x9fiOV-\x9b\xa0\x7f\x0b\x9f'\xc5\xc7\x0cj\xf0\xc0\xe7\xcf\xfeq\xf2\xfc\xd9\xc2\x0c\x14\x0fN\x85\xdb:\x94\xab,\xdad=\x17A\xe5\x89\xb6\x96\xa1\xec9\x10\xc2\x12c\xde-\xb5\x85\xef\xc8\xda\x19\xebi\xfd\xc9\x8d\x04,1\x99\xa0\xc5\x07\x82b\x1eYO\xf6\x05\xfd=\xd6\\tW\x90\xa2\xb1*\xd52\xd1&\xa3\xbex\xce\xbb\xb1\xf0bzR[\xbcg\x88\x10a\xe3b\xe5\xf7\xf1\xb1\xb5\xbcB\x7f\x0e\x17\x99\xc976\xa6	\x17)\x11\xe7&\xb6\xd8\x0fJx\x9c\xb6\xaa\x12\xfa\xa6\xbc\x9c\x9f\xa0B\x1d\x1e\x10\x05'\xc6\xc8\xec\x9c}\xe7\xceBz\xeb;7%\x8aCN\x9f!g\x83\xfaU<\xa8O;\xf2\xc3\x9c\xdd\x83G\x86hd\xb2U\xd0\xf6\xef\xb8\nUj\xf8\xce-\x93\xde\xc4\x97\x01\xa3\x1f1U\x81HGn\xf3\xf2\x86\xbb\x15\xa7<\xcaye~Q\x94\x1f\x96\x1b\xa1\x065%\xd4\xc2u\xb6d\xc7{\xa1E\xcby\x8a\xd8\xb7\xa10<'\xec#\x95\xc3'v\xec\xd4\xcaN\x9f\xcc\xc4N\xde\xa7\xf0\x84\xb6\x8c\xda>\xa7-\x9a\xa8\x1a\xedj\xc0\xda\xc5\xf9q\xf0\xac\xf6\xb3\xb2\xa2?\xde\xbc}\x7fz\x027\xd7\xf4\xef\xfb\xcbw\xa7\xb4#l#\xb2_\xef1\x0d\xe2\x87\xf4\x1e}~e\xfb\xe1\xe5\xcb\x87\xef_\xbe<\xc9\x1d\xe28\x97r\xce\xb1\x17\xfc|\x01)\xdcmQ\x8d\xb2YFA\xc9br\xcdc\xf8\xce\x85\xb3\xb3\x9a\xd9E\xdd	\xddb\x91\x05\xa3\x15\x87R\xb2\x83,\x18\x97\x83\xbe\x9ev\xbe\xe7\x8d7k \xb2\xa8}\xac8\xf6]\xccWT\xa9\xa6wQ+\xda\xef\x9a\xa7\x8e?#\x1d\x05\x99\xd7\xc5G\xed\xed(S\xa5\xde\xa26z-\xdb\xd1\x864\x04u\x08g;\xd1\xed\x93\x9e\xabPp\xf4\n\x16\xe1\xd7\xb1\xbe\x16\x85\xcf,\xaf\x84\xd8\xc4\xfal\x91\x16\xd8\x08\xcf\x1b\xf4\x86\xfe\x06\nW\x0cL\x83\xca\x8cG\x1e\xc5}\x92\xf3+\xfad#Yv\x8c\xa9\xd9\x92W\x91\x98]4\xa3M	\xd2\xc5\x07C\x16/\xd9\x15$\xdc\xc4W\x9a\xc6nS\x082\x15\xf8PC*\xeb\x89-c\xbd\xf9\x18T\xfc\xdd\xa7\xd7?\xc3\xc7w\xefBC()!\xfa{\xfa\x95l\x0e?V/.\xdf\xa4/\xd4^z\xd6\xa3\xcb\xf0\x1bc\xf5\xe1B*\x85m\xe0p\xc9?\x85*_\x8a\x16\x84X_\x17\x1a\xa7d( *j\xb1Cu\xe8\xd4[\xea\xf6\xd8\xad\xa2\x13:v\x7f\xbez{~|\xf9\xf2\xe1\xc7\xff\xd5\xedQ\xa65\xfc\xb0\xb1\xf8$}\xf1l\x1f\x1bBy\xd9bLm\xcc\x8f\xd3\xa5b\x18\x84\x15\xa9\x1c\xf00\xe5\xbd(\xb2\xdcO;\xdc\xdd\xf1I\x19[\x87\x1a\x16\xb7\xd756@\xe0\x8a\x13\xc4\x04\x87(\x1cG\xa8F\xa5\\\x97\x0e#\xbb\xc5\xc5\xc7a\xca\x9f/\xccN_\x9b)\x93\xb7\xf8#\xbf\xc2I\x1fSc\x81\xce\x1d\x87\xa8\x91\xac\x99\x812\xdavRTj>+\xe2\xc9yFna\xb1\x91\x16k\x7fyC\x9do\xe3\x17T{\xb8\xbc\x99\xf7\x08\x80\xee\xb0W\xccUq\xcf\xe0\xf2\xef\x8cQ^\x0e\xbc\x19Ry\xae\xd4`(\xc8\xe5\xc5cu\x8f\xb5?	\xffG\xc0\xc4\x9b\x18\xf2`\xa8k\xe1\x90\xa3T\xba\x04N	\xd7\xa1[B\xd0'\xae\xec\x1f\x95\xb0\x80\x0f\x83\x0dAptP\x0b\x87\xfe\"V\x91\xbc\x82\xc5\x9f	\xe2\x17E%\xd4\xfc!\xe8hjf\xedm\x02\xd4\xce\xa7\xe9:\xd1\x98\x1d\x9b\xa1\xf0\xab\xa0^p\xc0\x13\x1b\xa0\x92	\xb2D\xe0\xc1m\xf1E\x7f\xc2\xb6\xb1K\x08\x14&\x0b\xf34\x8c\x8d\xfd\xc2\xd6\xb0\x1e\x90\x01\xdf\xa1M\x1c\xf6:\x98_\xa35\xd6\x9c:\xf7\xc2\x8f.\xb7Nv\xfd\x93\x96\xb8	\xd5A\xec\xa5\x08\xd7\xe1\xfa\xcc\x1b.{\xb9\xbc!\x0c\xed\x93\xc1\xf0!\xab\xb8\x98\xf2\x88\x0b\x1f2n\x9c-\x88\x94\xfd\x10(\xf4\x97)\xa3\x8e\xbe\xf5\x13\xff`\x1a#\xfe`\xc1\x83\x9a\xc0\x97Hy\xfe\xec\x1f\xcf\xff\xf1\xfc\xbf\x02\x00\x00\xff\xffPK\x07\x08\x91\xf4o\xe5\xd9\x13\x00\x00\xb67\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pl_PL/common.jsonUT\x05\x00\x01\xc8r\x08f\x8cW\xcdn\x1bG\x12>SOQ  8\x01\x0c\xe5\xee\x1b%\xd167\x92H\x90\xb4\x85\xf5f\x0f\xc5\xe9\"\xd9\x9a\x9e\xae\xd9\xee\x1eO\x86\x81/B\x84<\xc3\xae\x1f\xc3\xb7 7\x8b\xef\xb5\xe8\x9f\xf9\xa1\xe1CN\xec\xfa\xea\xab\xee\x9a\x9a\xaao\x9a\xbf\x9d\x8d\xc6[6\x05\xba\xf1+\xf8\xedl4\x1aoPk\x12\xe3W0\xbe\x9c\xdc\xddM\xaf\xe1\xf2\x9f\xf0\xe2\xdc\xbe\x80\xd7\xf3%\x9c\xdb\xf0\xf3\xcb\xf8\xdc\xfe2\x1e\xbf\xf4|A\x8a\\\x0c\xb8\x9e\xdeL\xd7}\xc4\x89\x7fIhY\x7f\x87\x156\xec\xe9\xb2\xc0\x1d]\xf7{\xcen'o\xa6\xf0\xdd\x9d\x03uU\xb2Td\x86\xe4\xd5b>\xbb\x99.\xbf\xa5k\xaa\x17l\x9d\x9d\xe9\xf5\xde\x10\x86\x80s\x01\x9aj(\xd9\xba\x1f\xec\x8f 5\xb8\xe0\xbb\x88!\x1e\xb7\x13-f\xfe$;/\xa4KY\x9d\x8b.\x06\xb5\x80s\x01!\x19osb\xf5\x1b|?\xee\x94W\x99\x1d	\x9f\x9eg-\xe6\xab5,\xde-\xdf|S\xa5a\xd9\x0d	i(s\xb3E\x1b1]\xc2rz=[N\xaf|\xa5\xd6\xf3D?-B\x1b\xd6\x97`\xfdv9\x9d\\\xff\x8dP\xbbG\xc1\xf5\xa5l\xdbc\xf5vr=\xbf\x87\xcb\xd9\xdf\xe9\x92X\xd6\x1b\xce\xf25\xefv\x8a\x86G\x9f\xdb\xd3\x83*\xdd\xf7\xe0\xbb\xbb\xd3.\x8c\x8c\x8f\x92j\x12+,\xa8\x7f\xfc\x15\xcc_\xc3\xfa\xed\x14V\x93\xdb)\xcc\x16p?]N\xe1\xfdlz?\x88>\x1b}zy6\x1a\x97\xaa2\xa8l\xdb\xf2\x02\x9b\xf1+\xf8\xd7\xd9\xc8\xaf\x0f\x92\x8e\xbf\x87cFc\xa1\xe5\xf8l4\xfaw8t\xcf\x95\xe9h;\x16\x07\xa9\x9f\xff\x9b\x88\xd1\xec\xb9\x85\xd4\x95\xa3\x8e\x1d\xcc\x8e\x1c\xac\x01\x97\xb5\xdb\x0f\xa8d\xe5\xf3S\xd6q\xa3I=\xdfR\xc6Zt\x01\xc9|90lOn\x08\xfb\xa4\x0d\xe7\x89\xa70&\xd0\xd6\xc3\xb7i[\x0d\x14\x85\x0c\x83:	\x8b\xb0\x0d\xee\xd8#\x8e\x8a*\x01:\x0e\xf3D\xb3n\n\xael\x843\xd6\xce`\x16\xba\xf8\xaa]\x9fx\xe2(\x0d\xfd\x90\xa0@\xa3_K\x0c\x0f7\x9e\xbb\xfa\xeb\x17s\x18\xc2}\xec4\xd8'\x91{)(tLn\x9a\x87$\x10!\xc5:\x1a\x0f\xa8\xa5c\x13\xc2oI\nNpe\xdd\x1d\xd7\x1e-\xcd\x81\x04d\xfbZ\xaa\xe7\xa7\xe8U\x9c\xe5\xb1\x11\xd3*\xbe1\xb4y\xd8\x06m\x0e[\xa9HcA\xbdk\xbd\xf6\xce%\x95\n3\n\xfe\x17\x16<\x05j\xe9\xf6 \x9d\x85=\xda=|DU\xb5a,\xc8`\x97^kE'\xd7\x9a\xa2\xe3\x92\xd1\x08\x98{;\xcd$\xd1D\xa90\x02\x9c\xe3\xf1/\xa8\xed\xa1\xb1.\x97\xd4\xcel|\xb4\xe8MXT\xcc0\xc2%?\xa8v/\x17\x06s\xe5d\x967a:\x83\x0d6\x02i2\xfb\x82t\xeb\xd8g\\y\xf0\x87u\xf3c;d\xb6\xd1Yj\xbd1\x8b\xe3\xe3\xf3Sv`\x9d\xca\xeeL\x8d\x101\xd2]\xb6\x8d\xce\xf6\x86\xb5<`\xf6\x80\x11;\xf4 \xd7\xd8\x86o\x0c\xe6pB\xf7\x83\xea[~\xecdAm+g\xa8H\x8b\xc1\x04\xac\\\x93\x1d\xfa\xf9\xbe\xa9\\\x93\x96\xb7h\x0e\xd4N\xdd\xcf\xb5\xa4l\xa0\x03\xb7\xf8\x90VW\x072\xde\xd9n \xcb\xdeXI2\xe5 \xea\xde\x1c\xc8\x0e\xec\x05\x1e\xff\xf4\xf2b\xb4\xcc\xbbx\xeb\xb8\xc4v|\xdf\x98*\xe9O7\xc05Q\xde\xa5\x7f'\xc9\xfb\x15\xb6;\xb2\x0e\x08\x1e\x1f\xa9\xdd\xf2\xde\xb1\xe9\x8c\xe3\xff\x0c\x0b\xecr\xaf\xd1\xb8\xce\xb7\x90\xcfO\xbd\xb5\xe2\x0d;\x1c\x8aB%;E(K\xd5\xa4>\x9b\xf858\x06T\n\xa2n\xc47\xe2[\xb3\xeb\xd1\x16s\x8e\x0b\x0f\xde!\x88\xaf_\x8e\x8f\x11\xcfPg\xa4\xa2\x06l\xb5|hQ\x87\x8aw\x1e\xfe9-#\xae\xa4\xffj\\\xf5A\x1e\xf0)\xa4}\x02+^3.\xdb,\xe2\x0d\x026}2\x82u\xd0\x87k\xff\x1b\x10?\x99k\xe6\x1b4\xbb\xe0y-\x15\x81c\x06\x15\x90\xc4\xd1\xd2\xeec\xc7\x7f\xc0\x9c\x8f\xbf\x0f\x9ax\xc7\xec'\x86uLZ\xc9\\\xcb\x87\x97p\xfc\x8b6\x0d\xd4\x8d=T9>\xff\x01\xa5dK:O\xfa?\x96E\xc9\xc6]\xb11U\x19\x84r\x16\x00\xd8\xa2T$. \xa4\x91E\xf7\xc50\xe4:=A\xe2\xdb*\xcb\xc8\xdam\xa5.`\xbd'(q\xe7\x15F)\xd0\\\x83!\xc5\xdd=F\x96o\xd1\xeeC\xec\"hO\x84\x15\xc6\xfb\xc6\xdc:tZ&\x903t2\xaa\xfbM\xbbn=\xf9\xe0\xde\x10\xe5!~\xd8\xc1\xfbz\x16\x895_v/\xff\x1fd\x1d\x1d?\x83F\xb0X4\x05\x08V\x9d\\\x9a|E\xa4\xa3\x9a\x9a\x1c\xd0\x82\xf5v\xf4z\x99~/\xadd\xdd^\x8d\x82r\xc3\xc7\x80\x0dH\x11\x88\xc2\xfb^R\xddw'l\x0d\x17\xe0\xf6\xe4\xcf&\x7f/@\x1bMR\x949\x8a\x17\xb2\xb4Ue\xdd-\xbal\x1f%S\xa0&_\xad\xe3#BQ\xd9\xc3\xf3\x13l\x9a\xe7?\xc0a.\xe3v1LS\xdd\x97\xe5\x8e\xeb\x06\x1c\xf9\x0bup\x96,\xb5\xf3\xdd\xdb5\xf7\xc2#\xbe}\xaf\x86]\x1e\x92\xed?p\xe1\xa6\xfaS\xb4\x7fZ\xafo\x12\xc9_\x13\xfb\x9bt\\\xc1\x96\x0d\x04O$\xfd\xa7\xe2t\xdb\xfc\xc0\xd6yi8~\x86\x03f\x8d\xf3\x02\x9a\x94\xdc|\xb3I\x8b\xc6\xdb\xe1e\xd3?\xd02a\xa1\xa4\x9b&\xbd\xf0S\xbe\xcf6~Q:v\x19\xa1\x13Z\xfba\x8c\x96\x05\xe7\xe7,\x94\"\xbc\x90\x922\xb9\x95$\xa02\xaa\x0d\xdc\x1a\x8a\x8d;\x17\xc7\xcf\xb5\xa4\xf6#f\xa8TM\xc4K\xae%\x89\xe3\x9f\x9d\x83Mh\x95\x0f\xbb\xe3#\x1f?\xb7\xb0\xab\x8c\x8e\x95\xad\xcd\xd7/\xe9\xed\x98J\xc5z\x7f@\x8b\"\xd5\xc6\x12\x9a\xd8\x03\xf7q\x86\x1fZ\xdc\xfa.\x9b\xfeZ\xca\xf4\x7f\xe3\x86wRC\xc2\x81\x92#\x92\xf7\\\xdf\xb1\x93\x19\xa5C%\n.\xe4\xe0c\xe7\xd0U\xe1\xecU\\E\xb4\xda\x142\xa6\x8f\xae\x96d\xba\x07s\xfd\xe4\x0d\xde\x80\xdbW\xc5F\xa3T2\x8a\xd0\xad\xd4\x12]e\x9a\xf0\xb5\x94tq\x91$\xc0q9\x8eB\xbc\xfb\xfa\xc5\xb4ZT\xe9V\xe0\xba\x01C{\x00-\xe9\xd0\x89]3\x98\x90J\xd7\xed|\xbcK\xcb!\xdewM\xf2\x9etKUzI\xf2\xf2\x16\x18\xc1\n\xd7\xa3\xa1{axg\xc8\x86\xc2\xf8\x0b\x99\x9f>\xcd\xddc|s\xcc\xfd\xf0\x90\xb3\xd1\xa7\xb3Og\xff\x0f\x00\x00\xff\xffPK\x07\x08\xf1VB\x0dJ\x06\x00\x00\xd2\x0e\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pl_PL/server.jsonUT\x05\x00\x01\xc8r\x08f\xbc{Mo\x1c\xb9\xd1\xf0\xd9\xfe\x15\x85y\xb1\xaf/\x13\xc9\x9b\xddC\xe0C\x00K\xb2\xbd\xcaZ\x1f\x91\xe4\x08I\x90\x00\xd5\xdd53\x9ca\x93\xbd$[\xad\xee \x17c\x17\xf9\x0d\x86\x7f\x86\xaf{\xb3\xe6\x7f=(\x92\xdd\xcd\x9e\x917\x8b\x07\xc9s\xd2tU\xb1\x8a\x1f\xf5M\xea\x1fO\x9f\xcc\x16\xda\x94v\xf6\x02\xfe\xf1\xf4\xc9\x93\xd9\xeb\x97\x7f\x9c\xbd\x80\xbf>}\xf2\xe4\xc9\xec\x12\x15I\x10\x8a	0o\xd7\xaa\x9d\xcd=\xe2\xb6\x12\xb6\x85\xae\x11\x0f?u\xa8\x08:P\xb8\xce\xbb\x87\x0f\xdb\x8f\xb9\xa05tX`\x83\xaa-\x05T\xadC%\xb0\x14 @(\x0f\xea\x19\xae\xb1\x14\xb3\xa7O\x9e\xfc\x8d\x99\xce\xfep\xf9\xea\xcd\xcd\xaa.3\x85B\xdaa\x0e\x0c\x067\xc2\xc3\x04JZ\xd69Bm\xc9\xc2-e\x97	\x05d-\x14\xb4\xc0Z\xba\x03x\xa50\x93\x04n%,\x88\x05\xb4\xba\x86\x06\x95\x03\xa7\xc1\xd6U\xa5\x8d\x03-\x0b2\x90\x19\xddX2v\x0e\xd7\xb8@#@\x1bxYU\x92\xa0\xa0;\x91\x93\x05t\xe0V\x04t_\x91\xb2\x04z\x01J\x8fb\xc1\x19T\xb6BC*o\x0f\xc6U\x9d_\xbf\xbe\x1d\xd6r\xae\x1d\xb3'x\xad\x0d\xdcj\xb3\x89\xab9\xd2h\n@)uc\xa1DGF\xa0\x04\xb7B\x07\xc2\x82\xd2\x0e,\x8fr\x1a2\x82;A\x0d\x15 \x14 4\xdal\x80\xd4\x9d0Z\x95\xa4\xdc(\x17e\x83\xad}\xab\xf3\xcd \xfd\xa5\x07\x01\xc3\x98\xd5\x91vN\x97q\x06\x1ehs\xa3\xa5\x14j\xc9\xe8\n\x97\x04\x99\xa7\x01\xba#\x05\xcd\x8a\x148\xccxJ+Q\x14\xa4\x12iJ\xf3\xc9Z\x1a\x85\x0d\x90 \xe0D\xd8Jb\xcb\x8b\x84J[G\xc6\x02Z\x08\x03um\x13^u!\xf4\x9f\xb4\xac\xcb\x84\x1b\xc3\xe0.\x00\x03\xc3@\xc1\xc7\xe0\x07\xf0~\x94\xb5\x159\xa0*\xe0N\x14\xa4\x81\x05\x92\x99\xb0vz)\x16\xc9$\x05\xefv\x01oN_\xc3\xcd\xae\x8eE\xacG&\n8p\xcb\x96g\xb5\x1b\xe7\xc8\x1fp\x84\xf9fit\xad\n\xf8\x13\xcf!\xb2\xf28\xd6\x9el\xc4\xfb9\xa6\xdc\xc2\x80\x9e\xdd\x178\xbd,J\xa1~c+\xca\xc5BP\x01%\x95d\xa1\x12\x15\x15`\x9dA\xb1\\9\x10\xcai\xd6u\x03\xa5V\xc2i\x93\x88aU{u_	\xd3\x0e\xa2\x8e;\xb4\xd0\xb4K\xdc~T\xe2\xe1C.\x10\x8aN\xe0\xf6}\xddk\x87\xc8\xbb\x0c\xa1Pb\x0e\x95\x86\x8d\xfb\xfc\xc9\xb4\xf9*RAF\x1d\xe8\xa2\xd2\x8d\xa0\xa2\x13\xd0i\xcb&OP\xdb\x9a\xf9\xb9vG\xfc9&G{\x8e]\xb3+/\xc0\x94nh\xa9{\xd4\x01\x9c\xe9\xed\xcf\x04\x1d6\x82\x0c>\xfc\x0b\n\x0d_?\x87\x12\xb7\xefy.R82\xb0}\x8f\xb9\xd8\xfeh7\"_\x81\x00\xc9\x13?\xd8\x11\x7f#\x9c\x1c\xe5\xdf\xb4\xae\xde\xbe\xdf\x99@\x04Ng0\x87\\o?\xc2\x1a7\xf0\x8cu\xa3\x86\xff\x0fg\xa8\x96\xfa\xd9\x01\x9c\xf0\\\x9eC\xa7p\xf3\xf9S3\x91X\x8c\x1b}\xc4\x1fQ\x02\xdd;`$k0k\x06\x1b\xc58,\xc7\xca\xe5+\x1c\x8f(~\x87\xc1\x97\xba2<\x95z\xfbs\xeb6\xbaQb\x83\xa0\xa1i+\xda\xbeW\x82x\xf3\x03\x87\x16*\xd3\xb5\xe0\xd0o\x88\xe9(\xef0_\xf95\x18Z\x13kL\xbeF\x10\xe0\x1am:?\xd0Q\x89\xae\xde\x9b\xca\x0d.\xed\xeet\xc010.\x08\x97\xd6\xbbU]\x9b\x9c`\xa1M\x9c\x02\x82\xd5\xb2vB+{\x00\x17\x8ax\x10\xfb\x10\x83\xaa\xd0\xa5l!_iK\xca\x8f \x9e\xdc\x92\x14\x19o\x96\x91\xc1\x01\\\xd1\x0f\xb50T\xb0\x80\\+\x87\xec\xfd\x1cHB\xeb\xe0\x1b(\xc4bA\x86\xd8\xaf\xe3\xd2&\xbb\x9f\xaf\xd0\\\xe7\xda\x8c\xc7}\xbcB\x839\xab\x8a\xad\xb0\x04\xeb\x91\xbd\xbd;\xe1\x81\x0dy+\xd2\x0b(u!\x16-{D\x84|\x18\xe9]/\x9f\xd7\x01\xbc\\0\x80\xees\xa2\xc2;\xce\x15\x81\x14\xa5\x08\xb1\xa2\xb6d\xa0\x11R\x82\xa20y\xab\xe5\x1d1\xb3\xb8\xb2d\xaa\xb5u\xba<\xbe\xbe\x1e\xa6\x1a\xa7\xf5\xce\x92\xf9MA\x0b\xa1\xa8\x80\xe3\xebk0\xb5$\x0bRcA\x05h\x05NW\xbd\x12Y\x92\x94\xf3\xce\xb9\x15\x95\xf4\x08\xf3\x1b\xbd\\&\xca\x7f\xec\xe1\xcc\xb5?E\x8f\xe7\x893\xcb|D\x0f\xacbhM'z\xa2\xcbv\xfbQ\xaa\x16\xacke\xef\xf0#P\x10\x1be\xa19\x1d\xf0\xf89\xaci\xfbQ\x8aT{\x81\xc9\x9a6\x13d:\xe24\x81\x96zO\xe4[T\xcbGd\xae\x1f>t\xedf_*\xb3\xf7B#A\xc2\xce\xd6h\xf2\x95\xb8\x1b7\xe2$\x81\x05N	\xd5\x816K\x10%\x07DK\x0cKx	\xcb	\xc6\x95\xce\xb4\x1b\xcd\xe3\xd2p\xc4t\x90\x1bl$G\xa0\xf9\x04\xcca(\xc4\x9d\x862+\x1c\x0d\x84s\xb0u\xbe\xe2\xc0\x18\x04\x01\xa9\xa5P\xbc#\x05\xdd{\xfc\xc2\xe8\x120\xcf\xc9\xda\xa0o\xc2\x82\xf7j\x07{\x93b\xc5\xf1\x99\xc58\xb1\x93\x80\x01\xa5\xd5o\x90#I\x18\x0b\xb9!d\x0b\x9dN\x94\xc3s\xcb\xc2t\xcd\xb3\xae\xd0\xb8 \x9fU\xed\x99\x1f\xffl@{D\xe0\xa3\x96\xa0\xa8	\xac\x93xYh5n\xf8k\xa1\x84]\xc1%{\xbd \xf4Xj\xd6\xba\x8a\xd4\x8e/$N5\xfaq\xaf\nRp\x85\x85\xe8\x03\xe2\x08`w\xedc>O C\xa5(\x89{\xde\xa43\x94r\xe0t1F\xac\xff\xf7;\x8f\xe9\x83\x9du\x98\xc6\xb3\x8d.I\x15m\xa4\xda\x8fC%nl[\xa2W:\x0e\x02\xc9P\x01\xbf}\xfehX\xa0\x12\x85|e\xcc\xb8.\x06\xc0+c\xf4\xa0.\xd1\x16=)/\x89<\xf2\x11&g(\xe4\x94Q\xbf7<\"0xd\xd8%\xdaDe\xd1\xdaF\x9bb\x7f$T\x11\xf5\x18\x0bm\xdc\xce\x12,\x99;2\xc0\xa9\xf5#\xbc\x12\xec#\xec\xae\xeb\xecQn_fd\xeb\xac\xd0%\n\x95r\xbb_\x11\x87\x88\x91\xd5\xfdw\x01\x10\xd8\xf4\xf8_0k\xba\xafP\x15/\x13my\xe5!\xf0RJ8\xe5!\xfd!E8\xa7\xb5\x9e\x95=\xe0z\xe4l\x0e\x97'\xaf\xe7pv\xf9\x8d\xcfG}.\xa6\x9b\xa0\xd9\x16\xb8Jx\xe6\x00\x17\x0b\xef\xad\x0f\xe0\x9c\x1a\x8f\x8a<\x98\x00PZ\x0da\"4\xd9\xfb\xfbj\xb2\xeb\xf7\xe9V\xdf\x87\x92\xa6\xf2\xe1\x96\x03\xceB\xc8$\x0e,\x88\nNA\x83\x92\x0c\x99+o\xe8\x86\xc3\xea\xc6\xe9\xa6\xcfPv\xa0\xac\xc4\xeb\xa2^?\xfc\x94\xb7`\xc5\xc3\x07P\x08\xcb\xcf\x9f\xd8a[g\xb4J\xab\x9e\x85\x9cd\x0b\xec L\x0b\x01:\xef=\x91\xaf\x07B-\x00yJ\xc2A\xcdoT\xc2P\x9b\x9c\n.*\x06\xae\xb7mY\xdbN+\xf4E\x84(u\xa3\xb7\x1f\x1f\xfe\x15\xf9\xdf\xb6\xdb\xf7\x0f?\xe5\\\x98vM\x9b\xc6\x9a\xcf\x9f\x9a98#\xaa\x0dg_\x02x+\x08\xc2\xfec\xaa\x04K\x94\x92L{\xa6\x8b\xd1m\xbd	0\xf0\xc0 \xe9B\xc9\x16\xecJ7\xf1pc~\xc2\x16\x1bN3\xe1(u\x86\xf2\xe4W\xc5\x0c\x08\xc4\xb2\xfd/\x07\x8f\xc8%9\xbc\xa5\xd6i\x8e\xf0&|\xce\x93\x8f/XM\xac\n\xfb\x81\xdf\xf9\xcf\x17\xf0|p\xf0\x84&\x96\x8e\xbb\xe7\xbb\x12\x05\x1dq\xdc/\xd2\xe1\x04'$\x89\xd7z\xe9\xc9\xe7#\xa2\xaf#m(\x93WxG\x90\x11)(\xc2\x88)\xebI\x15\xee\xc73\x84\xf5\xec\x10\xa5<L\x19\x87\xa8\xc5\x89\xe4\x92\n\xde\xcc\xa1\xfc\xe6\x04\xd5\x17\xdcC\x0c\xf4\x83\xa1$\x87~\xd4T\xe6\x15\xe5\xb5\xb1\xe2\x8e\xe4X\x00\x0c\xb0`\xed+\xc1I\xe3\xa3\xcb\x9a\x87u\x19\xaa\xa4\x08\xa9#\xa6[\x07&\xe1>\x11\xeb\xcbX;]\xec^\xfbd\xa8\xc6\xe1\xaf\xd7+\xdd\xfc\x0d\xb2\xda9\xad8\xb3\xad$\xe6\x14\xf2\xc9\xfd\x92w\xa5\x8d\xe8X\xbf\xe5\xb9n.C\xa8\x1dE\x0d\xc8G\"\xf1\xae\xe4G(@(\xc9\xfaj\xd0\xad\xc8\xf0\xfa\x15\xb0In&\xa79\x08a\x95\xf8\x82\xf8\x1e\x15D~\xf3\xbb\x9cY)\xb6o\xb9\x1482\x13\xa3\xb6\xbd\xd5K\xd1\xa7?	\x01\xeb\xf9w\x9aCPO\xe8\xbd?x\x18x\xe7o\xc7\xbc\xa9_\\0\x8f\xca\xd0\x9d\xa0\xc6\xbb\xb3\x95\xe71\xe5{\xa5\xb5\xbb\xb8#cD\xe2`\x02{\xa3\xb5\x03\xdd\xe3\x02\xf3\xd3\xd8\xbe\xe2\xb4\xc9iX%\xe1\"\xd85X\xaa\x90\x0b'\x90:\xf7\xe9\x1cH\xb1\xe1j\xe3\xf8\xe4|\x0e\xa4\x9c\xdfU\x82E-e\x10\x81Ea\xc8ZX\x91\xa1\x03xKlETV\xaee	\\\x040yL\xbf{\xe2\x03xu\x8fe%\xe9\x05<[9W\xd9\x17\x87\x871\xf4\x85\xae\x1c\xc7\xd5g;k\x9d\x16aa\x91\xbf\xa6\x00\xab\xc2I\x02\xaa\xb0\xd6\xffd\xc5%\xcaI =-\x93@\x1a>\x86@\xea7x\x1aJ\x83\xb2\xbe\x16n\x9a\"$\xda\x10S\x83xFB\xd9`\x8a\x04\xbeK\xe8\x82\x1d3\x85!+:\xf2\x99\xb4)b\xfb\xcd\x92\xf3*<\xca\xfb\xa1\x18\x93\xa3\xd3?\x9e\x1cE)\x0c\xff\x85Lf\x8d\xbe\x01\x94\xf4S{\xc0<\xfd\x1c\xd2\xf8\xd3\x13{\x00=\x0d\xe4\xa8@s|\x0b^5\xb8\xa6d\x0b\xa5P\x9b\x91\xf5[\xa16\x02:j\xd4\xc3\x07g:5\xf4\xfet\x81\xeb9\xd4\xb6\xde\xfe\x08\xb2\xce\x80\x8a\xd6\xd5k\x90\xbb\xf4\xd0\xf8\n\xb1\x86\xdf\xff\xfe\xf7\x87\xdf\xb2\xd5\xe2\xe1(\xacD\x9bk\x97d-\xfe3\x88\x88\xb9r \x81\"\xd8\xe1\xeeP\xafv\xc3\xf8\xe1\xa4y\xdb\xa2\xba\xa3\xf5\x07\x14%%\xc3]\x9d\xa8\xef\x8d\xa1\xed\xc7\\@!\x11\nm\xb4\xf5\x1d\xa8!Nw-T\x82Lc;ZC#\xba6\x17\xbcs\xa1\xeb]\xafw\xbb6.p\xc3|\xb5\xc3\x90\x13,G\xeb\x90]	J\xa7s\xff\x9d\xb7\x90d\xe7\xd9\x00\x9a\xd6\xea\x8d\xf6S\xd3\x99\xc1n\x83C*\x17+\x14\x1ch\x1e\xfe\x05\x95\xe9\xc8\xb6\xdb\xf7\xa8XX\x18\xf1\xf9S3\x91s-:\xda\x91btW\n4\xf5\x97d\xb4=\x054\xa6\xabs\xe4Z\xbe\xa7\x85\xa65\xb8\xfdY\xab\x16\x1a(i\x89\x19\xae\xd1\xa5\xfaZ\xe2\xfd\xad(\xdcjG\xa8\xed\xc8\xfc\xdb\xb5\x0dD\xbfjq\xb4\xaco\xfe4jS\xf8\x8cg\xc8N<\xf4\xa7B>\xd07\\\xf3\xd0\xb5ek\xf6\xee\xd9g\xb0\xbe\xcb\xbc\xcb\xf8z\xa5\x8d\xcbk\xf7\xdf\x10\xa0\x0b281\xea\xb3\x114\x9f\x02\xa6\x86=\xd2y\xd3N\xadz\xceaxl\xcd\xab\x82\xad\x88]P\xcd\x01\xa7\x87g-\x1b\x880P**\xb5\x12\xb9\xe7;\xceM\xa5\xad\xdd4\xa6\x9e\x96\xe2\xe1\xc3<\xb4\x8e	\xb2\x87\x0fE7\xf6h(t)\x95n\xf8\xb0\xf6\x12qE\xcdP\x9e\xf6\xbc}\xc54\xadY\xa7\x03\xb4u\x13\xe2\xa4\xd3pQ\x91\xf2\x1d\x8ai\x9fAi'\xf2q\xf6\x17\xcb\xed{m}O4\x8e\xfb\xde|\xfe\xe46\x98\xdc_A\xa57\xd8\xf1\x12p\xaf\x1c\x9a2^\x88\x10\x92\x07\xf6'd7NWp\x9e \xfb\xd3{C\x0e\x8a\x88O\x07\xdbp	\xf3C\xad9'\xe6\xc3\x05\xdb\xaa\xbca\x13\x02a\x013]\x87\xbb-\x87&Y\x99\x96\xc5\xde\x06^\xc8\xe2\xcb\x1b\xd8\xa7\x1e\xc7\x8f\xf4~\xfb\x94\x05\xfa&\xf0\xcdX\xd5\x9daUq\x00\xd3\x8b!\x8f\xd6\xbe'\xbb\xe3`\xfb\x8e\xb0o_\xf2\xf7p\x9f\xb1\xdb\xcb\xd2\x8dO\x1e\x07\xe1M\x92K\xfa\x8f\xa9\x82\x87\x9b\xb40(\x14\x05>\xd9	5\x8f\xcf\xa2\xa5\xec{^Z9\xa3%[Em\xdd~[Y\xab\xb4(\xaav\xf7\xef\xf2K{\xc7Ju\x1c\xfbi\xd3\xb4\x87Uph\xb5\xfd\xaa\xf4g\xe8\xa7\xe1\xa0\xaf\xff\xc9\x0c\x88\xf9\x9d\xfa<&\xe4*c~\x112q?a\x8e\xea{\x99n$\xe0\x08NE\xc8djU\xc4\xfc\xd2\x7f2\x8a\x8f7\x97\"\xdf\x1c\xc0-+nlL\x16sPx'\x96\xe8((\xc8j\xcaI(\xeb\x08S-\xa8L\xadh\xa7\x97\xf9\xce\xa7\x13\xe1n\xa6/\x92'0\x7f/\xb5\xfd\x19\x0b\xd5{\x95\xcf\x9f\x1a\xd0\x05\x9cC\xa1\xc4\x0e\xf3\x9b\x95!\xdc\xe3\xee\xefB\xa6\xcc\x03\xe8W\xf3n\xeb\x81\xe5\xb5\xac\x1d\x90\xbf\x906Cf\xe4\xfb@6\xe9N\x1b\xdf\xdb\xec\xc7\\\x1d\xe2\xe1\xd8\xeb\x8c_\xbf\xd8\xe8\x0cQ\xe5\x1cK\xfaN\xd7\x89\xe5\\\x85h\xc3\x0e\x1aV\x1e3O\xe0\xb2\x05\xadr\x02\xba#\xd3\xc2\xd7\xbf\x05\xb4V,\x15\xeb\x1d\x0f\xf0\xe5gl\xd5\xf8\xdb\x0f\xe7\xb7+\x91\x9a\xdd\xd0\xfd\xe8p\xaf\xa88<\x925\x81\x87\xc6\xb5\x86\xa4\x0c\x0c\x97\xd5\xaa\x80\x8c\xf1\x8e\xee\x1d\x88\x05x\x87\xea|WC\xb8\x15<\xfb\xbby\xc6\xfe\xed\xd9\xdf\xb3\xa4\x92`\x91\x17*)\xa7oZ\xb9\xd1Ph\xd0E\xde\xb5\xae\xbf\xbd\x1bz?\xa5\xde\xfe,E\x133\x02m\x9dnP\xa5\x05\xa0\xa1\xa5\xe0E%\x13\x8f\x80]\xab6$\xd1\x89;\xba\x11I\x80\xbb\x8a@`\xa8uXV\xc3\xae\xf6\x18\xaf\xd0n@s\xf1t\xf0\x02\x9e}\xed\xcf\x00p\xa9'\xeb\xab\x08\xd3M\xf4\x9f\xfb3\xa9d{5I\xff\xfez\xc5\xb0\xbf\xb1\xef\n\x88\xe8\x8d\xf5\x1d\x81G\xf5\xf5}46\xe3\xfd\x8b\xafG\xfa\xdbG\xce\x8cG\x11Z\xbbwWo\xc7\x99p\xad\xc8\x80\xf9\xe4\xb3\x1f\xec+\x8f\xe0\xb8\xc7\x9b\xba\x856`u9\xed\x15Ae4'8\xc6\xdb}\xa3\xcd&1r\x7f\xaf5\xc8\xfc\x0bZ\x1cnK\xc3G\x7f\x11;F]\xae\x18\xf4F	\x82\x8e)F^6\xcd\xf4\xaf\xf9\xa378\xdf\xcf\xc9\xea\xb2\xf2S\x0fz\xdc\xefKr\x93\x16bDlD\x0eN\xfb \x95 \xc7\x038\xa2\xae\x12\x94w\x9c\x84~\xfe\xd4\xb7\xdf\xaf?\x7f\x92\xd0a\x16\x91\xb8~\xf8)\xc7\xa1\xf5x\x00\xe7\xb8\x96T	Z\xcfa\xfb3e-\x94\xbc<\x84\\\x83\xc2u\xa9\x04\xad\xe1\xdbp{ &\x92\xeb\x9c\x14\xead}uN\xe7//\xa2\xd8\x1e\x7f\x90\xeb\xf2\x0bU\xa1%\xcb\xce|\xe7\x1d\xc0m\xf2\x04\x80\xc7\xd8\xb5\x08\x0d\xe0\xc88\xbc\x13`\x07\x87\xf9\xca\xbf\x04X\xe3F\x94\x81\x06\xec\xc3O}s\xb2\xcd\xbbp\xa1'\xf5R7\xfe\xa0\x12\xd9+,\xc5\xd4\xcb]\x8f\xa0\xf9\x0e\xe0\x17}\x9d\xad\xb4\x90iz\x10\x8a\xb9\xeb\x1e\x9c:\xd9H\xbb\xd7\x93\xb5\x0e\x17\x8b\xe9\x93\x80k\x06A\x80M}\x97\xef\xe3\xfb\x11\xe0\x18\xcd\xcep\x88x+\xc2\"\x9d\x1d\xfb\xb6G\xdc\x95\xa3\xcd\x90\x89\x0e\x9ej,W\xb8V\x94bZ\xab\x84\xdb\xdc\x81\x8d\xff\x8a\x1b\xe5/|\xfd\xb5\xf0\xce\x95o\xd0\xecp\xc2gx?\x8c>\xc3{Q\xfa\xd7E^\xf3\xc9\x13x\x0f\x15Y\x9e\xd7eF\x86\xed\xa0\xc0\xd6z\x7f\xccYe\x9f\x7fX\xc8h\xa1\x0d\x0dA\x80\x13\xcf\xbd\xa6\xebD\xba\x18\xb3\xde3\xa1\xfe/\xa4O\x8e\xf3\xb1\x17&1\x95\x9f\x83\xae\x84\xd5\x0d\xfak\x82\x91h\xe4\xe4\xfb\nQ\xa1\x1eW\xb3\xc81v \x065\x0b\x8a\xf1\xc8u\xa63\x98S\xa9\xc7\xf9y\xc0\x01C\xe6\xd3o@%J\x02\x9b\x1b\"eW\xda\xed\xd91'{Go\x06N\xf1:\x7f|:4\x9d[\xbc\xce\x0f/\xbbF\x9a\x1dn\xbf\xbaK\xf2\x18\x0b_\x8a\xc4\\\xeaB\xf9\xd83\xba\x17_\xa6\x84c\xf3MQ\x1f\xc9\xa2\x80\x97\xc1s\x88\x1c\xa5l\x01\x8b\"q\xcd\x9e)\x15\xfbCG\xb1\x94\x95\xd36\xed-eg\xff\xa6K\xebI\xf6\x9a\xb4C\x08\xb3\xf0h\xab7fpiv\xcaqlrKt\xab\xcd\x06\xca\xf1\x8a\xe8;Q\x90\x1d\xee\xf6Bi\xcdLl\xc8\xd6\x1f\xdd\xc8\xc8s\xe7\xc1\xc6\x7f\x80s\x8b\xaa\xa0\xd1\x1f\xfc9|\xce\x93\x8f\xfd\xa0\xf1\xf4\xc9?\xe7O\x9f\xccb;\xb4\x7f*\xaa\xc2\x85\xfe,3\x18\xde_\xcc\x82\xaa2\xac\xd0\x15\xdaz\xcd\xc9\x19m\x0c\xaa`x\xb3&\xf6vR|\xd2\xdb\x99EAV\x1b\xc7\xab\xef=\xfb\xec\xc8\x07\xec\xdeM\x84H\xa4\xadC\xe7\x83\xe4x\xe3\x9e\xa0\xeb\xfeIS\x08_\xfd#\xb6]\xda\x1e\x9ct\x88x\x9ff\x0e\x87[\x94\xd9\xc5\xf2\xf3'\x19\xdb\x99\xb3\xeb\xfe\x8d\xcb\xec\xb6\xb5]\xbd\x11\x8dw\xda\x9eA\\\xe5_0\xc3&\x8a\xbd\xde\xb0\xabi\x07\xb6TV\x92\x0b\x9f~\x133T\x97\xa9\xc1\xfdY\xd7\xc9m\x96\x8fxE\xe8?\x1f~e\x0f!k\xe1+\xeb\x93+\xdf\xc9\xd7R\xea\x86C\xa3!\xb4Z\xbd\xe8\xcf\x91\xe3T\x86*\x14\x82\xde\xcb\x12k\xf8W\x96\xb3j\xc1?\x0ez\xc3K\xbb\xce>\xbc\xf9\x9b\xf5y\x88u\xa7\x97\xec`\xbf\x8a-\x9ex:\xb5\x98>\x16\x9e\x9d\xf6\x0d\x91\xb8E\xb1$g\xd4\xf7Z9\x0d\x02:4\xdd\xc3OE\xe7\xf7*\xb8Y,\xe3\x11`\xc1\x95gh\x13G\x88\xaf>|\x1cf\xcc\xcbP\x8c\xf8\xc0\x1b\x082\xf4Zv\x84j\xf8Vd\xae+\xca\xad\x1f\x90\xe7T9\x0bu\xc5\xab\xfa\xfa\xf9s\xf8\xc3\xe5\xab7s\xb8<\x7f3\xf7\xef9\xb5	\xe6\xbf\x10l+\xbe\xe6(90\xd6%\x14\xa2$o\xe9\x96\xa3\xd07\xcf\x9f\xdf\x7f\xfd\xfc\xf9|@\xf3\x08\xf0\xfd\xfa\x80\x85\xef\x8f\xbc\xe1)\xff\xf4M\x15\x07qB\xad\x9f_po\xc9s\xbe\xc9K\xbeY\xbeB\xb5\xa4\xa473\xfbK)h\xfb#\xac\xd0n\xdf\xeb\x81\xc8\x1c\xf7\xfb\xf9\xb2\xf4\xcd\x0e\xbd\x18\xdf\xa3\xd9I\x16\x92\xf5o\x0cg\xb9\xe4m\xcc\xbd\xd0\xf83\xc2	M\x80\xf2\x8f\x00\xd3j!\x96\xb5	Ev<8\x0f\xf1m\xae4x\x8e\xb4\xd7\xe1q\xc6.\xb1%\xd3\x90\xe9W\xc8\x99\xf3\xc8\xf5fxf8aY\xa0\xf3\x9bs\x821\xd9\x9c\x85\x98>\x8c\x0b\xf7\xc0!+O	\xfa`\xd5\x13x\xcf\x95\x12\xc4>`\x8f\xaf\xfa.\xe0\xac\xa8M\xdf\x90\x9b\x9d\xf4\xbf=&\x98\x8b\xd7\xa3W\xf1\xa7\x87\xf7\x8f'z\xb5\xc6Md\xb5\xa8\xf3\xcdE\xd0\xd4\xd7\xef\x8e\xbf\x87\x8b\xd7\xaf\x03\"\\\xe13\xfcM\xf8\xe5\xa1\xfe\xd6qvz\xd2\x7f\x91r\xc2\xb5\x83\xb1D\xb0\x94\xb4\x0ccO\xc3O\xdf\xa1\"\x15eJ\x8d\xc5q\xa2UR\x84W\xdd\x0c\xef;=#\xa5P\xcb=\xdb\xc0=C\xf8%;\xf8\xf6\xf9\xf3\xfbo\xff\x17v\xc0\x15\x80\xef\x82\xcfn\xb9\x1a\xa8\xd7)8<\xb0\xe91\xd0\x114\xb6k\xad\xf3\x8fU\xeb\xe00h\xfbc\x18\xb2\xd3E\x9d\xa5\x8d\xd3}\x8a\x9b\x1b\x7f\x1c\xda\xe4\xe1R\xdd\xb6*\xa7\xc2GE_{\xfa\xdc\xc2\xd7\xa7Y-\xa5]\x89\xb8\xafc\x88\x9b\xbd\xb3\x0e\x1b1\x06\x10\xdd\xa8s=4\xa1f\xe7\xfeV\xc7\n,\xd0\xc6^P\xf2X8\x0c\xa9\xa2\xf6\x0d\xcd\xe7YU\x9b\xe5\xa0\x94\x97\xfc\xe1u\xf20Q\\C\x850\x94\xbb\xd3K\xa6\xb9\x8a_\xec\xf9O/\xa7\x14!\xd1\xda\xa5\x8a\xbd\x19O\x19b\xf8\x8d\xd6\xd2\x89\xca\xef\x88\x90\xe1\x1a8\xa4RY\x0b\xb6\xce\xd6\x94\xbby|\x8dlb\xe9\xeb\xfb>\xa4r\xb4\xe1\x9f\x0dX\xf3\xadD\xbb\"{\x00\xbd\x0e\x19Z\xd6\x12\x0dG\x18\x13J\xca\x18Wf\x96\xdcQ\xbc\xf8\x7f\x01\xb3kr\x93w\x00\x8c~\x1b\xf4\xb2GG5\xf59or\xa6v\x85\x85n<Q\xf8\x95@\x8f|a\x11\x11\x90\x89~HL\x1e<N\x1bW\xaf\xfb\xa6]\x15\x8d+&\xe9\x83\xf3\xb8Nk\xc3H\x12v\xc5\xfb,\x1e\x1c\xa1\xad\xf2\xfe\xf4\xda\xa1\xab-T:\x94o\xa3\x8e0\xc1\xe0\xab\xdf)\xf1CMl\xb7*\xbc\xca\xc5\xdc\x89;:t\xda\xa1\xe4\xd0\x1a\xa2\xa4\x1f\xe8B\xfbl64\xccf.t\x9af7C\xd6\xe3\xda*@\xf8\xaf\x87\xd4*\x06\xc2w\xfe\x87\x87\x85l\xd9\xbb\xe5\xa0\x1fp\x1b!O\x9f\xfc\xf3\xe9?\x9f\xfeO\x00\x00\x00\xff\xffPK\x07\x08\x0b{\x92T0\x13\x00\x00\xf23\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pt_BR/common.jsonUT\x05\x00\x01\xc8r\x08f\x8cV\xcdn\xe38\x12>\xdbOQ\x10\x10t7`d\xee}\xb3ce\xe2A\x12{mu\x82\xc1\xce\x1eh\xa9lsL\xb1\xd4$\x15\xc7\xdb\xe8\x17\xd97\xd8\xbd\xee\x9e\xf6\x9a\x17\x1b\xb0HJr\xd0\x87>\xa9\xea\xab\xaf\xf8S\xfc\x8a\xe2\xb7\xf1(\xdb\x91\xa9\x85\xcb>\xc3\xb7\xf1h\x94m\x85\xd6Xe\x9f!\x9bM\x1f\x1f\xf39\xcc~\x87\x0fW\xf6\x03\xdc.\xd7pe\xf9\xf3Gve\xff\xc8\xb2\x89\xe7W\xa8\xd0\x85\x84y~\x9f\x17}\xc6E|\x8d\xc2\x92\xfe\x01\x8b\x07\xec\xe9\xb2\x16{\x9c\xf7c.\x1e\xa6\xbf\xe6\xf0\xc3\x91\x99\xbaiH*4C\xf2f\xb5\\\xdc\xe7\xeb\xf7t\x8d\xa7\x15Yg\x17\xba8\x18\x14\x9cpU\x81\xc6\x134d\xddG\xfb	\xa4\x06\xc7\xb1\xeb\x90\xe2q;\xd5\xd5\xc2\xcfd\x97\xb5tqUWU\x97#t\x05W\x15\xf0b\xbcO\x91\xd5\x0f\xf0\xe3\xbcK^k\xf6X\xf9\xe5y\xd6j\xb9)`\xf5e\xfd\xeb\xbb*\x0d\xcbn\xb0\x92\x06K\xb7X\xa5\x8c|\x0d\xeb|\xbeX\xe77\xbeR\xc52\xd2/\x8b\x90\xd2\xfa\x12\x14w\xeb|:\xff\x89T{\x10\x15\x9df2\xc9cs7\x9d/\x9fa\xb6\xf8\x19\x95\x84\xb2\xdeSy,h\xbfW8\x9c\xfa\xca^N\xd4\xea^\x83_\x1e/U\x18\x18/\x12OXmD\x8d\xfd\xf67\xb0\xbc\x85\xe2.\x87\xcd\xf4!\x87\xc5\n\x9e\xf3u\x0eO\x8b\xfcy\x90=\x1e}\x9f\x8cGY\xa3Z#\x94M\x92\xaf\xc49\xfb\x0c\x7f\x1f\x8f\xa2=I\x96\xcd\xc6\xa3\xd1?x\xca\x03\xb5\xa6#\xb13\xe9\xcc\x01\xad\x96\xbau\xd8\x11\xa3;\x198C2iw\xe8\xb9\xecMz{\xc0\xb4X\x92\xae:jt'\x03g@>\xa3\xe8\x97\xca\xce\xa43\x03-\x95\xc1\xab3\x15AT\xb5\xe4\xfe\x9c\xb2\xc1#\x89=y\xc4\x7f\x82\xafC\x0bO\xf5\xdb\xff\xb4\xac#Z\x92vF\x94,\xdd\x9bd_DB\xff\x0c\xe3\x10!\xa6\xe1k#xwY\xce\x964C\xbcO\x0e\xd1\x8b\xd4\x83\xac\x90C\xd6\x17\x01cb\xd8H\xda\xc5\x9fBKG\x86\x87x@Y\xc5e\xff\xd9Z\xf7H\xa7\xb8A#\xa0F\x9b\xb6\xa4\xa8<\x06\x01F+\x9c\x97\xb0G\x1eD\xd8#\xec\xa4B-j\xecCE\xe1\x83kl\x94(\x91\xe3\x1f,x\n\x9c\xa4;\x80t\x16\x0e\xc2\x1e\xe0E\xa86\xa5Q\x85Ft\x8bK^\x08\xd2Ic\x08\xccH\x98\n\x96\xde\x8f\xbd\x888U\xca\x87\x9e\xd0\x80\xa3\x8alj\xd2\xb0\xa3\xfcUnS\x19m\xb8\"\xb9g\xa3\x19z\x92;q\xe3dy<s;\xb2\x0f6\x00\xb1\x15\xfbJtv\x10\x19\xb5\x1e\xfcX\xb4\x9fRW\xd9\xb3.\xa3\xee\xb2J\xfa\x03\xd1XvW\\t\xa5\xde\xc7U\x9duy\xe1$b\x85\xd1\x1b\xb3\x9e3'kL\"-\x85B]\x0d\xe4\xfd\x9b\xd0Q\xdd\xb7\xf8\x12\xad\x87N\xf1\xd3\xad\xe90\x19\xad\xdfZ\xddY*\xf1\xa2\xc0G\xd9\x06]\xb4\x96m\xb2\x1e)\x8d<\xc7\x7f\xf6mvB<v\xeb\x98S\xdd\x8d\xb0\x8fV\x81i\xf6\xbf\xb5\xa2\xb3d\xc7{M\x96\xd8\x0e\xbb\xb2\x95]K6\x8d:\xc7s\x9ez\x1b\x1c\x81P\nB\xe3\xf2*\xb6^\x1a\x9dF\x12\xe6\x1c\xd5,F\xaaD\xf3\xf6\xefx\x02B\x97\xc8\x83\xdd\xb0\x15\xcb\x94\x95\xc2	E\xfb\x10\x08f\xc0\x95\xf4\xd7\xf5M\x9f\xe6\x01\xbf\x868R<.\xff\xaf\x9e\xa5e\x84_7l\xfb\xd5T\xa4\xb9E\xe7\xfe\xcb\x88o\x8d\x82\xe8^\x98=Gn\xa5BpD\xa0\x18\x89\x1c-\xed!\xfe'\xd0\xd4R\x8b\xd4\xb8{\"\xaf[\xd2\xfb\xb8\xa8\xaf-B#\x8c\x80\x06\xed\xd7VZa\xe0c }\x02\x01\xf5\xdb\xff\xad,\xc3\x01d\xb2n\xc8\xb8\x1b2\xa6m\xf8\xc2Z0\x00;!\x15V\xd7\xc0K)C\xf8z\x982\x8f\xbb\x88|\xdb\x96%Z\xbbk\xd55\x14\x07?\xfd\xde\xb7\xb9R\xa0\xe9\x04\x06\x15u\x8f\x08\xd9\xdc	{\xe0\xdc\x15_\x00\x01V\"\xfc\xec\xdf\xfe\xa5\x9c\xacS\xff**\x85\x93\xe1\x96\xbdOv\x8a\x1c\x07\x7f\xed\xd0\xab\xe1\xb7\n>\xd6\xb3\xb0*h\xd6I\xa00\xe2ET\x04\x82\xc0\x0c\xd5P\x0bs\xdc \xeap\xa1\x99#\x08\x0b\xd6\xfb!\xea\xef\xc9'i%\xe9\xf4*\xe1\xab\x13^\x18\x1b\x90\x02\x10\xee\xbe'\x89\xa7^\xa0\xb03T\x83; X\x7f	.V~\nvQ\xf1\xc5\xc0\xb48Tk\xdd\x83p%\xd7i%\xac=\x91\xa9,x\x18j\xc6\x99\xa6\xf1\xd4\xd7\xe0\x91^\x08\xdc\xdb\x7f\x1bYFe4$\xb5\xf3\x8a\xed\x04\xbd\xf2\x88\x97\xec\x85\xb2yy\xfd\x7f\x85\x9f\x85\xbf\x04\xff\x97\xa2\xb8\x8f$\xff&\xeb\x9f\xad\xc1\x82\x1d\x19\xe0H }m)>\xed\x9e\xa8|\xfb\x0f\xecH\x82\xc7:\xb1\x9aw\x03$4<\xc3f\xe7~;\xeb\x88q\x01\xb7\xe7x\xb6\x97|\xbf\xd2p\x93w\xec&@\x17\xb4\xf4'\n\x9e\x05\xe7\xfb\x8a\xcb\xc0\xe5o\xb0\x94;\x89\x15\xb4F\xa5\xc4\x9d\xc1 \xd2u4#\xde\xa8s*\x91\xe8f\xf1-\x10\xb8\xde\xeaq\xd7\x9a\xb8UGFwx\xabB\x9d\xd7l0fQ\x98x\xd8\xb1g\x13n\xbd\x9c\xf2\xd7F\xc67\xfd=\xed\xa5\x86\x88\x03\xc6@ \x1f\xe8\xf4HN\x96\x18\xe4\xc0V\x888\xe1Z\x9es\x13\xac\x80\xb6\xdbZ\xf2\xc27\xc1b\xd4\xf5M5\xa8\xb8;\xb4\xf5V\x0b\xa9d\xb8f\x8a\x81\x7f}\x1d[\xdbQ\x13\x9a\xb1\x89\xa7\xdd\xeato\xa5\xae\xf9\x9dZ8\x88\x17\x04\xa1\xa1\x8f\x0e\x94\xdf\xeaS\xd2\xfd\x97h\x0e\xf1^\x1f1z\xa1\x8b\xb6\xf1\x17\x8d\xbf\xb4\x98\xc1\x1e\xbf<\x86\xe1\x95\xa1\xbdA\xcb\xe5\x08\x08V\xdd\x16\xdeM\xf2<\x9cb<\xfa>\xfe>\xfe+\x00\x00\xff\xffPK\x07\x08L\x95\xc9\x96\x9e\x05\x00\x00#\x0e\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pt_BR/server.jsonUT\x05\x00\x01\xc8r\x08f\xbc{\xddn\x1c\xbb\x91\xf0\xb5\xfd\x14\x85\xf9p>\xdd\xcc\x91t~\x02\x04\x0e\x10@\xbf\xb6\x12IV4r\x8c ?@M\xb3f\x9a\x11\x9bl\x93l\x8d\xe6\x04y\x97\x04{\x11dow\xf7	\xf4b\x8b*\xb2\xbb\xd9#\xd9	vO\xf6\xc6\x1eV\x15\xab\xd8d\xfd\x93\xfa\xd3\xebW\xb3\x95\xf3M\x98\xbd\x81?\xbd~\xf5jv~\xf4\xab\xd9\x1b\xf8\xed\xebW\xaf^\xcd.,\xa30jg\xa1EK\x06\"=\xc6\xd9\\\x90g6zM\x01V\xceC\xac	\x96h-\xf9\xbd\x00\xe7\x9e>ud\xa3\xd9\xc2Q\xb8'\x05\xbf\xea(0\x8f\x00F\x87\x08h\x15\xe8\x82s\xe3\x14\x9a\xd9\xebW\xaf~\xcf\x8cg\xbf\xb89{{Ww\xcd\xd2\xa26aX\x0b\x83!\x8e\xf0\xb4\x88\x86\xd6]\x85\xd0\x05\n\xf0\x91\x967\x05\x05,\xb7\xa0h\x85\x9d\x89\xfbpfqi\x08b\xad\x03\xe8\x15l]\x07\x1b\xb4\x11\xa2\x83\xd0\xb5\xad\xf3\x11\x9cQ\xe4a\xe9\xdd&\x90\x0fsX\xe0\n\xbd\x06\xe7\xe1\xa8m\x0d\x81\xa2\x07]Q\x00\x8c\xf2\xb9\xf4\xd8\x92\x0d\x04n\x05\xd6\x8db!z\xb4\xa1EO\xb6\xda\xee\x8f_u\xbd8\xff8|\xcb\xb5\x8b\xcc\x9e\xe0\xdcy\xf8\xe8\xfc}\xfe\x9ac\x87^\x01\x1a\xe36\x01\x1a\x8c\xe45\x1a\x885F\xd0\x01\xac\x8b\x10xVt\xb0$x\xd0\xb4!\xdeI@\xd88\x7f\x0fd\x1f\xb4w\xb6!\x1bG\xb9h6\xb8\x0d\x97\xae\xba\x1f\xa4/\xa8i=\xf1B\x1f\xd0\xf3\xe2\xbdS\xd8>\xfd=/\xe2\x8e\xe1\xe0 T\xde\x19\x03\n\xa1}\xfa\xebZ[,H\xa1\xa1\xd08\xa8\\\x03\x08\xb8\x14\xd4\xaa\xb3\xca\x15_\x8c\xd6\xd9m\xa3\x03\x0d\x82\x8f\xac\xb3\xba\xd1?\xa0\xcf\xa2\xae\\\x88\x1e!:\xe5\x02\xb8\x00\xad\x0b\x11\x95\xf3\x14\x98\xb5\x03\xb4O\xffiu\xe3B\xc1\xb5S\xda\xfd\xda\x99\xae)\xf82\x0c\x1e\x120qN\x14|82\x81w\xa9\xe9\x82\xaeD\xf9\x1e\xb4\"\x07\xad\xc1-\xf9	\xeb\xe8\xd6z5\xb0\xbd\xd2Vc\xec<\x06P\x04o/\xce\x01\xadnPa\xaf|_ \x18\x98.\xd7W]\x1c\x97\xca\x038\xc6\xea~\xed]g\x15\xfc\x9a\x97\xd2\xb3c\\\xb2\xa4\x01/K-\xb9\xa5	=\xbb\xcfp:R\x8d\xb6_\x87\x96*\xbd\xd2\xa4\xa0\xa1\x86\x02\xb4\xba%\x05\xbc\xe3z]G\xd06:6\x04\x0f\x8d\xb3::_\x88a=<{l\xb5\xdf\x8e\xa2D7I\x80\x10\xf5\xb0\xd3\xd7]\xb3$\xcf;\xadp\x1b`\xa3c\xed\xba\x08\x966r\x9c\x01\x96\xb4r\x9e\x00A\xb8\xb2\"+2\x14I\xed\xc8\xbb\xc6\xe2H\x934\x8b\xa3\x18L\xc7\xc9\xfb\xc3\xbce\xca>\x9c\xa0\x85\xca\xd9\x88\xdaB\xd7\xb2e|s\x08\xc6m\xc8W\x18\x08.1j\x0b\x86b$\x1f\xd8\x94\xad\xac6\xec\xef\xc8\xbe\xd3\xd1\xec\n\x8f\x02\xcb6\xc1\xbf\x9f\x89\x9fC\xe8\xaa\x1a0\xc0\xde\x91\xd5M\x07\xff\x1f\xae\xd0\xae\xdd\xde>|\xc8k9\x84\xaaF\x8f\x95\xc87\xce\xae'\x92U\xb9\xbdj\xdb\x0b\xa3\xc7\x08\x8c\xec\x05\xf2>\x8e\xd3*lcU\xe30\xf3$\x8f\xf3\xd1\x87{\xf6\x86>\xb0\xfc\xca5-o5 \xe4Y\xe2\xad+\xf2\xb2a\x11\xc3=\xfb\xe4{\x02Ok\xcd\x9a!\x0e\x99m$\xd6\x9ePA\xe5I`\xcf\xc4\xdf\xe1:\xec.\x01\"\x03\xf3G\xe0Z\x96\x10\\\xe7+Jb3Up\xa6\x93p\xb0\x0f\xef-\xf1$V\n\x8fV\xb9\xc6l\xa1\xaa] +3\x08\xab\x1a\xd6d\xc9c$\xd53\xd8\x87[\xfa\xd4iO*}c:}\x8c`\x08C\x84\xef@\xe9\xd5\x8a<\xb1{\xc7uy\xd6|\x16\x8b\xca\xf9\xf1\xa8O\xfa\xd3\x81\xd0b\x03A\x90y'm\xd4\x02\xdc\x90\xd8\x8b[q\xa4\xd2\xab\xad\xb6k\xde\xd0a\xa6x`>\xa3}8Z1\x80\x1e+\"\xc5d|zF7:\x85\x0c>\x17\xd8hc\xc0RZ|p\xe6\xa18\x9dr\xa9]\x88\xae9Y,\x86\xa5\xe6e}\x08\xe4\xbfV\xb4\xd2\x96\x14\x9c,\x16\xe0;C\xac[\xa8H\x81\xb3\x10]\xdb+N C\x15\xef\\\xac\xa9\xa1\x17\x98\xdf\xb9\xf5\xbaP\xfc\x13\x813\xd7\xfe\x14\x05\xcf\x0bg\x96\xd5\x88\x1eX\xe5\x08[.\xf44\x81\xb2\xd0\xcc\x88\x7f\xf3\x17\xf3:\x8b\xc8<\x87MM\x16\xac\xb3\xc4J\x10(2\x92\xd7^\x19=	dy\xc2%\xda\xf53I\x06\xed\xba\xc3u/\xec2\x0f\xa1\xc5\xea\xfe\x05\xa1%\xd3\xd0\xa1\xafj\xfd@\x05\xd3\x11\x96\xf8\x15T\xfb\xce\xaf\xa1\xa5\xf0\xa9\xd3\x01\xd9\xeb_4\xb8&[8|\xa5\x03\xa7\x1a\xb7n\xe9\xe2h!7\x9e\x1eX!+\x8f\x1b\xc3Qg>\x01s\xe8iD\xc77\xb4\x0c:\xd2@8\xfa\x98@\xbc\x06 \xbb\xd6\xbcYV\xd1\xa3\xe0W\x9e\xa3pUQ\x08I\xe5t\xc8\xde\xf1\xd9\xa2Xw\xc4\xb9\x8d\x0b;M\x18>\x81\xaf\x91\xc3Fv\xd3\x83\xe1O\x16\x1a\x00\xed\x96\x85\xb9\x8eW\xdd\xa2\x8fI>\x9f\xd8\x9e\xcc\xdf\x1b\xd0\x82H|\xecz\xf4\x9a\xe5^9;\xee\xfb\x1d\xf9F[\xf4\xc9\xdd%\xb1\xe7\xc4\x0e\xc3	\x08pI>\x16\xc1\x90\x14\xd9a\xf6\x99\"\x0b\xb7\xa8t\x1f\x03G\x00X\xb7\x91h\xcf\xcbHYj\xc1\x84m{\x89\xc6\x0c\x9c\xfe\xdfOy\x08h\xc3f<\xa8KN\\9\x9bH\xd0!\xe9\xcd\xc45\x86\x9a\xbdm\x83\xf63A\xe9p\x98\xca\xde\xf5\xdb\xc3id\x88.\xa2)\xce\x8b\x1a\xd4\xe6\xcc\xfb\xf1\xf3\x18\x00g\xde\xbbaI\xd96\x85\x94\xbf\x8c\x04\xf9\x02\x93+\xd4f\xca\xa8\xdf\"\x9e\x91\x18\xbc0\xed\x06C\xa1\xbf\x18\xc2\xc6y\xf5|&\xb4\x19\xf5\x12\x0b\xe7\xe3\xce'\x04\xf2\x0f\xc4g\xec\xe3\x0b\xbc\n\xec\x0b\xec\x16\xdd\xf2En\x9fg\x14\xba\xa5r\x0dj[r{\xac\x89\xcffd\xf5\xf8.\x01\x12\x9b\x1e\xff\x8fM\x9d\x1e[\xb4\xea\xa8\xd0\x9d3\x81h\x0fwNa\x18f\xcc\x0b${A\xc6a\x00\x9d\xd0\xfb\\\xb9\\\xcd\xe1\xe6\xf4|\x0eW7\xdf\x01;\xee\x0eZ\xff\xf4\x1f\xad\xd7.\xa7Q\xf6\xe9\xdf\x1c\x04\xfe\x07W\xc4Yr\xd8\x87k\xf7\x90\xb3f\xc9@\x85_\x03\x11\x9b\xe5\xd3\xdf\x9bD\x9c\xd6\xa8\x95\x9b\xae{r02\x1c\x92\xf2~\x08\xae}\xfa\xdb\xd3\x7fq\xe2\x88\x1e\x01\xfd\xa7N?\x14\xe6\xb7\"R\x9c\xa9&\x8d\xea\x99\x9dgh:\x87\"f\xc1j\x82a\xabP:\xb0Yr\x00e[\xe2\xc0\xb5k\x9e+3\xc93\xd8\xaf\xf8-$\xe8\xbcw`\xc2\x84w\x81<T%	\x87C\xd9\x9d\x82\xa1\xf3\x15)\xaeD\xc6%\x0b\x08\x8e\xa4^q]\xc1X<\xa3Dm\xceD\xc3\x1c\xa2\xd7m\xe5\x14%+\x96\x0fyI\xc8\x1a\x8d!\xbf\xbdrjtoo\x13\x0c\x04\x98$\xbc\xb7f\x0b\xa1v}\xa6\x9c}\x06\x1b\xb3\x1ce\xc9\xd1\xb8%\x9a\xd3\x7f*\xb6@\"6\xdb\x7fq\x90\xc9\\\n\xd3Z;W\xa6\x13o\xd3p^\x0c\xbelP\xb5V\x13\x9f\x1e*\x97t\xf7\x0d\x1c\x0e\x8e\xb8i\x11\x06\xad\xa7\x81d\xc2\x85\x8e\xb5\xb5\xa4\x06N\xef\xb4\"8Me\x07\xdc\xc8a\xcdG\x04{\xf0\xc4NJ\xed\x1a\x1f\x08\x96D\xf6y\xa1\xc2\xac'\x95\xbc\xccg\x08k\xc1\x01\x1asP2N\xf1\x8e\xb3\xd05)\xde\xde\xa1\x84\xe7\xf8!E\xfb\x10=e24\x14QfMe\xdeR\xd5\xf9\xa0\x1f\xc8\x8c\x15\xc3\x00K\xd1\xb1\xd6\x9cq\xbe\xf8Y\xf3\xf4]\x9eZ\xa3S\xde\x89\x90\xb6:M\xf5\x05\xf7\x89Xi\xc3\x84\xe9\xc7>k\xc1\xf4\x06\x88\xf0\xdbE\xed6\xbf\x87e\x17\xa3\xb3l\xd5\xad\xc1*\x97M\xc3\xa4Q\x80\xf3\xfa\x07\xd6xs\xed67)<\x8f\xa2\x06\xe4\x0b\xd1{W\xf2\x0b\x14\xa0\xada\x0d\xf6\x18k\xe2P\x8d\x16B\xc4\xea~r\x9a\x83\x10V\x89R<\x8f\xc5\x97\x8e\xeb\xc82\xaf\x19c\xd6\x1aA9\xf8\xee\xa7U\x8dEm\xa4G\x8d\xbbtk\xdd'O\x05\x01k\xfc;\xc71k\x122\xc4U\xf7\x16\xd1\x00:\xb8A\xedw\xfb$\xad\x7f\xfa\xfb\x83\xc6\xd1\xd7\xdb\xc0\xa4m\"\x9d\n\xb9u.\xbe\x7f \xefu\xe1\x83\x84=x\xe7\"\xb8\x1e\x97$\\\xe4\x9e\x98\x0e5\xebG\xcdj\x91|P6}\x08\xc4a \x12\x18W\xa52Q\nG\x84\x93\xd3\xeb9\x90\x8d\x942\xa2UgL\x12\x81Jy\n\x01j\xf2\xb4\x0f\x97\xc4fEM\x1b\xb7,\x81K\n&\xcf)yO\xbc\x0fg\x8f\xc8E\xeb\x1b\xd8\xabcl\xc3\x9b\x83\x83\xb4\x8e\xfd\xd4\xea\xe3\xa8\xbc\xb7\xf3\xad\xd3\x92.}\xe4?S\xce\xb5\xe9\xd4\x01m\xfa\xd6\x1f\xb3~\xd3\xcd$\xc6^4\x93\x18\xdb\x0f\xc7\x18\xcb\xf6\xba\x1ba\x93\x0e\x9f\xeb\x91\xcd\xd8q\xea\x83u\xa8\x9c\x91\x149\xeaVT\xa8\xe9I\xde\xfc\xce\xdeH#\x16\xdf\xc07\xdf\xfe\xe4\xf1\x9bo\x7f2\xe7\xdc\x00m\xed\nq\xd0\n\x91\xfb\xd9\xef\xec\xa2F\xdf\x16\xc4\x0dJ\xb3&\xa2\xa9Q\xe1\xcf~g\x93C&\xff&\xfb\xdd\xe7\xb9\xcc\xcf\x8a\xd5\x7fRc\xbav\xf1\xab\xd3\xe3\xbcd\x86\xff\xe3\xdc\xea\x8f(=\xa9\xa2\xff\xdb\x03\xe6\xe5p(6.N\xc3>\xf44P\xa1\x05\xc7\xd15y\xf0\xe4\x06\x8b\xd31\xda\xde\x87\xc2\x04\xa3'\x8b\x06\x128\xeb\x8bRs\xf0\xd4\xb8\x07\x02N+\x95\x8e@\x8f\x91<\x13\xfe\xfc\xe7??\xf8\x9em\xff\xe0\xeb\xb8m	<I\xc3\xa1*Cv\x83\xa1r\xc5\xd1\xa5ab\x9es\xf7D\xd2\xe7A\xbbSeO\x86\xf9\x83\xe6\xb0vg\x03\xc2 z\x99%\x15\xd3cW\x18\xc4\x95\x0c%\xbb\xe0\x8a:\xf3\x91\x06\xfc\xa8\xd6\xfccH\x0bR\x1e\"-\xe8b&\x87\xb8\x95\xf6!\xc2\x83\x0ez\"\xef\xf1\x9d\x18\xd5\x8e\x0d\xd6\xc9\xd2\xc4\x80zO\x86\x8f\xba\xe9\x9a\x1e\xe5V\xd0\xb5\xb9u\xb1\x9b\xf24\xf8\xb8\xd0?<\xb3k\xfd\x03\xbd\xc8Q\x10\xcf\xf9q\x18\xba:\x9ep\xfd\xa8U\xacw\xd8n\x18\xf6\"\xdf\x84\xf9\xe2Bi\xdd\xdd\xfdz\xdc\xee4L\\8\xae\xe5\xd6V\xca\x06\xfa\xael\x95Z\xbb\xb2>\xf6\xc5\x92\xc2JGz\x97\xf1\xa2v>V]\xfcW\x08p\x8a<N\xcc\xecj\x04\xcd\xa7\x80\xa9\xa9\x8dtbl\xa5\x9d\xcd9\x08\xe7|<%\xcaJ\x8b\xa3\xed8\xba\xf4\xf0\xd4\xe6\xd1\x1e\x1aK\x8d\xb3\xba\x12\xbe\xe3\xdal\xd9\x0e\xbe\xdei\x04s\n\x13#V\x12\xac\x86Vs1\x996C\xc5:\xf0`\xb2i\x19;\x9d\xe0\xc2\xb8\xc7\\Y\x95\x8d\x88\xa3\xa5'\xe8\x1a\xb0\x03|\x9c\xea\xa2\xae\x8a\xa5\xa6a\x9a&gW^v\xa1\x81\x86B`\x95\xcbV\xcf\x89a\x1c\xea\x9f\xbe\xe3\xcb\x96?\x91\xb0\xd2)\xeaN\xe40p\x8c\x1f\xa7\x14\xee\xa3k{\x17C\xb6F\xb0S*\xcb\x9e_\xa88q`\xbb\xff\xd49.&\xc1u\xf0\xa9c5\x02\x07ak\xab\x0d\xc6JZ\x1a\xf4\xf4\xb72\xb9pF=\xdb\xd9\xf7F}~g\xfbD\xe3\xe4\x85\xbeq\x9f\xa0@\xdf@\xbe\x1b\xeb\xba+l[\x0e\xc1n5\xa4\xd1N\xfa\xb9;\xce\xaf\xef&K\xeb\x93\xc7\xc3\xad\xc7n\x13\xccm$w\x1c\x84o\x8aTR\x06S\x0dO\xb7\x00iR\xaa	$\xb5IE\x90h\xa01}\xb3\xcc\xd9\xe8\x9da\xb3\xe8B|\xde\x92v\xb6\xac\x92\xda\xdd\xfd\xbb\xf9\xdc\xde\xb1\xa2\x9d\xe4F\xdc4\xc9ae\x1dzt\xffT\xb234\xe2p0\x98\x1f3\xdfa~\x17\x92\xaf\xa46\xc7\xe8_S\".\x0b\xbe\xd4\xf6\x1eR\xc2;\xb6\x163\x01\xc7^R\xa9 \xe98\xc9\x18\xae>\x04\xc5\xc7[\x19]\xdd\xef\xc3\xc7\x9aK\xb3T\n\xab9X|\xd0k\x8c\x94\x14\xa4\x9er\xd26D\xc2R\x0bZ\xdfY\xdai\x82\xde0\xac\xefN\xa6U\xa5bqP\xbd\xa1.\xe4\n\xaeF%}\xd0\xf1\x8e\x8b\xcb\xb9k\xb9\x00\xdb\x11t'\xd7(\xbb\x92b\x86NDe\xe0gd%9\xda>\x17\xb3\xed\x06\xee\x0b\xd3E \xb9\xea\xee3\xcdSg\xf7\"`\xb8\x1fgx\xe9\x92\xf6sn\x0f\xf0`\xec\x9a\xe6\xd1\x17[\xa6)\xda\xb0\x1f~\xe7\xba\xc2\xa0nS\x14b\xc7\x0d\xb5`\xe6\x05\xdcl\xc1\xd9\x8a\x80\x1e\xc8o\xe1\x9bo\x01C\xd0k\xcb\xea\xd8{\xf4\xbe\x87#\x17*i;\n\xa9\xcb;z\x1c\x1d\xf4-\xa9\x83c\xd3\x11\xdc\x8d/\x12\xfaz\xd0\xb3O\xb5\n\x96\x8c\x8f\xf4\x18A\xaf \xb9`\xe9~\xe8X\xc3\xde\x1f\xfc\x1e\xe7u{\x7fX\x16\xe5\x04\x8b|o'E6*\xc9$G\x11\xd2\x14jK\xfb+\xe7\xaf5\x7fB1?\x03vM\xdb\x93\xc1\xa8\x1f\xe8N\x17a\xee,Dl\xdaT\xe0EjZ\x07\x99\xac\xc8\xf7?K\xc1\xa5\xd3\xfe\x1b\xd8\xfb\x06j\xe7\x110\xfa\xa7\xbf\x86\xc9\xb7\xb5\x84\xe5\x06\xca\xf0\xf9\xbaZ\xb3\xbd\x9d$s\xbf\x95j\xd8\xff\x1e\x9e\xfe\x02J{\xd2\x11\x87\xdc\x80\x93cX\xba\x98\xeb\xd7D\x99\x1b\x86=q\xf1\x84\xa0\x90\xe3\\\xfcp{9.\x87+F\x06\xcc'\xc3> J\xce\x95\xef|\x87\xdb?\xb6\xba\xe0\x9a\x8c\xed{W\xadw\x9c\xf9\xa4\x1b\xd0\x8d\xf3\xf7\x85\xf1\xcb]\xd9(SFI`\xf2\xf6\xe9.\xedyk\x92\xe1\xe9\x9d\nl\xb4Un3\xb2\x0ce\x92\xbe\x18/\xa1N\x9d\xd8\xef\xb2k\xda\x14\xdd\xd3\x85j\xf6QE\xacO!$7\x11\x07\x9d\xda/%\x98\xf10\x16TqJ.\xb0y\x96ib\xda\x8a\x84\xea{\x94b\x02Cr\x95oQ\x993\xdcR\xe5\x9a\x86\xacJN}Ic\xa4\xfa\xfeK\x17\xd6\x01\xbb\x8a,\xba\xe2s\xbb\x8a\xae\x8f\xde\xe7\x95\xf4\xf8\xfd\xca5_\xae\xee\x02\x05\x8e\x00;O\x0c\x8er\xf8\xcd\xd8\xfc\xd8\xa0\xcfg4\x1f\xb4M\xaf\x0c:\x1b\xb5I\xf1Ib\xf62\x06@O}\x8bSWh\xcc\x16\x8c\x93\xbe\x9b\xeb\x8al-\xd4\xd8\xe8\xa9\x07\\\x8c\xa0\xf9\x0e\xe0\x8b~0\xb4N\x9b2\xa3H\xa5\xc4\xa2\x07\x97\x0e8\xd3>\xab\x1dB\xc4\xd5j\xfa\xfa`\xc1 \xb8+^\x1f\xf4~M\x1ek\xc8\x8c\xf48\xa1\xd7\xce\xd4\xff#T\xe5\xea\xd8\xefM\\\x99<+x\xc1\x95\xad\xb4\xa1\\\xdc\x14\x0bK\x97\xb7\xe3\xdc\xa6\xb7\xf9\x05\x19\xaa\xb4\xb3l\xf8\x91\x1a\x9c^\x07'-O\xe7z\x85\x8f\x03\x03\xa9\xa6\xe4-\x93X\xc1\xff\xe6\x19If\xf1\xd2;\x92\x89t=\xe6\xcaW\xda\xfe_H\xff\x07\x8fHR1\xa0(T^\xb7\xec\xfb\xf3)\x96~\xa0\xe0&-\x82\xacL\xa3rd=\xb2\x98o{\xfa\x84\x8fC\xc0\xa0e\xf637\xa6\xd1cE\x8d\x1b\x17)\x80}\x86\xcc\xa7cy\xc9D\x10*OdC\xedb\xf6\xae#/6\xbe\xe3\xb7\x03\xa7\xf3\x8ek\x87\x96|\xe0\"G\xff\x80\xcaM\x96\x96\xdf\x87M)^\x8c\x0c\x89\xf1\xe7\x9b\x1fM\n/]@\x9f^\x89	\xe3q\xbeT.9\xefzoo9\x9e\x0d|>JU\xd3gZ\xceJO\xbc7\x88\xa3\x89\xf3@\xa5\n\x9f-LI=\x9f:\x8a\xa5e\xf3\x85\xce\xeeGZ^\x15\xcd\xda$\xf1\x85\xbe\xee\x94.\x05;\xf2\xf0\xc5.1H\x020y|\xc7qor\xfd\xf4\xd1\xf9{\x8e`\xfdI\xbf\xd3\x8aB\xdf#\xc95:\xbb\x83\x90\xbc\xea\xf8\x04\xed9\xcf\x9dG#?\x02\xe7-ZE\xa3\xb3\xf8M\x1a\xce\x8b\xc1$\xc4\xcb\xc4\xd7\xaf\xfe<\x7f\xfdj\xe6ZyJ\xd4\xbf^\xb5\xe9E\xc1\xcc\x92\xad\xbbd\x1c\xb3\xa4\xc4\x0c]\xe9\xf4\xe23\x01\xd2G\xe5f\xd0\x0c\xff\xd8I\xee\xf2\xf4\x170\xe8\xd7\x9dg\x95\x14\x11\xc1\xf9\xc8\xdf\xdd;\xfa\xd9\xb1\xc4\xf4\xde{\xcc.1\xa4\xcb\x95\xc2\xa5\xcc\xfa\xb2\xad\x00\x89*\xa6\x0b\xca\x048g\xcf\x9b\xc6\xafe'f\x11\x87\xeb\x96\xd9[\xf2\xf9\xd6av\xd4\xa2\x7f\xfaw[\xe9\xe4\x84g79\xb6\xa6\xd1\xa9~ \xcf\xba\x91\x89#\x9aZ\xee\xc3\x12KjZ\xc3\xe5Q\xbfEK\xb47\xa5m\xfd\xc6u\xc5\x95\x97\x049\x95\x9a\xfe\x07_\x85\x03Xn\xe1\xab\xf1\xbd\xc3\xca\x19\xe36\x1c\x0d=ap\xf6M\x7fJ\x1c\x9a\x96hS\xb9(\x0e\x96\xd8H\xbe\x92Ww\x9a\x7f\xec\xf7fVU\xce\xa7j\xd3\x81D4\xb9\xab\x9f\xa7\xf0vq\xc3\xbe\xf5\xab\xdc	\xca'\xd0\xe9\xe9\xeb\xe4\xf2ar\xfa\xe8\\\xb93\xae\xcf\"\xa4\x02\x10'\xdc\xa0e\x83\xe9\x9b\x9f3TJ\x08\x95\xcac\xa9D$\xee\n<\x15&\x12h\x13\xc1\x12E}\x8e\xd1\x0ecK~\xd1R\x15\xb2Djc(\xdez\xfc\xe2\xe6\xec\xed\x1cn\xae\xdf\xce\xe5Y\xa8\xf3\xc9\xae9\xd4\xa6\xe8\x02Mn/*\xdd\x90T\xc3\x81\xe3\xc0w\x87\x87\x8f\xdf\x1c\x1e\xce\x07\xb4\x04\xe7\xbe\xb5\xf9\xdd\xe1!\xfc\xf2X>\xcc\xca\xcb:\xab\xf6\xf3\x82\xb6\xb2\xbe\xe4\xc9\x8a\x17\x82\x93\xc7\x81\xb3\xaaF\xbb\xa6\xa2}3;\x11\xc8\xb4y#\xaf\xe4N\x86\xddld3\xdd\xaa\xcc\x0b\xcb\xbcc\xd9?\\\x9cU\x867\xb2\x12\xbe\xf9g\x86\x13\xfa\x04\xa5\xec\xfaf\x95\xb3+\xbd\xee|\xaa\xc4\x05\xd9Cr \x9c\x92-\xd2C\x8f	]\xf1\xf8c&is\xc1L\x86%'\x85Q6\xe4\x14s\xf52K\xf1{\x98R\x16\xfc%A\x1f\x88z\x02qD%A\xee\x1b\xf6\xf8\xa1i8S\x9d\xef[v\xb3\xd3\xfe\xb7`\x92}\x88\xee\x9c\xe5\x9f\x02\xef\x9fH0\xa2\x7fH\x911]u\xff>\xa9\xe7\xf9\x87\x93_\xc2\xfb\xf3\xf3\x84Hw\xfd\x0c\x7f\x9b~	T.\"g\x17\xa7\xfd\x88l\xd4Qt\xe4\xa2\xff\x9d0\xc6\xd0:M\xbfH?'\xd7\x043N\nO\nm2\xbaxPW\x95\xba\xc5\x10m\xd7\xcfl\x02\x9f\x19\xc0\x97\xf4\xff\xfb\xc3\xc3\xc7\xef\xff\x07\xfao\xdc\xdaI\x87|v\x99~\x15\xd0\xf4>\x87\x11\\	H\xd7.?\xe5OT;\xdd\xd5\xa1\xb1:\x1eWIqw'g\xe0|\x95.\xd8\xc3\xd6V\xa4\xc6'\xb7\x920HQ\xba\xec\x8c	u\xbeO(\xe2\xd4\xec}\xfe\x99\xe0\x1b{\xed\x86v\x948c%\x15\x83\xdbXi\xfc\x14])i\xb21\xd5\xcd\xa0cm\xe7\xd7\x83\x06\xde\xf0@\x14\xf0\xa0\xd0RO\\\x87W\xf1\xe2\x86in\xf3\x88\xfd\xfa\xc5\xcd\x94\"%M\xbbT\xb9\x11#\x94)\xfe\xde9g\xa2ne#\xb4I\x17\xbf)-Zn!t\xcb?R\x15\xe7\xf9\x05\xb3\xcf>X\x9a<d+\x0c\xe9o\x16X\xb5\x83\xc1PS\xd8\x87^Y<\xad;\x83\x9e\xe3\x87O5`\x8e\x1a\xb3@\xf18\xdf\xfd\xbf\x81\xd9\x82\xe2\xe4)\x00\xa3/\x93\x02\xf6\xe8\xac\x8f\xe9E\xfex\x94\xa1F\xae\xde\x99(\xfd*\xa0\xc7R1d\x04,u?%\x87\x7f\xc1q\x06?~l&HY\xf7\xe0(\x16e\xad\x97I\xd2\xa6\xccRt\xe9l\xcc\xb1\x9a\xb5g\x96\x9c\x9a\xa5*\xf5r#\xc6.\x8c\xe8\xc1\x13\x7f\xb0\xfaS'\x97q6=\xea\xc5\x8a\x8b\x88\x03y;\xc8q\xb3\xc8,bj\x95\xcd\x86\xe6\xd8,\xa6>\x93\x94\xd1\x19\xb2m\x13\x84\xff\x17Hgs\xa0\xfb ?\x04\x96\x12_\xf1\xbcI=\xe0c\x86\xbc~\xf5\xe7\xd7\x7f~\xfd\xdf\x01\x00\x00\xff\xffPK\x07\x088,\x08\xa1\x90\x12\x00\x00@4\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/ru_RU/common.jsonUT\x05\x00\x01\xc8r\x08f\x8cWQo\x1b\xb9\xf1\x7f\x96>\x05\xb1\x80\x91; \xc8\xbd\xe7\xcd\x8e\x95\x8b\x00'6$%\xc1\x1f\xff\xeb\x03\xad\xa5\xa5\xadWKu\x97\x1b\x9dq\x08 +8\xa7\xad\x83\xa4\xf5\x15\xe8\xa1\xc8%\x0d\xee\xd0>VV\xacD\xb6,\xf9+\x0c\xbfB?I1Cr\xb92\x8c\xf6\x9e\xb4\x1c\xfef8\x1c\xce\xfcf\xf4]\xb5\x12\xec\xc9\xb4\xc7Up\x97}W\xadT\x82]\x9e$\"\x0c\xee\xb2`c\xfd\xd1\xa3\xda&\xdb\xf8?vk-\xbb\xc5\xeeo7\xd8ZF?\xdf\x04k\xd97Ap\x1b\xf1\xa1\x88\x852\n\x9b\xb5\xadZ\xcbk\xac\xec7\x04\xcfdr\x03\x8a\x0czx\xd4\xe3\x1d\xb1\xe9m\xd6\x1f\xae\x7f]c7Z&h\xb3/\xa3X\xa4epsg\xbb\xbeUk\\\x87'b\xb0#3\x95\xd5\x93V7\x15\x9c\x14\xd6B\x96\x88\x01\xeb\xcbL}\x91}\xc9\xa2\x84)\xda\xbbcTP\x9e\xad'a\x1dO\xca\xb6{\x91\xb2^\xad\x85\x85\x0eOB\xb6\x162r\x06\xd7\xd2\xa2\xbc\x81\x9b\xf5Vqy\xda\x11!\xba\x87\xa8\x9d\xedf\x8b\xed<n|}-J\xe5\xb0\xa7\"\x8cR\xd1V\xf5\x1d\xa7Qk\xb0Fm\xb3\xde\xa8\xdd\xc3H\xb5\xb6-|5\x08N\xcd\x87\xa0\xf5\xa0Q[\xdf\xfc\x15\xaaY\x97\x87r\xb0\x11\xb9\xf4h>X\xdf\xdc~\xca6\xea\xbf&KLX\xb7d{\xbf%;\x9dX\x94\x8f^\xcbV\x0f\xca\x13\x9f\x83\x8f\x1f\xadf\xa1A<\x8b\xc4@\x84M\xde\x13\xfe\xfaM\xb6}\x9f\xb5\x1e\xd4Xs\xfda\x8d\xd5w\xd8\xd3Z\xa3\xc6\x9e\xd4kOK\xda\xd5\xca\xf3\xdb\xd5J\xd0\x8f\xf3\x94\xc7\x99K\xf9\x90\x1f\x04w\xd9\xffW+\x95J\x00g0\x85\x85~E\x07\xd1r\x01S8\x0f\xaa\x95\xcao\xe8\xec\xae\xcc\xd3\x02\xad_\xc2X\x1fZ\xacY\xc0\x12&\x1e\xdd\x8b\x92\\	o\xfd\x12f\xb0\xd0/\xf4\x08\xc6\xee\x84BT\xd2\x92\x89\xea\x96\x95\xa6\xfaP\xbf\xd1G^\xc5\n`Z>,\x13m\x99\x84\x85\x9e]\xde.-2\x0f>\x10\xdc\xdf\x03>\xc2\x12\xce\x9c\xf99L\xad7.^\x98\xc6.Z<\xecET\xc8\xf0'83\xde\x9bW\xe1\x1d\x89b=\x82%\\\xea\x17V\x98H\x07^\xc0\x12\x160\x83K\xb3\xd3\x96\x89Jy\x9b2\xfe\x9e\xfb^\xd91eG\xda\x1f`\x02S=4\x81\xd2\xaf\x18\xcc\xe03,\xe1T\x0fa\x0c\x9f\xf0\xc9`\xa6\xdf\x18u\xf1m\x9fS\x18\x02\xf8;\x8c\xe1\xf3\xaaj\x19S:\xe0\x06\xe4\x7f=\xa4\x1b\x85\xc2zv\xa1\x87\xfa\xd8\x9b\xb6\xc1\x99\x98\xd5oy\x12)\x99\x9aC\xdecd`\xa9\xff@q\xb8\xb0\x88<S\x8f\xe4\xa0\x08\xdd\\\xbf\x82\x0bX2\xfd\x12\x97\x06\x13\xcb\xf6\xbe)\x08\xfbe\xd2\x84g\xfb({\xc8\xb3}\xb6\x17\xc5\"\xe1=\xe1\xb7Z-\xdcl\x88~\xcc\xdb\x82\xf6oe\x0c!l\x10\xa9.\x8bT\xc6\xba<\xeb\xb2g<\xce\x9d\x9a\x0cE\xca\x0b\x7f\xdfbVPH\xc6\xe8\x8b\x1e\x1a\x94\x1c$\xc2\"N`\x0ec\xaa\x19L\x9a#\x06g\xb0\xd4\x87p\x013K\x1aB\xac\xc7\xb1\x0d\x14^}\xa4\x87\x98]\x18\xdd\x89>\x84\xa9\xe3\x16\x13\x00\x8a\xd0\x05=\xc5\xd8G43\x1co\xad\\\xc1\x12\xce\xe98\xeb\x8e\"Fi\xaa\xa8\xbd\x7f`\x8c\xe8!\x86\x17O\x82+\x98yCy\xe2\x03Y|\x9bj\x909\n\xbf\x80\x13}\xfc\xa5\xa3\x89\xec i\xdb\x12	\xd0u\xb8\x80\xb9~\xad_\xea?\xbb\x94\xa7r\x9f\xc2\x19\x16\x81\xc9\x0fw#}He\xfd\xbd\x1e\xda\xac\xc7\x1b\x1d\xf9\xfc\xb9a\x7fF\xdf\x13\x18\x17\xd61\xee\x87\xff\x03Y\xa5z\x0eT\xd4\x13\xaeB\xdb<\x16IX.\xef\x7f\xc1\xc2&d%\x80_\x885\xec\xe2-\x8cm\x1c+X\xa3W~\xf1\x16\xc6p\xee\x16?\xea\xd7\xd6+\xbb\x98{\x9d	|t\x8b\x0f\x18q\xb7x\x07\x17z\xe4\x16?\xc1\xd2^\xbd\x12\xc0_`\n\x17\x9e\x8a\x06B\xec{_O0\x7f\x1c\xf2=,</\x9d`\xf6\x14G\xe9\xa1\xdf\xf9\xa7\x1e\xf9+\xbd\xd7#\xef\xeb\x078]\xe5\xb2<*\x88\xac\xdf\x8f\x0flv\xae\xe37S\x92\xf18f\x86\xee\xc8\xb5]\xc9S3\x0c\xd1\x87\x95)%{\x94i?\xe1s\x18i\x9b'ma2\xfd\x9d\x1e!E\x13]\x14\xb9\xd7\xe6\x8a\xc7\xb2C\x80\xbfQ=\x8da\x0eK\x1b\xbb\xa0\x1dG\xd8\x1b\xef\x15V\xee\xa1\x00=\xb2\x86	e\x86\xa9\x0d\xe7\x14\xfc\x0cgdff*\xca\x16\x9f\xe3\xdeP&\x86\xa3~\xa0\xec\xc5\x8c\xb1|\x82d\xd0\x92r\x8b\xa7\x1dB\xdc\x8fb\xc1\x94\x94,&\x89\xc5$Q\xd65\xc5\x02\x7f\x85\xb1!G\xfd{\xba\x98\xb5\xd3\x91\x12KO&\x1d\x1b\x0e\xe4\xc9Kt\x07\xa6\x96\xc0\xe0T\x1f3\x98\x11)\x8c\x8d\x9bW\xd8\xbe`\xa1_\x1b#Q\xaf/SuO\xa6i\xde\xa7n\x00?\xc2%\x96\xb9\x1e\xea\x11\xc3\xc2b\xfa\x85\xb9(v\xbd;\x0c~\xc1\xd4\x849#.\x98P\x9d\x7f\x823,\xcb;e\x93\x9b\xee\xfe+\xf6>\xfb\xab\x90\x06\x83\x0f\xc4Jcz\xaf#\x1838\xa5\xf3\xa6\x88\xbe\"\xfa\x9b\x92\xd6G=\xd4/l#\x18\xbb\x93\xfa\x0fx\x86\xbd:\xa8\xef\x10\x9d\x1aq\xcc\xcd(\x87\x84\xa6\x0f\x91\xadh\x92\x98\xd9\x8aB\x1a\xe7*2\xadq\xcb}\xbb\x9d\xfd\xd2tF\xdcf\xa7R\x86{\x1e%\xc2\x96\xdc\xf0\xb9\x88O\xb4\xc2y\x0c&\x86/\\>\xf4x\xba\xdf\x14\"1=#\xddg<c\x19\xae\xcd\xae\x88B\xf9$\xca\"\x99\xb8A\xf4!\x8a\xd83\x92\x95@F`\xda\xcb\x93H\x0c|\xd1\xb0\xbdT\xf6\x98\xea\n\x96a\x9f\xa9\xef\xe0\x11\xb4\x14\xb1h+a\xc6_k*\xcf\xd4C\xae\xda]\x1b\xa71\x91\xda\x1cf\x94\xc70\x87O\xb0\xd0\xc7\xccLTpEm\xa6\xd4\x14\x121\xf0aBz\x81\x89>\x86sf:\x8c\xa5\x86\xa0/\xa3DaY\xf9\xe2{o_\xf4\\\x8f\xf0\xac\x0bf\x12\xd3\x95\xa3\x0b\x16\xdd\xa74 \xd0C\xea\x91>\xfe\x8a\xcaw\x88\xda\xb0\xc0N\xf7U\xab\xb5eup\x8c\xf7\xfft\xcc\x17\xdb\x93)\xa3\x1d\x03\xfa].\xed\xbf\x018A~gH\xba\xb0\xa4\xe60*Q\xfb\xdc\xf5\xd0\xf4\x9aA'5\x93\xfc\xc6\x81\x0fC\xc3\xca\xe8Av\x0fl\xda\xac\xe2\xf1mM/-\xd0}#Z\x81\xb9\xe1\xc1\xac2\xa6\x90\x18\x14\xf2\x11=g_\xb4\xa3\xbdH\x84,Oc\xa7\xb8\x97\nS	\xf0\x0eNi\xda\x9b\x94	0\x15\xfd\xf8\xa0\xe0G\xac\xc0\xd1\xb5m\x99\x165\x03\x9f\xec{L\xcc\x9bc\xe1;\x9c\xca\xd3\xa4\xa0\x1b\xacK\xfb\xd6i\x1e\xfb\xb7*O\x11&\xc2X\xf53\x1cW\x0c:\x13<-roi\x08\xca\xedd\x98\xdf\xb5o\xfb\x91\xfd_\x89}\xcd4b\xfd\x86\xb8\x0c\xf9\x0d\xa7\x81\xc2XW\x0e\x1eI\x15\xb5\x85\xbb\xbf\xfe\xa3~\x03\x13*\xfc\xd2T\x90)\xaer\xf2\xb1i\xbe\x8c4\xdf\xedE\xaa\x08M\xe1\xac\x0f\x8e\xf2\x84PzR\xd5\xcd{\xbb	\x8f\xe2\xc8\xf2\xef\x0ft\x9a\x99\xd7\x8e\x8c\xb3W\x94\xeb\x13\xfdJ\xbf\xfe\xf7\xf0\x1fVO\xf6m\xfa!\x0b~o\x84y\xe2\x08\xdfU?\xfc\xcc(\xfc\x87\x8cnO\x81\\X*,\x11(V)\x9c\x13\x17#\xcaY\x1b\xb8\xd2~l?\xcbr\x9f\xb2vw%U\xf3~,y\x88=\x89\x10\xb4\xa2\xf9\xb5\xbc\xbd\x93\xcaN*2\xf3\xe0\x9e\x9d?\xe3\xc3\x177\xbdv\xd8\xd3\xf2Q\xd5\xca\xf3\xea\xf3\xea\x7f\x02\x00\x00\xff\xffPK\x07\x08\xc4\xb1\xe3\x96\\\x07\x00\x00\x01\x11\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/ru_RU/server.jsonUT\x05\x00\x01\xc8r\x08f\xcc\\_\x8f\x1b\xc7\x91\x7f\x96>Ec\x0f\x81\x13\x80\xd9]\xc7~\x08\xfc\x10@\xd2J\xb2\x12K\xda\xec\xae#\x1c\x82\x04h\xce4\xc9\x89\x86\xd3\xf4\xccp\xff80\xb0\x7fdI\xbeU,[1`\xc3\xb1\xa5\xd3\xd9\xc0=\x1c\x0e\xc7\xa5\x96^\x8aKr\x01\xdf\x17\xe8\xfe\n\xf7I\x0eU\xd5=\xd3C\x0e)-.\x87\xbb'-\xfbOuuwu\xd5\xaf\xfe\x8c\xfe|\xf1\xc2BM\xc6\xcdd\xe1\x1d\xf6\xe7\x8b\x17.,\\\xbb\xf4\xdb\x85w\xd8\xef/^0\x7fW\xf0/\xf5/\xaa\xa7\x06zO\xef3h\xbcx\xe1\xc2\x1f\xa0c\xe1\xd7\xabW\xafo4\xda\xcdj\xc4\x830\xc9&B3K\xf3v\"\xd2\x14\xf5\xb6\xc7Y;\x11	\xbb#\xaa\xab\xce\x08V\xdda\xbe\xa8\xf1v\x98.\xb2\xab\x11\xaf\x86\x82\xa5\x8d aA\x8d\xed\xc86\xdb\xe2Q\xcaR\xc9\x92v\xab%\xe3\x94\xc9\xd0\x171\xab\xc6r+\x11qRa\xeb\xbc\xc6\xe3\x80\xc9\x98]j\xb5B\xc1|\xb1\x19x\"a<eiC0\xb1\xdd\x12Q\"\x98\xac\xb1H\xe6\xcb\xb24\xe6Q\xd2\xe2\xb1\x88\xbc\x9d\xc5|W\xb7\xd6\xaf\xdd\xc9\xf6rK\xa6@^\xb0k2fwd|\xd7\xec\xe6\xb2\xe4\xb1\xcfx\x18\xca\xad\x845y*\xe2\x80\x87,m\xf0\x94\x05	\x8bd\xca\x12\x98\x95JV\x15l3\x10[\xc2gA\xc48\xdb\x92\xf1]&\xa2\xcd \x96QSDi\xbe.\x0f\xb7\xf8N\xf2\x9e\xf4\xeef\xab\xab/UG\x0d\xf4\xae\xea\xa93\xd5\xd7\xfb\xfa\x11S]5R}u\xa2\x0f\xec\xe5<\xd1{\xaa\xa7^\xa8c\xd5a\xeaL\xef\xaa\xb1\x1a\xaa\x8e\xde\xd7\x87\xaa\x8b\xff>bj\xc0\xec,\x06\xd7\xa8wU\x07\x1a\xf4}}\xc0`\xa2\xfaA\xf5\x98\xea\xe9=u\xaa\xfa\xb0\xc6@\x9d\xaa\x8e:V\x03 :R=\xe4c_\xf5q\xf9\x8e\xc3t$\xa3\x9df\x90\x88\x9c\xe7\xcf\xd4H\x8dq\xb9!,\xa9:\xfa\xbe\xea\xeb\xc7\x96\xdd\xa7z_\x8d\xd5\x11\xb2\xf0\x83\xe5\xaf\x0b{\xd0\x1f3u\xa6\xc6\xc0\xa0\xea\xe1>\xba\x0c\xf9\xb4\xd4:@\xd1Y\xbb\xed\x07\xf2w2l7\xf3\xd5/A\x1b\xdb\xa4FZ\x91F\xc0\xed\xe3\x04\xb8\x86f;	<\xc6#\x9fm\x06\xbe\x90\xac\x15\xf2\x1d\x11'.\xe9T\xd6\x83ZqS\xb8!\xe2\x0b\xd9\x1a\xe9C\xd5c\xd7o\\\xfb9\x1e{Ou\xf5#\xfd\xa9\xdd\xe79fd\xcbV\xeb7\xdbi\xbe\x19\xf8\xc1.s\xefn=\x96\xed\xc8g\xbf\x03f\x0d}\xec\x03\xe9\xae\xe6\xfd\xb8\x19\x97\x1aM\xb0\xe4fP\xba\xe47\x83\xe8\xe7IKxA-\x10>k\x8a\xa6HX+h	\x9f%i\xcc\x83z#eA\x94Jx\x8b1k\xca(He\xec,\x03O\xe1\xeav+\x88w\xf2\xf3z\x82\xdb\x1b\xea\xc7L\xfd\x80R0\x02\xb9:\x86\xdbU\x03\xd5\xb7\x87\xf4\xaf\xaa\x8f27\x86>\x10\xb3\x97L\x1d\xa9\x9e:\xc9%\x01\xa5\xe0\x18FtT\x17~\xeb\x03\x94\xd8S\xd5C\x11~\xec\x92-2u\x8b;\x92\xa1\xbe\"v\xa6x0\xed#\xbc\xa51p\x90\x0d\xa905Tcx\x1cz\x9f\xe9=5V\xc7(\x99\x99\xdc\x02_o.3x,\xf8:F4\x0f$\xf9H\x1f\xa8\x01\xf0\xdeg\xf8\x00\xee\xe9]\x06?A*~\xc0\xbd\x0e\x19\x1e\xd2\x0b8\x03|\x93\xbd	\xfe7\x824\x14Ee\xf0B\x8d\xe1\xb8\x90\xd3A\xc9fJ\xc6\xe0\xc6\xf4\xe1\xe4\xc6F\xaa\x83B\x082\xda\xd3\xbb\xec\xc7\x7f\xcbD\xb6\x07L\xe3{\x1b\xa9\x17\xaa\xf3\xe3i\x85~\x0d\xf4\x1e\xf4\xeb\x035do./3\xfa\x89\xeb\xe0z.\xfb~.\x0c\x97\xe1\x07\xf1\xb7!\xb6S\x06\x9d\xf0\x1cAz[2qT\xa0\xc7[\xa9\xd7\xe0\xf9\x96\xbfF&\x1f\xa8\x8e\xb3?\x94\x8a\x8e\xea\xaaS\xfd\x98n\xe1\x0c\x18\xd0\x8f\xd4	=4P\x1f(\x1e/A\x93u\xf1\xd6\x8c\xf2\x1c\x10=\xd2z\xa7t\xed=5Pc\x14\xac]}\x087w\x0cSq\x95\xae\xea\xab\x97%gE?&\xaf\x0f\x15\x1d\x9c\\\x9fd\xe5\x04\xe5t\x84mt\xbf\xd00\xb5\xdb\x0d^\xcfm\xe7\x15jc)4\x9a3\xe3\xf5\x04\xad\x9fl\xc7\x9e`5\x1933\x93%2l\xa7\x81\x8c\x92Ev;\x120	\xacO\xcc#_6\xc3\x1d\xe65d\"\"\x9c!\xb8\xd7`u\x11\x89\x98\xa7\xc2\xb7\x04\x16\xd9\x9a\xf8\xa0\x1d\xc4\xc2\x87\x05<\x19\xa5\x1c\x8cT\xcaB\xc1\x93\x94\xbd\xc5\xfc\xa0V\x13\xb1\x00\xf3\xcb\xeb\x89c$\xbd\x06\x8f\xd7=\x19\xe7\xe2y\xa5\xc1c\xee\xa5\"fI\x8b7Y\x82\x9dF\xc3Di\x80\x8d[\x02\x95\x89\xac\xb1\xa6\xf4\x83\xdaN\x10\xd5\x19g^6\x13-$\x88\xc4\"\xbbT\x83\x06\xb1\xed	\xe1\xc30\x10\x960h\x06d\xd2\xdb\x89\x88\xd9V\x10\x86,\x12\xc4|\"\xc3M\x01\xc4\xcc\xce\x1cV\xdbI*\x9bW\xd6\xd73V3Sd\xb40\\\xf8\xa9~\x8c\n\x0b\x14\xf4L\x81\x1a\xb2+\xeb\xeb\xa4\xbb;(\x1c\xa7\xaaSa\xb9h\xa8\xd1$\x95.\xc8\x0b\x08\x15\xbc\xc0#cwG\xa8f\x90\xeaP\x1f\x96p\xba!\xebu\xf7\xe5?+\xe3G?\xa2\xb7\xac^\x02W\xb9\xd9\xb1v:3<s\x1e\xc9\x04\x8d\x8c\x13\x83\xc6\xdcCC\x108D\x8c\xa1\xc6\x0ct\x00\x12|`\x90Df\xf7\xbe\xd2{\xf9Z\xfa\x80&\xc1K+\x9ff\x8e\xc1}\x17f\xf1\xf7xT\xcfW\xff\x0fu\xa2\x0fA\x9d\x9d{uR}\xb3V\x7fLt\xdd\xd5\x936\x8f\xbdF\xb0\x99_\xc0\x8a\xd3F\x0b9\xa3\x16e\\\xa7\xe3\x05[f8\x04M\xa3w\xc9&\xa0\xd6\x19:+\x04	@\xdc5Y\x95i\xfe\xf2\xd5\xe7\xa8D\x07\x13\xf7\x86?\x8eHA\xa9\xae\xab\xe6Qva\x05\xba_\x1a\xd3QCT\xde\x19;F\xf9\x0f]B8\xe8\x98\xec\xab>Pg\xcc5%\xbd)F\xdfOD\x8cx7q\x85\x81\xcex@\x86\xf9\x18\xb1\xdeh\xca\\\x92\n\xec:\xe6r\xda\\M\xec\x03\xa1\xa0\x1aV\x18\x02\xdf1\x99\xa3l\x05xoe\x94\xad\x91\xeb\x95\xa2\x01_F\x13v\x94\xde\xe5C\xbb\xa8\x01\x1b.[\x034\x07\xd8\x0bgf\x7fZa\xc2\xe1\xd9\x02\xc2\x17Q\xb6\xc0U_Dl\x8d\xfb\x81EXy\x03\x8b\xe4\x16\xa2MPiU\x1eE\xc2\x01R\xa8\x1c\xab<\x0c3J\xff\xf0K\xf8I\x0c\x00\xc7\xfb\xfa\xd0\xb2\xf8\x1c=\x82=2\xf1Y\xbfEKh\xda\x06\xe4	\xa8\x91:\xd6\x87\x8ch\xbd&\xa2Y\x9e\xa2\xd9g\xbfX.1\xf9\xf6\xbe^\xa8\xb1\xb3\x91&\x0f\xc2\xabq\x9c\x9f\x084\xb0\xabq,\xe3\xcc\xaa\xa1\x8ac8\x14\x0eC`\xe7\xe24\x91\x9b<\x08\x8b\x84\xec\xa9\xc2\x0c\"P2m\x95'\xb9\xb8\xc2\x8f-\x19\xfb\xd33Y\xcbt\x95\x91\x90q:\xb1\x85D\xc4\x9b\"f\xe0\x85\x96\xd0rzK\xc8\xad\xb7\xab\xa5\xd4f\x13J\xdaU_6y\x10\xb9\xd4\xb6\x1b\x02\xcctNj\xfb]j 2\xb6\xff\xdcZIl\xb7x\xe4_r\xa4O\xfd\xb3\xea\xa8\x13z*j\xa4\x0f\x9c\xf7\xc9\x10\xd3\xe7n\x9c\x81\xe2V8\xcf=\x91\xfd\x14\x80\x18\xc0\xb5S\xf8\x89\xd2	\x8f\x9d\xdd\x11\xd5\x9b\x15\xb6\xbar\xad\xc2n\xae\xbe\x95A\xab#\x03\xcfz\x99Se\xdf\xa4>\xfcYaO\xee\x1d\xaa\x7fG\x08{\x86Po\xbf\xa8h-\xebs\x86 G\x06\xeb\x8d\xd5KP1\x80\xe8\xf5=\xd5Q/\xd5i\xbelM\x08\x1f\xdc2\x92\xd6l\xf5\xa7\xb4k\xbd\x8ft\x1e\xc3N\xbah\x7f\xb2\xc5\x9f\xa9\xb1~\x00\n\xba\xc2\x8c\xc6\xcf\xfc\xe4\xdc\x8e\xc2\x9b#Ta\xbc\x08udPE\xaf\xe8C\xd4\xc2\x02\xb2\x94\xed(\x8dw\x18\xb5\xd2\x82+A\x02\xca\x08!\x97\x88\x99\xe7\x0ea2\xc2v\xc7+\xae\xc9\xd8\x13\xfe\xa5H\xe6\xbaN}\x8f'\xb5Wts\x89Q\xd7u\x1f\x99\xdb\xc9\xb6\xfa\x14t\xaa:\xd5\x9f\xea\x07V	\x1b\x08\x85*~\x1f!\xd5\x19\xea0\xd0^\xc6\xa6\xc1\xe9\xe8\x03\x06\xf8\xdd\xf1\x0fs\x0e\xeb<\x0cE\xbcsS\xfa\xc2\x15\xe3\x1e:\xa1C\x06\xde\x0c\x009D}}\xe7\xd4\xf11\x9c8\x11\x13$\x9c\x19\xb8L\xb6\x98\xde\x9b!\xc1\xc5\xd0D=\x94U\x1e\xae\x94\x1a\xfa\xd5Xl\x02\xa2\xf6b\xbe\x15\x8a8a48\xb4>\x92\xed\xe7\xedT6\x11\xado\x89j\x12\xa4\"\x9bQaI\xdbk0\x9e\xb0D\x00\x08a\"\xaa\x07\x91`A\xe4\x8bm\xec\xaf\xc5\xb2\xc9\xb8\xe7\x89$!\xf0\x1c$\x96\x8a\xa3K\xeaR\xba0\xf3:\xfd\xac8?\xce\xa5A\x1a\x81\xef\x9aA\xf5\xdc\x1aM5~\x87-\xe7\x17\xff\x80<&s\xed\xf8\xda\xac%\x83\x85\xec\x9c\xa2Mi\x04\xbe\xb8\x1cD\x91\xf0\xb3\x05\xde\x0d|\xc1VD(\xe0\x8cVQT+y\x07\x18N\x94_\x8a\xca5\xf8\xa6`U!\"\xe6\xd3\x8c\"\xe9B\xd0\x0f\xe7C\x0b\xbc\x81%\x1e\x86K.at\xcd\x13p\x88\xea\xc2\x87K\xc8\xa2}\xe0ha|\x0f\x8f\x1f\xfc\x14\x9c\xcc\x9a\"\xe58\xab\xb8\xe6\x9a\xf0\xdaq\x12l\x8a0\xf7\x95\xb36\xe4\x9d5\x02p~\xe6l+\x16\xad0 \x0f\x883\xba\x00\x9a\x19;\xc4\x0b\xabb\xc06)\xb9%\xc4A\xd3q\xac\xd2x\xdd\x00\x9f\xf6\x99\x1a\xe8\x03\xf6\xfb\xfc\x01Q\xf7\x1fHu\xa3\xaf\x8c\xb1<V\x1e\xedj\xc88\xf8\x10|\xce\xf0\x96\xdcZ%P\x94\xdfA\xd6Y\x82\x99&\xf5W\xc9\x08\x16D!\xbc\x89\x98\xa7\x0d\x11\xc3aE,I\xb9w\xb7p\xf3\xd9\" >\xee\xf2\xea\xaf\xa0\xd7\xccS\x1f!f\x06m0*\xe0?|\x04/\xecA}k\x1ai\xe4\x0b\nM\xc1\x9by\xeb\x97^\x83G\xf9\xaaA.\xc2\xeao(\xe7}52T\x9cQM^\x17\xefJ\x00\x08\xaeAv^H\x7f\x869\xb5\xee\x0e\x86-\xba\xc6\xbf-xI\x0e\x9d\xdc\xb8\x95\x1bf\x13\xea\x98\xa4\xe5*;\xe4tM\xca\xf4\xf6\xa6\x88\xe3\xc0U\xbd\xdf\x1a\x11@\x10j4\x07\x9d\xa1\xfe\x98\x8ek\xc6\x0e,\xa7_ \x94\xc5\xbe\x13\x14\xa8Or\xcefl\x1de\x0f\xac'\xb9\xf5\x8fPT\x87\xd9\x82\xaa\x87`c\"\xa0C\x90\xf9\xca\xca\xad\x9fQ\xcc\xa8G1#\x8c\x8a\x83\"2\xfe\xb3\x15\x80\x8e:F\xafe\xaf4\x90\xd6H\xd3V\xf2\xce\xd2\x12\x1eL\xb2H\x99\x0f\x00d?\x9eN\x9cZ1\x84r\x03\x9a^+|\xd2\"qe<bH\xe7\xef\x19/	\x9aE\xd0\xf4\x95\x1aZ<do\xc5i*\x82\xa2\x1e\xd8\x89\xbe:\xc9@\x91\xeb\xd7\xd3\x8b\xbc\x16\xa4s\x04z\x12A\x8e\x111=\xc0\x80\xc6|9\xed\x1a\xa4i\x02sc{\x81Y\x90c\x9c\xdd#\x89\xa4\xc3\xd8\x07~\x0e\xcdo\xfcv\xe5\xb2\xe1\x02\xda\xcf\x8d\xa3\xff\xc41>\xeeh\xd8g\xe8\x87\x8d\xf5'(\xa1\x8e\xf7\xfb\x99\x1a \xa5\x03\xd00\x00r\xce&F\x82\x83\xf5St\xd8^\xc0\xde\x8a\xa8\xc4\xc6\xc1\x9d(\xe8\x14\x00\x0e\x83\xe8\xae\xc3\xc9\x13\x04\x89\x0f\x91z\x8f\xe9=\xbd\xa7\x0f\xd5\xa9\xcb\xd2\x17\x18$\xc0\xe0\x16P\xadd\xab\xe4 \xed\xd4F>\x8fmR\xa8\x08\x92\xbb3\x17axbg\xaa\xc3~\xf5\xab_-\xbd\x0dZq)\xe7\xb5\xc9\x13O:\xb2\xf1\x0d\xc9\x06<\xe5\xb9\xb6h\x98\x8fs/\x95\xc8\xe1\x93\xcah\xe6\xf2[*B3)\xa5m\xe7\x95\xaa'\xea\x04vL\x89\x0b\xdc\xa2\xe3F\xf7\xf1\xbaz\xf9J#}\x0f\xa5x\xf8z\x01\xb9\\\xe3\x9e!\xc5.*/\x0c6\x9eX\x8d4\x86\xdf.\x0f\xc32\x1e\xb0\xb1\xa3^Nnf\xfb]T#\x85\x83\xb6\xa1}k\xe6\x8c\xa7\xa1\x0f\x81.\x10x\x85\xc3\xf7z$NTG\xbd\xd0\xbb\xfa sh\xcc\x9b,\xa7\xed\xf2\xbc\x1e|(\xe6rLF%\xb7\x13\xbb\xe7\xe7\xb8\x84\xc4\xf98F\xdfl\x88\x06\xa3\x83\xaf\x08\xcf^\x7f\\\xd8\xc9\x9d\xc0O\x1b\xaf>|\x8cM\xedR\xc8\xeb\xfc[)!q\xbe\xad8,\x8bz{\xe3w\x19\xc37\xe9'-\x0d\xa0\xcd\xa4\x1b\x08\x16\xdb4\xa2G\xb9H@a\x0c \x0c:\x99\x98d\x9d$\xbc\xde\x90q\xea\xb5\xd3\xff\x8d\x05\xa4/b^\xd4\xc5\xdfd\xcf\xa4cS>3\xb5\xf1pr\xec\xb4>\x9e\xa5\x82+\xd6)\xb7Z\x93^\xf01\xc6\xf0\x1e\xb1\x1b+\xb9\x1d2\x99nGiG\xd3i\xcb\xb9\n\xb0\x8f	L\xf0\x89M\x14\xd4M\xa4\x17\xdc\xe3Hle\xa10\x07\xa3e\xf9\xc130k\xa4\x96\xa6\xf0(\xcc\x95I:c^!|\xfa\xd4\x89\x97>*$ \xa7\x02\xa7\x91L\x03\xcf\xd9\xedSu\xa4\xffI?\xc6@\x10!\xbaL\x95~m.b\x80J\x17\x93\xadV\xb3R\xe6mlR+F#\xc39}b\x89\xcc\x08\xa8\x98\xc1&\x9e2]\x1dqX\xe4\xb4\x16x<\x0d\xdc\xf0\xc7w\x06+\x82\xdd>\x9d|\x98\x94\xb69\xd0\x0flP\xe1\xa0lt\x96M\xbc\x8f\xfa\xdd\x0d\xa4\xf4\xf5c\xb8K\xb2\xb9\xc6 \xf4\xd41EaG\xfac\x1c:\xca\xab,TO\xbd\xcc9\x96\xa1?}\xdb\xcfQ%\xed\xbe\xfa\xbe\xa5\x01\xf3WJ\xb2\x94\x16\xe83\x9b\xae\xdc\xc8cJ7y\xab\x05\x00T\xd62OYb\xf6\x10\xfem'\x02<f\x80\xa46w	>6\xfe\xce\n\x10p\x9a\x03H\xe5\x16\xba|\x8e\xfd\xa5\xda\x14\xcc\xc9\xe9\xfb3\xdfo7\x1f\xa7\x1f\xe9\xfb\xe0\x80:\xd9\x01\xf6S\x13p\xc2Xc1\x1b\x82\x16\x96\xf2\x10L\xdf\x03\x8aj@U,Y\xe6\xc4d\xd1\x8f\xd1+@\xb5I\xd9\xe5\x019\x1a\xfbp\xafG\xc0\x05\x02Q5r\xdewk\xeaR\x9e\xcd\xb9\x07\x80\xfaWb\x81rWt\x17\xe092\xcft\xbd\x96\xdb@\x831\xe9\x1a\x89\xad\xbf{\xa6\x15\xe8\xdd@\x90\x7f\x15\x83\xc9\xf3\x1c\xd7\x0c\x17\x9a\x1a\x85\x8e\xa3=\xe7\xb9\xa9\x05<91/\x03\xe7\xa7\x80\x9e\xf5A\x85\x91\xf1\xd3\x0f(@L\xcf\xc7\x86%P5\xeb=\xcc\x940,\xd6\xc0\x08\x86Q\"C'\xc6\xa8\x0f\x9c=\xc6\xedHLf\xc3>\xa3\xca\x145\xceb[X7E\x822V\x03\xbb\xa1\xef\x8a\xe6\"\x97E\xda\xc8T\x1dB\x07\xc14z\xf2=\x14'*\x95)\xd5\xed\xc8\xd8F#\x16\xfc\xd5\x9ce\xd5\x08NJ\xb1\xc8\x9b\x1d\x81\xef\xe8\x7f\xca\xd9N;ch=l\xa7L`\x89\x9fM|\xac\xc8\xe8\x8d\x94\xf1\xe4n>#\xc6\xe4\x99\x9d\xb3\xb6\xc4\x97\xf2d\x9a\xf957\x93F\x88\xe1\x16o\x8awe\xdbQ\x1dk\x84$\xc0\xc0\xb2\x06\xf6T\x9c\xf6p\x87\xc9\xc8\x13Ll\x8ax\x87\xbd\xf9\x0b\xc6\x93$\xa8G\xf0X`\x02\x86\xd8L\xa4\x1c\x0b\x15R<lg\xd5\xea\x86\xd8\xce\x0d\xe4\x9a\xf0\x97.\x87m\xc1\xb0\xd5\xec5\xa0\x80U,|,?\xabB\x7f*\xb6S\x16\xd4XM\xc6M\x9eb\xc07H\x1b\xec\x8d?\xc6o0\x19\xb37\xfeX}\xc3YDp\xffv\xe4D\x0c\x0b\xa9X\xcc\x1eL\xd8\xcd\x92\x1c+\\\xa6-b\x18\xcc\x0c\xa6\xc7\xa2\x1e\xc0n\xf3\xa5\xbeD=\xe5\xd6\xbc\x94\xe5P\\\n!O\x83M\xb1\x11\xb8\x88\xe6)f@\xc6\x984\xd8wb4=P\xba\xa6l\xcc\x85\x12\xaf\x1a[`\xbf4 \xf3&C\x1b\xbcG}\xf0\xc8\x8f\xddPL,Z\x82\xa7E\x87\xbdk _\x7f\xc6\xc6Z\xe1\xceZ\xc1\x8d\xfa=\xf2\x8a\x19\xd3?`P\xdb\x9co'G\x03%\xfa\xa7\x18Ju\x92\xae\x1d|w\x86\x88>\xd0\x9f\x9a-X%8\x03\xa5\xc4R\xa6\xef\xaf\xbd\x97\xef\xe5k\xdc\xc5\x08\x8c\x1fV\x9d@gef\x17\x95\x11\xec\xc2\xbb\xafPm\x14 \xa6\x8f\x8d\x11\x1b\xce\xa9\x9a\xca|ML\n\xe7\xe5\x07\x9dWGL\xe2v(\xdcp\x89v\xaak\xb2\xc3s\x1b\x8b&\xb8\x04\xd7QF\xb0k!|\xc7	\x03b\xa8z\xe4\xa6\xc9\x127B\xa0\x9e\x13\x15\xbb\xee\xb7\xa8\xde\xb0\xf2\xf4,K\x0d\x91jt)\x84\x8e\xf4|M\xa9+Ja f\xbd\xa7\xfa`\x82\xe8<(\xf1\xe7\xdaz\xf5\x9c~f\xa7\xfb\x02\x9f09\x1e\xf7\xf3\xf2\xb1,\x1ff3\xf2\x98W#\x18\x8b\xf1\xa2\n\xc5e(\xef\xdf\xc3\xbc?\xd5\xdfX=M\xad=\xd5co\x97\xa6\xf2\x91\x03\x84\x96\x07\xee\xee\xda\x9e\x88x\xae\x8f\xd7\xa1\xe1\xd6\xa5\xdb\x86}\xdb\xbf\xe8\xc9\xe6\xb9\"e\x89H\x92@F\xb3KG\xf5\x01E\xea(y`\x111\xc3Z\xe1=d\x7fn!\xa9[4J%\xd1\xce=\x90\xf3k\xbc\xfdS\x1b\x85\xa78\xa3\x13`\x06\x02\x0e\xact#)I\x837\x83\xa2\xa5Z\xcf\x9b*\x13\x0ds\xedU\xd2\x92AX\xc0\xb8\xcf\xf1 _R\xb2\x12\xf3\x9f\xf3\xa2\xe4\xdf\x12\xa0\xda\xcf\x03w\xcc\xd4A9\x14\xe6\x04\x12rFR^\xabM\x94\x9d~\x83\xfah@\x19\x9dI\x8f\xb83\xd73\x9d\x1a\x9fU\x9b\x99\x8b\xdd\x87+fFc\x90;\x9c3\x03vq\x8e\xa9\xdb\xb7\x1f%\xcc1ty\xd0\xe3\x04\x15l\x16\x96.\x18\xba\xb4!\\\xfbd\n\xdd,\xd9'\xb6r\xcf&\x86{\x08\x0e\xcb\x82k\x84\x08H\xa0o\xf2m\xf7\x0c\xa7C4\x93F,\xaf\x93v\x8aE\xe7\x88\xb7\xa9\x93.\xf7\xf9\x0b\xdeb\xa1`\x9a\x9c\x9a\x92r\xd4\x02\xf3A\xe42O/\xe3\xff1\xeb\xe7/\x94&\xe3\x87\x95]\x946:\x997)_	\xcb\x96\xd6\xe9\xbd\xcex\xae\xaf\x08\xd7=\x99\xa8{(\xbe\xd4\xcc\x088EgsR\x17i\xcc=\xd1\x94\xf9\xe6\xb1a\x11Z*\xc5\xdf\xd3\xaa\xb9cn\xb5\xf7s\xf2\xb2P\xf1?D\xe7\xd5Q\xff\xe0\x00^\xbe^\x04G\xf3\xeaH\xf5=0Gv\xb3\x85z\xccWG\xbf\xa7\xc8L\xb0Q\x1e\xce\xff\x1e\x0d ]\xdb\x8c\x94\xa8\xa3V\xb6x\xea5\x8c\xa3t;Z\x03$\x97Q\xbc\x03}\x06\xd8c\x05\x0c\x02=\xebPSAF\xe0\xf10\xdca\xdc\xf7\xcd@\xf0\x0c\x90\xa8\xf0\xa7\xa7\xe6\xcb\x8aj\xf3\x95\xe9\xdc;\xa2z\xd3\xdc\xce\xf9\x92\xb70\xf2H\x0d\xc9\x99,\x9f\x8f\xe8\xe05\xf3\xc7hJ\x06\xf4U\x90\x0dq\xa0\xbbwd\x83z\xddL\x8aa\x8eS\xa8\xb1%\xe3\xbb3\xebo\x8eP\x82\xf7r\xedP\xa8>\x98\x11T\xef\x9f_Z,\x13\x93\x85\xd4\xff\x07\xac\xec\xf0\xc8\x17\xb9)\xf8G\xfaYq~P\n\xd7\xd4\xf2\xe0\xc4\x8b\x17>\xaa\\\xbc\xb0 [X\xdco?\xce\x8b\xa8\x8au\x01\xb1!Y\xbd\x85\xc4\x8b\x05\xd6\xdc, c\xc7\xa8*F\xce#c\xfa/\xf8\xb6;\x08\xea\xe8pL\x06b\xf6\x94<q\x00\xef\x06yId\x9c\xc2\x89Z\x8cR\xf8\xc4'\x03\xc8\xb4\x80\xdbuf\x92T=\xa3\xfdQ\x919n\xce\xd4\x8c\xe2\xa7\x13Fm\x16\xccH\xa12u\xaa{\xc2\xc4\xc3%,\xa4\xbc\x9a\xf3\xfdT\xef\x19m1\xca2u\x14\x17\xc58\xab\xf9\xfd,W\x96\xb3\xa1W\x9e\xa7\x06\xdd\xfd\xa0\xa0\xe9M	\x89~\x8c\xd9\xeb\x1e3\x9f\xd4\x01>{\x88\x16\x858\x13\xcdV\xc8S\x91]r\x95G\xab\x05\x87\xe4	`7\n\xb0\xf4\xc9JQf\xa1\xa7F\x16\xd5-\xfd$Y*OW\x0c\xd9O\x12\xa3\x0f\xd0#F^F\xaa\xf7Nn\x8c:\xfa\xa1IV0\x13!\xea\xe9\x07\xfas\xbd\x8fSM\xe8\xb9\xcb~\x92,\x16\xe7\xdcXe\xff\xb5\xfb\x05\xb6;\"\xdb\x0e\x8a\x9f\x92f_\x91.p\x0f\xcb\x0bQ\xee\xbe\xcb\xbc\xe3\xcc\xb2O\x02\xed\xb1)d\xb76xl\x8f\x9c\xfb>\xd2\x98\xc8T\x9bN\x8c\xd8\xac\x03\x8a\xc5A\xdf\xe2\xf5P\x08\xb0?\x03\x93Z\x19\xacrzG\x9f\xc3YdM\x91\x88\xd7[\xc2K\xb0\xeb	\x8a&\x15P\x8f(\x05\xef\xd4=\xe5\xc5\xd3\xbf^\xbdz\xbd\xc2Vo]\xaf\xb0\xeb7\xae\xd9SD\xe5\xee\x8a'V\x13\x0eK\x13\x91C\xe3\x9a \x9c}\xe8\xc0\x9f\xb7\x96\x97\xff\xf3\xcb7\x97\x97+\x85L\xa5I\x12C/\xfb\xcde<8\x83\xb1NT\x97\xb84;\xda\xc1\x8d<\xb5\xfa#\xff\xe2j\xf2c\xab\x05\xaf\xc1\xa3\xbap2\x08\xa0\"\x87F\xc6\x8d\xae(\x86\xad\xf1\xab\xa0+\xf6\x96/5\xe1/\x0c;\xdb\x0f|0]g?\xfb\xc2\xef\xc0\xcc\xbc\x10.\xce\x83YW\xcc\x9f\xa6]\xf0\xd80\\\xa8\x1a4\xdd2\xaa\x05\xf5vL\xf1X{\xe1\xda\x16\xa3\xf4\x0bQV\xab\xfd\xb2I\xebT}=c\x96\x8d\x83\xd8Y\xb1\xe0\xa9\xb3\xces\xab\xa4J\xd7\xf0y\x8aG\xba\x02\xffR\x0b\x16\x1d\xe6\xf3\xbf+VUL\xce\xc7\xd1\x16\xecL\x8d.\x078\xceL\x93\xa8\x9b\x9a\xe8\xe6\xe9\x16\xfcvl\x93Y\x0b+\xf6o\xec\x11\xe0\x03\x08\x12\xf9\xafH%\xa0\x9cgV\xc7\x168\xd3\xd5\xcc/k^\xa8\xb5\xbd\xbb\xb7\xe9=^{\xff\xcao\xd8\xedk\xd7\xa8\x83\xea^\x91\xc6_\xf1A\x1c\xb9\x99x\x1a\x83\xb5r\x0b7V\xec/\x11\xa5AJ2\xfc7Tg\xc5\xc2\xe2\x85 \x0cE\x9d\x88\xde\xa0?\xf1\xb36\x11Q\x04v!\x94\xdc\xbfR\x90y\xcc\x15\x90L\x13\xdaA\xf4]p\x1c\xe19\x99/\x07\xad}\x00:AT\xcfT\xc3%\xcf\x13\xad4a\x1c\x1f\xbc\x8c\xe9\xb1\xd7\x82PP0\xb7\xc9\xb7\x83f\xbb\xc9\xfc\xa0)\xa2\x04\xac:<\x8c\xb7\x97\x97\xb7\xdf\x86\xb7l\xbbqB\x12|\x88\x9f+\x9b\xc7\xcc#\x9fE\xf8\xf9_\xe4/\xda\xd5\xeb\xb2M7\xfc\x04\xfc'Pin\x0fU\xf1[\xeb\xe4D7\x0cv\xc3J\xfc\xa90\xcad\x8a\xb34\xbbi\x05\xcd\x1d\xbb\xb1\x81\x97+c\x8f\xeaS\x93\x9d\xc8\x13>\xa6\x8e0\xeb\x87 9\x91M\xc1\xaa\xed0L\x1a\x81\xb9\x8c\x1c\xdf\xd0\xb7x\xf7sV\xe4VtKfi\x16c\x05\xb3O\x04(\xab\xd7\xa3\x1b\xc3/\x8b\xc7\x08/\n_\xeff\x16\xa3e_\xc33W\xfc[\xed\xb8\x9e=\x94U\xf8\x81Z\x89\x8a\xf6hH,\xfc \x16^zc\x15\xc6\xac\x99_\xac\xba\xc3n\xac\x16G\x90;19\xca$\x08p$\xa1\xbb\x0d)\xc34h!7\xdf\x83Y\xd0\x8f\x8c\xda\xe9ND\x17m6\xcb|\x1e\xd7\xabL|\xadC\x98\x1e5\xbd\xad\x9b\xef\x17\xb3\x9bVC\x0c\xd5\xa9\xfe\x1c\xbf\x938\x80	G\x08\x08\xf7\xd4\xa9\xfe\x8b~H\x9f\xd4\xff\xcc$2\xcfL\xc0\xad\xaf\x86\xfa\xd0|\xdd\xaa\x0f0'\xb4k?\xb3\x00\x7f}\xda\xad]HDz\xd9\x94\xe6\x96\x18\xe7\xc2'\n&\xbf\x07S\xde\xa3W\x04S\xd6E\xca\xcc\xa3b<\n\x9a\x8e:J\x1a\xdc\x97[8\x88\xferZ/c\xa0\xc2t\xb0j`\xa7\x18\xb4juu\xc9\xc7\x1c\x13\x07m\xe6\x91c\x9fk\xde\xe7\xa5\xbe\xf9l\xff~!iW\xff$<\x9277\x90\xb4\x00\x8f\x82H:\x91/\xbc\xd3\x1ee\x88'\xe8\xecD^fJ\xdf\x8f\x82\x0f\xda\x024Y$\xbcT\xf8\x8c{i\xb0)\x96R\x99\xf2\x10\x90\x18A+\x9c\x98R\x0e\xca\xf9O;L;\xe5`\x1c\xa8m\xdawZ\xc2\x8c\xef\xab3jkG\x16\x0c\x91\x06qjYh\x00\xb9\xbbh<I\xf4\xd9\x1d\xd3r\xf1\xc2G\x17?\xba\xf8\xdf\x01\x00\x00\xff\xffPK\x07\x08\xe3k\xc5-v\x16\x00\x00YD\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/sk_SK/common.jsonUT\x05\x00\x01\xc8r\x08f\x8cW\xbd\x8e\x1b9\x12\x8e\xa5\xa7 \x04\x0c\xbc\x0b\x18\xb3\xb93\x8dG\xde\x91oF\x12$\x8d\x8d\xf3\xed\x05\xa5f\x8d\x9a\xd3lV\x1f\xc9n\xad\xb4p~\x89_\xe0\xa2S\xe0\xc0\x81\xa3\xcb\x0c\x9c\x93\x1e\xbd\xd7\x81?\xfd\xa39\x07\x1b\xa9\xf8\xd5W\xfc\xa9\xfe\xaaH\xfd1\x1c\x8c\x1eH\xe7`G\xaf\xd8\x1f\xc3\xc1`\xb4\x01\xa5\x90\x8f^\xb1\xd1\xd5x6\x9b\\\xb3\xab\xbf\xb2\x17\x17\xe6\x05{3_\xb2\x0b\xe3\x7f~\x1b]\x98\xdfF\xa3\x97\x8e\xcfQ\xa2\x0d\x01\xd7\x93\xdb\xc9\xba\x8b8\xf3/\x11\x0c\xa9\x1f\xb0\xfc\x84\x1d]\xe4\xb0\xc5\xebn\xce\xe9\xdd\xf8\xd7	\xfb\xe1\xcc\x9e\xba*HH\xd4}\xf2j1\x9f\xdeN\x96\xcf\xe9\nw\x0b2\xd6L\xd5:\xd5\x08>\xe0\x823\x85;V\x90\xb1?\x99\x9f\x99P\xccz\xdfe\x08q\xb8\x19+>u+\x99y.l\xdc\xd5\x05oc@qv\xc1\x99\xdf\x8c\x1bSdu\x13\xfc8\xee\x9cW\xea-r\xb7=\xc7Z\xccWk\xb6\xb8_\xfe\xfa,K\xfd\xb4k\xe4Bcb\xa7\x8b&b\xb2d\xcb\xc9\xf5t9y\xed2\xb5\x9eG\xfay\x12\x9a\xb0.\x05\xeb\x9b\xe5d|\xfd'BM\n\x9cvW\xa2\x91\xc7\xeaf|=\x7f\xcf\xae\xa6\x7fF%!\xad\xb7\x94dk\xdan%\xf6\x97\xbe0\xe7\x0b\x95\xaa\xd3\xe0\xfd\xec\\\x85\x81Q	\xdc!_A\x8e\xdd\xf1Wl\xfe\x86\xado&l5\xbe\x9b\xb0\xe9\x82\xbd\x9f,'\xec\xddt\xf2\xbe\x17=\x1c||9\x1c\x8c\nYj\x90\xa6\x91<\x87\xfd\xe8\x15\xfb\xdbp\xe0\xe5z\xfa\xa7_d0\xe2\xaa\xfe:\x1a\x0e\x06\x7f\xf7k\xa6T\xea\x96\x95\x12\x17\n\"/%^\x7fU\x1d3\x17\xaa\xb4\xd8rs\xa1\xeao\xb6!\x87\xd1\xbe\xc7&e\xd3\x8e\x8cF@\xd2p\xfd\x80\xaa\x8el0!\xc5[v\x1c\xbe\xec\x0dLG\xde#t;\xd6\x94E\x9e\xa6,N\xd9d\xc3\x89\xb4\xc9\x05\xf0\\\xf82\x1d{\xc3O\x04[rH\xa1\x1b\xb9\x82\n\xa5\xfc\xd6\x19\xfb\x9cJ\x13\xf0\x84\x94\xd5\x90x\x11\xbfn\xec3O\xa8$\xe7\xff\x90\xa3:\x1d\x05\xa3\x8d\xae\x8f\x87l\x1fx\xf8{\x01\xfe\x80\xa3\x89\xb7\xa8\x82\xd3\xe7\xbe\xab\x9b \x10\xca\xc7g3\xa4\x82\xa3\x97g\x92\xf6\x82\xc3\x99\xaa0x\x04%,i?K\xfd/\xaeO\xdf7\xf5Q\x8b\xe8,\x8d\x9d\xd1.\x1c\xb8>V\xc8,j8\x04\xa7\xa4$\x0b\xc2\x8cV\xf8\x84`2\x87\xdd\x81\xc9\xd8\x83\x90\xa8 \xc7\xce\xb5^;\xe7\x12\x0b		z\xff\x0b\xc3\x1c\x85\xed\x84M\x99\xb0\x86\xa5`RV\x81,\x9b0\xe2\xa8\xa1\xd9\xe3\x9d\x1b\xd5GK\xcd\x1ei\xa70\xba\xe0QX|\xfa\xce8\x99&\x03\x06q,\xa5\xcf1m4\x1c\xc4\xe93\xabNG\xb4-!\x0d\xe7k\xdd\x11\x0e\xad\xd4'/\x9a\xa1v}\xc5\xae\xacH\xb2\xbd\xaf6\x8d\x85\x12\xcc\x04 \x96l\x97\x99\xd6\x0e\"\xa4\xd2\x81?\xad\xf7?7\xd5g\xf6*\x89\xba\x1c\x11/\xe8\x11U\xfd\xdf\xc0.\xb4(\xea\xe3#0\x03qO{\x95\xa4\x9a\x948\x94\x8f\xd8\xa2\x07\xeap\xaa\xa0\x0d\xe7\xf8\x7f\xf8\xd0\x17\xc3\xc8\x8a\x1c\x1b\x89' Q\xf1^m\xbc\x05\x15k\xe3\x0dn\xa2u\x07:Z\xe3\xa2\xb1\xee\xea\xe3c4\xdf\xd6\xdfTg\xca\x86Zn\xa3\xb5\xc2\"Z\xf3\xccFkFU\xb4\xae1\xe9\xcat\x87\x98\xb5[\x99!\xc7\xa7\xefM\xb3X\x90\xe2(\xdb\xca\xbd\xb7\xd4\x95\xf1\xcaj\xe4\x0d\xf1\xf4o[i\xdb\xfa\x16\x02\xba\xc1\x8a6d\xa1_\xf0\xa5h\xab\xbd(\xe4>\x8ae\xeclf\x89\x81\x94,\xf4\x04\xbf\xbf\x0d\x81\x0e\xef\x01oD\xccZ\xca\x1dxM\xb2\x0cX\x02*\xc1 ;]\x9e\x8e\xad\xac\x12\xb0 i\xeb\x1c\x7fqf\xfd\x9fmtH\xe1\xae\x83\xd7m\xdck\x07\xb8\x1d\xc4\xa9\xe27uo\x81\xabf\x13\x1fr8\xc0\xe9\xb3\xd7{\\\x98\x93\xc2\xb0\x15\x15\xcb\xc7\xd5\xd8\x9a\xe8\x16\xf4\xd6{\xde\x08\x89\xcc\x121\xe9\x91\xc8Q\xc2\xa4A\xb27d\xa9\xa2\x80o\x89\x9c\xdaI\x85\x1dK\x91)\xc1\n\x8d\xac\xdao\xebo[\x89J +\xd0(\xf1\xf4\xa9\x91\xbf\xc8\x0b\xd2\xf65i]\x16\xbe\xf9M=\xc0\x0er\x9f\x82\xbcd\x0b:\x1d3\xe2N\xe7\xcc\xd4\xdf6\xa4/\xfb\x81\xd7\xf1\x003\x08c/\xdd/\x97le\xeb\xa3V\x99\xab\x06\xa6\xe0\xe9S\xfd\xd5\x02;(\xaa\xca&\xbc\xb8\x01\x93\xfa\x05\x17\xbe\x87\x04XB|G\x90\x91\xc8U\xfd%\xc2\x94\x80\x15\xa1s\xdf6v\xe3\xc9\xba\x17A\xac\xef\xf2\x00y\xa6J+\x90U\xb2>f\xf1\xbe\x8b\x9doMW\xad\x06>\x04b\xfd\x85)`\xa6 \x1e\xb57\xcaAg+D\x15:\x95\xce\x18\x18f\xdc8xQpz'\x8c \xd5\xbc|\xee\x1c\xc4*\x8f\xf5H\x01\x08}\xf4\x9d\xc0]'Q\xf6\xa0)g6u\xbd!Gw\xed\x83	C\x94\x98X\x0c\xef\xad8Ui\xec\x1d\xd8\xc4'\xec\x06\x8d\xac\x8f.\xb3yi\x04\xb0CJ\xfd\xcbF\xe1\xaeK\xc8\x8c\xaa\xfaKLBTIABY\xa7\xddV\xdb\x0b\x878\xf16X\xe4\xb9\x17g{g-$l]#\xaf~\x99\x87K\x8b\xaa_\xd6\xeb\xdb\xc8u\x8f\xc0\xee\x9d\x1c,\xf6@\x9ayO \xfd\xa3\xa4\xf8\x96\x9c	\xcc,\xb1\xd3g`\x89p\x9a\x91Q\x15\xfa\xd9\x14\x0d\x1a^~W\xfb\xee`\xcb\x88\xf9|n\xf6\xf1\xf1{\xcew\x9f&\\\n-\xbb\x08\xd0\x19\xad\xb9\xe4\xc2\xc80\xeb*\xcd\xe7\xc3\x7f\x8d\x02\x13\xf1 \x90\xb3R\xcb&\xf0Ac\x10\xef|\xa3\xa8j\xfb\x85\xc6B\xfa{f\xce\x0b\xaa\x90C\xcfA\xda\x86BIe}4\xbd\x10[j\x7f\xe2Y}\xac4\xc4\x0f\xaeK\x19\xd3\xae\xa1\x12\\\xd6\xc7\x80\x1b\x04\x1dU\xf0\xf4\x1d\xba\x05\x0c\x1a\xa7\xb3\xc9\xef\x85\x88\x7f(V\xc8}\xbdW\xfbB\x9f\x8e \xe3\xd7w\x17\xe8\x8c\xacH|\xd9\xde\x17t \xad\x1c1\xba-\xd8\xd2/\xbc\nV@\xcbM.l8\x18\x19\xd9\xaej\xbb\xa7x/\xfd6-\xf3\x8d\x02!EhDsn%<}\xca\xcaG\xcc//\xe3\x97\xb6T\xf8\x92p\xa7\xf1@\xa9\x9a\xb6\xd6VT}4L!\xa7\x8c\xd4\xd3'\xdf\x81\x8a \xc2&b\xd7\x94\xc4}4\xfbx\xa7\x95\xe8=\xd3HYH\x02\xee\xbak\xc8\x83\x1b\xf9\x07N\xdf\xbd\xd0\xb4\xd5h|>\xc8\x9f\xdc5\xb7\xe6\x08\xcfVy\xdf_c8\xf88\xfc8\xfc_\x00\x00\x00\xff\xffPK\x07\x08	6\x1d\x9a]\x06\x00\x00\xa3\x0e\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/sk_SK/server.jsonUT\x05\x00\x01\xc8r\x08f\xbc{\xcfo\x1c\xb9\xb1\xf0\xd9\xfe+\n\xf3a?_&\xb27\xbb\x87\xc0\x87\x00\xb6d{\x95\xb5dE\xd2\xda\xc8\x06	\xc0i\xd6LS\xcd&{Iv\x8fz\x82\xdc\xbf\xc3g\xe4_x\x03\xc4\x87=\xf8\xf4\xdei\x01\xeb\xd2\x9a\xff\xeb\xa1Hv7{$\xfb\x05A\xf2N\x9a.\xb2\xaa\xf8\xa3~\xb3\xf4\x97\x87\x0ffKmJ;{\n\x7fy\xf8\xe0\xc1\xec\xe5\xb3\xdf\xcf\x9e\xc2\x1f\x1f>x\xf0`v\xach\x889\xa1\x15TL\xa1\x04\x87\xd7n6\xf7\x83/\x943\x02-,\xb5\x01\x97#,\x98Rh\x1eYxi\xf0\xa7\x1a\x95\x93-<\xb3\x05r\xf8}\x8d\x96hX\x90\xc2:`\x8a\x83H(\x97\x9a39{\xf8\xe0\xc1\x9f\x88\xf0\xecwg/^]\xe6u\xb9PLH;\xac\x85\xc0\xe0FxXD\x89\xab:cP[\xb4\xf0\x0e\x17g\xc9\x0cX\xb4\xc0q\xc9j\xe9\x0e\xe0\x85b\x0b\x89\xe0raA,\xa1\xd55\xac\x99r\xe04\xd8\xba\xaa\xb4q\xa0%G\x03\x0b\xa3\xd7\x16\x8d\x9d\xc3\x05[2#@\x1bxVU\x12\x81c#2\xb4\xc0\x9c\xdf.^W\xa8,\x82^\x82\xd2#[p\x86)[1\x83*k\x0f\xc6]\x9d^\xbc|7\xec\xe5\x14\x9b\\s\xd5\xfd\x0c\\Ce\xbam\x86q?G\xda\x16\x0c*\xdd\xe8\xdb\x9b\xfa\n\xa1d\x0e\x8d\xe8\xb6\x12\n\xa7M\xf7	\x94@\xb8BX\xe0\xa6\xc2\xdb\xf7D\xa22\xb8A\xc3v\x1f\xa0\x81\xca\xb0L7J\x97P\x19m\x9dA\xde}\x1c\xd7\xc0\xe4\x9a\xb5\xf6\xb5\xce\x8aa%ow7\xbc\x85\x0d+\xbbO\x05\xbb\x82\x02l\xa5yQ\xc7\xd5\xd0T\xb0\x99\xd1R\n\xb5\xa2\xc3\xaa\xd8\na\xa1\x9d\xd3%`\x83\n\xd69*pl\x01\xc2B.8G\x95\xf0SZ\xb5\xa5\xb08\xb0{\x16 \x9b\xfa*r\xf8Q/\x0c\xdb@\xb3\xdb\xa2+\xbaOY\x0e\x95\x11\xb6\x12\xd80\x87\xb77\xba\x01Vh B\xdd\xa7R\xb5Y\x9eP\xaf\xb9\xd0o\xb5\xac\xcb\x84>\xc1\xa0	\xc0\xc0!\xcc\xa0k\xf2\x08 \x14\x94\xb5\x15\x99\x17\xc3Fp\xd4PI\xd6\xa2\xb1)i\xa7Wb\x99,[\x94\xbaat\xda\xaf\x8e_B\xc5d\xa6\x15f\xb9k#\x13\x9aP_\xdd\x19\x1c\x08.V'\xb5\x1b\x97I\x1f\xf0\x9ce\xc5\xca\xe8ZqxK\xcb\x88\xa4\xfcX\xd0\xa7a\xdc/3\xa5\x16\x10zr\x9f\xa1\xf4\x8c\x97B\xfd\xcaV\x98\x89\xa5@\x0e%\x96h\xa1\x12\x15r\xb0\xce0\xb1\xca\x1d\x08\xe54\xa9\x83\x81R+\xe1\xb4I\xd8hf\xf8\x8b\xebJ\x98v`u\xfb\xff\x99%\xd1\x17$\xb4\x02\x81k[\xf4\x87p\xa6o\xdf\xa3\x03\xae\xba\x8f$\x9f\xa0t\x13o\xb4\xfbh+l\nM\x02\x8a\xdcu\x9f\xca9(\xdc\xdd\x80e\x9e\x02\x83M\xc9v7\xb8\xc7\xfb\x94%W{\xdam7\xba\x990\x8c \xa5\x1b\xbc\n\x03\x07p\xd2\xfd\xd7\xee\x06A/,\xcbuCZQ\xb2kQv[\xa9\x10\xbe~\x02%\x93\xdd\xcf \x99\x13\xca\x16\xa4=\xddG[\xa2b\xc0$.4\xdc\xbe\xef>Z\xc9\x0e\xf6Vr)\x9c\x1c\x97r)\\\xf7\x8b\xd4\xc5d1\x030Y\x8e\x17^\xc5\xe8\x00\n\xc98<:\xd3R8Q0\xf8\xbfpa\x98\x12\xf8\xe8\x00N\xd2\xf5=\x81\x8db\x85n&\x0b\xe0\xe3\xf9_\xa2\xec\xaf\x97~z{\\\xfb\x93\xfe9\xf7\x82\xbc\xea\xb6\xae\x1e\x913V\xb9,g\x03\xfe\xf7\xac\xba}\xcf\x86\x0b\xdb\xdd\x08\xc6\xd9\x15\xd4\xbb\x9b\xee\xe3\xa8r\x8b\x16\x9a\xb6\x92JH\x01\x05a\xd4tqP\x9b\xdb\xf7\xc2u?\x03m4\x0f\xbb\xbbB0\xb8\x12\xd6y\x81`\xc0\xa0i]\xd3m\xfd\xee\xa0\x91\xdd\xb6HMB\\\xcf%[\x8d\x96\xfd0\xc0\xc0\x110\xee\x8d\xad\xac\xb7\xcd\xba6\x19z\x17\x131\xc1jY{gr\x00o\x14\x12\x12\x99\x1e\xc3\x14\xd7\xa5l!\xcb\xb5E\xe51\x90e9\xacP\xa1a\x0eyO\xe0\x00\xce\xf1\xa7Z\x18\xe4\xc4 \xd3\xca1\xa1\xc8\xa8Kd\xd6\xc17\xc0\xc5r\x89\x06\xc99\xb0\x95M.\"\xcb\x99\xb9\xc8\xb4\x19\x05\xe10g\x86e\x0e\x0d\xd8\x8a\x95`\xfd`o\x11\x9c\xf0\xc05z=\xd3K\xf2sb\xd9\x92!e\x90\x0d\x98\xc4\x1c*m\xdd\x01<[\x12\x00\xaf3D\xee\xedm\x8e E)\x82\xc3\xa9-\x1aX\x0b)AaX\xbc\xd5\xb2A\"\x16w\x96,\xb5\xb6N\x97\x87\x17\x17\xc3R\xe3\xb2~\xb0h~\xc5q)\x14r8\xbc\xb8\x00SK\xb4 5\xe3\xc8A+p\xba\xa2\xb5\x12C\x8b\x123:9\x97c\x89\xf7\x10\xbf\xd4\xabU\xa2\x16o%\xb3\x8el$\xf1\x8d\x12f\xb0R\x02*\x1d\x05,\xc8D\x98\x96k?q \x1b}u\xba\xe8\xb7d?4\xdf\x08\x06\xae\xfb\xb9\xec\xe5\xf6\x92~\x07\x87\xf8\x0b(F:\xeb\xd8\xee\xc3\x1c\n\xbc\xfd\x1b\x14R\xd0\xe5y\xd1V4\x017\x8d\x96B\xde\xe1\xf4\x9a\xa9\xd5\x1dV\xddG\xb8b\x9b\xb6\x88\xac~G\xbf{\xdf\x1b\xb7\xb1\xfb\xe0%\xbf\x190R\xca\xb6f&\xcbE3\x1e\xcbQ\x02\x0bD\x93Y\x07\xda\xac@\x94\xe4U-\x12,\xa1%,\xc5,\xe7z\xa1\xdd\xa8,?\xb2\xa2\xdb\xeen`\x8d\x8b\xcc\xb0\xb5D\xa3\x9b\xf1\xb0\x1b\xda9y\xaf\xd2\x8b\xfc\x1a\x17V8\x848\xd3\xce\xc1\xd6Y\x0e\xccFf\x80j%\x14\x82P\x1c\xaf\xfd\xf8\xd2\xe8\x12X\x96\xa1\xb5A\x02\x85\x05o\x01\x0f\xee,\x8cD\xe99\x0d%\x8b3\xf5n\x9b\x18\x00o\xdd\x05\x16\xa0\xf0W\x8c\xbcQ)z\xbf\xcf\x16\xa6\xdb*\x01N\x97\xb578\xac\xe8>\x15\xfa\xf6\xa6\xc1\x02\x84\xea>A\xf7\x8bw'\xdeOxd(u\xae\xe5@~\xf7!\xd8\xbdh\x85\xc7\xe5i\x95\x1c\xbe.\xb4\xba}/v\x1fz\xe38,\xc05\xda\x10\\\xd3\x0f$\x8e\xfd\x8c\x81\x14R8\xd3\x93z\xc1Q\xc19\xe3\xa27\xc0#\x00\x94^\xfb(\x82\xce,\xc4\xc1	\x11\xd2\xff\x05\x93r\xa0\xf4\x7f~C\x9f\xa0y\xa5\x1b\xbc\xfd[/\x16\xaf)8\xa68E\xd95\x9a1\xb0\x8e\xd3sfs\xc8tY2\xc5\x0f\xe0\x90\xa9\xc1x\xd5\x15Y\x03r\x1e=*\xc56\xbf~\xf2\xe4\xc9hg\xc8\x9c:&\x93k\xc4\x92	\xf9\xc2\x98q\x83\x04\x80\x17\xc6h3\xd8a\xaf\xe1\xe0\xa7\xd2\xde\xd0\x0f\xdeC\xe4\x84	9%\xd4\x1f\x12a\x04\x02\xf7\xa0\x9d1;J\x0f}\xac\xb5\xe1w1\xa1\x8aC\xf7\x91\xd0\xc6\xedm\xc1\xa2i\xd0\x00E\xf5\xf7\xd0JF\xef!wQ/\xee\xa5\xf6yB\xb6^p]2\xa1Rj\xd79\xd2\xdd\x8c\xa4\xae\xbf\x0b\x80@\xa6\x1f\xff\x82\xfa\xe3u\xc5\x14\x7f\x96\x88\xcd\x0b\x0f\x81gR\xc21\xa1\xf4\x97\x14\xe1$#\x9e\x94=\xa0T\xe8d\x0egG/\xe7pr\xf6\x8d\x17\x07\x1f\xe3\xe9\xb5\xf2\xbe\xc6\x02%(\x8f\x1c\xb0\xe5\xd2\xdb\xf8\x038\xc5\xb5\x1f\x8a4h\x020i5\x84\x85\xe0\xe4\xec\xaf\xab\xc9\xa9\xfbO\x1fm\x8dK\xea!\xa0\x98u\xac\xc1`\x0d\xc0v\xbf,\xb4I\"\x94%\"\xa7P7\x08\xcd\xf4\xe8\x15\x03[u\x7fwd\xc6\x9b\xee\xef\x9bE\x9d\xb81\xe81\xe3u8\x0d\\X\xd2B\xf2\xa9\xa48\xe4\xcb\xf6\xb5q)\xd3\xd0\xe3{#\xae\x94n$\xbb\xd2\xb7\xef\x87P\xaeOJ\"\xb8\x86\xc2\xb0+\xa1\xda\xde@\xb0\x84\x9c6\x19rJkFO\xd2\xaa\xee\x17\x87\xaa\xdbBL\x80\\\xef\xb8\x82}\xacw7\"\xc4Z>\x02-i\xea\x9c\xdc\x89D\xa7\x8b\xee?y\x0b,\xec\xa9\xa5\x94.\xf0\xd4\xa9l\xac\x98\x94h\xda\x13\xcdGKw\x8e\xbb\x1bQ\xc2\x8a\xe2[#p\xb2\x95\xee#HTc \xde\x82\x05M\x06xS\xb0R$t\xa5^0yt\xaf\xe3\xe9\xbdK\xefK L\x96\xed\xbf\xd9\xfbD*\x89\xf4\xad\xb4N\xc3\x8eW\xe1s\x9e||F\xa5b~\xda#^d\xb9O\xea>e\xf9Sx\x12	\xbcmo\xdf\x0b\xeb\xc81l\xf4F\xf9\xa8n\x986\\F3!\x8a\xcf\x85R\xc8\x07\xc2\xdf	\x8ep\x84\x12\xe9\x14\xceH\xdd\"q?@z\x1at\xd0\xe5\xccA\xce\x1aJ\xe7Q\x01\x0f\x18S\xd2\x93\xba\x81\xc7'\x08Ej\x8f\x99\x94\x8fS\xc2\xdeO[\x8aZW\xc8\xe9\x98\x95v`\xd92\xc4\xcfkm\x8ap\xc0\xa4\x1a\x1e\x19Jt\xcccMy\x9ecV\x1b+\x1a\x94m\"^\x11\x16\x8cD.(B\xfd\xc2\xb6\x0cVR\x840\x95\xc5\xc2@\xc04	\xf1	W_\xf3\xb1{\xb7C\xf6\xa3\xdb\xe6\xb77\x8c\xf7\x82v\x14\x95\x9c\xc1\x1f/r\xbd\xfe\x13,j\xe7\xb4\"\xa5\xaf$\xcb0\x84\xafC\x9dh\xe4\xa1\x8d\xd8\x90\xc7\x94\xa7z}\x16\x9c\xf5x\xb2\xc3\xe0=\xbe\xdc\xeeq\xbeg\x06\x08%I\x96\x0ds9\x92\xdbf\n\xaccY1\xb9\xcf\x81		\xc5g\xd8\xf7C\x81\xe57\xbf\xc9\x88\x94\xd2\xd61\xb9\x12\x89\xe5\x11\xa3\xbc\xbd\xd6+\xa1\"B2\x81t\xe0;M\xbek\xeaA\xc2\xb1F\xfdo\xa1\xd2\x1c\x8a\xdal\xb4\xd1\xe5\xbe\xd5\xe8\xcf\xbe\x9f]OgOy\x9dk\xed\xde4h\x8cH\xcc\x92\xf7T`\xb4v\xa0\xfb\xb1\xc0\xe48\x96\xe1\x84\xcdIJ\xf2\xc4\xf7\x04;\x00\x16+F\xb9\x1bH\x9d\x85R\xa1\x14\x05%<\x87G\xa7s@\xe50\x04H\xcbZ\xca\xc0\x82qn\xd0Z\xc8\xd1\xe0\x01\xbcF\xd2-,+\xd7\x12\x87\xda\x86\xc2J\x0c\xff\xfb\xc9\x07\xf0\xe2\x9a\x95\x95\xc4\xa7\xf0(w\xae\xb2O\x1f?\x8e~4T\x17\xc9I?\xda\xdb\xeb4\x0f\x0c\x9b\xfcGr\xc0*\xdc.0\x15\xf6\xfa\xafL\xfaD9\xf1\xca\xc7\xe5\xbeWN \xa9W\xde\xdc\xe3\x95\x838\xbf\x14\xee>\xd9\x99\xc6\x1d\xf1\xce\x84\xb2d\x06h\xd1\xbe\xfa\xe9\x82\xba\xd3\x0c\x83Vl\x90\x0c\xbb6<\x16\x12-:/\xe6#\xc7\x9f\xf8\x18y\x1d\xff\xfe\xe8y\xe4B\xf0/\x84IW\xccW\xad\x92:q\x0f\x98\xa7\x9f\x9ey\xad\x1c\x1c\x1f\xd9\x03\xe8\xe7@\xc6\x14h%\xdbh{\x83\x01K\x8eT\nU\xd8\xe4\x08\x1c\x1aJp5/\xd8ft|\x82\xfb\xecsS\xb2\x0d\x1dm\xa8$!\x17\xf1\xa8\xb1G\xfb\xedo\x7f\xfb\xf8[\xd2\xe7\xc7=\x85\x81Q\xc9l\xa6\xc7\xc3>	\x9f\x81A\x0c\xc2\xc3\x94>\xc6\xd9G\xf5\"8\xe0\x0f\x17NG\x16E\x9fY\x7f9\x91S\x82\xee\xeaD\x94O\xfc\xa7O-P\xf5+\x08\xef\x00\xa3@\xd2\x8f\xc1\xbb\x87$\xc4B9\xc1$\x0f\xb5\x14\xc6:h\x84\x15\x13~\xd7\xdfyu\x18\xcd\x97\x97v\xc5`\xb7\xed>\x9a\xa2\x1eB\x93\xc8\xdd\x17\xc8\xea\x12\xf2A\x8b\xea*\x16,\x82\xecM\x88_\x88\x0d\xde%\xdd\xe0\xedM\xa1\xedh\xf7\x86\xacy(\xbf\xdd3\xa9\x86\x06N\x9eO\xc8\xbf\x13\xdc\xe5\xf7\xd0\xef>\xed\xb6E\xfb\xb9\xa5\xaf	\xeb\xcb+\xc7U}\xf9v\xbc\x86\xf0\x19%\x8c\x9cN\xa8p\x05'\xdf\x17u\xb3P\x19&\xe5\xf3\xd6\x95\xe6\x85b\xf6>\xe1\x8b\\\x1b\x97\xd5\xee\xdf\xc1@s4l\xa2\x83'#h>\x05L\xf5p\x9c\xe751U\xc29yV\xff\xb3\xcfg\xb9\xf0\xa6\xb3&\x7f\xd1\xc3\x17-\xc9\xa20P*,\xb5\x12\x99\xa7;\xaeM\xa5\x15\xe4\x13T}\xea~\xcaJ\xaf\x17\xcc9\x96y\xf7\xa3b\xfa\x93\\\x89\xc2\xf5\x90\x92\xf64|\x964\xcdS\xa7\x08\xda\x8eg|\xaa\x9b\xa4\xaa\x10f\xbf\xe9\xcb\x0ej28\xd2\xd0Nd\xe3\x9a\xdflT\xb7-Q\x0d\x01\xfd\xf7\xa6\xdb\xba\x82\xf5\x0fh\xb7\xef)\xcf\xb0\x95\xe9\xb6\x0dYr\xf2\xdb>\xf5 \x994Y^\xefWG\x88\xfeR\x04w:p9B[P\xb2t\x9a\x0c\xf6W\xf7\n\x1d\xf08\x9e\"\xdb\xf0\xf4\xf3S\xad)\xca\xa5\x9b\x05\xdb\xaal\xcd\\\x96\x83\xb0\xc0\x16\xba\x0e\xefk\x8e\x99d\x83Z\xf2;\xa7\xfaF\xf2\xcf\x9fj\x1f6\x1c\xdeS:\xee\xc3\x0d\xe8k\xc8\x97c\x0d\xf9\x84U\x159\x1b\xbd\x1c\"c\xedK\xba{\x06\xb1/(\xfb\xea'}\x0f\x0f&\xfb\x85/\xbd\xf6\xc1\xe0\xc0|\x9d\xc4\x86\xfec*\xdd\xbe8\x06\x01)\x84\xf9>P	\xf9\x8d\x97>)\x03\x0fo4\x8d\x96\xa4\x12\xb5uw\xab\xd2Z\xa5	P\xb5\x7f~g\x9f;;\x92\xe8C\x83\xfe\xc2\xa6!\x0b	*dq\xe8\x1f\n]\xc2d_\xbd\xee\x95\xe5_\x19\xbd\x10\xbdc\x1fu\x84\xb8b\x8c\x05Bd\xed\x17\xfcZ\xa8\x02\xfc\xb8%\xf9\x9d\xa7\x13\xc8W#\x0fQG\xadxtS\xfe\x93\x86\xe8z3)\xb2\xe2\x00\xde\x91\xe0\xc6*&\x9f\x83b\x8dX1\x87A@\xf2)%\xa1\xacC\x96JAej\x85{\x85\xcf3\x82E1\x8b\xab\n\xf9\xdf zC\xaaGIY\xce80\xd5\x8eF\xc7gh\xa7\xc0Yk\xf7\x18]\xe6\x06\xd9\x1dN.B'\xac\"\xf03\xbc\x02\x1f\xa1\xee\xb2i\xeb\x81\xfa\x85\xac\x1d\xa0\x7f+\xef+^G\xda\xd7\x89l1b\x18_\x04\xedq\xce\x1f\xb3\xc7cQ4~}\xb1\"\x1a<\x0d\xd9\xe0\xeft\x9d(\xd4y\xf0@d\xb4!\xf7#\xf3\x04.[\xd0*C\xc0\x06M\x0b_\xff\x1a\x98\xb5b\xa5H\x1c{k\x1e\xfdBxS	\xc7\x91p]\\\xe2\xf5h\x9c\xcf\x91?~.k\x84\xcb\xb1\xa5\xa1O\xf0\x0c\xe5\xcf\x8a\xc3\x82\xc6\x1d^;\x10K\x08\x0d\x0b\xbe\xb0!\\\x0e\x8f\xfel\x1e\x91\xd9{\xf4\xe7E\x92\x1c\x10\xcb7*\xc9\x9b_\xa3\"[\x1c\xde(F3\xee\xcb\xf8>Z\x1c\xde\xbbi0\xa1\xb3\x12\xb4\x95d\xb9\x11\xb0\xaf\xe2\x06%s\xa2\xc1KQ\xa6\xc5\xa0\x00\x04\x82Z\xc7\xcaj8\xcb~\xc4K\xb7\x1b\x86)\x0b:x\n\x8f\xbe\xf6'\x0fl\xa5'\xbb\xaa\x90\xa5G\xe7?\xef\xae\xa4\x92\xed\xf9$\xb4\xfb\xe39\xc1\xfeD\x86,\x0cD\xd3\xac\x1b\x04?\xd4'\xefQ\xf3\x8c76>\x91\x88\x8fP\x15\x85\xb5#\x0b\xad\xdd\x0f\xe7\xaf\xc7\x95P\xd2G\x80\xf9\xe4\xb3G\xf6AV\xb0\xe2\xe3\xab\x1f\xa9\x9a\xd5\xe5\xb4H\x04\x95\xd1\x14\xea\xf8:\xb9\xaf\x95$\x1a\xef\xdf\xc8\x12\x0ddM\xf7\xd1\x0d1^0\xf3\xe1\x1d\xedn\x0d\x92\xe0\xa1\xc3\x05\xd6Bq\xbd\x1e\xc9\xda4b\xbf\xa0\x8f^\xe3\xbc\xe2.\xea\xb2\neL/\xc8\xfd\x11%\x0ft\xc1w\xe8XX\xe9\x8d\xf9A\xcaA\x8ew\xf1\xbco\x19\xd9\x82\xd5\xb77\x91\xd9\x05\x93.\x1c	f\x14\xbe;#\xaaLs\xf4\xf2?DU\xf1\x15\x95\xa8\xc39f\xba,Q\xf1`\xd1\x178\xba\xa9o\xc3\x83\x03\x86\x07\x07\xa9\xd5j\xb2\x98:C\xc5t\xb2\xe5:\xc3\xd3go\xe2J\xfa\xf1\x83L\x97\x9fI\xf6,Z\xb2\xfb{=	o\xdb\xca\xec\xb6\xfem\xd1\"\xf7)me0\xbe\x1fE\xe2\xbeo\xa1\x81J\xdf\xbew\x08|\xf7\xfft\x96\xcf\xa1@\xde\x82e\xfb\x15Y\xc2tm_\xc9\x14\x19\xc5\xf5<\x97\xdd\xd6\xa6\x05\x18\x9b\xb3RLM\xe1\xc5\x08\x9a\xef\x01\xbeh\x10m\xa5\x85LC\x8b\x90\xb8]\xf4\xe0\xd4\x12\xc7\xb9w\x12\x08\xeb\xd8r9\xedS\x88\xfd\x11F\xcb;\xe5`\xd7\xe8\xab\x9aF(\xc5\xc9%k\xc4\xed\xfb\x02\xefi  \xebw\xc7\xa0%]`\xa3!\xd3\xfe\xadO\x86{\xf0\xf5\x84\xb4L\x1a\xde\x90{\"\x97\xfe+\x9e\x91\x7ff\xf6\x8f\xd1{\x0f\xcdA\xf0\xc3m\x9f\xb0\xeb\x01\xdbgUL\xf6\x8a\xe1\xdbQZo\xcb\"\xc9\xd3\xba\\\xa0!5!W\xe7\xed5\x05\xa3\xa3\xbb]\xe0R\x1b\x1c\x9c\x04\xc5\xabw\xaa\xaf\x13\xeeb\x0c\x96O\x84\xfa\xdf\xe0\xfe?w\x9c\x84T\x80<{E\x14\xf4~\xa8\xef|\xdd \n\xd1(\xa3\x95\xbe\"\xf9\xd9\xcbT\xfdK}\xf7\xcb\xee\x03	\xd8d\x02\xf8\x0e\x1b]\xf6\xc2\x91^\x90a\x19\x96z\\\xa7\x07\x1c\x10d>\xfd\x06\xa6D\x89`3\x83\xa8l\xae\xdd\x1d\xdd\xa6X\xf1\xf9\xab\x81\xd2\xa1o2H\x9a\xa4\"\xc5X\x0c	=\x08\xb1\x1dm\x9c\xb3G\xed\x1f.\x8a\xdcG\xc2g21\xfcz\xa3\xbc\xb7\x1aH\xbd\xf3YN\x1fpi\xe5\xcb\xddC3Xo7\x98\x94-0\xce\x13\x0b\xee\x89\"\xbf\x8b:\xb2\xc5E\xf9\xf9\xaa\xed;\\\x9c\xdcW\xb1\xed#\x970n\xb0\x11\xb8\xf6\xd4s\"5x?\x1b^\x0e\xc13\x18\x03\xe9\x18\xf2\xa5Q.\xb9\xc0\xc9\xfb\xd2;m\nrd\xfd\xd5~'8\xda\xe1\xb10\xe4\xe7D\xc4\x86\xa8\xff\xde\x13\x8d4\xf7\xfaF\xceB\xd3b\xf7	\x8c\x7f\xc3\xfa\xe7\x19\xb4Lq\x1c\xed\xc4\x1f\xc2\xe7<\xf9\xb8\xebX\x1e>\xf8\xeb\xfc\xe1\x83\x99\xaeB\xd2\x1b\xbb`Uh\"\x08\x7f\x83}\xf5\xa2K\xb0\xa5\x08ym\x00\x84\x9d\xc5\xaaP?\x16\xbe#i\xab\x8d\xa3m\xf7\xe6}vdV\xaav\"\xa8\x1eeb\x12yh\x88\x19^\xe8\xc2Cgt[m\xe8P\x18\x11n\xdf\xbb:\xb6\x0f\xf0\xee\xe3\x04\x9aX^:\x93\x99c\xc3\x9b\xca\xec\xedn\x8bz\x81\x99\xea~\x0e8\xbb\xffp\xdd\xa7\xf0R?{\xdb\xfaJ\x7f\xb7\x0d]:\xd32\xd9\xec\xc7n\xbb`M\\\xc0Ea\x98\xf3\x96&\xb0\xc0\xb2\x92\x94?\xf5\x87\xb7`\xea,U\xbc?\xe8:y\xe6\xf2\xce\x8f\x87\x1a\xff\xe3\xaf\xeccX\xb4\xf0\xd5\xd8\xed\xb0\xd4R\xea5yI\x83\xccj\xf5\xb4\xbf?\nK\x17L\x85|\xd2[]$\x01\xff\xcaR\x14.\xe8\xc7A\xaf\x80i\xa1\x99\xd0\xc2K\xfd<\xbc\x81\x1f\x9f\x91\xc1\xfd*\x96\x89\xe2\x1d\xd5b\xda\xff\x1cK\x9e\xbe\xc91l:\xa6\xf64v\x11\xcb->N\xa8\x81%Vy\xc68e\xae}Q8\xc2|\xa2rA\x0e:\x8e\x19v\xfb7\xd0\x96qT\xd65!Z\xa0c\xa3\xe1\xe7L\x0d\xdf\n\xcdE\x85\x19\x9d,\xed\n+g\x93n\x8f\xdf\x9d\xbdx5\x87\xb3\xd3Ws\xdfy\xaaMP\xff\xa5 %\xf1IJ\x19\xeb\x8f\\\x94\xe85\xdd\x92[\xfa\xe6\xc9\x93\xeb\xaf\x9f<\x99\x0f\xc3\x84\x01\xbeF\x1fF\xe1\xfb\xe7^\xe3\x94\xef\xc0S\xfc .\xa8\xf5\xeb\x8b;\x1d[\x0b\xd3\xae\xc2Y\x963\xb5\xc2\xa4\xc43\xfb\xb1D%v\x1f G\x1b\x9b\x17}+\xdda\x7f\x9c\xcfJ_3\xd1\xcb\xb4[%\x86\xcf>\xb2\xf5\x1d\x90\x01O\xd2Yf\x84u\x18\x7fF822\x98\xc3\xf3m\x04k\xb5\x14\xab\xda\x84t\x9d\xc6O\xfd\xb3\x07\xad\x86\xee\xac\xde\x9bv\x11Z=&\xf3\x92\xf6\x8f\x99\x0f\xb1Gbo\xdb\xbe\x98\x97\x10\xe3\xcc\xf939b\xf1\xd9\x7f\x16|\xfb\x80\xf5c|&Hq\xfc\x8c\xde[\x0d3\x82\x0e\xea\"\x9d\x14\x0b\x8c\xc3\x9c\xb4\xba8\xe3\xb5\xe9\x0b{\xb3\xa3\xfe\xb7\x1f	\xfa\xe2\xe5\xc8G4\xf5U\x14\xeb\xbe\x89\"\xc8\xb5\xef\xb5\xd8\x86^\x8b\xb8\xfae\x9d\x15o\x82\xe0\xbe\xfc\xe1\xf0{x\xf3\xf2e\x18\x08\xef\xff\x04\x7f%\xf5\xc27\xb0\x86\x01\xff29;>\xea\xbfP9\xe1\xbc\xe8\x1c\x87\xdf\x91\xb2\x90\x12W\x81\xc2)J\\\x11\x896t\xee\x86	R3~\x98H\x99\x14\x85\x12>\xb6\xef\x9b\xfe\xc8R\xf9\x06U1b\x08\xb5\xba\xa33\xec\x8e\x82|I?\xbe}\xf2\xe4\xfa\xdb\x7fB?\xa4^i_b\x9f\xbd\x89\xe9Bo\x01\xc2Hh\xe6I\x06a\xa3\xd3\xd6\xf7\x0d3\x82LBo\xd2\xf7j\xb5\xb3\x1f*\xbd\xd1F\x8d\x9e \x9dpy\xe9\xafH\x9b,\xbc\xc5\xdbVe\xc8\xbd\xaf\xf4y\xa9\x8f@|\xde\xbb\xa8\xa5\xb4\xb9\x8823:\xbe\xd9[}{\xb3\x88\x9a\xa6\xd7\xeaT\x0fU\xae\xd9)\xc6\xb6\xcd\x8f\xbbml\xadL\x9b\xbf}\xf5\xce\xdb\xb6T\x1c\xab\xda\xac\x06\x81=\xa3\x0f\xaf\xcf\xe1u5L1\xc8\x05\xe5\x8b\xc7g4\xe7<~\x91K8>\x9b\xce\x08\x91\xd8\xfe\xacX\xe4\xf13\x83S\xbf\xd4Z:Q\xf9\xc3\x102<\x11\x87Xk\xd1\x82\xad\x17W\x98\xb9\xb97)$\x0f!\x85\xf6\x05$T\x19\xb3\xc8\xc9\xf2\x90BX\xc9l\x8e\xf6\x00z\x192\xb8\xaa%3\xe4zL\xc8C\xa3\xc3\x99Yt\xcfc\xa3\xc0`:b\x8e\xd7\x0e3^\x07\xd1LfPHL\x0e\xa6N\xc49^\xac\xcd\x19\xd7k\xaf\x93\xe1W\x02}\xees\x918\x00\x8b\xf8\xf2?D\x19\xde8h\xca9w\x1fB\xff\xb5b\x141\xde\xde\xf4\xb4CB0X\x9b\x8b4\xa1\x8cS\xc21\xf9k3\xc8\xcb\x98F\xcfH\xa6<\n-\xbf2\xa2\xd2W\xa30\xd2\xe0`\xd3\x7fP\xe2\xa7\xda?\x06\xaa\xd0C\xcc2'\x1a|\xec\x9b\x10\xc9\x05\x07g\xea\x11](\xcb\xcd\x86B\xdc\xcc\x85Z\x96\x0f\x7f\"\xa4\xad<\xe4\xb2\xad\x02\xa0V\xd1]\xbe\xe1\x0b\xa6\xe2\xff\x93\x84\x98=X\xf0 /\xf0.B\x1e>\xf8\xeb\xc3\xbf>\xfc\xef\x00\x00\x00\xff\xffPK\x07\x08\x12\xca\xb2\xa0/\x14\x00\x00\xee4\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/tr_TR/common.jsonUT\x05\x00\x01\xc8r\x08f\x8cV\xc1n\xe38\x12=\xdb_Q\x10\x10\xf4\x0c\xd0\xc8\xdc\xfb\xe6\xc4\xea\xc4\xd8$6lu7\x1a;{\xa8H\x15\x9bc\x8a\xd4\x92T<\xeaA\x9f\xf7'\x16\x93\xe3^7\x97>\xed-\xce\x7f-X$%\xb9\xd1\x879\xa9\xea\xd5+\xb2X\xaa*\xf2\x8f\xe9${\xd0\xa6F\x97\xbd\x83?\xa6\x93Iv\x8fJQ\x95\xbd\x83\xecbvw\x97\xcf\xe1\xe23\xbc9\xb3o\xe0\xfdr\x0dg\x96?\xbffg\xf6\xd7,{\xeb\xf9\x15Ir\xc1a\x9e\xdf\xe4\xc5\xe0qb_\x13Z\xad~\xc0\xe2\x05\x07\xba\xa8qK\xf3a\xcd\xc5\xed\xec*\x87\x1f\xae\xcc\xd4M\xa3\x85$3&oV\xcb\xc5M\xbe\xfe\x9e\xae\xe8\xb0\xd2\xd6\xd9\x85*v\x86\x90\x1d\xce*Pt\x80F[\xf7\x93\xfd\x19\x84\x02\xc7\xb6\xf3\xe0\xe2q;S\xd5\xc2\xefd\x97\xb5p1\xaa\xb3\xaa\xf7AU\xc1Y\x05\x1c\x8c\xd7ud\x0d\x0b\xfc\xd8\xef\x94\xd7\x9a-U><\xcfZ-7\x05\xac>\xac\xaf\xbe\xcb\xd28\xed\x86*a\xa8t\x8bU\xf2\xc8\xd7\xb0\xce\xe7\x8bu~\xe93U,#\xfd4	\xc9mHAq\xbd\xceg\xf3\xbf\xe0jwX\xe9\xc3\x85H\xe5\xb1\xb9\x9e\xcd\x97\x9f\xe0b\xf1W\xaa$\xa4\xf5F\x97\xfbBo\xb7\x92\xc6[\x9f\xd9\xd3\x8dZ5\xd4\xe0\x87\xbb\xd3*\x0c\x8cGA\x07\xaa6X\xd3p\xfc\x0d,\xdfCq\x9d\xc3fv\x9b\xc3b\x05\x9f\xf2u\x0e\x1f\x17\xf9\xa7\x91\xf7t\xf2\xf5\xedt\x925\xb25(m*\xf9\n\xbb\xec\x1d\xfc}:\x99L\xb2\xed\xcb\xff\x14o\x12DI&\x9bN&\xff\xe0mw\xba5=\xd1\"\xbaH\xf4\xe2	\xb1\x16\xaau\xd4S+\xdc\x8b=FrT\x06\xaeVn\xd7S\xb1\x8b4\xec\x06\x8a\xa5R\xabj\xd89\xa8oG\x8a\x1d\xc8\x1d\xe1\x10dw|\x96\x91\xc8\xa2g\xa5\x1c\xf8\xd2L\x19\xc0\xaa\x16\xdc\x9c3\x16x!\xdcj\x8f\xf8O\xd0U\xe8\xdf\x99\xff2Rj\xe5\x0c\x96\\\xb3\x97I>\xb1\x84\xc6\x19\xdb!BL\xa3\xdf\x1b\xe4\x83eW\xa4\xc4\xeb\x93$76\x0c\xde9\xeb'\xbe;Q\x11{\x8a/\x92\xe2@\xe0\xf8R\xfc\xbf\xa1\x12N\x1b\xf6\xbf%Q\xc5c\xfc\xd6Zw\xa7\x0f\x1e}}\x12u%\x02,u\xb9\x0f%\x17\xa5\xf0s\xd0\xee\xd9\x1f\xed\x1e\x1e\x84$\x855\x0d\xa6\xa2\xf0\xc655\x12Kb\xfb\x1b\x0b\x9e\x02\x07\xe1v \x9c\x85\x1d\xda\x1d<\xa2l\x93\x9b\xae\xc8`\x1fW\xd2\x82Q\x1f\x14\x05\xc3\x85FS\xc1\xd2\xeb\xb1\xfb\x88fRz\xd355V(\x01\xdb\x97o\xd6\xf5\xe6]8\xd2\xd5	\x18f#7k\x14C3r\x0bn\x9c(\xf7\x1d\xf7!\xeb`\x03\x10{pHH/\x87\xfa\xd2\xad\x07\x7f\xda\x90\xfa9\xf5\x93\xedT\x19k.\xab\x84-\xb5RT\xf6\xc3-\xaaBmcX\x9d*O\x94D\xac(jS\xae\xe5\xcc\x89\x9aR\x85\x96(IU\xa3\xd2^\x96\xa9\xa3^\xffl\xef\xa3x\x8b&Jw\xc2\xf6X\xea\xa9k\xfc\x12\xa5\x82\xea(\xcd\x8eOm\x14\xf3.\xf5J\xbe\x17Q\xfa\x1b\xa6eff\xd4\xb4\x07\xa2}\x1f\xc9\xaa_v\xf5%\x8d\x84\x0d\xa6\xb5^\xfee^\x9f\x92}\x10/\xd3\xae\x97\xce\x8e\xdb\xb2\x15}O6\x8d\xec\xe2?\x9fy\x19\x9c\x06\x94\x12B\xe7r\x1c\xf7\xbeL\xfazI\x98s\xbaf/\x99\xda\x11UI\xbc\xd0\xf1\xbf\x8d\x8b\xa1e%:\x94z\xcb\xdd\x19\xc5\x80K\xe1\xc7\xf4e\xeft\xe9\x01\xbf{\\'\xfe,\x7fG_\xa4\x00\xc2\x95\x0d\xf7C\x1c\x95V\xdc\xa0s\xffe\xc47H\xa1\xf5\x0d\x9a-[\xde\x0bI\xe0\xb4\x06\xc9H\xe4(aw\xf1\x01\"\x9c\x8b\xed\xb9\xd5\xda\x97\xacV\x1c\xee\xeb\x9fh\xf6\xc7\xe7\xee\xf8\x0c\xc1P!\xa0AW\xe3\x1e\xc4\xcb\x7f\xfc5~|\xdeK\xec\x8e\xcfq\x18\x88\xba\xd1\xc6]jc\xda\x86\xe7\xd5\x82\x01x@!\xa9:\x07\x0e\xa5\x0c\xe6\xf3\xb1\xcb<\x9e\"\xf2m[\x96d\xedC+\xcf\xa1\xd8\x114\xb8\xf5\xcd.%(}\x00CR\xf7\x8f\x07\xd1\\\xa3\xdd\xb1\xef\x8a\xc7@\x80%\x86K~\x93\xa6\xa8\xd4%:\x11f\xebM\x92\x93e?\xba\xa8C\x97\x86\x9b\x14\xbcm`QU\xe8\x8b\xe1\xbf\xbf>\xe1\xf1\xc9'\xe7\xe5\x9b\xaa\xc8\x08\x99\xa6\\\x8df\xbf!Ra\xa6\x99=\xa0\x05\xeb\xf5`\xf5S\xf2\xa3\xb0B\xab\xf4\x14\xe1\xc1	\x8f\x8c\x8dH\x01\x08\xe3\xef\xa3\xa0\xc3P\x97\xf0`t\x0dnG`\xfd\x1c\\\xac\xfc\x16\xac\x92\xe4\x99\xc0\xb4\xb8Tk\xdd-\xba\x92\x93\xb4Bk\x0f\xdaT\x16<\x0c5\xe3LSt\x18\xb2\xf0\x99\x94\x80\xbdV\xa1}\xb2F\x0b\xe5|\xad\xf6\xa5\xbc\xf2\x88/\xd6\x93\x9a\xe6\xd8\x86\xfb\x84\x1f\x82\xbf\x04\xfd\x97\xa2\xb8\x89$\xff\n\x1b\x1e\xaaA\x82\x07m\x80-\x81\xf4\xcfV\xbbT\x9eF\x80\x15_*R\x80\xf2\xf8\xac\xdc\xf1\x19:l\xdc\xf19P\xcdwK%4<\xc1.\xba\xe1T\xeb\x88q\x1e\xef\xbb\xf8\x93O\xf9>\xe60\xcc{v\xa3\x87a\xdf\xbf\xec\x8a1\xc5\x82\xf3\xbd\xc5	\xe1\xbf\xd0P)\x1e\x04U\xd0\x1a\x99\x1c\x1f\x0c\x85B]G1\xe2\x8d\xe4\xeb\xe1\x92\x1e\xb1\x91\xd8\xa3\xda\xb80Jl#:\xad\x06\x8bk\x0d\x9f\xf6\x8a\x8c\x80\xf9\xcb\xb7t\xe0V\x86\xac\xafY`\xcc\x12\x9a\xf0\xdf\xfd\\\x8d\x90\xf5E\x95\xff\xde\x88\xf8\x9c\xbf\xd1[\xa1 \xe2@\xd1\x10\xc8;}\xb8\xd3N\x94\xdc\x9cQ\n\x16\x87\xae\xe5\xed6A\nh{_\x8b\xd0wAb\xd4\x0d\xcd5J\xb8\xdb\xb5\xf5\xbdB!E\x986\xc5H??\x8f\xdd\xedt\xe3m/\xffN\xc5\xdc\xaa4\xbaR\xef|\xd6-\xec\xf0\x91\x00\x15\x0c\xd6Q\xfd\xb7\xea\x90\xaa\xffC\x14\xc7\xf8P\x1e\xd1zR\x16m\xe3g\x8d\x9f[\xcc`\x8d\x9f c\xf3\xca\xe8\xad!\xcb\xe9\x08\x08U\xfd\x11\xbe\xdb\xe4\xd3x\x8b\xe9\xe4\xeb\xf4\xeb\xf4\xff\x01\x00\x00\xff\xffPK\x07\x08\xb4\xf6\xe2~\xd4\x05\x00\x00\x1e\x0e\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/tr_TR/server.jsonUT\x05\x00\x01\xc8r\x08f\xbc:]o\x1c7\x92\xcf\xf6\xaf(\xcc!\xe7=`\")\xbby8\xf8a\x01}\xda\xdaH\xb6V\x92\xd7\xd8\x0b\xb2@Mw\xcd\x0cw\xd8d\x87dk\xd4^\xe4\x9f\x1c\xa0\xc7\xbcF/y\xca\x9b\xc6\xff\xebPEv7{${\x83\xbb\xec=\xcd\xb0\x8a\xacb\x17\xeb\x9b\xfc\xc7\xf3g\x93\xb9u\x95\x9f\xbc\x84\x7f<\x7f\xf6lr\xb2\xff\xe7\xc9K\xf8\xf6\xf9\xb3g\xcf&\xa7\x86Q\x18\x945P\xa3!\x0d\x81n\xc3d*\xc8c\x13\x9c\"\x0fs\xeb ,	fh\x0c\xb9\x17\x1eN\x1c}\xdf\x90	\xba\x85}\xbf\xa2\x12\xfe\xdc\x90g\x1a\x1e\xb4\xf2\x01\xd0\x94\xa02\xca\x95-QO\x9e?{\xf6\x1d\x13\x9e\xfc\xe9\xe2\xf8\xd5\xf5\xb2\xa9f\x06\x95\xf6\xfd^\x18\x0ca\x80\xc7MT\xb4h\n\x84\xc6\x93\x87\xf74\xbb\xc8f\xc0\xac\x85\x92\xe6\xd8\xe8\xb0\x03\xc7\x06g\x9a ,\x95\x075\x87\xd66\xb0F\x13 X\xf0M][\x17\xc0\xea\x92\x1c\xcc\x9c]{r~\nW8G\xa7\xc0:\xd8\xafkMP\xd2\x8d*\xc8\x03\x06\xf9\\\xba\xad\xc9x\x02;\x07c\x07\xb6\x10\x1c\x1a_\xa3#S\xb4;\xc3W\xbd\xb9:y\xdf\x7f\xcb\x1b\x1b\x98<\xc1\x89u\xf0\xde\xbaU\xfa\x9a\x03\x8b\xae\x04\xd4\xda\xae=T\x18\xc8)\xd4\x10\x96\x18@y06\x80\xe7U\xc1\xc2\x8c\xe0F\xd1\x9aX\x92\x80\xb0\xb6n\x05dn\x94\xb3\xa6\"\x13\x06\xbe\xa8\xd7\xd8\xfa3[\xacz\xee\xaf\xc9\xc1\x07\xac\xd0\x00~\xbc\xc3\xcd\xdd\xe6\xbeDX\xa1N\x9b\xf8$z\xa0i\xaci+\xe5\xa9'\xb9o\xacQ\x15\xb4X\x0fDV\xe4\x15`D,\x1e~\xf6\x81\\F\xa2)\x95\xfd\x8b\xd5M\x95\x11a\x18\xdcD`$\x13g\xb0\x8ce\x01\x7fl\xd5xU\x88\x0e\xdd\xa8\x92,\xd4\x1a[r>'\x1d\xecB\xcd\x87\xcfEG+\nZ\xc1\xab\xd3\x13X=\xfc\xf2\xf0\xe3\xc3/+G^U\x9a\x9cJ\x9c\x9e\xc4\xc12\xae\x05\n^\x99\x81\xc5lq\xde\x84a\xe3<\x80\x03,V\x0bg\x1bS\xc2_xc\x89\xae\xe0\xa2y\xf4x\xd9xN-.\xe8\xc8}\x82\xd2~Y)\xf3\xa5\xaf\xa9PsE%TT\x91\x87Z\xd5T\x82\x0f\x0e\xd5b\x19@\x99`Y\xbb\x1dT\xd6\xa8`3\x91\xcfX\xb9\x8eok\xe5\xda\x81\x95(\x1c	\x10\x82\xea\xe5\xfe\xa6\xa9f\xe4X\xee%\xb6\x1e\xd6*,m\x13\xc0\xd0\x1aj\xeb\x83\x87\x19\xcd\xad#@\x10\xaa\xac\x9d%i\nTn\xf1{\x83\xd9\x01Gn\x06\x076\x18\x0f\x97\xe5\xc3\xb4e\xc9\x0e\x1c\xa2\x81\xc2\x9a\x80\xca@S\xb3\xba\x7f\xb5\x07\xda\xae\xc9\x15\xe8	\xce0(\x03\x9aB \xe7\xd9>\x8d\xec\xd6\xefl\xf1\xbeVAo3\x0f\x02\x8b\xdc\x05\xff\x88\xfd\x14|S,\x01=\xbc\xd87\xaaj\xe0\xdf\xe1\x1c\xcd\xc2\xbe\xd8\x81wi/{P,\xd1a!\xfc\xb55\x8b\x11\xe72\x17o\xd9v\xcc\xe86\x00#;\x86,\xc7aY\x81u(\x96\xd8\xaf<L\xe3t\xf4~\xc5.\xcey\xe6_\xd8\xaafQ\x03BZ%.\xb8 '\x02\x0b\xe8W\xechW\x04\x8e\x16\x8a5C\xbc,[LX:\xc2\x12\nG\x02{\xc4\xfe\x1a\x17~{\x0b\x10\x18\x98>\x02\x17\xb2\x05o\x1bWPd\x9bfy\xab\x1b\xf1\xf1;\xf0\xd6\x10/b\xa5phJ[\xe9\x16\x8a\xa5\xf5dd\x05a\xb1\x84\x05\x19r\x18\xa8\xec\x08\xec\xc0%}\xdf(Ge\xfc\xc6x\xfa\x18@\x13\xfa\x00\x7f\x80R\xcd\xe7\xe4\x88}6.\xf2\xb3\xe6\xb3\xb8*\xac\x1b\x8e\xfa\xb0;\x1d\xf05V\xe0\x05\x99$i\x82\x12\xe0\x9a\xc4^\xec\x9c\xc3\x8f\x9a\xb7\xca,X\xa0\xfdJq\xab|F;\xb0?g\x00\xdd\x16D%O\xe3\xd3\xd3\xaaR1\x0e\xf0\xb9\xc0Zi\x0d\x86\xe2\xe6\xbd\xd57\xd9\xe9\xe4[m|\xb0\xd5\xe1\xd5U\xbf\xd5\xb4\xadw\x9e\xdc\x97%\xcd\x95\xa1\x12\x0e\xaf\xae\xc05\x9aX\xb7\xb0\xa4\x12\xac\x81`\xebNq<i*XraI\x15=A\xfc\xda.\x16\x99\xe2\x1f\n\x9c\xa9v\xa7(x\xde8\x93,\x06tO*\x85\xcd|\xa3G\x11\x94\x98&B\xfc\x9f\xbf\x98\xf7\x99\x85\xdb)\xac\x97d\xc0XC\xac\x04\x9e\x02#y\xef\x85V\xa3\xe8\x94\x16\x9c\xa1Y<\xe2\xa4\xd1,\x1a\\t\xcc\xce\xd2\x10j,VO0\xcd\x89\xfa\x06]\xb1T7\x94\x11\x1d`\x91^6k\xc7\xba\x05\x88\xd3\x07tXaFJy\xce\x1b.\xed\xcc\x86\xc12.\x1c\xdd\xb0\"\x16\x0e\xd7\x9ac\xcft\x04\xe6\x00T\x89n\xafi\xe6U\xa0~\xe2\xe0[<1o \xb3P,$S\xd2\xad\xe0\xe7\xceV\x80EA\xdeGUS>y\xc5G\x9bb\x9d\x11\xa76l\xec(bX\xf2_\"\x87\x8b\xe4\x9e{\x83\x1fm\xd4\x03\x9a\x96\x99\xd9\x86w]\xa3\x0b\x91?\x9f\xd4\x0bY\xff\xa2G\x0b\"\xd21\x8b\xc1[fq\xb7\xb4&\xf3\xb6*(\x97\xd8\xad\xb0\xc6\xecx\xa8$\xd3\xcf;.\xc9\xc0%\x96\xaa\x8br\x03\x00\x8c]Ktg\x861\xb9\xcc\x88\xb0\xf5\xceP\xeb\x9e\xd2\xbf\xfd'\x0f\x01\x8d_\x0fGr\xc6\xf9&g\x0f\x11\xda\xe7\xaai\xf2\x12\xfd\x92\xfdi\x85\xe6\x13ag\xaf_\xca\xfe\xf3\xf7{c\xdf\x1fl@\x9d\x9d\x0cU\xa8\xf4\xb1s\xc3\xe71\x00\x8e\x9d\xb3\xfd\x96\x92\xf5\xc9T\xfe2\x12\xe4\x13D\xceQ\xe91\xa1ND\xbc\"\x12xb\xd9\x05\xfaLS\xd1\xfb\xb5u\xe5\xe3\x95P'\xd4S$\xac\x0b[\x9f\xe0\xc9\xdd\x90\x03N\x94\x9f\xa0\x95a\x9f w\xd5\xcc\x9e\xa4\xf6iB\xbe\x99\x95\xb6Berj\xb7K\xe2\xb3\x19H\xdd\xbe\x8e\x80H\xa6\xc3\x7f\xda\x98\xe9\xb6FS\xeeg:s\xf0\xf0Kx\xf8\xc5\xc0\x90\xf0-\xc8\xa8\x8fw\x9a\xba\xaf\xfc+\x19\x05\x0b\xe5J\xa5\xc9A\x89K\xa5a\xf6\xe9U\xca\x8dv\\\xe7\x82<\xda\xdc\x7f\xbcC\xb7\xb9\x87>\xd5\xdeo\xd1i\x01\x95\xd6\xb7\xd8F\\O`NTr\xda\x18\x0f\xbf\xa3s\x92\xa0QdY\x00\x81\xf9\x08\xc3\n\\*\xcf\x16\xc4\xd1\x8c\xd5\x9e\xa3\xc8\xb6%\xcd\xf5(\xe8\xb3\xb1\xbb\x16\"t\xday\x15!\xc2\xd1\x90\x1c\x14\xf9\x14\x8eM\x92\x11f\x04\xad+\xa8\xe4\x8a`\xd8\xb2\x80`_\xea\x06\xdbd\x84\xc5]I\x08\xe5\xb4\xd0O!8U\x17\xb6\xa4hp\xf2!O1Y\xa0\xd6\xe4\xdas[\x0e>\xe7U\x84\x81\x00#\x87\xb7F\xb7\xe0\x97\xb6K[\x93y\xb3\xdd\xa9\n\x17\x94S\xd4v\x86\xfa\xe8W9|\x88\x93u\xfb/\xf6\xfc\x89J\xa6S\x0bk\xf3\xd8\xfe*\x0e\xa7\xd9\xe0i\xdd_\xaa2w\xbb\xaf\xd4\x07\xad^\xc2^\xb7\x92\x87\xbd\x9a+\x08T\xa9\x0f\x9aF\xcb\xe9@\x19CeO\xe2\xb5*	\x8eb\xd2\x0f\x17r:\xd3\x01\xc1\xde5\x8a\\\xaa\xd7%\xde\x10\xcc\x88\xcc\xe32\x81I\x8f\x8acY\xcf\x10>\xf6]\xd4z7'\x1c\xa3\x0e\xe7\x80\x0b*Y\x9e}U\xcc\xbe]\xea\xe0>\x86\xc9b\xa8(\xa0\xac\x1a\xf3\xbc\xa4\xa2q^\xdd\x90\x1e\xf2\xf5\x1e&{\x87\xa5\xe2|\xef3\x9f\xe5\xa8\xd6*&}\x08Q\xc4q\xa5\xcb\x88\x8f\xb8Jc\xc3\x8f\xbf\xf5QS\xa338\x84o\xaf\x96v\xfd\x1d\xcc\x9a\x10\xaca+\xae5\x16\xa9f\xe9\x17\x0d\x0c\xacS\x1fX\xc3\xf5\x1b\xbb\xbe\x88\x91s`\xd5#\x9f\x08\xac\xdb\x9c\x9f\x98\x01\xcah\xd6X\x87aI\x1cE\xd1\x80\x0fX\xacF\x87\xd93a\x8d\xc8\xd9\x1f\x1b^\xdc\xa9X\xe7\xfa\xc7\xc0\x9e\x8c\x1a\xd4\xec\xcc.T\x97\xb7d\x13\xd8x_[\x0e\"\xdd\xc4\x87\xff\xf6\xec\x95KjWd\x06\x8f\x0c\xbf\xbbd{\xf8\x8fD\xe2\x04\x1d\xc1\xc3/2\x95`A\xbaT\x9b;6\xc2'c\xc0\x98\xdf\xa5\xb5\xe1\xed\x0d9\xa72\xa7s\xca\x18p\xd6\x06\xb0\x1d.\xb2:Mm&\xe5\x97\xac K\xd6\x8b\xe8t\x92\xad\x83\xa7\x1a\xb9\x08\x02m\x8bX\xa4I\xd9\x86px\xf4f\nd\x02\xc5le\xdeh\x1dY`Y:\xf2\x1e\x96\xe4h\x07\xce\x88\xcd\x8a\xaa:\xb4\xcc\x81\x13z\x9e\x9e\x12\xe2n\xf2\x0e\x1c\xdf\"\x97\x8c/\xe1\xc52\x84\xda\xbf\xdc\xdd\x8d\xfb\xd8\x89\xdd3\x8e\x98/\xb6\xbeu\\P\xc5\x8f\xfc5\xc5T\x1d\x8f\x1d\xd0\xc4o\xfd-\xab'U\x8d\xe2\xe9\xe6\xa7\x87\x1f\xf9\xb8>\x15MK4\xa3h\x1a\xf5\xf7D\x0d\x14^\xa5\x83\xee\xd4q\xf3\x93^\xc1\xe2\xe1gS\x12\x9f\xe3J=\xa5\x14\xc0\xbb\x8b\x9c\x90';\xf6Im\x134\x9ars\x9f+\xf1\xf7\xe5\x90\xf7\x9c\xfe\xf9\xe8 qa\xf8\xa7\x93\x94\xbf\xa3\xb4m\xb2\xbeg\x07\x98\xe6\xc3>/?=\xf2;\xd0\xcd\x81\x02\x0dX\x8ey\xd1\xcdF_\x95\x89P+\xb3\xf2Y\x06\x15\x1c\x19\xd4\x10\xc1I\x8ae9\x05G\x95\xbd!\xe0\xbc\xacT\x01\xe86\x90\xe3\x89\x7f\xfc\xe3\x1fw\xbf.\x96hv\xbf\x0cm\xcdF#5y\x91\x07\xd2\n}a\x07!\x9f\xc7a$\x9e\x92\xdf8\xa5\xcbN\xb6\x97\x8a\xb6\xf5\xeb;k\x12\x15LZ\x8e^\x94'q\xca\x96\x87&\xd3\xdas\x19J\xcc\xe7\xa23\xd1\x91\xc6\xf3\xa0{\xfc\xa7\x0f\xd61;\x90\xd6k\xb6\x92\xe3\xd0\\9\x1f\xe0Fy5\xe2w\xfbZ4\x7f\xcbP\x96\xd1\x1cD\xcb\x13\xdbs\xbcUUSu(;\x87\xa6N\xd5\xfdv\"R\xe1\xed\x95\xfa\xf0\xc8\xf8\xd4\x07z\x92\xa2 \x1e\xd3\xe3`q~0\xa2\xfa^\x95a\xb9Ev\xcd\xb0'\xe9F\xccg7J\x8b\xe6\xfa/\x83\xb8\xe30R\xe1\xe8\x93\xba?1dw\x8d\xcb\"v?e\x7f\xec0%\xb1\x94\x16\xee6\xe1\xab\xa5u\xa1h\xc2\xbf\x82\x81-\xc9\xe1\xc8\xcc\xce\x07\xd0t\x0c\x18\x9b\xda0O\x8c-\xb7\xb3)\x87\xca\x94%\xc7\xf4\xb5T\xe2\x0d\x1b\x0e\x01\x1d<vB\x94\x83\xcaPe\x8d*\x84\xee\xb07\x93wL\xdfl\xf5J9\xd1\x08\x01\x0b\x89(}76[L\xeb\xbe\xe4\xebi\xf0\xb4q\x1d8^`\xfd \xe3X\xef$\x17\x98f\x8f`\xad\x02|\xf81#`\x83*\xb2\x0d\xc7a\\('\x98_\xf5\xa0\x86\x8a\xbcg\xc5K\xb6\xcf9\\\xe8k\x93\xae5\xca\xf6?\xe20W1@\xe6^\x05c\x18\x87\xa6e\x9f/\x8e\xbfsazso\xc2\xe6\x1eZ\xac7\xf7\xba\xdc\xdco\xee6\xf7\xa6D\xb8\xa1\x16\xc1\xb7\xa6Xc(\x960\xc3\x8fw\x1a3|Fk\x14;\xac.\x1f\xc9\xf5\xad.?-\xd7.\x178|\xa2\xb1\xda\xe5\x10\xd0uX\xaf\x87Z\xeb\x1c\xeb\x9a\xa3\xa4\x9d\xf7\x99\xae\x95\x86\xe7\x96\xeb\xeb\xda\xad\xd2\x1b\xe4q\x7f-\xb0\xdd-\xb2k\xc9\xefz\xe6\xeb,\xdd\x93\xc1X\xbfc\x9b<.\x8ai\xbbd\x1f\xb10\x11\xfd\xd3\xba\xeb*Y\x13\x9c\xd5l\x14\x8d\x0f\x8f{\xb6\xd6\xe4\x95K\xbd-\xbf\x8bO\xc9\x8eu\xfa0u\xac\xc6y\x08\xabj\xdf\xcc\xfaU\xf9H\xdf\xb1\xc2\xde\\~\xcb\x94\x84\xe9\x9dJ^q,\xcd\x85\xc1\xbb\xc6dY6|\xa6\xcc\n\x04\xef\x87\x1e\\\x9a\xc0\x91\x97\xcaX44l`\xfd\xdd\x80\xa0\xf8x\x0b\xad\x8a\xd5\x0e\xbc_r\xf5\x14\xcb\xd3r\n\x06o\xd4\x02\x03E\x05Y\x8e))\xe3\x03a\xae\x05\xb5k\x0cmu\x0b/\x18\xd6\xb5\xf1\xe2\xaeb=\xd7\xab^_\xbaq\x91\xb5\xc4R\x1a\x86\xc3%\x10W\\o\xe4\x86h\x8b\xd1\xb5\xdc3ls\n	:b\x95\x80\x9f\xe0\x15\xf9(\xf3\x98M\xdb\xf4\xd4\xaft\x13\x80\xe4\x82\xb7\xcb\xe4\x8e\xacy\x11\x00\xfdjX\xe1\xa4\xc9\xd8\xad\xb9\xdc\xc5\xdd\xa1\xe9\x98F\x9f\xed8\xc6X\xc3^\xf8\xb5m2\x83\xba\x8c1\x88\xdd6,\x053\xcd\xe0\xba\x05k\n\x02\xba!\xd7\xc2W\xbf\x07\xf4^-\x0c\xabc\xe7\xcf\xbb\xbe\x8a\xdc8Dqd\\g\xd7t;\xb8\xe7K*w\x0ftCp=\xdc\xc3w5\x9bc_jJ\x981>\xd0m\x005\x87\xe8z\xa5#\xa1\xc2\x12^\xfc\xcd\xbd\xe0\xac\xee\xc5\xdffY\xc6\xcf,\xdf\x9aQ\x1d\x8c\xa5\xe4\x91\x03\x0bi\xd4\xd4\xb9\xfd\xe5\xeb\x17J\xaew\x87\xf5	\xb0m\xda\x8e4\x06uC\xd7*\x0brW\x88a\xf7\xbf\xb0\xc2\xce6\xe4\xff\xe6\x1e\xbe\x02\x8f\x18\xe0\xe1gSP[*X\xa8\x99z|\x95\xec\xa8&\xccE$\xc3\xc7\x9ck\xdd^\x8e\x92\xb5o\x0f\xe9\x06k\x8d\xdf\x81\xc7\xcd\x1d\x04t8\x0f\xdd\x95[\xc2Ah>\xde5\x06\xe3\x14\xd4\x01\xbbH\x98\x91\xb66\xbc\xbb<\x1bv\xc0\x95\x1a\x03\xa6\xa3a\x17\xdd$\x8dJ7\x9d\xfd\x9d\x17\x9b\x92\xb7U\xc2vM\xa2\xdaYNf\xe2\xbd\xdf\xda\xbaUf\xd1rC4\xf0\x94Qd\x18]x\xbcAz\xdc\x03dx|r\x01keJ\xbb\x1eH\xfa<\xef\xbe\x1a\xae^\x8e\xac\x18\xe5\xac\xa9\xea\x18\xaa\xe35br<Y\xe0\x8eq!u\xebzE\xd9\xc99\xe8A\xfeWTp\x96-\xb0i\xe2\xa9C\x14EDu\xcd@\xd1\xeb>_Jw\x87L\x19.\xa9\xb0UE\xa6\x8c\x9ezFC\xf8\xf9\xfas\xd7\xb4\x1e\x9b\x82\x0c\xda\xecs\x9b\x82\xde\xec\xbfM;\xe9\xf0;\x85\xad\x9e.\xd4<yv\xe7[\x17\xea\xfb)\x96&l\xbaZODY\xeb\xf9\x18\xe4N\xbd1A\xe9\x18l$\x00\xcf\x82\x07t\xd4\xf5\x10U\x81Z\xb7\xa0\xad\xf4\xb9l\x93\x95\x1d~\x89\x95\x1a\xbb\xb3\xab\x014\xdd\x02|\xd6\xa9\xf9\xda*\x9d\xa7\x07\xb1*\xb8\xea\xc0\xb97Ms\x1f\x95\x01>\xe0|>\xbek\xbfb\x10\\gw\xed\x9d\x93\x92\xa7	\xb2\"^\xc5wZ\x19\xfbm\x84#\xc3b'6\xf2Kr\x89\xfe\x84_\x9a+M\xa9N\xc96\x16\xaf*\x87\xb5Ug\xdb\xfc\xb7\xdd\xdc\x83\xa7<\x93\x8dZ\x1d\xcf\xf3\x1co\xb3T\xf3VU\xf2\x0cG\xb4\xfe\xff\xf2X\"\x91x\xea\xb5\xc4\x88\xbb\xca\x12]e\xfe?\xb8\xff\x93\xa7\x121\x93/\xc9\x17N\xd5\xec\xc0\xd3\xe9\xe5v\x9fQ\x93*?)Q\xe6\x98\xd9\x8a\x92\x16ut\x93N\xd1*\xef6\x07\x87\x05Uv\xd8\x90\x00v\x182\x1d\x8f\x01\x0d\xdb\x94/\x1c\x91\xf1K\x1b\x92\xe7\x1ch\xb1\x81\x1d\xbc\xea)}\xa3>\xde)O\x1a\xd0\xad\xd8\xbfw!\xe7\x11\x9c\x0b\x00\xe9\xf2l\x93z\xb2;\xb1\x9fV\x81z\xf8Q\xa5\x9b\xa1-\xf5\x92\x8a#%Fo\xcd%\x87\xa3\x9e\xca{\xa9F\xbaT\xc8\x1ai,wJ\xbe?r\x08X\x96\x99\xff\x15\xa2T>^:\xb0\xa5Y\xf5\xab\x1a\xa5\xbf{O\xb3\xf3\x7f\xde&\xe5Y\xe3\x86\x18;a\xf1\x8fm,\x9b\x1e~\xdc\xdc\xaf\xc0\xea\n\xf5\xe6~\xdc\x12\xe3\x106\xba\xb2\xd9\xfc\xf4\xf1\x8e\x83Q\xd3\xc5\xca\xa1\xdf\xa6>hrS\x08\xc9V9\x01\x1f\x8e\xc6o\xee\xe7\x9b{\xa7\xf1	\xe2[\xcf\x1f~K\x16-\x9a\x92\x06\xcf\xf0\xd78\x9cf\x83Q\xfc\x96\x85\xcf\x9f\xfd0}\xfelbky\x1d\xd3\xbd\xb24\xf1\xb2|\xd2\xda\xf8\xf6o\x12U\x98As\x15\x9f%F@\xfc\xb4\xd4\xb9\x99tB\xaf\xa8%\xde\xe1\xe6.	Xxx\xeb\x02K\xa0s\xe7\x93\x03\x89\xd8\x9d\xaf\x98\x9c\xa1\x8fw\x16\x99\x03\x99t\x95V\x06\x12\xe5\x8c\xf7|\x11p\xc2\xfe5\x8e\x9f\x8b(&\x01\xfb[\x8c\xc9+2\x14{\xb0\x93\xab\x90n$\x93\xb5\xef\xbb\xe8s'\xc7\x9b;M&u\x06&\xdfl\xee=\xb6VG\xe1F\x82T\xd5\x9a\xeb\x99NB34\x17\xb9\xa5\xfd\xd56\xd95\x92\x04\xb226\xd2w\xbf\xf0\xbb0k\xe1\x8b\xe1~\x7fn\xb5\xb6k\x8ex\x8e\xd0[\xf3\xb2;$\x0e?34\xb1\xbe\x13gJl4_\xc8;2\xc5\x7fv:\xb3+\n\xebbyhA\xa2\x96\xdcMOc\x08;\xbd`?\xfaEj\xdc$\xf97j\xfc\x886\x7f?\x1b?<\x95\xda\x8c\xeb2\x05I\xd9\xc5\xe1VhpAU\xd7\xab\x9c`Y\xca\xc4\xb2Lc)\x1d$\xb6\n<V\x12\x12L\xe3\x84\x19\x8a\x02\x1d$\xaf6\x89\xd1\xfe\xaa\xa6\xc2'\x8eT\x07\x9f\xbdm\xf8\xd3\xc5\xf1\xab)\\\xbcy5\x95\xb7\x91\xd6\x89yK8\x8d\x91\x04\xaa\xd4\x0d,UER\xbez\xf6\xf9\x7f\xd8\xdb\xbb\xfdjoo\xda\xa3%\x00w\x9d\xc8?\xec\xed\xc17\x07\xf2aF\xde\x8a\x99r'm\xa8\x95\xfdE\xcf\x96\xbdy\x1b=w\x9b\x14K4\x0b\xca\xfa-\x93C\x81\x8c\xbb-\xf2\xee\xeb\xb0\x97f%\xc2\xe4\x8a\x7f\xc8\xf9\xf2\xdcb\xd6=\xc5\x9b\x14\x9a\x05Y\x08\xdd\xf47\xc1	]\x84\xf2\x9f\x08\xb3f\xae\x16\x8d\x8b\xa5\xb3 ;H\nz\xe3iW\xf1a\xc3h^\xf6\xd8a\")qFL\x869\xa5\x12\x83\x08\xe4\x08S%2\x89\xb1\xba_\x92W\xe8\xf9\x84.,u\x13\xc4\x0f\xe5\x13R\x9b\xaf\xc3\xcb\xf3\xc3\x88n\\\xd7[\x9b\x1cu\xff\x05\x13\xedCt\xe78\xfd\x15x\xf7\xce\x80\x11\xddk\x84\x84i\x8a\xd5\xdb\xa8\x9e'\xef\x0e\xbf\x81\xb7''\x11\x11/\xcc\x19\xfe*\xfe\x13\xa8\xdc\xf3MN\x8f\xba\x11\x99\xa0\x82\xe8\xc8i\xf7?b\xb4\xa6E\\~\x1a\xff\x8e\xba\xfa\x13N\xfc\x0e3m\xd2*{\"V\xe4\xba\xc5\x10e\x16\x8fl\x02\x1f\x19\xc0\xe7\xf4\xff\xeb\xbd\xbd\xdb\xaf\xff\x17\xfa\xaf\xed\xc2JC{r\x16\xffe\xd0\xf8.\x85\x11\x9c\xedK\x9b-\xbd8\x8f\xb3\xb6\xda\xa0\xd2i\xed\xc7\x8ff\\_\xcb\x19XW\xc4Kk\xdf\x9a\x82\xca\xe1\x11\xa9$\x10Rp\xce\x1a\xad\xfd2\xb5\xff\xb305y\x9b\xfeF\xf8\xda\xbc\xb1}\xffH\x9cq)U\x81]\x1b\xe9\xd4dm$\xe9\x8a\xf1\xac\x8b^\xc7\xea\xc6-z\x0d\xbc\xe0\x81(\xe0n\xa6\xa5\x8eJ\xe5\xa8\x08\xa7\x17<\xe72\x8d\xd8\xaf\x9f^\x8cg\xc4$j{V\xea\x9c\xc8\xcc\x18~\xaf\xad\xd5A\xd5\"\x08\xa5\xe3ejL\x93f-\xf8f\xf6w*\xc24\xbd\xc9u\xc9\x07KW\x86L\x81>>\xadg\xd5\xf6\x1a\xfd\x92\xfc\x0et\xca\xe2h\xd1ht\x1c?\\\xac\xf3R\xd4\x98x\n\x07\xe9B\xfd%L\xae(\x8c\xee\xd7\x19}\x16\x15\xb0C'}\x94<6;J\xbfD\xae\xccyR\xfc\x97A\x0f\xa4:H\x08\x98\xa9nI\n\xfe\x82\xe3l}\xf8\xd84!\xe6\xda\xbd\xa3\xb8\xca\xeb\xb94%\n\x85\xb1\xdfX\xd3$`k\xa2\xb3\xb4\xc6P\x11;\xaf\x01C\xe3\x07t\xef\x86\xdf\x19\xf5}#\x17g&\xbeQ\xc5\x82\xab\x85]y(\xc7A3K*BllM\xfaV\xd6$\xc4\xae\x90\xd4\xc9	\xd2\xd6\x11\xc2\xbf\x02iL\x8ar\xef\xe4\x8f\xc0b\x16,n7\xea\x06\xbcO\x90\xe7\xcf~x\xfe\xc3\xf3\xff	\x00\x00\xff\xffPK\x07\x08\xf5\x0cE\x94\x1a\x12\x00\x00\xe42\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/uk_UA/common.jsonUT\x05\x00\x01\xc8r\x08f\x8cW_o\x1b\xb9\x11\x7f\x96>\x05\xb1\x80\xe1; \xf0\xbd\xe7\xcd\x7f\x94\x8b\x00\xc7\x16$%A\xd1\xeb\x03\xbdKK\xacV\xcb\xed\x92\x1b\x9dq\x08`\xd9\xc8\xb5\x05\x82s\x9b\x0b\\\xe0\x0ewn\xfa\xd2>\xea\x14\xbb\xd6\xc5\x96\xfc\x15\x86\xdf\xa8\xe0\x90\x14WFz\xc8\x8b\xbd\x9c\xf9\x0d9\x1c\xfe\xe6\x8f\xbe\xa9\xd7\xa2CQ\x0c\xa9\x8a\x1e\x92o\xea\xb5Zt@\xb3\x8c%\xd1C\x12mm\xee\xed5v\xc8\xd6\xef\xc8\xfa\x9a\\'\x8f\xf6\xdbdM\xe2\xbf\xaf\xa25\xf9U\x14=0\xf8\x84\xa5LY\x83\x9d\xc6n\xa3\x1b,V\xf4mF\xa5\xc8>\x82\xc2\x0d\x03\x9c\x0fi\x8f\xed\x84=\x9bO6\xbfl\x90\x8f\xee\x8c\xd0N.x\xca\x8a*\xb8\xd3\xdao\xee6\xda\xf7\xe1\x19\x1b\xb5\x84T\xb2\x99u\xfb\x05\xa3h\xb0\x96\x90\x8c\x8dH.\xa4\xfaL~NxF\x14\xea6\xac\x89\x91\xcb\xcd,i\x9a\x93\xe4\xfe\x90+\xe7\xd5Z\xb2\xb4\xa1YB\xd6\x12\x82\xce\x98\xb5p\xa8\xb0\xc1\xc7\xedVqe\xd1c\x89q\xcf\xa0Z\xfb\x9d.i=m\x7fy/J\xd5\xb0\x17,\xe1\x05\x8bU\xb3\xe5-\x1am\xd2n\xec4\xdb\x8dm\x13\xa9\xee\xbe\x83\xaf\x06\xc1\x9b\x85\x10t\x1f\xb7\x1b\x9b;\x9f`*\xfb4\x11\xa3-\xee\xe9\xd1y\xbc\xb9\xb3\xff\x9cl5?\x85%6\xac\xbb\"\x1etE\xaf\x97\xb2\xea\xd1kr\xf5\xa02\x0b\x1c|\xba\xb7\xcaB\x8bx\xc1\xd9\x88%\x1d:d\xe1\xfa\x1d\xb2\xff\x88t\x1f7Hg\xf3I\x834[\xe4y\xa3\xdd \xcf\x9a\x8d\xe7\x15\xebz\xed\xe5\x83z-\xca\xd3\xb2\xa0\xa9\xf4\x94O\xe8Q\xf4\x90\xfc\xbe^\xab\xd5\"\xb8\x84+\x98\xeb\xd7x\x10.\xe7\xfa<\xaa\xd7j\x7f\xc0\x93\xfb\xa2,\x02\xf6=,\xe0\x12f0\x87\x89\xc7\x07\xd1,X\x0dyV*\xb6\xb4\xd3\xaf`\n3\xb8Y\xb1\xac\n+\x96\"S\xfdp\xe0\xad>\xd7c}\xa6\xbf\x0d\x0e\x06Q\xc5M\xc9b\x91%K;\xb7|PY\xc8\x00>b4\xdcI\x1f\xebs\xf8\xe0}:\x86\x05|p7\xf1\xb13\x94\xf6\x91\xa3\xc9\x90cRo\xe2\x07nG{\xc2H\xf4	,\xe0V\x9f:af\x93\x1f\xfe\x06sX\x98\x98\xc2\xad\xd5\xc4\"S\x05\x8d\x91\xf8\xdb\xfe{Ec\xb3\xaf\xaa'N\x840\xf6uN\xf1\xae\x11\xfc\x13\x16pm\x9e@\x1f\xc3\\\x9f\xea\x13\x98U1a\xa3\x06\xaeW\xb6\xe9\xf3\x84\xe1&\xef\xf4+X\xc0\x14&\xc1\xdc\xde\xd1\xdf\xe5\x8f4\xe3J\x14\xb8\xd1\x13\xc6\x13\xe1\xc4\xa5T{b\x84\xc8\xbf\xc2\x02~57\xb5\xaaT\xc4\x03\xcbg\xf7e\xdf\x96\xca\x01\xeeA\xe5\x80\x1c\xf2\x94et\xc8\x82\xaa\xdb5\xca6\xcbS\x1a3\xd4\xafKb d\xc4U\x9fp%I\x9f\xca>yA\xd3\xd2\x9b\x89\x84\x15t\xe9\x9b_Y\xa5\x18e\xcc*\xb6\x04-\x12\xb2o\xd6.\xb5\x19\xdbLS\xbc\xfd\x05\xbe\xf8\x04\xae\xed\xfd	L\xf5\x18\xae|\x05\xb0\xd7\xbb\x0frZ[\x89m\x0c\xe1\x0e#p\x03W\xfa\xd8U\x00\xcc\xfb\x8e\xe2\xf1\xe0\x08\x93\x1f\xd7DZ\x81K\xfc\x10\xa8\xe5\xb7\xa5\xa8(\x8d\xf03x\x03\xb3\xcf}\x16\xcb\xa3,v\xbc\x8d`\xaa\xcf\xe1r]\xbf5	\x0b\x93\x10z\xb8\xd3\xc70\xb3r}\xaa\xdf\xc2-,\xf4\xd8\xa5O\xa4\xc7&\xdd\xf4+$\xba!\xe5\xb5\xc7\xfc?\xbd\xa5F\xd8\x1f5\xd7\xbf\x89\xabc\x9eE\x8a\x0f\x99\xcf\x9b\x98\xa6,K*i\x07\xef\xf4\xb9\xfe3\x96\x9e3\x9f\xd9?\xea\xef0\x89\xde\xbb\xb3j\x11\xfc\xdd\xc4\x13\xae\xe0\xba\x8a\xfb\xc1\\^\x9fTE\xff\xd2\xc70\x81iU\xf4o4]\x11\xfd\x083\xb8\xab\n\xde!fE\xf4\xc6\x9e\xa8\xc7U\xe1\xb9\xb9\x9d>\xb9\xe7.\xcc\xf4\x18\x1d\xbe\x83	\\\x86\x92\xf8\xbd>\xd6\xa7\xae\xae\x9e\x85\xb23bl\x10\xee\xff\x13\\z\xfc\x05\xcc\x97\xa7\xeb\x93\xa5o\x8eH\xe6*AzQ\x01\xc0/\xd5:U\xf2e\x91\xca\xf3\xf4\xc8\xf1{\xd3|\x13%\x08MSbK\x19:s`Rb\x99\x1b^\xa6\x94\x18\"\x9f\xdf\x86\x07\x8fi\x16\xb3\xd4\xb1\xfc\x03L\xf4X\x9f\xaeV\x8b\x98*\x9a\x8a\x1eB~@\xc5\x04n\xcc;:u\xcaM\x17\xdc^\xee\xb3m\x04\xc6'\xb75\xa2\xec\xd8\xb4\xe5\xdd\xb2\x13\x119\x08\xde%\"\xc3z\xb5c\xfe\xa3\xc4\x94\x88\xae\x10\xbb\xb4\xe8\xa1\xe6\x11O\x19QB\x90\x14%\x0e\x93q\xd9\xb7)\x06\xdf\xc3\x02\xdfk\n\x0b7\xf0\xf4\x840I*2\xef\xfd\x8d\xe9\x06\x86\xd1\xfaD\xbf&p	7\xfa\x8c\xc0{}\n\xef\xe1\xc6H\xeeL\xfb\xc1\x1e\x89\x1b\xf0a.\n\xb5-\x8a\xa2\xcc\xb1\xa47Q@\x0e)OY\xb2A\xd0\xa7\xd8\xaa7\xaa&;\xee:\x0e/\xcb8fR\x1e\x96\xe9\x06\xe9\xf6\x19\xc9i\xcf\xd4\xbd4%\x99\x18\x91\x82\xa5b9\xa4\xf1\xfc1\x95}\xb4maE\xb4\xe2\x94\xdaa\n~FV\x9aT\\z\x99\x8a\x98*n;\xd2\xae\xff\xf6\x9aAe6\xb25\xca\x0e/\xc4\xe8\x02\x8a%]\xb1\x15\x18ra\xca\x0cL\xd7\xf5\x99\xa9\x8a\x86.&Z\xf8\xc7\xf7\xf8hH\x8bA\x87\xb1\xcc\x16\xfdb@\xa8$\xd2\xac\xad\xd6\xb4\x92g\\r\x91\xf9A\x10\xbb\x0by\x81\xb2\n\xc8\nl\x7fx\xc6\xd9(\x90\x99\x1c\x16bHT\x9f\x11i\x1aE\xb3e\x8e\xc0%KY\xac\x98\x1d?\xddV\xa5TO\xa8\x8a\xfb\xae\xa4O\xb0x\xdd\xe8s\x02\xb701\xb5G\xbf&zl^\x18\xa6>\xa7\x03\xcb36\n\x81\x82\x9f\x90F3\xf8\x95\xe8\x13\xacP6\x9b\xa3\\\xf0L\x19\xb2/S\xa2e$\x86\xed^\xe6pf2_\xf6g\x1c\xd4\xbf\xb0\xeb/\xba\xdd]\x072Sr\xf8!a\xbf\xc8\xa1(\x08j,\xe8O\xa5p\xc36\xbc1\xd9I\xe0\x17}j\xf2\x8f`+X\xe8oa\xe6X_)\xe3\xc5\xbd]\xbd\xd4N\xcb[G\xe1\xaam'\xc3\xa0\x1f\x1c9r\xac\xe2\x8d\xfb\xb6\x13.\xd1\xb9\x15\xad\xc0|\x87\xb7+I\x94\xc9S\x8c\x0d>Y\xceb~\xc8YB\xca\"\xf5\x86\x87\x05\xb3\\\x87\x9fq\x90\x9a\xe2ef^\x9d\xa7G\xf6\xe6\xa6\x1bb\x0f\x9e\xe2xX\x85\x88\xc2\xe6\xc5?\xf0\xc5\xaf\x0cJ\x1f\x1bD\x15\xa5\xca\"\x8b|\xab\x9fb\x0fp\x03\x95\x196\x1d\xaaL\xedk!\xf9'n|u\\\x97\x8c\x16Kj-\xf4_\xf4\xa9\x9b*#\xc9\xa4\xa1o\xe3\xeb\x9c\xbb\x9fm\xbb\xa2\xc73\xe2\xe4\x849\x85\x05\xf7\xc5hO(\x1e\xb3\xe0\x0d\xdem\x01\xb7f\xba\xc0\xacv\xfeHEU\x89\x0eu\xec\x97\x95\x96\x07C\xae\x1cI'p\x89\xf5\xea\xa6\xcad\x15\xf2\xbd\xf2\x96\xaa_\x0e\x0f2\xcaS\xee\x8a\xe1\x05Fk\xba\xae\xbfs\xd4\x99\xeb\xb3\x0dW\x83\x94\xc8\x11\xf3\x1f\x98\xc0\x9d\xe9\nV\\f\xbe\xde\xfa\x8c6\x03\x8c\xcd\xaf\xb7\xa6\x7f\x12\x98c7\x9f\xc0\x07}\x0es;\x01`\x1a\xe1\xb3\x8c]w\x8b\xcal\xe43\xf5\xa9\xfb\xac\xca\x03;\x9dv\x85\x95en\xaa\xa5\xa9\xbc\x88\xc0\x15\xce\x93Uu\xab\x10\xbd\x82I\xfb\x9e\xd7\xf8\x9a\x13\x98c\xe1\xfc\xaf\x0f\xf3\x86\xbf\xee\xbdC\x9fW\x8f\xac\xd7^\xd6_\xd6\xff\x17\x00\x00\xff\xffPK\x07\x08^G\xe1\xcd\x0e\x07\x00\x00X\x10\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/uk_UA/server.jsonUT\x05\x00\x01\xc8r\x08f\xbc[\xddo\x1b\xc9\x91\x7f\xb6\xff\x8a\x02\x0f\x0b\xdd\x01\x0c\xa5\xcd\xeeC\xe0\x87\x00\xfe^%\x96\xacX\xda\x18A\x90\x00\xcd\x99&\xd9\xd1\xb0\x9b\xe9\x9e\x11\xc5\x04\x01l)^;\x80\xb1\x0e\xb2{Z`\xb3\xc9f\x83\x03\xee\x1ei\xd9\xb4\xb4\xfa\xa0\xfe\x85\x9e\xff\xe8P\xd5=3=$\xe5\xf3\xdde\xf3bs\xfa\xa3\xba\xba\xbb>~U\xd5\xfa\xed\xd5+\x8d\x8e\xd2}\xd3\xb8\x06\xbf\xbdz\xe5J\xe3\xce\xf5\x9f4\xae\xc1\xcf\xaf^\xb9r\xa5a?\xb1\xe7\xf9\xef\xed4\x7fd\xcf\xec8\xff$?\xb0\xdf\xdas{\x0c\xf6\xa5=\xb5S{\x02\xf9\x9e\x9d\xd8\x93\xfcq\xbe\x97\xef7\x9an\xd2\x17vl/\xecq\xfe\x18\x07\xbe\xb6\xa7\xf9\x0b\x1c?\xb6\xe7\xf6\xdcN\xf2G\xf9>\xe4\x8f\xfd\x80\x93|\x1f\xec\x7f\xd8?\xda/\x91\xd2\x18\xf2\x83\xcb\x16<\xb3S\xfb\xda\x8e\xedi\xfe\x1c\x1b\xf2'`\x0f\xf3\x03{b\xa7\xf6\xbcq\xf5\xca\x95_\xe0\xe2\x8d\x1fm\xdc\xbe\xbb\xd5\xcb\xfam\xc9Db\xca}`3\xa4U\xbbc\xb4\xcf\xbbY\xc4 3\xdc\xc0C\xde\xde\x08F@{\x041\xef\xb0,I[p[\xb2v\xc2!\xed	\x03\xa2\x03#\x95\xc1\x90\xc9\x14R\x05&\x1b\x0c\x94NA%1\xd7\xd0\xd6jh\xb86M\xd8d\x1d\xa6\x05(\x0d\xd7\x07\x83\x84C\xccwD\xc4\x0d\xb0\x14\xd2\x1e\x07\xbe;\xe0\xd2pP\x1d\x90\xaaZ\x16R\xcd\xa4\x190\xcde4jU\xbbZ\xdf\xbc\xf3\xb0\xdc\xcb\xbaJ\x91<\x87;J\xc3C\xa5\xb7\xfdnn(\xa6c`I\xa2\x86\x06\xfa,\xe5Z\xb0\x04\xd2\x1eKA\x18\x90*\x05\x83\xb3R\x05m\x0e;\x82\x0fy\x0cB\x02\x83\xa1\xd2\xdb\xc0\xe5\x8e\xd0J\xf6\xb9L\xabuY2d#sOE\xdb\x95D\xe0\xe5\x1e\xda7\xf65^\xcaE\xfe\xc8\x1e\xdb\xc3\xa5\xfc\x85=\xca\xf7\xed\xa1\x1d\xe7{\xee\xd2\xa7\xf8\xcf\xb9\x1d\x172\xf1\xa5\x9d\xdaS\xec:\xb4'\xf6\xd4\x8e\xedk{b\xc7\x80\x12a\xc7\xf6\x04\xa7\xd9C\x9c\xd0|'\xaa\x15\x8bR\xc9Q_\x18^q\xf8G{\x8eB\x91\x1f\xd8\xb3\xfc \xa4P\xf0\xf25J\xae\x1d\xd7\x89\x1f\xe6\x8f\xf3\x03\x94\xaa\x0b;Eq&I=\xb0\x87\x90\xbf\xb0'@\xd2[\x11=\x0c\x18\xc8b\xa1~\xaa\x92\xac_\xb1p\x1d\xdb`\xc75\xbaE\xdd\x08\xbcp\x9a\x80'\xdf\xcf\x8c\x88\x80\xc9\x18vD\xcc\x15\x0c\x126\xe2\xda\x84\xa4S\xd5\x15\x9d\xda\xce\x90\x01;E\xb6\xf1\xb7;\xab	\x9e\xd5\xa7pw\xf5\x0en\xac\xd8\xa5\x1f\x1c\xeeq\xc1\xe0r\xb1vw-K\xab-\xe0\x07\xdc`\xd1vW\xabL\xc6\xf0Sd\xd1\x93\xa6>\x14\xe3v\xd5O[\x08\xa9\xb9	\x05\xb9K(]\x8f\xfbB~\xcf\x0cx$:\x82\xc7\xd0\xe7}n` \x06<\x06\x93j&\xba\xbd\x14\x84L\x15*\x9d\x86\xbe\x92\"U:X\x06e\xfe\xf6\xee@\xe8Q\xb5\x14\xe9\x01\xa7FHEy\x03\xebY\xbf\xcd5\xde@\xccF\x06\x86\"\xed\xa9,\x05\xc9\x870P&5\xd0\xe6\x1d\xa590 \xaa\xa841Ox\xca\xe3\x99\xf5\xd6Yp\xd5\xf6/(Hx\xc4h\xe3\xd0n\xbd\xae\x04-\xe8;\xa7K\x9b\xe6_\x90\x18\xe7\xcf\xec\x89=n\x81\xfd\xcaN\xed\x1b;A\xb58\xa4\xab\x1d\xe7\x7f\x08\xc5\xfd\xfd\x15\xb4|c{j'\xf6<\x7f\x8e\xb3PFO\xdd\x18{\x9e?\x0e\x1a\xf3\x03'\xb63\xfcn\x894\xe1u\x05~E\xdaH\x1c\xa1p\x97\x1c\x85\x16|f\xcc\xa2\x0d`\xeb\xd8\xab\xab\xd7jX*\xa5t\xe2L\xba\xfd\x8a\x94\xe7\x95\x1d/\xb5\xc0\xfe\x057\xfb2?@;\x9e?\xb3\x13x\x7fe\x05\xdd\xc1\xb1=\xa3\x95N\xeb\xda\xd5Vqx\xb3\xf1\xc8s\xb8\xc5wS\xc0N\xbcO\x14E\xbc\xc2jZ\xc4\x06i\xd4c\xd5\xa6\xbf$>\x9fV\xf6\xe8k{\x8c\xdc\xb9\x93>\xa1\x8b;v^\x8c\xd4\xe5)\xa9\xbe\xdb\x1a\x90\xd6\xbc\xca\x0f\xa8\xff\x91sK\xf9\x17\xdea\xa1\xa98\xa4\xf9\x13tp\xf9\x01\xe4{\xf9\x13\xfa|\x9d\xef\xcf\xb1\xb4\xc5\xba\x95[\xba\xe9\xda \xc5F\xbf1\xd65\xe4XT\xa6#\x0e\x1d\xa5\xc1\xcf\x04\xa3\x92,\x15J\x9a\x16\xdc\x97\x1c'\xa1\x8cj&c\xd5OF\x10\xf5\x94\xe1\x92fp\x16\xf5\xa0\xcb%\xd7,\xe5qA\xa0\x05\x0f\xf8\xaf3\xa1y\x8c\x0bDJ\xa6\x0c\xed\x7f\n	g&\x85\x0f \x16\x9d\x0e\xd7\x1c=\x1b\xeb\x9a\xc0\xffD=\xa67#\xa5+)\xba\xd9c\x9aE)\xd7`\x06\xac\x0f\x86:\xbdN\xcbTP\xe3\x90\x93\xfa\xaa\x0e\xf4U,:#!\xbb\xc0 *g\x92\xf3\xc1{k\xc1\xf5\x0e6\xf0\xdd\x88\xf3\x18\x87\xe1\x8d&\xa2/\x9c\xb7\xcc\x0c\xd70\x14I\x02\x92;\xe6\x8dJvPS\x8b\x9d\x05\xacf&U\xfd\x9b\x9b\x9b%\xab\x9e\xad\x8f\x0d\xd7\xdf\x8byGH\x1e\xc3\xcd\xcdM\xd0Y\xc2\x0d$\x8a\xc5<\x06%!U\x83B\x98\x0cOx\x84'\x97\xf6x\x9f/ \xbe\xa5\xba\xdd@\xa7nR;R-n\x91\xfa\x91q$\x19U\xdd%)\x0f.BF\xed\xe7v\x82\xd8\xc7\x9e\xe6{\xa8X\x0e[\x9dU2\xfbw\xf7	\xf9\x1f\xd0\x11\x1e\x91#F7\xb0g\xc7\xf6M\xbe\x9f\x7f\x9e\xef\xe5\xcf\xf3\xc7\x08\xb7.\x9c\xab,\xe9\xe5\xfbM\x12s\xef\x83\x17\x8a\xbcw\xc7\xceE\x8f\x89\xfe\xb9\x9d\xa2r\xa2|\x7f\xda\x9ac\xfe\x1e\x93\xdd\xcb\xb9/\xfcT\xc1\xfdW\xee\xd3s\x8f\xd6\xee\xb5\x9d\xda\x97\xa8P\xf6\x8d\x1d\xbf\x9d\xfbpm\x931\x1d\xf5\xc4Nu\xfe\xb7\x82\xb6\xca\xc7\xe7\xcf\xf2}\xb4\\G\xc12dE\xdd\x02\x01\xa1\x96\xd2\xdd`\x05a\x10\xef=Pm\x95V\xba\xba\xa1\xf9\x0e\xaaF\xa4\xd90A7\xdd\xac5\xa3\xaf\xee\x93\xb6\x0dy\xdb\x88\x94\x97\x03\x9b`\xb2\xa8\x07\xcc\x80\xe1\xb8\x1ep\xd9\x15\x92\x83\x901\xdf\xa5\xfe\x8eV}`Q\xc4\x8dq\xc2/\x8c\xf3A\xad9\xa6P\x8a\xc9\xc1U\x8c\xddr= \x95\xfc\x1eC\x7f\xea\xfdW\xa49CsQg\xd4\x00\x93#\\Le\xc8\xf5\x80\xe9\xd4\xad\x8fr\xbfD\xf3\x97\xcan\xeaptd\x97<e\xdb\xad]\xb1\xa5\xe4\x8ck9!\x08\xff\x94\xcc\xebq\x89\xa6B\xc7rB\x82\xe7A\x17JA\xd9`\xbf\xad&\x94K\xf0\x98\xcbr\x89\xdb1\x97\xf0\x80\xc5\xa2@\x10U\x03H5$\x0c\x85\xbc\xb6\x99\x94<\x00\nd\x8a\xda,IJJ\xff\xf2\x03\xfc,X\xb8@\xe1\xc4_\xf9A\xc1\xea7>D!\xef73\xcaN\x90U\x17\xdaxB\xf9\x13;\xc9\x9f9%;#\xd5y\x1d\xfa\xf73\xe7>\x8a\x8d;\xdf\xbe\xb2\x9809\x96\xef\xaf`\xf7\x11y\xd8\x13\xe7\x8d\x8e\xc8+#\x18\x98\x06\x92\xc1\xfbL$\xb7\xb5\xae\xce\x08\x1b\xe0\xb6\xd6\xaa\x94Ro\x8fh(\x1e\x0f\xa7\xce\x05D\xd6\x98H\xea\x84\x8as\xc6\x19\x8e\xc0\x82i\x1b\xcc\x04\x9a\xc2\x8c\x19*\x1d\xcf\xcf\x84\x81\xefZDB\xe9tf\x0b\x86\xeb\x1d\xae\x01\x03\xac\x05\xb4\x82\xde\x05\xe46\xb3\xf6Bj\x97\x132Y;V}&dHm\xb7\xc7\xd1MV\xa4v?r\x0d\xeflf\n\x12u\x1b\xc3w\x07L\xc6\xd7\x03y\xb4\x7f\xb3S{\x84\xa8+\x7fd\xcf\xf3}''\x7f\xc2\xa8\x04\xec\x17u\xda\xf6<\x7fQp\xb0p\xda\xa1\x9bv4?\xad\x85\xe1\xf6\xd8~K\x8e \x88\xb4\xf7\xf2}\x8c\x81\xd7\x9a\xb0q\xeb\x0e\xe4\x07\xb0\xb6\xf1\x81s\x07\xf9\xa3j\x05\x82L\x9f\x16\x86\xba\xb5\x901\xc0\x90\xde\xa1\xc5\xe30\x9e\x1a\xe7OH\xb2IC\xde \x08\xdcG\x84\x84\xc4\xe6\xd7\xd8#\xd7\xf4\xa2v\x15\x83PB\xec\xbf\xdb\x13\xca\"\xd0\x8c\xe20j\x8da\xe4sn\xc7\x1e\xbdM\xed\xb7\x04])\xac\xcb\x7f\xef\xce\xa2Z\xa6\xc3y\x8ca\x8d\x93\xfd\xc0\xb29\x8c7E\xe7\xe6]T\xfe\x0cY\xadK\x02\x01\xdeK|\xab\xcf\x84\x1c\xd5H=\xb7S\xdc65c\xbckOBg\xd7Ijp\x11\x8d\xb2\x1e\x81km\x16\xd6\x1fm\x1e\xe1(\xae!\n\x87 \xaa\xa1\xd0& \xa8t\xc4\xe3\xebR\xc9Z\x04C\x00\xdceV\xa6\x10\x06\xd0\xf8\xab\xd8\xe1\x9f\x08\xa4#\xd2\x1f\xe7\x9f\x03\xc5\x96/.\xc5\xceM\x84\xc1\x18@_\xd0}\xbf\xc6\x13\xa7\xc3A\x083\xf1\xf2W\x1cI \"\x15\xab]\x96$\\\x8f\xd6T\\y\x98\xbb\xae\x0d\xa8\xd1\xb1u_&#0=UDq\x1e\xd7\xa2\x95\x13}\xd6\xe5\xc1\xe6\xbb\x89j\xb3\xe4\xd6;\xb9wp\x83\x93\xd1w\xec\xe7=\x95@\xd0\xbbJ\x85\xd8\xd2~\x96\xef\xdbWv\x82\xd7\xf3NV\x07\xd5\xefU9\xe7\xa0\"\xdc\x13q\xe8J\xed7\xf9\x93\"\x93`\xa7\xd7`\xa5\xa0\xfeW\xf2\xde\x95\xb3\xca\x1fW\x03)\xe5\xe0o\xeb\xb8F\x9a\xdf\x10R\xf2\xb8$\xff\x91\x889\xdcr\x014l\x90\x146\xab\x0e\xf4\x98\xee\xbe(A\xd5c;\x1c\xda\x9c\xcb\xf9\x90\x1bI\xd7\xf2_4\x1f[P\xbc\x97Y\x92,\x87\x84\x1d@\xc1\x00\xa6\xcbc\xbc\x8c2\xf1\x85\x81\x11\xa5\xbaJ\xb8C\x93\xa1\xcfSF\xb3\xeak>\xe0Q\xa6\x8d\xd8\xe1I\x15\x80\x96m\xc4;\xf4\x04\x06+o\xd9\x96\xe6\x83D\xb8\x88\x85\x81;~7S\x07\xc4k\xabR\xee\xb2\x92J\xfb5\xe9\x96?\xfe\xd9$N \x0e\xf3\xf9\xac\x13R\xe2\x0b\xca\xb0\xfe|\xb3\xa7\x86\xbfp\x00\xc2\x03\x11rO\x15\xa5\x8a	\xa5\xc5oP\x85\x92u5\xdcph\xaa:\xfa\xb2s\x01\xd8\x9a\xb5H\x0bF\x80\x90	\xaa\x84fi\x8fk<#	&e\xd1v\xed\xc2\xcbEPj\xc2\xe5\xedg\xce\xd6\x90\xd0\xbb\xb0\xc7g\x83\x03\xe0h\x8f\xed\xb9}\x15j\x8ao\xf2\x89<\x0cM>\xf8A\xd4c\xb2ZRTb{OuE\x01\x99\x83\x01hI>R\x88\x1f\x16\xf9kb\xc59\xbfy\x85\xac\xa0\xef\x02W\xe9\xfd\x9ew\x07\xf5 \x88r\x0f\x84\xff\x0e\xed\x84\xa0\xa1\xcb/\xa0\x11\x0e\xf2/Py\x91\xd6\x0c\xc7\x0f\x94J\xef\xefp\xadE`CW\xb1\x07\xb4R)\xa8\xa2\xcf\xb1\xb8\xeas\xdb\xc2\xf4Pd{(\xa9\xce\x86z\xd3\x05\x86\x0f\x98f)\x87DE\x14\\@\"\xb61\x10\xbfyk\xbd	\\\xa6t\xab\x1c:Y\x92\xb8%X\x1ckn\x0c\xf4\xb8\xe6-\xb8\xc7Q\xd1y\x7f\x90\x8ep\x05\x8c\x8fq\xb8\x8f'\x8b\xc1-\xb8\xbd\xcb\xfa\x83\x84_\x83\xa5^\x9a\x0e\xcc\xb5\xe5e\xc7G\xcb\xa5\xec\x11K-\xcd\xec\xb5\x9e\x9fp\x9b|\x97\xdc\xc4\xc0	\x190\xe9\xf6\xfa\x8fLF\x88~\x1d\xb3|b\xcff\x11K\xd0\xf4v\xbcb\x8fJ\xb4\x12\"\x04\xa7QwD:#\x98\xf93{\\\xe6\xa4\xde\x0e\x16\x17\xa0Dp\xe8\xf1\x0cc\xb9\xc2\x0b8QE\xf3\xf1\xc8Y\x92S;\xb1o\x1cb\xa0\xcc|\x9d\xe7\xa9=	O\xe2\xd7q\x85\xc7W\x7fr\xeb\xc6;\xb92\x02\xd08\xb5\x0e\x9e\x7f\xc5(\x0d\x1c\x94w\x8a\x86f\xf8Y\x86\xb1\xab\xb7L\x0b\x8a1\x101	\nA\x83s5\xce^\x07\xac&Bn\x9b\x10\xf9M\xa90q\x9e\x1f\xe4\xcfJ\xf7\x87p\xa9\xd0\xf9b+\x9f\xfb\nUUR\xb0\xc7\xbed\xf5\xc2\x1d!%\x0c)3\x88\x91\\x\xdfGn\x15\\#p\xb2\xe1*\xf0\xc3\x1f\xfep\xf9C\xb4[\xcb\x15\xaf}f\"U]\xfd\x9a\xfbt\xfc\xf8\x98\xcf\x0d\x81\xd8Y\xe5\xd9\xa9\xa4'\xe5\xfc\xc2\x0e\x90\xf2x\xfdd\x86\xc4\xde\xaf\x14LO\xb3@\xdf\xd6\xe8\x93\xc0\x17\x97\x05\x07\xab\xb2\xa3t\xbf\xd2\x1a\xfcQ\xa2&\x07\xd3\xa8R\x15\xccD\x9f\xde\x11\xda\xa4\xb0#\x8c\xa8\xad\xb7\xfb\x11\xe9lu3\x7fv\xc5\x98|\x8fN\x1a#\xf5\xf2,\xf3O\x17\x07K_Q0\xed\xb2\xcd\x85\xf3\x18\x87\xd3\xc7\x8b\xd5\xa1\xc2\xee\xb5\x9c\x1b\xf5S\x903o\xf5C\xce7\xc5o\xf8\"\xbeC\x9dB\xd8v\xa9\xefX\xc47:\xbdY\xa5\x9cS\xa0C \xb8\xfd\xca\x8e\xa9\x02\xfb\xad\x8f\xc3\xfeu\xed\xc6\xbf\xd58|(\xe2\xb4\xb7\x90EgG\x8e]\x11v\xc1\xd9\xbc\xfdh\x83\xe9\xdf\xc5\xd1\xf2n\xb6\xf5\xd3J\x08\xdd\xa7c\x08\xb1\x8bO\x8e;PX\x94\x99\"W\xabB0B\x0e\x90\xa2'*\xbd\xcd\x12\xde\xec)\x9dFY\xfa],\xa0b\xaeY\xcd\x88\xadUM\xcdzC\xdd\x90U\xe3\xc8\x94\x85V\xac\x89@\xcb\x87\x82\x86*\x8b\xb1 \xef\x96\xa1K/\xda\xdb#\xd4D\xa1\xa1/y_I\x11\x11\xdd\x8a7Y\xabo}b\xcf\x96\xaa[v_\x1ew,\x0c\xdb$\x1f\x96	\xa0\x82\xc8:\x1f\x96\xa9\x9f9l\x85\x13\x94I\xc3x\x94r\x07\x88\xe9\xbe\xaeg\x0f\xffTO\x17\x96i\x86\x85yC\xa9R\x11\x05\x1b\xf9\xda\xe5\xd8\x88\xe3\x93Za\xda\xa3\xaf\x13;\xb9\xec\xfd\xc1\x04\x82\x14\xdd\xd4\x9e\xb9z\x9c\xf3\x94.\x19\x9f\xbf\xb0\x87d\xe8\x03\x04\xe7m\xcb$\x7f\x94?\xa1G\x0f\xf9\x1eyzt\xaa'a\xf8\x84\xacv\x84CTs\x0c\xcf\xad\xe8\x14\x0b5\xff\xa5\x9db\xac\x86\x86\x00\x1d\xef\x81=\xadb\xb8=R\xbbz\xf9\xf72\x82Ea \xff\x84\x0e\xb6\x0c\n\x81\xe6>+\x0f\x17\xc8\x8cL]\xbd\xee\x9c\xaa\\.Op\xe4\x8bb>\x96\x7fJ\xe8\x00\xd1\xc0\xf3@\xaeT\x12\xcfI\xc6\xfd$\xbe\\2\nxzsA\xe9\xac\x80\xb5P\xd4\xd0\xb6\xaa\x9c\xc8\x1a\x1b\x0c\x10\xb8\xa9N\x19\x0e**i\xcd\xf8\xb4\xa2\xa0F\xd5\x1f\xfc.\xeb\xd0\xb3\xd9w5\xa4\x00\xa7\\|\x18\xc4;\xf4QWQW\x82v\x93\\lK\x80\xd8\x85\xfe\x14\x0d&I\x91\xa5W2\xd5*A\xbd\xceL:_\x95S2\xcc\x0d\x0cf\xcfo\xe3\xb2\xb3Ce\xbf\xe9+\x00uh\x8c\xcaV\x16\x07\xde	\"\x97\x15\x00VV\xcb\xff\x91(\x19\xe9\xad\x12\x8c\xbdMY\xd1\n'\xbah\x91\x18\xbe'\xe46P\xbf\xa9j\x1a~\x00\"6\x1e\xbb\xc8:\x93\xb1G\x1a\xf4\x89]x\xbdQ\"\xa2\xed\x16<\xecq	\xbe\x94\x127A\xb2\x1d\xd1e)w\x02\xd2\xabS\x12\xd2\xa4\x9c\x85R0\xd0\x99\xe43\xd5\x97\x0dl+\xca\"\x8e+\x97\xf4(E\xaf\xccoH\x85?b*\xc0T\xaf\x0e:J\xc3:=I\x98Yh\xab\xa79\x9b[)\xf5\xad\xb5\xa5|\xe3%k\xb9u\x84\x9c_f\x94\x95\xd47\x93,\x05N\x0f\x9d\x8a\x1c\xf9-%\x97R`f\xbb\x9a\xa1\xa9\xf2R\xccy\xb0\xcc\x96\xabJ\x8c\xffzk\x19\xc6\xb9\xcbu\xd6\xe7\x1f\xa9,P\xa8\x07\xce\x8d\xa2\xe7\x81\x1e\xf54\x83\xf6d\x04JF\x1c\xf8\x0e\xd7#x\xff\xfb\xc0\x8c\x11]\x89\xe2\x88\x13(\xbb\xe2\xf3\x9fTSv\xc7\x11\xac\xda\xde\xe2\xbb\x95\x83y\xc0\xe3\xe5\x1bI\xc6\x81Z\xfd^\x1d<\x06\xcdc\xf2\x9bm\xecO\xf9n\n\xa2\x83W\xd4g)\xe5\xfcD\xda\x83\xa5_\xea%P\x1a\x96~\xd9\x0e\x82P\\\xf2\xbe\x0c\x92E\xf6\xcf\x14\xa6O\xc0\x17\xc3jO\x90fr\xa9\xb3\x8f\x08\xaa\x9ch\xfd9\x84\xe6]\x81\xfb\x0c\xf6\xe2\x1bf\xf5_\xf3\x84\xa5b\x87o\x89\xd0\x99;\x07zN\xb1\x05=UxJ\xb9_r\x0b\x0e)\xbe\xa9\n`\xef4\xb8\xc6(\x06\xee\xadk\xb0d?s)_\x8c1\x81\x1c\xcaY\xbe_;\xab\x01g\xe1\x85\xd0\xe7\xfc\x16\x06\xc9\xe8A\x0d\xf3\xff\xdc3u\x11\xf8\xf2\xe3_\xd0sBB\x88\x87\xf5|\xfc\xe3|\xbf\nsk	\xb1\xc5t\xca\xe7,Hi\x1ap\xa2T\xfa\xf1\x83{\x15\xc3J\xa5\x80\x0d\xcd\xdag\xf1|\x80\xf2\x08\xce\x85TO.P\xcf\x8d\xea\xfb\xde\"G<\xd0\n\xc1\xa2&\x0b4Tz;07\xf4@!\x80\x03\x9e/\x8a	\xcb]\x86\x8d\xc1\x0b\xa4E\x05\xf6O+dr8\xfb\x9e\x92n\xa8xQ\x89\x97]\xb1a\xc2\x08q\x13?\n\xf3@V\xa6\x9d\xf5\x07\xb4k\xa7u\x85%\x0d^S8G\xe7k\x11\xa5\xe7i\x85+$\x01\xfc\xfb\xc6=\x0e*v\xe8?\x83\x87\xa4\x13{d/\xec$\x7f\xea#\x84z\xa1\xc1\xbd\xd9\xdbs\x0f\x10\xce\xa9\xdc@\x18\x85\x86\xd3\xf3\xa5W\xa4d\x93\xea%O\x0b\xec\xdf\xec\xc4Wn\xb1\xefu\x00\x81\xf2'\x0e\xd0\x80}	\x1f\xd6\x9f*\x85\x10\xce\xb0,\xe2\x92\xa9\xe0\xa4\xb2\x88\xaf_\xbf\x1f\xca\xe3\xdb\x13\x1dP\xd0hE\xaa\x1fP\xe6\x06]\xde\xcc+7\xfb\x9f\xa8\x8c\x80\xfb\xcd\xbf@\xae&\xf4~\xf1\x8bb9\xdf}H\x0f&\xf3\x17\xbe\x10\xe72Ic{B\x86\x07\x95c\xcf\x17\xe2\x10\xe2\x8e\xed\xa1SV_\x80{\xea\x0e\xc0\xc5\x98\xa7vj_\x11t\x9dPQ\xa8\xe2\xaf\xc7\xfa\xa2\xee\x186\xab\xa6\xe6L\xc3[\xdd\x83\x19(\x91\x84@\xcbe\"6\x8b\xe6\xd0/\xf9\xb1sE\x1e\x93\xb2N\xa7\xfe\xe4m\x13\x9b\xc0\xb5\xd5\xcd=\xbd*\xa4\x19\x90b7\xfa\x8f\x12;\xf48\x8bC\xee\xd0\x1d\\b\xe1\xcbG\xd0\x8b\xed\xfb\xc2\xe0\x96\x82\x87\"\xa3wp\xe9\x9b\x1c$\x13\xf0@\x0f\x92J\x06\xfe^\x7f \x84\xab\x92`\x91\xc5\xbb\xb9\xb9\xe9\x9f\x10\x85	C\xa7\xa6N\x9a\xd6\xd8nIk\x8d\xed\x8a>=\x18&5\xfe\xff\xbc\x9f\xf4$\x16=\xa0\xac\xad.\xaa`gM\xc8\x7f\xc6\xea\x8b_B^\xf6\n2\x0c\x0d)\xc6<+\xc3G\xfa<\xaa\xbd\x92\xacQ\xa8\x96\xa4,\x9c\x97\xe0\xba1\xf7u\x1dw\xd5\x97\x94\x0f\xc2\x0c\xcb\xd7d\xb3\xf0\xc2O*\xafv\xf1\x8e\x84\xc2\xb4I\xaaY\xc4\xfb\xaa:	jhaK\xb3\xfe\x0dL\x8a>\x07\x13i\xce\xa5\xe9\xa9\xd4\xfb\xad\x8a\x16\x02\xfd\x1bw\x03\x84\xe1\x02\xbf\"?\xf5{4\xbe\xf3\x91\xef\xdbvt\xf8N\x14f\x18X\x98\xc7\x0c\x98\xa1\xaa\xfc\x98*5\xc7Da\xec\xc3\xe8\xff\x89\xfc\x90\xa5Q\xcfC\xf1\xfb\xf2\x01B\x92r\x99\x87\xd8W\x82o%\xa9\xdeW\x14\x8c\xaf\xbbB\xb1\x88X\x92\x8c\x80\xc5q\xe0 \x89(\x8f\xe7\xa7V\xcb\xf2v\xff\x9d\xeaM\x0fy{\xcd\xaf\x88?\xdd\xd3\x86\xd9\xaa\xd2\xa7\xff\xab\xaaR\xc0\x85\xd2\xdb\xb5\xfa;1\x11d\x1d\x1eQ	\xe0\xd8\x9e\x85x\xa4\x14Gg\xf9\x16\x17\x17\x16\xdd\xf2\xfc\xc23\xef,\xffY\xcb\x8f\x98\x8cye\x1a\x7f\xe6>\x9b\xc1G\x0d\xc5\xd1\xc4\xabW~\xd7\xbcz\xa5\xa1\x06\xf4D\xb7\xf8c\x1a\xe9\xde\xc75\xec\x1b\x82[\xe7\xaeN\xd7p\x9e\x8a\xb4\x8az/\xdc\xd33\xfb\x8a\x8a(E\xb2\x06\xc8\xab<\"\xbf\xe1\xfe\xb8\xa61\xf4y\xdaK\xa7T	W*\xfd\x13OF\xe9\x14O\xb3\xf0\xab%:xI\xee\xe5\xa2 ^4\x1fA\x91\xd8\xa3\x13{\xee`\xd3\xe2\x87r\xc5\xa4\xf9\x10\xc6w\x7f\xe9\xf0\x9b=\xa9\xea\xce\x0b\xde\xbc]:8\xf4\x90\x8d\xabt?\x8d\x94\xb5\xab\xad|V\x1a\xe0\xe2\xafM\x103\xee\xb9\xf7,\xfe\xfbm\xd8\xcb\x0d\xb9\x93I?\xf6\xbf\xbc\x82\x9d\xf8\x80\xadX\x93\xf7\x07	Kyy\xb3m&7B\x9b\xf33\x95\x05\xcf\x18\x08\xd9\xc4\xael\xba\xfc\x9eY\x86\xf6\x08\xdes\xc1>\xd5GU\x92\xa8!B \xcd\x99Q\xf2Z!\\\x88G\xdaL\xba\xd4	yA\x8e\xd6\xe1=\x83\x01\xa7\xc0\x1f\xad\xc2\xbeD\x91\xd2.\xf3\xa2\x80`\x0c=ck:L\xb3\xba\x81\x0e\xf0=\x9f\xd6\xf5r\x90\x89\xfa\xdfx5\xdc_^\xf9\x87\x04\x0d\x9f\xc2\xa2\x8e?\xd6 b\x11\xef\x9d\x93\xc5x\xe3~\x13t\xb4\xe1_<4X\x1c\xd3l_	+\x03\xde\x86\x0b\xd8	\x87\xe1\x80\xeb.~'\xe0\xe5\x06\xb4\x19)\xc2\x0d&\xcbo\xc9\xf5\xe6\x80G\x86&D\x11\x1f\xa4\x06\xb2\x01\xee\xf6\xfd\x95\x15\xf8\xd1\xc6\xed\xbbM\xd8X\xbf\xdb\x84\xbb\xabw\xf0t\xd0\xfcAG$\xdc\xa1\x03\xe8#\xa0\xc9\xfa\x10\x8b>\xa7\xa4\x91A\xf4\xf0\xc1\xca\xca\xee\xfb++\xcd\xb2\x1bg\x80\x11\xbf\xe1\xbe\x17~|\x832\x00\x92\xde\xe0\xcb\xb8\xe5\x19\x1a\x11\x7f\xce\xba\x07\x7f_P\xbc\xe1\xf7\xed=&\xbb<\xc8r\"\xc8\x08\xab\xa8\xf6\x02\xbd\x10\xbdj~^N\xd17\x8bs\xbf\xde\xa7$\xa2\xeaT\xcf\xe4M\x0d\x93\xb6\x8b?\x81hD	\x1ejD,\xf8\x9f\xbe\x9d3\xedZ\xf1\x87kS\xb2#\xba\x99v\xc9+b\xeb/>E\xfb\xac^w\xf6\x17ZX\x84r\xe2\xa6{<y\xf9L\x8a@\x1e\xb9l\xb7\x9f\x8b\x81^\xb0\xe27\xa5\x818^\xb8V\xccR:\xd0[\xf8\xbfk!\xfcVR\x08\xf3j\xe1\x80\xc2\xf7\x17\x03\xc84\x87\x03|y\xa1\xe8\xa7?\x15q\xdd\x99.r\xee\x8d[\xc5o\xeaq\xaaG\xb2w\xdb\xff\xa4\xf6\xe2y \xed\xc8\xe5\x10^\xa1a)\x04\xbd\x93E\xdb\xf7\x9d\x90\xdf\xf9\xf8\xe6\x8f\xe1\xfe\x9d;\xae\xc3='\xc3\xf6\xbb\xee\x17\xb5\xd2\xc3\x93\xc6\xea\xad\xe2\x8b\xcbT\xa4$i\xf6\xaf\x94jy\xe9\xc3\x01o\x0e\xfd\xb8$\xe1]Gl\xd5\xfd\xac\x15^\x1b\x89b\xf1\xcd@B\x13\x11m\xa3\xde`{\x91\x7f\xadF\n\xd9\x9d\xd336\xa7To\xd3\xa9\x0fWVv?\xfc?\xe8T\xa2\xba*s\xf6\x06#\x97o+{\xe1z\xdc\xf3\xd8\xa0\xb3(\xa9\x94\x7f\xaaW\x86K\x88t^\xe4O\xdc\xec\x99rJc=\xfc\x9e\x1b\xb1\xb5E\xb7\xa5t\xe4\xdeo\x99\x91\x8cxL\x19l\xca\xca\x10h\xa3\xccM;K\x12\xd3\x13\xfe\x94+O\xdf\xb0\x7f\xb5\x17.\x95\xe0\xbb\x86r]\x95\x89b\xda\x80\xff\xd3\x8a3\xf7\xc6\x07\x7f\x16\x88\x80\x8a\x9c^\x17\xfc\xc1\x0c\xbc\xc0n\x94\x92:\xc8t\xb7\x94\xe3\x0d\xfc 1^\x0ed]\xf3Xh\x1e\xa5\xab\x1b8\xe6\x81\xffB\xc7\xb3\xbaQ\x1f\xe1\xe0\xec\xec(\x9f5\xa5\x91\x0e\xd7l)\x95\xa4b@\xc7#\x12\xf7\xb6\xc7\x01\xd6\xf6\x08L\xd6\xfe\x15\x8f\xd2\xa6\xff+,\xed\xd3<\x94\x91\xe52b\xc6\xfdy)*\x8bI\x98\xe9q\xd3\x82B\xbc4\xeff	\xd3\xe8\xe0\xb4\xcbnx\xb7\xd60<\xbd\xe1_\x93]\x83\xc6&Ok\x8f\xcb\xb0\xfb\x9e\x13\xd9\xa2\xdbK0\x05*\xc1\x05\x9b\x1e\x8b\xd5\x90\x06\xb9_A\xeb\x0d\x8a;}\x07\xb4E1\xc5\xa3\xa4J\xb5\xe9M@\xfda\x0e\x89\xda\xc4e\xd8\x8e\n\xbc\xe1S\x11\xa5\x1d\xda\x0cS\x13~\x88;-\xa2\x1d\x06\xed\x0d\x94\xb6Fa\x1e\xe9\x196!0\xff\xd7\xaf\xf4\x1a\xb8\x1cW\xba\x89\x8f\xa5\xf8uFo&\xa4\xfb;%\x16\xa5b\x87/\xa7*e	\xfa~\xe7\xc8ib\xeaR\xdf\x8d2\xd9\xddH]J\xb8\xb1UD\xd7\x8dt4p-\xf8?\xb5d\xd2{\xe4\x8f\xe9\x07\xb5\xb9\xa8\x85\x9c\x80\x93 x\xe8[\xae^\xf9\xdd\xd5\xdf]\xfd\xef\x00\x00\x00\xff\xffPK\x07\x08~\xcd\xb4,\x01\x16\x00\x00J>\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/zh_TW/common.jsonUT\x05\x00\x01\xc8r\x08f\x8cW_o\xdbF\x12\x7f\x96>\x05!\xc0\xe8K\x81\xbe\xfb-\x8e]\xd4\x80\xdb\x18\xb6\xae\xc0\xe1z\x0f\x1bq-\xf1Lqu\xe42\xaaQ\x04\x90\x9d\xd8\x92l\xc5V\x1aY\x89\":v\x1c\xbbV\x93\xf3?5W)\x8e\x13\x7f\x97\x9a\xbb\x14\x9f\xf2\x15\x0e\xdcY\x8a\x94\x1b\xe0\xfa\xb63\xf3\x9b\x9d\xe5\xec\xfcf\x87?%\x13\xa9\x05b\xe6\x11M\x8d+?%\x13\x89\xd4]d\x18XM\x8d+\xa9\xc1\xfe\x1b\xe5\x8b1\xeb\x0b\x85\x9d-\xfb\x9b\xcdO\x975\xb6\xb9\xcb\xda{\xe3\xca\x98u]Z\xe6\xad\x15\x7f\xbb7\xae\xfc\x90\x1a\xb3~H\xa5\xbe\x0c\x9cU\xaccz\xd3\xbb\xf2\xdao\x1d\x8c\xd8\xe70\xb2\x88\x11\xa0&\xa7f\xa6\xd2S\x93\xca\xc4\xdf\x01\xfc\xf5\x9d9\xb1\x00\xb8\x96GY<\x19\xed\xc9\x9c\xa6W-\x7fvg\x01\x9d/\x10M\xc7\xe6g\xc1\xbc\xd3r\xfb\xebl\xbd\xec\x97\xb6x\xa75\xe8<\x03G\x03\x17g\x89E\xadi#\x9d31\x12\xaec\xaa\xc2\xaae\xde<\x1bt/y\xb3\xcc\x9c\xce\xa0\xb39x\xd3t\xfb\xdd\xeb\xd2\n\xf8\x15\x02\xa7[\x86:\x1d\x04\xb6\xee\xe45\x1a\x1e\xb2\xd7\xf5\x9ceo\xfbP\x81m\xe4\x1e?\xd7\x84|\xf9_o\xf5\xc8\xab\x96c\x9b\xfc__\x89\xb5\xcd,V\x83\xb3\x8a\xf4\nK\xf4u\xfdU\xbfu\x10\xbb\xa2\xf8\xad\x98X\xd5L\x9c\xa1\xd3\xb3\x81\xe7\xec\x9d\xf9\xf4\xd4\x9c2759=7u;H~\xfa\x8e\x84\x87\xd70\xea\x16\xe5%\xfd\xcd\xdc\xd4\xad\xc9\xbf\xe0j\xe5\x90J\x8a\x13\xda\x9fJ\xc9\x7f~>x\xba\xf5WJ\x89\x8a\xa83$\xb3\x98&\xd9\xac.\xf7	\xaf\x81\xf5\xba\xc1\x9ec\x96\xf2\xe9\xb2\xe65\xcec\xb1m\xe33%<8z\xe5\xb7\x0e\xa0\x90\x01vO\xc3E\xac\xce\xa3<\x86\xb4@\x1aw\x0f=g\xc3\xbdX\xf3\xda}V\xaf)\xd3\xb3\x8a\xf7\xfc\xa1\xbc\x85d\xe2\xfe\x97\xc9D\xaa\xa0\xdb&\xd2\xad\x90/*ZJ\x8d+\xffH&\x12\x89\x14\x7fz(6\x87U2\x91\xf8\xa7\x08\x95#\xb69\xc4\xb0\xb3-\xdeZ\x910)\x0c\x91y\xcd\xb0)\x8e\xb0\x955\xbf\xfe,\xc4\x82\x10a\x89AsQh\xa7\x12\x86v*\x11\xc8\xc2\x19b\xa8C\x94w\xf4\xb3D\x05\xab!j	\xa3\xd8\x01\xdf\xbd\x0d#\xbe{+0\xe1\x87\x07\xc5\x1a~6R\xf3\x9a`\xb0w\xf2\xd2\xab\xaf\xb1'/!\xad(KD!W\x1fI\xd9\x00\xa2\xb3\xda\x15\xabK]\x86\x18\xd4D\x19Q\xc7\xbc\xf1\xbb\xd7?\x19\xd5\x03\xa5\"+0\x190\xf8\xc7\x022\x80+\xe7\xdb~s#\xae\x8d\xfc\xc0\x16\xf7\xcbij\x90\xd7\x14\x14\xa0\xec\x19p2\xa7\x03\xe2\xbf\x90\xa1QbB\xe0\x9d+\xb6\xd1b\xeb\xbfz\xf55i\xb5-\xfa\x1d)\x8aO\xde\xfa8t\xd2If\x11j\xcd\xdfl\xb2\x93\xe7\xa0\xcd#k1\xd0}\x8b\xacEeA\xd3\xb1\x81\xf282\xa5\xd3\x81q\x0e\x17t\x94\xc1\xc2\xfe\x85\xa5\x04\x10\xa5\xa8\xd1\x9c\xa2QK\xc9!+\xa7\xdcC\xba\x1d\xba\x11\x15\x9b(v<\xb7\xff\x1e,\xa4h`\xd0z\xce\x06\xdf\xb9\xe2O\x96\xb9S\x1d\x94V%\x151\xbe\xa5\xeb\xc2I\x147[\xed\xf8\x0f:!M\xe1\x83\xfc\x97\xa7\xde\xc1\x85\xd4A\x0f\x15\x99\xf9S\xb3\xa4\x82\x8a\xf3T\xcb,.\x89\x88\x1fN\xfc\xbd\x95\x90wQ.\x02\xba\x85D[\"v\xa0\xfatYq?\xec}\xba\xac\x86<\xb2\x96\x8c\x8c,\xba\x14o\xf6\xbc\x9e\xcc\x9d_z\xe5\xf5\x9e\xbb\xfdc\x10Y\xbd\xc6\x8f\x0f#\xb1\xd7\x05\x0d\x88\x92\xd7\xa0I\x8arNQ-\x8f\xc32\xcd \x1d\x1bj\xac\xba\xaf\x1f\x1eETq/j1\xa1_\x8d\x04\xd6n\xc7a\x8d\x98e\xf58\xee\xf3 ny\x13\xb3\xbc\xdb\x89Y\x1e-\x8f\x08n\xbf4*\xc39\x86|,b\xbc\x181\xfb\xd9.wvc\xadE\xc8n\xbf4*_\xd4n\xd8\xab#2k\xb7o\xe0\x1b\xa3\xf6\xd5\xe38\xd7mmH\xf4BA_\x92\x15t+X+\x94(H\xd7\x15h\x07\xe2\xbcw	2\xc5\xbdO\x88\x85\xd4QJ\xf2\xa2\xeejU\xbf\xb2\x0d\xda\x0c22X\xec\xc5\xb6\x9a\xfc\xf7J\xa8\xa5H'YQQ\xed\x13\xbf\xf6P\xaau-\xe8\xfe\xb7\x87>\xfe\xfb\x17\xfc\xc9\xba\xfb\xfe0\xee\x0c3\xc5Dx\x04\x18\n\x80\n\x12@\x0cA}vR\xe3\x95:\xe8\x02\xce\xa5	\x99AfV\xd8\xf8\xeb\x06\x7fYa\x07\xaf\xd9\xc1Q\x8804+'\x9f\xe5\x98g\x96\x90\x80\x02\xc4\xc8F'R@\xa9\xf0\xe3\x1aow\x01\xa7\xe5\x0b\xc4\xa4\xb7\x89i\xda\x05\n\x1d\xf0\x94\xad\x1e\xb2\x83s\xbe\xfd\xf4\xba\xb4\"#\xf6\xba\xbc\xfe\x88\x9f\x96\x86s\x05\xf8M\x86g\x16N\xbcRg\xeb\xbb\xd7\xa5\x15\x7fo\xd9\xdf\xd9gge\xee<\xf0\xcb\x8fx\xf3\x8co\xbf\xf5\xeak\x91w\xe1\x1bd\x05\xafBjzV\xf4\x10P\xeb\x08\xa6\x06\xee\x94\xd8\xc7\x9a\xd4\x91\x0c\xa2\x1at\xe7\x99p\x1dZ\x16\xa3G\x9fU\xca|\xab=|y\xe3m\x0e\x08\x9f&\x13\xc3\xbb\x06+s:\xdc)\xb9\xfd\x0d\x7fg\x1f\x90yd.\xcecl@S4\x17\x15d)V \x83\x15k*\xf9^\xb34b\x84\xf3\x0d4\xe0\xc1/\xcd\x81|\xb2\x00tO\x80\xa0\x7f~\xaf\xe1bT\x89\xca\x82I\xf2\n\xcda\xc5\n\x1a\xe9\xf4l\x10B\x88X\xc7\x19\x8aU\x01\x93[\xd9\x16\xfd\x16\xd1\x8cH\x14;]\xf3\xf6/\xd9\xd5\xaa\xbfW	\x88\xd9y\x05 \x03\x17\xa3$\x04\xb3`\x98\x010\x17\x88f\xd0\xa08\x87\xa5\xcbkeV\x7f\x1c/`q\xb0\xe8U\x829\xe2+x\x96\xbeJ\xa7g$*\x18\xec\xa2\x91\x18V\xca\x021\x15a\x01\xd0\xbfm\"gD\xf7\xc3\xde`\xff\x0d\xbb\xdc\xf6\x1a\x1d\xf7B\xbeN\xe6\xd0\x1d\x06\xabP\x0b\x13\xdc\xc4R\xec:?\xbe\x88\xeeR\x94\x10;\xdbd\xf5\xc7\xf1w!\xf4\x0b.\x03\x9e\x829\xa9\x11I\xc4\xe6(,|\xce@\xb2\x14\x1a\xb0\x8a\x06}B\xe4\xbf\x803\xda\x82\x86U\xc56\xf5\xd0q\xc1\xc4P\xa6\xf1*\x0e\x8d\x05]<.\xac\xfd\x82\x97\xabC%1\xa9\xd4\xb2\xbd\xf3PKmS|\xf5\xe0\xaa\xc1\xda/\xa4\xd6\xd6!\xdf\xf0\xac\x0d~\xd9b\xe1\x14`ad\xc2\xad\xf3\xba\xc3\xce6B\xad\x15T\xd5\xd4\x8f\x05M\xfe;x\xad\xf7\x01\xed\x9c\x07\x83_\xcfY\xaf\xeb/orgW\x82s\xa4\xf8\x1d\xa1Z\x06\xf8\xb9\xfa\x1f\xf6x]Z(\xa2\xb6\x08<\x0f+\xd0\xdaw\xf3\x1a\x94\xf4V\xdd\xbd\x90\xbf+4\x9a\xa9a%\xb5v\xfe\xae\x814]\x83\xee\"g\x9f\xc6\xbe\xd7\xd8u\xfb\xc7\x7fl\x9c\xfe\xb1q*\xa1\xa4 	\xed\xf6\xd7\x874\xb3\x8d\xb0m\x85<r?\xecq\xa7\xea\xf6K\xc1\x0f\x8d\xf3\x1a:Y4\xd3J\xafbH\x86\xbf\xc9e\\\x1f\xab\x1d\xd1v\xfd\xa7\x0e\xff\xads\x83\x0evA'H\xfdZ\xd3EZ\x82?\xad\x95\xdf\xa0\xc5\xc5\xed\xb3&\xc9\x9a\xd8\x82\x19\xad\xd7\x05X\xfc\xb3n\x04\xbc\x19*\x99\xb8\x9f\xbc\x9f\xfc_\x00\x00\x00\xff\xffPK\x07\x08\xb2\x18\x02p\xef\x06\x00\x00\xb5\x0e\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/zh_TW/server.jsonUT\x05\x00\x01\xc8r\x08f\xb4:[S\x1b\xc9\xb9\xcf\xf6\xaf\xe8\xd2\xa9=~\xf1Y{\xb3\xfb\x90\xcaC\xaa|\xddu\xce\xdaK\x8c\x13\xd7\xa9TR5H\x03\x9aX\xccpfF\xc6$\x95*I KB\x12\xc26\x12\x16\xc8\\l\x90\x851 \xc0\x06!	\xf81L\xf7\xcc<\xf1\x17Nu\x7fs\xe9\x11\xf2n\xaaN\xf2\xa2\xd2|\xfd]\xfa\xf2\xdd\xbb\xff~\xf9RhXQG\xb5\xd0o\xd0\xdf/_\xba\x14\xba{\xe3\xf7\xa1\xdf\xa0?]\xbet\xe9R\xc88]%\xc9\x1d\xfb\xcd[\xf2\xe6\xd4\\\x98\"\x95\x0c\xde\x9a\x0f]ec\xb8\xd5\xb2\xeay\\.\xd9\xab\x05\x9c\x9d\xb7V\x1b\x14cc\x13\x1f\xa5\xf1\xcbB\x7f\xca\xcb\x97.\xfd\x99R\x87~7p\xe7\xfbG\xd1\xf8\xe8\x90,H1\xcd\x93G\xc1H\xf7\xe1 iT\x1c\x89\x87\x05\x14\xd7D\x0d=\x16\x87\x068\x0c44\x81\"\xe2\xb0\x10\x8f\xe9_\xa3;\xb20\x14\x13\x91\x1e\x954$\x0d\xa3	%\x8e\xc6\x05YG\xba\x82\xb4\xf8\xd8\x98\xa2\xeaH\x89ED\x15\x0d\xa9\xca\xb8&\xaa\xdaU4(\x0c\x0b\xaa\x84\x14\x15\xdd\x18\x1b\x8b\x89(\">\x95\xc2\xa2\x86\x04\x1d\xe9Q\x11\x89\xcf\xc6DY\x13\x912\x8cd\xc5\x17\x8btU\x90\xb51A\x15\xe5\xf0\xc4\xd7\xfe\xaa\x1e\x0c\xde}\xec\xad\xe5\x81\xa2S\xf6\"\xba\xab\xa8\xe8\xb1\xa2>q\xf7-\x9d\xb4\x1a\xbb$;k\xb4\xdb8\xfd\x1eo\x1f\x99\x0bSf-O\xde\x9c\xfa\x9c\x84\xd8\xb80\xa1\xfd\xa8\x84\x9fx\xfcH\xb3e'W\xec\x99\n\xde^\xc0\xb5\x06\xa9%\x8cV\xde~\xf3\xd6e[\xdc7\x8eO\xed\x85]k\xbed\xb4\x9f\x93F\xd5\xdc];\xef\x16\x8c\xa3eR\x9b\x042\xd29\xc1\xf92\xce6\xed\x95\xa4\xfd\xe6-n\x97\xed\xc9\x06'TV\xe4\x89QI\x13=\x99\xb8p\x8ag\x8b\xb8Pq\x8543$\x97 \xb5\x9cY\xed\x92J\xc6J\xa4\xed\xd5\x1ds\xadm\xa6\xda\x80\xca1\x8bG$\xe5\x8fJ,>\xea\xb3\xb3\x97\xf7\xedL\xc9\xe1e/\xef\x93F\n\xbf,\xe0\xe3]3\x97!/\xb7\xc8\xdc	\xae6\x8c\xd6\x96\xb90\x05\xa8g\x89\x14\xcfQWF\xa4a\x7fr\xf9\xb2Y\xdeD\xdf\xdf\xbb\x8b\xcc\xd66\xaey\x93\xbc\x00\xf7X\x0c\x8d\xdc\x8f\xeb\xdc\xf2\x9a\x19k\xb2@\xaa;0\x05\xfbM\xcd^\xde\xf7\x97\xdag\x8c\xe3\xf4G)\"*\x1e+\x1e\xd7\xe1`n\xaf\x9a\xb3\xcf\xf1\xabU\xdc\xcc\xe2\xdd\x8f\xe6\xc2\x94uz\x88\x17W\xcc\xc5OV\xb7e\x1c\xafP\xc8\xd2[|T\xe6\xd8*\x82\x1a\xb9\xf3lLR'<\xd6\xa0\x1bv\xe2\x84\xd4\x96I5eW^\xb9S\xac5p\xf6\x83]]\x03\x0c\x9c+\x92\xbd\x97\xa4\x963:\x1d\xe3\xb8L*Mk\x8f\x9e\x92\xb90\x85\xd76H\xb9\xd5#\xe6\x81\xc0\x1d\x8d\xc3b\xb6h6v\x1d\xf6\xa4\xd2\x04(e\xc0\x06\xce\x12)RK\xe0\xb5\x05\\\xda\xc1\x854\x9e\xddD\xdf\\G8\x91\xc7\xcd\x12\xde\xd9$\xf9\x9c\xd1J\xe2\xady\xb2S$\xd9\n)\xb7\xf0\xd6|\xe0\x08\x99\xdcG\x92\x1e\xeb\x15L\x1aU{\xb5\xd0G0\x0c\x9cw\x0bx\xb2D^\xef\xa0+7di4\x8e\xfe\x13\xdd\x17\xe4\x11\xe5\x8a7#\xf4\xcdu\x98\xc9\xd6\xbc\xf9\xb1\xde#4\xe2o&N\xbf'\x15\xf7\x84\xfc\xfd\x01\xa8G\x12\x16\xc6\xf4pT\xf0\x15wc\xde\xda\xca\x99o\xbb.a=IvS\xe6\\\x83d\x0f\xf0v\x81dg=\x0c\xa3\xb3n'\xf6\xac\xd5\x82\x99;\xc2\xdb\x0b\xe6\xc2\x94\xd1\xce\x93\xc9\xb4\xb7\x04\xab\xd1\xc1\xcf\xa7\xe9\xfe\xbc\xde\x013\xba \xf7\x910\xa2]\x94\x0d&\xed*\xd7\\\x83T\xba\xde\x98\xf5\xfe\x1d\xd9m\x93\xca\x11Y\xcd\xc2\xae\x99\xbbktsvJ8\x917\xe7\x96Iv\x96Z\x95\x8b\x8f\x9b\x19{\xa1A6\x96\xedd\x8b\xbc\xca\x18\xad\x04N\xe4=*X\x1e\x9c\xb0\x95\xd9\xc7\xcd\x17\xe8[\xba\xb9F\xab\x88g\x0b<\x7fn\xeaQA\x1d\x0c+*g][\xf38=IN7\xcdj\x17g\x9fS\x05\xf4\xf4\x16v\x9e\x9a\xfaa\xc3\xea\xee\x80| \xa0\xd3|\xbd\x07d\xe4\xe3\xb4\x9d)\xd2\x19\x1d\xa4\xed\xe4\x8c]\x9d\xc5\xd9\x03|B\xf5\x016\xdf\xae%\xacz\x12V\xef-.8\xaf\xb8\xa6+\xa3\xb7\x06\x07\xbdy\xb9\xb3\x98^\xb1\xba9\xeaIs	;\xd92Z\x1d{\xb5`\x1c\xe5\x8d\xd64u\xc8\x8c\xbd\x95\xf9@\xcf\xf0d\x03\xdd\x1a\x1cDV\xbd\x84s\x99>\xac\x1f)##\x9cJ\x07\xa8\\i\xd9\x0c)-\x06\xf9Q\xdd8>5\xe78\x17\xec\xc42~\xba\xf6\xca\xac\xd5\xd8\x82\xe9\xb9\xae\x93\x81\xc8d\x9a\xec'\x8dV\x1eVB\xb91$\xba9\xe5\x03\xbc\xfd\x96d\x0f\xcc\xcd\x1d\xf0	Vc\xcb<\xde&\xd5\xd4\x05Y?\n\xf2H\x8f0\xeb\xc3\x92\xd5H\xf4\x17f\xd5\x93\x9e<\xc0\xc3\x854\xcfT\x8b\x0bj8*=\xf5\xf7\xe36\x07\x03\x9e\x1c\xd6\xd7\x8a:\x82p\xadBC\xc0l\x0d7\xf3\x1c/I\xa3\x01\xfd\xa12\xa4\xe8\x9c=\xbc\xde#[o\xcd\xecGky\xcf\x9d\"\x03\xd1\xcd\xcd\x97\xcd\x83=s\xb3J\xa6_\xe1R\x05\x1f\xae\xe3\xf4\xe1y7\x8b\xeb)`\x8e\xbbe\xf2j\xc6\xfc\xf4\x16w\xcb\xb8\xda8\xef\xe6\xac\xc6\x07\\.\x91-\xc7\x89\x06t\xc7\x99\xc0\x1f4Q\xbdI=\x97?	\xb3\x9e$[o\xed7K\xbe\x93\xcf}\xc6\x9d\xb6\x13\xc7\xf9i\xa1+BdT\x92\xaf \xdc\xda'\xd9\x03\xe3(\x8f\xd7*\xf4\xb0\x98\x97\x06 \x10S\xbfw1\x15\x88(2gS\xcc\xd9\x80\xf5\xb8R\xe6kv%Gr\xaf\xecJ\x9e\x9e\xc9^\xd0\xa5\x88\x11Q\xf6\xc8\xefDD\x19=\x14\"\x92\xe2\x10\xfb\x00$+\xe3h,&LH\xf2\x08\x1a\x12dYT9&\xd2HT\x1f\x12b1\x8f\xd3\x7f\xfc\x9a~\"sk\x8e\xacf\x1df\x0e,*hQD\n\x19\xa3\xb3F\x97\xc30 A\xec\x1b?\x98\xdbv\xb0^\x16\xd0\xaf\xae\x7f\xd1\x91\x8b\xa3\x82\x14\xbb\xa3\xaa\xfer(\x00\xd9\x85\x1d\xeb\xc3Z\xc0\xce\xf0\xce\x94\x9dH\xc2\x00bX}\x19\xdd\x17\xa4X\x90\x99\xbb\xa7@)~\x91r@\xd0|e\xc0;\xcf\xfd\xd0\xc0\x93R\x0b\x87\xc1\xfeL\x14U\xefY\x8b\xd1m\x93Z\x11W\x1bvb\xc1jL\xe3\xe5\x95~l=,\xea+]\xc4\xbe\"\x06\xe3C_\x92\xf0K\x8c\xf1\xd6\xacy\xb0\x87\x97\x97\x83\x8c\x9fEEY\x17$\x9f\xeb\xb3\x1f\x00\x00\xec\xdc\xf1\x9f1k\xf1\xd9\x98 Gnp\xca\x84w\xcbv%\x0f\x99%\xd0\xb8\xa7ya\xe0,\x91z,\x0e\xdd?K$\x07n\xdfETa\xee\x0f|\x8b\x8c\xce:.M\x1b\xc7+\xd4\x07\x1c\xeezf`\xb4\x8a\xa46\x89K\xf38\xdb\xc4\xc7\xbb\xf62\xb5\xef@b\xc4\x98B\x8el\xbd\xdd\x04y=\x0b\x1e\xe3\x8f	\x17vp\xa6\xed\xe7\x88v\xb2e\xaf\xa4\x01\x8a\xb3M\xf2\x81\xd9\x83G=,\x8a\x91!!\xfc\x04\x94\xcbcR*\xda\xeby{\xf2\xb3\xb9\xed&[\x90G\xe3Z\xc3^I\xd9\x93\x0d\xa8\xa3 \x15v\xd2\x0c\xa0Y\xec\xe0\xadY{\xf2\xb3\xd19\xe0\xa4\xc4\xf8\x84\x01\xd7\xf2d~>\xc0\xd7K\xd7\xd9\x92\xe90\x17\x83\xa79F\x8a\x1a\x16#7d\xc5w\x19\xb8{Hc.d\xf7W]\xe7g\xce\xf9\xe4\xfe\x14g\x8bg\x89\xa4\xaeJca%\"j\xf4t\xfa\xcfwD\x88\xc5Du\xe2\xbe\x12\xe1<[\xad\x82\xdb\x9b\xa4\xb1\x8a\xbbn\x85\x80'\x9d\xf2\x02|\x05\x9cU\x1f\x177\x12S\x86\x84\xd8\xed\xbe\xd1b@\x15\x9f\x8a\xb2\x8e\xc2\xaa0\x1e\x13U\x0d\x01rl\xc2\x91\xe1\x8e\xd3\xe2bT\xd0\xc5\x08\x1a\x17\x874I\x17=\x8a\xabH\x8b\x87\xa3H\xd0\x90&\xd2\x98\x85DyD\x92E$\xc9\x11\xf1\x19\x1b\x1fV\x95Q$\x84\xc3\xa2\xa6Q\xe7\xc9\xeaO\x87\x0bW\x1c\x8e(\n\x9f$|\x0f\x9fW\xb9\x8f/\xd8KT\x8a\xf0>\x1cJ\xbc\xf3\xee\x02\xba\xee\xe6\xcb\xad\xb4]]\x03x\x9f\xed\x89J\x11\xf1\xa6$\xcbb\xa4\x87\x07>\xdcs\xea\x07\x8f\xe8\xea\x17\x86\xc1\x04\xfbq\x0e\xd4\xbc@\x88(\x0cAm\x8bk\x0dtM\x88\xc5\xae\xb9gz\xb2\x04\xdf\x88\xa6z,\xd4\x19\xad-\xa0\xb3\xden\x92F\xd5j\xbc6Sm$+:\xd2h\xf9<\xac\xa8h\\Q\x9f\xa0>\xb1\x91N\xe0\xa1\x18\x8e\xab\x9a\xf4T\x8c\xf9Y\xbe\x9d\\\xb2N?\xc1t\x81\xb7\xbb26\x00 \xc7\xad,.\x91L\x8e\xd6\xc40\x07\xd73\xf4[+\xebXh=\xab\x0d\xd4\x9e\xa0\xaf\xe7\xdd\x8e\xfb\xe7\x98\x14rv\xb6l\x9c<7:\xef\xc8\xe2ioE\x1aUT\xe9o\x8a\xac\x0b\xb1\x07\xca\xf8\x00\x84_O\xc0\x0f\xde`\x9f\xe8\xecvGnK\x1a\x1d\xe8\x87\x81$9FUU\x15\xf4\xa8\xa8\"=*\xc8H\xd3\x85\xf0\x131\xd2o\x06\x03\x8a\xa6\xf3\xe2\xa9\x13\x9a}\x11\xd0\x8co\x7f\x1d\xa6LH\xe6\xd0\xcaN\x1bG\xb4\xb0\xf19I\xbe\x82\x99\xd5\x0eN\xaf;D\x1c\xc6\xa80\"\xfe\xa0<\x15U\xde\xee\xa9\xcew^\xd8\xc9\x19p\xbf\xae\x97)\x1f8\xd0\x99\x0e-{\x1d\x07I\xb1iJZ?\xeea\xfbPQ\xf4\x9f\x9e\x8a\xaa*\x05\xbd\n\xe5\xberd.n\xdb\x85)k-\x83w6]U\xac\xa7\xc8R\xcd8^\xc1\xad\x02\xa9-\xe2f\x06\xb0\xad\xc6\xbc\xb9\xbdJ*]\xa8J\xbc\xa2\xc78.\x9a\xc7\xdb~)z\xeb\xf6\x83\xf3n\xc1\xea\xb6pz\x9d\xe6e\xe5O\xd4PV\x8e\x00\x8f\xba\xf2D\xd5z\xb7z\x96H\x99\xe5\xaa\xb9\xd16:\xeb\x90\xee;\x19=C\xa3\xa3;S\xc6I\xfe\xbc\xbbp%\xaa\xebc\xdao\xae]c\xeb\xd1\xbe\x86\xbe\x17\x8d\xa4Wz\xd6\xdaSh\xc1\"/\x16Z\xf4\xbb5I\x97\xd1\xfd\xecy\xcf\x7feq%\x8d^\x08\x8e\xde\xa9\xe3\x93%\x88\x86\x00\x85@\xc9\x912\xd5\xbc+\xe9=Y@ \xf2\x9b\xd9\x03\xbfRd\xd1\x1aO\x96\x8cV\x9d\xac\x1c\x91W\x0bVc\x0bo/X\x1fNI\xf9\x13^{\x8f\x9b%\x8e\xfd\xffF\xfc\xcc\xe7\xde\xefo\xdft\xf8R\xf8\xcf\xe4&\x7f\x15dIWT\xdf\xc6\xc9\x9bS\x9c\xaf\xe2\xe9\x0ds\xf6\xb9\xebn9\x10\x9dTk\xdf\xaa.\xa3{\xb7ij\xc1\x0d\xe1\xd2\x07\\\xda\xa1\x99	s\xa2\xb0\x8e\xc0\xee\xc5$\xf9	\x17\xb6\xd7*\xf6d\xc3.e\xc9\x8c\xbb\x85\xa4\xd2\xc4o\x97\xce\x12I`A\xb2\x15(\x97\x01\x15\xfd\xf6\xb7\xbf\xbd\xf6\x1d\xb5\xc7k\xffe\xaf.\xe1\xa5<.MZ\x89I_\xc0\xa8\xa0\x85\x15n\x83gs\xe6\xfa\xba\x99\xdb\x08\xe6\xca.\x14,\xac\x97\xfa\x1e\xd57\x8f\x85Ci\xd5\x93\xa0\xcaf\xaa\xed\xd1{\xe9\x14\xcfB\x8fs\x8a\xca7=\xdd9\xd4\x1a\x8e\xb2\xd5+\xe4\xe3\xaaS\x8dUS\xf8\xc5\xb4\xb9\xbcnm\xecA=\x07\x19@\x80\x9e\x13\xf2\xec\x07V\xa0\xf4\x18\x84\xbd\xf9\x1a\xb7\xeb\xa0\xd6\x8e4\xa35\x8dS\xfb\x9e)\xb0b\xe4=\xe0\x05\xf8\x0dJ\x7f\xeb5/P\xb0_\xe6\x06x\xe7\xdd\xec\xfd\x9b\xe7\xdd\\\x80\xebc)\xa2G{\xd9\xee|\xfc\xa7&	x\x1c;q$\xfe\xe8\x8f\x1e\xb3\xfb\xf0\xe9\xa8\x0dk\xa5:-4\xb7\x0b\x05\xed\x1ehPR\xdf\x94)\x9a\x95i\xaf\xe9\xda\xcbx0\xaa\xa8z8\xae\xff;\x04(\x11Q\x15z\x8d\xcchu|\xf32Z\x9d\x1e\xc32sY\xa3\xd5\xe9gOI\xdcL\xda3\x15/\xc3\xa5Igb\x81\x86\x8d\xe9\x0d\xab\xf1\x1a\xdd\xbb\x8dp1\x81\xb3\xcf=\x8c\x80\x0d\xca|\x134\xd0\xfe\xb4\xeaI\xbb:\x85\xa7WhZ\xcf\x97\x0c\x80\xe4s\x10\xc7i58\xae\xa8~\xf8\xa3\x86\xcb\x17\x85AlE\xd3y\xcc@\x80\x85:\xde\x87\xfa\x84\x8a.\x85\xb9\xa9\xa6?\xe2\x17\xd3n\xd6\xc1\xda\xf9P98	\xff\xc2\x94\xd9\\5\x97\xb7\xe0\x1e\x86$\xde[\x8di\x92\xdc	\xf2\x1b\x96\xc2\x82.q\xc9>Y-PF\x89\x05sy\xdd7PZ\x18u\xcb\xccL+\xb8\xb8O\xab\x9eJ\x1e\xbf\xcf#mB\x0e\x8f\x0bz8\x8aH5e\x16\xf7p\xa9\x12\xe0\xe0	Sb\x91\x0b{de\xa7\xbf\xb0G\x8a\x13\xc5o\xf5\xe9\x86Z\xf5\xe7\xd6\xab|\xff\x9e(ysje3\x00\xa2\x07\xd5,\xb2\xccn\xdeZm\x18'\xcf\xa1c\xea\xa8\xab\xdb^\xee\xdb@\x0d(\x882\xcer-/\xb1\x81v\xf5\xab$M\x87\x13i7\xd0\x06\xa1\xbd\xca\x1b\x1cu\xb4x\xbb\x80\xd3\x0d\xf0w~\xb7\x9b\xa5\xa4d\xe6=\xce\x1e\x9c%R\xf84m\xafd\xa1\x03\x0b\x1e\x10R\x92\xc0\x04\xc7z7\xf6\x0b\xbb:\xa6h\xfa-Ud\x07\x1e\xcc\x1f@\xd5p\xa7mn\xe6\xfb\xb5k\xd9\x00\x95\x9c\xcbxV\xf0\xaf\xcc\"\xe8\xcc\xee\xb1t\xe0\x0ek\x0dp\xc6\xd1\xa5\xce\xfes\x01\xb2\x01'\x9fO\xbc3?\xcf\xfaI\xa2\xddY\"\xaf\xa6\xf91\x1a>j\x0d\xa3\x95'\x95#\xa0\x07\xb8\x97\xd5\xb3\x94\xec\xc0i\x9cUS4\xa5\xcbeps\x06\xcf\xbe\xa0\x85\xc0\x05dn\xaaj\\\x16{z\x81\xfc\x1d\x8c\x17YY\xbcf\x9dW\xcf\x96\xed\xe4\x1cz\x80\xf0\xdaF\x9fB\x86\xf1}\x14UE\xe1\x02c\xab1cmV\x8c\xd6^\x1f\xde\xd0C\xbc\xc8\xde\xa7\xf1%L\xc4\xfd\x03/o\xe0\x8d:./\xe3|\xd9\xef\x04\x19\xad\xa2UO\xe22\x97E\xa9\xacU\xe8\x92=\xbc&\\\xf3[\x87\xce\xd7\xcf\xf6\x0dUA\x8e(\xa3\x0f\x84Q\xf1\x07%\xceY\xd1C\x06G\xd4\xf9\xa2(\x1b\xb9\xca\xc1c\x13H\x91\xc3\"\x12\x9f\x8a\xea\x04\xfa\xe6WH\xd04iDF\x02\x10\xe8\n\x12\x10\xd5\x19QE\x12\x85\xeal\xdf8\xa9C\x8f\xc4g\xbe\x8b5?\xa5\xafY\xf3E\x14\xb8\xbdv\xdc\xe4'\x1a/\xac\xf9\xa2wE\x0dE\x81\xb5\xb1\x07\x9f\xe6\\\x03]\xf9\x8bz\x05\x91l\x05]\xf9\xcb\xd0\x15DV\xba\xb8[\xc2\x05\xae\x86\xa3\xd2\x7f\x92\xb9\xf2\x13\xcf\xedX\xdb^\xff\x9c\xa9\x19oc<\xe5\x88D\xd7\xe1\x9f\x0c\xbb\x1c\xba`\xbb\xaa\x18\x13t\xe9\xa9\xf8H\xe2\xaf\xec\x16[\xd4\xc5\xb1\x1bA\x92u\xef-{\xa0g\x89\x94q\x92\xc7\xf5\xd4yw\xe1,Q\xf8\x06\xe1&M\xaf\xa8\x03\xca\x15\xcf\x12E^\xc6\x98(\xf8\xbbfg\x8a\xd6Z\xa6\xcfL\xc6b\x13\x0f\x03\xd9\xd6\x9f\xf0\xe2\x92U\x7f\xfeg\x84k\x0d\\\xda\xb7S\xee\xfciE\xc5\x86\xa0\x066\xdfw\x02\xb7\xcf\xa5}\x9c\xfc\xc4qV\x14\xfd\x0f\x0f\x7f\xf4\x8d\x7f\xe5\x08\xd1\xef\xab\xae\x8f\xc2\xb5\n\xe8\xb5\x99\xcbB\xa5E\xc7\xbfF\xd4\x0e\xda\x8b|>OJ\xb3\xc6\xc9\".?\x07\xdf\x83\xb7'I\xae\x08n\xd7N\xe6\x8d\xe3Z\xc0\xa6\xd5xL\xe4\x03\x0c\xbb\xe4q\xa4\xd6\x934\xfe1\x10i\xac\x9a\x9f\xa7\xcc\x0f\xf3\xb8\xf4\xce\x0b\xb24\xe4\xd49\x83\xd1\xf8d\xd9JV\x8cv\xdb\x8f\xa3p\xfa\xa0	\xf4\x0c\xc0\xde\x9a\x8eS\xb5WR8\xdb\xf4,\x97\xfaX\x16\xd0\x03s\xd5\x84\x18\x97\xe6n\xe7p\xbaa\x1f\x1d\xbb\x9avt\x0cA\x0e\x06\xcc\x85)\xe46\xe3\x10~Y\x80\x84\xc8\xfcXg\x0d\xbbe\x92\x9d\xa5!\xa6\xd3\xb6\xb6\x9av\xf9\x10\xb7\xeb\x10h\xccT\x1b}\xf7\xa5\x16\xbc&\xc4\xc3\xa2,\xf8>a\x90\x02\x1e\xdc\xf8\xc9\x99\x82;\xfeuX\x19\xfdB\x89\xa5\x89\x9a&)r\xcf%7\xdc\x83\x90\xda\xa4\xb5\xb1K\x13.\xb8\xea.\x1f\xbaZ\x0d\x0dF\x86\xe4\xdc\xf3T;8\xd3\x06=\xees\xc3\xadE\x85Q)\xe8\xbe\x06}\xd0\xd5\x1e\xc0\xcf:1mL\x91b|\x0e\xe0d\xee\xd3\x19;Q\x82\x86\x95\xe7\x9a\xd7\xbd.\x02iTi\"\xcf#\xf9\x1cuax8x\x0d\x0e\x95\xa3\x95<4\x8e\x8b\\\xe6\x05\xba\xc2\xee\xc0\x8d\xd6\x16\xa8\x1d\xbc\x1c\x08\x10x\x9cu\xf1\x99\x1etE\x93i\xbb:\x1bt}\x10\xf7Xm\x0e\xb5\x06G\x1f\x159\x0f\x13\xbctd7\xc5\xec\xe2\xd2\x81sT\xd4\x05\xc2\x89\xde\x17\x9e\xf9\x8bb\xa5\x8b\xa7\xd2\xfe\xb9\xfaO\x18\x82!\xce8\xca{\xaf\x18~\xee\xfdB@\xa0$\x07\x046K\xff\x0e\x81\xbf\xf4f\xc1K\xe1 \xed&\xa5\x92u\xda$\x89\xf7\x0e\x92\xcf\x88]\x15\x0f\x82J}I\xa3\xfc\x94\x96\x16R\x95<./{\xe9\x88S\xb4\xf3\xa8>sU\x08\x8b\xa3\x8a?Q\x06\xf8\x9aB\xae\x06\xbf\x11\xce\x97Iw\x93d?\xe0Z\xa5\xd7B\xe3\x9a\xa8\xde\xfc\xdewc\xee\xa55\xbcq\xf162p\xa1\xed\x8c\xf5\xf0\xe8\xdb<0\xe7\x1a\xc6q\x0d\x08\xfa\xf4\x0cXA\xe1\xa4B?\xc9\x0fi\xbc\xe1\xf6\xa9\xe1D\x94j\xca\x9e\xaf\x91\xfd\xc6\x85\xec\x88\xc3\x00GA\x1d\xac\x8bD\x0e;P\xd0\x011\x9f)\x19\xad-n\x0e\xe2\xd0h\xb0M\xf9X\x1c\xba\x8f\xfe\xd9.%b\xd8\xd0\xa5<K\xa4l\x16\x85\xa0\xb2;K\x14.v<\xcf\x12\xc5\x80\x97\x1dW\xd4'\xc1\xcb\x91\xc3u\xe3\xb8\x16\xb8\x1cqz\xf4\xa08/\x0b`\xd4\xce\xab\x85\x9e\xb3p\xd9\xf5<S\xf8\xff1\x9d\x10\xe4\x88\xe8\x9b\xfa\xff\xc0\xe7U\xee\xe3\xa2\xff\xbf|\xe9\x1fW/_\n)c\xb4\x06\xf1\x1e\x1c\xcap\xc1\x1d2\xa7VC\xe0\x1e\xc3\xaa\xc8.<B\xe6\xc7:\x9e\xcd:\x8f\xa4`-N\x0f\xc5\x19\xf2\xda\"\x8c\xb1\xa6\xa8:]\xa8\xeb\xaaC\xf6J\nb\xad\xeb\x00\x98\x878)\xf8J\xe4\xc2\x9d\xea\x87\x87\xb0\x8b\x01Rn\xb9O\xd6B\xe02\x1d\xc8e\xb6\x0f!]\xf0.\x04BF+ae?\x02\xae\xfd\xb6AV\xba\x0e'~\x1f\x9cY\xe4\xac\x83w\xe6\xc2\x94\xf3Y<\xc4'/<\x96\xe2\xe8XL\xd0Eo\x83\x86\x04y\x80\xb7#\xe3x\x85]\xab|\xa5]C\xb46g\x0d\x10Vo\xed\xa2\xaf4Z\xcd,\xae\x98)\xd6on\xe5\xf1\xcc2^\\9\xef.8g\x03\xd1\x03hp3Cj\x93\x94\xd7W\x1a\xcbp\xbf\xd2\x10>)\xc0S3\xaa\x91@\x02\xddV\x92}\x81\x13/\xcc\x85)\xef\x92\xf8\xbc[\x00n\xe8\xde\x00\"\xafw\xd0W\x9a\xab\xc6\xce\x81\xc4\xa5\xe0\xabR\xe7A)\xacZ\x08\x87\x95\xb8L\xd3\x99\x10T\xcb\xf8e\xc1q\xa3\xecQ\x85\x83\x15\xa1\xf5\xa0\xd3\nu@\xac\x1c\x18\xa4A\x94\x0d\x152\xe4\xd3\x89\x99\xcb\xe2|\xd5h\xb7\xf1+G\x8d\x86\x04\xa6C\xb0T\x0f$\x8b\xea\xe0\x98\x18\xd6\x18\xa5\xfb\x18\x81\xcc\xac\xe3\xd2\xbc\xfb\x18\x01\xfdn\xe0\xce\xf7g\x89\xe4\xc0\x03\xfa\xfb\xfd\xbd\xbblo\xc0\x03T2F\xe7\xe0\xbc[p\xbar\xcd6\xdei\xd1\x8c\xe9\xdb\xeb\xd7\x9f}s\xfd\xba7\x02\x88\xd0\x0ct\xc6\xd1\x7f\xdf\xa4\x874\xb5j%\xf7\xec\xe5}w\x7fCC\x13L\x9f\xe7\xa0\xf1\xc4\xbd=\xebyv\x16\nG\x05yD\xe4\x1a)!\xb2\xf8\x89\xcc\x1dq\x05?{\x86u\xcb\xdd\xd6\x1b\xa3\xf4\x1fR\x86\x11\x05\x0ba\x1d\xee\x84\xd8\xd3VZ@!\xf64\x0e\xe8btW\xc3\x94\xea\x96\xf3\xd7\x81\x8b\x82\xcaD\xb1KF\x07\xa8\xc8\xc3\xd2H\\\x85J\x98\xcd4]4\x8f\xb7\xb9\x1a\xd8\xc7\x19\x14Up\xa4\x0eR\xf0\x01B(\xac\x8a\x82\xee3\x02;\xe4\x19E\x04\x9dm\x86\xb5\x9f!\x95\xaa\x03\x13c\"O\xd4[\x81;\x08n\xf8q\x10\xb8\xa7\x05\x0e\x82\xd3\x8a\x0b\xf1-Eg<\xae\xba\xad\xb1\x10\x97\x89\x86D\x9as0\xd3\x0c\xd9\xc9\x19R[\x06\xb0{\xcf\xcf\x98\xb1\x9bz\x07\x1e\x0f?\xf9\xc9Q\xd3\xce\x89\x1b6\x9c\x1bk\x86\x9cn\x98\xb3\x93\x00e\xf7g\xa1{\xb7\xdd/Q\xd6%\x9d)\x87\xd5\xde\xc4Y\xc7 \xa4XL\x1c\x01b\xfb\xcd\x12\xd9/s\xfd\xf4PL\x11\"\xb7|\x05\xc2\x95m\xf2j\x9a&\xc9\xec\xc9V\x8f>QdI\x1e\xf1\xcd\x81YA\xaff\x7fw\xfd\xfa\xb3\xef~Q\xb3\x91\xd1\x9a\xf3T\x9b\xba\x84>f\xe3i|L\x19QXo9\x04Y<\x0f\x85w!!x\x97G\xfd\xe4\xbb7TiZ\xd3<jO\xf32\xc4u,\x03c\x8f\x1e\xd1\xd1\xbb\x8a\x1a\x16\x91\x10\x8b\xb1\x86\xa5\x18A49\xd1\x90\xae \x9ad M\x19\x15\xd1P<\x16\xd3\xa2\x92\x0e<\xfc\x08\x15r\xee\xad\x00<.?P\xbc\xee\x0fu\xa5N\xe6\xc8Z{\xd0\x8c\xe1\xb5p\xccQ/^\xb1\xc6\xe2\xea\x88\xa7w`T\x08\xc6\xaf\x99\xe9\xf7\x9e~\xaabDR\xc5\xb0~o\x80\xe2=t\xbe\xd0\xd0\x04\xba7\x10\xc4\x80T\xa9\x17\xcb\xe9\x890Lx\xb7\xf0HQb\xba4\xc6&\xdeY\x87\xbc\xf4,\x91\x84\xa7\xaf$[\xc1\x85\xb4\xf5\xee\xc8z1\x8fKER\xa9\x99\x87\x0b^\x9f\nZ\xde\xc6\xc9s\xaa\xf2\xa7'g\x89\x94\xa3*[\xef\xacz\xc9Zm\xd8\xc99\xdc-y\xe7\xab\x89\xfaM\xe7\x8e\x9a\xae\x1e\x9e\x1e\xb2\x17-\xde\xf8\x8f\xa0{\xfe\xb8\xf3\x1c\x93\xbd\xddv\xb0\xa2BD\x19\xa7\x18\x83\xf0\x8f\x83\xde\x94\xe0\xdc\xe1\x06\xdeyE\xe3\xc5\x7fP\xe6\x97\xb8\xed\x97\x02F\xc7Q\x0f\xa7\x9a\xf3]\x03\xcb\xa4y\xd7\xa0\xc5\x87\xfe*\x86\xe1|\xfc\x14?Du\x07t\xed\x1d\x99Y7\xf3	\x92\xce\xfb#\x9e\xcf5ZEh\x9dP# \x9f:V\xbbx\xcdl\x1d\xe3\xf4.\xb2\x13\xef\xcc\xc3\x05\x16,\xb9\xacB\x87^U\x88\xab\xd1B:\xf4yB|>\xa2O\x8c1\x18\\\xf5\x01,.;A\xcez\xff\x8e:8.\xd4\xb14\x1a\xfc.h\x08z\xec@._\xfa\xc7\xe5\x7f\\\xfe\xbf\x00\x00\x00\xff\xffPK\x07\x08\xc7\xcc\xae\xb0\xff\x14\x00\x00\xf81\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00$\x00	\x00sql/functions/assert_can_perform.sqlUT\x05\x00\x01\xc8r\x08fl\x90\xcdj\xc30\x10\x84\xcf\xd2S\xec\xc1\xe0\x04\x92@\xcf\xa6\x85>I\xd8HcG \xaf\\\xed:\xcd\xe3\x17\xff\xe4P\x88.\x92V\xfbiv\xe6|\xa6oUT#\x0e\xa1\xccb\x14XhB\xedK\x1di,\x11\x95-\x15!\x0e\xcb\xe6C\x05\x1b\xa8\x9f%l\xe5\x15\xbe\x06\x96\xeb\x0e\x1d^\x1f\x19\x9ev\xa2[\xe1\x1a\xf7s\xc6\x03\x99t\xe4\x9c\x93\xd8\xd1W\xd8\\E\xe9QR$Vj\x1a\x1f\x112Wx\xb7\x8cq+%w\xfe\x86!\x89w\x8a\x8c`\x84gRS:\xec\xd7\x0f\xef\x96\xd5\xd72\x92\x1a\xf7=\xe9V\xf9\xbd\xa3\x82\xf4\xb2\xe9'\xa1C\xcb9\xb7\xa77\x13o=\xc7\x8ds,\x91\xf42\x15M\xab\xc1\xaf\xcfw\xc4\xea\xe4\x1f\xf0r\xfd\xb6}\x7f\\$\x92XY2\xee\xbcK=I\xd9\x02\xb7;\xc4;W9)\x08\xcf\x80i\x15o9\x04\xa8R\x84$\xc4\xb6\xf3\x0e\x12)\xf5\x9d\x87\xc4\xce7\x0de\x96a\xe6\x014\xe5i\xd0\x9f\xdc\xf9\xbf\x00\x00\x00\xff\xffPK\x07\x08A\xbd\xd5h\x05\x01\x00\x00\xd2\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00sql/functions/bump_thread.sqlUT\x05\x00\x01\xc8r\x08fd\xcfMN\xc30\x10\xc5\xf1\xb5\xe7\x14o\x91E\"!T\xb6\x98\x9c\xa5r\xecIb\xc9\x9d1\xfe\xa0=>\x82\x16\xb5\x15\xdb'\xeb\xef\xdf\xf8\xc2\xae1\xb4\xa0pN\xce3\xd6.\xbeE\x15,\xfd\x94\x8fm/\xec\xc2\xa8\x19K\xdc\xa2\xb4\x97\xdb\x1cO\x8cE5a\xc6\xeaR\xe5\x89\n\xb7^\xa4\xe2Kc W1\x0c\x14\xd8'W\x98\x8c\xe8\xf9\xd8%^n\x11\xbc\xcf\xe0K+\xce\xb7\x91\xb3\xfa\x1dk\xd1\x13D\xcf\xe34YZx\x8bB\xa6\xe7\xf0C\xbb\n*\x19S\xb9\xe1:^\xff\x9f\xf1\xd7%c\xce;\x17F\x0c\x98\xa1\xd9\x92\x89\xeb\xe3\x01\xafw\xb5\x93\x80\xac\xb5\x1d\xbdvi\xe3\xe3#\xcd\x13>\xf0v8\x1c\xd0v\x162\xff\x0d\xbf\x88{\xec\x89\xf0hx\xceZ2,\x01q\xb5\xc4\x12,\x0d\x03\x92\x93\xad\xbb\x8d\x91S\xde\xeag\xb2\xf4\x1d\x00\x00\xff\xffPK\x07\x08s\xdcw}\xe3\x00\x00\x00\x8b\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1f\x00	\x00sql/functions/delete_images.sqlUT\x05\x00\x01\xc8r\x08f\xa4\x93O\x8b\xdb<\x10\xc6\xcf\xd2\xa7xXrH\xc0	,\xefmM\x0e/\xb4\x94\xde\n=\x96b&\xd6\xd8\x16U$U\x92\xb7\xcd\xb7/\xfa\x93t\x13z(tn\x96f\x9e\xf9\xcd\xa3\xf1\x18\x98\x12\xc3\x05\x04\xf6\x86F\xc6\xb4\xda1ig\xa1\xd8p\xe2A\x9fi\xe6\xb8\xd5\n'=k\x9b:\xd08\xba\xd5&$\xfe\x99:\x9c.\x83\xf689g\x98\xecN\x06Nk\xb0\x11\xafN+P\xc4f#\x15\x8f\x86\x02K\x91(\xcc\x9c\x86\x93\xa3\xa0Ju/\x85w1\x0d7\xf1\xfe\x96\xa4=\xb4\xe5|\xa0Ul\xb7_\xbe\xf6\xf2\xc4\xb3\xb6R\xec\xf7\xf8\xc0	\xb9\x1aU\x8f\xac\xc2\xc7ORD6<\xd6\x9b\xdai\xeb\x0fZ\xed:\xf8C\xd1L\x0eo9:\xdc\x1aJ!\xa6\xe0\xce\xa54\"\x7f\xfeX8p.T8\xde\xfbq\xd0\xaa\x97\xa2p\xfc\x1f#\x87\x845r\xc0HW\xdfP\xf3\xe0l\xe5\x93\xc2s\x98\\8\x83J\xfe0\x92\x1d\xda\xd1\xb69\xda=\x90=\xbf\xbc\xc43\x19\xa3m\xda\xf5R\n=5\xb7\xf3\xaco|\x8a\xb0.\xc1\xae\xc6 -l\xa5(\x9e\xbd\x1cA!\xd0e{u\xe4\xa0\x95\x14%\x1e\xc6\xccq\x1b\xd5\xe3xgI\x89\xdc\xf0\xd1\xd1\xdfym\xc0\x1a\xfb=\xde\xdb\xb8\x06.Pd\x02\x93\xba4S\xd4\x9d\xde!.\xf4\xfc\x96~\xd7K\xc1&\xb2,*\x9f\x936\x06\x96Y!9\x8c\x0b\x8f\xdf\xa0\xa7<a\xeb\xcb\x95	\x0bE\x90\xad\x86\xe7\xd4\xda\xeb\xdf\\\xf8\xd3\x83\xff\x05\xbcU\xd0S~\xaa\xfd\x1e\xef\xea\x1ed\xde*!\xc5\xe4\x02\xd3\xb8\xe0\xba\xf4\xdaV8dR\xe3\\\xc6X\xbd\xca\x7fd\xe5\xa2\x86\x169\xa14<\x96^\xf2\x11\xb5	\xf6yh[\xd6\xb1,\xfa\xd9\xa9\xc1\xb8\x19\xdbt\xf1\xdc\xa1\xadUK\xee\xf0t\xba<\xed\xb2\xd6+\x99\x95#\xb6\xff=n\xe0-\xb5m\xe8u\xc8\xcc\xdaK\xb6\xaa\x97\x9b\x0d\x0c\xd9y\xcd\xe6{\xe3\xe7\xf8\xdd\xf4\xf2W\x00\x00\x00\xff\xffPK\x07\x08\xf38\x13\xa0\xf9\x01\x00\x00W\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00sql/functions/delete_posts.sqlUT\x05\x00\x01\xc8r\x08f\xa4R\xc1\xaa\xdb0\x10<[_1<rH\xc0	\xb4\xc7gr(\xb4\x94\xde\n=\x96b\xd6\xd2\xda\x11UV\xae$\xbf6\x7f_$+i^\xe8\xa1\xd0\xbdY\xbb;3;\x1e\x1d\x98\x12\xc3\x07\x04\x9e\x1di\xc6\xb8\x88N\xd6\x0b\x0c;N\xdc\xcf>\xa6\xb8\xb5\x06\x83\x9d\xac\xa4\x16\xa4\xb5_$!\xf1\xaf\xd4b\xb8\xf4v\xc6\xe0\xbdc\x92\x9d\n\x9c\x96 \x11/\xde\x1aP\xc4f\xa3\x0ckG\x81U\x93(L\x9c\xfa\xc1S0e\xbbSMF\xefo\xe0\xddm\xc8\xce\xb0\xc2\xf9\xc1\x9aX\xbb_\xbfuj\xe0\xc9\x8aj\xf6{|\xe4\x84\xbc\x8d\x15\x8f\xc4\xe0\xd3g\xd5Dv\xac\xd7\xce\xca\xb4\x9d\x0f\xd6\xecZ\xcc\x87\x82\x99<\xeeu\xb4\xb8\x11\xaa\xa6\x19\x83?\x97\xd5\x88\xfc\xf9\xf3\xc4\x81\xf3\xa2\xc1\xf1\x95\x1d\x07k:UT\xbc\x8b\x91C\xc2\x129@\xd3\xd5\xb4\x8a\xe1e\x15\xa7\x9a\x99\xc3\xe8\xc3\x19T\xc6{M\xd2\xd7\xa7m\xb5\xb3}\x90\xf5\xe6\xf99\x9e\xc99+i\x97\xb9\xecX\xad\xce\x87\xde\x99\x14!>A\x16\xe7\x90N,\xaa)\x86=\x1fA!\xd0e{\xb5\xe3`\x8djJ=\xdc\x98\xebv\xe7\x8c\xe3+?Je\xc2G;\xff\xcc\xd5\x03\xd7\xda\xef\xf1A\xe2\x12\xb8\x88\"\x17\x98\xcc\xa5zb\xee\xf1r\xdb\xc6\xbevV\xcc]\xa7\x1av\x91U\xc1\xf9\x92\xacs\x10f\x83\xe4\xa1O\xac\xbf\xc3\x8e\xf9\xc6\xca\xcc\xab*\x9c(b`\x96;\x9a\xffp\xe0/\x7f\xfa\x9ft\x8b\x81\x1dk&\xde\xaf\x19\xc8R\x0b\x86jF\x1f\x98\xf4	\xd7\xb4[Y\xc5!+u\xdeg\x19VJ\x92JB\xcf\xde\xf4\xceO\xd8\xa6\xcb\xcc-j$\xeav\x8b\xa7\xe1\xf2\xb4\xcb\xb2^\xc8-\x1c\xb1}\xfb\x98\x9e\xdbhM\xd7Uc\xe6\xea\x14\x8b\xe9\xd4f\x03G2-41f7O\xf1\x87\xeb\xd4\xef\x00\x00\x00\xff\xffPK\x07\x08\x99\n\xf8;\xd9\x01\x00\x00\x0f\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00g|\x8fX\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00sql/functions/insert_image.sqlUT\x05\x00\x013I\x1df\\\x92A\xae\x9c0\x0c\x86\xd7\xf1)\xbc\x18	\x90x\xa3iUU\x95\xd0\x1c\xa0\x9b^\x01\x05b\x18\xbff\x1c\x1a\x87\xf6-\xde\xe1\xab$L;\xed\x0e\x8c\x93\xff\xf3\x87_^\xf0\xab(\xc5\xa4\xc8w\xbb\x12\xb2\xa4\x80\xf4\xc6\x9aXV\xdc\x82&\xb4\xe20R\xda\xa3\x1c=\xaf\x1a\x04\xe6H6\x11.\xbb\xcc\x89\x83 \x97k\xc6\xd2\xd1\xe6s#;\x9cxeI=\xa6\xf0\x9d\x04\xe7\x9b\x8d\xed\x97\xcf]\x8fb\xef\x84?m,\x95\x8f\x97K\xd7\x83\xd1-\xb0\xa7\x88S\x08\xbe\x83\x1a\xa8%kB\xabx:\x81\xa3\xd9\xdbH`JH\xbe\xbf\x9c\xfft\xe9\x060\xce&[\xbb\x07\x98he\x01\xb3o.#f\x18\x05c\x94\x12\xea\xcd~\xc0+\xeeJ\x95t,d\xed3\xfc\xb9\x942\x91\xa9A\xdf2\xec\xf5\x9f\x01\xcfy\x80\xd2\xf1\xa0\xfe\xef\xfbQ\x06c~\xdd(\x12\xb2\xc3+\x1eV\xc0\x98:\xdd\xc3\xb0\x9e\x0bVQ\xff\x18m\x00\xc3\xcb\x9f7dE\xd9\xbd\xc7t#\xc9\xe7-+!\xbd\xcd\xb4\x15\xf9M\xf9Q\x12\x12.a\x17\xd7\x0c`H\x1c\xf22\x00\x18%Os\xc2\x14\xc6\xa2\xa7\xe5\xaeFec`\xcc\x12\xc3\xbd\x06)\xf2_\xe0\xf3\xa1\xea	\xe8X\x82b\xfa\xfd\xbd\xca\x1e\xa7\x9d\xbd\x1b\xc3\xf4Jsj\xc1\x98\x86]\xd3?F\xcd\x8a\x9a\xc3E\xd3\xe3\xf1T\xaa\xd9`S7\xa1\x1b\x80\xc4\x0dp:\xa1\xb7\xb2\xeey\xc76\xbf\xad\xfa\xc3\x0f\xf0;\x00\x00\xff\xffPK\x07\x08\x0b\xce\xa2\xd0u\x01\x00\x00\xa2\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00sql/functions/is_deleted.sqlUT\x05\x00\x01\xc8r\x08fL\xce\xb1n\xc30\x0c\x04\xd0Y\xfc\x8a\x1b<$K\x80v5\xf2-\x81,]T\x02\xb2\xa4J4\xda\xfe}\x11\xdb@\xcb\x8d\xbc\x07\xf0B\xa77\xa2vt\xb6\xec\x03\xf1\xdcJ0\xad\x05:\x1e\x91\x99\xc6x\xd1\x88E\x93\x16\xbbJ\xa7m\xbd\x0c,\xb5f\xf8\x81i\x92\xc8\x90}\xa7\xb8\x93\xef\xd9,\x0b\x93\x16q\x83\x99\xc1\xc0o\x1d6p9\xd77q\xafy\xf6\xba\xa2\xd5a\x8f\xb5Fv\xbf?n\xeb\x11~}\xb0\x13m\xbd\xed@#\xee\xff:\xdd4\x1e\xca\xf9\x12_\xc8~\x1aq\xc7\xfbU\x9c\xd3b\x15'\x9c\xc5\x1d\x9d\xff\x0e,q\x96iB\xf6%m>\x11-\xb74>\xf3,\xbf\x01\x00\x00\xff\xffPK\x07\x08)\x9e\xae\xd8\xb4\x00\x00\x00\x0f\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00sql/functions/post_board.sqlUT\x05\x00\x01\xc8r\x08f<\x8eAN\xc50\x0cD\xd7\xf6)f\xd1\xc5\xff\x9b^ \xe2,(m\xfcS\xa3\xe0\x04\xc7\x15\x1c\x1f\xa9E\xec,\xbf\xd1\xcc\xdb]r\x08^\xa7\xed\xa1\xdd0\xfa\x8c\xf7\xadg/\x0f-\xd8\xb4\xaa\xc5\x93]\xe2t\x9b\x08\xf9	\xe4\x89e\xe1\"{\xcb.LW\xf8\"\x897\xa9jLS\x9a\xec\x81Xo\xa6\x16\x1d\xd7\xc9D/\xef\x9f\xd7\xca\xc4`\xa2\x8f\xae\x868\\r\x99\x08t\xc3#V-x\xc3X\xfbx2\xd1\xf7!.\x18\x7f\xcf\x7f\xbdUKb\xba\xc5\xee\xf2\xc4b%\xf1\xb2\xa0e\xabg\xae\x82\xd1F\x9d_-\xf1o\x00\x00\x00\xff\xffPK\x07\x08\xad\x9f\xd7\x04\xa3\x00\x00\x00\xe7\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00sql/functions/post_count.sqlUT\x05\x00\x01\xc8r\x08fL\xcd1\xae\xc3 \x0c\xc6\xf1\xd9>\x85\x07\x86\x977\xe4\x02\xa8g\xa9\x88\xe3P$jS0\xea\xf5+5\x19:\xfe\xbf\xe1\xfbq\x97\xe4B\xc7T\xf6bJ\xcd\x86\xdf\xd9\xa6\xfa\x9f5\xdaJ.\xea\x0bv\xf1\xd9u\\MiP\x08\xb8\x0b\xd7\xd4\x05\x81\xaf=\xe2&\xb9(\xc2\x90*\xect\xde\xfc/T\xd4\x8d\x18\x01\x8en\xcf/1\x10\xe0\xfd\x90.g\xad\xd6\xe8\xf6c\xaf\xd6\"\xc2\xa9\x12G\x14\xdd#\x86@5i\x9e)\x0b\xb5\xda\xf2x\xd5\x88\x9f\x00\x00\x00\xff\xffPK\x07\x08\xbaki\xf9\x8b\x00\x00\x00\xc0\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x19\x00	\x00sql/functions/post_op.sqlUT\x05\x00\x01\xc8r\x08f4\xcd1\x8e\x021\x0c\x85\xe1\xda>\x85\x8b\x14\xbb\x0d\x17\x888\x0b\xca$\x9e`)\xd8&\xf1\x88\xeb#\xc1P>=\xe9\xff\xea\xe4\x12L\xfb\xa15\xc4\x94\xdcV\xdc\xcc\xff\xa4\xd1&]4\xfeqr\x1cS\xd7\xb9\xa9,J	\x1b\xd7Q&#\x98\x9fG\xc6\x8d\xbb(\xc2\xe2\xc15\xc8\x9cD\xc3\xc8\x1c\x01\xf6i\x8fO{!\xc0\xeb\xce\x93I\x1a]\x7f\xdcEZF\xf8Bd\x9e\x91\xb5eL\x89F\xd1~\x94\xce\xe4\xc3\xfbz\x8e\x8c\xef\x00\x00\x00\xff\xffPK\x07\x08Q\xa7\x96\xff\x86\x00\x00\x00\xb1\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00sql/functions/spoiler_images.sqlUT\x05\x00\x01\xc8r\x08f\xa4\x93?\x8b\xdc0\x10\xc5k\xe9S<\x8e-\xbc\xe0[8Huf\x8bT!]\xe0\xca\x10\xccX\x1a\xdb\"ZI\x91\xe4K\xf6\xdb\x07\xd9:goI\x11\x88:K\xf3\xe77o\x9eUd\xca\x0c\x1f\x119XR\x8cqq*\x1b\xef\x90\x827\x96co.4qj\x8c\xc6`&\xe3r\x0bR\xca/.#\xf3\xaf\xdcb\xb8\xf6&`\xf0\xde2\xb9\xa3\x8c\x9c\x97\xe8\x12^\xbd\xd1\xa0\x84\xc3AjV\x96\"K\x91)N\x9c\xfb\xc1S\xd4kv'E\xf0)\xf7{\xf1n\x0f2\x01\xc6q\xb90:\xd5\xd7\xaf\xdf:9\xf0d\x9c\x14\x8f\x8f\xf8\xc4\x19%\x1b[=r\x1a\x9f\xbfH\x91\xd8\xb2\xda^\xb6NM8\x19}l\x11Nk\xcd\xecq\xcb\xd1bo(\x85\x18\xa3\xbf\xac\xa9	\xe5\xf3\xe7\xcc\x91K\xa2\xc6\xf9N\x90\x93\xd1\x9d\\9>\xa6\xc41cI\x1c\xa1h\x17\x0e[\x1c\xbc\xdb\x00\xa5\x08\x1cG\x1f/\xa05\xa1W\xe4\xfaz\xd5TI\xdb;\xb4\xa7\xe7\xe7t!k\x8d\xcb\xc7\xd2\xcd\x8cU\xee2\xec\x8dP	\xceg\xb8\xc5Z\xe4\x99\x9d\x14\xabh\xcfgP\x8ctm\xde$9\x19-\xc5z\xee\xe6,g\x9f5\xe0\xfcN\x93\xf5\x94\x86\xf7\x92\xfe\x89\xab\x03\xde\x84\x9e\xd2LO\xb7`\xc7N\n\xb6\x89\xa5(\x9a\xbddc-\x1c\xb3F\xf6P3\xab\xef0c\x81\xaf%yk\x87\x99\x12\xc8mZ\x96\xd0*\xee\xffM\xf8\xd7m\xfe\x03\xbe\xd30c\xdd\xfaK\xddrA\xdejH1\xfa\xc8\xa4f\xbcy\xda\xb8\x0d\x0f\x85\xd5z_@\x96\xa0\xcb\x1f\xb7\x91Q\x85K\x9cw\xdb\x9c\x91\xe3Rdz\xcf[kver\xb7\x1an\xb5\xf2\xc5\xeb\xde\xfa	M\xbe\x06nQ}S\x83[<\x0c\xd7\x87c\xa9\xf5Jv\xe1\x84\xe6\xc3\xbd\xc5\xf6\xd0j\xc1\xb7A\x0bn'\xd9\xe9N\x1e\x0e\xb0\xe4\xa6\xa5\xac \xd80\xa5\x1f\xb6\x93\xbf\x03\x00\x00\xff\xffPK\x07\x08\xd8.\xe8\xaf\xe6\x01\x00\x00:\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00!\x00	\x00sql/functions/use_image_token.sqlUT\x05\x00\x01\xc8r\x08f\\\x90\xc1N\xc40\x0cD\xcf\xf1W\xf8P\xa9\xdb\xc3\"\x90\x10B\x8a\xb8q\xe1\x038\xafL\xe2\xb6\x11Y\xb7\xc4	\xf0\xf9\xa8IA\xec\xde\xa2\xb1\xe7\x8d'\xc7#\xbe*+\x96\x15I0\x9cib\xa4\x18\x17G9,\x82yygA\x12\x8f\x89sI\xa2\x98gnk\xbd\xe2\xcb3\xb8\xc4\x94\x19\xc7\"\xae\x1a\x8a\xf2\xa9\x8eO\xd5zh\x007S:<>\x0c\x03\xfcb\xaar\x7f; )v\x1dxv\x91\x12\x83\xd1\x99\xee\xfe\x86\x16\xdex\n\x02\xc6s\xe4-%-g\xfcGW0\xe6k\xe6\xc4\x17\xe2M\xcb|\xba\xbe\xa5\xe9`L\xbb!\xc8ti\xab\xd1A\xf2\x82\xdb\xcb\x82	#6MQJ\x8c[\xf5j\xa7\xa0\x8c\xfc\xedx\xad\x95\xfb \x9f\x14\x83\xdf\x7f\xaf\xd2z\x0b\x86\xc5c\x18-\xecy;\x95\xc5[\xe8:\x8c$S\xd9\xf6\xd7\xb8N\xfa\x11-\xfc\x04\x00\x00\xff\xffPK\x07\x08w\x97\xe0l\xe7\x00\x00\x00\x8b\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x15\x00	\x00sql/triggers/bans.sqlUT\x05\x00\x01\xc8r\x08f$\xcc\xb1Q\x041\x0c\x05\xd0\x18W\xf1\x83\x0d \xa1\x81\x1dj\xf1\xe8\xbc\x7f}\x9e\x11\xb2\x91d\xae}\x02\x1ax\xcd)IL\x87s\xa94\xe2\xde\xd6rL\x83\xdcI\xaf\x0f\xb1\xa8\xc3\x82\x9e\xef\x1f\xc5\x99\xdb-\x90>z\xa7C\x02\xc7Q\x1e\xec\xc3\n\x00\\T&q\xfb\xfc\x86Jd\x8d\xa9\xbf\xbcj\x93\x95\xed)\x81\xd7\x93N\x8c\x85/\x18_\x9fc\x9d\xe5\xed\x1f\x85m\xd5\xb3\xd0\xae\xb3\x1c\x07T\xaco\xe9\xc4\xd2\xd5\xe3G\xcf\xf2\x17\x00\x00\xff\xffPK\x07\x081\x86\xf77\x86\x00\x00\x00\xab\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00sql/triggers/boards.sqlUT\x05\x00\x01\xc8r\x08f\xac\xcfAj\xc40\x0c\x05\xd0ut\x8a\xbf\x08L\x02\x99\xd2}N\xd0S\x04O\xac\x18\x83+\xbb\xb2\xdc\x92\xdb\x17\xe2\x0b\xb4\x90\xad\x90\xbe\xde\xdf\x95\x9d1\xb2B\xb9$\xb73\x8e&\xbb\xc5,p\x87\xb1n\xaf\xec\xd4\xd7-Je\xb5i&ek*\x15\xa61\x04V\xb8\x8aq\xa4\x17\x87(4\x14\xd6#\xeb'J\xd8$[<\xce\xe9q\xddo\xadxg\xec\x1f\x0b\x84\x7f\xde\xa2\x9fW\xa2\xe1\xf9\xc4\x87DC9\x1b\xbe]jLC\x7f\x83(\x96\xaf\xf1\x14\xfd\x82\xb2\xe7&6\xf7\x9d\x8a\xa9G,x\xbfR:\x08\xd2RZ\x89\xc5\xaf4\x8eHNBs\x81QR	\xf5+\xadD\x7fm\xda\xa9w6\xbd\x9d\xe89\xf1-\xc4\x9c\xfc\xff\x88\xbf\x01\x00\x00\xff\xffPK\x07\x08\xae\x07\x84\xd3\xc3\x00\x00\x00/\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00X\x0c\x87X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00sql/triggers/claude.sqlUT\x05\x00\x018\xf8\x11fT\x8f\xc1N31\x0c\x84\xefy\x8a9\xec\xa1\x95~\xed\x0b\xac\xfe\x13*'\x10\x12p_e\x13oj\x94:\xc6I\xe8\xeb#\xd2\n	\xdf\xec\xf9l\xcf\x04#\xdf\x08\xc5`\xa4\xd9\x07\xc2\xde%4.\x02\xbf7\xb25d\xdf#\xad]\xa3ot8:\xa3\xd6M*\x9aqJd\xf0\x15\xd3\xe4\"\x85\xec\x8d\x1c\x00|\xadE\xb1qbi\x8b\xdb(\xb1\x8c\xf1\xdb\xe9\xe9\xf4\xf0\x0e\x9d\x8b\x82\xa5\x95\xc1\x0d\xe5\xf1\xf5\xe5\x19\xb7?\xa3\xff-\x16!\xc3Ga\x81\xf6-s\x98\xb5\xd4V\xa1(r_\x989\xe2?t\xbe\xdb\xe48.\\\xcfd\xf4\x87\x10\xba\xce\x1c\x177d%\xdb\x8b]\xb0\xf5\x8b\xae\xedl\xe4\xe3\xe1\xc7\xcd?\xec>W:.\x83\xba%\x85\xf4\x9c\x17G\x12\x177M\xc8^R\xf7\x89\xa0YS\xfd\xcc\xcbw\x00\x00\x00\xff\xffPK\x07\x08'J\xc7d\xda\x00\x00\x00A\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00sql/triggers/mod_log.sqlUT\x05\x00\x01\xc8r\x08fL\x91Q\x8e\xdb0\x0cD\xbf\xa5SL\x17\x01\xe2\x00\xdeE\xff\x8d\xbdCo`(\x16\xad\x08PHU\xa2\x1b\xe4\xf6\x05\xed\xb4\xc8\x1fAb\xdep\xc8\xa5QP\x8244\xaa%,\x84u\xe3E\xb30\xc2\xaa\xd4\xe6\xbb\xc4\xb9H\x9a3wj:\\|#\xdd\x1awh\xcb)QC\xe88\x9d|\xa4\xa5\x84F\xdeI\xc55\xa7\xcc:\xf9+\xa5\xcc\xde\xe5\x15L\x8f\xaf*]\xe7\x1c\xf1\xe3\x1b?\xa17b\xef\xdc\x01Ef\x15\xec\xf3\xbbDja\xb7\x1f^\x82\x11\xfa\xac4\xe2\xe3\xfa\xfc\x18Q\x88\x93\xdeF\xc4\xa0\xe1\xe2\x9ds\x7fB\xd9\xa8cx\xb3\x18w\xbfCe\xd5\xa1\xb4\xea\x9f\xda\xea\x9d0y\xe7\xb6\x1a\xed\x04&\xeeF\xec\xa4x\xedA\x11\xdf\xd0\xb6\x91\xf5\x1f7j\x84l\xad73\x9b\x1c'\xc9\x9c\x0e\xc8\x97\xd4#\x92T\xe3Wj\xab\xb4;j\x9aY4\xaf\xcf\xe1\xfc\x9e\x95\xe2y4\xc8\"\xbc\x04\x9d\x1f}8\x8f\xe7\x11R\x8f5s\xbc\\&\xef\x9d\xfb\xfc\xc4/\xa3\xe3\xba\xdd+\xf4\xd6(\xc4\x0e\xe1\xf2\x840\xf6?\xee_\xe3\x88\xa5H\xdf\xec\x19\xff\xbdM3\x1f\x9a\xc1\xc8\x96\xc9\xc2\x13G\xe4u\xf2\xaf\x08\xe0\xad\x94\xc9\x13\xc7\xc9\x9fN(\x81\xd3\x16\x12\xa1\x96\x9a\xfa\xef2\xf9\xbf\x01\x00\x00\xff\xffPK\x07\x08\x0b\x1dv\x11J\x01\x00\x00/\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00sql/triggers/posts.sqlUT\x05\x00\x01\xc8r\x08f\x94S\xc1n\xd40\x10=\xc7_\xf1X\xad\x94\xac\x9a\xaeT\xaeQ\xb9\x94\x0b7\x0e \x8e\x91\x1dO\xb2V\xbdv\xb0'l\x17\xf5\xe3\x91\x1d\xb7[\x8a(\"\x97$\xe37\x9eyo\xde\x0c\x81$\x13|@\xa0\xd9\xca\x810.n`\xe3\x1d\x14\x8d>P?\xfb\xc8\xb17.R\xe0f'\x02\xf1\x12\\\x04\x073M\x14 #\xb6[\xa1i\xb02\x90\xa8\xd8\xf7\x9a,1\xf5\xea\x0c\xa6\x07\xee^\xc6\x02\xc9\xe8]\x89+\x9a\x8c\x13\xd5La\xf4\xe1\x08\xb5\x1c\xe7\x9e\x0f\x81\xa4n\x1c\x9d\xf6~n\xe1<#}G9\xd1\xae\x13\xd5\xf55\xaenZ(\x1a\xe4\x12)\x1d!\xb5\x07\x133tm\x924\xce\xc4\x97\x8b\xe7\xa9w\x9e\xcdxnjG\xa7\xcc\xa77\xae\x94\xaa[QUk9<>\xa2n\xeb\xf4\xca\x98\xc1/\x8eK+;\\\xe1f\xd7\x89\xdc\xc2\xc7L&\x83Z\x98\x11\x9f>CY9\xdc[\x13\x99\xb4\xa8\"Y\x1a\x18j\xaf\xce-\xd4\xbe\x906\x8e=^\xca\xd3\xe2\xb50\xa2\xaa\xc6\xe0\x8fP\xd2E(Q\xa5bw\xd2\xd5\x8c\xc467\xa5\xbc\x0c\xba\xd9\xbd\xd0\xe0\x0f\xde\xd5\xe9@\x81\xd0d(n\xd1\x94~x\x9f#\xa2\xcaO.\xb4j\x10\xc1%\xb8f\xf2\xde\xa4\xbc\xc2<\x1d\xf9\x80\xa7\xdbjim\x9d\x83\xd2i\xa8\xbd\x99\x0b\xd4\xcc\x97 \x9fgJ\xd8x\x90\xda\x9f\xea\xcb\x01=\xcc&P\xc4\x078\x7fjv\x90\x0c6G\xc2O\xef\x08\xf5\xd7/wu'*3\xfe\xa6\xd3\xd3t\xddb-\xf8@n\x15\xe6\x9b\xb1\x16\xa34\x16\x9e\x0f\x14N&\xd2E\x95{zN;\x05\xc3L\x0eI}\xa9,\x15\x91\"1\x06\xef\"\x07i\x1c\xc7U\xdd\xa3\xd7\x14dr\x7f\xb1\x89\xee\xc7t\x95\xa6\x91B \xdd\x89\xaaZ\xc5^\xc7\xf9*	M\xc9j\x91\x14h\xb1Q\xe7M\x8b\x8d\x96,7Y\xb3\x1f\xd2.\x14\x91]\x95`\xef\xdb\x7fX\"\xb9>\xfb\xb3T\xa14\x03\x0e\x0bu\xa2\"\xa7a\xc6d\xcau)\xd3\x18:ANwb\xbb\x85\x95nZ\xe4D\x98\xed<\xc5\xef\xb6\x13\xe2\x8de\x97#S(\xbb\xbe\xccZ2\xfdu\xd7\xcb\xde\x9a1\x8f\x9d\xb4a\xe3&\xbc\xbb\x85\xb7\xfa\xf9\xb7\xcc\xe9\x7f\x96\xfb\x89\xce3\x9b\xc5\xda\xb7\xe8\xfc\n\x00\x00\xff\xffPK\x07\x08\xb9\x0d+/@\x02\x00\x00\xbb\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00sql/triggers/threads.sqlUT\x05\x00\x01\xc8r\x08f\xb4\x91Aj\xec0\x10D\xd7\xd6)\xfa\x83\xc130\xf3/`r\x87\xdc\xc0\xc8VI#\x90[J\xab\x95\x10\x98\xc3\x07\xc7\x93EVN\x16Y	\x15\xaa\xe2=\xb4\x08\xac\x82\xb2\x90\xa0$\xbb\x80|\xe3Ecf\xb2^!\x93\xde\x04\xd6\xd5)r\x85\xe8\xe9l\x04\xda\x84+\xa9\xc4\x10 d+\xf5\xbd\x99\x11\"\x9b\xae@|\x96\x95\xe6\xb6\x96G\xf5\xc4x\xfb\x1f\xddy4\xdd^%n)\x8d\x06\xecF\xd3\xf7\x94,\x87f\x03\xa8\xa4\x12\xeaK\x1a\x8d\xf91T+\xce*\x8e\xa0\xaeWz\x16\xbc\x82\x95\"\xfb\xc8QA\x82\xa5I\xdd\x163\x93\xc6\x15U\xedZh\x1f\xac\xa6\x8b\x9e6\xee\xfd>m\x0f\xe8\xdf\x13\xe5\xe4\xbeEz\x03\x9b\xee\xc0\x1a\xec(\xfa\xbf\xd0wH8\xd6\xff\xa2+a\xe2\xac\xd1\xbf\x9f\x86}\xe0\xd1w\xc3\xe5Sl\xceV\x1c\xdd\xef4\\\x86\xed\xd8\xb2_\xfd\xdbG\x00\x00\x00\xff\xffPK\x07\x08\x0eg\xacZ\xed\x00\x00\x00L\x02\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X]12\x95\xaeZ\x00\x00\x8f^\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00assets/loading.gifUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\xb8\x0cr\xe9a\x05\x00\x00\xf5\x0d\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xf7Z\x00\x00lang/en_GB/common.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xfa\x0b\xa4X\xeb\xec\xd7\x86\x07\x12\x00\x00|4\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa5`\x00\x00lang/en_GB/server.jsonUT\x05\x00\x01	\x905fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\xba\xd8\xb6\xc1\x91\x05\x00\x00\x13\x0e\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xf9r\x00\x00lang/es_ES/common.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x97\xd3;\x8fp\x12\x00\x00\xbf4\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xd7x\x00\x00lang/es_ES/server.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x05t\xaf\xb8(\x06\x00\x00\x99\x0e\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x94\x8b\x00\x00lang/fr_FR/common.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~XV_2\xc0@\x12\x00\x00D5\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81	\x92\x00\x00lang/fr_FR/server.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x0d\x04\xf70 \x06\x00\x00\xa8\x0e\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x96\xa4\x00\x00lang/nl_NL/common.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x91\xf4o\xe5\xd9\x13\x00\x00\xb67\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x03\xab\x00\x00lang/nl_NL/server.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\xf1VB\x0dJ\x06\x00\x00\xd2\x0e\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81)\xbf\x00\x00lang/pl_PL/common.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x0b{\x92T0\x13\x00\x00\xf23\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xc0\xc5\x00\x00lang/pl_PL/server.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~XL\x95\xc9\x96\x9e\x05\x00\x00#\x0e\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81=\xd9\x00\x00lang/pt_BR/common.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X8,\x08\xa1\x90\x12\x00\x00@4\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81(\xdf\x00\x00lang/pt_BR/server.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\xc4\xb1\xe3\x96\\\x07\x00\x00\x01\x11\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x05\xf2\x00\x00lang/ru_RU/common.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\xe3k\xc5-v\x16\x00\x00YD\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xae\xf9\x00\x00lang/ru_RU/server.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X	6\x1d\x9a]\x06\x00\x00\xa3\x0e\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81q\x10\x01\x00lang/sk_SK/common.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x12\xca\xb2\xa0/\x14\x00\x00\xee4\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x1b\x17\x01\x00lang/sk_SK/server.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\xb4\xf6\xe2~\xd4\x05\x00\x00\x1e\x0e\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x97+\x01\x00lang/tr_TR/common.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\xf5\x0cE\x94\x1a\x12\x00\x00\xe42\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xb81\x01\x00lang/tr_TR/server.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X^G\xe1\xcd\x0e\x07\x00\x00X\x10\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x1fD\x01\x00lang/uk_UA/common.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X~\xcd\xb4,\x01\x16\x00\x00J>\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81zK\x01\x00lang/uk_UA/server.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\xb2\x18\x02p\xef\x06\x00\x00\xb5\x0e\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xc8a\x01\x00lang/zh_TW/common.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\xc7\xcc\xae\xb0\xff\x14\x00\x00\xf81\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x04i\x01\x00lang/zh_TW/server.jsonUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~XA\xbd\xd5h\x05\x01\x00\x00\xd2\x01\x00\x00$\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81P~\x01\x00sql/functions/assert_can_perform.sqlUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~Xs\xdcw}\xe3\x00\x00\x00\x8b\x01\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xb0\x7f\x01\x00sql/functions/bump_thread.sqlUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\xf38\x13\xa0\xf9\x01\x00\x00W\x04\x00\x00\x1f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xe7\x80\x01\x00sql/functions/delete_images.sqlUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x99\n\xf8;\xd9\x01\x00\x00\x0f\x04\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x816\x83\x01\x00sql/functions/delete_posts.sqlUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00g|\x8fX\x0b\xce\xa2\xd0u\x01\x00\x00\xa2\x02\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81d\x85\x01\x00sql/functions/insert_image.sqlUT\x05\x00\x013I\x1dfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X)\x9e\xae\xd8\xb4\x00\x00\x00\x0f\x01\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81.\x87\x01\x00sql/functions/is_deleted.sqlUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\xad\x9f\xd7\x04\xa3\x00\x00\x00\xe7\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x815\x88\x01\x00sql/functions/post_board.sqlUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\xbaki\xf9\x8b\x00\x00\x00\xc0\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81+\x89\x01\x00sql/functions/post_count.sqlUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~XQ\xa7\x96\xff\x86\x00\x00\x00\xb1\x00\x00\x00\x19\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81	\x8a\x01\x00sql/functions/post_op.sqlUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\xd8.\xe8\xaf\xe6\x01\x00\x00:\x04\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xdf\x8a\x01\x00sql/functions/spoiler_images.sqlUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~Xw\x97\xe0l\xe7\x00\x00\x00\x8b\x01\x00\x00!\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x1c\x8d\x01\x00sql/functions/use_image_token.sqlUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X1\x86\xf77\x86\x00\x00\x00\xab\x00\x00\x00\x15\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81[\x8e\x01\x00sql/triggers/bans.sqlUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\xae\x07\x84\xd3\xc3\x00\x00\x00/\x02\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81-\x8f\x01\x00sql/triggers/boards.sqlUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00X\x0c\x87X'J\xc7d\xda\x00\x00\x00A\x01\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81>\x90\x01\x00sql/triggers/claude.sqlUT\x05\x00\x018\xf8\x11fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x0b\x1dv\x11J\x01\x00\x00/\x02\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81f\x91\x01\x00sql/triggers/mod_log.sqlUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\xb9\x0d+/@\x02\x00\x00\xbb\x04\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xff\x92\x01\x00sql/triggers/posts.sqlUT\x05\x00\x01\xc8r\x08fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\xa1~X\x0eg\xacZ\xed\x00\x00\x00L\x02\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x8c\x95\x01\x00sql/triggers/threads.sqlUT\x05\x00\x01\xc8r\x08fPK\x05\x06\x00\x00\x00\x00(\x00(\x00a\x0c\x00\x00\xc8\x96\x01\x00\x00\x00"
-	fs.Register(data)
-}
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00assets/loading.gifUT\x05\x00\x01*b2h\x00\x8f^p\xa1GIF89a\xe0\x00\x80\x00\xd5+\x000\x00 \xf8\xf8\xf8\x80\xa0\xa8\xe0Px\xa8\xa8\xd0` @\xa88`\x88 0\xf0\xe0\xd8`\x10(\xd8\xb8P`p\x80\x10\x100 (8@\x00(XXhHP`\xd8\xa0\x98`h\xa8 (H\x10HX\x90p(\xa8`h\x00\x00\x00\xd8\xb0\xc8\xa8x\x98\x18h\x80\xe0\xe8\xe8\xf8\xc8\xf0\xe0x\xa8\xf8\xa8\xb0\xf8`\xa8\xd88p\xa8\xa8\xf8\xb0Hp\xc8XP\xa8\x1880Hp((P\xf0\xb0PX8\x18@P\xb0 0\x80\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00!\xff\x0bNETSCAPE2.0\x03\x01\x00\x00\x00!\xff\x0bXMP DataXMP<?xpacket begin=\"\xef\xbb\xbf\" id=\"W5M0MpCehiHzreSzNTczkc9d\"?> <x:xmpmeta xmlns:x=\"adobe:ns:meta/\" x:xmptk=\"Adobe XMP Core 5.0-c060 61.134777, 2010/02/12-17:32:00        \"> <rdf:RDF xmlns:rdf=\"http://www.w3.org/1999/02/22-rdf-syntax-ns#\"> <rdf:Description rdf:about=\"\" xmlns:xmpMM=\"http://ns.adobe.com/xap/1.0/mm/\" xmlns:stRef=\"http://ns.adobe.com/xap/1.0/sType/ResourceRef#\" xmlns:xmp=\"http://ns.adobe.com/xap/1.0/\" xmpMM:DocumentID=\"xmp.did:CB3790B2E86211E4969693F512777AEC\" xmpMM:InstanceID=\"xmp.iid:CB3790B1E86211E4969693F512777AEC\" xmp:CreatorTool=\"Adobe Photoshop CS5 Windows\"> <xmpMM:DerivedFrom stRef:instanceID=\"xmp.did:7871C5BD62E8E411BABFC0027CE0A7AC\" stRef:documentID=\"xmp.did:7871C5BD62E8E411BABFC0027CE0A7AC\"/> </rdf:Description> </rdf:RDF> </x:xmpmeta> <?xpacket end=\"r\"?>\x01\xff\xfe\xfd\xfc\xfb\xfa\xf9\xf8\xf7\xf6\xf5\xf4\xf3\xf2\xf1\xf0\xef\xee\xed\xec\xeb\xea\xe9\xe8\xe7\xe6\xe5\xe4\xe3\xe2\xe1\xe0\xdf\xde\xdd\xdc\xdb\xda\xd9\xd8\xd7\xd6\xd5\xd4\xd3\xd2\xd1\xd0\xcf\xce\xcd\xcc\xcb\xca\xc9\xc8\xc7\xc6\xc5\xc4\xc3\xc2\xc1\xc0\xbf\xbe\xbd\xbc\xbb\xba\xb9\xb8\xb7\xb6\xb5\xb4\xb3\xb2\xb1\xb0\xaf\xae\xad\xac\xab\xaa\xa9\xa8\xa7\xa6\xa5\xa4\xa3\xa2\xa1\xa0\x9f\x9e\x9d\x9c\x9b\x9a\x99\x98\x97\x96\x95\x94\x93\x92\x91\x90\x8f\x8e\x8d\x8c\x8b\x8a\x89\x88\x87\x86\x85\x84\x83\x82\x81\x80\x7f~}|{zyxwvutsrqponmlkjihgfedcba`_^]\\[ZYXWVUTSRQPONMLKJIHGFEDCBA@?>=<;:9876543210/.-,+*)('&%$#\"! \x1f\x1e\x1d\x1c\x1b\x1a\x19\x18\x17\x16\x15\x14\x13\x12\x11\x10\x0f\x0e\x0d\x0c\x0b\n	\x08\x07\x06\x05\x04\x03\x02\x01\x00\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95\x96\x97\x98\x99\x9af\x00\x9d\x9e\x9b\xa0`\x9e\x05\xa4\xa5\x9f\xa1\xa8V\x9d\x05\x06\x03\xae\xae\x06\xa5\x05\x9d\xa9\xb5O\xab\xad\xaf\xba\xb0\xa6\x00\xb6\xbfH\x00\xac\xbb\xc4\xaf\xb1\xa4\xbe\xc0\xca+\x00\xb9\xc5\xcf\xc7\xb3\xcb\xbf\xcd\xcf\xd6\xbc\xb3\x9e\xc9\xd3\x9a\xd5\xd7\xb0\xd1\xb2\x00\x0c\x13\xe5\x0c\xb4\xdc\x98\x00\xdf\x03\xe1\xb2\xb3\xe4\xe5\xf2\xe7\xda\xe9\x92\xde\xd6\xef\xef\xe3\xf2\xfd\x0c\xff\xff\xb6\xd9s\xb4\xee\x9a\xbe^\xe4\xfe\xcd\xd3\xc6\x10\xdd\xc0D\xc2\x0c\x962@\xd1@\xa7x\x00\x03\n\x93U\x91b6\x81\x0f\x07\xe1#6\xb1\xe2Es\x19;9\xc3\xe6\xcc\xa3\xc3\x90\x81\n\x16#\xd5\xd1\"\xc6\x8c\xe7V\x12\xa3\xa8\xcb%H\xff\x98{\"\x16\x8bU3\xd68\x9c\xe7\xd8\xc1\xea	\xeb'P<#u\xb1\xaai\n\xa7L\xa5\xbbrY|\xca\xe7j\xcf\xa9\x15\xc5\x1d\xed\xe4\xca\x03Vk[\xb9\xe6\xf1j\x8c\xaa)V*\xcb\x9e\xbd\x96\x16X\xbd\xb5\x0d\xc9\xf6tkJ\xa7\x87\xbf\xbb\xcc\x0e\x98\xa8T\x1a5\x05\n*T8%\xc7\x13\x84\x05\x90#/\x80\xe0	\\Xq\x05\x02\xff\xdd,\xb7]\xe6c:w:\x05\x05\x00q\x05\xc4\x89\x19\xb3\xe9\xf4X\x80\xeb\xd7\xb0\x05L\xf6\xd4q\x9f_]\x9b\x05\x0f\xc3\xf6\xcd\xb0-O\xa7\x13\x9bV\xcd	\xc0\x82\xd8\xc8\x91\xcfn\xa6o\xa4\xe0\xc0\xbc\xe66\x9d\x06\x1c\xf5\xf0\x97b\x8c'\xdf\x9e|\xc1\xaa\xb7<\xaf\xe9\x0e\xcd\xae.\xf5N\xc1Q/\xc6\xde\x05\x00\xf7\xf7\xdd7\xce\xfa\x8c5\xf3\x00\xc0;Ie\xf5\x9d\xae\xba\xf5\xf5\xa3\xa9\"\xc0\x06\x1b\xc0g\xe0k\x1byC\xde~\xafp\xb6\x12Q\xf7\x99EQ\x80\xcb\xf8\xa7\x1eqU\xb8G\xe0\x81\x1c\n \xff\x0c>\x0b\xbe\xb2\xdbW\x11\xb6#\x148\x14rc\xe1u).a\xdc\x86\x1dr\x18W[\xf9\x84v\xe2`z\xb53\xa1ZC\xac\x98\x1a{MhX`\x8c2\x86\x06\xe1W:Y\xd4\x12m\x96\xb5\xf8\x90\x8f\x00\xde\xb2\x00\x81C\x12i\xa0w\xd0\xb83\xa2+3\x1a\xb5\x8a\x8e;\xf2x\x04\x94\x18\x1a!d\x95V*\x07\x01\x04\xaeE5\xd4T=\xc55\xa1\x05\xb4\x11e\x9e\x98f\xa2g\xdd\x8f\xa3iW \x8ci&\xc7\xa6\x00\x94\x95\x07\xe7+\x00\xd0i\x92\x05t\xaa\xa4$\x7fx\x06\xa3\xe7\x7f\xc4\xb9\x07\x1b\xa0iZ\xea\x1ad\xb2\xb9\xb9_x&^p\xc1\x84\x11D\xd0(s\xcd8\x19\xa9\x10d\xd2\x02\xc0\xa0\xc7\xbd\x86i\x8c\x9d\xc0\xc6\xe6l!\x9a\x08\xea,t\x8aZ\xaa\xa9\xdf\xad\xa2\xea\xaa=Nz\xa1q\x9c\"7\xebv\x83\xb6\xa9\xdd\xa6\x91	\x03jV\xcd\xf0d\x11\xa3\x9dDpA\xa9\xa3\xf8\x02$\xb1A\x1a\xfbjd\xdcQ\xf9Z\xb3\x9b6\xeb\xff]\xad\xe7B\xb6QG\xd4Vd\"\xb6\xbfn+,\xab\xe0^\x01\x1c\xb2\xb1\x1e8Yl\xaf\"\xd8h\xbf\x8fy\xe7\x11X5I\xbb\xa8\xa8\xdb\xfez\xca\xb0\xf9\xbaXp\xbf\x06\xb6\x06\xe3\xb3\xde\x01\xdbl\xc1\xd5\x16e\x92\xa3FY\xc0\xf0\xb6\x08D\x00q\xc4N\xf0\x1bc\xb3\x04z'\xaaw\x16pk\xe9d\xeezlR\xc9\n\x03\xa0\xed\x05\x010\x8c\x00\x02'\xa3,\xb1\xbb\x1d\xfek+\xb2\xbe\xca,\x1b\xcd\xeb\xdal\x11\x02\xd8\x92z\x01\x01=\x8b\xfas\xd0B'\x81\xac\xa6\x9b\xbe\xd7\x1al\xaf\xae\xa9\xf3\x058\xf3\xfb\x18e\xcc\xd8\x0c\x00\xd4t\xc2\xa5-\x01\x05\xf0\x1c\xc1\xd5\xe0\xe6\x85u\x8fbk\x9a\xecv4\xc3\xe6\x1de:\xcfm/\xbf\xc6m\xa3pGk3j\xf2,\xa5\x060\x00\xcf?\x03\xbdj'\x12P\x1d\xc0\xe5\x04H\xd0\x10\x14\xda\xc4J.wkR\x0c\xf8\xc8s\x9b\x8c,eh\x17\x8b\xb0\xceP\xcb<\xb7\xe3dG~\xf7o\x96_n\xff\xfb\xed\x98k~\x17\x13\xda}\xcel\xe8`\xef<2\xd4\x9d,W\xa9|\x8c\xff\xcc\xed\xdc\x08\x0c\x16\xb9\xe4b\x02\x80\xfb\xf4\xd4\xe7\xbe\xbb\x99\x13\xef\x8d\x1c\xe0\xda\x8fM\xb5\xdc@\xb3\xe6\x899\x0c\xb1\x1al\xe4\xdc\xfeL\xca\xf3\xb3\xd7\"}\xf5\xf0\xe3\x9e9\x86\xfcj\x1f\x1be&\xef\xed\x1d\x02<\xf7\x1c\xc0\xd5\xaf\xd2\x06\xf90\x80\x81\x0cd\xc0nk{\x9e\x02e7\xb9\xf89\xd0v\x04\x98\x1f:\xc6\xe5\x1d\xfb\xbdf6@\xdb\x1be\xf8W5\xd9yBv\x01\xc1\xc0\xed0\x90@\x00H \x01	H\xe0\x02\xd97\xb9\xda=0~\x11\x94`\xd8\x8aG\xb1\x0b\x8a-\x83\xfdb\xdd\xf7b\x074\xc4x\x82[\xe7\x10\xa1\xedH\xf83:\x11 \x01\x9ac\x9e\xf2\x18\xa5\xbc\xf6\xfd\xe6\x85\xd3\x8b`\x14#\xa8;O\xf4\xeb\x8a\x93\xc1\x1f\xb26\x95\xc0\xcc\xf10|\xe9iH\x06\x08H\xc0\x04\x1a\x11\x89)L\x94\xa9\x14\xd7\xa8\xbaA\x91z1ta\x1c#\xd8;\x8ce\xff\x912tJ\xd6\x06\x11\x10\x00	|\xd1t\xc6b\xd1\x01\x7fe\xc4\x13\x12\xc0[\x9b\xa3\x82\x13\x1b\x01\x00\x17\xbe\x11\x82s\x8c\xe4\xd6z\x07\x995\xe5-Y\xac\xe3c	J\xa0@\xf9\xb4*QgL\xc0!\xdb\x13\x80om\xe2}\x8f\x9cb$\xe9\xe8\x9dCJ\xc6\x92\x96\xdc\xe2\xfe\"g5\xf6!0=,B\xe1(\xb9\x80\xca]\xba/\x95\xf0[%\x1dY\x19\x19X\x8a\xad\x98*\\!\xd0P\xc8\xccf~\xb2=\x0fp\xa1)/\x81J`VO\x98\x87,\x981\x8f\xe9.%*\x13\x00\x07\x08\xa78\x9b\x89\xc2gb\x01\x00\x0fH\xe7\x03n\xe7K\xd28\xd2\x9a\xaa\x9c\xe3\xd6\xb6y\xbad2\xf1y&\x13\xa7>\x0f@\xcer\x06\x92O\x19R\xa7:\xa5\xb9\xc8B4\x12\x9e\x0f\x94$e\xb2	\xcb\xad)\x91\x90\xe8\x03\xe7>\xc3\xd9\xcff\x8a\xc0\x9c\x9c\x13\xa8@\xd9YPBT\x13\xa1\xc1\x8cc'\xe8\x18K\xe3\x00\x8eu\xbf*U\xa3&J\xd1\x8a&@\x04\xff\x1d\xe8\xc0E\x99\xf1\xcf(\xa5\x0c\x00\x0d\xd0h:	z\xcaw\x824\x9e\x87\x8ca\x00\xb3\xd9\xc8CfK\xa5\x9d`iKQ\xc8O\x14\xc2\xf4\xa2\x9d\x98\xa9\xf9\x00\x80K\x80\xba\xc8\x139\xd5)G\xbb\x11\xc7\x9f\x86t\x95E5*\x1d\xc3\xa9\x0d\xa56U\x9f\xcc\x84i'@\xc0V\xf6|2\x914\xd5FV\x05\xcaSu\x08\xd3\xab\xf2\xc3fP\xc7j\xd6\xbe\xa6\xb5\x03\x00\x00\xc1\x07\x06\xfb\x01\x10\x04hE\x8aIl\x05P\x80\x82\x87y\x02\x85sU\xe7V\xa9\xa9\xd7\xbbz\x15\x9b\x12\xed+K\x9d*\xd3\xc0\x82\x80\x04p\x95\x14U\xad\xe3\x00f:\xc0\x01\x8c},Z\x13\xa0\xd3\xbaVBz\x95\xf5\xe9e%\xd9\x81\x04hv\x9f	\xe8\x00\x078\x00X\x12\x80\x16\x94cq\x92'P\xe3\x00}\x9ev\xb1\x00\xb0-n#\x9b\xce\xc9R\xe2\xa0\xab\xc4\xab\x03\xe7\xa8\xb9\xdd\xd6V\xb9f\xcd-ow\x0b\x80\xdf\xc6\xccd\xfe\x98&\x11J\x83\x98\xd2R\xf4\xb8\x8b\xd9\xffl\x02\x98\xeb\xda{XN\x8a\xd2M\xa8H\x01\xa0\xdb\xdd\xf2\xd6\xa5/\xad\xafn=\xf1]`\xf5\x83|)S\xcfi\x13pZ\x07(&\xb3\xb8e\xadF\x9d\xeb\xde\xf8B\x91\xba\x88\x84iLc*\x82\n\xc3\xd4\xba\xf6\x05l\x7f\x815\xbe\xff\x0ek\xb4\xa6)\xf0iS\x93]\xe6\xe2N\xbc\x109\xa8\x83\xaf)\xc9\xf1\xd2\xd7\xbe\x18\xce\xb0u\xedf\xc0\x03\xc6\xa3\x1f\x1f\x06\xf1\x9e\x86\x93\xdd\xf5\xd2\x95\xc1\x91\xe8\xc4\x8a\xd9)L\xa7tB\xbf0\xa6\xb0j\x000F2\x1e\xc5\xc3Q@\x0f\x80\x18b^\xa5\xa2p\xc1\xb7\xebhWV\\Y\nyB\xc2J\x06\xae\xf9\x12\xd0\xe42*d!S\xf0\x84\x88\xd7<`+G\xb6\xbdA\xc6kl\xdb\xa9\x84\x860\x8aN4m\xa6\x10\x03@B\xab\xa8\x82\xcd\x80\xae\xf2j#\x0bdH|\x14\x98\xb1\xad\"\xef\x00\xd2\x89;7\x8a\x9c\x06\xccrJ\xa2\\>\x00\x04:\xd0\xd8m\xe9\x8fm\x87\xe2C\xa8\xd8\x9a\x95\xd5\x9d	\xffF\x9d\xa2\xa30Z\xb5\xe2$ \x99ow\xc0q\x04\x0d\xa7\x0d\x88u\x03:q\xe9Z\x0b\xda\xc7\x92\x85\xa0\x96\xa1\"\xdb\xe9bS\xd4\xa3\x0e\xb6\x97S\xc2\x00f\x8a\xf3rMM\xc0\x08g\x07kY;;\xd6\xb6\xc6tS\xe7\ngF\x14u\xbay]%\xb0\xe5h\x82>Y\xa5\xd8\xcaE\xf6R	\xd8jU<\xfb\xdc\xe8\x86v\xb4K\xab\xe0\xe6\xea:\xc8\xf0\x9db\xb6!\x0c\x80Q\xbfW\x1bt\xee\x91U&\x80Bq\xfb\xb3|\xfaJ\xb7\xc0\x07\xde\x80KS;\xcb\xbb\x9e\xc3\xb5\xb3=\xef\x18\xea\xaer\xbaN\x14\xc0\x8b`jFO\x80\x80\xe2,\xd39\x1d.\x01\x82{<\xdd\x05\xcej\x03\n-\x88\xbc\xc4\x10s]%2|\x8dZ9*\xf2\x97N\x9a\xd3\x1aR\x00\xc0o}&\x9c\xe2s\xe6\xf8\xc7?^m\xa8\xe4%\x04@\x0fz\x08\xc2:G\x86\x07\x80\xe5\x12H\xfa\xcb\x1b\x95\xf4\x12\x18\x19)\xe7\xa89Y\xc7@\xf4\x9c\x87\xba\xe3;'\xf9\x1b~.\xfft\xa1O\xaf\xea\xf1F9\xe6\xec\x16W\xcaEp\x93 \xb9\x08\xd4i\x9eq2\x80\xdd\xeap\xaf,\xc2\xb7\xae\x8d\xae{\xbdzC\xf7D$\xe57\xf6z'=\xe6\xe3m9\x016Y\x02:\xf6\xa8\x80\x15\xd7\xc8\x04(z\xf3<!\xd0\x13I\x8f\xbb\xe4\xef\xbd\xf5\x10<0\xefp\xad:\x1c)'\x81`w\xdbL\x84/<\xa7YEF\x1b3\x9a\x19\xe0n\xbc\"\x1f\xcfz\xbbE\x1e\xb3\xaa\xbf\xa9\xd0\xc9^\xe7\x94C\x92\xf3\x7fWz0\xde\xe9\xad2#\x9e\x1e\xc5\xea\xb4G[/\xfc0\\\xef\x16'\xe7(\xees\x0fx\x17\x8fT~\x9d\xf0=\xe2c\x9f5\xe4;\xd2\xa8&d\xfe\xdf\x11i\xbe^\x97\x12\x85M\xae\xf1\x01\xab\xbfu9B^\xfb\xdbg\xc6\x08\xf4\xee\xfd\xef\x83\xbf\x80\x06\xa4>\xf9y\xa7\xfc\xecS7\xfd\x00\xa0\xc0\xfa\xc3\n\xc3\xe4\x92\x99\xcc\xf17\x7fm\xd0K\xae\"x\x91\x04x\x00\xa0\x01#p\x02`\x07}\xfe\x97\x01\xcc4~\x02\xb8\x06\xff\xef\x83}f\xa7m\xae\x92\x80\x1a@\x01\x0c\x08{Iu\x00\x10\xe8O\x13H\x81\x0c\xb1\x7f\xaf7_\xda@\x01\x1a\xb0\x82\n\xd8\x81(\xc8*\xe1\x84\x01\xcc$\x7f#\x982#\xb0~'(A\x14\xa0\x82+\xc8\x83=\xe8\x82K\x16\x83ME\x835\x18$\xfa\x87\x83\xdb\xe7-;\xc8\x82>\xb8\x817\xe8VB\xc8ODX\x84\xbc\xb3\x83O\xc8\x18\xf9\xc7\x83K\xc8\x84\xfa\xf7\x13\xc9\x15\x85SH\x85u\xb6\x83Vx\x83\xeb\xd7#>H\x86dh\x86^\x18\x85)$\x86[\xa7\x86k\x98\x0c\xf9\xc7\x82\x1b\x88@\x14\xa7\\\x180up\xe8\x06Y\xa8\x86g\xc8*L(|\xe3\x00\x82m\xd7\x87\xab!\x87\x14\xf0\x12\x1aX|\xa8\xc7\x00\x19p\x88\x88\xa8\x06\x7f\xb8\x83^\xe8\x88S\xc5\x00\xe3\x14\x86\x93\xc8*\x8axs\x85(\x85\x9d\x88\x06\x95H\x86\xa0xz\xa3\xc8	\x8ah\x89^p|\xa9h|\x9d\xf0\x89\xaf\xa8\x08\xb1h\x8a\xb3\xb8\x08\x13w\x8b\xba\xb8\x8b\xbc\xd8\x8b\x11\xbe\xf8\x8b\xc0\x18\x8c\xc28\x8c\xc4X\x8c\xc6\xe8\x07A\x00\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95\x96\x97\x98\x99\x9a\x9b\x9c\x9d\x9e\x9f]\x00\xa2\xa2\xa0\xa5O\xa3\xa8\xa4B\x00\x06\x06\x05\xaa\xa6\xb1D\xa3\x05\xad\xb6\xad\xaf\xa4\xac\xb8\x00\xb2\xb2\xa2\xb5\x03\xc2\xc3\xc4\x03\xae\xa3\xb6\xaf\xbe\xa0\xc0\x06\xc5\xcf\xc4\xc7\xb5\xbc\xcb\x9d\x00\xc1\xd0\xcf\xb7\xae\x05\xd3\xca\xd5\x9a\xac\xd9\xc5\xdc\xdd\xdd\xb8\xe6\xdd\xbd\xe0\x97\xe2\xe3\xc6\xe9\xf1\xe7\xb9\xa3\xec\x96\xee\xd9\xe5\xf2\xe9\xa2\x0c\x13\xff\x0cR\xd5\xb3\xb7\xe8\xda\xbb}\xf2\xfa\xfd[\x08\x90\x81C\x87\xeb\x08&\xc2\xf7,\xde\xb6V\n\x192|\xf80\xa2DC\x06\xb3\x9d\xbb\x88\x11\x80\xbf\x86''\x04\x14\x88\xeac!\x8a\xc4\xa6\x914`\x12%\xc7\x95\x16o\xd1s\x19\x08\x80\xc8\xff\x99\x18\xfd\xdd\x84(\xcaY\xb4s\xc3xy\xe4\x99'$9[\xc6t\xbe\x1a\xba\xd2\xe8\xb8V\xd1\x8e-e*\x87\xa5(aP\xa3\xa23g\xb2#\xccwV\x8d\x81\xdd\xca\x95\xcd(\x08\x0b\xe2\xca]\x00aTV\x84e\xcf\xbe\x83f\x95m\xdb3\xa2\xe0\n\x18L\xb8\xb0\x00\xba\xc8\x10\xaa\xfbJ\xcc\xc3\xde\xc7\x03\xfc\xfe\x15#j\x81\xe1\xcb\x97\x11_\xdbw-\xed\x00\xc7\x90\x1fK\xce\xd4rNe\xcc\xa81/\x00\x16\x0f\xa6\x87\xd7\xc5\x1c\x97\xf3<n\xf4=\x05\xb8+\x0ct\x03\xc0r\xea\xdf\x997\xab+\x10\xfb\xb5qa\xa0\x83q\xa3\xad\xcd6%\x00\xb8\x15T\xc8\xbd;\x0d\x80\xc1\x1b6\x00\xdfNX\xb8\xeba\xc6a\xbb:J|\xaf\xf3\xe7\xa2\xa6\xe7\x96^\x9d\xcc\xf5\xec\xda\xb9\xcb\xdfL\x11tla\xd8B\x0f\xfb\xc6,\xbdz\xea\xb0\x84\xf1^v\xf2\x15(@3\xcc5\x06\x8f~\xe4\x9c\x87\xc9(\xff\x01\x18\xe0\x16\xbd\xc1\x17\x9f\x81\xdc\x19\x94`1\xf9\xe5\xd3\xff\x8d6\xfc\xfd\x92^t\xd1\xe96\xe1\x15\xef	@ \x86\xf3\x81\xf5Xy\x9f\xd9\xf7LH\xe2\xd1d\x0f\x84$J\x88E\x85\xd8]\xc8\"p\xab\xb9x\x15\x8c1\xca\x16\xa30\x8c%e\xa3D8\xe6h\xa2\x83G\\W\xd8\x8a?f\x06\x01\x04\x83\xf9\x04V\x82\x1d\xee\xd7 >\xb6@i\x8d@\x11\xea\x08\x05\x00X\x1aF\xe5\x8fR\x12\x96f]G\xa5\xe5\nmD\x1aC\x8b\x92K\x82#\x8a\x04\x04\x10 \x01\x999\xb2w\"\x12\xbd\x0d\xe6\x1bak\x1a(Ja\xbe\x05\xf9\x94E\x15e\x85\xca\x96\x18\xb1\x03\x80\x04\x98J\x10\xc0\xa6\x01\xf8\x99J\x99\x82\x8e\x86\xa6ei^\x96\xe8a\x97\x95\xbaZ\xa1\x84Y\x86\xd8A]b\x13\xa6\x05vm#f8\x99\x12\xc0)\xa7\x9e\xa2\x02\xea\x93Q\xce\xf5\x1b|\x86\x96Zlw\xa3\x14\x86e\\Z\xfe$\xa7L\xc7X@kQ\xb8\x1cC\xd0\xa5\x98\xee\xaam\xa7\x7f\xfa\xea\xe4n\xa3\xd2e\xa0`+\nv\x18\x00\xd3\x1e:\xffW\xb3 b5\xcc5\x17\\\x90K\x04\x11L\xbb\x0bMy\xde\x88\xa9\xae\xdbj\xdbk\x93%\xa2\"\xac|\xe2\xf6(\x80`\xabYP/\x9a\xad\xc2\xe5(_\xbb$\x85J\xbc\xf4.L\xed+!\xea\xabi\xbf\xfd\xfe;\"\x89\xba\xc95\xee\xa1\xc5\xc6[W\xc5\xa7\x1d\xe6p\xc4\xcd\x85\xf5J\xc5\xf4^@/*Z\xdd\xfa\xc9\xa5\x1c\xe7\xdc\xa9.\x1f\x87\xdbf\\\xbf\x15\\\xd8\xa8\x10P\x8c@\x04\x81\x15\xcb,\xb4\xd1t\xe6\x0cM0W,3*\xca\xd8\xcc\x8c\xce:\x13\xa0\n*p\x15*r\xd0\xc6fy%\x001\x1f\x9dl\\+/w\xd1+\xb7\x90\x1dA\xbc2\xa3\xbc\xc25\xbdX\xcd\x0c\xbfXs\xacuDhV\xf6uju\x85\xbd\xda\xc9\x11 \x80\xc0\xd4~\xd3\x85\x18P4\xdd\xfb\xf2\xdbp\x17\x8e\xf4\xdcv\x9b\x02@\x9fyg\xbd\xce[\x7f\xa3\x86\x18\xc9\x07\x8b\x02\xb7\xccf'\xde\xdb\xbd$\x91\xed\xb4\xdb\xf1\x06\x00\xb7\xe1\x95\xff\xd2'\xde\x99w\x8a\xff\xb7\xd6s\xcf\xdd9jc\x83N\xf6\xe8\xf1\"`/\xda}\xa3n\xab\xf0\xbb\xb0\xae\xeb\xeb\x08\xc4\x1e\xcb\xe5\xb3\xd7\xce\xeb\xec}r\x0e\xf4oc\xb3j(\x00\x87/\x7ft\xf3\xa3h\xb6\xf9L\xdc\xd3Z\x0b\xd9\xdd\x17pA\x00\x857?Y\x11\xd0G/\xbd\xed\xd4k\xbd\xbb\x95\xaa\x93\x8c\xfe\xfa\xae\x1b\x0e~`u\x9b\x05\xb40\"<\xf3\xa1/\x02\x01\x18\xc0\xfa\xfc\xe7<\xcb\xdd\x8ev\x99\xab\x9f\xfd\xaeg\xa8T\xd5eT\xad\xe2\xde\xe1\\w\x01\x06\xce\xad.\xb9\x83\x9f\xd30R8\x0b\x80\xaf}	\xec\xa0\x07\xdf7\x04\x00l\x0b\x82y\xab_\xb2Z\x05:\xba\\\x90\x82u1\xdc\xf2T\xe8\xbe\xad\xb5\x875\xeah\x1f\xec\xfc7\x80\x02\xf8\xcf},\x9c\x1b\x0c;5?\xfa\xcdNJ\xab\"\x99\x0dox(\xf4!\xc0O<D\xe2*\x16\xb2\x92\x81\xd0\xe2\x15G<b7\x8e\xd8\xc0\xab51g2\\\x95\xfdhx%*n\xcf\x7f\x01\x90@\x16\x91V7Q\x00\xff\x04\x00\x18\xc0@\x062\xe0\x15\x0d\x86\xf1\x8fe\xbc\xd9\x19\xb3\xf6\xc4\xf8\xb1\nmm\xac\xcb\xf5\xac\x88\x80\x00\x94\xa0\x04a\x04\x80n\x18\x08\x11\x0c\xec\n\x03\x1a\xbcT\x02\x12\xe0\xc7?\xae\xf0}\x97\x1b$!\xb5F\xbdCN\xb1w\xae\xea\xe4\xe1\xb2\xd8<\x05\x8cbf\x01\xb1$\xa70i8Z\x11 \x01\x7f\x12\xe2\xd1\xa4e\xb8\xc9\xb1\xd0\x85\xa2\x1ce)\x97uJT\xaeF\x97\x9elet\x04\x92\x81<\xe6Q\x83\xb6\xc4%'\xd1U/iY,\x89s\x0b&\xd6$8\xc1b*\x92Y\xc8\xf4$\xc0\x02&\n>*la}J\x00\xeeX\x82\"\xcbis\x9b2\x84K\xe0\x12\xd9\x1b\xc4\xe8\x92\x97G\xa4\xa3\xb7\xbe%\ni]n\x93\xb8\xf3\x82\x0b\x07E\x9a%\xbesz\xc3\xbc\x1c\x9a\xdaXOQ\x08\xb1b&\xec%\x006I\xd1iJ2PO\x02h \xe1\xc7\xab\x8d\xbe\xe4\xa0\xc2\xdc[\xf5\x18\xda\x9b\xba\xecMr0\x9b\xd6\x01V\xca\xd2\x8a\xee\x93\x9c\xff\x1em\xe1\x03hG\xd0{\x80\x14\x9e\xd5{\xa2I\xfbVH\xb7Y\x8c\xa5@miE-Z&`i\x01\x00\x0fH\xea\x03v\x15\xd0M\x84\xf2\xa6!\xcdi\xf5\x0c\xb9RT\x045\xa8C\xcd\xea8C\x15;\xa4*5\xa94\x8d\xe9\x1f\x80	U\xe9\x95\xb2\x94WM\xeb\x01*\nT\x8a\x8a\xa0\x03\x1d\x10\xc1Ka:\x05\xaf~5\xa9L\x15k\x1f\x9eZV\xb3J\x10\x00j\x0d\xacP\xdf\x1a\xd7Q\xc8u\x15\x1f\x03Y{\x96 \x8a\x06\xdc\x15\xac\xbb\xaai$\xf8\xda\xd7\x08\xcaP\xb0\x81M\x80\x08\xde*W\x00\x80\xe0\xb3\x13\xdaj\xa8\x9a\x80\n\xc7>6\xaf\x055he_(\xc3\x0e$\x00\xb3m\x85ka=\xfb\x81\x0f\x80\xa0\xb6\x83\x12\xed\x93\xd8\x89\xd8\xd2>6\xac\x0f\xc2\xdcjq\xda\xa7?q\x80\x03\xae}mf;p\xdc\x0e\x88\x82\x04\xb7\xadmtm+\x19\xddV\xe0\xba\xd7E\x01\n\xbc8\x8aM\x9a\xf6\xab\xa8\xbd\x87\xfc\x86\xab\xb7R2\xf7\xb8\xc8M\xffnV	{\\\x00@\xf7\xb3\xb5\xfd\x00	\xe2\x0bZ\xc6&6:\x0e\xa0\xa8\x03\x1c\xa0\xdd\xee\xb65\x01\xbf\x8d\xac^\xed\x10?\xd5\xaeV\x86\x86\x85+z\xe3\xba\xd9\xcd\x9e\x97\xb9\x9e\xbd\xedt\xe5\x9b\n\x12\x9cg\x9c\x0e\x00\xea~+\xb0]\xe5\xb6\xf5\xbbJ\x0d\xaf$\n,\\\xf2r\x13\x00\x8f,\xc1(\xce\x9b^\xf4\"\xb7\xb9\x11\x96\xaet\xfb)\x903]\x147\xf9]i\x026\xac\x9b\xb4z\xf7\xae\xc0}I\x1fE\xc1\xcd\x12[\xb6\xc8H\x9e\xdd\x9f\x1e\x897T\xbc\xd5\xc5p\xed,|\xa5kajN\xcb\n\xa2(\xd1~w\xccc\xc0\xfa\x18\xc0w\x15\xf1 \x00\x10\x822\x9b\xb9\xcc$\xe6f\x0c\x93\xcc\xe6nI\xa0\x04\x10\xdc\x9c(d\xdb\xd9\xf7\xd2\xb7\x9f(\x83\x08\x8a\xfeS\x81\xfd\xfa\x99=\x99\x05\xf1R\x05\xfc\x92\x10h\xeb\xa9l6h\xa2\x93\xdc\xad>=r[K\x19\xf2(\xce93\x868\xef\xbe\xdf\xcal\x02@L\xbb\xa6\nB\x14\x86\xe6\x14\x91\xff7\x95h\xa6.\x9a\x9b\xdd\x1a\x85\x9f\xe0\x0c\xe9$\xa0KZ\xb0\xa6\x15\xa5\x01\xa0\x91	4\xd0?^\xc9\xb1Z7\x19\xe6\xc8\x82\xc4+\xfc*\xb2\xa9OM\xbdT\xcfb\x14\xfd\xaan\xaca=iZ\xd7: Y\x18\x85\x9f\xa7=m\x0fc\x95\xd3\x84f\xc4\xa8\xd1Hl%/v\x15K\xf4\xb4\x08\xfb\xc9\xecU\xa4D#\x1b\x05\x00\xb5\xd7\xedgk\xebx\xd3\xe0\xed\xa8#\xb6\x8dPb\xa7ZT\xe1\x16\x95Y\xc0\xe5l\x8d@\xbb\xae\xa9\x98\x1b\xbb\x07\xaek\xa1~5\xc8\xda\xa6\xb7\x13\x8b|o\xd2n\xec\xd0\xb6)\xcbM\"]k[S\x01\x00\x0d\xc8x\xc6EA\xf0\x8e\x17\x1c\xde!\x96\xf7#P\x01\xc3\xe2\xd6\xd8	\x94\xdd\x19i\xa9\xb2\x95~/\xc4n\x18\xd7\xb8\xcc5\xee\xf1\x81\xbf\xf6\xc7\x90\x15\xf5\x80\xbb\xd2\xc7L]x\x14|\n\xb6d[(q\x8e\xb0\xa5&w\xbc\xf8\xcc\x97\xce\xf4\x8c\xd7|\xcb`\xc6\xab\xc8G\x8e\xadLY\xfdO(\x1f\xc1\x9eJ\x19\xff%\x96P\xe5\xdfQ\x9ax]\x9bN\xf6\xb27\x80\xe0\xdfExA\x82^l\x9f\x9fb\x04'\xb8\xd4\x13	\xe5L>~]\xcf\x84\x12\xbb\x14\xe2\x87)\xb3\xfb\xbd\xe9~6m\x03\xc4\x8c\x08\xb9\xa3z\xe8\xb3\xa0\x80\x06N\x10wLEz\x14\xcd\xcc\xa3\xdd\xef^]\xbdG!\xcd\x8c\x96\xc0\xdf\xff\xae\xf6\x97\xb0\xbd\xd8\x88\x87\x9f\xe2\x17\x1fwX\x88\xa2\xa2\x91\x7f&\xe5\x19\xbb\xef\xbdw{\xd1}\xdf<\xe1\xc7\nt	v\xeb\xf2\x00\xa0\xc0\xe8I\xafu\xcae5\xf5z,:Q\xec\x0by>\xba\xfe\xf5\xc8/\xb5\xce?\xadu\xc3\x9b\xdcf\x00\xd0\x80\x06t/}\xe97\x7f\xa2-\xdd\xe3\x1e%\xbf\xc7\xa2\x87\x1e\x00\xdb\x9f\xe5\xad$\x0d\xf4\xcf'\x7f\xd1\xa4\xde9\x18\x000\x02\xad_\xddj\xd1\xaf>\xf55\xd0~/\xafT\xf2\xa8\xd7>\x1fKC\xda<^R\xaf\xe4\x17\x80\xa9\xb0/H\x16z]A\x01\xf5\xe7vuU}\xd2\xa7{\xa3\xc0Ry\xb4VC\xa5\x7f\xff\xc6\xb7w\xfe'~{%\x80{\xa5{\x08\xe8~\x95\x13\x7f\x0ch\x12\x9bt\x00\x11(\x81\x16\x05~\xddg\x80\xf0s\x81\x01\x10|\xd8ty\x1c\xd8\x81*\x18%\x0c\xa8\x01\xfdP\x82\x12hz\xdfv\n\x14\xb8I\xea\xb7\x0c\xb9\x17\x832\x88\"!(\x82-5\x83g\xb2I\x19@Q?\xf8\x0bB\xa8{\xbd\xb7#\xf2g\x84UE\x19J\xc8\x84/HZO\x88\x80\x81\x94{\xd5\xd7\x0fUh\x85\x9b\x84\x01k\xd5\x84\x96\xf3\x84H\xc8X_h\x12ah\x85$\xa8cf\xc8\x0cB\x08\x80\xa8\xc0\x00>\xe8\x1e\xcaE\x86\x07\x10\x87r\xc8\x81M\xb8\x83\x02\xf5Zz\xb8\x87Y\xc8\x04AH\x01|\x08\x18+\x15\x00,\x95\x86mq\x88\x88\xd8\x08\xd8\xc7\x88G\x08\x88I\x04\x89\x89h\x06\x80E\x89X\x95\x89\x0f\xe2\x87\xf36\x86W\x05v\x85xl\x8e\xc8\x07\x135\x88\x07\xf0\x0f\x9eX\x8ap0Q	\xb0\x84\xac\xd8\x8a\xae\xf8\x06\xb0\x98\x00+Q\x8b\x9c\x90\x17\xba\xe8	\x1e\xa7\xd8\x8b\xc0\x18\x8c\xc28\x8c\xc4X\x8c\xc6x\x8c\xc8\x98\x8c\xca\xb8\x8c\xcc\xd8\x8c\xce\xf8\x8cD\x10\x04\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95\x96\x97\x8e\x00\x9a\x9b\x00\x98\x9ef\x9a\x05\x06\xa3\xa3\x05\x9b\x9f\xa8^\x00\xa2\x03\xad\xae\xad\x06\xa6\x9d\xa9\xb4V\x00\x06\xaf\xb9\xae\xb1\x9aD\xb3\xb5\xc0I\x00\xba\xc4\xbb\xa6B\xb7\xbf\xc1\xcb+\x9a\xc5\xcf\x03\xbc\xb7\xbc\xcc\xc1\x9a\xb8\xb9\xa4\x05\xdb\xdb\xd8\xc6\xdd\x06\xca\xd5\x9e\xd7\xba\xa4\xe7\xdc\xdc\xde\xb1\xdd\xc7\xe3\xe4\xde\xbb\xe7\xf3\xec\xe9\xd8\xec\xa3\xe2\xef\x93\xc3\xd9\xf4\xff\xf5\xd4\x95\x12\xe5n\x1f?s\xa3\x10\xfeK\xd7\x8e\xa0>\x83\x8d\xfa\xbd\x8aG\x0c C\x82\xdb\x1eBT$\x11Z\xb1\x85\x173n|t\xcb\xe3G\x80\xf58\xf5j\xc6i\xe4\xa1\x8e&a\xa1\xcc\xa7\x89\xc1\x84\x9b\x0c61\xd8\x99\xd3e\xa1\xff\x921\xe5\x85\x94\x05\xc0\xe6\xcd\xa38y\xf2\xd4\xe8s\x0fL\x8f\x01\x19\xd6DJ5\xa9\xd2\x9eM\x01%S\xb9)\xdbPYF'\\=\x9a\x93k\xcb\xacN7AX\xc0\xb6\xed\x02\x08]\x05F-*\xf6\xea\xd2P\xf6\xd0\x9dBKG\xed\x02\x01\x80\x03\x0b\x06\xfcv\xd3@\xa9v\xef\xb2\x9a\xd8M\xe8J\xben4\xad\x1dL\xb9r\xe1U!\x8b^-\x07-\xa1c\xa6\x90\xc5h\xfa[\xb9t\xe9\x05x\xd3i\xde\xc99f\xbc{\xa0Q\x9d}\x03\x80\xf4\x86\x0d\xa6s\x0fF\x8d\x99[\xd1\xd6A\xcd\xb9z\x0c\x0c\x80\x82\xe3\x15\x88\xab\xa9-\xe06n\xdd\xd0\x03\xa3V\x0d\xd4\x95\x87\xe0\xcf\xc2-3\x8e\\A\xf2\xd8`\x98;\x8fN>0\xe6U\x14\xafc\x87\x06\xbe\x92\xa6\n\x15\x8e{\xdf;De\x17\xf1\xb7\xcb\xeb\x17\xb0\xaaz+\x0f\x00\xe6r]=\x14\xb1\xc7\xcc{\xdd\xcd\xc7	\x01\x014H\x00}\xb6@\x00\xd8x\xfb\x95\xb7\x8a\x80\x00f\xf8_+\x05\xc0\xd2\xff\x98I\xda\x1d\x88\xa0|\n\x00 \xc1\x89\x124\xa8\xe2\x83\xcaE!^s\xf9UH\x1eL\xea\x0d\x90a\x80\xb10\xd6\xa1I\xed\x91\x83\xe0&(\xa6\xa8\xe2\x8a\x10:\x01\x80\x84\x82\xc5(#t\xa8YG\x8cz\x8b\xad7@A\xefl\x12\x9f&'28\xe4\x90,B\xc1\xdc`J.)\xd8[\x93\xf1\xe7\xd1\x80;J\x19M\x8f\xb4\xfcx\xe2\x96pv\xc9\xc4\x91\xa4\x81\xf9\xdc\x92\x00\xec\x86$\\&-\x16\xa09\xdbTD%D*i	'\x97-\x16Q[\x9d\x94\x85\xc9\xa4yy\n6Y\x93\x9d\xa5i\xe3\x9f\xf1\\\xf8\xdf\x80!6\xb5\x89\xa1\x87\x12\xc9\xd4\xa2\xd2U\xe6\xa8\x00HJ*\xddhcJX\x184Q\xe6\xb2c\x80\xc0E\xd3iV\x00\x10\x00j\xa8\x0e\xb6H\xe7_o\xe5F\xe1d1\x06\x0b\x18'\x8c\xba\x8a\x9ag\xba\xc4\xea\x8a\xa5kj*\x135|\xe5\xca\xeb\xa1\x0f*\xeaV\xaaL\xa6\x9a\xdf\x97\xa8E\xb0\xc9\x98m\xf5\x13\xcbk\xd0r\xf8\xff\x1a'\xeb\x90\xc2\xe6\x81\xba^{\xe8c\x8b\xa2\xa6_\x99\xab\x02\x0b@\x04\x11X\xc0j`k\xbd*\x93=\xcd\xceCT(\x1e\xba\x0bY\xae\xf1\xca\xbbe\xb6\xcd\xb0\x15)y\xc6\nv\xe4\x05\x17\xec\x1b\x01\x02\xe2\x1e)i\xb9\x82Rtn)\x9aX\xe0oWk*\\\xad\xae\xbb:\x1c@\xb6\xb5yL\x18[\xb9\xe1K\xd8\x91pa\x8c\x00\xc7\x9b\x90\xf6\x16\xc8\x80\xaeK2\x00\x16\xf4{\xb23\xf98\xb4\xf0\xcb\x0d\xbb,\xeahnAg3\x7fpi\x8c\xc0\x05<Kv3\xd0^\xb5\xbb\n\xc6E\xf3\xdb/\xbb\xd2\xdc\x8a\xab\x83-\xbb\xcc\xa2[\x8c\x9e\xc6\xad\x00\xbc\x85\xbd\xf3\xd5\x1dk\x027jk\xf1i\x0ePC\x13\x8d\xb1\xd8GO\xd3I\x7f\xa1\x01\xb0b\xda\xd8\"\x1a5t_B\xca/\xc6\x7fg\x0du\xd5\xcbV4\x8d\xadD\x8b\xfd8\xbf\xbd\\sL\xa2>\x19\xbe\xa2\xd3L\xabX\xef\x8c\x8c\x86{5\xe4:\x07.\xb1)p\x9d3\xd14$k.\xff\xf6\x05\x1d#\xf3\xf9\xbb\xd5X;:\xe9,\x07@'yp%\x0b\xc0\xce\x170\x88\xf5\xc6\x9d\xd7V\xb5\xe7\xa2\x18\x8c^>aC\xae\xf9/\xa0\xa3%:\xe9\x0f\xb3\xfc\xaftm\xa3:\xbcy\x1b_\xdd \xd6;\xaft\xe4\xe0\xb4/tM>\xb7[\xcf32\xa1\x19\xe1;\xf7\xdd\xeb:\xf1\xdd\xe1CP|\x9d\xa8\x99\xdb\x05\x02\xa0\xb3\xf4\xd5\x07{3\xd9\x17zL\xf1\xb8\x01B\xce\x80\xf5;\xc2\xf6\xf0\x97\xbfH\xf1f\x7f\x80\xf1\xdf\xff\xcc37\x04(\x0f}\x08\x98\x85>\xda7\x8f\xe3\xf9+\x1cE\xbb\xda\x07\xd1\xc7\xbb\x91L\x90\x82\x87{\x10o\xf4\xa7'\xff\x91\nn\xc7\x9b\x1b\x01$\x00\xc2\x10*\x81\x84\xeeB\x80\xbf\x18\x88<\x02\x14`\x80\xe5k\xe1F^\x08C\xb4i\xc2{\xa9\xd3\xe0\x06\xc5\xd7\xc1\x00\xf0\xb0\x83\xe2\xfaa\xf4\xcea\xc2\x93\x95/\x02\x01\x18\xc0\x00\xe7\xa6DB51N,\x83\xa2t\xa4\xb8\xc1\x00V\xb1\x04%\xe8\xa0\x0f\x7f8=\xf8	\xff1\x84\xe5C@\x18{XF\x83\xdc\xef\x8chK#\xb8\xc8\xa4\xc1z\xc1E\x8e\xab\x93c{RC\xc4\xf4upJ\x1d\xec\xa3\x1f\x11\x87\xbf4\xea\x0fX\x84,d\xb9\x10\xc9\xc99\x1e\xb0H\x9b\xc8H'\xb7\x11\xc9\x08\"\x81a\x94\x04\x9e \x03\xc6F\x1bn\xb2\x93\x8a\xf4\x05Y\xcc\xc2\x15X\x96\xd2\x94\x12L# \x99\x06E\xb8\xb4rQ\x87\x94\xa3\xc9\xe4X7\x96\x00\x00'\x00\xc0\x8023\xb0\xaf\xf4I \x01	\xc8\xa1-%9IK\x02r\x95\xb9\xc2\x99\x0d\xe9\xb4	,\xf2\xcb\x02s\x13\x17r\xa4\xa9\x99d\xaa\x08\x039\xf4\x17\x01\x12 \x81f\x86s\x98\xf3\xc3\xa5\xfd,\xd94\x18\n\xd2{U\xd3\x1f\\\xf4\x97C\xdb\x9d,>\xc79\xde\xd8~\x83\x81s\xa63W\xec\x8cf\xe6LV\xb4\x93\xc9S\x82\\\xd2\xe5\x19\xe9\xf9 A\xa2\x92E\x1a\x1b\xa8J\xae\xc4\x95\x0cx\x94\x99aS\xe7:a\xc6\x95\x87\xe6\x12[\xc1\x9b(E\xbd'Hdl\x02\x9a\xff0\x85\xe6\x88\xe4\xf3\x9d\x921\x14\xa1	\x80\x98I\x9b\xc0\xc4\xfc\xed2\xa2\x14}i\x02\x0e@\xd4\xa2\x12\x15\xa6V\"\x91\x82\x80\x04M\x9d\xeetNN\xab\xe7.\x83:T\xa3Z\xb5\xa81M*\x89j\xcaNj\x16.\x95?\xbd\x16=\x01p\xd5\xb2\xc64\xab3\x1dg\xf6\xb4\xb0\xd6L\x98\xc5\x17am\xe2X\xcbJ\xd7\xa3\x9eU\xa1\x00\x00(M\xdbZ\x05\xd1\xf1u\x11\x00\x08\x81`\x07\xbb\xa0\xb8\xda\xd3\x92d\xad\xabQc*\x02\x11t\xe0\xb1\x8e\xed\x80\x08\xd2\xbaT.L\xd0\xa9\xfc\x08\x81\x8a\x06\x1b\x82\x9er\xcf\x9a\x9f\xb5d;\xa1IW\x986\xf6\xb1\x90\x9d\xacJ\x1a\xab\xd5\xbd\xfeu	\x00x\x00\x9c\xbc\xfa\x07Mh\xd6t`\xe5\xd5JS\xea0\xd1\x02 \xb5\x8d\x0dnd9@\xdc\x0e\x14w\x13$\x00\x81rI@\x82\xbd\xb4V\xade\x8c\xed\x03\x1e\xb0\xab\xd7&\x82\x96\x7f\x14\xebnW\xaa[\x96\xb5\x13\x00\xc4\x0d/\x07\x8c\x1b^\xf2\x8e\xb7\x03\xc8\x05\xff\xc1\x07H\xf0\x81\xf6\xb2\x17\x04\xcdU\xc6s\x15\xe4\xa2\xe9\xdaW\xb6\x88J\x05W\xd2\xb6\xdd\xfe\xf6\xf7\xbb\x00(\x81&\xccK`\xc8r\"\xb9\xeauo{\xd7\xdb\xde\xe5\x8e0\xafJ\xad\xa9\x914q_\xean\xc9\xba\x91\xf8\x14\x1a\xfd\xeb\xdf\x13-\x88\x00%\xe0'v\x01\x80`\xe5&\xb8\xc1\x0bN0r\x1f2_	\xd3\x91\xc2\x15~\x18m\xaf\xab\xe1\x18r8\x8d\x1e\xde\x04\xe7\x18\x04b\"\xd9\x8f\xc4&V\xee\x82?pb\"\xc7w6\x12\xa4lMKj\xccMT\xb8\xba3\xe6H\x8dmL\xd1\x1ck\"\x8f<k\x10\x1c\xf3\xfbc\x04\x0f\x99\xc8).Y\xdd\x16\x99V\xf8\x98\xb9\x02(@\x81\xfa4\x01\xd3\x18s\xb9J\x85=\x9c\x95\xe9\xa3cq\xf18\xc4\x17\x16\x06\x90\x83\xec\xe0\xccq\xae\x85\xadu\x00L\x1d\xe0\x804\xb3\xd9\xaa	xr\x9e]HK\x86\xfa+\xc9,\xdb\xf2\xa2\xf5l\x96\xb0q\xee&\xd1\xe5\x8e\x02\x1c`TB\xa39\xb1\x8bM\xf4}\xff/\x1c\xe5\x0c\xb7\xd3\xd1&\x03\xcd&\xf0<\xe9\x1f:\xda\xcf\x97>J\x1f5-\xe8\xa3z:9fU\xb4\x8f](\x81\x07\xa1\x9a\xcc\xb3\xe5\xe9\xaf\xc3vL\xaa\xccZ>\x84\x86&\xa1\x1d0\x1f\xb3\x8a\xda\xbe\xa4^\xa2	\xf8\x99\xea\x1e1\xd1\xda\xc3.vUf\x0d\xd0\n,{\xd9\xcdv\xf6}A\x85\xd9\xde\x99`\xda;D\xb20\xa0\xacnE\xa1\xfadU\xc1\xb4- \xac\xd4\xbd\xd6\xf5\xd9\xd3\x8d\xf68L$\x81s\xf7z\x87\xed\x9c\x93\x90\x88\x84U\xbc\x8ex\x13\xd5\x8e7V\xfaz\xf0\xaa\x8a\xdb\xbe\xe4.\xf5K\xd2xn\x13x\x98\xa7\xd5\xc5\x80\xb3\xa1\xa9L\x0c0s5e\x19\\\xbc\xa3\x0c\x80o\x9b\xdc\xe1W\xc57~MW\x9c'\x8a\xf6\xdc\xd6\x8e\xf8\x01:\x8e\x81\xbb&\x80\xe6\xcc\xb4\x8b\x08\xb7\xbd\x85\x92\x9b\xfc\xe7(/\xf8\xb8\x87\x84a)\x9bh\xb7\x01\xe7i\xaf	\xbe\xd8\x8f:\x1d\xe7 g\x0d2\xc2\"\x96R\xfb\xfc\xe7@O9\xbe\xdf\x0c\xff\x89S`\xc9\xbfl\xba\xe8\x83\nn\xf3\x04d\x80\xe6Q\x97z3t~\x05\xae`\xfd\xed\xc9F\xf4\xd0M'\xf19\x00\x80\x02#\xf8:\xd8\xbd\x84c\xb2\xd22\xa6\x1e]f\xda{\x92v\xaf\x02\xa0\x01\x88O<\xe2\xe1\x8eu\x94Cs\xd4\xbbf\xc4\xdd\xf1\xce\xef e\xa9\xefO\x00\x92\xf7\xa2yJ\x00\x98\xdd\xe9\x83g\x1f\xdb\xdb\xae\xf8\xd2\x9b\x9e\xf1q?\xea\xdc\x1bT\xf76h\x82\x02\xb0\xcf\xbb\xe5g_$\xd8\x8e \xef\xfcn+\x00\x9c\x9es\xb6\xef^'e\xc1\xc2\xe1MO\xfc\xe2\xbf}\xd0\x90g}\xeb\xcb\xa0\x12\xd8;_\xf6\x96\xb7\x8f\x14\xee>\x82\x13\x9c\xa0\xdd\x8a\xd2\xc4Gk\xa2\x94\xc1\x9d\xdd\xe3\x1f/\xba\x04\x8bO\xfe\xf2/~\xd9\xca\x86x\xe4\xb5\xe2\xfc\xf6\xc7\xbe\xf2\xb5\x9f>\x054\xa0\x81\xea\x9f \xef\x13\xa6%2\x94\xb9%t\n_\xb4\x12`~\x02xz\x0d\x00mt\x17\x08\x93\xe7~\xb1\x87{$7\x7f\xf4\xa7\x01\x94\xc7V\xfc7$\xff\xcc\xf4\x7f7\x86c\x018\x80\xa6ga\xbd\xc2~\n\xf8|\xf8gY\x0f\x08\x81!(|g\xd7j}u\x81*\xa8+'B~D\xb7|_\x90\x80\xce'}\x96\xe5\x80\xf5Gr\x9fWP\xac\x97\x05#vy+\xa8\x82\xeb\xa7\x072(~.\xd2~V\x07M\x19\xf0y\x15(\x1a\x07\xc7\x15>\x08\x84;\xc8\x07	\x08\x83\xf5\xe1\x80\xb0w\x84f\x87Tv\xd0\x84N\xf8oZB\x84h0yTX\x1f#\x08\x81`h?H(S4\xa6\x7fy\x10\x7f1X\x86\xf4G\x01gX\x1f7\xa7\x85O%\x846X\x86\x86ws\x07\xc0yw(\x84\x9c\x90\x87{\x18\x00}8\x86\x7fhY\xd87}	@\x88~x\x88\xe4\x00M\x8ch\x88\x8e\xb8\x85\x90HT\x928\x89}Q\x89\x96\x88\x89\x97\xe0y|XT\x97\xc8\x89\xb4\xc1qV5\x87\xa2(\x85J\xe1p\x8dx\x8an\xb5	H\xb1p\xac(	L\x16\x8b\xb4X\x8b\xb6x\x8b\xb8\x98\x8b\xba\xb8\x8b\xbc\xd8\x8b\x06\xbe\xf8\x8b\x87\x10\x04\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95V\x00\x98\x99\x99D\x9b\x96\x9e`\x98\x05\x06\x03\xa4\xa4\x06\x05\x9d\x00\xa8\x00\x9f\xadZ\x00\xa3\xa5\xb2\xa5\xa7\x98+\xaa\xa8\xae\xbaP\x9a\xb0\xb3\xbf\xb4\xab\xb0\x06\xac\xbb\xc6F\x9a\xa2\x06\xcb\xcb\xc0\xce\xb5\xcc\xc5\xc7\xbb\x99\xa2\xce\xb4\xcc\x05\xda\xda\xb1\xb4\xc3\xc4\xd3\xae\xa1\xdd\xd7\xb2\xcc\xd9\xdb\xdd\xa7\xcb\xb9\xe1\x95\x98\xe4\xe5\xc0\xe7\xec\xe9\xa6\xca\xd2\xee\x91\xbe\xf2\xfd\x03\xf4\xa7\xec\xadk\xa7\xef\x11?\x7f\xfd\x00\x06\xe4\xc6-_\xc1E\x07\x11\xd6K\xa7\x0e\xe06Q\xdb\x1c><t\xb0\xd9\xac\x85\xca\xe8]\x0c\xa9p\xa46\x8d\x1b	\xf1\xf3\x18\x8c\xa2Bt\x17_\x9aD\x992\x90\xaaQ,\xff\xc5|Y\xd2\xa4\xc5\xff\x914k\xfa\xf9\xa6n\xa7H\x93\xdc&\x8e<\x9aQ\xa8\xca\x90\xf7\\\x82DJ\xb5j/MCz9\xd5\xf3M\xa7\xd1\xaa`\xa9fb0\xa1\xac\xd9	\x0c\xc62X\x1bt\xeb\x9b\x9b0\x19\x86\x9d{\x11\x13\xd9\xb3x\xcb\xae\xdd\x9b\xd6\xed\x1d\xa2t\x03#\x05p\x97o^\xbd|\xfb\xfa\xa5\x03w\xaa`\xc1\x84\x13\xef5\xcb\xf6*\xd6\xc5p\xbe=\xde\\W\xf2\xdedF\xd9u\xc2\xac\x06.]\xcb\xa1 K\xae\x16\xcfk\xc5U\xa4\xb1\xb4E&\n\xd7LM\x10\x16\xe8\xde\xbd\x00B\xb5\xd3\x9fo&$'zv\xec%\xb6\x9a\xa8J\x9d\x11Sn\x01\xd0\xa3K\x8f\xde\xfb\xb7\xd5L\xad\xe5\x117e\xfc8\x12\x00\x12\xba[_\xf5|\xba\xf9\xf3\xd5m\x0f\xce\x8e\xd0\\\xa9\xe4\xdey\x110\xce\n\x17\xa6\x05\xe7\xf3\xeb\x17\xb0\x80y\xdd\xd6\x1e\xb4w\x0d8\xf1=\x01\xde|\xca\xa1\x82\x1ft\x1bl\xb0\xdf\x83\xd4\xf9\x17\x11)\x01\nXNw\x05\n\x01@\x00\xe2\x01\x10\xff]\x83\x0eB(\"\x7f\xb6\x01\xf0\x8b\x07(\xceR\xa1=\x08aX\xe0\x86\x08\"\xc7 \x88#\xd6(\xc0r\x05\xa8\x88\xe2\x8e\x14\x92\x92\xa3k\xfe\x10\x98\xa1\x12\x1br(\xa3\x00 \x86h\xe3\x88\xf0\xa8(\xcb\x8e\x01\xfe\xd8\x92?\x04\x0d\x89\x0c\x01\x01\x04\x10\xe3\x11\x00\xe0\x17b\x83K\xda\x18Q\x85NZc\xa17\xd4\x8c\xc6E\x91Y\xd2\x04\x00\x04\xd3\x81\x19f\x8d\x13\x9ex\n\x85d\xb6W\x8b8\n\xf4Y\x81\x9a\xb2e\xd9\xe6w\x0bJ'\xe7\x9c\x10\xf6\xd7\x8f\x99\x03\xf0\xf8\x916\xf3\x08\xe9	\x00}*P\x81\x9f\x97U\x01\x00\x96\x82n\x99\x15\x9c\xe7\x1d\x8a\xe8t\xbd\x81\xea[9w\x0e(\x8b*\xa5\xa4\xb8\x8c\x8b\x8c`R\xc1\xa5~b\n_\x14l\n\xaaQ\x97\xfa\x89\x1a\xa6\x87\xd3\x81\xda\x1f{\x030j\x0e\xa4\x8d\x06\xd8\xa49{\xea\xa2\xc9\xac\x95\xda\nk\xae\x9d:\xc4+t\x85\x1a\xaa\xa4\x8d\xfd\x01K\x1d~\xd5\xe5t\xcf/\x01\xc5C\x0c\xab\xccJ\x9a\xff&\x00\xb4F\xfb'\xa0E`\"\xe8\xbc\x83~\xba`\xb6\xe6\xf9\xca\x9fy\xa0\xde\xe8\\\xb0\xfc)\xaaS7\xc6\xfa(\xee\xb9\x98\xa4\xab\xee1\x99\xb4\xeb\xae\x9a\x99pJo\xb5Y\xed\xd6\xef~4\n\x00\xaa\x9c\xe5\xf5\x17\xc1\xbf\xd1\xe5\xa6\x9b\x89\xd8l\xf3\x8b2\xc1\\U\xd1\xab)=\x1b\xad\xb4\x9bN,\xb3\x91\x1a\xeav\xf1\x88\x177\xd8\x1b\xb8\x00D\xf0\xf1}\xdf\xdaL\xf2\xb1\xe6\x00\x96\x89\x05HWc\xca9\xb0\x8a#\xeb\xcb1\xcf<s1]\x96\x87m\xa27\xdf(r\xcf\x11 `\x01\xc8\xd0\x89,\xb0,fF\xd3\x0b\xd2>G\xf05v\x03\xb3\xecW\xc3\nD-\xf5\xd4\xb7\xf8\xf6\xad\x88Vc\xfb\xe6\x05\x17\xf8\x8c\x00\x02?{\xab\xf1nC\xdf\x83S6\x00 \xcd\xb7\x05i\xabm\xcb77U\xf9\xb6\xbcs\xcf\x9d	\xb8\xba\x89\xd8\x9byob\x12\x01\xdf\x7f\x07^\xa8\xd8\x85\xb7\xcdt\xe3\x9f\xf7\xed\xf3\xda\xb7\xe0\xb3\x9cwrW\xdei\xb5]\xee\x86\xff3\xbe\xdd2\xde5\x02\x17\x00\x9e\x89\xb0\xa4?J\x0f\xd7\xa8\x7f\xees*{\xde\x8aY\xec\xb2g\xc9)\x01\xf3\xd9Nj~Y{\xbe\xfb\xdf\xbc\x07\xce\xeb\xce#3\xc3\xdd\xe9\xc5\xa7\xdd7|\xaf\xdf\x12\x1f\xf3\xcdk\xa9e\xf4\xf8\x0e\x8e\xde\xcd\x1e\x03\xce7\xdf]\xff|\x0b\xe1\xb9\xf9F\x121f\xfb-?\xfdi\xbb\x95\xf2\x8e\x83\xbe\xe6A\x0fz@\x93\xce\xe6\xces\xad\xe8\xf4\xeco\xf3\x9b\x9f\xd7\x1e\xb7\xb5\xce)d\x18\x99\xc0\xde\xff\xe8\xe7\xbbxY\xe9\x16\x12K\x9f\xf3\x0e(\xb8n\xb5\x8fD\xd9\xea\x0f\xf6.\x80\xa5\xdeu-\x15\xe9\x81\x07@`\x81\x8a\x8fi\x90o\x01\x90 \x02\x9a\xb6\xbc\x10\x8a\x90\x84\x0e\xdc\x94\xe0\xa4\xe3\x9bl=\x10\x82Y\xea\xdd\xdf\xc8\xe7\x1b\x0d\xbd\x04\x16\x89\xf3\x1f\x04[\x08\xba\x1d~\xf0;>4 \x02G\x06D~\xbd\xa9P*\\a\x0e\x95\xb8\xc3|\x90\xef\x82\xa8@\x9a\x06yG\x80\x02\\ \x00\xbb\xe3!i\nX9\x04b\xff\x82\x84\xed\x83@\x11\x0bu\xc4\xbfQ\x11{\xd6\x8a\xd7\xfej\xa8F\x0dF \x00\x03x# \xaf\x98\x04:J\xed\x80\x90\x9c\x0f\xa9\xf4\xb8G\xbd\xad\x91\x00\x12 \xa3\x15\x91\xd3\x98\x1azm\x8d\x08@\xa4&\xe58G\xe8\x89p\x84\x91\xf4\x96\xcd(Y;\xe8\xf4\xf1o\x01\xc8\xa4!;T\x97\xeb\xad\xb1X\x1a$e\x0fM\xf9\xc3T\xc2\xc9b\x94\xacd\x185\x18\x80\x12\x94`\x8d.\xfa\x0d\x00@\xf97m\xe4\x92\x91X<\xe0)S\xc9\x9b`\xea\xb1v\xf1\x03e\x15\x17\xd9H\xd4d\x90\x99\xc8\x84\xe6\x95\"9M<\xae\xd2\x9a\xd8|%8\x97\xf8\x1d\x00d\x00\x03\x18\xc8\xc0\x11\xc1\x93\x80\x04,s\x9d\xec\x14''\"\xc9\xcb\xf4\x91\xf0\x9a\xce	f\xed|cK|\xee*\x13\x18\x10\x14\x06\xee\xf95\x02$ <\xb6T\x9b\x05B\xa7K\xb7\x14\x89\x9fY\x9c\x9b\x1d75\x1ft\xe6O\x9d^\x9b\xe8,\xcdx\x97	\x00 \xa1\x01X\xe8\xdf\xbe\x16\x80\x87\xda3\x8a\xffHc\x1c\xeb\xf4\xa9!z\xf1\xf3\x94\x1c\x82\xe4\x9b|\xa3\x89\xfe\xcc\xa7\x8fi\x13\xa9\xef,e\x19\xbd\xb83\x03\xf2\\\x1d\x8c\x12\x80 \xcb\xd04+3#'N\x9d\x17\xb1\x7fr\xf4\xa7\xf5k\xdc\xda^F\xd4\xe3U\x06\x131\xddP==\x95!x!\x83Z6\x85\xe4T\xe7\xc5\xcf\xabn4\xa8Wi\xd7=}\x87		\x84'\x13-ejE\x95c\x89\x0d\x85\x00P\x9a\xc8\xe8\xc4\xd4\xbaV\xb6b\xf4\xa7/\xc5D\x02D\xd0\x81\xc6\x8a\xa0aq\xd5\x84]\x1d\xfa\xd0\xbd*g\x80\x06	@\x086{\x15\xc1j\xb4\x9f\x85E\xa5N\x0f@\xda\x04t\x80\x03\xa8m,jS\x0b7\xae\xbe\x0b\x00\xf5\xb4,\x13.*[>\x14i\xb3\xb8\xfd\xabg\xeb\x08\xda\xc2\xb6\xf5\xb4\xa9\xed\x80e\x80\xcb\x01\xe1\xb6\xf6a\x98\xedB\xae\x92\x1b+jq6\xb4\xeb\xdb\xad\xec~\xdb\x81\xc7*\xb6\x9e\xd8M,c\x1b[\xdd\xe3V\xea\xb5\xb5\x8d\xd7\x03BHVHd\"\x04\xf3B\xff+N\xa5\xb9\xd6H\xde\x15\x13\"\x88o|\xb1\x8b	\x12\xd8W\x13\xdb\xed\xee\xd3\\kVM=\xe0\xbf\xf4bn#z\xf1W\xe8\x1a\x18\x92\xef\x95\xaf\x82\xadk\xdf\x06;\xb8\x17\xf2u\xd9\xcb^+\x9b\xffZ\x98\xbc\x026\xc8\x1d\x0d\x1c\xdd\xdeF\x15zw\xcd\n\x00\x16\x0c\x00\x12\x80\xe0\xc4(6q\x8aAp\xdf\xfa6\xd8\xbb0\xa3\x02\x00,Lc\x0c;\xcb\x91\xbc=,{E\x1bbd\x90\x18\xc5,&\xc1\x07\x86<d!\x17\x99\xc8)\xee\x85\xc3\xbe\xdb\xdfv\xce\x98\xc6\x16\x0epx\x01\xb1a\x7f\xea\xf8\xca \x160|#\x1cd\x10\x10y\xc8^&\xb2\x91?\xc0b\x88\xc1\x98\xa8\x19\xd6\x90&\xa0\xfc_\x1b\xdfXb\xa0\xc5\xb2\x9c1\x99f5s\xf9\xcbHFr\x98A\x90\xe13\x83\x97H\x9aH\x00\x9b\x1f \xe54\xc1y\xcer\xbe\xab	L\x90\xcc^\x84\x19\xcc_>1	\xc0\xfa\xb5\xcb\xb2\xcb\xb5\xe0\xd5\xca-0QZAC\xd9\xcd7\x96\x1b\xa2\xff\xf9\xf9^\x13\x18\xd3\x98\xa4\xcc\x84\xa4\x93L\xe9\xb55z\xbf\xdf\x9d\x95\xacg\x95\x89\x04\x90\xf6\xd6\x9e\xfetz\xa7,\x88\x88\x19\x16\xcb\xef\xe5%\x01N]\xde\xb3\n\xd0\xd5\x9a\x88\xe9\xda\xd82\x85\xe3:\xc0\xd6\xa5u\x00\xad\x01pk\\\xe7\x9a\xc6l\xad\xb3$\x02\x9b\xd6\x03\xbe\xd72\xcfK3ab\x9b\xec\xe2}-i\x89\xd1\xe5~\x9f\x8dk\x07H\x1b\x05\xd4\xaev\xa7\xd9\x0c\xeaix\xf3hI\xd3*\xb2\x9d0\xee\xec\xc6\x16u\xc8\x8e\x0c_*\x8a\x89>\xb9;\xbb\xee\xa6\xb5\xbc\xad\xcd\xe6B\xd7$q\xca\xfeZA\xed\xc7o\x06 \x15\xa9\xf4\xd5\xdd\xb2\xd3\x82T\x813\xfb\n\x97\xae\x80\xbbG\xeen\xa2.\x9c\xe15\xce\xf6\xc3#\x9eo\xc7\xdd\x11C\xfd\xc6\xee\xc5\xe59\x16\xb3\x1c\xd5\xe3\x1f\xbf\x04\xa5\xb8\xca\xe4\x93\xa3\xfc\xc2\xbbnY\xc4{!1\x983\xc0\xdf\xd9%L^n\xbe\x9aW\xdc\x1b\xb6>\xc7u\xc3)\xf6\x90\xab\x84\xa7\x9f\xc3.\x01}\x8e\x8e\xff\xf4\xa4\xe7%-8\xcf\xf9\x16\x00@\xf2\xb2C\xdb\xe7\xf5\xd4\xb5\xaex\xbd\x87Lq\xc2\xaep\xb7\xebU\x89\xbd\xf5\xa4\x07\xda\x9e%\xb5y\xd8\x15\xb3\xa6\xb2\xfb\xfd\xe0h\xbf6\xa1i7\xe0\xcb`\x82\x02\x88\x1f\x01J\xc0\x03\xf7Ec\x82\xd8\xf5\"\x12\xd7\x95\xa7\x89\xa5\xdbe\xe0\xa0\xf8\xbb\xe6\xcf^\xed\xb4\x03\x9d\xea\x8a\x00\x00\xe2\x11\x7f\xf8\xd1+\xfe*\x1aZ\xb4\xea\x19\x8d\xd6\x0e\xb5\x05\x00KWs\x93e\xa3\xf9\xda\xb3[\xde\x9eos\xd0\x13!\xfa\xd1\xfb>\xf1\x12po&\xe2.\xf7\xd6\xbf\"\xef|\x07\xc5Ul\xcf|\xce\x0b^\xe5\x88\xe8\xfd\xefM\x1f|\x8c\x12\xbf\xf8\x13\x93\xad\xc0mN\x06\x004\xe0\xfb\xe0\x0f\xbf\xf8\xc3\xdf\xfch\xa7|\xed\x86\x90\xfe\xf4\x81_}R\xc3\xbd\xca\x01\xd6\xf9\xde\xd9\x8e\x8c\xf1\xdb\xff\xfe\xf8'y=\x1d\x80m\xd0\xf7z\xfd\xbe7\x02pg}r\xe7Y\xea&O\x9eA\x7f\xf5\x87\x7f\x0c\xd8\x80\xf8\xf7_\x0d\xb0{6\xe1{\xff\x99\x10\x80\x92\xe5^\xe1a9\x9a\x02O\x08\x88ye\xe0V\xa3\x86I\x12\xe0\x80\x0c\x98m\n\x18\x06\xbdG>\x9a\xb6i\x8cW\x80Q\xa5n\xf0\x04O\x1ew\x82\xe3\x14\x826(g\xfeg[\xda&bw\x94E?\xa5)\xef\x84R\x08\xb8\x83Y\x00\x827x\x84WF\x84\xbdvzq\xa6\x84\xc8\xf0N\xf3\"O\xf2\x84\x06OW\x85Vx\x85\xa8g^#p\x02 \xe8\x84\xc8PO\x19@/\x1d\xf7Tr\x00\x00\x1ap\x02\\\xf8~\xaf\x00\x86a\xa8P4H\x86\x06B\x01\x1ap\x86\\8{\x06\x02\x86`\x98%\x0b\x05\x87q`\x86sH\x87\xa7W\x84\x07\x80\x01v\xf7\x86|\xc8o\x7f\xa8\x01\x89\xa7}\xa4\x85\x01\x07\x10[\x87X\x86r\xf8\x87\x14\x10\x88 gk\x01PZ\x86\x18\x89\xfc6zsX\x89\x8cx\x00\x99hO\x9c(\x89\x14\xe8\x85\xf1\x82\x89\xa4\xb5\x89\xa58[\x93Hzc\xa7\x8a\x07\xc0\x8a\xadH$\x89\xa8\x88\xa8\xc8	\xf5\xe4\x88\xb7F\x8bE\xb5\xc8%\xb7\xf8\x89\xb9x\x0b\xbb(o\xbe\xf8\x8b\x9c0\x89\xc1Hp|qv\xa4\x88\x8ci\xd0\x0b\xca\xa8\x01\x04Wy\x94q\x8c\xd0\x18/n'\x1b+\x98\x8d\xde\xf8\x8d\xe0\x18\x8e\xe28\x8e\xe4X\x8e\xe6x\x8e\xe8\x98\x8eK\x10\x04\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92m\x00\x93\x96x\x00\x95\x97\x9b]\x99\x9e\x9f\x9aI\x00\x05\x99\x9c\xa6U\x9e\x05\x06\x03\xac\xad\x03\x06\x9eH\xa3\x06\xa4\xa1\xb2\xb1\xa7\x9c\x99\xaa\x06\xbd\xbe\xab\xae\xb4\xb8D\x00\xbe\xb5\xb6B\xbb\xbd\xb5\xb9\x93\xca\xbf\xd0\xbe\xae\xaf\xc7C\xb3\xbf\xc7\x9e\xc0\xad\xc2\xc8\xcd\x8a\xd7\xd1\xd3\xaf\xbd\xe3\xdd\x95\xe1\xd1\xdb\xe6\xa4\xdf\x8c\xd7\x05\xf1\xf2\xe5\xe6\xeb\xc1\xd9\xbc\xd0\xe3\xe3\xed\xee\x88\xb3\xf2\x02\n\xb4\xb7o\x1a\xad\x80\xea\x0e\xc6['\xcc\xdf\xa1b\xa9\x04JTU\xb0\"\xab\x84\xd8\x16r\xeb\xe7\x90P\xa8]\x13\x07Z\x0c\x86Q\xdd<V\xf1\xbcu\xf48q\x19\xc2\x91\x17K\xba\x94\xa8P\xe5JA\xa3Z\x1a\xd38rf\xc8\xff\x9f)\x89\x95\xba	(g\xc8\x8c\x05`^\x04*\x10T\xa9L\x0c\x18\xd8$z\xc7\xe8Oh\xf1\x94\xde\x93\xf8\x89\xc1\x84\xaf_\xa5F\x8d:\x95*\x1d\xab@\xa3%\xd5\xea\n\x94W\xb0p\xc1\x8e%kV\x0fZ\xa6\xf9\x96\x11\x1c	\xe0m\xdc\xbfa\xe7\x96\xad\xeb\xe6.\xde\x85\xfa\xd8\xf6\x9d\xcb\x18\xaeT\xa7\x9f\x08\xcf1|\x18\xb1^\xad\xb0\x183\xfe4\x10\xdb0\xc9k(W\x9e\xe7r\xef\xbd\xc5\x9a\x95\xb1\xa3\x18\x93\x19h5\xa2G\x93VHP!\xa9\xcd\xc5`\xd2k=\xf4\xb5\x99Q\xa0d\x0b\xe7:6\x93\xe9\x82\x0c/\x0e\xf6\xcd\x05Y\xc4\xe1\xc2\xb5\xb1\xad\xb8\xad7s\xd8\xa2!\x83\x1c\x9d{\x9a\x87\xe9\xc8\x97_\x0f\xb3\xfd\x13\x84\x05\xe8\xd3/\x80\xf0\x1chwW\xdf\xc1\x87\x1fO)\xd3y\x01\xf8\xf3\xeb\xc7\xbf\xbe=\xd7q\x1e\x04\xe8\x1dJ<\xe9&\x1e}\xcd\x01p\xdf~\x0c2\xd8\x9fa\xa3x\x17\xe0\x84\xac\xc4G\x0b5\xac\x8d\xc4\x11\x82bd\xb2\xff@\x83 \x86\xb8\xc0v)\xd9\x13_\x85\x14\x0e\x90\xe1A\x06r8\x06\x00\x1f\n\xb0\xc1\x06!\xd6\xb8\xdf\x889\x19\x07\xdf>\x16\xae%\xdf\x86.6\x87\xdf\x8c4\xdahd~8^3R|\x19\x82\x07K\x90^\x00 #\x91GV\x99\xdf.\xaf(u\xe1\x00\x02\xae\xd6J\x97\x0dA\xa9\x85\x94E\xceh\xe5\x99\xdd\x1d\xd7J\x86)N\x13!IO\x8a\x89\x85\x94\xfa\x99y\xa6\x95\x00p\xa3aAyr\xf9\x9d\x8e\xdc\xf4r h\xd6AA\xe7~v\xdei\xe4\x88\x81\"\xe7c[\x06a\xb9\xe62\x83\xd6\x05@\x00\x95Zsc~E*j${\xf7\xd8\xd3$+}r\xf3\xc96\xf9d\x9aHd\xa8\x04\x80i\x14\x1e\"\x19#\xa7\x9e\x82\xb8\x1e\x04\x020j\x90m\x8f\x92\xba\xcd9\xc6\x01S\x0c\xa5\xa6\x00\xa0\xc0\xb1\x15\xb0\xfa\xc4\xa5\xae\x12p\xa0}\xea\xad\xd7`\xa2w\x1e\x9a\x1f\xae\x02\x80\xea\xe8\xa3\xc1R\n\x80\x05\x16\xa4RN\xb7\xaa\x1ab,\xb2\xc7*\x90\xff\xecg\xb2\xb8\xea\xee\xb3\xe7\xa9\x87-\x88\xd4\x1e\x89\xe3\xa6\xb9\x96j\xce{\xaf\x80\x94\x89\x05\x11D\x10\xae6\x82\xae\x00P\xb9\x85xRA\xba\x0b#\xab,1\x04\xb8\xeb\xea\xa0\xd0\x8ex$\x95\xf8\xcd{\xed\x95\xf6m\xda_xp\x02p\xc1\x05\x00\x07,0g\x05\x1b\x0c\\3\n7\x9c\xae\xc3\xc30+\xb1\xb3\x86f\xe2\xa9\xb4vJ\x9bo\x04\x1d_\x9b\x9e\xbe\xae\x90\x12(,&\x8fl\xf2\xc0\xe2\xdaR\xe8)\x9f\xb8\xfc\xb2\xba\x9eD,\xf1\xc4\xb0\xd6\xba\xa0\x99\n\xe2\xf7-\xcf\xb1\xf2\xf7\xf3^\x11\xfe\x02\x80\xc9E\x07\x0c\x8a\xc1@\x12\xd5\xf4\xd3\xc7\xca<\xf5\xabM\xd8|\xb3\xc6Z\x7f8b\xc0\x03k\x1c/{\xd2(\x07\xcd\xd8d\x97\xad\xec\xd2\x96*\xdc\xb6\xd4oS\xcd\x04\x8cV\xcf\xca\x9f\xc8\x17l\x8d\x00\xd7\x8cg\xfcs^\xb0\xe4\x05x\xc0#_@6:J#\xf8I\xe2S\x13NLz\xfa9\x1e\"\xdd\xd9\xc2\x08\x81\xd1\x93{\x82^\xdd\xe8\xf1m\xff\xcc\xb0\xc2 \xa0\xfb\xe4\x9d{\x1e\xbb\xc1r&\x838\xe9\xcd>|z\xb4\xf6\xb2\xae {\x9c\xc7^\xb1\x00\x1e\xa2\x87{\xe6\xe7\xec\x8e\xc0\xc8\x01t\xae;\xc2\x96\x12\x9f\xb8\xb3\xc6\x1b\xac^\x95\x95#\xa9 \xe0\xba{\xfe\xc9\xcf\x06\x9fg{\x8e[G`\xfd\x05\x11k\x8f\x00\xf7f\xb9\xed\xfd\xcc\x90Y\xa3z\x8d\x16\xdb\x8f'z'?\xca)Hz\xc98\x1f,>!0\x0bX\xefz\x04(\xc0\x05\x02 \xbf\xfb\x05\xcf\x1a\xfb#\x1e\x01$\xa00\xd9\xd9\xaaA\xec\xb9\xd1\xd8z72\x04 \x0dZ\x1f	\xd61\x1a\xf8\xc0\x08\x04`\x00\x13\xdc\x1d\xfe\xa8\xa2\xbf\x0c\xce\x8c\x00\x1b\xf4 \x08\x1b4\"\xd5\x01\x8e~\xd9\x9b\xdc\xfd\"s>\xa1\xe4\x83\x14\xf2s\xe0\x03_x\x01\xeb\xcdPm6\x9c\xd9\x0dq\xc8\xc1\x05\x99\x0fD!L\xdd\xd8\xae\xe7\xaa&n/f\xde \xd1\x16\x1f\xa8;\x159\xf1\x82\xc9\x88\xe2\x0d\xdd\x85\xc36V\xac?X\x94\xdb\x95*8\xc1\x12n\xffOe\xc4\xb8E\x8e\xc8\xa8\xbbx\x9c\x11\x8d\x00\x18^\x14\xdb\xd8\xac6\xe2\x10\x14Xd\x8f\xc6F\xb4\xbb\xfay\xd1\x82\xcb\xd1\x0e\x00\xf8H\xc6'BQ\x8dl4\xa4&\xdd\x98H\xf6\xcc\xean\x8d\x94\xc0#-\xb88\x0c``\x8b\x00\x90@\x02\x120IJ\xca\x10\x8d\xc2\xc3d&7\xc9I\x07A\xe0\x96\x9e\xe4\x0f{\xac\x17\x00QZ\x8fgJ\xc8\x04\x06\\uJ\x13\x062\x01\x1c\xac\xa0\xeeX\xf8;X\x1aL\x90\x83\xa4\xa5\xb3TW;\\\x1e0W\xa0\xdc]\x00JP\x82\x07Net\x01(f\xb8\x02\x80LVn\x0d\\\x00\x1b\x983\xad\x01M\x1b\xd2\x12F\xd1\xaa\xdd\xf2<\x99\x9e]>\xd0\x8e\xaf<\x02\x00\xe4\x02\x00\x0cd`l\xe3$@\x02h\xd6\xbfuBL\x96\xb3tc\xc5\xd83\xcf\x03\xca\xd3\x95\xde\xd4#Y\xfe	\xaeK\xad\x92f\x06]\x1c\x0e\x11\x9aP\x85\xda\x07\x97\xf4\xec\x8f2!:\xc4\x98\xc9\xf01\x9e \xe7@-\x19\xa4@\xb6S\x8d\xff\xef\x9c\xa7\"\xe59F\xeb\x81\xab\x85\xe7\x82\x9a'&70	\xf8\x14\x87\xab\xe4`F\x97EH\x8eN\xd1\xa3\x1f\xcd\xc4!kz\xb4\xdd\xf1\xcci\x0c\xfb\xc4*\xa7:U\x96\xc6\xcdt\xdfp\xe9F\x8dzT\xa4n2\x13\x05$\xdb\xc0\xcc	\x00\xa8F\xd5\x13UE\xc3\xa5\xc0w\x13\xad\x16\x95\xab\x1d\x95\xe6R77V\xaa\x92\xb5\xacl\x83\x19V\xa3\x848\xb6\xfa\xc3\xad\x9a\x84\xeb\xdb\xe4z\xc8\x0ep\xe0\x13\x1dHlbWy\x80\xc6\xaermy]\xd7^\xb3\x00\x80\x07\x0c\xcf\xaf\xb9p\xe9\xd4\x02+\xd8\xcd\xbe\x93\x03\xa0U\xacaA\x1b\xda\x0e$\xa0\xb1\x8e}\xac\xe1\"\x1b\xbe9=\xe0\xb5\x96\xe5\x9fU\xfd\xa0Y\xd2\x19\xb2\xb3]\x9d+dD \x82\xc4\x86\xf6\xb4\xa8\xb5+d\xd9&Y\x96V\x16\xb6\xaf\xbd\xecd\xdf\xf1R\xcf6\x17\xa1_\xcd\x04o\xa7\xcb[O\x8c\xf6\xb7T\xe5mbE\xe0\x14\xb3\xea\x95{\x99@.r\x95k.I\x9e-\x90\x98|\xff+W\xbf\x9a@\xear\x97\x04$\x90\xaebI\xab\xd8\xea\x02 \xbe\x15E)^Y\xbb\\#|B\xbc\xb0-\xddl\xd3\xc0\xac\x10\x18\xf8\xc0\x08\x0e\x81Rq\x0b]C:\x07\x00\xd5\x05\x81\x84\xe1\xeb\x89\xde\xd6\xd7\x13\x14\xce/n2\xe1\xdd\xe2>\xeb\xbf\x00V\xee\x80\xcf\x00\x80\x10\x90.\xc15d\xf0\xfe\x1c|\x0b	\x83\x80\x04.\xa60(\xe0;\xe1o\x85K3\xc5\x19\xee\xd3<\\P\x95\x01`\xaa\x00\x8e-\x1b\xfbk\x87L\x18x\x7f)V\xf1\xf7\xa8X\x96L\xb8X\xc2\x1f\x88\xf2\x84i\x0c\x02)\xdbx`8\x96\x8aP8\x1c\xd9\nx\xf9\xcb_~\npS\x1bd\xf2\x0e\xc2\xbc\xa0\x10\xec&m\xdbF\x0e\x8a\x07\x00U\x8e\xb2\x94\xe3,e9\xc7\xd7\x13\x19\xf8'\x8em\xa2\xe3c9\x80\xaa\x0ep\x80\x9731\xe6\xe0&\xa0\xcc\x12#\xf2\x99\xd7K\xd8M\xba\xd9Pq\x86\xb2\x9c?@\x029\x83@\x13\x00\xc8s\x9eQC\x97\xc5q9]\x7f\x0en\xa0\xff+\x80\x02\x00\xa0\xf6\xd4\x07Xe\x90e\x0b\x89$g\xb0\xd1\x86|\xb4\x14\x9c<\xe9(\xc3\xd8\xca\xd6\xc8\xb3)\xf5\xdc\xe9ee\x02\xd4cN\xc0\xa8\x93\x85\xeaS\xabZ\xbc\"v\x04ze	k&\xb3\xcb	\xdf\xf2D\xa4\xe1{\xe7\\\xebz\xd7}\x01o\xc3\x02\x0dhA\x13\xbb\xd8\x86\x0e1\xff\x94\xdd`\xc2\xba\xb9d\xe5\x8a\xdf	\xd9\x95\xe9\x0c\x98\xf2\xdd\xff\xbc\x02\x97+\x10\xe8z\x07\x1aj\xe06\xf6\xa1\x91\x9d\xe8\x11\x17\xe6\xb9\x8357X\x8f\xa6hk\xc4\x0fo*\xf9q\x02\xdc\xfdn\x7fZ\xf2\xd7yuX\xa1\xc1}\xec\xf1\x0e\xd9\xdflpu\xc0\xa5\xe9f\xb1fB\xa8\x86\"\xcb\xd9\xfc;\xd5k\x9br\xb6h\x06@\xbd'\x8e\xea\x8a\xc3\xb6\xaf\x18\x87M4i)k\x1b\x7f\xdc\x8d\x86\xfa\x8b\x96I\xbe\xf0Uj:\xe6*\xb7\xb7\xd0\xed\xcdr2\x8b\xb7\xdf\xffp'g1\xebc	\xb4\xd9\x04\x95\xdag\\vN\x0c\x9f\x0b\x17\xe8C\xcf\xba\xd0Y\xee\xffr!\x07\x80\xe98Y\xf1\xd2\x97\x96\xca\xa7\x9b\x00\xea9\x8fK\x18\xadN\xd5\x98[C\xebp'\xba\xbe\xf9]<\xb7\x7f\x01\x9cK\xbe\xed\xd7\x9d\x92@O\x9c\xfd\xef>\xa5\xd8_\x9c\xc3v\xd5\x92\x07\x14qO\xfc\xca\x8d\xfe\xf2!\xef\x01\x14>\xf5i\xde\xb7\xbaw\x00\x94\x00\x00\x14\xa0\xc0\x08<\x11\xf9\xc0\xb37n~	\x8b\xd2\n\xcf\xca\x175\xe0\xf4\xa8O\xbd\xeaW\xafx [|bv\xb7B*;\xff\xd3W\xa7\xd4Y\x99\xd7\xfc\xeci\xffS\x16/\x01*\xa1\x9f\x80\xd2\x1a\x8b\x81\xb6\x93\x01\x00\xabO\xbe\xf2\x97\xbf|\xd86\xe0\xe2U\xe1\xbd\x04\xbc\x07>\xb7a>\xf7\x9b\x97~\xefq.\nN\xfb\x85\xea?>\x00\x06R}\xda\xd8\x1b\x8c\xf9\xe8O\x7f\xf3\x1b\x00s\xf3\xc3\x8a\xf6\xd0\x9c\xeb\xdb\xae\x8f\xfd\xddK\xdf\xf7\xfa\xe4uj\xac\xc1\x80\x04\x8c\x1f\xb5\xee\x87|\xea7\x80\x04\x88z0Wd\xbd\x97Ix\xc7?\xb9W\x7f\xdaG{\xdftr{F\x0c\xff\x0c\x90\x01\xc6\x16\x80\xcd\x96\x81\x1aHK\xef\xe2~\xbe\xd6?\xcb&`\x0d\x88}\x9b\x97r\xa2\x00o\xde\xe7\x1c\xfdwj\x1e\x08X\x1b\xf8\x82\xcdVw}\x90b\xf4G\x01\x9e\x90y#\xb0yT\x90i\x0d\x87\x1a\x84\xd3\x17\xc1\xd5\x82\x19\xd8y)W\x84F\xf8x\xd3W:\xb9\xd7\x1b#7k\x0cGL\xd9&\n\x0c\x00\x80je\x84\xad\xa5\x0b\x9f b\x997`\n7L\xeerJ\xdf\xb4\x82\x07\xe0\x81.\x02\x00%\xe8t,\xf6ls\xe2sSsJ\xc1\xd4\x7f\xa57T\x945\x02'Pv\xdc\x07\x06\n\x97\x01\x0b\xe7\x85p\xd3}\x05'\x87\xc1\xa4\x01'P\x87\x91\xe7v\xe1\xa7\x87Sur\x80\xa8V\x14\xa0\x01\x82X\x87j8&\xa75~U\xf5\x87\x8b(o\x8e\xe8\x88\x83\x98\x83@G|\xe4G\x86\x97\x98\x0c\x99\xe8\x88\xba\x87q\xa6v\x00\x01\xe0X\xa0\x18\x8a\x063\x8a\x1a\xa0{x\x08\\\xa98\x86\xacH`\xb9G\x8a\x91(o\x8c5\x8bqX\x8bb\xbf\xf1\x8aK\xd8!\xbb\xd8X\xab\xc8\x8a\x00\x90\x89[(\x8c\xfeG\x85\xbeHb\xaeh\x83\xa6\x18\x15\xff\x97j\xc5x\x89\xc7\xe8\x8a\xafh\x89\x9e&ui\xd5\x8ce\x80y\xd8\xe8\x88@\xc7\x8d\xbd\xe8\x8d\x87w\x83\xa3hw\xc0W\x8d\xb5\xd8\x84/\xa2\x8d\xe6\x18\x8f\xf28\x8f\xf4X\x8f\xf6x\x8f\xf8\x98\x8f\xfa\xb8\x8f\xfc\x18\x06A\x00\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95\x96\x97\x98\x99\x9a\x9b\x9c\x9d\x9e\x9fT\x00\xa2\xa3\xa4\xa0\xa6Q\xa4\x05\x06\xab\xac\xab\x05\xa3\xa7\xb1G\xa3\xaa\x03\xb6\xb7\xb8\x03\xac\xaf\xa2\xb2\xb1\xa2\xb5\xb9\xb9\xad\xc4\xbc\xbe\x9e\xc0\x06\xc2\xb8\x06\x05\xce\xcf\xcf\xad\xaf\xc7\x9b\x00\xc1\xcb\xcd\xd0\xda\xd0\xa4\x00D\xb0\xd4\x90\x00\xca\xcb\xba\xdb\xe7\xbc\x0c\x13\xeb\x0c\xa4\x0c\xed\xe1\x8e\xa2\xe5\xba\xd9\xd2\xdb\xa2\xea\xeb\xfb\x13\xef\xfe\xf0\xf1\x12\x89\"\xc7L\x95\xabb\xda\xf2\xf1[\xf8\xef\x9d\xb7\x80\x87\xc6\x11sepX\xb6g\n\x17\xeek\xd7\xad\x1bD9\x0f\xa1H\xbc\xe8\x8c`A\x8c\x00\xf45d\x07\x0cZ1p\x1f\xd9\xf4B\xa5\xcd\xe4\xc9\x92)\xfb5t8nX\xc9\xff[\xaef\xc6L3.\xe4\x93d\xf4H\xaeJ\xb9\x93\xa3M\x8b\xcc\x9a	\x1dZF\xa2\xd1&=\x93F\xb3%ugVz@\x99q\xbdJ5\x8c\xb5\xa5dg\x01\x00{\xd1dW\x7f\xf3\xc0\xca%8\xb5\xec\x97\xb3A\xeb\n\x19\xf5\x94k\xabe\x18\x9d\xe2\xf2 \xb7\xb0\x81\xb4v\xb5X3H\xb1\xa35\xc7q\xe7:\xe3\x9b\x8bpa\xc3\x88\x13_Y\xcc\xf8\xe9(\x08\x0bB\x8b^\x00a\x14X\x8cO=\xa8\xae<`\xeb\xe5i\x9a\xbbp\xae\xb7j\xc0\xe7\x05\x02r\xeb\xde-\x80\xb4\xe9\\\x18\x0b\x08SM\xdc2\xe1f\xe6\xae%\xcd\x1c;\x14\xb4\xb1\x00@\xf3\x9e>\xdd\xf7Z[\xdcR\x0f&\xde\x9a`\xb6\xc2\xb0\x9bg\xe1<\x19\x00n\xea\xe8\xa9/hY\xde\xa6e\xd6\xad/\x0b;,~\x0bg\xf3\xe9\xf3\xa3_\xbf\x18o\xe1\xe3\xc2\xc9\x07\x1cs\xf5\x1d5\x99n\x1bl\xa0\xdf\x82\xba\xad\xf7\x8ap}\x01\xa6\xccj\xf39s\x0b\x85\xf4\x15\x88\x85(\xb9%\xa8\xff \x83 \n0\x10W\xaf]H!3\xd6\xccG\xa0\x86K\x8c\xd2a\x82!\xc6\xf8U\x84@\x058\xcc\x00\xab\x1dv]A+\xb2\x88\x04\x87\x02x\xf8a\x8c\x0c\xae\x17\x15=\xcaa\x17\xd5\x88@-\x85\x0cLDu\xf8\"\x91!\x96\xe6\xd3S6\xd6\xd8$/n9\xc9	\x00\n\x84YA)f\x000\x1d\x8cT\x16\xb9cT\xdb\x08\x13\x0c+\xb4\xd4\x18\xd4\x97a*PA\x9dcB\xe9\x85\x99\xd4\xa1\x99\xa6z\x10@ \x80\x95lYX\x101\x00X\x80\xd4Rs~	@\x05w\x8a\x89'\x99\xf6\xf1v\xde\x94\x7f\x8a8\x9d\xa0F\x16j\xd3HRY\xa0\xe8@A\xa9\x12\x9e\xa3\x00\xa0\x00i\x9d\xac\xe6\xa9\x97\x14\xf8\xe5FZ\x9fC\x12	d\x83\xb8\x11ZN3&Ys\xc1\x05\xa3X\x10\xc1\xa8#y\xd3\xdf)\xa4D\xca\xea\xa4z:a\xdeh|\xa2\xe7\xa7n\x82\xf2Vmo\xa2\\\x9b\x1b\xa7k\xaa\xe8\x1d)\xbf\n;,-R\xedu*\xb2\xa3(\xbb\xff\xac\x9d\x94\xb68\xdaz\x0czH\xedt\xd1\x92\xa2-\xb7\xb5a\x93\xd5a\xe2F\xe0\xef\x05\xfev\x93\xe1^=v\x92\xec\xba\xcc\xbe\xfa\x0d)\x97\x868+\x9a\xf0\xe6\x96\xa8\x8b\xb8\xfa\xf6\x17.\xaf(#\x95\xbf\x1c\xff\x1bA7\xe7\x16\x0c\xca\xc1\x08\xb3\xdbl\x11\xd1\xc6(]\x90\nF'+\x00\xe3\xc6\xda\x1bh\x0ev\x06'c\x89\xfe\xfbk\xc7\xc6\xf6\\`7\xea\xb6\xda\xee^\x7fj+\xf1\xaf\xeb\x89+\xca\xa5\xa4\x85\x06*\xa2\xbb\xc0\x8c@\x04\xbf\xee<\xf5CX\xfbH\xb0(A'l\xec\x9f\xb3\xee\xb6\x1eh;\x07\xec\xb2\xac4\x976\x11\xa3KE\x80\x00\x02\xbf\x06P\xf5\xdb\"\xff\x9cn\xc9c6,+\x832K\x1c]iT_\x1d\x1dnN\x87\xa6\xf6D\x19K\xf56\xdc\x04\xc8\xfd+\xddZ\x1fuw\x9d\xcf6\xac\xf7~}\x8b\x18\x1d\xccn\x03<\x8ah\xa5\x81v8\xa2\xa6\x02\xb08\xe3\x05\\\x10\x80\xdb\x08\xd4\x1d\xf9\xd6\x8f*P\xb9h \x96f\xff\xa9(U\x03,x\xe5\xc6\x06\x8aW+\xfd\x9d>u\x00\x03\xa8\xbe\xb8\xeb\xaf/\xfcyh\xb5\xdb\xbe\x1b\xcc\xb9?N\xac\xe8X\x97\x96\xcc\xcd\xa6\x0b\x8f\x00\xf1\x17\x9c\x8e|\xf2F<[\x9dz\xd9\xf2\x06}\xe3\xdd\xbb\xdd\xcdz\x0b\x13\x8c\x12\xeb\xda\xb7\xe6}}\n[\x91yo\xe8\xc1\xdc\xf7zp\x07 \xf7\xf1\xf6\xba\x8aQh\xf1\n\xed\xbd\xcd\x19\xf3k\x0e\x00\xfc\xf7\xbd$\x9c\xadA+\xbb\xdd\xc7\xcc\xd79\xc7\x1do\x05\xbdP\x18d\xb2g\xc0\x04j\x06\x00\x8dc`\x03Q68\xc3\x95\x8f:\xd6K\x19\xff\x10\x80\xbe\xeeA\x0e\x83{	\x1f\x000\x10\x00\x0cLL\x02	H\x00\x07;\xd8:\x05\xfa\xef\x87\x048Y\x8bF!\x81\x8e\x04\x11=\xbe{\xa0\x88XG\x00	\xb8\xf0\x85\x0e\x1c\x05\x0dm\xf81\x02$\xa0\x88\xf0\x9b\x9a\xa8\xde\xf6\xb1\xd8\x80\xf0\x87`\x0c@\x10\x85\x88ARH@\x02\x04H\xa3\x1a\xd5x?\xd2$\x91i\x1c\x0c\x80\x13O\xf71\xc4\x00\xff\x80%\x0b\xa4\xe2\x02\xaf\xa8\xc3\x9c\x89JX\xa3\xf2a\x18\x07\x99\xc6\x0d\x9eq\x8d\x88Dd\xcaz\xe3\xc67\xbe\x0c~\x01(A	\x84\xf7\xa8v\xb9\x03\x00\x19\xc8\x80\xfe\xc4\x98\x80 \x96\xd1#\xe2Y\xe0 GI\xca0&R\x91L3\\\xa0\x1c\xe9\x1b\xe1=\xce{B#\x05\x00E\xa1\xa8\x00\xe4\xd0\x93\xc9\x13e)w\xb9\xcbD\xce\x0et\xab\xf4\x1d\xcd\xd6\x93\xc5\x0e\x82	ac\xd2b7\xac\xd8\xc9\x11\x86\xc2\x99\x80\xd0%/\xa7Y\xcaB\x96\xd0z\xc1\xf4\x1d\xe8v\xc8C\x92Q\xce18\xbc\"4\xa7\x00\xc2\xfa-B\x9a\xd4L\xa7\xff|9\x8a f\xb34&,\xe6\x16\xe9\x08\x80\x1c\xf6\x91kx\x1bZU\x80hND|Q\x9d`L\xe3(Oy\xca\xbf%\x91z\xa2\x80\x1f\xc7,\xb0\xb8\x8f\x1d\xe0\xa1\x10\xcd\xa17\x97\xe5\xaaq:\xcb\x94\x16\xcd\x03:\x01J\xd0\x8e*\x92\x14\xee,\x0d\x1b\xa5\xd6\xb1\x98A\xf4\xa4\x0f\xb5\xe7=c\x87L}\xda\xe7\x01\xff\x0f\x08\xe1\x0f\xfbY\x88\x7f\x024\xa0\x1e\xcd\xe9\x1a7\xf7Q\xce\x99\x14\xa5\x11U\xa9P'\x1aK\x9a\xa2\x02\xa60\x95\xa9\x18\x8d*\x88\x8d\xde\x14\xa7:-(\x08\x15)\xd1Q\x005\xa8\xf6<\xa9J\xb16\xb9uUtCH\x0d\xabR\xc7\xd8\x08\x9b>\xf5\xa6Q\xdd)\x07\xd6\xda\x81\x1c^\xf5\xad)M\x80\x08:\xd0\x01\x11\x08\xb0\xab\x14u)V\x00\x10\xd6\xb0b\xb4\xacJ=\xab`\xd7IP\x00\xb0u\xad\x1ch+\\Q*\xd7\xba\x8e\xc2\xae?\xc2kQE\xd6\x8d\xbe&5\x8cL\xf5\x83Y\x07;\xd8D\x16\x91\xae\x88El]\x13\xf0\xd6\x1cv\x80\xad\x00\x00\x81jiJT\xaf\x0d\x91\x14	\xb0lL\x03\x9aY>\x00\x00\x8d\x9c\xcdmO\x1f;\xd7\xd3\xb2U\x04B\xf5\xedi;\x90\xda\x0f\x18\xf7\x03 \xa0\xacd\xbd\xe6\x18\x82\x91\xf6\x009\x94\xedX3\x1a\x07Q\x046\xb7\x1cUc\x11E\xc0]\xee\x8e\xc2\xb7\x87\x15\xadh\xed\xaa\xda\xe3\x1a7\xb9\xb0Z\xff\xae\x9d \xc5\xde\n\xa0\x00\x05\xa2x\xaeVckY\xccR\x17\x0eS\xc5\xeeSw\xfa\xc9\xeezW\x14\xbd\x0d\xadpE\x01\x02\xe4\x16\x18\xb9d\xdc+>Y\xe5\x00\x07\xc87\x01\x0e\x80/\x00\xae\x1a\xdd\xbeN\xf7\xben\x98\xeau\xf5[\xcd4\x16qa\xdd\x05\x00	H\xf0]\xb6:V\xc4\xe6]-\x86\xbb\xda\xe0\x077\x18\xbe\xa5\xa5\xafXi\x8ba\x99\xac\x91\xc3\xbc\xe4\xaf\x0c\xbdK\x02\xd5\x92\xb8\x1b\x05&\xc1q\x93\x9b(E\xdd\x85\xa5\x15h\xb1=\x1b<&\xb8Vx\xc6\xfc\xf4'\x1ao\x8c\xe3\x81zX\x83\x00\xb0\xab\x88U\xcbe!\x9f\xd7\xb8\xb4$V\x8d	\x16\xa9$7\xf8\xcc\x0eh\xb2\x93e\x8cT\xfb\xeea\x83 Md\x95\xa1\x1aD\x13|8\xb2\xa3\xe81\x08\xbc|^\x12\x8b*\x1f\x0e\x11\xc3\x82[*_\n\xb3y\xb6\xfc\x1c\xb3H6\x18\x82F;\xfa\xd1\x8d\xbe-A\xe7\xbcS\x13\x98\xa0\x90J(\xb2(\xf4\xec\xe303\xe5\x1f\x8a\x1e\x02\x9c\xffEq\xe6B3\xf6\xd0\xd3}\xc3\x02!\xcd\xeaRn\x96\xb0\x1bFk\xa5/\xcd\xc6\xc8\x8aK\xcc\x19TTSB\x1d>4\xfb\xda\xd7\xa6\x8e\xab\x85\xc1X\xdb=\xa93\xd2\xed\x9c3\x10\x15ii_\xce\x82#9\x1b\xd77\x9a\x12\xe82\xfd\xfa\xdah6\xf5\x93aJ\xe36\x8c\xba#\xa2\x96@g\xd3:F;;;|\x1b\xd1\xe7\xa7\x1b\xc2k\x94a\xfb\xdd\xc0\x166RS\x1dM\xc1\x92\x1b\x97\x92V\xe4,4\x02\x10\x94Q\xbb\xdd\xdd\x80\xb7\xc0\xb3\x0da(\x8b\xf0\x0f\xaf\x9e&\xb9\xef\x8c\xc1\x82\x86O\x1f\xfc\xe8\xb7\xbf\xbd2\x06\x004\xe0\xe2\x18\xcf\xb8\xc63>\xf03#\xb5\x012%\xabm\xc5\x9d]\x9d\x161$\x1a>d\x13\xc9rG\x8d0g\xdd\xd56\xcb\xc6gN\xf3\x9ao\x1c\xa6\x17\x0fy\xb1\xd7\xe0\xd4\x0e\xe7\xf4\xe4\xdf\xf0\xf0\x19\x87\x8e\xf2Q@<\xdd\x99\xde\xb5\xa0m\xce\xf4\xa6\xd7\x9c\xb6o\x8e5!\x7f^\x17I\x0f\x9d\xe8\x9f\xd4\xa4?\\\xce\xff\x04\x98K|O5W\xf9\xbd\xef=\xd3v\xdb\x8f\xe49\xee\xe8\xc9\xd3r\xdb\xab\x9fq\x14\x99\xd4\xfa?\"\xbe\"\xaf\xf3Z\xc3c\xcf{G\xd7\xb9\xf3\xaa\xe0\xb6\x97T^j\xfd\xda\xeevQd\x00\x03\x88\x97\xfb\xdc\xfbQ0\x98\xdf]\xefy\x7f\xfb\xb7\xcd\x0e\xab3\xfa\\\xe7B4c\xe1\x0d\x8fx\xc4{=\xe6]\x87\x0c\x18\xf0~J\xc9O~\xd4\x84h\xfb\xd4\x05\xcaw\xeb\xe2r\x16#\x10\xc5\xe6sx\xf8\x1f*\x9e'\x92\xcbd\xe7=?\xfa\xc9\x1bl\xe8\xbdl\xe7\x953=\x82\xd8_=\xbe\xb4\xa7\xa1\xedAy\x94\xb8\xef\xde\x86\xe0k\x11\xf0	)\xfc+\xbf\x9c\x02\x14(~\xdb\xebi\xcf\xb8\x83\x11\x03\x9a\x0c\x85\xf3wOy_\x10\x1e\xed+\x17\x05F\xeb\x8e\xfd\xec\xc7>\xbeq\xef~\xe2\xfb.j\xdd'>\xfc\xd1\xff\xd1\xd5\xd3\x9f\xdfD\x87\xbe\xfd\xd8W|\xb1gKB\x95\x00\x9a4N\x98\x14\x7f\x12\x95\x7f\xb3\xb0\x7f\xd5\xe7\x7f]\x07\x80\x00\xf8~\xff\xd0eOz\x05+\x19@{\x16\xc8\x80(s|\x00\x90}'\x80wL\xf5\x81\x12\x18\x80\xb1W\x81	V\x05\xdc\x97\x81\x0b\xc8\x81\xca#\n\x14\xa0\x01\x1ap\x02!\xf8v\"Q\x82\x14`U}$h\x1a\xd8\x82.\x18>1(\x834\x98\x82(S\x82\x13\x06Q\x94\xb7\x82\xa4U~\xc7@\x822(\x83\x02\xe8:$\xd8~Gx\x00\xe5\xa7\x84\xd0\xc5\x84\xe8\"\x83A\xc8\x85\xef\x07+\x00x\x84ZXO\x19\xf8PZ82]\x88}^8BS\x98\x83g\xc8}\x18\x80\x84gh0O\xa8\x01\x12\xe8Lm8\x87\x18\x94\x00q\x18Q\x17\xa8!\x00P\x87vH\x84\x11\x88}z\x08\x87\x14\xa6\x87\x97\x10\x88Ox_\x7f\xc8\x83}\x88R_\x179\x1f\xd8\x88\x8c\xb0\x82'\xb5\x0e\x8a\x88	\x8c(\x83\x9b\xb8'=\xa8\x89\x9f\xb8\x88]8\x8a\\\xc0}	\x80{?\x88A\x96xNp\xb1\x8a\xa2\xf6\x88ME\x7f\xb0X\x8b\xb6x\x8b\xb8\x98\x8b\xba\xb8\x8b\xbc\xd8\x8b\x0c\xbe\xf8\x8b\xc0\x18\x8c\xc28\x8cU\x10\x04\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95\x96\x97\x98\x99\x9a\x9b\x9c\x9d\x9e\x9fV\x00\xa2\xa3\xa3\xa0\xa6T\xa2\x05\x06\xab\xac\x06\x05\xa5\xa7\xb1J\xa9\xad\xb5\xac\xaf\x00\xb2\xbaC\xb4\x03\xbe\xbf\xbe\xae\xaa\xb7\xb9\xbb\xb1\x00\xaa\xc0\xca\xbf\xc2\xc3\xaf\xc6\xa6\x00\x06\xcb\xd4\xc1\x05\xd7\xaa\xcf\xd0\x9d\x00\xd5\xca\xcd\xd8\xb8\xb0\xdb\x99\xdd\xde\x03\xe0\xe1\xaf\x0c\x13\xed\x0c\xa4\xe3\xe4\x8f\xe6\xd5\xe9\xea\x00\xec\xed\xfa\x0c\xfc\xfd\xc5\xf2\x8d\xbaM\xa3\xa6\xae >}\x08\xfb)\xfc\x070\x91\xc0j\x05\xef\xb1\xe3\xb7\x0f\x9e\xc5\x86\x88\xba\x15\x80\x18\x11\xdbA\x85\xef\x90\x85\xab%\x0e\xe3 i\x1b	^\xbb\x15\xee\xe3\xc2\x81\xccV2s%\xca\xe4\x1f\x940\x81\xadd\xe9q\xe2K\xffo\xab\x80\xad\xc2e\xf3\x8e\xc5Q\xcb\x92Yk\xe9\xd3_\xcezB\x831,\xeaf\x14\x84\x05X\xb3.\x80\x80t@\xca\xa5=A\xf2\xa3wn\x19\xcc\xa9T\xd3\x88\xba*\xa0\xad\xdb\xb7\x02\xb6vE\x17\x11\x1f\xc8n\x1e\xca\xeaE\x0b*\x1e\x1cQ\x0b\xe0\n\x16,\x17\xa5\xc1u\xfe\x06\xe4\xd5\xbbW\x16\x00\x05\x90k\xfe\x0d<\xb82\xe1T\xf7V\xd9\xc5\xeba\xf1\xaf\xbc\xd8\x9e\xd6\xe3\xcb\xe91\xe4\n\x15\xfc\xa2\x01`\xb9\xf5\xe0\x05\"\xaf!c%Jq\xe7\xdb\xbe\x16o\x14&:)\xe9M\xa2PCV\x90Z\xf2\x19\xd6\x1b6\xb8^\xee6\xb6\xb4e\x9emw\xa6\x1b\xf3\xebh]\xc1O\x9fV\xfd\x05\xb9r\xe6\xe0E>\x07\x16\xfd\xb3/\xa5\x8c\x7fis\x9c}8q\xeeZ\xbc\x83\x9f/\x80Voe\xba\xd3\x7f\xfb\xdd\xb7\xfd\xf0\xe2\xfcM\xe1\xddw\xf41\x87\x0c:\xfam4\x9dY\xd7\xe4\xb6\x98\x01\x01F\xe3\xdfv\xc6\x85\xd2Vr\x04\x16\xe8\x1al\xc10\xe6\xff\x8a\x83\x0f2C\x965\x11\x9e2J\x05\xee\xbdWa\x14\xac	\xa0\\r\x1a\x82\xc7U\x87e\xa1\xa7\x9e\x83\x10>\xa5YQ\x13RX\"\x11-\xbe\x05c\x8c\xafA\x00A\\\xf4\x04\xe5\x8du\xd6\x08u\xe0L\x10\xa6ub\x8a*\x96\x18d\\n\x0dI\xe4\x95\x02\x1c\xd9%Y\xae\xf4\xc6\xa4W0\xd1d\x1dmi\xf1\xd2c\x95I\x04\x89\x95`Z\x16(\xca[\x94q\xf8\x8d=L~\x18\x0c\x84\xb5\xed\xb9c\x9a@\xae	`\x11\x00h\xe5\xe5`qZvh}\x00\x1c\xfa\xa6\x9d\xe7@\xc8d2\xb4Y \xca4a\xfe	(\xa1\x82\x96RhV\x8bV\x86\xa1[\xa1\xd6\xe7\x16ls\x92\x1a\xd7\x8c\x91>\xa9\x1e1\x16X*\x8dfC\xfd\xc8c\xa7\xa0r)\xa3\x8b\x04\x06\xc9\x15`\xaab5\xa2Y\xe3\x05s\xc1\x05\xb8X\x10\x81\xac\xb5j\xba\xe9,\xfe5j$\xb0\x05nu\xa1r\xb05\x17k\xa3\xa4f5\xec~9I\x03\xc0\xb1\xca.{)\x9f\xaa\xd8\xfa\xff\xec\x94\xa3(\xa0+sl\xd1y,\x92\x11D\xc0m[W	\xab$59\x8a\x08@\xb9\x17\xd4;\nM+ \xa3\xee\xb3BL\xe9n\x8c\xa5\x1a)\x00\xb9\x02\xfb\xea\xed0\xfb\xaa7\x1e\x9f\xe5\xd6\x1b\xf0\xc0\xc5\xac\x880\x14\xa4hh-\\\x8d\xca\x15\xb0\xbd\x85\xae\xea-o\xb6\xf0\x89)\x84\x16\x1c{\xb2\xc0\x05\xafw\xf0\xc7\x84:*c\xa8\xb0M\xab\xec\xc6\x9f\xc2\x86\x15W-\xd36[\xad1\xcb\x1c\x01\x02\xf6\x16|3\xceH\xa4\x8c%\xbcR\x93:\xae\xd2L\x03&\x97\xbeECho+\xff\x1e\x1b\x80\xcc\x08 \xd01\xd4Y\xa4\xfc\xe6r\xd3R\xd6\x9c\xccd\xcb*l\xc9\xe7\xb6\x0c\x80\xd9\xc4Dp\x01\x01c\x1f[\xf6\xd3h\x1fQ\xf2\xdat^Fm[\xb0\xed=6\xd3f\x03\xc6U\xc1\x8f\xcfj\xcb\xdd\x92\x03\xb0\xf4\xde\x05\\\x10\xc0\xd2f\x07\x9evV\x84\x0df\xf9\xe1\xf5\xe9\x1d\xc0\xe2\x7f\x03\xd6\xf1?G\x83\x8d7MeG\x10\xc0\x00\x9a\x97\xdd\xff\xb9\xe7R\xc0\x93\xaf`\xf1\xbe\xc5U\xe3n\xc3\x86\x80\xe6~\xff\x0d9\xeb\x81R\xec5Jw\xdb>\xfb\x05\xb6\xdf\x8e;\xb4\xa4H@\xc0\xf5\xa3\xb8\xdd\xd6\xbdt\xfe^\xb2\xd5\x08\xf0]|\xe7\x1es\xda\x92\xc1\xd1\x97\xedU\xf4\x80K9\x8a\xf5\xa7\xc7\x1f\xc0\xf5\xd8\x07O:\xbe\\\xd9K\xb8\xe5\xe1K\x00\xbd\xedgc\xc2(d\xc3\xbf\xf4]\x83}\xd3\x0b\x14\x00\xf8&\xbf\x06\xca\x8f\x00A\x13\x05\x04	\xe3\xb3O!\xaey\x01\xf0_\xf4PF\xbd\xa3\x140}\xe9k\x1f@F\xc1@\x07\x9a0~\xa4\xa8\x1f\\\xb6b\xa4_\xad\xedw\x08\x08@	J\x10\xc2\xe2pJ\x14\x18\x08\x00\x06,g/	$ \x01\xcd\x03!\x02='\xc1\x13\x1a\x91~H\xa4\x1f\x97\x86\xd6B\xae\xac\xed\x83\xe3\xfb\x9b\x8f\x9c\x06\x80v\x00\xe0t;\xcc\x1a\x01\x12 \x81\x0f2-V\xb1\x13\xa11\x8ahD\x07&\xf1\x8c\xd8;\x15\x13\x9bhA\xe1	\x11\x80)J\x0d\x00\xc7\xa2\xc3\xbb\xd9\xff+\x00\\\x04\xe2\xbf\x96\x15+s\x89q\x17\x0b,\xa3\x19\xd1x\xc6\xc1\x81\xaa\x89m\x0b\x0c\x0c\xdf\xd88\x00\xa0(2\xb1\x8b\xc0X2\x90\x01\xcbYj~	\x80 \x15IADA60\x89G\x84\xa0\xa1\xd6\xc2\xc6|\xc1\x86s\x08\x00\xe3\x06W\xe7Hwyp[[\xcc\xe4\x1f1rEO\xc6\xefz\xb6<\x9dE \xc8\xc6\xc1\xe5/\x92\xcb\xb2]\xd3\xd4\xa4\xb0\xff\xbc\xef\x87]L \xa1ry\xba\x122\xf3\x96\xd8\x9b\xd6\x02K\xc6\x15\x08.\xad^\xd8\x94\x15<~\xc8\xcdb\xfah\x96\x0d\xa9\xe53\xc7iB\x12F\xb3\x9a\x10\x14E6E\xf1\xc3\x03\xb8\xf3\x9d\x07\xe0f7{4\xa82\x94\x8f\x12\xe2$\xa7>\x07\xc9\xcb\x05b\xcf\"\xf0\x0c\xa8@\xe5\xa9\xc7V\xba\xa7\x9e`\xb8b:1\x91\xcf}:\xf4\x81\x12Tb\x02\x04J\xd1\x80\x12\x94\xa0\xded\x93\x17\xc4\xa9\xc9J4\xf4\xa1 \x9d_\x12\x01P\xd1\x92^t\xa2\xee\xd4c\xc2\xe8	\x1f\x01=\xc0\x99\xff\xf7\x0c\x883C\xea\xd0B\x96t\xa0\xdc\xa4\xe8\x0f\xd1\xd2\xa9\x98>\x01\x00\x0f\x08*D=\x1aH\x9a\xd6\x14\x89]\xbc\xa9R\xdf\x99\x00\x11t@\x04\xa4\xc9\xa8Fs\x17\xd4\xaa\xc2\x14\x9c{ #9\xe9wT\xfa%u\xa97mj\x07: \n\xa8R\xef\x91\x07\xf5i\xd4\xaa\xca\xd6\xa1\x12\xf5\x99\\}(R\xcb\xdaN\xb0Z4\x01c%+\x08@\xa0\xd6\x95\x1a\xd4\x98}\xf5+[\xad*\xbf\xc0:\xc4\x93H\x04\xe9\\\xcb\x9aW\x11\xd4U\xa9?t*\x078\xa0\xd7\x0fX\x96\xaf?e\xa9Z\xe11X\xa1\xde\x12\xabY=!(\xe5\xeaU\xe3\x88\xa2\x03\x93Mm\x07N\xcaM\xd4Rv\xb2z\x05\x81e/{\xb0\x8c\x02\xe8(\x9b\x1cEg\xaf\xeaQg\x8ev\x9fI\xec\xa2\xc7N\x0b\xdb\xb1\xa6\x96\xb2\xae}-e\xa1\n\x00\xd9\xeeu\xb6\x1f\xc0l\xee\xe8\x89\x9a\xeaV\x00\x05\x92a'7;\xeb\xd6I\xf8\xf3\xb7\xb6$di\x01`\x82\xf2\x0e\x97\xb8\xc7M\xff\xaer\xc9\xda\\\x12\xccV\xb6\xd1mi\x13\xbc\xe9\x80\xfa\xda\x17\x05\xd8\x05\x00J\x99\xba\xdb\xc2\x82\xd6(\xd6K,3\xc5K\x00\xe1\x9a`\x86\x086ATG!Y\xd52\xb7\xb9\x96u/m\x0d\xeb\x84vA\xc6\x01\xfbM\x80\x03\xf2\x8bS\xee~\xd6\xbb\x02\xce\xa5x\x0d\x8c\xe0\x12\x94\xb0\xa3m:\n	\xe0{\xd9\xf8\xfe\xcbR\xdd\x19\x0e\x86\x99\xbaa\xec\xea4\x01\x83\xe5\xadQ<\xc8c\x0f\xc6u\xc0g4py\xcb\xcb\xb7t\x8a\"\x99\xd0\"\x81\x92\x9f\x0b]\x17o\x0b\x1f\xffM\xd8#\xeb+O\x07\xa0\x86\xa4:\xedo3\xa3\x1c\x9f\x10x\xf9\xcb`\x0e\xb3\x98\xbd\xec\xcfq\x06Y\x14C&\xf2\xfc\x8e,\xdc\xc0\x8aB\xc9K\xde+	Da)K-\xa4;\xa6\xa9\x80}\xed{\xe5\x8a\xfe\xd0\xc3\xba\xe42\x16\x00\x10\x82}\x02\x00~fF\"\x9b%\xc0h%\x1e\xfaz\xe6\xa5\xf0+\xfd(\x16A\x9b\x86J\xdb\xd9\xef]s\xfca;\x88\xc2\xcbe$3<\xffp	WE\xbfo\xb1\xf4+/\xa3\x9f\xb6\xc7z\xc9\xea\x1dbyGB{\x0c\x00*\xfb\x19\xd0\x01\x10\xf4\xa0i]\xa1GoU\x89\xb1z2<\x02Ld%\n\x88\x14\xf9\xb0\xcb]\xc8P\xeb=;\xdb\xd6\x9bfk	)\x9c\x07_\x97\x9ao/\xce\x98\xb9V*\x81b\x976w\xf9H\x88\xb2\xef,\x86f?\xfb\xd9\x9aN)\x8e\xdb\xba\xe5CX\x1b\xc8\xb9~16]\xcd\x90\x88\x8e\xb4\xc2\xf0@\x88>\xc6\xed\x0ff\x9f\xfb\xdf3\x86\xe7\xba	\x1bhC\x14U\xc4\xd8\x86G\xb0al\x84\xef~\x1bZ\x19\xc0\x80\xc4+\xa9ow\xf0[\xd6]8\n\xc07\x9e\xe1\x81{v\xcd\x8584\\s]0S\xc3\xc7\xe1\xd8\x8b\x9a(\".q\x0cT\x92\"\xfa\x86\xf5\xb23\xde\x80\x9a\xdb\xfc\xe6\x1b\xff7J\xffL\xf0x\x13\xe2\xa3G$y\xc1f\xe8\xdb\xa8\x16\xd2\x83\xdcd\xb9\xc4\x95]q\x99\x93\x9b\x0b\x00\xb8\xb9\xd4\xa7\x8es\x8e\xc7\xd3\x01\x83\xed\xb4 \x80~\xffB\xd6\x95\x98\x81\xbfAy\x17Y\x9b\xf4\xc2*D\xdc\x95\xee\x0e\xd5\xd7\xcev\x9b\xa3[\xc3\xd2F\xa1\xae\xcb\x8d\xd8z_\xaf\xc4%\x88j\x80\x19\xdd\xc5	\xf8\xf9\x87\x190{\xacE\x91\xf6\x8d\x06Y\x02mO<\xdb\xab\xda\x00\xad\x03\x82\xeb(\xf4\xeb\xc1A\x1e\xb5\xeb\xf1\xbd\xef\x02m9%\x03\x8f\xc2J\x03\xa0\x92\xe3\xb64\x81	\xcch\xc5O=\xa8\x8d\x97\xfb\xd6g\xfaY\xad~R\xef\x05\xe6\xbb(\xfc\xee\xce\xf8\x11\x94\xf3\xa7\x03=<6\x0f\xfa\xb1$t\xf4\xc0\x1f}\xe9\xa9\xde\xdd\x9bL>\xd0\xac/l\x9b,/\xfb	\xe4\xf0t)\xdd&%'\xde\xeb\xcdS\x9f\xda \xe3q\xa3\x83\xcf}4\xfaw\xebd\x84\xa0\xf7\x1dO\xa8\xd8\xaf\xba\x8a\x02_\xd1E\x80\xc4\xfb\x96\xbb|\xee*\xe7\xf5\xb0\x03\xdc}R\xa3\xd8\xf8)$d\xbb\xa3f\xbd\xcb\xcf\xfe\x9d\xd8W0\xd6\xe7~\x95\xd4\x07\xf27\x7f#\x05\x7fW\x00\x0b\x00@\x01\x140\x02(\x87F>uh\xff\xfeW0\xb4\x078\xfa\x95\x00J\xb7t\x90\xd0cx\xd0\x80\x0e(\n\x14\xa0\x01\x0f\x18\x81\x8e\x16!\x14({\x05\xc3\x00@T\x05\x19\x98\x01\x1aH}\xca\x042#\xa8\x01$h\x83\x14p\x02b'_\xc4T\x81T\x14\n\x807O\nHK5x\x83$x\x02\x10X`<H(\x10\x98\x82\x01\x185A\xb8S3H\x83EX\x83\x0e\xa8\x83O\x08\x00#\xb0\x85\xa4\x00\x81i\xa3\x81\xdd4\x854h\x83$h\x85[\xe8\x85\x02\xe2\x80\x0fx\x86h\xb8\x80`(\x85b8_EX\x86\x0e\xd8\x84OX0j\x98\x87m\xe8\x82Q8\x84&\x01\x00dH\x82\xf00hy\xa8\x86{\x88\n}\x18\x87\x15\x86\x83K\xc8\"\x85\xd8\x84\x83\x96\x00\x18\x00\x87\x8a(@\x8d\x98\x86z\xf8G\x19\x88\x01\xf1\xe4\x87\x95\xb8\x04 \x18\x82w\x08$?\xc4\x89\x07\xe0\x89\x9f\xd8&\x858K\x9b\x08\x80\xa9X\x15\x85H\x01\xa3\x980\xa5\x08O\xa8\xf8\x8a@\x12\x8b\xa2\xc8\x87\x92\xa8i2\xb3\x88\x8b\x98\xa8\x8b\xed3n\x19v\x8b\xb8\x08\x0f\xab\xe8\x82\xc8V\x11\xc0\xd8\x06\x83\x18\x1f\xcf\xd8\x8c\xd28\x8d\xd4X\x8d\xd6x\x8d\xd8\x98\x8d\xda\xb8\x8d\xdc\x188A\x00\x00!\xf9\x04\x05	\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95\x96\x97\x98\x99\x9a\x9b\x9c\x9d\x9e\x9fS\x00\xa2\xa3\xa4\xa0\xa6R\xa3\x05\x06\xab\xac\xab\x05\xa3\xa7\xb1I\xa2\xaa\xad\xb6\xad\xaf\x00\xb2\xbbB\x00\xaa\x03\xc0\xc1\xc1\xae\xb5\xae\xa2\xbc\xa7\x00\x06\xc2\xcc\xcc\x06\x05\xd0\xac\xaf\xc8\x9f\xca\xcd\xd7\xc3\xd0\xd1\xcf\xba\xd4\x9b\xd6\xd8\xc2\xc4\xda\xe4\xd0\xdd\xde\x97\xbe\xe1\xd9\xe5\xe5\xa4\xe7\xe8\x94\xd6\xcb\xd7\xcf\xed\xe4\xa2\x0c\x13\xfb\x0c\xc7+\xb0\xf1\x1a\xf9Z\x85\xed\x9e;}\xfb\x12N\xe8\x07\x80\x81C\x7f\x01\x13)#\xd8L\x9b\xbdv\x0d\x15*t\xc8\x91#\xbc\x88\x84\x06\xfeb\xb6\xcd`F\x8e	\x19\xbe+\x05\xb2\xd0\xc4\x02\x15\xa5\xdd;\xd9\x91a9[\xb9>\xb6\xec\xa3\x0c\x1aI\xff`\x171.\xac	\x8e\x1d\xbd\x01\xc6t\xee\xc4#\xf2(Rv\x18\xf5\x11u\xea\xcci\xd2\xa5vV\x8e\xa2\x1a\xcc\xe0\xab\xa1\x1e\xb96\xb3\nT)V5\xa3 ,X\xcbv\x01\x84Q\xceLJ\x0d\xbb.\xdcQ\xb3\x9eX\xd2\x11\xa5V\x80\xdf\xbf\x80\x05\xb8\x85;\xc0kC\xa2\x03<\xd4]\x8c\xf7\x9b\x02\x05\x15*\x00|#jA\xe0\xcb\x97\x07\xfb\x9a9*\xac\xe2\xc5u\x0d4\xce\x04\xe0q\x85\xc7\x90'\xa3\xb5\x8c\xb9ufZ\xeej\x91\xf2@\x9b\x99b\x8b\xa0G\x93\x16u\x1a\xb2i\xd5e\x00\xb8\x1e\x8ey\xc1fs0m\xd3^\x0eL\xf13\xa4\xd1\xeaN\xdb5\xaa7j\xc9\x10\xc5\x08\xdf\xb0\x81\xb8\xf7\xbf\xc7\x8b\x06\xfb\xdc\x9c\xf9H\xe8\xc9\xb1\x89\xf6V\x1d\xf5\xef\xec]\xb6w\xffN\x7f\xb3\xf8\xc4\xd7>\xa7\x07\x0dl:{\xde\xee\xa5\x06\x1f\x16\xf2\xd1g\xa0\x00\xb4P\xb4\x8es\xfb\xf1\xb7^D\xed\xb9\x87\x9dnM\x00`\x19w\x07\xd2g\x1cLb]\xf3\xffKmc\xf9\x94\x98s\x14\xca\x12\xe1u\xc0E!\xdf|\x19z\x07N\x87?\x95G\x9e0\x00\xc4U\"u\x00J\x98\"\x13\xc2	\xc0\x1d\x8b-f\x06\x01\x04\x02\xbc5Lh\x0d\x1eY[\x8d\xe2<x\xd6?9\xa28 \x12\x16\xfa\xe8\x17\x86A\xb6F\xa4qM\x86s\x9e0\xfb\x89v\x14+7\xa2s\xe2{\xa3\xf5\x08\x18\x96Y\xaa)\x98_F\x8a\xf3%t\\\xbdB\xa3:@\xadRf@g\n\xa8T\x95\x7f\xb1\xe6#\x90\x07\x8a\x02\x98e\\\x8e\x15Tt`\x8a2\x0ca\xad\xec	R\x9f\x13\x0e\x01\xa8_\x82\xfe\xc5\xe6pD\x82\x07@\xa7o&\x8a\xa4U\x90\x02`\x81\xa3\xb8H\xba\x13\xa5i	\xda\x17f?\xfe\x05\xaa\xac\x81\x8eB\xeb`\x8b=\xe3\x94\xa9\xa7Bg\xaa(\xae\xe8\xf9$*Q>v\xa9[\x1a\x12\x89%\xb2~\xfd\xaa\xe6\x96\xa2\x863\xd1\xa3\x17\\0\x11\xaf\xc0\x8a\xe9\xdf\xb0*\x02h!\xa2ne\xca)k\x18\xbej\\\x04\xa7\xb2\xa6\xff\xd6Z\xf793-R\xa6F\x10A\xb5\xaf\xa0+\x92/\xaarKDem1\xfb\xdd\xac\xcdVk\x9c\x05\xf2~\n'[\x03u(&=\xa2\xc9\x1b\x81/\x17\x14\xdc\x13\xbe\xfa^\xf1m[n\x12\xe7/\xa6\xdfV\xeb\xb0\xa1E\"\xbc\x8d\x82e\x11\xc4\xcd\xc7\x00\xcc\xfbpN\xf9Vl\xe9\xa7k\xbd\x95\xf1p\x06\x03\xf6\x96Z\xa6F\xfc\xb0p\xe1\x0eF\xcc-z\x9a\x1co\xb5:K\xdc\xb2\xcbF\xacd\xe0\xa5\xcd\xf2\x95\xb2\xca\xfc\xae\x9b0\xd0zN\xa4\xa7\xbcDG\x8c\xc0\xc3H\x831\xb3ko\x89+J\xd6\x11l\xcd\xaf\x852S}\xb5\xd5\x0dW\x1b\x00\xd1\x08 pt\xd7\xb3\xd07$\xd3\xc6e]-\x02\xa7\xaaus7l\xdb\x02\x80\xdc\xd2\x0c~\x01\x01o\xef-7\xdd^|}\x99\xcc\xaf6;/\xe2\x17l-7\xda\x9f\xc2\x138\x99r\xcbV\xf6\xe1\x05\\\x10@\xd9\x8b3\xfe\x84VJ\x7f\x97\xf2\xb7\x80\xa5,\xfa\xdbq\xcb-\xf3\x80Ss~\xff\xea\xd5[\x070\x80\xe8\xb1\xcf\xbd\xea;\x12\x10 \xfc\xf0\xc4\x13\xe0x`o\xc9\xbdV\xad\x08\x88\xae\xf8\xe5\x14\x1f\x91 \x99e\x0f4x\xdc\xbaW\xde\xbb\xe9E\x90\x12|\x00\xe0\x87\x0f~\xf1\xc3\x1fo\xf3\xe0\xac\xc398\xe5\xda_\xbe\xad\xf4\xc7\xd5\xbb\xf2\xf5q\x17\x16{\xe9\xa6\x8f\xf2\xbd\xf8\xfc\xf7_\xbe\xdd\xab[\x9e\xe4\x10@\x00	\xb4On\x95z\x19KRQ/Q\x90.n\xd0\xb8\x9f\xefp\x04\x00\xc4\xf5\xef\x82\x184\xde\xbf\xde\x92.\xd6\x18\x07{\x06\xbc\xdf\xc3\xd04\x8a}\x00 \x03\xa8[\xdd\xfdV\x18\xb7	&\xa3\x82\x18\x8c\xa1\xf8\x8ag+N\xdd-}\xc9C@\x00JP\x82\x15\x96\xc6=\xf4;\x0c\x000\x80B\x82\x01@\x02	H@\xcaX\xe8C\x97\x89\xc2\x822\xcc \xf9\xfe\x07\x1e\xe4\x0d\xe9n\x02\\b\xdc\x9e\xd7\xc2\x1c]\xaf`\xfd\xc8\x00\x11\x07w*\x02$@\x02Z\x8c\x1b\xba,\xa0F\x17\xe6\x05\x8aQ\xf4\xdf\x14i\xd84+^\xff\x91u\x1fd\xe2\xf6\xa0\x94BQ\xa0\x90o\x00\x08\xc0\x19\x95\x18/\x0b\x18\xd2^n\xecD \xe3(E\xe1]\xf0\x7f\x15t\xd3\x15\xef\xb8\xbc\x1c\xea\x11\x7f\xbd(\x96\x9f\xcahF\xe3\xf1Q/\xdcZ$#\xe5\x18G\xe1UP\x83p\x9a$\x16-\x93FC\xae\x90k\xf0\x03\x80u\xd0\x94\x00O\x8eaJ\xf2\x18\xe5#u9>T\xf2e\x92|a\x17\xe9\x1c\xc6\xc66\xc2/\x89\x84\x94e\x80\x12\xa8\x1d\xe3%\x92\x0f0\xe4\xa54/8\nS\xde\xd0\x94\xecZ\xa2\xc3\xe4u*\x9d\x88\"\x01\x078\x002\x93\xc8*\\\x12(|\xb6\xac\x04)\xe08Mi\x12\xaf\x9a\xe5\xd3\x8c\x03\xb9	\x9fQ\x803\x9c\xf8\x14\xe78\xcb\xf9LQ\x82\xcf\x9c\x90\x10E;\x07\xca?\x1a\xbe\xc5\x96}\x04@\x12\xf3\x99\xcfq\xee\x93\x9fY\x00\xc0\x03\x1e\x00\xc7t\xa6\x83\xa0\x18\xed\xa5)#i\xcf{\xe2\x13\x99\x0cm(H\xf5\xa99M23\x14\x13\x9d(\xff\x00\xfa\x08\x7fft\x9a\xff\xe53\xe5BC:\xd3\x90\x86s\xa475\x0bDU\x94\xd2\x94V\xf4\x99\xd0|\xe9@\xc9\x07\x00\x9b\x1a\x95\xa18\xcd\xe9\x12vZ!\x89\xf6T\xa5\xe2c)#\xa2\xc9HG\n\xb5xh<\xeaQ\x93zS\x11\x94\x88\xa9\xb3\x18\xc5S):C\xa0\xe6\x81\xaa\x8f\x1c\x9eP\xd19\xbc\xacj\x95\xa6\x1eE\xaa\x08\xbc\xda-e\xea(\x85\x99\x14\xebXWj\xd6\xacX\x95\xad\xc5[\xabF\xd1\xa8\xd0\xb7\x1a\x16\x9f\"\xe8\xc0\x8e\x9aj\xd7\xebD\xe6\xb1\x15@\x81?::\xd6\x9f\xaas\x8ej]+\xf1\x08\xdb\x81\x0e\xd4\xf4\xb0F\xed,\x00@ \xd5\xa5F\xc9\x01\xa8M\xad\x03P \xd9\xa2\x8at\xafQ\xed\xeb\x1cN9\xc5\x8cN\x91\xb0\xba\x00@g; \x82\xb8\x82\xf6\xa3\xa2\x05\xc1\x07H\xab\x85\x1c9\xc0\xa3	@mk\xe1\xfaT\xcbF\xe2\x88\x01\x08,L1[@\xd5\x00\x80\x03\xd8\xe5\x80g}k\xd8\x0e`w\xb4\xc2\xfd@\"E\xf1\x98\xe3~T\xb9\x92\xb5\xffi\x12\x9f\xca\xd7\xe7\"\xee\xaf\xee\xc4,a%\x80\xc6\xee%v\xb7\xbc\xfd\xacQ\x13\xd0Y\x0e\x8c\xf6\x03$\xf8\xc0p\xfbY\xde\xe3\"\x13\xb5\x91q-M\x9b[\xd6\x80J \xbaC\x9d\xe3|q\xfb\x11Qx7\xbb\xd8\xdd\xaez/\xac\xd8\xf0\x92\x00\x94\xc5=M\x05T\x9b\xda\x04\xef7\x01\x0c\x0e_i\x05\x81V\x19\xb2\x13\xb3+Q\x01\x00L\xf0'\xddf\xb7\xbf\xdau\xa8w/\x0c\xde\x0fc\xcb\xac\x9a\xd4\x11wo\x8a\xe2\x9e6\xd8\x11-\xdeeA\xa7H\n\x15\xa4\xe0\xc9)\x98\xb1N\x01p_\x0ck\x17\xc3<&\x81\x8f\xe9)\xdbO\xa2\x0e\xb5C\x16g\x8a\xff\xd9R\x97\xce\x90\x9d\xd1e\xb2	\xd6L\xe3w\xac\x19/\x16\xc62\x96yK\n\x82\xed\xec!d\x00\x00\x89\xf7\x0cf\xe6\x1aY\xc5]n\x83\x99\xd3\x9cV\x1a\xb2\x99\x87\x88N\xb4	\xea\x1bV*_8\xc3^\xc5\x16\xc1N\x85\x90	\x04\xba\x17|\xce\xb4o\xd7\xebSt^z\x0d.\x85/`\xff\xdfI\xdfR\x97\x1a\xbe\xd5\xe5\x91\x85E\x9b\xdb:\x03@#\xfd\x08N\xa6g\x1d\xd7\"\xa74\xb6\x83H\xa8\x05\x89W\xe8\xf2y/x\xe4\xa3\xefbc\xc9\xc7\x0c\x18[\x14\x1a1\xe1\x17\xb42\xebf#\xd7\xd6d\x1d\xdf\xa7\x19\x9bPQ\x84\xe0\xda\xd8\xcev\x08h+j\x8d\xfa\xda\x90\xdd\xecc(\xbe\x99\x001b\xe0\xdc(L6\x90\x1b\xc0\xeev\xbb\x9b\xdd\xcd\xde\xf3=9\x0d\xd5\x7fN{\xa9\xda\xce\xb7\xb6c\xc8\xedF\".\xb7\xe0\x8e\xd76\xedeZ\xd49\xd4\xdc\xe8\xce\xc8F\x80\n\x80w;\xfc\xe1\xf0v\xb68\x1d\xd0ii\xc7\x01\x00!\xc8\xe8\x113+\xc7\x7f+p\x9e\\\x06hC\xf4\xfb\xd1$f@|\x188\xcc\xc2\x1b\x07\xf1\x96\xbb\xbc\x01\x99\xfe\xb3\xbd\xe1`\xed\x8c\xf7\xef\xda\xd5>e#\x03\xa0\xb9R\xff8\x9a\x8di\x88Q\xcfMDc\xa3\\\xe5`a\xf8\xcb\x97\xcetvW<\xba\xf7VBBy\xd4\xed^\xf2\xbc{l\xae\xe6J\xa5>\xff\x81|\x8a\x8f\xc8\xe5>zMb\xdd8\xf9J\xa0\xe9h\x9fh\x03p\xed\x124[\xfdOo&^\xa2\x83\xde\xf5\xf0\x89\x14\x99'\x0f\x1f\n\x89\xe25\xea\xfa\x9d\xba\xf4}\xf9\x91s\xedv\x08\xeb\xd4\xe7\x89.A\xf9\xa84\x81s\x7f\x14u\xc6N\xf8J\xc2\xc0\xed\xbf[\xfe\xf2\x1c\xe7y\xd4\xb7\x00]\xff]\x9dJ\x88G\xb3N\xbb\x8e\xcf)\xad\xa4#\x81\xce\xb9(\xe8\x8b\xf9\xd6[\xd5\xa2~\xe8|\xff*\x0c\x8b\x95\x88\x9e\xf1\xa5\xa7\xd2\xb1\xc7\xbey\xa9\xab>\xe7\xac'\x1f\xd4{o1jvo\x04#X\xa7\xa7g\x8f\xfb\x03\xe8\xd4\xd8\xbbG}\x99\xab\x0d\x88\x16/\x90\x02#8\x81\xce\xe3\xa8S\x06(Q\xf7\xd0\x1f\xa3G\xb8\xb7\xec\x07\x03\x9a\x02\xe8\x17\x05\xfa)\xa0}\xe9>r\xcap\x0e\x7f\xc2\xc9N\xfe\xb2K\x1b\x00\x14\xd0\x80\xfe\xf3\xaf\x01\xf6W\xbe\xdb\xb0w:&Gt	W\x7f^c~\xb9\xc5\x7f\xfd\xb7\x7f'\x90|\xd45l<br\x08wn\xd2\xc4\xa7/\x1b\xa7~\n\xc8\x7f\xd8\xd7~\x1b\xf5\x0e\x16cr\x03H\x81\x06\xa8\x1d	\xa8\x7f\xfd\xa7\x81\xfe\x07\x81\xa1\x00\x82x\x97r#xK\xf8g\x82'\x88}\xc8\x87|\x0c\xc7\x82\xe4\xa4\x82/H 2H\x01x\xc5\x05\nUn\xfb\xb4\x83f\x00\x00\xfb'\n'\xd0\x80\xb2\x15\x84\x180\x84D\x18\x1c\xa3\xb0\x7f6\xe85I\x84\x01\x8f\xf7\x84\xc1\xb1~Z\x98|TX\x85J\x85\x85y\xa6\x85\xe8\xc7\x85]h\x85\xe1T\x81\xf5\x87\x7fb\xe8\x83\x94\x87Lf\xe8|`\x18\x86k\xb8\x84U\xe8QhH~j8\x87^\xe3\x10M\x98Ow\x98?\x18(\x86@\x96\x0f\xdew\x85q\x08\x83\x82\xa8\x1d\x0e\x91\x12\x7f\x98\x86 \xe65\x1ex\x88\x928\x89\x94X\x89\x96x\x89\x98\x98\x89\x9a\xb8\x89\x9c\xd8\x89Z\x10\x04\x00;\x03\x00PK\x07\x08]12\x95\x96^\x00\x00\x8f^\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x001\x8dS]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/en_GB/common.jsonUT\x05\x00\x01NV\xd6j\x8cW]o\xe3\xb8\x0e}N~\x05a\xa0\x98]\xec\xa0\xfb>oi\x93n3h\xda \xf6L1\xb8{\x1fT\x9bq4\xb5%_In6X\xcc\x7f\xbf E\xf9\xa3;\x0f\xfb\x94\xc3\xc3\xa3O\x93\x14\xf3\xf7r\x91\x1d\xadkU\xc8>\xc1\xdf\xcb\xc5\"{Q\xc6`\x95}\x82\xecf\xf5\xf8\xb8Y\xc3\xcd7\xf8p\xe5?\xc0\xdd\xd3\x01\xae<\xff\xfc\x99]\xf9?\xb3\xec#\xe9+l0\xc4\x01\xeb\xcd\xc3\xa6\x18G\xcc\xfc\x07T\xde\x9a\x9f\xa8xB\x02Q\xae[U\xe3z\x9cs\xbb[\xfd\xb1\x81\x9f\xce\xcc\xd2\xbc\xb3\xbaA7\x15\xe7\xfb\xa7\xed\xc3\xe6\xf0^n\xf0\xbc\xb7>\xf8\xad)N\x0e\x15\x0f\xb8\xaa\xc0\xe0\x19:\xeb\xc3/\xfeW\xd0\x06\x02\xfb\xae\xe3\n\xc4\xfb\x95\xa9\xb6\xb4\x92\x7fju\x90\x93^U\xc3\x18e*\xb8\xaa\x807CsXQ\x8d\x13\xfc|\xdc\\\xd7\xbb\x1a+\xda\x1e\xcd\xbe\x7f\xca\x0b\xd8\x7f9\xfc1\x1e\xe1\x1f\xd7\xee\xb0\xd2\x0e\xcb\xb0\xdd\xa7\x11\x9b\x03\x1c6\xeb\xedasK\xdf\xa0x\x92\xaf4\xbf\x844l\xbc\x82\xe2\xfe\xb0Y\xad\xff\xc5P\x7fR\x95=\xdf\xe8\x14\x1e\xf9\xfdj\xfd\xf4\x0c7\xdb\x7f\x13%\xf1Z\x1fl\xf9Z\xd8\xbanp\xba\xf4\x95\x9f\xef\xb17c\x0c~y\x9cGa\x9c\xedM\xe3\x19\xab\\\xb58\x1e?\x87\xa7;(\xee7\x90\xafv\x1b\xd8\xee\xe1ys\xd8\xc0\xd7\xed\xe6y\xdc]\xb6\\\xfc\xf8\xb8\\d]\xd3;\xd5\xf8\x14\xf2\x95\xbad\x9f\xe0?\xcb\x85\xe0\x8f	\xf9l\xb9X\xfc\x97\xcc\xecd{7\x88\xd8 :\xf2\x13Y\xabM\x1fp\x10\x8aI\xae\xe4\x9b\x8a\xad	\xa7Q\xcb\x96H	O\x94\x1eKk\xaaA*&\xb9\x92o\"\xbe\xa0\x1a\xb7\xca\xc6\xc7\x01FY\xba\x06\x8a\xcet	\xaaj5\xe7\xe7\x8a\x01\x0d\xc9Tm\x89\xa1\x9fh\x9b\x98\xc2+c\xcd\xa5\xb5\xbd\x8ftiMp\xaa\xe4\xd8\xbdMx\xe6\x89	4\xf5\x83P,\xc3\xbf:\xc5\xc7\xcb6\x11M\xd8qh\xf4\xcd\x06\x9etE\x97\x9d\xdd\xd3/\x0f\x8agH\x07\xf8\xae\x8c\x0e\xd6\xf1\xca;\xd4\x95\x9c\xe3{\xef\xc3\xa3=\x13K\x10\x8c=GGc\xcb\xd7\x18\x9b\x82\x98m\x95\x7f%\xedN\xf9W8\xea\x06\x8dje9r\x15\x059\x0f\xd85\xaaD\xf6\x7f\xf0@\x128\xebp\x02\x1d<\x9c\x94?\xc1\x9bj\xfa4\xccV\xe8\xd4lg\xbf\xfd\x16]\xf6l0n\xf8\x1eU\x05\x93]{\xc4U\xd3\xd0Z9\"\xa8\xa6Iy\x19O\x92\x9f\xd2)|\xac\x89\xac\x14\xc8|\xe0\xd4\xcb\x83._)\xe0\xb3\x98\x8a\xe0#\xc1\x92\xde\x8cW0`\xf6\\lO\xe4/\xdfl\xffk\xca#\x7f1\xa5DZVi_Zc\xb0\x1c\x8a\x9f\x98\xda\xd4\xb2\xad\x8b)gF\x12V(\xd6\x92\x93-\x0b\xba\xc5\x14\x96\xa5j\xd0T\x93\x80\xfe\xac\x8c\xc4\xf3\x1d\xbe\x08\xda\x0d1\xbe\xea\x12\xda\x0d\x89\xfc\xb9O#>\xf7\x8d\xa0U_\x0b\xca\xb1\x13\xf4$Q\xbb\xc8\x1e\xed\x9b\xa05\x96cb\x9d\x11_\x87}\xe4\xc3\xac;\x9bP!\xdfw\x91=\xcb\xd9\x16Yq\xea\x05\xdd9-(Wa\x9a\x87\xbd\x1e\x92\xb0\xeb\x9a\x8b|\xe4\x15a\x08\x96\xbe4\xbf6\x92,/V9\x0e\xd1\x1b\x06\xc2\x85`\xdbH2b\xb6T\xa6D\x0e\x98\xdb\x88\x84\x0d\xaa\xb1u\xa4#\x8c|\xa3\xa9:\x8b\x94\xbcD\xd0\x06d\x9e\xc9s~\x93\xf6\x10_jx\x19\xb7RYC\x1f/[\xd3/\x0f\xc1\xd6~\xa7\x13f\x1b\x06\xccQ\x96\x14\xd6>(W\xb3\xfaN7\x08\xc1Zh\x98\x11\x8d\xd1\xfe\x14\xd3\xf1.a\xf6\xd4\xd6\xd6\x0d\xe6\xd6\xd4\xb3}F\x1a\xbcMA\xa6\xdb\xce\xbapk\x9d\xeb;\xaeN[&\xe0\xa8t\x83\xd55\xf0\xaaet\xcb\x93\x1f\x87\xac\xe5\x10\xa2\xf7}Y\xa2\xf7\xc7\xbe\xb9\x86\xe2\x84\xd0\xa9\x9a\x92\xbbi\xa8r\x80\xc3\xc6\x0e-\x83\xee\xee\x95\xa7\xa2\x9em\xf7\x9c\xf6RX\x94\xe7\x0d<\xd0o*5*\xe8XO\x1f\x12\x1e\x8a\xd0\xe4}\x8eI\x1a\x1fP\xa0\xec\x1cUX\x15\xf6f\xf8\xf4\xf4\xbabE\x17!\xe1 \x15\xca\xbd\xe6\x88\xbc\xceN\xb9WP\x1e<\xd9\xd1K\xe5\xe5\xab\xf6\xda\x9a\xd4}p\xc5\x817\xe6&\xa2H\xc4Z\xf7U\xe3y\x0cK8:\xdbB8!x*z\xdb=-\xc1&6\\\x0e8z\xd3T\xae\xc6\xf1p;2A\x9b`\xe5\x80\xa2\xb2oS\x91}\xc3\xb9\xbb\xf7a\xa7B\xc9\xd7\xbcW\xde\x9f\xad\xab<\x10\x0d-\xf3\xa9\xe1\x1b'y\xc4\xf3l\x8e\x0eM\xa5M}@\xea%H\xb0\x8f\x04\xb8\xc8H\xfb\xa6M(\xec\xed\x981{b\xe8\x8a\x137\xb6y\xf2.\xd1Td\xfe\x1e\xed\xdf\x8b\xe2AD\xd4\xe3\x8dmpDp\xb4\x0e\xd8\x13E\xff\xeb\xad\xb4\x98\xdfl\x0f'\xf5\x86\xf0\x82h@x\xd6\xb8ws\xcc;\xbb\x9b\xcbx\xe8\x83t{\xfc\xad^.\xb3\x0bH\x9d m\x16\xddLM\x9f\x0b\xdd\\\x96\x1e\xb9(\xf1\x10(O\xf9&\xf8KwX\xea\xa3\xc6\nz'\x85\xc2\xe1\xd1a\xcc\x84\x83@\xe1\xbb\xe6\x92\x1e\xcc\xcb\xc0Y\x17\x84$$l\xe8\x9d\xdc\x15\xa3\xc8\xf6\x0d\xfa\xf4\xde\xc1\x81-vxT.\x86D\x1e\x91\xb0\xd49\xc5\x01\x02\x85\xf7\x14\xe0\x9b\xbf:-\x7f\x1e\x1el\xad\x0d\x08\x0f(\x0e\x11\x87\xbc\xb1\xe7\x9d\x8d\xddF\x8e\x01|c\xcf@\xcf\xb8(N\xf6\xfch\x83.Y (z\xbaFO\xda\xed\x9cL\xb0\xc7c\x8cz\xf3.,}P\xa1\x8f\xbb\x8d(\xce\xd1\xbf|\xc7\xd8_\xe5\x02\x13\xdf\xeaD\xb7z\xc6\xdeY7\x06wts\xb0M\xe3[\xe2\xe1\x13d\xb2A\x9e5\x9c\xfa\xf6\xc5(\xdd\xe8X_\x8b\x89}}-u.\xf6\x12\xb7\x17z5&\xbdD\x19	\x91t\xd1\xd3\xa5\xde\"U\xf4Tj\x86\x18W\x06F\xef\xa4\\\xf4\xe6\x9c\xf2\xfc\x8b@\x99\x8a\x8d\xf1Z\xc5;\xbb\xcb\xbe\xa3\xbaL5\x9e\x15lq{&s0\xb1w\xb6v\xe8\xf9\xca#\x83\xd5p\xc8w\x8b<O\x97X.~,\x7f,\xff?\x00PK\x07\x08G\\\x81M\xed\x05\x00\x00@\x0f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xc4\x8aS]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/en_GB/server.jsonUT\x05\x00\x01\xc1Q\xd6j\xb4|[o\x1c\xb9\xb1\xff\xb3\xfd)\n\xf3G\xfe>\x07\x98\x95\xbd\xbbF\xb0\xf0C\x00\xd9\xb2\xbcJ,Y\xd1\xc8\xbb\x08\x82\x04\xe0t\xd7\xcc0b\x93\xbd$[\xa3\xd9 \xdf\xfd\xe0W$\xbb9\x97u6\xe7$O\xd3]E\x16o\xc5\xba\xf7\xfc\xfd\xf9\xb3\xd9\xca\xf9.\xcc\xde\xd0\xdf\x9f?{6\xbb<\xff\xe3\xec\x0d\xfd\xf9\xf9\xb3g\xcffW\x16(\x15\xb5\xb3\xd4+\xcb\x86\"?\xc5\xd9\\\x90\xefm\xf4\x9a\x03\xad\x9c\xa7\xb8aZ*k\xd9\xbf\x08t\xe9\xf9\xa7\x81m4;:\x0f\x0f\xdc\xd2\x1f\x07\x0e\xa0\x11\xc8\xe8\x10I\xd9\x96tE\xb9s\xad2\xb3\xe7\xcf\x9e\xfd\x05\x84g\xbf\xbf}\xff\xe1~3tK\xab\xb4	\xe3\\\x00\xa68\xc1\xd1\xf6\xd9\xac\xe3\xf5\xd0(\x1a\x02\x07\xfa\x91\x97\xb7U\x0bZ\xee\xa8\xe5\x95\x1aL<\xa3\xf7V-\x0dS\xdc\xe8@zE;7\xd0V\xd9H\xd1Q\x18\xfa\xde\xf9H\xce\xb4\xeci\xe9\xdd6\xb0\x0fsZ\xa8\x95\xf2\x9a\x9c\xa7\xf3\xbe7L-?\xea\x86\x03\xa9(\xcb\xe5\xa7\x9em`r+\xb2n\x1a\x96\xa2W6\xf4\xca\xb3mvg\xd3\xaan\x16\x97?\x8ek\xb9q\x11\xe4\x99.\x9d\xa7\x1f\x9d\x7f\xc8\xaby\xeb\x94oI\x19\xe3\xb6\x81:\x15\xd9ke(nT$\x1d\xc8\xbaHA\xad\x18\x93^2=j\xde2v\x92\x14m\x9d\x7f \xb6\x8f\xda;\xdb\xb1\x8d\xd3\xb8\xcal\xd5.|t\xcd\xc38\xfa\xb9\x80\x080\x90z\xebbt]\x9e\x81\x00C\xe3\x9d1\xda\xae\x81\xee\xd5\x9ai)m\x88\x1f\xd9\xd2v\xc3\x96\xa2ZbJ\x1b\xdd\xb6l\xab\xd1\xac\xb3\xbbN\x07\x9e\x06\x1b!i\x80\x0b\x1dz\xa3vX$\xf5.D\xf6\x81T\xa0\xd4\xd1\x0d\xa1\xa25\xb4\xda\xfd\xe0\xcc\xd0U\xd4\x00\xa3\xc7\x04L\x04S\x0b\x1c\x83t\xc0~tC\xd0\x8d\xb0\xd9\xa3n\xd9\x11\x06d\x1f\xaa\xd3PCtk\xbd\x9a\xe8Z\x8d\xedn\xe9\xc3\xd5%U\xcc\x87\xc6#V\x90\xe3AW3]\xae\xaf\x878M\x12/\xf4V5\x0fk\xef\x06\xdb\xd2\x0f\x98D&%8\xb0\xcfr\xc2\xcb$kj\xa9C!\xf7\x0b\x94\xce\xdbN\xdb\xafB\xcf\x8d^in\xa9\xe3\x8e\x03\xf5\xba\xe7\x96B\xf4J\xaf7\x91\xb4\x8d\x0e\xcc\xee\xa9sVG\xe7\xaba\xc0k\xef\x9fz\xedw\xd3P\x80\x81\xb3\xb5\xdfQ\xd4\xe3\x1e\xdf\x0c\xdd\x92=\xf6\xb8U\xbb@[\x1d7n\x88dy+g\x18h\xc9+\xe7\x99\x14	UpF\xcb\x86#\xb7\x07\xe3\xdd\xa8\xea0\xd3hVM\xc3\xa8t\x90\xd8\x1f\xd0\x16bg\xf4NYj\x9c\x8dJ[\x1az\xf0\xe4\xd7\xaf\xc8\xb8-\xfbF\x05\xa6\x8f*jK\x86\xa3\xf0\x92\xf3de\xb6\xf5q\x0b\xa1{\x1d\xcd\xe1\xe0Q`it\xc1\x1f\x0d?\xa704\x1b\xb0\xe8\x0b\xb0\xc1@\xff\x9f\xae\x95]\xbb\x17g\xf49\xcf\xe5\x155\x1b\xe5U#\xe3\x1bg\xd7{#\xb7\xf5\xf6\xb6\xbb2\x18?EZ\xbavW\x06\xc4>V\xdd\x86\xae\xff\xa8;\x1d\xa7\xbeC\xd7\x93\x11\xd0\xe1\x99\xa0k\x00\xdf+\x8a\x1b\xcf\xaa\x9d\x93ZE\xf6\xb4\xdd\xe8fC:\x0b\x0f\x99\x1a$\xdc\xd0\xf5\x8cm\xdd5F7\xb9K\xa0\xde\x0fVXS{\x11\x85!\x92\xe7\xde@\xc2k\x1b\"\xab\xb6ZV\xa3\xfa\xd8l\xd48\xbbw\xf9=\xb3fx\x80D\xf6\x01\xfb\xd3\xb8\xae\x07+\x90\xa2\xdcK4F\xc3^\x0e4\xaa\xf0\x00\xbd\xf0\xc0\xe4y\xad\xc1\xb9\xa2\x14p{\xd3\xd4\xa8\xf1,\xb0\xa3\xe1\xef\xd5:\x1cN\x81\"\x80y\x93\xd5Z\xa6\x10\xdc\xe0\x1bN\xc3\xe6)\x04g\x06\xd0\x0cg\xf4	\xcbVkl\x92W\xb6u\x9d\xd9Q\xb3q\x81\xad\xf4`\xd5lh\xcd\x96\xbd\x08\x88L\xe0\x8c\xee\xf8\xa7A{n1@\xe1N\x15\xc9\xb0\n\x91\xbe\xa5V\xafV\xec\x19*F\xadk^\x04\xaf,\x1a\xe7'V|W\xb8\x87B\xaf:\n\x82\xcc;i\xa3\x16\xe0\x96\xe5>\xbb\x15\xb4\xa5^\xed \x9c\xd5\xc4w\xe9\xf4\xc1\x08gt.g\xcfO\x0ds\x8bf\xb8M\xc28\xf2\x84s\xa1\xad6\x86,\xa7\xc9\x07g\x1e\xab\xd3\xa9\xa7:\x84\xe8\xbaw\x8b\xc58\xd5<\xad\xcf\x81\xfdW-\xaf\xb4\xe5\x96\xde-\x16\xe4\x07\xc3\xe0}\xd5rK\xceRt}a\xec\xc0\x86\x1b\xec\\\xdcp\xc7'\x88\xdf\xbb\xf5\xba\xba\x98\xefdPP\xcd\x83%<&\x0e\x92\xcd\x84\x1eIe-_O\xf4\"\x81\xf2\xa0\x99\x10&\x80\xe32N\xb5\x95u0O*\xcd:\xcb`\x82\xc0\x11HlVc\xf4\x9e2\xcd\x03}Tv}4\x92Qv=\xa8u\x19\xecc~\xa5^5\x0f'\x06\xadg\x1f\x06\xe5\x9b\x8d~\x9c6\xe1\xa2\x82\xa5\xc9\xb7\x13\xe4\xcc\xf95\xe9\x0ez90`\x15-\x1d`\xe7\xdc\xb9\xa5\x8b\xd3\xd5\xb8\xf5P\xdc\x91\x1a\xaf\xb6\x86}\xb9\x1e\x05\x0ce\x98\xb4\xdf\x96\x97AG\x1e\x1bN\xc2/\x0dDl\xd7\x1a\xbbd[~\x12\xfc\xca\xbb\x8eT\xd3p\x08\x89\xd7t\xc8b\xfbhR`\x1a\x11\xf9\xd3\xc4.\xd2t\xb1\xf5_)\xe8\xb3\xac?\xc6\x1b\xbf7QX	;\x0c\xe6\x06\x1bI\xf5\xca\xc74>\x8e\xea\x85\xf4\x7f1\xa2\x05\x91\xe8\xd8\xf5\xa4M\xc2\xd1^\xb5\xef\\\xd7){<\xab\x966*l \xbf\x12:\x8b]\x055\xebV\xfbH\x1cp\xde{a\xffq\x1b\xa6\x1d\\\x19\xdd\xcf\xa9\xd5\x0d\xcf\xe9\xbb\xa52fNa\x0bu\x85\xed_\xaaM%\xdeZg'V\xb8\xd4V\x87\x0d\xddB?\x00\xfbl\xf6\xce8\xd8\x9d=\xdb\x03\xad\xc1\xb0\xc5J\xbf\xf7-[\xbaS\xad.\xa6\xc7\x04 \xeb\xb6b\x14ak\x92\xd9^\x11\x81\xa0\xc1\xfcFJ\xffO\xa6K\xca\x86\xed\xc4<\x1fa\xc9\xc3\xe8J\xd0\xd1\x0b\xc8\x8d\xeb\xdd9\xad\xc1_\x8d]!\xea\xbfy\xb5\xafF\xa3\x8b\xcaT<\xc4\x9d\xd2\xe6\xbd\xf7\xd3\xf2\x00 \xf6\xde\xf9\xb0/(\xa4)V\x96\x90'\x88\\+=\xadN\x08\x95-B\x8fD\xe0D\xb7[\x15&&\xc1\xcb\xd6\xf9\xf6\xb8'\xf5\x19u\x8a\x84\xf3\x93J\x97\x91)\xb0\x7fdOpAN\xd0\xaa\xb0'\xc8-\x86\xe5Ij\xbfL(\x0c\xcb\xd6uJ\xdb\x9a\xda\xd3\x86a]M\xa4\x9e\xbeO\x00\xa0'\xfc\x17\xe4\x0e?\xf5\xca\xb6\xe7\x15\xd3\xbc\x17\x08\x9d\x1bCW\xe8R\x0e)\xc3\xc1#\"\xc2\xc2\x19\xfc\xb6\xeb9\xdd^\\\xce\xe9\xfa\xf6[a\x071Y\xdd\xd6f\xdb\x06\xde\xd4\x8bHj\xb5\x12UrF7\xd9\xf2\xcc4\xd0\x80\x94	\x0e\xb6\xab\xb2-\xef\xed\xfdS\xbf\xb7\xebO\xf5V\xcb\x0b\xb9^l\x01\xdc\xe2\x956\x95\x92Z1\xb7\xb0\xd4es\xc7\xb5]fh\xda\xda\xbc0\x887*\xed\x13&K\x05\xdc4(h\x88)(\xc6\xc3\x1b\xb7\xe2\xd8l.\xe4T&\xfe\xba\x04P\xe4I\x9b1\xd9\x7fJos2\xda>\xc8\x8c\x93\x95\xd7(\x0b\xa7P\xc5\xa8\x9a\x0d\xb4\xad+{\x17dM\xe1\x8c\xaelc\x86\x96\xc3\xc4\x04\xf5\xe5X\x99=k\n2\xd6\xef(A\xf7]7\xd0eOM\xdd\x04R\x0f\xf0J\xbe\xae\x9co\xb8\x85\xff7-J@t>:{#aY\xa9\xd8&\xf0\x07\xc2\x9c\xa2\xd7}\xe30[\xf0\x83l\xe7\xa9A\xd6\xca\x18\xf6\xbbk\xd7N\x12\xf3C\x82\x91\x00\xd1\xea\xd9\xec\x935;\n\x1bW\xfc\x95l\xb0AJ$\x0e\x9a\xa6\xbd6n\xa9\xcc\xc5\xafR\xa4\x94\x1a\x9b\xdd\x7fX\xa3f\xbd\\\x9d\xd6\xda\xb9\xdah\xfa\x90^\x81*/\xbf`!d\x8f\xbd\xec\xd5\xf7\xf2\xfa\x86^\xe5\xbe\xef\x0c+\x9f\xdd\xfa\xc3\x03\xdd\xe8\x96\xdfjk\xb9\xad\xbb3]$WOTTaSA\x14\x1f?\xa4\x10\xc6F=2-\x99\xed\xb1s\x08\xd2{\x11\x12\xe9\x0f\x08\xce\xfc\xa52\xe6eMX\xac\x84\x00S\x7f\xcd-\xa9*4\x025$\xc1\x90\xd10\x90\xce\xd4qT\xd2k\x7f\xcc;n\x06\x1f\xf4#\x9b\xc9K\x1ba2w\xec\x85\xb6\xeb/,+yIr\xe3T\xbds\xe4\x0b!\xb3\xdb\x1fU\x02\x0c\x13W\xc9Z\xab\xb8\xc2\xfemS\xf4\xe7\xc5\xc6m\xffB\xcb!Fg!Hz\xa3\x9a\xec\xa9\x1e\x07#6\xce\xeb\x9f\xc1\xde\xe6\xc6mo\x93\x92\x9f\x86\x1a\x91'l\x80Q\xc4d\x91u\xa2\x05ik`\x00z\x157\x0c\x85\xaf,\x85\xa8\x9a\x07nO\xcd\x00\x1c\xf1\x0b\xc3\x17TZ\xec\xb7\xdf5 e]\x88\xca\xac\xb5\x9a\x96\xa3'f\xfb\xe8\xd6\xda\xe6\x0eU\x03\xdc\xdf\xef\x1d\xb4^i(\n\x87\x04F\xa2o\xc2dK\x96\xc5I7\xea=#\x8c&\x92e\x83\xf6\x13]\xe5\xbd\xdb\xfe\x81w\xe1F=\xea\xb5\xaa\xe2;\xe7\xc0\xd0\x03\xef\x02\xd9\x84\x9b\xc8\x97\xc6\xe0\x06\xc8{\x0b?\xdf\xf94\x90\x1b\x820\x0e\x0d\xd9XfR#\xad\x83\x15\xed{\xffiE\xb5\xfb\x7f\xad\x9et7t9\xd8\x01f\x10)_\x87\x01\xce\xe8\x95\xdc\x07\xebR\xcfJ|\xc8\xea\xef\x9c\x8b\x9f\x1e\xd9{]	\xcf4\x92w.\x92+8ty6\xbb\xcaaSX\xa3\xd1\xd1\xa6R\xbfr\xd9\x14\x05\xee\x15\xbcd2\xae\x11\xfb=\xf9\xf5\x8a\xde]\xdc\xcc\x89-\xb4\x066e5\x18\x93\x86Pm\xeb9\x04\xda\xb0\xe73\xfa\xc8\x90\x10\xdc\xf5q\x87\x11\xe0\xf1\xa1y\xf6\xbaJ\xe33z\xff\xa4\x10SxC/61\xf6\xe1\xcd\xcb\x97\xd9\x94H\xd1`\xd8)/\x0e\xd6\xba\xefq\xa7E\xfe\x1ao\x1b\xc7\x85\xb3R6\xad\xf5\xdf\xe9^\xebn\xcf0\xb9\xea*\xc3\xe4\xaa\xdb3Ld\x83\xf7M\x93t\x13/\xab\x10\xd1!\xabgS+\x9bH\xda\x86$g\xe0\x92J`\x02k\x13\xed\xea9\xe8\x9fY\\'\xdf\xe6\xb0o\xe0\x88\x85W\xdb\xf8S;\x19\x9bW\x7f\xbcx\x9b\xd9\x02\xf0/X\x86\x7fS\x12w\x9c\xa4\xdd\xef\x0b`^\xbf\x8e~\xdb\xd5E8\xa3\xd2F\xac\x1a\x07\xdd\x9d4\x86L\xb96Z\xc4\x08\x1aI\xbf\x7f\x8a\xec\xad2\xc96\xca\x03\x9c\xb7\xed\x9c<w\xee\x91q\x11\xb9\xd5\x91\xb84\xfc\xdd\xef~\xf7\xf25D\xcf\xcb\xaf\xe2\xaeG\x08J\x826\x0dW\xd7\xb1S\xa1q\xd3._\xa7\xd7=\x97#5)\xb6\xdeaW\xe1\xb6\xb1\x7f\xee)\xc0\xc2\xe5\n\x1a\xb2\x90\xa9\xbb\xc7\xa1\x8a\x13]\xcb\xab\xd8.\x88Jd:\x92H\x99B;x\xc8\xe6B\xb1r$\x95P\xf5\x84\xb0[i\x1f\"=\xea\xa0\xf7\xc6{:\x17\xfb\x11)\x84i[/E\xb0\xf4\xe2\xa4\x84\x7f\"\x7f\xe6\xd0	\xf1\x945\xaa\x08B\xcf\xf0\x81\xcf\xda\xa9\xa7\xef\xe5\xb6\x8d\xc3\xa5\x9d\xd9\x08\xf0\xa4\xc8\xcb(\xb7\xa2\xa1\xcf!\xa7C#\xaeSO\x0b\xfd\xf3\xd1\x85\x07\x97\x9f\xa2(\x88cz\x10\xa6\xd7o\xf7\xe6\xfa\xa3n\xe3\xe6\x80\xec\x16\xb0\x93t\x13\xe6\x8b\x13\xe5\xf5p\xff\xc3H\xf0:\xbdfk\x12J8\x85$\x93\xc5S\xa2\xfdMJ\x19\xc8\xfc \xa4\xd1\x0eJ\xadV_\x89\xf0b\xe3|l\x86\xf8\x9f\x18\xc0\xb5\x1f\xdd\xfa \x81\x00\x93;\x07p\x8d[\x93gpj\xa5\x1dGf\x91<B\xb7\xdf\x9asB\x11\xce\xdc\x03\xf7\x11\xca\x0b:\xec\x81\xb9\x97\x0b\xd2I\xe0i\xa5\xad\x8el\xea\xe4Z&T\x8b\x99<\x93):0\x02\xf6E\xcd\xd4N\x98\xb6\x963s\xd8ES\x92\xca\xb6\xb8\xe0\x10\x8a\x03T`\x81\xa7P\xa1\xf6\xd4YF\x92\xa5\x11\x116\xcd\xcd\xf2\x83;q\x107\x02\xaeN:P\xd8\xd9f\xe3\x9d\xd5?sN\n\xa5\xb0\n\xecM\xb8F\x15\xdb\xd8:\x8frs\x90A\x89.\xbb\x81x\x1as4Ug\xde\x8e\xd1\x8bi:\xdb1n\x91'\xb5\xdf\x017\xbfn\\\xc5\xa3>!\x12U\xc69\xea\xc5\x1eM\xa7\x83)\xae\xbb\xd8\x02\x9e\xc3\xc6\x99\xf6\x88=\xb2\xebj\xe9\xeaV\xe2\xd4\xe2\xe8\x8a\xff\xd0)(1W\xe73\x986lZ\xd9\xaad\xceUspQ7\xd5N\xa5\xd74\x9a\x1cI\x9d\x88V\x86:\x0e\x01\x92\"Kr8\x17q\xf4\xdbs@\x1b	\xd1\xfd\x11V:\x99;\xe38\x17\x1c\x1e\xe0\xe9\xdfT\xc8\xc2\x87\x1f8R\x9b\xf1\xb6\xc6\xa7(\xf4O\x83\x83/\x85c\x17\x86\xd8\xaa\x88\x1cN \xb5D\xca\x0d\xf1\xfa\xa8|\xb5\xcd\xce\xb4G\xa7\xf9\xc9\xb4\xbf|\x9a\xc5\xac{w\"\x89R\xccA\xcaH\xba\x9f\xdc\xffk\xd5\xf70\x0e\xdcj\xf4\xbf\x1c\\\xb0p\xa0\xc5Jj\x05j\x06\xfb\x95\x85\x16\xb7G\x81a\xb7\x85O1\xcd:\xbdf\xa6\xc2\xcb\xfeU\x95\xe01\xa2B\x887\n3\x88!\x99\xa2\xcf\x98\x04.J\x0e ;\x1b\xbd3\xe1\x8c\xae\x87\x10\x8f\xf33\xce\xd6\xce\xf4\xb8Ue&\xb7\xbft\x13\xc0\x97\xefrpz\xdf\xa4\x04\x93\x8fq\xeb_eZ\x8e\xc1i5^\x9e\x7f\xa7u\x89\xa9^\x89\x8d\x98\xec\xc0q\xa6	(\x17\x98>j\xfbp\xe4\"\xe5\x060\xa2\xb8\xcd\x0e\x8bm\xb3\xed.\xaf@\xe1x\x91@|8\xa3\x1f\xc1\xb89\xfc\xdd\xce\x8bS\xc4\xa18A5\xa5\xe3\\b\xef\xf9\xda\xb59HX&y'\x17Y6\x06fo\xe1\xc1\xef\x9di\xb3\xc3\x9d\xd2\xd0\xd9\xebq\xabq\x0f\xc1\x1c\x93(\x80x\x0eQ\xadV\x87\xe3\xdd\x89\xd7~r\xc0L\xff\xd4\x90\xff\xfa8\xf7\x92\xc3<9N\xce\xbc\xd6\xe3\x94d\xec\xbf6\xce`\xf9 \xb1r\x0bX\xbe\xa6\x99~\x8a\xd2\x8cWw\x0c\xc8\xa0\xaad\xa3Z\xc9\xad\x94=Lz\xe7F\xb4t\xbdq\x83\xe5\xc3\xf5\xdc\xe6\xb4q\xbd\x94<TY\xcc\xe9\xb1\xc6\xac\xf5\xd10\xbba\xdc\xad\x85\x19\"\xb1\xd4\xee\x94\xa0\xf6\x85\x93Ppx\x98&\xe6\x91\xd4\x98v\xf8\xa5z9e=\xf2\xdb\x89`F\xdd\x1fi_\xa8\xd1\xef\xddP	\xa4;\x81KD\x926\x82I\xeb\xbb+ibg\x1bFQ\x8c\xdf\xd1\xd7\xdf\xa4&\xa4B\xd0k\xd4\xe4H7X\x0dRdP\x02\xb2\xec\xf7|\xf4j\x12\xac\x9ac\xb1r\x97\xa1\xbfJ\xa2$\x12\x90\x1a0\xb7\xf7\xed\xecB~Z\x9d\x08\x80	\x9c\x03\xe2\x9d\xfb\x9b\xce\xc9{\xd8C\x82\x9f\x82\xc9\xa8\xfc\xa8\xa6\xbcD\x19\xc3H\xf0\x8e\xdb\x97o\xcd\xc0t?\x15\x89\x95p\x0b\x12\xe5\xd8\x8b%\xf0(\"C\x15V\xd2\xbcPw\xa0K/\xfe\xea_@\xf1\xbd\xf8\xeb\xb2r\xdf\xb1K\x08\xdfV\xc3(\xe4\x98\xc7\xa0k\x0e\xd5\xca\x0cG	\\\xf7GUA\x15\x1d\xba+\x80C\xe1\xee\xd9\xa8\xa8\x1f\xf9^W\xc6\xd5]\x06\x12\xa0!\xaa\xae/[5bd\xe08\xa2\x11\x9f8{C/\xbe\x16\x86 \xb5v{\xab\xe9Y\xd5[&\xaf\xc73\xe9\xcd\xeen\xcf+\xfa3\x04\xd6\xee/(1H\x88\xec\x86\xc1\xad\x15T\x89\x0ff\x99\xeb\xd1\x88\xc4\xe5?i\xb7x\xe7\xe2\xe7\xbb\x8f\xd3L\x10\xf1\x01`\xbe\xf7Z:\x8b\xdf\x92\xebq\xc6\xca\x07\x88\xa5\xe0\xba\xfdP3\xf5\xde\xc1=I\xd5\x1f[\xe7\x1f*\xa5$u\x02\xd3\x98\xc3$\xd9E\x80\xe5:\x82\xe3\xb4	\xe0p\x17\x94\xa1\xad\xb6\xad\xabL\xbcP;\xd7\x8b)\x01\x7f\x81;\x1f\xa5\xdaEV\x90\x8bI\xa2;\xb4\xe7dU%\xb502P5\xe9\xa0\xcctb\x0b\x04u\x99\x04\x96\xedHe\xc4U\xa1\x90P%s!\xfc>:\x05\xb9\x82\x04\x94\xe9\x8e\x917f\xe4\xa9r-\xdfX8\xf2\xfaK\xc5D\xbd3\xe6b\xf0\xfb\xb6\xe6\xad3\x86\xda\x02\x9d\xefW?h\x1c\x8e\xa5N\xdb\x01\xfax\xb0Q\xa3\xec\xce\x98@\x0d\xb2\xc8\xf5\"\x87\x86\xad\x9a\xc4\xe8\x02\x80\x9b\xf3O\x99d\xc1\x9f5\xae\xdb;\xef\x8a\x04r\x95wl[\xbd/h\x16\x80\xe7R<?\xa1'\xd7'\xd5L\"\xdb\xd3\x96\xfa\xcb\xafP6\xa4\xa2FZh\xea\x03n\x1c=S\xa9I*\x87\xe8\xbc^kX\xf0\xc9\xd3\x0e\xb9\xa6C=*m \x1d\xeaY\x06\xd8:\x07\x8e\xeby6436\xd7\xc0\xe59\xde\xe7m\x84:\xcc{8\x84\xc9:\xcd\xe9\xc7TE\xa1\x1be\xcc\x0e\xfe,R\x13n\xa8,\xf5\xb0Q\x9d\xde\xd7U\x8b	4?\x00|Qc\x05\xe3\xb6{9\xaf\x85q[\\\x90\xc2\xfd\xd7\x1a\xc5j\x1dx\xd2\xd9\x16\x05zq\xcb91\x11\xca\xa6\x05h\xa8\xab[,M\xaa\x9c\xd2\x15\x81\xcb\x9d\x8d\xb9:\xd4\x16z\xa7Mm\xae\x8b\xc5F\x8b\x02\xae\xb5sn{\x14\xed\x10Kl\xbf\x0eo\x01\x10\xddWuxEeH\x0eXzd\x0d\x9ae\x01\x96@\x1bV\xed\xde\x86\xe0\xd6]sT\xad\x8aSY\xda\x02P1\x11%\x01$\xb8\"\xbc% X\x02\xd5sjT\xc7^\xc9\x9du\x92\xdb\xd0-B\x17\xa9\xd2\xaatNq\xd81\xea\x84\x92\xe49\xdd\xde|\x98#\x87}+\x9d\xafo_g\x8bu\\5\xd4\xdd\x9e\x06\x83~<\xa5\xc1\xd0\xaf0p\xd5\x1deK\xe3\x92\xee\xab\x82\xa6\x85\x94VI\x01\xd6AqU:\xc9\xc4\xe3\xd7\xeai\xec-\x01/))\x86Z\xfd?Uzfyz\xaa\xd4sot=\xb9\xc5\xc2\x94\xff\xf9\xd1\xf7\xf8\xeb\xb8\xce3\xf9\xfd-\x87\xc6\xeb^T{\xac+?\x0f\xb2\x84Q\xaa\xcf2\x97\x9f\xe6\xfd\xfd:\xb5\x91\xf7\x13\xb7\x9e(\xd3\x89^5\xdc\xb9\xe9L\x05p\x06\xc8|\xff\x9d\x94\x85\xe8	\x8dg\xb6a\xe3\xe2\x91\xcc\x85\x1cz\xfb\xe1\xb0xn*\x19\xde\x9f[.\x9e\xeb\xd5\xba.@\x9e\x16\x9b\xa8\xfd\xeax\xf5)\x12\xa96\xfbs?\xf6\xcf\xf5\xd9\x9f\xfbB\xc8B\xc5\x06\xdc\xc8V\xabR\xcb}\xd0\xff\xc2m\xed!\x05\x81e\x11\xc1_\xa4!1\x93\xec\xa8|\xb2b\x1d\x8d\xc4~\x04.s\xa0\xa4\xfd\xe0\xda\x15A\x7f\xbe'\xc3U;\x96\xa0\xc2\x92AGn\x8f\xbbN\xc3\xf2\xb2\xdbOD\xa2\xb2\xe5\x9f\xe4!\xa5\xc9Q\x1ar4\xb2\x02\x9dLff\x9f\xa8\x0e\xa6\xc0\xd2\xdaS	\xf8\xae\xa0V	H3\x87,\x92K4\x13DBRf\xa7\x0e\xb3\xd0<(\xd1\xfc7P\xde\xa1Jg\x92K\x7fJ\xaf\xf3\xea\xe5\xd8\xc8x\xfe\xec\x1f\xf3\xe7\xcff9'V\xbeWA\xd9\xe6\xec\x0d\xa5_\x10\x98\xa5\xeb\x02\xd8\nE\xaf.\xdf\x9f\x84\xdc\xe6\x00~\xc1\xa5\xf7L:8\x1f\xb1\x87E\xc9\xa5\xaa\xebQ@\xcd>\xaa\\\x15]U\xc7\xcfJ<\xa8\x02	\xcb\xa5\x02\x99\xd4\x06)\x94\xfc\xfe\\v`\x16\xd5X\x010\xfb \xb6a*$\x9a-\xe2.\xab\xc2|\xe7\x16bVg:C^F\x89'\x87\x91\x1ew\xbdA\xd0\xa5\xec\xcbR\xd9\xdb\xfa\x1e\xff\xc9\x0dU\x05\x86\xe4\xf3\xdb\xa4\xcf^\xfe&\xbcD\x9c\xe47S\x15\xdf\xca\xe1\x1b\x14X\x1e\xb8g\xce\xbe)G\x03\x9d\x8c\xa8\xb8T\x10\x8b\xfa\x90\x82\xc7\xdfH\xe1\xbd\xc6\xc3Ynz^g\x13\xd1M\xccC?Oz\x1dVG\x90\xd6\xd5\xc9\x0e\xbaL>}\x84T\x7f\x7f$Tg\x88\xf5\xf50\xc6A\x1eO\x15\xf4\xbc\xefY\x99	G*\x01\xaa&\xdcN]\xb9\x1d10\xe2r?<\xca\xe5\x105@\x9d\xb2j\xcdH\x87\x95\xc6P\x18\xa9\xad<%h\x9b\xe8\xb6\x85d\xdb^\xbb\xf6\xc6I\xcd\x00\x12\x90\xf0D\xf2\x99\xa6\xc2\xca\x19J\xe5R\xdfi\xd6\xf5t\xe5\xf9\xad\xb2\x13\x02\xdb^#oQ\x04\x82\xe0\xb9\xc8\x1b${\x0d\xea\xe9\xb7\x1b\xe4#P~\x8a\xd2\x8c\x8d\x1bL\x0b\x07\xc3\xe8\xd5\xb4b!\xbe\x88*\x0e\xa1\xa2\x1f\x12\xa0\x0c\xe1]^h~\xac\xe19\x1bPpS^\xb04h\xab\x9eeT\x89\x8b\x88\xb9'Hy\xcd\xb1\xb9\xaa\x01\xb7\xf7n\xc2C\xde\xa6\xfaU\xf03\xe0o\xcb.,\x95=O\xd5\x03\x00/Rn1\x97\x08\xd0\x7f]\xdd>\xfe\x96^\xfe\xf6\xf5\x7f\x8f\x8d\xef\x94\x95\xdb\x80\xe8\xcd\x9a\xa5\xc5kz\xf9\xf5o\xe7\x94\xda~\xfb\xcd\xd4v\xd1\xb8\xbej\xebVtu+\xee\xecx\x06Ke\x17\xc3\xd2\xb2lCz*$\xbfy]H\xbe\xfen\"i\xd9/znB\xe63\xeec\x18?fyU[\x92\xf8\xc8\x08_\x85A/\xc0 \xccq\xce.\xa7\x14[\xdd\xb1\xe8\x11\xb1\xe2\xbf}\xf5\xea\xe9\xebW\xaf\xe6#\x1a=\xc6t\xe6\xb7\xaf^\xd1\x1f\xde\n;[\x84\x8a\x07\xdb\x9e\xe5	\x0d\xe6!g\xbep\xb7\xb0\xaf\x83y\xa8\x12r\xa7\x9a\xdd\xdf\xe7S5;\xd4C\xe4\xc0T\x8e\xbb\x83\x05B	\xf9\xa7\xcb\x136\xca\x97\x18\xf6\xd5\xed\xbc\xe8R\xe7\xd3,\x0f\xbf\x0f\x00\x853\xbaG\x91$\x8c\x1e/[\xafC.\xceT\xe6\x8cn\x07_4L\xd8\xa8\xd6mi\x89\xecv\xa9\x13I\xa2J\xd0\xc5\x1f\xa6\x95f#\xee\x8fq\xdb\xb2v\x18\x03\xb3\xb7\xbb\xd9\xc1\x97,{\x1f\xb1\xe03\x0d\xbb\xe6*\xb3\x82\xcf40\xa3\xbd\xbc\n\x9a\xf9w\xa3\xfc\xe8\xf0\x84\x85U>|\xed\xb5,\xcb\x07@\xb3\xc6(\xdd\xc9\xa0\xf20\xc1\xd2\xd5\x11h\xb99\x8d\xc1\xddi2\\\x1es{Vr\xf7\xa5h/\xc3$\xb0 \xb3y\x97\x1f3\xdc\xae\xf4z\xf0,\xf6pBgH\xb6x\xf7\x9b\x89\xcf\xee\xf7\xdb%\x01\x9e\x1bB\xe9U\xc4\xe4\xb5\xa6\x94\xfd\xb0\xd9E\xf1\xb9f\xc9M\x18\xbb\xd4\xf1\xe8\xbaA\xb1>K\x031\x03\xea\x06E\xf8d\xfc${Z\x1d:\x1d\xe4\x86]\xe4\xc7\x1a\xcem\x85){;\x06N\x80*\xcf\xa5l\x18\xf6W\x96\xad\xf2\x98\xabuS\xf1/\x10\xa5\x108cP\x9f\x03\xa8.\n|\xa5M\x8a<\xc2\x00\x88\xd9S\x98\xad\x86\xe6\xe1S\x92\x82\x97\x9f\xdf\xfd\x81>]^\xce\xaa\xd2S4\xff\x90\x9e\xa6\n\xb8\xd9\xd5Ey\x83[\x1a\x85\x87\xaf\xcas\xc2\x18\xc3\xeb\xd4\xfd*=\xee\x95\x8a\xcc\xe0V\x16\x16\xc7\xb9\x1a]}\x98R\xae\x81\xb4d\x04\x81\xbf \xad\x0e\xe4\xd4\xed?\x97S_\x7f\xf3\xdd\xd3\xd7\xdf|'w\xf3\x0b\xa2*]\x7fPK\xd1\xf3\xf2\xa1!.\x10jv\x84 d\xc2\x10\x10OFj\x81\xe9M\x10[\xc8\xb5\xfc&\xd3G`^\xec\xaf\x94\xf5\x9b>\x15\xcc\x1f	\xe23\x8c\xb5F\xdc\xc6\xb6)\xb5%\x81\xf5l\xba\xc8Fi\xbb>\xda\x00u$\x9b\xbf$\x9a_\xbfz\xf5\xf4\xfa\x7f!\x9a\x8d[#j\xf4\x86P\xef\x88\xa7\n\n\xab!!\x10Y\x92|g\xfe*9\xb5\xea\x92\xd5!L+\x9aV\x0c\x8f\x03d\x12\xe3\xb7^?\xa2TO\x1a\x8c\xa9\xdaZ\x16\xcbfb\x97r\xbd7\x8a\x85\x10\x8eC\xe0\"\xe7\xa1\x8b\xea\xb6\xbcMl~#\x0f%1\xceowW\xb7\x98\xcb\xf9X\x8e0\x95\x04/K!\xf9\xd8|L\x85\xa3K\x9d:?n\x91\xd6 \xe5\xe4B\x12\x99rn\xa7\x8f\x0eE\xd1Hhz9\x18\x136\xf9\xab\xc9\xd9h.}\x92\x87\x04\xeb\xe5\xab\x19)c(\x90\xe2a\xcc>\xe5\xc7\x04\xdf\xda\x1b7f\xda`\x0cS+\xd9(|\xa9\x80\x9cV\x95p\x9b\xf5\x88ZJ\xed\xeb\xec6?\xd6\xf0\x94\xe0\xcc\x05	hS\x02su\x1es\xefS\"\x892&G\x91\xdb1\xafY>\xbd\xed\x87\xa5\xd1a\xa3\xed\xfa\x8c\xc4J\xc7\xa9%3]\xec=\x9f\x92U\xc5>\xcb\xcc\xd6gY:Vs\xccz\xe8\xd8\"bE\xe1\n\xa5\x97\x95\x18\xf6\xdcj\xcfMLg{\x97\xdfp\xa0W\xb7\xfb-\x92\x0f~\xd8*\xd9\x01\xa5\xe5\xdf\xb8\x91\xb1\xee\xd2S\x05\x9d\x0c\xfa\x84\xdb3\xe8S\xc72\xd1\xdc`\xd2\x05	\xcdy\xec\xfc\x9c1\x88\xb4'\xeb.?f8\xca/G]~'\xaf\xf9,=\x97\xa2\x86\xd4m|\x9b\xb0\x8f\x99\xa2|M\xb9\x07\xcfs\xc8\xcf\x05\xd3;[&\x91\x9f3\x06\x81\x80\xc96\xcfy\xf0\xda6G\xd4\xf62\xab\x9a\x05\"\xb8\xabQ\xdf\x00\x95NE\x10\xe58\x00\x9e\x8eBP\xf5\x19\xa4\x98\xd2\xbds&\xea>+\xb0R\x9e\xa3`<\xed\xf0\xc5\x08\xf6p\x9e?Y\xf6\xd9\xc2\x13!\xcd\x16\x9fa\xcb\x1f\x11 \x86\x10\x8c\n\x1b|nR\xc4\xa6\xe7\xf5`\x14\x8a\x1az\xd8\xe6\xb8Y\x99\xfb\x02\xc7\xb7\xb9\xf2\x1cS\xe6X\xca\xccGt\xfaF\xb9`\x9b\xf4V\x90\x1f\x9d*7\x0c}\xb3\xd8\x96\x98Y\xa5\xc7\x03GIj\x8eDR\x0cLt\\n!\xb6\xa4\xe0\xd3S\x05}+\xe1\xcb\x8c\x80\xb9\x99\x919P \x9dP\xa8[\xa2B\xcbl\xdf\xe5(\xe0h\xcc\xe4\x00bm\xcd\xe4=\xcd\x0e\xc4\xc4\xfc\x90e\xd9\xf0\xb2\x9c\x93\xbf\xf5\xf1\xefl3r\xe9g\xab\x7f\x1a\x10Y\x90\xa6\xd0\x89\xe2\x96\xbe\x94\xcf\xe7\xe0dW1\x08\x84\xa1Aw\xcc\xce\xce`g\x0bd\x0c\\\xa0\x08V \xf8\x95^\x83\xcd\x8e\xd7g;:?`Q\x80~\xc0\xaf\xb4J\xc11Q\x01\x89\xd1\xe8\xc7\x0cy\xfe\xec\x1f\xcf\xff\xf1\xfc\x7f\x06\x00PK\x07\x086\"s\xf0\xd6\x17\x00\x00fD\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x001\x8dS]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/es_ES/common.jsonUT\x05\x00\x01NV\xd6j\x8cW\xc1\x8e#7\x0e=\xdb_A\x14\xd0H\x02\x0c:\xf7\xb9\xb9\xdb\xd5i\x07\xed\xb6a{f\x10l\xf6 W\xd1\xb6\xda*\xb1VR\xb5c\x04\xf3#\xfb\x1b\xfb	\xf9\xb1\x05%\xaa\xaa<\x99CN&\x1f\x9fT\x14ER\xf4\x9f\xd3Iq \xd7\xa8P|\x84?\xa7\x93I\xb1W\xd6b]|\x84\xe2a\xf6\xfaZ\xce\xe1\xe17\xf8\xe1\xce\xff\x00O\xab\x0d\xdc\xf9\xf8\xf3{q\xe7\x7f/\x8a\x0f\xcc\xaf\xd1`H\x0b\xe6\xe5K\xb9\x1bV\xdc\xd87\xa8<\xd9\xef\xb0\xe2\x86,$\xban\xd4\x11\xe7\xc3\x9e\x8b\xe5\xec\x97\x12\xbe\xbbs\xa4n[\xd2\x06\xdd\x98\xbc]\xaf\x16/\xe5\xe6[\xba\xc5\xcb\x9a|\xf0\x0b\xbb;9Tq\xc1]\x0d\x16/\xd0\x92\x0f?\xfa\x9f@[\x08\xd1v\x9f\xbe\xc0\xb8\x9f\xd9z\xc1_\xf2\xabF\x079\xe9]\xdd\xafQ\xb6\x86\xbb\x1a\xa23\xbc\x07	k\xd8\xe0\xfb\xebny\x9d;b\xcd\xee\xf1\xee\xeb\xd5v\x07\xebO\x9b_\x86#\xfc-\xec\x0ek\xed\xb0\n\x8bu^Qn`S\xce\x17\x9b\xf2\x91\xef`\xb7\x92[\xba\x0dB^6\x84`\xf7\xbc)g\xf3\x7f\xb0\xd4\x9fTM\x97\x07\x9d\xd3c\xfb<\x9b\xaf\xbe\xc0\xc3\xe2\x9fdI\n\xeb\x0bU\xe7\x1d\x1d\x8f\x06\xc7\x9f\xbe\xf3\xb7>vv\xc8\xc1O\xaf\xb7Y\x98v{\xd7x\xc1z\xab\x1a\x1c\x8e\xbf\x85\xd5\x13\xec\x9eK\xd8\xce\x96%,\xd6\xf0\xa5\xdc\x94\xf0yQ~\x19\xbc+\xa6\x93\xaf\x1f\xa6\x93\xa25\x9dS\xc6\xe7\x94\xaf\xd5\xb5\xf8\x08\xff\x9aND\xfe\x90%_L'\x93\x7f\xb3Z\x9c\xa8s=)*\x0c'|Dk\xb4\xed\x02\xf6DQ\xd9\x94mc2\xd9p\x1a\xb8Q\x13*\xcb#\xa6\xc7\x8al\xddSEeS\xb6\x8d\xc8WT\x83\xabQ\xf9\xd0\x8b\x89\x96\xc3\xc0\xd9\x99\x83\xa0\xeaF\xc7\xfa\x9cE\x81\x97\x14\xeaH\x8c\xf0O\xd2m*\xe1\x99\xfd\xeb\x7fV7\x82Vd\x83SUL\xdd\xc7,\xdfXR\xfd\x8c\xed P\xa4\xe1\x1f\xad\x8a\xa7+fMk\xb4rcxX[F\xda\xcd\xca\x93\xae9\xd8\xc53\xff\xc6E\xe9\x0c\xf9\x00o\xca\xea@.~z\x89\xba\x16\x8f\xdf:\x1f^\xe9\xc2\xa8:\x91S\xd0h\x9fOc\xa8:\xa7\xf4\x14)\xa2\x8d\xf2g\xa6/\x95?\xc3A\x1b\xb4\xaa\x91/\xb2i\xb7c\xe3\x06[\xa3*\x8c\xf6\x1f<0\x05.:\x9c@\x07\x0f'\xe5O\xf0\xaeL\x97\x97Q\x8dN\xf5\xcee-\x19\xe9b1y\xfd@\xca\xd5\xb0b]\xca\x10qf\x0c\x7fnI>8\xe5 PM\x12I\x7f\xa2\xcb\xc8$`j\x91\xbcD\xbae\xc2C\xac\xc4m\xd0\xd5\x99\xf3\xbfH\x95	>\x01\x91\xd2\xd9!\x1c\xbd\x1c-W\xea\x18\xfcq\xd7\xfd\x94\xab\xca_m%yW\xd4\xdaWd-V}+\x14U\xdb\xa3xu\xb5\xd5\x8d\x92\x895\x8a6\x8d\xa5W\x04\xdd`N\xd2J\x19\xb4\xf5(\xbdK\x9b\xab\xeb	\xf7\"-\xfb\x8c\x9f\xed\xb3\xb4\xec\xcb\xfa\xd7\xce\xf6\x92\x11i&	>)\xb6\xd8\x8a\xb4\x92$\x9e\x14\xaf\xf4.\xd2\\WC\x99]\x10\xcf\xbd\x1fsj\x84\xf3\xd2\xd9\xbf\xf9\xb1\xd4\xd9\xcb_\xe5\xfa'\xc5\xe7\x1e\xdb\xaa\xfd\xb8*;\xdd\x97d\xdb\x9a\xab\\\xf6\x8ce\x08\x04\xca\x98\xf8\xf6\xc8\x8d\xef9?\xfaD\xc9X\x08\xd408\xdb\xab7\xc9\xebJ\xd9\nc\xde<FI\x9c+*\x15\x94\xa1c2$1\xe1Fs\xb3~\x1c\x961\xc0\x1e\xc8N\xa3\xd7\xfd!;\x91\x1en\xd8\x0f\xbe\xd4d\xf9\xf6\x8a9\xc9M\x15\xd8\xd0\x1b\x1f\xb1(\xa3\x101\xae\x98\x1d\xd1\x8br\xc7\xc8~\xd2\x06!\x10\x81\x89\x88p\xac\xf6'y9\xd05\xda\xaa\\\xcfG\xa2\xa3\xc1-\xd9t\x0c\xceYh\x95S\x90\x0c\xca\x81Q\xd1mM\xd2\x18t\xd3\x92\x0b\x8f\xe4\\\xd7\xc6\xc6\xb5\x88\x00\x1c\x946X\xdfCt\xa0Jf\x19\x06\xd2\x92\xb9\x9cG\xf8\xbe\xab*\xf4\xfe\xd0\x99{\xd8\x9d\x10Zu\xe4\x9a7\x06,]\xc0\xa1\xa1~\x98\xd0\xed\xb3\xf2\xdc\xee\x8b\xc5:v\x03\xe97\xcaG\x07\xfe\xfa\xaf	\xba\xc9\x85l\xa8R\x81\xbd\xfd\x08\xc5K\x96\xb3\xe5<z\xbdS\xcd\xa6\xe7\x15\xf8\xe0\x03\x0b\xeb\x1d=\xf4\xa9\xb0\xc6\xa3\xaa9}\xe0@6\x07\xaeQ\xee\xbcE\x8c=s\xa9\xdc\x19\x94\x07\xcfz\xb2r\xc7\xfc\xac\xbd&\x9bG\x93\xd8D\xe1=b#R\x02R\x17\xfc\xac\xf12d)\x1c\x1c5\x10N\x08\x9e\xdb\xe1b\xcd\x9f\x88*\x9a\xd8\x1db2\xe7\xad\xdc\x11\x87\xb3-Y\x05m\x03\xc9\xf9\x84E\xefc\x12\xbd\xe3\xad\xb9\xf3a\xa9B\x15#\xbdV\xde_\xc8\xd5\x1e\x18\x86&\xe2y\x1a\x1c6y\xed\xf0\x9d\xe0Y\x1b	K\x8b\xb6\xd6\xf6\xb8A\x1e4\x98\xb0N\x00\xb8\x84\xc8l\xa7m\xd8\xd1\xe3P?kF\xb8B26\xcc\x80\xf2h\xf1V\xac\xfe\x9c\xf4\x9fw\xbb\x17!\xf1\x008\xcc\xc8I\x82\x039\x88\x96D\xfaOG2\x7f>\xf3=\xe9\x9a\xa0\xd2\xa1\xaf\x02\xf7\xcd\xf2\xdb\x89\xef\xe1:\x9cw#S`\xbc\xa6\xfd\xf5&~yBd?\xd1\xdd\xb0\xf9\xa6\xd0\xdd\xd2\xf2\xcb\x97(\x1e\x02\x17l\x0cB\xbc\xe4\x16+}\xd0XC\xe7\xa4c8<8Lu\xb0\x11Q\xf0\xd6\xc4gh\x83\xbe\xed\xd0\x07\xd5\xe3\xe4\x82<\xaf\xe4B\xee[\x0eC\xe7$XG\x87\xbe\xc7;\x83\xf1\xe5\xdcDA\xdeL\xe5RB<t\xbe\xca\xcc<8\xf1\xbb(\xa2\xb0=\xa7w\xf9G\xab\xe5\x7f\xc5\x0b\x1d\xb5\x05\xc1\x01\xc5 \xe4\xb05t\xe1\xf7\x9b\x99[\x0c\xe0\x0d]\x80\x9fwa\x9c\xe8\xf2JAW\x91 \x92<\xccF\x8f&\xf1-\xab@\x87C\xcay\xfec2\xbe\x18\x1fT\xe8\x92\xb7IJ{t\xfb7L\xb3\xd7V\xc4\x8c7:\xc3\x8d\xbeA\x9f\xc8\x0d\xa9\x9d\xcc1\xd5\xc6\xd9-_\xe6^;\xf2!\x9c\xbafo\x956:\xb5\xda\xddH\xbf\xbf\x97F\x97\xe6\x8a\xc7+\xbf \xa3\xb9\xa2J\x80PZ\xb6\xcc\x9c\xd3{\xb9\xe7\xce\xe6\x06\x9f{\xcdo\xd4\xc1I\xbd#(\x0b\x83u\xd4/:{\xc9\x85\xfeID\xd9**Cd\xc5z\x13\xce\xae\xe5\xde\xcc}>2\xa2\x16'7\xd9#\x02kG\x9c[1\xea	\xc1\xba?\xe77\x1f\xf92\xfe\xc4t\xf2u\xfau\xfa\xff\x01\x00PK\x07\x08LAB~$\x06\x00\x00^\x0f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/es_ES/server.jsonUT\x05\x00\x01*b2h\xbc;\xcbn$\xb9\x91g\xf5W\x04j1\xabK\x8d\xa4\xf1\xcc\xc1\xe8\x83\x01=Z=\xb2%\xb5,\xa9\xdd0\x0c\x1b\x88JFU\xb1\xc5$\xb3I\xa6J5\x86?\xc6\xc7=.|\xdbk\xff\xd8\"\x82d&\xb3\xa4n\x0f\xbc\x9e=\xa9\x18$#\"\xc9`\xbc\xf5\xd7W{\xb3\xa5\xf3m\x98\xbd\x86\xbf\xbe\xda\xdb\x9b\x9d\x1f\xff~\xf6\x1a\xfe\xf4jooovay\n\xa3v\x16:\xb4d \xd2S\x9c\xcde\xf2\x8d\x8d^S\x80\xa5\xf3\x10\xd7\x04\x0b\xb4\x96\xfc~\x80sO\x9fz\xb2\xd1l\xe18<\x90\x82\xdf\xf7\x14\x18G\x00\xa3C\x04\xb4\nt\x85\xb9u\n\xcd\xec\xd5\xde\xde\x9f\x19\xf1\xec\xb77o\xde\xde\xaf\xfbvaQ\x9b0\xf0\xc2`\x88#\x9c\xd7\xee\xcdZZ\xf5\x0dB\x1f(\xc0\x07Z\xdcT+`\xb1\x05EK\xecM<\x807\x16\x17\x86 \xaeu\x00\xbd\x84\xad\xeba\x836Bt\x10\xfa\xaes>\x823\x8a<,\xbc\xdb\x04\xf2a\x0ew\xb8D\xaf\xc1y8\xee:C\xa0\xe8Q7\x14\x00\xa3|.=ud\x03\x81[\x82u#Y\x88\x1em\xe8\xd0\x93m\xb6\x07\xe3W]\xdf\x9d\x7f\x18\xbe\xe5\xdaEFOp\xee<|p\xfe!\x7f\xcd\x89C\xaf\x00\x8dq\x9b\x00-F\xf2\x1a\x0d\xc45F\xd0\x01\xac\x8b\x10pI\xcc\xf4\x82\xe0Q\xd3\x86\xf8$\x01a\xe3\xfc\x03\x90}\xd4\xde\xd9\x96l\x1c\xe9\xa2\xd9\xe06\\\xba\xe6a\xa0~\xa7\xa9\xed<\xc1\xc2\xb8O=\xa1\x07\x04\x83\xd0\xa1\x8f\xc4\xf7B^;_\x18JK 4\xde\x19\xa3\xed\xea\xa5\xb5\xa0(!Xi\x8b\xa0mc\xfa\xe0\xa0\xe9\xd1*'\x13\x14\"~\xfeo\x04\xfe\x0b\x14\x1ag\x95VX\xf1h\x9d\xdd\xb6:\xd0\xc0\xe2\xb1uV\xb7\xfa',\x8c\\\xb1\x08y\x84\xe8\x94\x0b`\\\x80\xce\x85H>@\xe3Z\x07h?\xff\xc3\xea\xd6U8{\xa5\xdd\x1f\x9c\xe9\xdb\n+\xc3\xe01\x01\xd3\x07\xa6\x15|\x89\xb2\x81O\xb3\xed\x83nDH\x1f\xb5\"\x07\x9d\xc1-\xf9P\xa3\x8en\xa5\x97\x03\xdaAX\xf9 \xde^\x9c\x03Z\xdd\xa2r\x99\xc41\x8fj\xb9L\xabF|\x8b\xd5U\x1fG.y\x00'\xd8<\xac\xbc\xeb\xad\x82?0\x17\x19\x97\xcc\xb1\xf4-\xc6y\xe1\xb2\xc6\x966\x14t_\xc0t\xacZm\xbf\x0d\x1d5z\xa9IAK-\x05\xe8tG\nB\xf4\xa8W\xeb\x08\xdaF\xc7o\xc5C\xeb\xac\x8e\xceWdXT\xdf<u\xdaoGR\x0c\xe3\x87\xa1\xfd\x16\xa2\x1e\x0e\xf9\xbao\x17\xe4\xf9\x90\x15n\x03lt\\\xbb>\x82\xa5\x8d\xdcb\x80\x05-\x9d'@\x10\xac,\xeb\x8a\x0cER;\xf4\xae\xb1\xba\xcdD\xcd\xe2H\x06\xd3M\xf2\xf90nAv\x00\xa7h\xa1q6\xa2\xb6\xd0w\xfcx\xbe;\x02\xe36\xe4\x1b\x0c\x04\x97\x18\xb5\x05CQ\xa4\xc9y\xb0\xc2m\xa8\xde\xae \xba\xd7\xd1\xec\x12\x8f\x02\xe35{3\x99\x7fF~\x0e\xa1o\xd6\x80\x01\xf6Y\x0ez\xf8O\xb8B\xbbr\xfb\x07\xf0>\xf3r\x04\xcd\x1a=6B\xdf8\xbb\x9aPV\xf5\xf1\xaam!FO\x11\x16Nm\x0bA>\xc7q[\x83]l\xd68\xec<\xcd\xe3|\xf5\xe1\x81\x15\xa6\x0fL\xbfqm\xc7G\x0d\x08y\x97(\xf4\x86\xbc\x1cX\xc4\xf0\xc0j\xfb\x81\xc0\xd3J\xb3d\x88\xce\xe6\xe7\x11\xd7\x9ePA\xe3I`\xcf\xc8\xdf\xe3*\xec\xb2\x00\x91\x81\xf9#p%,\x04\xd7\xfb\x86\x12\xd9\xccBp\xa6g\x9c\xe1\x00\xdeY\xe2M,\x14\x9e5Jk\xb6\xd0\xac] +;\x08\x9b5\xac\xc8\x92\xc7H\xaa|\xc3\x01\xdc\xd2\xa7^{RL\xa0\xdc>F0\x84!\xc2\xf7\xa0\xf4rI\x9e\xd8\x02\xe0\xaa\xbek\xbe\x8b\xbb\xc6\xf9\xf1\xaaO\xcb\xed@\xe8\xb0\x85 \x93\xf9$m\xd4\x02\xdc\x90\xbc\x17\xb7dc\xa6\x97\xdb\xa4)\x87{e\xb5\x82rG\x07p\xbcd\x00=5D\x8a\x97\xb1\xb4\x1a\xdd\xea(\xbf\xf8^`\xa3\x8d\x01K\x89\xf9\xe0\xcccu;5\xab}\x88\xae=\xbd\xbb\x1bX\xcdl\xbd\x0f\xe4\xbfU\xb4\xd4\x96\x14\x9c\xde\xdd\x81\xef\x0d\xb1l\xa1\"\x05\xceBt]\x11\x9c@\x86\x1a>\xb9\xb8\xa6\x96^@~\xefV\xabJ\xf0O\x85(c\xcd\xc4\xd2<3\xce(\x9bqz@\x95\x8dp\xcd\xe8Y\x02e\xa2\x19\x113\xc0\xd7e\x1c\xaa\xcax\xcfa\xb3&\x0b\xd6Yb!\x08\x14y\x92\x0f\xab1zb\xeb2\xa1K\xb4\xabg\x94\x0c\xdaU\x8f\xabB\xec2\x0f\xa1\xc3\xe6\xe1\x05\xa25\xf7\xa1G\xdf\xac\xf5\xe3x\x08g\x15,1\xafF\xc8\x81\xf3+X|\xfe\x9f\xf0\xa9'\x85\xac\xedu\xfb\xf9\xef,\xa2\x95\x0dQ:\xb0Cr\xeb\x16.\x8e\x8f\xe4\xc6\xd3#\xcbd\xe3qc\xd8\xe6\xcc'`\xec\xa3c\xaf@\xc1\x86\x16AG\x1a\x16\x8ej&\x10\xb3\x01dW\x9a\xcf\xcb*z\x92\xf9\xa5w-`\xd3P\x08I\xeat\xc8\n\xf2\x19S,>\xa2\\G\xc6\xce\x12\xbb|	\xdf\"[\x8e\xac\xa9\x87\xb7?a4\x00\xda-\x13s\xbd\x8d\x80\xec-$\xfa|i\xfb\xb2\x7f\x7f\x98\x96\x89\x84\xc7\xaeF\xbd]\x9f\x95\xb3\x95\xfci\xf2>\xbd\xa5\xd9\xbc\x86\x90\x11 \xe0B\x93\x8f\x95A$Ev\xd8\xfeF\x91\x85[T\xbaX\xd4\x11\x00\xd6m\xc4\xd83\x1f\xc9\x99\xad\x90\xf0\xfb^\xa01\x03\xa6\xff\xf85\x0f\x01m\xd8\x8c7u\xc9\xfe-;\x13	:\xf8\xc6y\xf1\x1a\xc3\x9a5n\x8b\xf6\x0b\x86\xe9h\xd8\xca\x1a\xf6WGS\xeb\x10]DS]\x18\xb5\xa8\xcd\x1b\xef\xc7\xcfc\x00\xbc\xf1\xde\xf90}\x9f\xb2\x94\xbf\x8cd\xf2\x05$W\xa8\xc7\xaf\x13D\xe5\x88xGB\xf0\xc2\xb6\x1b\x0c\x95\x00c\x08\x1b\xe7\xd5\xf3\x9d\xd0\xe5\xa9\x97P8\x1f\x07\x14B\x19\x02\xf9G\xf2\xc0\x8e\xf9\x0b\xb8\xaa\xd9\x17\xd0\xdd\xf5\x8b\x17\xb1}\x19Q\xe8\x17\xca\xb5\xa8m\x8d\xediM|7#\xaa\xa7\x1f\x13\x80\xa7\xc7\xf9\x9f\xf5\xdc\xe9\xa9C\xab\x8e+\xf1y#\x10\xed\xd9\xa3\xc5\x00\x06C\xb5/3*Kh\xb2\x02E\x8f\x1cp\xacs5\x87\x9b\xb3\xf39\\\xdd|\x0f[\x88=t\xdeu\xdae\x97\xca\xb2]\xb5\x80Kj\"*\x17\x0e\xe0\xba\xa7\xc7\xec7\x07\x88\xd8.\xf4\xe7\xff\xb2\xb2(q\xa7yU\xfd\xfd\xdd\xe4Zd8\xf8\xe3e\x08\xaek\xb4\xb3\x14\x00\x81\x15\x8f~\xac^\xde\x92H\xb1\xa3*\x970|\xf9y\x86\xa6+\xc8\xf8X\xe7@Y\x9ffX++\x1d\xf8E\xb2\xfdd\xdd\xc1vk\xf7e.\xcd\xc4\xcd`\x95\xe3\xb7\x90\xa0\xf3\xa2\xbc\x04	\x7f9yh\xea%l\x0d\x19^\xdd\xd5\xd2\xf9\x86\x14G #\xcb\x02\x02\x86m[\xd7\x97\xa7U\xb4\xa2\x18mvD\xc3\x1c\xa2\xd7]\xe3\x14\x1f\x88U\xe9C^\"\xb2Bc\xc8o\xaf\x9c\x1aU\xdb\xdb\x04\x03\x01\xf2\xaa\xbd\xd9;k\xb6\x10\xd6\xae8\xca\xd9\x93\xe1w,\xb2P\xb1\xbd2n\x81\xe6\xecg\xd9\x15H\x8b\xcd\xf6\x1760\xd9LUR\xb5r\xae\xf6&\xde\xa6!O\x95\xc1?5\x9dk\xad&\x1a=\xc7\x93\xee5\x1ceD\x97\xba\xed4\xfa|f%\xe0t\xd5a\xad\xb5\xa2\x13m-\xa9\xe1\xf4\x7f\xd4\x8a\xe0,\x85\x1dp\xc3\xa7\x9d\xb1\xc9\x04k\xef\x84N\xa2\xf15>\x12,\x88\xec\xf3@\x85QO\x82}\xd9\xcf\x10\x16\x83C4\xe6\xb0F,v\x94\xdf\xe3jE\n\xb0\x8a\xf2\xd9vH\\?\x98N\xd9\x0c-E\x94]S\x9a\xb7\xd4\xf4>\xe8G2c\xc40\xc0\x84wXk\xf68\xbf\xf2Y\x9e:\xc3\xc1`t\x80\xbc\x9am\xa3\xec\xf4\x05\x91\xd9N\xa9J\xec;\n\x9a|\xeb\x18\xea\xee<@\x84?\xdd\xad\xdd\xe6\xcf\xb0\xe8ct\x96_ug\xb0\xc9Q\xd3\xb0i$\xe0\xbc\xfe\x89%\xde\\\xbb\xcdM\xb2\xcc#\xa9a\xf2\x05\xc3\xbdK\xf9\x85\x15\xa0\xada\x17\xc9c\\\x13[i\xb4\x10\"6\x0f\xa4^\xe2\x80%\xa2&\x9f\xc70\xf2\x98I^\xbb\x10\xd1\xac\xb4\x88\xef\xf7\xbfn\xd6XEFz\x94\xb7K\xb7\xd26\xef\xa9\x16\xf0\xab\xfe\xd1\xb1\xb5*\x0b\xc5\x0c\x04\x0e\xba\xc4\xb6\xb0\x05\x00\x14{\x8a\x9e\xbd\x1e\x8f\xf1\xf3?\n\xa6\x92'\xe9-B\xe7\xe9Q\x87\x1e\x8d\xfe	\x1b\xfd\xf9\x1f6'jvp\xec\x10\xbfu.\xbe{$\xefu\xa5\x9a.x\x0bx\xe7\"\xb82\x97X\xbf\xc8\xc94\x1d\xd6,6k\x96\x16!\x10\xb2F\x80@\x1drp\x06\xc65\xe2,\xa6p\x12\xe1\xf4\xecz\x0edY'\xb3r_\xf6\xc6$\x12\xa8\x94\xa7\x10`M\x9e\x0e\xe0\x92\xf8\xb1Q\xdb\xc5-S\xe0@\x83\x97gg\xbf,>\x807O\xc8\xa1\xeck\xd8_\xc7\xd8\x85\xd7\x87\x87\x89\x8f\x83\x94#d;\xbd\xbf\xf3\xad\xd3@/}\xe4\xcf	\xf2\xba|\xf9h\xd3\xb7\xfe;\xa3:\xddN\xec\xeeE;\xb1\xbb\x17\xed\xae\xddU\x14\x14=\xb7\xbdI\xba\xcf\xf5\xc4\x82'9\xca7'r\xa5\xcaei\x1b\xd2\xdb\xe5\x90H\x02c\xfeH\xf1B=\x05\xfd\x13\x89\xc3\xeeY\x81\xf0-\x04\x8a,\xfe\x15\xbdOj\xf4\xba.~\x7fv\x92\xa90\xfcg\xb9H\x1fQ2L\xa3.\xf9m\x01\xcc\xeb\xe1\x107\\\x9c\x85\x03(k\xa0A\x0b\x8e\x8de\xd2\xc7\xc2|\xed\xcd\x18m\x1fF\xd4o\x9e\"y\x8b\x06\x128\xdf\xb3Rs\xf0\xd4\xbaG\xe24\x10)\x1d\x81\xca\xc2\xdf\xfc\xe67\x87?\xf0[>\xfc6n;N\x86H\xfa\xa0\xa9-p\x8b\xa1q\xe3y_\xa5\xe1\xc4\x0bOK\x8a[\xb3\xbbU\x04p\xd8\x9fw\n\xb0\x08>\x06\xb9\xa1L\xa9\xda\x1e\xfb*cq%Cq\x168>\xcex$\xe3>&\x19\xf8G\xb6\xcf\xc5\xad\x90\x9cs\xb5\x93\x0d\xd6R\xfb\x10\xe1Q\x07=\xa1\xf7\xf4\xa3<\x86\x81d\xe2r-\xc0$\xf8\x99\xec\x15>\xe9\xb6o\xcb\x94[B\xdf\xe5D\xc4\xae\x07\xd3\xe2\xd3\x9d\xfe\xe9\xd9{d\xd9{	\xa3L<\xc7\xc7V\xe5\xead\xc2\xeb\x07\xad\xe2z\x07\xed\x86a/\xe2M3_e\x94V\xfd\xfd\x1f\x06\x84Wi\x98])67)Q\x95l{\xc9\xb16)Q+\xfc\xb1\x0e\xe5u\xec\x96R\xa5\x80\x13\xe2\xbb\xb5\xf3\xb1\xe9\xe3/A\xc0)N\x94\xd5\xcf\xecj\x04\xcd\xa7\x80\xe9S\x1b\xd7\xc9c\xab\xdf\xd9\x9c\xad\xee\x90\x90g\x95\xa1\xb4(\xc8\x9e\xadB\x81\xa7\xa4\x8d\xf6\xd0Z\xe2tr#Ox\xfcx['w\xafw\xd2\xba\xec\x91\xc4\xc8\xb9\xbe\xe8\xc6\xc4q\xb5\x996C\xecY\x8e\xed\x9a6C\xd4\x99\xbfm\xba\x81s\x08\xc3b\x8e\x8e\xea\xb4\xc2\xf1\xc2\x13\xd8\x11:\xeetQ7\x15\xa7i\x986\xc9\xd5\xd5\xc5-4\xd0R\x08,\xc8\xf9\xd1\xb3\x97\x17\x87h&g\xe1:\xceLM(,u2\x96\x13:\x0c\xcc\xe1\x96\"vl=k\xbf!\x97qK\x8d^p\x92\xe6\xcb+KY\x86<\x05h4\x87\x86\xe0\xa0\xb7\x10\xb6\xb6\xd9`l\xd6\xa9J\x83\xd0\xf5\x9c\xfcW\xac\x1cZ\xb2\\\x89\x19\xf8sF=;\xecwF}\xf9\xb0\x8b\xcfp\xfaBb\xb8\xf8\x1a\x90'\xe1~\x8c\xdc\xae\xb0\xeb\xd8\xe0\xb8\xe5\xe0';v\x95\xc3\x8e>,\xe9bVX|\x9c\xf9\xc9\x91z\x96\xe2r\x1b\xf6\xfdF\xae\xd30\x07[<\x98\n\xbd\xa4\xc1 mJN\xbfx))\x8f\xc6L\xb0\x03\x9dSa\xceF\xefL8\x80\xab>\xc4\xe79gg\xeb8h8\xaa\xc2\xc9\xcd\x97\x04\x95E\xf24\xa7\xd9\xa6\xfe\n\xcb\xef\x90\x81\xfbY~\xcb\x90f\xc3\xe1\x0d\xfd;]\x17f\xf5B\xfc\x8e\xe4[\x0c\x9c&\xa0DUp\xa9\xed\x03\x0c>\xedl^/`sLJ^!\xf4Ve\xc7P\x86<\xc5\xd7\xdb\x18\xdd<\x1c\xc0\x07N	\xe7$\xaa\x9a\x83\xc5G\xbd\xc2Hr'q=\xc5\xa4m\x88\x84\xb5\x14t\xbe\xb7\xb4\x93\xe2\xbcaX\x16\xb3\xccU\x8a\x06\x07\xd1\x1b\x02?.\xc4\xaeQI\x96\xb3\x9cc\xca\xf5]K\x85k\x87\xd0\xbd\xd4Iv)\xc5\x0c\x9d\x90\xca\xc0/\xd0\xe2\xaf\x11\xf5\xfe\x8c\xcc\xb6\x1f\xb0\xdf\x99>\x02I\xb9\xbb8\x8dg\xce\xeeG\xc0\xf002\xe69\xe39\xec\xb9=\xc4\xc31%\x9aG/\x04M\xf5~.\xc5\xb0\x96\xfe\xd1\xf5\xd5\x83\xba\x15\xb8$C`-3\xe9\xfbnK\xe9\xc6\xd9\x86\x80\x1e\xc9o\xe1\xbb_\x01\x86\xa0W\\\xc0\xb6E\xc9gs\xc1_\x89\xf98*\xaa\x0b.|\x8d\xc4H\x1d\x9e\x98\x9e\xe0~\xecJ(\x11\x1f\x97~\xd8\x12-x\x9e\xbb\x16\xb8\xec\x9f\xd42\x07\xf8\x1b\x1d\xd7\xb0\xff\x17\xbf\xcf\xae\xde\xfe_\x16Ud\xc0$9\xefR\x91A\xae\x9a\x0c\xd9\x92\x92\xf6\xe9\xea\xf7W\xef\xe7:Y\x15\xc0\xdd\x16\xc0\xee\xd3\xf6d0\xeaG\xba\xd7\x95\xe5{\x13\"\xb6\x1d\x06V\xbd\x91+\xf5\x0e\xf2:,\xc22Y2]\xc1a\xd0\xc1k\xd8\xff\x8e\x03S\x04\x8c\xfe\xf3\xdf\xc3\xe4\xe3:\xc2\xfa\x04e\xf8\x9c\xb1\xcelo'\x0e\xde\x9fn)t\x8e\xdf\xe4\x9fS\x0f\x80\"OcM\xf1\xaa'\x0e\xd2\x0c,\x1cG\xa30\xac\x9e,\x9et\nTL9\x17\xdf\xdf^\x8e\\q\x9c\xc9\x80\xf9dX\x8c\xa5\xb8c\xb9\xb8;\x94\xf98[\x12\\\x9b#\xe4\x92\xa5\xea\xbcc\xaf+\x95:7\xce?TJ@\x8ab#M\x19\xe5\xae\x07\xc6\x9d\x8bf\xcf\x93\x90\x0c\xe72\x1f\x1a\xd8h\xab\xdcfD\x19j\xff\xfdn\xac6\x9d\xf1c\x8a\xb0\xe8\xdbN\xbe WN\xa3\xdb\xf5\x03\xe4\xabJ\xbap\xd0\xed\x15\xd3\x01\xcdx{w\x9c\x95!\x10X\xf6?\xd0\xc4t\x14i\xaad#\xe5)\x0c~W.\x972f\xb8%\xaeG\x90e\x87<\xf5\x95\x0cU\xd2\x1f\xbeV\x99\x0e\xd87dq\xd4!w\x0c\xb8>~\x979)\xf3\x07\x8dk\xffiJ/pU\xca\xd9\x9dv\x82\xe3l\x89\xf3ln,\xc8\xf8\xf9\xcd\xb0\x92`}\x0b\xbd\x8d\xda\xb0C0\x98\xef\x00\xe8\xa9\xe43u\x83\xc6l\xc18\xc9\xb1\xb9\xber\xe6\xc2\x1a[=U\x86w#h\xbe\x03\xf8\xaaJ\x0c\x9d\xd3\xa6v.R\xa0qW\xc0\xb5.\xcek\x9fE\x16!\xe2r9\xed4\xb8c\x10\xdcW\x9d\x06E\xc5Ic\x86\xecH\x8d\x08%K.ziM\xa8j\xeeX\x05N\xb4\x1a\xeb\xcc\x97\xb4\xdaR\x1b\xca\xa1OeaR\xa1v\xdc\xdb\x96\x87\x7f\xc7\xf5c\xf64\x11\"\xb5r\xc1\\\xe8\xad6\xb2>M7{\x85O\x03\n\x89\xe2\xa4\xb9\x89\xe7\xffOM#\xf95\xbd\xd452\xa1\xaeGg\xfaJ\xdb\xff\x0f\xea\x93\x8b|\xde2\x92\xa2\x05%~|\xc7\x86 \xdfc\xd6s;I\xde(\x85\xf6,N\xc3\x97\xe4\xf1\x98\x1b\xcc\xb8\x8f\x1b\xb6\x07\x83\xa0\x91\xfdr\x814zl\xa8u\xa3\xe6\x12\xc0\x01C\xe6\xd3\xb1t6\x11w\x83\x11\xd9\xb0\xe6L\xb5\x94\x9bG&\xf9\x0d\x9e\xbc\x1d0\x9d;\xee\x01\xeb\xc8\x07g%\x0f9vE%\xfe\x96\xb2@q\xd2\x89m\xc2\xce\xd2\x1d\xb4_\xce\x8fp{\xa2\xe76@\xe4\xfaN\xeb2\xde/`\x938'{e\xef\xec-\x1b\xbb\x01\xeb\x07\x9e\xcb\x97*\x85\x1b\xce\x89\x17\xa5s<\xd1'\xa8\x86\x1e\x18\xb6.\xbc\x91\xd4\xf3\xad#YZ\xb4/\xa6vsj\x96kz\xffzf\xd7L\xf7g\xd3Hl\x04X\xdd\x8f.\xf7W\xd3\xc8\x80r/\xcaU\xaa\x9e\xed\xe6\xa4Pu\xe5\xd2\x9dE\x8f\x0b\xfcXn4\x15bhZ\xca\x04\xee\xc1\x0ck\\h\xa3#\xf2G\xbdp3/\xd0\xda\xe9:\xf9\x05)n\x91KX\xc3\x95\xfc1\x0d\x99\x992\x98\xb8\x14\xb2\xf1\xd5\xde\xdf\xe6\xaf\xf6f\xaec\x1b:t\xcer\x87\xca\xec5\xcc\xb8<\xd7\xdbt,\xb3\xf4V\x18\xbc\xe4\x16\x1f\x97\x1fO\x9a\xdc\xe4\xc4\xd4\x0c?\xf6\x81\xb3\xba\x08h\x9bu\xef\xd99\x12\x12\xc1\xf9\xc8\xe7Q\xac\xca\xecD|\x88\xa2\xa8f\x97\xdc\xce$r66\xdc\xcdJ\xb8X\x81D\xc8S\xe93m<g5\x9f\xc6\xaf\xe4$f\x11\x87B\xce\xec-\x8bL.i\xb0\xbb\xa9M\xfe\x9c\x93b\xc9\xf3\x94\x8d\x9e\"q\xaf&\xd9\x98\xd7\x1cG\xfc(\xc5\xb6\x84\x96\xda\xcephV\x8ei\x81\xf6\xa6~\xc7\x7ft}UO\x93\xea\x8cJ\xb5\x83\xc3o\xc2!\xa7\x8d\xbe\x19\x1b)\x96\x8e\x9bc\xb5]\x81'\x0c\xce\xbe.7\xc5\xb6\x90\xb3P\xd2;%\xfa\x9c\xf8\xf5~#-}\xda\xc27\xe1 /=\xae\xf3\xd8\xbc-u\x07\xcc\x93=\xbd\xb8aU\xfeM\xce\x1d\xe7[\xe8ua>uG\xd7\x8d\xd1\x82u\x96\xdd\x0e\x9e+n\x0b\xbb\\\xa2\xc2\xa1E\x8b+\x92\xbe\xdc\xb4Xqh<;V*\x8f%\n\x12C/p\x19&\xcb\x9e\x16,P$\xe8\x04359$\x7f\xd7Q\x132E\xeab\xa8\x9aH\xb8Yz\x0e7\xd7o\xe7\xd2\x90\xca]\xc6\xac\\\xd8\xb6\xa7\xfeKhs\xb6S\xe9\x96\xa4*\x10\xd8\xec|\x7ft\xf4\xf4\xdd\xd1\xd1|\x98\xe6\x1dC\xa6\xf5\xfb\xa3#\xf8\xdd\x89|\x98\xb4\x17\xf4V\x1dd\x86X\x81\xceN\xb6\xb3\x9d\xf6\xc3I\xe7!\xf7\xd6\xd9\x15U\xa9#\xee\xad\xb3+\x9a&\x8ex\x99\x97:>#8n\xf9\x17\xf3V\xf9\xa2\xb5\xa3\xb3(]\x91\xb3\xc6\xf0A6\xbc\xeb4\xffLd\x0d!\x1b\xcc\xd9\xa9\xfcH0g\x97z\xd5\xfb\x94\x05\x90\xc9\x02\xc9vw\xba\xecNdd\xba.\xc9M^\xc8O\xaeB&\xc3\x1a\x93\xc2\xc8\xfd\x98\xb33\xfe\x9b\x1b\xcb\x0cU[\xeadC\xbd\xa0\x18\xbd\xb2@\x94Q\xbd \xa71\xcb\xfc\x90\xc2\x9c\xa9\xde\x97\x14\xe2\xec\xac\xfc.\xbd\x1d\xda\x8bN\xe1*\x90\xfc\x14xi\xc0`FK\x9bF\x9e\xe9\x9b\x87wI<\xcf\xdf\x9f\xfe\x0e\xde\x9d\x9f\xa7\x89\xd4I\xc0\x1b\xde\xa6_\x02\x95:\xe7\xec\xe2\xac\x8c\xc8F\x1dEF.\xca\xef4c\x0c\xad\xd2\xf6\x8b\xf4sR\xb5\x98\xb1\x17ZD\x88\xcf\xde\xe8\xaa[\xaf\x88\xd9\xb0R\xdb\xd5\xb37\x81\xcf\x1e\xc0\xd7\xe4\xff\x87\xa3\xa3\xa7\x1f\xfe\x05\xf97n\xc5a\xc5k\xe0\xb2.\xff\xca<\xf1\xef\xd4\xf5\xc3\x13\x1czH\xc60\xff+AZU\xd2\xb5\xe5\xaa\xae\xeb\xf1\xb3\x15\xf7\xf7\x8cL\x1aR\x04\x15\xe7mI\x8d\xfd\xbc\xec|\xa4@x\xd1\x1b\x13\xd6\xb9lR\xd9\xaa\xd9\xbb\xfc3\xc17\xf6\xda\x0d\xa90\xd6\xa3\xa0$]\xe46\xdc\xe5\xbb\xad3b\x92\xe0\xe3U7\x83\x8cu\xbd_\x0d\x12x\xc3\x03\x11\xc0\xc3JJ=)\xed\xa9\x89\x177\xbc\xf56\x8fX\xaf_\xdcLW$\x97lwUN\x02\xc9\xca\xe4n\xde;g\xa2\xeex\xe1\xb96\xa9~\xcc\xdf-5\x86\xd0/>R\x13\xe7\xb9=\xdag\x1d,	&\xb2\xdc\xf2-\xff3\xc1\x1dH\xc1`Xs7U\x11\x16O\xab\xdepU\xfd\xa9\xe3\x024\x1b\xf7|\xc1\x81\xe2I\xee,x\x0d\xb3;\x8a\xa5\x8d`\x98\xbeL\x02X\xa6\xb3<\x8a\xc7\\\xbd\xbc\xb0F\xce\x18\xf0\xa2\xf4\xab\x82\x9eh;N\xc0\"w\x07\x0c.\x80l\xe2\x7fT)\x1e\xe6\"\xeb\xbd\xec\xe3\x0f\x8a\xa2\x84\x04\xd5\x1d\xe4C\x11\x14\xfdG*\x96\x9a\x85'\xeb4K\x0ds\xc9V'\xe6\x06'\x99\x1e\x14\xf1{\xab?\xf5\\\x0d\x90\xa5\x9c]cO\x91\x0e\xa5'\x91\xcdf\xe5\\p\xb0\xc9x\x87\xbc\xdcL<\x11\x86\x0c\x1e	\x97Q\x05\xc2\x7feWo\xb3\x9d{/?\x04\x96\xbcjQ\xbcI:\xe0C\x86\xbc\xda\xfb\xdb\xab\xbf\xbd\xfa\xdf\x01\x00PK\x07\x08\x97\xd3;\x8f\xdb\x12\x00\x00\xbf4\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x001\x8dS]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/fr_FR/common.jsonUT\x05\x00\x01NV\xd6j\x8cW\xcdn\x1bI\x0e>KOA4`x\x06\x082\xf7\xdcd[\x1e+\x90lAR\x1c,v\xf6@wS\xea\xb2\xab\x8b\xbd\xf5#E;\xc8\x03\xcc[\xecq\xb5\xaf\xb0\xc7~\xb1E\xfd\xa9[I\x0e9\x89M~\xc5\xbf\"Y\xd4\x9f\xe3Q\xb1e\xdd\xa0->\xc0\x9f\xe3\xd1\xa8xA\xa5\xa8*>@q3y|\x9c\xde\xc1\xcd\xdf\xe0\xfa\xca\\\xc3\xfd\xd3\n\xaeL\xf8\xf9\xa3\xb82\x7f\x14\xc5;\x8f\xafH\x92\x8d\x07\xee\xa6\xf3\xe9\xa6?q!_\x11\x1aV?@\x05\x85\x9e\x88p\xd1\xe0\x8e\xeez\x9d\xb3\xc5\xe4\xf7)\xfcPs\x80\xae[\x16\x92\xf4\x10\xbc^>\xcd\xe6\xd3\xd5\xb7pE\x87%\x1bkfjSk\xc2p\xe0\xaa\x02E\x07h\xd9\xd8_\xcc\xaf \x14\xd8 {\x1f-x\xbe\x99\xa8j\xe6-\x99\xa7F\xd8\x14\xe9Uu>\x83\xaa\x82\xab\n\x823^\x07'T\xaf\xe0\xc7\xe7.qN\xef\xa8\xf2\xeey\xed\xcb\xa7\xf5\x06\x96\x9fV\xbf\xf7!|\x97vM\x95\xd0T\xda\xd92\x9f\x98\xae`5\xbd\x9b\xad\xa6\xb7\xfe\x0e6O\xe9\x96.\x93\x90\x8f\xf5)\xd8<\xac\xa6\x93\xbb\x9f8jj\xac\xf8p#ry\xac\x1f&wO\x9f\xe1f\xf63U\x12\xd3:\xe7\xf2m\xc3\xbb\x9d\xa4\xa1\xe9+s\xe9\xa3S}\x0d~z\xbc\xac\xc2\xa8m/\xe8@\xd5\x1a\x1b\xea\xc3_\xc3\xd3=l\x1e\xa6\xb0\x9e,\xa60[\xc2\xe7\xe9j\n\xcf\xb3\xe9\xe7\xde\xbbb<\xfa\xfan<*Z\xe94J\x93K\xbe\xc2c\xf1\x01\xfe>\x1e\x8dF\xc5+;\x1d\x8cD\xd2\x14\xe3\xd1\xe8\x1f\xfe\xbb\xa8\xbd$\xc3jr\x9a\x12.\xd0\x03`#\x94\xb3t\x86\xa6O/\xca\xb2!\x98\x95\xad{,\x0b\x93\x91,\x060C%\xab\xea\x8cK\x9f^\x94e\x03\x9dG\xc2\xdeST\xaa;e\xf3\xf1#Bs.|\x89\xe6L`\xd5\x88\xd0\xa4\x13O\x08c5ZJ	)p\xc7^$$\x1c\x01\x13K\xc5\x9e\x9e(V\xc7&Z)JVVc\x19J\xf96\xd3\x17\x92\xd8O^\xbe\xeaN\x95\x13\x9a@\x92\x89=\x14\xe3/\xe8K\x8b!\xe0bE\xaf,T\xa5i(\xe85t\x7fYR\xd5\x0f4\xd4\xa2\xf2\x97P\xdcbYS\n!\x06W\xa1JV^Q	\xcb\xda$WKAz\x97\xec\xbc:c\x1f\xf9\xe0%\xdd\xbfA^\x0be,\xaa\x14\x88\xe4\xf2-\xd6p\xa2\x82k\x0d\x9a7\x8f_\xa0y\x83\xad\x90\xa40\xe7\xc4\x8b6\x1b/\\Q+\xb1\xa4 \xbf6\xe0!p\x10\xb6\x06a\x0d\xd4hj\xd8\xa3t\xf9\x18W\xa41{\xb8\xe0\xaa;\x0do\x84\x0f\x8a\xa2\xf3K\xcd\xad\x16\xdd\xc9\xa2\xc8\x892D\x13)\xbd\xc9\x0d;\x0b{\x16\xe9\x94\xa9cX\x93\xedV\xf4\xa91q\x90z|\x9a\xa9\x11mC\xbf\xae\xad(\xdf|\x97\x14\xdd_\xadP\xbb\xb3\xd8\xa9>\x17g:\x1c<\xb2\xf3\xcc_\x9e\xd9\x99_s\xe7\x99\xa3*Si\x16Uw*Y)*mw:\xd7\x87\xa2/\x82Ur\xf4\xa8\xcaZ\xf3\xfb\xf7i\x1c\xc7\x89W\xbc\xfb\xee\xf08\xb4haEC\xb9\x92K\x94\xa4\xaaA\x1f|D\x95\x9a\xe0\xbe;\xed\x13\xb9\xc0\xdc\xec\x93}\xa6\x16(\x12\xf5\xd1\x89|\xe6\xa3\x132\x91\x13\xee\xfe\x97\xc85\xb5\x89zJ%>*\x1e9+\xbf\xebNe\xdf\x94\x07\xa2\xb7\xb33w\xa2I\xa0\xb9S\xdf\xf9\xb2H\xc9\x1d\x15\x1f\xc9%\xea\x992n\x8d\xcd\xb0\x7f\x9d87o\xdb\xcac\xba\xf2\x89\xa7\xc12\xa0\x94\xe1\xa9J\x05\xff\xc2\xa8C\xdd\xde\x04\"\xf1\xac\xe5&01\xc1JT%\x85\xda\x99(\xe5\xce\x97]\xa2E\xc9;\xcf\xbf\x8dd.\xd4R\n?\xdao\xcf\xe7n=\xc3;\x90T\x0dv\x81\x9b\xec\xc3\xda\xb5\xad\x16\x0dip\x8a\xa0\x95\xa8\xca:\xe9\xabX\xf9\xab,6\xa4\x1b\xa1\xb2}j\xf8\xd5\x87[L\x03\x11x\xbe\x8f6\xccs\xf4\x9d\xfb\x01\x8a{!	,3H<\xf7\xf2V(a\xea\xf4\xe8\x04\x8d\xb9\xe2v\xcc;IkV\xbb\x0b\xa7#\x1b\x8c\xe7\xa7\xb5\xa4emoYk\xd7\x86\xc16\xbf\x8e,\xb4\x82\x15 t\xa7\xb2f\xd7\x9d\xa0e\xa7\xa1Dg\x08*\xdf\xe5e-HC\xc9Zs\xd3\xbaT\xc9\xf1\xec]\n\xf2[]\xba;9c\xc4{\x98#\xb4\xb8#\xd8#4(\x94%\x85\xcaB\xf7_\xab	4\x955\xea]w\xa2\xac\xb3}@\xe3\x9f\x92b\xb6\x0c\x83$\x8d*4\xc1\xe1\x8a\xb4\x12~Z\xe4\x01\x16<\xf7\x92y\xa6\xcf\xa3\xad\xdf\x0e\x9eIkvB\x9eK v\xfb\x86o\xceEs/\xbet'@\x07/\xb9z\x1a\xd4ok\xa2\xa0|\x81\xfa\x0d\xd0\x80\xa1T\xc0EC\xa2\xe2ga\x04\xab\xbc\xf0,<\x0b\xf6\x817\x00EF\x1c\x9b\xcf\x82\x0e}1\xc3Vs\x03\xb6&0~~\xce\x96\xdeD\xf8$I\xa5\xa5\xb8feUzG}D\x0b\xff	BYN\xbb^B\xf1~\x08\xe2=]\x8a\x9d\xb1\x0b\xb4e\xc8\xef\x9c\x0c4l\x8d\xbf\xe0\x16\x8d\xbfi\x16{R6\\3\x99\x96\xfb\xe7J\xd1\xa1W\xfb\xc8nO\xe8\xc0\xb8WJ\xde\xb5\xa4*\xa1v+\xf2;\x8d\xc7,#\x03t\xe4\xa45R(\xbb\xe1\xd4s\x1e\xf5L\xda\x80$(/\xdb0tz\xff6.\xc8\x18\xff \xc2o\x10\x99\xf0\x1bl6\xf3\x84\xf5;g\xbf\x96G\n\xb6\xac!H\"\xe8\x9f\x8e\xd3\xca\xeb\xc78\xe0\x9e\xfe\x05\xdd\xc9v'(\xc5yl\xeb^	\n\xc3\xear\xd5\xbc9\xf6	X\xa5\xf53\xdc\xe4\xcb\xf1\"\xc5y5\xf5EA\xfa\x02\xed\xc3\"}	\xcb\xafi\x84\x18\xb0\xbe\xdd\xado\xdeP\x07-\x95b+\xa8\x02\xa7\xd3\xf0\xd1\xb4\xd5\x14\x1bdRZ\x87R\x98^g+\x8fi\x1d\x19^\x9e&\xdf\xe2^\xb0\x16;\x85\xe7&\xd0d\x9dNY\xb3\x9c\xf7#\xedd^j\xfe\xb3\x93y\x0d1\x84:\x96\xcdmMz\xf0\xde\xa6\xad\xcd+Od\xe2\x1b\xdf\x06\xd3/\xadH\xffj\xe6\x08\x89	\x08\xe4\xf99\xf1\x86\xecZ\xf2a\xc1q\xd3Y\x93\x05#\xf9\x00~mH\x88\x9a\x0f\x8flE\x19\x003\xb5\xe5l\xa6\x95b\xf0/`\xed?\x81\xb7\xdb\xd8\x19\xfeO\xd1\xf0n\x8cE\xebBl\xebHE\xe5\xee\xe5\xd5?\xc8\xdet\"3\xbf\x11!mS\xb5\xe7\xe39\xe2\xc0\xbeg\xdd\x17\xfb:\xb0B\xd1\x0d\xeb=\x99\xf6\xd3z\xe0\x84\xad]\xf3\xa2PH\x11\xa7\xf5B(\x81\xd6ia\xc2(;\xaf	qc\xb9=\xfa7)\xe8\x08\xdfPFFT\xc5\xad\x97<\xa0K.;\x95\xdf\x88<\x93\xfazw\n\x9a\xd8H \x94_&\xf79\xffN\x1d\xf2L\xf8\x94\xc8\xa4-|\xf4\xe9M\xd2\x8b\x9c\xbaV2V\xfe\xb9\n\x88\xf0\x15\xb6\xc2\xa4#0\x96\x9aw\x9aLH\xbd\xedN\xd2?3a\xea\x9f\xa3\xfd\xc6\xd2\xe7\xa1\x9d\xf1\xe8\xeb\xf8\xeb\xf8\xff\x03\x00PK\x07\x08=)\xd4\x0d\xbb\x06\x00\x00\xe4\x0f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/fr_FR/server.jsonUT\x05\x00\x01*b2h\xc4[\xdd\x8e\x1b\xb7\x92\xbe\x1e?E\xa1\x17\x81\x12@\x99\x99\x9c\x93\x8b\x85\x81\x0d0\x7f\xb6\xe7\xacg\xac\x8c\x14\x1b\x8b \x07\xa0\x9a%\x896\x9bl\xf3G#\xf9 \x0f\x90\xb78\xd8++\xaf\xd1/\xb6(\x92\xdd\xcd\x96d'\xd9=\xc1\x02s\xa1\xe6O\xb1X,\xd6\xcf\xc7\x9a\x7f<9)\x16\xdaT\xb6x\n\xffxrrR<\xbb\xf8\xbex\n?>999)&L)d\x1e\xf8H(\x1a\xc4\x9c\xd0\xaa\x18\x87\xbe;\xb4\x96-\x11\x9a\x7f\x02[,D\xb9B\x03\x9c)\x0b\x92\xc13-\x0c\xc2\x85\xdf\xc0\xf7\x1e-M\xb2\xc5\x93\x93\x93\x9fhf\xf1\xb7\xc9\xcd\xf3\xd9\xcaWs\xc5\x84\xb4\xddZ\xd4\x0c\xaeo\xa7\xb1'E\x85K_2\xf0\x16-\xbc\xc1\xf9$\x1b\x01\xf3-p\\0/\xdd)\xdc(6\x97\x08n%,\x88\x05l\xb5\x87G\xa6\x1c8\x0d\xd6\xd7\xb56\x0e\xb4\xe4h`n\xf4\xa3Ec\xc70e\x0bf\x04h\x03\x17u-\x118\xaeE\x89\x16\x98\x03\xb7B\xc0M\x8d\xca\"\xe8\x05(\xdd/\x0b\xce0ekfP\x95\xdb\xd3~W\xf7\xd3go\xba\xbd\x84\x8f\xb8\x81+t\x0e\xa1\x96L\x95+\x04\xe6\x9d6\xc2\"p\x0f\xa5V\x0e\x95\x87\xf7^\x80\x1a\xa1uP3\x0b\x06K]UL\xf1f\x17\xc5\xe9\x15\xa0Z\x0b\xa3\x95\xc2\n\x95\x03\x8e\xc4\xc3\x9a	\xd9\xaf\xce\xe4#\xdb\xda\x97\xba|\xd7\xf10\xd3\xfe\xad\xf6\xc6\x82EX\x88\x0d\x1a`\x1e\xe6\xac\x15\xeck4\xda\x0b)\x11$\x02ov\x0b!#\xf98\x8aV\x91\x0cj:\xe1\xaa\xf9\xb5B\xb0\x02\xe4H\xab\xa5D\x07\xc4l\xc9\xcaU\xb3\xcbXPZm+a\xb1\xe3\xe0\xa2m1i\xcd+F2\x90\x08JW\xb4\x80\xd3\xde\x82D\x0b\xde	),s\xe8M\xa6(\xccs\xa1_k\xe9\xab\x8c&\xb5\xc1:6\xa6\x9d\x84\x0f\x92\xa8\xd5\nj\xed\x0dT\xde\x8a\xf7\x1e\x01\x1dH,\x89,\xac\x05ov:'\xee\xf4R,:\xc2\xaf\xc5R\xd1QYx~\xfb\x0c\x98\x12U\xb3\xc3VX\x17JT$\xa8\xd8Y	%\x98\xf3F\xd8f\x97\xb1;_\xdey\xd7sJ\x1fp\xc9\xcawK\xa3\xbd\xe2\xf0Zp\xd4\x89\xde\x1d\xc9P\x81\xd5\xdep\xa1\x82\xa4#\x7f\xc0G\xcc\x18\xd1|4\xf85\xe9LN=\x12h\xc9\x7f\x8ar\xf3\xb1B\x0b\x95\xf6\xb2\xd9Y\xba\x9c\x92\x81\xd4\x9e\x04_3\x03r\xc4x%\xd4\x18\xa4X\x1b\x1a\xc1\x85!	\x85\xa3\x0f\xfa\xb6\xd6\xce TZ	\x92[\xc6\x80f\x86\xdflja\xb6\x1d\x13\xe13\x18\x05\xe0#\xaf:=O\xdb\xbc\xd7\xd5\xdc\xd0\xc5\x82\xa4\x89D_i\xbfF\xe67PE\x03b\x81\xad\xe9\xa6J\x16n\xaaAk\x0f\xe9\x0d\xb9\xb8g\x99F\xdcG]\x92\xac\x1b\x1c\x17\x9f\xa0w\xe9\x8e	\x03o\xbd}\xefG\xcd?\xe1\x9bs(Wb\xb10hA{\x90\xe8\x1c\xfd\xac\x84\xf2\xb6\xf4\xa4\x8c_\xe2\x06+2\x08O\xa1\xd6\xf2\xab\xbd\xb5g\xc2\xc9~\xf1\x99 a\xfd\xa1\xe5\xcf\xa1d\x86\x95\x8e\xcex\xb0\xd8DK\xe1Hm\x07K\xf2^\xda\xc9\xe2\xa6\xfd\xbd\x8e\xe7\x94\xda\xba\x19%\xab]\xb9b\xdd\xa4\xab\xf4\x1d']#\x99\x16\xb2C\x9b\xc1\xa5\xa3-\x94\xba\xaae\xb3sh\xc8\xe6$:\xf1>\x95h\x1c\x13\n-\xb8\xe6\xbf\xcb\x15Z\x1aL7b\x84\xca\xe0RXg\xa2\x06\x91D\x19\x94\xa6\xd9\xf5Z\x01\xd6\xbfEw\xc0\xe1\x8c-\xed>\x97\xe0\xa81\xb2J\xfd\xa4\xbf\x91M4\x91\x13: \xab\xa5'\xea\x91\xeb\xc8\xe7)\xfc\xa0hv4M+-\xac\x00&\x89\x0drE\x81\xb70\xbf\\\xb1\xf7\xbe\x9b\x05\xcbf\xa7\x9a\x9div\xa7p\xa5\x95\x134N5\xbb\x92\xa4\xda\xcec\x1e*-\x94\x85\xbf\xd2\x026\xb3\xf9\xe5\x8a\x99i\xa9M\xaf\x0f\xe1\x8b\xd8\xb25\xab\xc2\x85\xeb\x0f;\xedk\xa2\x05\xb7\xc0\x94\x13a\x8c\xd4\xa65\xb6\x95\xe6b!\xcaLr\xfd\xe4\xce\x17\xa4\x03?\x85\x8b\xda4\x1f\xe9\xf2ha\x007e\xb3#\x97A\xb7]T\xc2\xe1\x18\xe4(;a\xe0\xb86\x0c\x0ci\xb6\xc8\x0f8\xdf\x8e\xb7NWW\xd3i\xb7\x9d\xc4\xf23\x8c\x9e\x82\xf6\xe5\xb6\x12\xa1Fc\xb5RL\x92	\x0c!\x00\x89bI\x87\xc4h)k\x83a\x07\xb7\"s\x04\xb6\xd9\x91\x19\x16Z\xa9fwd\xbd\x99^.\xb3Ku5\x9d\x0eWHl\\\x94N\xac\x83\xa4\x16\x9fg\xa8\xdfR\x8a\x0f\xf2=\xcd\x02O\x89d\xfc8\xd8@\xb3[0\xef\xa2\xcb\xcb\x85\xa8F\xa8\x80\x0d\xf7\x03\xcc\x97^\x1d,\xf9\x92\xa9e\xb7#\xfa\xf0\x98\xd3.\xc6y\xc7\xf1\xf5s\x9a\xd63S\xae\xc4\xba\x97\xd2u\xd6\x16\x89=`\xb9\xa2?\n\xd7*r\xdc\xbc\x1fr\xaa\xcd2\xa3',\x85K\x0fz\xae]\x7f	/\xa5~\xef1\xde1\x13\xbb\"\xe1\x9b\xaan~%\xc2}\x0f\xf0\x11nj\xa9;\xeb\xcfJ\xd2@4\xc9\xe5\xb4\xb6x\x7f\xc9\x1f,\x9aK\xf2$\xfd\xb2\x938\xd4\xe6\xfe)-<E/[\x97EF&\x9e\x02yzAAU0\xb1\xa6\xd9Q\xe4IA\x85_\xa3$\x16\xd3\xe2\x99o\xe6Z\xf5\x92\x9b\xa1\xa9\x84\n\x1bm\xafS1\xce{\xb2\x0e \xa2&;\n\xe4\xa8:J7\x1c\x15<0.Z\xc7\xde7\x80\xd2\x8f\xc4\xc8V\xa8%\xcc)\x8e\xce|)\x8a\xe5\xca\xcd\x99\x94\x1d\xa5.N\x86\x7f\xfb\xf7\xd0q\xcc\x89}s~\x0e\xef\xbb\x81\xe8\xe8\x1a;\xed\x98\xa4\x8b\xf0\x97\xf3\xa1k\xc9V\xab\x98\x907\xc6t\x8b]io\x8c@I\x87h\x0cy\xf9\xfc\x82\x91\\\xc8\xc0\xc71\x16\x90lE\xb0Qs\xbd\xf4xzH\xf8\x8eb\xd1\x96\xf8\x057hm+\xd1\xf4\x05\xe5\xfe\x92G\xc8L\x98\xed\xb5\xe2N\x87H\xb7f=\xad\xbc\x89~\x90b\x0c\xa9\x1f#\xaa\x8d\xeb\x88\xd2\x07X4k\x8a\x08[\x96\x12\xa7\xa1\x8f\xfb\xae\x9b\xff>\xa6\xa7~\xde\x91\x9f\x1e\xa7<\xd5\xde~\xcduE\x1e\xf4\x8f\xac\xb0Y\xa1rLt\xe4o6/bCv\xddM~\xdf\xdb\x19\xc3\xcb\x8e\x9b\x9a)~\x91)[\xf3\x8bC\xc5\x0d\xe9\xb9\x850\xb5u\xba\xb1\x87\x02s\x876\xef\xce\xa9\xe5\x12\xbd	\x9f]\x84?kv\xb2\xd9Eg@\xb7\x88\xf2B\x11\xaeg\xb8\xde\xacj>\xba\x81n.\x10\xf9\x9c\x95\xefn\xaa\\\x87Z\x05\xddS\xa2 0\xe5X\x99\xdd\xc7\x85\xcc\xe3\x88k\xc3jd\xbe\x9d\x17\xf3Rb\x84\xc7\x0e\x12\x7f\xcd\xb6A\x9b\x07\xfe1#\xa8M\x89\x9cr\x97N^\xf4\xb1\xad\x98\x03\xea\xeb\xdc\xd1u\xb3\xb3,\xb9$\x92c\x9f!\xdb\xd6}Es\x146\xef\xe72\xb9\xf6l\xf7K&%\x9a\xed\x9d\xe6\xbdu\xa2\x0fX2\x89F\xe0\xde>\xbc\xa2\xf00\x04%r\x8f&\xb05\x96\xc0\x8f\x1c\xd8R\xea9\x93\xd7G-\xfe\xc4\xe0\x9a\xa8\x95\x86=J4\x16\xe2`\xb9-\xc6\x83~J[+\xe6\x90\xc3#\xce\x83\xedmg\x8c\xc1\xfar\x05\x8c\xd2Lr5\x80jIj.\x14\xc7MH\xb3\x17FW\xc0J\n\xa9\xc8\x14\x86\x0c=Q\xc9\x94}\xa9u\x1e\x02<\x8f\x9f\x9fR\xf4\xd4\xdd\xcd^	\x9e\x9be\xca3\x9b\x1d<\x85\xf3\x8eB\x85\x8e<\xd3\x87fg4\xf9')\xac##b[\x0f`SB\x9bIn%8^\n\xa5\x90w\x87\xf3Bp\x84k\x94H\xb2\x98h\xdb\xb9\xc8\xd0\xc1\xa4\x84\x9a\x1a\xc1\xad\x98\x83\x15[#\xcc\x11\x15\xf08cHz\x80\x18\x10\xcb\xc1\xf2\x025\x83\xf5\x06\xce\x98\x94g{\x99s\xef\xdb\xd2\x81\xf70B\x8d\xbe\x07\x0f\xc8\xd5fD\x06\xeb>`\xe9\x8d\x15k\x94\xdb\xfd\xe5M\xb3K}\xa4di\xedka\xad\xa8\xbc\xc4\xdc,\x98fWkeI\xbbG}Tz\x00\n\xd0z\x01\xeb\xb1\x87;\xdd3=\x0f\x14\x9a\xb227Jd2\xc8\xc7\xcd\xb5wZ\xc1\x8f\xe9>\x9b\x9f\xb2\x0di#>\x90M\x90\xf7\xfaq\x12\xddm\xb7\xd4\x8b\xae\xf3\x887nO\xeeZXr\xd3\xc7F\x80P\x92\x94\xd90G,\xbb\x15S`\x1d+\xdf\x0d\x8e\xb2[\x84\xf4!_~Jy\x8f\x85n\x80\xdf\xa4%{\xbbdcr\x14\x9c,\x85\xc8\xde`?>\xd7p\xd1\xeb\xe0-G\xe5\xc4B\xb0\xee\x84\xb2a$\xd1\x17z\x8d\xbd\xb3\xbf\xa5&`>\xf8M\xfaI\x9e\x93\x05\xecA\xd8=\x86B\xcam\x9a\xddZXO\xc1}\n\xee\xc8V\x8a\xcf\x92\x192\xf0\xa0\xb5{\xb5FcDf\xd7n\xe2\xf1&\x08k\xef\xf0'\x94\x9b\xad\x9a\xdd\x1cC\x10\x9c\xa9\x00\xc7\xda\x0bKZ\x90\xf4#\x12\x10\xd6\x11h\xf0\xe5\x9a\xee\xdd\x7f\xc0\x9aI\xf4\x83\xe09K\xa2\x03O\xc3<-\n%$`6t|&7\xf3\xe0\x82W[\xa3\xb1i\xf5\x80u\x88\xeaO\xca\xc3D5p\xb1\xb7\xd5\xc0\xc5^E\xe7\xeaU\xe6\\\x8f\xfb\xd6\xa8\xbd\xcfD\xee\xad\x1d*\x9ba\xb8L\x05\xac	\xf8\xa8\xd9\x1d\xc4\x04d0\xe5H(\xd7\xec\x8cH\x81w\xb2\x96\xb4 Y\x88^\xc6\xefy\x1f\x07\xdd~\x7f}\x99$zh\xbbi\xe40@y\xcb\x94p\xdad&B\xabR\x90&\xb4\xfa\x19\xc2`\x8b>A\x94\x01'\x12UR\x94\xd6\x80\xf7\xccH\xa1\xde\xf5\xd4^\n\xa4hy\xe3\xd0\xa8\x8e\xe2\xc5[\xed\x1d\x9aqFK{hv\\\xb8DV\x86i\x84Rnk\x84\xef\xbe\xfb\xee\xec\xdbr\xc5TfL+fK\xdd\x0b\xf7.|\xba\x83d4]\xb1.y\xad>1n\x8fnP\xd1\x8ex\xa2\x12\x1a\x07\xa8\x08*XzB\x94	\xd6hI\xe7\xb4\xc8\xa6td\xae\x12\xe6\\\xd3}c\xdcK\xd7\xc6\x18\xb7!|\xc1\x0386\xd0\x8d\x98 \xb9\xec\xe0n\x02L\x92\xbb\x9e\x9eZ\xba3D\x87\xee\xa3\xc1**\xd8ZX1\xe4k\xf3\"dA\x1dk/\x98\xa7\x05\xd3\xb5)\xc6\x83\xc6\x8amD\xc5(\x8e\xeb\xb5\xd3\xf5\xb1f\x80j\xb3=o\xa6\xe2C\xbf\xe9\x19\x0b\xe8\xc5\x80pjC\x05w\x97\x7f\x98\xfa\x1b\xc1\xdd\xaa#\xff\x92\xee\xe3>\xe3m\xe3\x1f#\x8dK?{\xdd\x11\xbe\x8b\x9fQ\x12\x7f\xd3>\xee>\x02\xc56C\xb7B*h\xebfW\x8a\x05\x05\x88-\xec\x9bB\x85\x0e:j\xa1p\xebk4\xb5\xb6\xb9\xa3\x8eKOW\xda\xb8\xd2\xbb\xff\x1f\x164G\xc3\x06\x86\xe0N\xf3fg\xd2\xbb@n\n\x8e\x1a\x80q\xf0\xdbd\x85\xa3\x83\x10j\xd9\xc2\x19\xb9F\xf7+\xaa=\x1c9-q\xaf+b\xff\xad\x16!Ib\x9d\xd5\xcbf\xe2#e\xab\x8f\xda\xf4~\xf9\x9e\xa0\x02\x1a\x9c\xa5\xa9\x89\xe2p\xa2\xb6\xbd\x80\xaftU\xa1*#\xe6\xda.3\x1et\x91\xa9O\xa0\xf9\x11F\xb4\x13e\xbf	\xba\xc5\xbd\xa4\x9cp\xed\x8c\xe1\x13^z\xb1kvt\x01V\xcc\xbb\x14\x14\xd4\x07\xb4[<\xb2[\xe1>klWz\xc0\x12\x03\x0cI\xca\xa1\xf2\x01\xc1\x1cD\x0b\xe2-4\xbfRVY\nG\x0b;\x98\x0bTPKO\xd1Op\xc2\x9d\x94\xb4\xe4\x07\xe2\xbd \x9f\xa0>/]\x9d\x82\x8e\xab#\x10\xf3+\xef\x0c\x86C\x89\xf6\x9d\xb0\xdc\x0c@N[\x990a\x12t\x96\x85\xda~\x13\x87\xe7\x86\x97\"m\xec\xb0\xe8\x1c\x8a\xce\xf8yT\x98\xa9\xf3\xc4\xe8\xda\x88f\xe7\x08[\xee\x8eI[\xdb|\xe4mt\x8f\x844\xd7\xda\x93<3U\xad\xf7\xc5q\x04\x1f\xc9Fk\xeb\xae\x0c\x863\x18\x06>\xa4|P\xa6\xae\xdf\x0c\x808\x1e\x81\xf3\xf7t\xf1\xcf@\xa2\x89\xff\xdb\x10\xbd\xdc\x04\xf0\xe2\x18r\xd1\xdd\x85\x1c\xb9\x18\xf4$Mc\x84\xe9\x08\x95\x9e1\x93\xa2\xf7\xe9x\xb6\xaa\xf1\n\xf7\xc0\xc9)Y\x9a\xd3\x90\x00\x0ft\"\xad:M\x86\xa8\xcd\x90\x87\xf9y\xab?\x9fx\xf7\xeaC\xdb\xf4\xbe\x02j\xf8`\xb6\xc7\xdale\x90}\x86\xb7\x98J\xfc\x1e\xceR\xd2\xf1/\xe0k\xeb{v\xa4\xa7WMJ=\xdbXu\xa2m\xb8\xaa$\xf5\x0e\xb8\xecg\x1b\x02J\xbb\xf9\x0fg\xec\xacGR\xd3\xd7\x91\xb4,\x9f\xaf\xb8\xae\xe85\xf0\x05\xbd/\xf6\x94B;\x90y\x87U\xe8Iv*\xb4\xcb-hU\"\xe0\x1a\xcd\x16\xbe\xf9\x0b0k\xc5\x92p\xfd0\xc1i\xa0W/Kq\xa0\xa0V\x17\xc4\x9e\xad:\x9f\xe1\xa6\xb7\xe0\x0f\xc8\xcf.\xa5G\x08\xad\xc3|\x8ar\x07\n<\xc9\xcc\x1a\xed\x03\x9cL\xbf\xe7\x12	\xc3\x86y\x8c\xfdb.?\xfa\xbb\x19Q\x10:\xfa\xfb|\x94\xad\x86\x8c\xbfRY\xaa\xfe2\xa5\x89!\x1c>\x02E\x0d\xeekB\xc5\xf3\xe3\xcdI\xd3\xcb]\x96*N\xb3\xe7\xbc\xf6\x08\xf3\xe1\x92Q\x0c;\x13\x99\xd7\x9caU[hvexp>H\xe5\x19p\xe6\xd2\xcb3\x82\x1b\x0cn\xf5\x7fx\x1d\xf3\x17Q!a\x0b\x0c\xbe\x81\x15z\x93?\x8b\x1a\xac\x91\xf5Gp\xa3\xb2\x1cn0Jn\x1f\x06A\xe6\x8f\x0f\x01\xb7\xe0\x06\x7f\"c\xce\x8d\xeeC\xb6\xebf\xd7\x02\x10-\xe2\x10\x02[\xd3N\xe9g\x90>\xcbQ\xb3+M\xfeDo\xb4v?<\xbc\xec\xd8\xa2\xdfI \xacl\xb1\xdfA\x14l\xe8\xc1\xb9\x1b\xff\xd0|\\\xca\xce\xb4\xbc\xec\x10*\x13\xdb[\xd3\xb5\xff\xb2b\xf3<a\xda[\xc4	\x1aB\xbdH\x070\x95\x96T\x14\xfe\x87P#X\x80\x14\xac\x87l\xb6\x05t\xfa\xedX&{\x11O\x99<F\xd9 \xa5\x0d\x14\xbe\x10\xa4\x10\xd0\xa3\xe0\xdd\xbf\x94\x94)p\x04\xae\x85\x83\x05\xf9\xbb\xfe\x1d\xf5\xdb\xc1;Ev\xac\x96\xf9\x12\x15\xebM\xc2\x94\x1a\xee/^}2\x99lg\x9c\x96\xba\xca\xe8\x10\xd6\xa8\xd5o\x15+\xa4a\x9f(V\xe8\xaa\x128i\xb7R\xb8!7\x9f\xd9\xf9P\xa2\x90G\x96\x19\x03+V\x89\xa1u\x9b\xf6M\xe3\xbd\x86\xcf\xda8[k!\xf3 b\x1a\x1b\x0e\xc0\xb9\x0c3	\xe6D\x8et\x1d6\x9b(d\xdc9\xb6X\x0c\xeb\x18\x9e\x1b\xc6\xdb\xd3m\x8dWL\xfa\x96\xd4\x93\n\xbbF\xa8\xbev\x14\xc4\x91&'w\xd1\x93%K7\xb0Td\x10I\xca^\x1eC\xcc\x0fQ\x95\xee\xb9 \xb3Qn\x85\x15\x1e\x7f\xbb\xbdZ1\xb5\xc4\xec\x99\xf9\xe8\xd3i\xb4\xde\xe1\xec\xb7wl\xd3\xed\xf8\xb5\xc0,9\x1b\xb5W\xe2_T\xb9\xb2_\xf30\xe0B\xf4\xd1t\xe0B\xa8\x94\xdb\xfe\xe9\\\x0c\xce<\xd4\xae\x14\xe3O\xd6\xb1\xf4g\x10\x9e\xe7[\xbdk\xa7w\xb0\xb0i\xd1\xc1=\xa4cO\xff2\x9d9xTu\x86\x90A\xdd\x1fth8\xa5\x96\x83\x9bO sM\xa0\x06A\xcf\xd1\x04\xd3\xf3s\xa8\xd0\xea\xa7u\xa4==8?\xef\x08?\xd3\x8a\x0f\x01\x9a=\xae\x11\x16\x87C\xf6\xa8\xfdo\xa0\x19\xa2\xda\xd3yd\xae\\\xa5x\xee\x95z\xc0:\xbb6o\xa8/E\x1d\x16\xb4\x02\x13\xba\x13\x9b\x11\xb7:\x12o\xc2B\xc8\x90\xa9,\x84$XY\xac\x85\x05\x16CsTk->i\xe8\x1fq^\x0d1\xe378\xbf\xfb\xbfA\xc6\x1e>G\x03\xbeLE6\xc2e\x8a\xc2}*\x1d\xc9\\\xc2\xa36\xef\x0e\x9f\xc7\xda\xfa\xc7\xfcq${\x9cH\xd0\xf2\x01\x91\xbd:\x93\xdfO\x8a\x00\x16>0^\x9fS\x92-\x95X\xf5\x96\xe6\xbf\xe2\xe78\xfb\x88\x1c\xa6'\xb30\xf1\xc9\xc9\xcf\xe3''E\xbc2]-\xae\x8ae\x0bE(1\x89\xf7\xab\xb0\xa5\xc1\xf0\xd6Ut5zD\xbaxL\xa0T!#\xf4T$\x92V\x1bG;m=HV\x95\x17\x03\x82\xae\xce\xb1xH\xaaa\xc3C\x10*\xd7v\\S\x10\xc7\xb1\xcf\x04\x8b\xf1\x1e\xa5v\xe6~Gf\xd1\xe9,\n\xc7\xba\xa7\xa0\xe2y,\xbeb\xf1\x1c\x8b)\x95\x16\xc5\x9f\xf1.M\xc3\x8bbly\xe6\xd3\x9a\x0f\xac\xa4G\xf3R\xf4$\xc3\xc3\x80\xc3Njs\xa6&\xf9\x05}M\xd8\x03[\xe3\x07hv\x14\x9c\x04\x94\x88\x98;\xfb\xc2\x9e\x05\xaf\xf1\x85M\xd5e\x0c\x0c\x13TFj\xbd \xff\x8f\xf0\xb4U2$h\xd72\x15\xea~\xa06\x94m2X\x08\x02\xb5\xe0\x0b;\x862\xd4\xf0R\x8c(\xdaz\xad/\xeci\x9a>E\xa9)\x8f\xa2\x9a\xbdTa0N\x1e\xb6-b\xb8\x9d\x84\xe2\xb5/R\x85Y:>/\xdam\xc5\xc2\xec\x04\xd4f\xa7\x10\x04\xa2(T+\xaetU\xa7x\xb6`\x9c\xd2\xe6\xd6`\xa4\xb6\x90\xe9L\xc9\xffS_\xf3\xcb{/\xea4~\xce\x82R]\x92p\xd2p\x12\x14\x9ai\x8d%\x9dYqQ\x96X;\xcck)\xcf\xbb\x03\x06\xaa\xdf\x1e\xc3\xe4\xfe\xf98\x14\xc9\xead\x01B\x8eI\x12\xfd\x92\x8b*>>\xc0S\xf8\xeb\xf9\xf9\xe6\x9b\xf3\xf31\xb8\x88\xc3\x86\x16\xf8\xcf\xcb\xafN\xd3\xcad\x0e\x8b	K\x8c\xa4Z5j\xcb\xeb\x19\xa9\x00O-1\x83\x8a\x0ea\x11\x1ac\xaeZ\x01]T\xf4\x8bj\xba\xa9\x99\x95\x8e8\x17*\x14|\x13\xe8\x00s\xcd\xb7iMI\xd2*\xc3\x9a\xe9gjGF^\xb0\xa0z\xdc\x96?\xad\x16b\xe9MD\x0f\xa8\xf3\xaam1\x90\xd7\xb1\x0eG\x87z\x11\xb37\\v\n\x92F\x13X\x93\x11\x8e\xf5N\xfb\xc5\xb6\x05g.\xc8\xe7:\x14\xa5\xb5\x177><w\x93[\xb8\xe2\x08Sqd\xeb\xd9\xb2\x91\xb9sO\xa3\x12\x82\x99\x0f\x1a\xc21\x05\xf7\xb10,p\xe4\x89\xe5\"\xd5\xb4\xd4\x01\xfaz\nT9\"R\xd2\xd6U\x81P\xfb\xa0\xf6\xa3X\xf8\xf2\xdd\xab\xa8\xadW\x17\xd3\xe9\x0d\xcc^\xdd\x16Y]\x03u<\x8f\xbf\xfaw\xd2\xe2\xf6\xba\xfd\xa2\xa7R\x17\x94)>\x9b\xbaT\xbeQ\x90\xda-\xe3\xfc\xf6QDH\xd9\xec\x96\xad9\x92\x9a\xf1V\xd9hP\xaa\x0f\x94\x1d\xce\xd7\x0f\x13jypI(\x1c\xef\xee\xc6o_\x88o\xcf\xcf7\xdf~\xe6BH\xbd\xd4\x01\x97/\xae\xb3t\xa4e\x81\xfab\x81\xcf\xa0;\x95s$!\xe7\xc0,\x8d\xcc\x91\xdc\xc3\x11\xb3\x19\x8dyF\xc50\xa1\xae\xc1nU\x89\x9c\xfe\x11\xc3X\xc2H(:\x01\xab+\x84\xb9\x97\xd2\xaeD\x0c\x9d3\x0fVL\xb2\x82\x9f\x16\x0e\xbd\xd7\x1d\xc0\x16\xed2\xe9!!\xa0;\x8e\x1fb)\xe5\x9eb\xd7I\xd9\xf2\xea\xe7\xa2\xf6f\xd9\xa9a\xf3\x0b\x01\x8dT\xd0\x97t\xf0,\xd3X\x83\xb1\xc2\xfdvBD\x1e\xd2\x17\xfd\xef\xc8\xedd8\"\x86c\xfb\xa3\x12\xfa\x93\xf2JrH3\xad\xa5\x135\x0d|&\xa43\x98Cj\xe4M\x9cp\x06\xc7-3t\xf0\xe9\xff\x1d\xd2\xber\x88\xe3\xac\xd6\xf2\xec\xab\x96\xbc\xbbL\xa5	\xad!\xa6\xb7\xb3\xae\xf3eT5\xea\xbcM\xf5\x9d\xa9l\xb4\xab\xd6\xa0\xcc\x93\xebG\x1a2\x8d\xbf\xb2\xd6K\xa1\xfa\x0e\x98\x8bt\xeam\x80@\x93fF$x<D\x91uk\x80S\x0c\xdf\x99\x87O\x04\xff\x85\xf5\xf3\xb7X\x06=\xed\xd3\x8b\x82t\x87\x9a\xa6\x8e\xb9\xf8\xd6\xb0\xa7\xc14\xa0\xb3\xd1m|2\xba\x9d\xd8T\xf2D\xe5\x88Ja\x19b\x15\x88Q\x98\x85\xb3\x0e/\x0dCC%d\xd1\xa5\xa2\xb4`\xc8@SSD\xadB(\x93Z\xb6u\xdc\xf3\xb6u\x81^%'x\xdd\xec\xe6\x99\x1f\x0c\xd1z4\xd4QI\xe0Mjyr\xf2\xf3\x93\x9f\x9f\xfc\xcf\x00PK\x07\x08V_2\xc0\x91\x12\x00\x00D5\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x001\x8dS]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/nl_NL/common.jsonUT\x05\x00\x01NV\xd6j\x8cWO\x8f\xdb\xb8\x0e?'\x9f\x8200\xe8.Pt\xef\xbd%\x13O\x9bi&	\x92t\x8a\x9d\xb7\xef\xa0\xd8\x8c\xad\x89,\xfaI\xf2d\x83E\xbf\xfb\x03\xf5\xc7v\xa6\xef\xf0N\xa1~\xfa\x91\xa2)\x92b\xfe\x99N\xb2\x13\x99F\xb8\xec3\xfc3\x9dL\xb2\xa3\xd0\x1a\xcb\xec3d\xcf\xf9n>[\xaf\xf35,6\x9b\x1d|\xb8\xb3\x1f\xe0\x99\xa5;\x1b~\xff\xca\xee\xec_Y\xf6\x91\xd5JT\xe8z\xbd\x1f\xcb\xc7E\xbe[x\x9d\x9b\xfd\x1d\nK\x9a\xad/\xf2U~\xc8\x170\xff\xd3\xb3\xe0!\x1e\x11\xe8\xb2\x11\x15.\x06\x9b\xb3\x87y\x9e\xaf\x16\xcb\xf5\x17\x18\x99_l~U\xda\xb7$\x15\x9a\xe0\xca\xf2i\xf6%\x87\xfdv\xb3\\\xe5\xbb\xfc\x17\x05\x8d\x97-Yg\x97\xfaP\x1b\x14^\xe5\xae\x04-\xf1\x82pD#\x8b\xda\xa1\x06\xa9\xc1Q+\x8bO\xc1\xb7\x96Uf\xba\\\xb2\x8fv\xd3H\x17?\xfc\xae\x04\xde\xfb\xcd\xfe\x0eB\x97pW\x82\xff\x0c^Sd\x0d\x06\xfe\xb7\xde-\xaf3\x15\x96\xec![\x9f\xe7\xbb\xe5\xfd\xd7\x03|_\x1e\xbe\xe4?\x96\xfb\xc3\xf05\xbf^\x87\xc1R\x1a,\xdcr\xcb\xaa\xdb\xcd\xfe\x90\xef`\x97/\x96\xbb\xfc\x9e\xa3~\xd8Dz\x8a\xff\xad\xda\x10\x8e\xc3\xd7]>[\xfc\x1f\xaa\xb6\x16%]\xe62e\xcf\xfe\xebl\xb1\xf9\x01\xf3\xe5z\xfd\xee\x96\xef,<\xbc\xcb\x1e\xe7\xcf[Qq>PU\xa9\x10\xce\xc3f\xbb\xbcgv\xff\x9d\x81\xdc\xe9!G\xbf\xaf\xe7\xb3\xf1\x01\x81\xf1\xc6\x17X\xeeE\x83\xcb\xed(t\xf9\x1a\x9egkX\xe4/\xf9\xeaa\x91\xc3r\x0b/\xcb\xc75\xcc\xf3o\xf9\xb7q\x9ag\xd3\xc9\xcf\x8f\xd3I\xd6\xaa\xce\x08eSi\x94\xe2\x9a}\x86\x7fM'\x9c\xcf\xa2\xf2gy	u6\x9dL\xfe\xcd\xeb\xac\xa6\xce\xf4\xac\xae3\x91\xd5\x991\xa9\x91\xbas\xd8\xd3x\xd9\xb9\xc8\xe4\x85\xbb!\x93v\xf5\xc0\x15B\x97\x89\xca\xf2\x98j\xb1 ]\xf6\xdc\xb0\xc4\xec\xe3x5\xa2_Q\x0c\xbe\xbe\n\x91\x9c}\x15\xc9\xdb\x14\x08\xce\xd8\x14\x06Q6\xd2\x97\xf0\xcc\x0b\xde\x92\xa8\x88\x91\n\x15\xb2C\x01\xd3\xa1\xd2g\x9a\xb4\xc4&\x80\x05igD\xe13\xfa>\xc97;\xa1\xac\xc6\xfb\x10!O\xc3\xbf[\x0e\xc0g\xc8\xbeKw4(\xfb\xf3\xc2\xce\xa0>;\x1d\x11U)u\x85\x1a\xbaw\xe4Z\x96\x1c\xffl_\xd4\x9dT\xb1\xe9xw\xd3'\xbd\n-\x1d\x19\xef\xc9\x13\xca\x92\"\xdcY\xb7\xa6\x0b\xa3\xba\x0b\x90\xa2\xe2\x1cR\xb6B\xab\xc8%\x87\x1aa\xcf\xcc{\x12\xf6\x0c'\xa9P\x8b\x06\x87\xad\xc3\x817w\xd8*Q\xa0\xdf\xff`\x81)p\x91\xae\x06\xe9,\xd4\xc2\xd6\xf0&T\x97\xd4\xa8D#z\xaf\xd2*l\xd2Ecp7\x97\x15\xeat\x9d\x99E\x9c)\xc5g\xcd\xf1,_\xcf \x94J\xd1\xb4u\xf8\x94\x03\xe9\xe4\xb5\x0d\x0d\x94\xf9\xb1\x97\x06\xaa\xf3\x95\xb9w\xb28s\x19d\xa1R\xc1\x06 V\xe6\x10\n\xd2\xae2\xa8KT\xb1\xeb]\xa9c\xfc\xb7?\xa9\xfb=\x15\x98\xbd\xea\"&`\xf6\x86\xe6(5_\x17\xb0h\xe8\x9c<\x8a;\xbd\x83W]\xd4\x86\xb4\xb4h\x12V\xe1\x08E\x13\x8f,=\x8ae6\xf5\xc5\x999\xd9`\xca\xe2B(\xd4\xe5(\xff\x1f\x85\x8e\xe9\xff\x80\xc7(=\xc5\x18N\xb2Y\x9b\xa4'q\x8d\xd2c\x974\x1e;\x15\xa5Y\x97\xfa\xc2\x1e\xdb(mb\x8aO\xb25\xbdEi\x81\xc5P\x87\x17\xc4s\xef\xc7\x0b%\xabO\xbdG\x0b\x19\x85\x1f\x94\xaayAQx6\xf25\x8a/\xc2y\xa31\xba\x9d\xecK\xb6m\xd55&\xc1\x8cep\xc4i\xe0\xdf\xab\x98\nG\x12\xc6'\xf1\xdc\x0b\x11s\x8e\x9a\x00\x96}	\x0b]\xa0\xcf\xa7\x99\xd6\x9d\x1a.\xa1\x10N(\xaax\xe7>\x8aAAIn\xec\xf7\xbd\xde=\x03@\x0d8\x04qk#\xcc	\xf3\xe4\xcb3\x9a\x8b|-\xd1\xc0q\xf0\xaa$\xcd\xd7\x98}S}\x8ecC\xaf\xfc\xb5Y\xee\x05o\x8a+\xea@\xb4\x12\xa6\xf2\xf49Z\xc7\xef\xb2\xb4|ne\x88\\\"jik,\xdf\xd9\xac\x88*\x85{\xd2\xd5\x8d\xcfJb\xf9\x8a\xde\x84'\xc4\x0c\x94MK\xc6\xdd\x931]\xeb\xbb\xdb\xd2\x03\x1c\x1dh\xa4U\xdd\xd9}\x82\xe4B\x11hq\xa0\x08\xaa\x8b\xf8U#=\xdb\x15\x05\xda7R\x9f`\x81\xd0\x8aJj\x01\x172\xa5\x03\xdd\x01\xb5Zbw\x81\n\x95(Q'c\xedWa\xf9\xb9\xc8\x96[\xdf?b\x8f\x12\xd6{\xb5\x12\xc2Y\x17\xdb\x89\xa2B8\x19\xba\xf4*\xc9}K\x1b\x86\x80}Q\x8b3\xaa0\x05qu\xc6\xca\xe6ZM\xed2T\xfe\x81\xe6}\xca|\x89\xcd\x10\xb8\x15\xc1\x11\xf5\xf044\xc2\x9c\xf7\x88\xfe\xe0'a\xce ,X\xecw\xb9\xdb>K+I\xa7\xe1\xc77`x\xf3\xd8\x88\x14\x80\xd0H\x9f%^\x86\x9c\x86\x93\xe1\xfc\xaa\x11,w\xd4\xe5\x96\x8f\xf0KTX8\x0c#W2e*\x1c\xbe\xf6\x89\x97 \xb5#\x08sId\xd1\xdb\x98Dox\xbb\xddY\xf7$\\\xe1C\xffC\x14\xb5\xbb\x10\x99\x1254\x84<E\xd2\x1b\x1aD}\xa6&}\xa7\xc6\xcb`p\xcdw\x89!\xc4qLD\xdf\x0dw\xc8\xe3\x0cS\xb6\x01\x00\x13\x908KJ\xed\x0e\x94\xea\x8dY\x8cp}'l\x989\x87\xb7\x91\xe3j\xff\x08\xeb?\x0e\x87U$\xf1\xc09\x8c\xe8A\x82\x13\x19\xf0;\x81\xf4\x9f\x8e\xe2\xbc\xfb\xc8C\xb2vPa!\xdd\xd0u\xcd\xc8@\x7f\xe3i \x9d_\x87O\xdeE\xcc\xdf\xda\xf1z\x13\xce\xc4gG\xd1Dc\x1e\xf1\x17\x87\xe6\x96\x96\xde\xd2@\xb1\xe0\xb8\xe8}\x14\xfc\x9d\xb7X\xc8\x93\xc4\x12:\x13\xdfz\x83'\x83\xa1NvQ\x8cx\xab\xae\xe9]\xbe\xf6\x18\x19\x17\xc1T\x9ci\xcbu\xc6\xa7\xf1\x01MW\x9d\x87fh:~d\x87Gw\x87\x15\xaa\xf4\xea\xa20!Q^\x08\xfbg.Lk\xfe\x01\xdfG1\xb2-\xa7}\xfew+\xe3\xdf\x9a\x15UR\x83\xc7\x91\xcbQQ;\x18q{E\x17\x1e\x0d\x98\xb9G\x07V\xd1\x05xr\x88\x8c\x9a.kr\xb2\xf0\x84M\xdb\xa0\x19\\h\x95\x1c\x0d\xff{^\x02\x9dN\xa1\x1a4^n\xee\xc8:\xe1\xba\xe0o\x90\x82\xfd\xee\xf8\x8aa\xc0\xdbG1\xe1\x8d\xf4\xf0Vq\x13\xea\xcf\xf4\xf8\x03\x99!\xd1\xf7\x1e\xf2\x897\xce\xf5x6\x8f+#/\\\xdd5G-$w\xa3\xb07\xac?}\x8a]1\x0c/\xf7W~\x8aF\xc3K\x11\x80Hi\xc3N\x9b\x86\x99\xf4.\xa4&\xf4\x88P\xe3\xd1\x01r5kq\xaa\xb0\x11\xe2\xecp\xd4J:}I=\xe0{\x14\xa31\xbf\x18B\x1bw\xc7\xf5\xde\xb5\x8aD\xf9 \x95\xbf\x98\xef~\x05\xc7\xf0bD+\x1e\xdb\x1a\xaa\x0cZ\x1f\xf8\x80`\xd9\x7f\xe8\xbbcb\xf2\x85S\xa6\x93\x9f\xd3\x9f\xd3\xff\x0e\x00PK\x07\x08\xfeL{\xac\xb5\x06\x00\x00\xf3\x0f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/nl_NL/server.jsonUT\x05\x00\x01*b2h\xbc{\xcdr\x1c\xb9\x91\xffYz\x8a\x8c\xfe\x87\xff\xbaP\xa4\xc63\x07\x87\x0e\x8e %QC\x8fH\xd1l\x8a\x8a\xb5\xc3\x8e@WeW\x81\x8d\x02j\x01T7I\x87\x1f\xc8\xa7}\x81\xbd\xcd\x8bm\xfc\xf0Q\x85j\xf6h\x1c\xbb\xeb\xbdH]\xf8H$\x12\x89\xfc\xf8!\xf9\xb7\x97/\x16kc;\xb7xK\x7f{\xf9\xe2\xc5\xe2\xfc\xf4\x8f\x8b\xb7\xf4\xe7\x97/^\xbcX\\ht	/\x99z\xa1Y\x91\xe7\x07\xbf8J}[\xc3\x96\xb6\xc6X\xaa\x99\x94\xbcw\x9e:\xf6\xb4eV\x0d;\xcf\xaaf\xdaZ\xd1\xb0&\xd6\x18#'r\x9d\xa9\x85Z\xbc|\xf1\xe2/\xa0\xb6\xf8\xc3\xf5\x87\x8f\xb7\xed\xd0\xad\xb4\x90\xca\x8d\xeb\xa3\x99\xfc\xd4\x8e\xb1/\x16\x1d7C%hp\xec\xe8+\xaf\xae\x8b\x11\xb4z\xa4\x9a\xd7bP\xfe\x98>h\xb1RL\xbe\x95\x8e\xe4\x9a\x1e\xcd@;\xa1=yCn\xe8{c=\x19U\xb3\xa5\x955;\xc7\xd6\x1d\xd1R\xac\x85\x95d,\x9d\xf6\xbdb\xaay++v$<\xf9\x96\x89\x1fz\xd6\x8e\xc9\xacI\x9biY\xf2Vh\xd7\x0b\xcb\xbaz<\x9evu\xb5<\xff:\xee\xe5J\x06\xd9H%\x9b(\xb4\x1d\xdbM\xda\xd1\x99\xb159/\x84\xa7Nx\xb6R\x08E\xde0\xd5\xc2\x93.&JG\xa6#\xcf\xb43\xb6fM+\xde\xf0\x865IM\xcc:\x904]\xc3[\xa9\x9b\x89\x0d\xa1v\xe2\xd1}2\xd5fd\xe644\x11\xda \x8e3\xe3\xbd\xe9\x123wl\x1b\xcb\xbafE\xae\xb2F)\xd6\xa4\x85\xb0\xd4\x8bFjAF\xd7l\x85\xd0G\xf4\xc4j\xedH(G-{\xf2b\xb5R\xa2\xa6-\xdb\x95\xb18u\xe9\n&\xb4\xd1\x8f\x9dt<\xf1\xa0\x8d\x96h\xb1\xac\xd3\xd2\xb7\xc6h\x12J1\xf5\xc6\xf9H\x1a\x13%w\x05\xa5\xa1\x96\xe6\xce\xa8\xa1+h\xa1\x8d\xb6\xb11m#|\xd0Vh\n3 \xa3nx\x92\xbcy\x0du\xdc\xca\x9a\x8d\xebY\xb1u\xc5\x91\x89\xc1\x9bF\xae'\xc2Z\xe2Dj\xfaxqN\x85\x86b\xf0\xd8\x1b:'%\x9c\xa8\xad\x9a\xcb\xc1O\\\xe2\x83N\xab\xd6C\xc2F\xd7t\x07&\x12\xad\xd0Y3\x89\xa2?0Y\x92\x8b\x132\xbd3Qm\x1ak\x86=J\xa7u'\xf5k\xd7s%\xd7\x92k\xea\xb8cG\xbd\xec\x19Jf\x85lZOR{\x83+a\xa93Zzc\x8be\x8c\xb0\xf5\x87\x87^\xda\xc7i)\xb4\xe1h\xb7B\xd5\xc2\x0fYYN\x85\xf6BQ\x1dn\xf9SP\x0d(\xec\xb0cZ\xb1\x95\xd8\x8b\x0e\xea\x0eM\x86\x8a\xae\xa0\xea\xd2\x81\xd4N\xde\xd7l\xeb\xbd\x85\xafDq\xaeqY-D^\xefJ\x88.\x9c)4.0zL?	M\xdex\xfa\xee\x0d}\x12^\xdek\xc7\xa4\xd8{\xb6\x0e7\xb5\x92\xf7k\xfc\x94\x9a6\x8a\xa5\x9e:W\xbc\x15\xde\xb3.\xce?\x90\xbc\x95^\xed\xf3\xe0\xa5g\x95\x98\xb8\xc5\xef\x91\x8b\xbc]\xcc<\"\xcd>\xa8\xed+\xe8\xc6@\xff\x9f.\x85n\xcc\xabc\xba\x0d\x1c\xbe!\x8f\x0b\xebH	\xdd\xcc\xd6\xadKa\xd7\x8fy)~\xf0\xb42\xf5cX\xae\x8e\x17c\x9aV\x89\xdeW\xad\x18g\xbeK\xdf\xe9\nX!\x1ajxe\x07\xb9\xc1\x86q\x00i\n\x8c\xc8\xd6(o\x8cL'D+\xee\x85\x80\xd1\xf6b\xc3\xb8\xde\x06\x1b\xb1\xdcH\xe7mp\x02\x1cw\xdc\xa1?0\xe4[\xcb\xa2.4>Q\xbf\x15\x8d\xdbg\x8a<\x1a\xd3\xb6D\xe3\xa8\x96\xa3\x1dK<\xfa\xa0*\x99\xc5\xd7\xa6W\xc69\xa9\x1b\xd6\xc7\xf4\xe1\xe7\x7fh\x90\x08S<\xed\xa4R\xbc\xe1\xc1J\xecpc\x9e\xf2.Xm\x98\x1anX\xb3e\xb65gr\xc7t\xc7\x96\xa5\xf30\xa1\x9d\xc4\xd5\x15\x8a\xbe\x87&\xba\xaa\x059\x1d\xf6\xde8\xc8\xe6\x80nT\xad\xb0\xcb\xca\xd8I5~\x12Vl<[r\xbd\xe8\xc8\x85\xbel\x19\xbc\x0c\x8dYKv\xf2\xfeI6Il8\x07_\x1a\xeetW\x8e\xe9J\x90\x89\x0cYy_K\xdd\xe4sW\xb2\x83\x1f\xe8\x0c{\xa8\xc1x\xa8\xb33\x8d\x02\x9b)t58o\xbaw\xcb\xe5\xc8t\xe2\xf0}r\xdc\x13\xa5\x86k^K-\xa3\xd4\xde-\x97\xaf-7\xac\xe2A\xad\xcc6\x9d~\xc3\x8e\x15W>\x0e\xf3-w\x82\x9e\xe4\xbd\xa6\x86\x95\xa8Y\x1fX\xfb\xd64Mq\xa3\xde\x05\x9e\xb0B\xe2%\xf6\xc3\xa3\x87\x0b;u\x8f\xa4\x92S/\xf7\xb1\xf4B\xd7\"\xdcM\xf0\x90I\xe1w\xf0\x9bn\xec\x8f\x1a\x93\xd8;B\x10\xa0\x99-\xb1\xa5\x06\xd2Cx\xa0S\xc4Bu\x12K\xa5$k\xff\x8c\x81OB7\x878\x10b4\x0e\xd0\xaa^l60P\x8f\x05\x17{\xc2\xa9\xd9\x0d\xc2V\xad\xdcN\x82y_\xb4\xc5\xed\x14\xa3\x8e\x8dmH\xacW\xcc*(\xc6\x93\xe1\xcd\x8c\xa0t\x88wn\xcc\xca\xf8\xe2\xf2Y\xb1\x83\x8f\x0b7kc\xba\xd1\xd7\xde\xe5\xef \xac\x86\xe1\xf9\x10\xea\xb9x\xae;^9\xe9\xf9u\x95\xe6gs u\xcd\x0faH\xa2\x19\xf8\xe8D\xd5J\xcd\xee\x08QK#tC\x1b+\xef\xa1\xee0\xcc\xb5\xf4\xb46v\xe8\x8e\x9fq\xfb\xc5\xb1\x0dF~\xe2xY\xb5b\xc3j\xcf\xd2 \nz\xbd\xe26-\x0d7\xc2\x9a\x06\xe9\xe7\xdb\xf1\x94\x1d\x8d\xd0\x08\xedDU\x99A\xa35*\xef+\x01\xe7\xf8*7\x97\xb6\xdbQ'\xc4\xa6<r\xa3\xa7\xa3\xb9\x8b\xb62\xdf\xd4\xb4\xea\xe7~\xba\xbc\xe4\xd4 }\xa9\xff\x8c\xdb\x90	|\x00\xbf7\xa2\x96\xd9\xe1O\x0d\xa4\xcd\x8ez%\x1eq\xac+\xa15\x17\xde\x98\xe1\xaeWB\xa9\x91\xd2\xff\xfb\x1d>Ih\xbf3A\x0c\x89\xe0\xa71\x04\x9f\xba\xc6\xf8<Mj\x85k\xa92]'t\xf2\x9c\x9dx\x88\x96\x10\xce\xa9\x98\xc8\x9a~\xfbf\xf2W\x07\xec!wB\xaa\x0f\xd6N[D\x03\xad\xcd\xe0Y\xcfov\x18\x8a\xdd\xc5\xce\x03D.\x85\x9cv\x18\x08\xa5]\x9d\x9b\xc1\xc7\xf9\x07f]\x0b7)\xceW\x84N\x81\xfdgSi7\xf6\x1d\xa2b\xac\x1f\xa9\x84\xb5\xc9\xb1\xdd\xb2%$	\xcf\x89\x15\x9d\x07\xa8-\x87\xd5Ab\xbfH\xc7\x0d\xab\xdatB\xce\xc4\xf2\xd0\xb2\xf6BN\x94\x1e~\x8c\x0d\x91J\xee\xff5\xa3\xc0\x0f\xbd\xd0\xf5i\xa1=\xa7\x08\xaf\xa7\x19\xf1\x12\xad,\xcbI\x91\xbe5\xe4\x18)\xd7\xe5\x11]\xbf??\xa2\xcb\xeb\xef\xa1'\xf7L\x1c|[\xba\x1c\xd9\xab\xe3\xce\xd2\x8a\x7f\xfe\x0f\xbdU\x86\xebc\xbaJ\xd7-\x0d\x9b-\x91\xe6\x18\xb3\xc1\xad\x86Wb9;\xac\x87~vL\xe1\xb3\xc8\x1dR\x03[2\xbd\x97\xec\x105\"\xa6	\x06x\"\xb3f\xaeW\xa2\xda\x84\x93\x19\x85r\x9eZ\xa3\xa2%\x19\x7fx\x8d1!\xa7\x1d\x9d\xa4\xcb\xf3\x11D\xc8\xe0\x99\x83gt\x08\x19\x84\xda\xd0\x0e\x1cxD \xdb\xf2\x1c\xd6\xaa\x8c\x88>\xc18i\xda*\xd14\xe3\x06B\xf2\x83\xbc\x87-\xa2\xc3:w\x93\xe9\xb3\xc4f\x14\x8d\xad\xb8F\x125\xee\xe2#\x87F\xb65\x9d\xa6\xac\xe9hfR\xa7mh\xd1!\xc4\xf3V\xf6\x95\xa9\xd9\xe1\x189\xec\xd7\xcd\xd6\xc3YLk6\xc8\xcd\xec\xe3\xa5\xa9'\xc3\xf8Q(\xb6\xf2\x9eB\xe3^\x1a7\xa9\x04\xeb \xc7\xf2\xc4\x0b\xb2\xca\xac\x84z\x7f\xd0\x7f][\x08\xd2S\xf6C\x14\x07\xab\x1c \xe7\xfe\xe4\xbe\xb8\xce\x9ek\x9cqDn\xa8Z\x12\x8e\x1c\xc3\xb9\x12\xeb\x06Y@\xf4d\xc8\xfa\xd7\xd6t\xf0\x14\x1c\x82\xcd\x08\x18$*\x85\x026\xc6\x94A\xcc\xc7\xf8\x89\xae\xfc\xf1-\xef\xdc\xca\xba\xf4\x06w9I~Ko\x12\x8d\xbb\x9c\x11\x15\x19\xf4\x81soe\xcdgRk\xae\xc7#\xf8Q\xd6L\xefY1\xb6\x7f\x8d\xcc9\x91\x0c\x1d0\xfbP+G\xbe\x15\x9eZ\xb1En\x06\xbf\x1fgL;\x04\xe99f\xb1<\xff\x1a\xb8a\x9b\x14\xf1\x04\xe4\xd8\xd1\xc9\xc44:\xb3\x07\xad%\xd6s3\xcc\"\xc5\x86\x9d\xb0\x9b\xa0\x9a#\xf8\x81\xd0v\x90!\x9c\x1d\xc9BKD 6g\xeb\x86\xab\xc1:\xb9e5\xa5I\xa9\x8d\xb71+\x9a\x18\xdd\xe3\x0d\x1c\x17z\x08\x1em\x9a\xba\xc6\x9e \x8bg\"\xa7\x96W\xab\x90\x99d\x9f8g(`\x01\x93\x9aN\xd0\xc036>2\xaf\xc3\x1a\x7f^\xb6f\xf7\x17\xdah\xd3G3!5\xbc\xbe\xf0.\xe5R\xcf\x01\x84\xd6X\xf9d\x90b_\x99\xddu\x8c\x10\xc65\x7f\x1c;\x0f\x04\x10Y\x05\xdeK\x87\xc8\xe2\xd0\x08\x92Z\xe1\"X\xe1[\x18\xadVh\x84\xab\xd5\x86\xcb\xbd\x8e\x8b@\xb1\x0e./\x14\xe5>Ly\xb1\xf8\xfew\x15hi\xe3\xbcP\x8d\x14\x1359)\xed\x85V\xa6\xb0~\xc5\x98N4\xfc#\xb2\xa0q\xec\x19\x8c\xc6\x96\xad\nW\xb7\xa1/\xc9cMK\x06!\xf7\x96\xb7\x92wQ\x9c\xe1&\x96\x063\xd0\xbd1\xc6\x7f\xde\xb2\xb5\xb20a\xa7\xd3\xb5\xb5\xc6\xf82\x03\x1b\xf9;U\x8e\x86\x99\xfd\x1a39\x18s\xcb\xa4L\x15\x92\xe4\x986\xe3\xc0\xdf\xbd\xbfB\x9e\xea\xa9\x85U\xd7G\x04\xc4\xd4\xd3@\xadd\x1b\xc2\xd1\xadQ\x8ak\xd9pX\xf8\xb5\xa8-\xe3X\x8e\xe9\x13\xb0@\xc5\xdc\xc0\xcf`\xe4\x94G\xc4A~\xca\xdc\xf41!\x98\x0f\x9c\xbd\xa5W\xad\xf7\xbd{{r\x12\xf6\xeb\x8e#Z\x8a8\xe1\x15\xed	c\x9e\xccNR`\xfdO$\xb4QsK1\x8c\xf3\xff\x05i\xac\xecf\xde\xff\xa2\xdb\xf3\xfe\xa9a\xf2\xfe\xe0\xea\x99\xf3\x8f\n\x7f.\xa70\xe2\xb9&\x9dA\xb1\xe6\x07\x0d#%3\xe4\xb4e\xad$\x14\xb7\x1e\x8d\n\x9cg\xcc\xef\xc3\x98\x00r\x8bp\xb8\x0dB\xe7\x80|\x03\x0fG\x04<\xd3\xc8\x7f\xaf\xa7P\xf1\xe2\x8f\xef\xcf\x12\x0bh\xff\xb5\xc0\xee^\x04\xd4n\xb2@\x7f\xc8\x0dG\xe5\xe7\x98\x00]\xbcw\xc7\x94\xc7\xd0f\xd0zr/\xd9W\x8f\x98\xdc\x0cAPRo\xa6e><x\xb6\xc0\xd0Bk\xf6\xf7\xbc5@\\\x8ehtbp\x17k\x08\x08\x984q\x9a\xf5\xfb\xdf\xff\xfe\xe4\x87\xaa\x15\xfa\xe4\xb5\x7f\xec\x99,\xaf\x11\xc2!^\x9b\xc4\xd2	W\x99\xe9\x88.\xe3g^*\xe4\x13q\x08\xd5\xd1\xb8\xedO\xbd\x80\xe6\x8f\xf3\x17G{\xea=]\x9c1;JK\x16t\xfcP\xe0<wF\xed\x84\x83\xb2K\xdd\x9a!\xe7\x17\xf1y\x04J7Cd\x90\x81N\x194\xf9`F\\P\x0df\x1b\"EFx\x90\x88\xc1\xe5\x05\xf5\xdf\x8e\xabl2\xae\x14\xb2\xae\x92\xad\x87\x1fC>8rV\xec\xaa\x0d=\xe9\x86%\x0e/sr\x97:q+\x1a\xfe\xf9?{e\xc4\\\xc9g\x8b,\xe5\x13\x1fZ\xa2\x81\x95\xf2\xfc\x0bk\xe4^\x04S#[\x80\x00\x98\x86\xb0`\x90\x1f]\x9e\xcd\xd6\xfa*k\xdf\x1eZl\x87\x8e_X*\xf6\xfdS\xbb\xe1f\xb8\xbd\x1b\xe9_\xc6\xcf\x14\x16\xf7\xcc\xaaD\x12\x11\xcc\xdb:#\xe7\x1b\x8e\x00\xfc+\x97\"}\xf8\x05%\x1e\x01\xad\xab2E\x8fk,[c}5\xf8\x7f\xf1Z\x06\xaf/\xb3\x9b\x7f95\x1d\xcd\x1b\xe6\xb7\x7f\x1a\xb7w\xff\xe7W\xff(%!\x0ev/a\x1a\xb0p\xb95\x80\xfc\xaejC\xc6\x18\xf1\xb2v\xd0\xc1\xc0L\"\xd1%\x8a\x7f\xb5\x87\xdf#\x02\x13\x08\x10f\xe9 R\x04\xa0\x9b)\x11\x04\x90\x14\xccJ\x11\x8ch\xde!\xe3\xc7\x88Q\xcaW\x92w\\\xe4\xf7I\x06\xf3I\x00\xcd\x8b	\xc5\xaaix\x00r\xe6\xfc\x14\x14\x8c\x97U\xb1\x1d\xe3\xa5\x979\xd9\xf9	~\xa7x\xdaDtmL\xed\xaaV\xf417\x14	\xb9\x0f\xcfgs\xaakY	/\x8b\x14\xee=\xbb\x8d7=]\x8d\x9d\x9cO\xf5\xb3\xf6[ k\xab\xc1\xb2\x18\xf0\xd4\xd6\x8d\x1e*C\x9a5?\x15\x98z%\x03H\x0bL\x15\xf6\xc5=\xeaj'|\xd5R\x03\xf7\xb4\xe2\x06iDq\xf3\x8d\xaa\x9f\x89\xf7\xf3P\x7fK\xb8&\xc5R\xef\xbe\x81\xfb\xa3\x91>\x1f\x88\xa9.E\xdf\xc3\x1e\xc3Q \xe4wx\x8b\x8c\x00\xbc	`\xb0@\xce\xc2\xe4\x8c\x1a %2\x88\x92\x99\xf2\x05\xad\xd3\x0bP\xb1\x83\x9d\xe6\xe2f|\x00*!\xc4\xe8\x14\xf3\xf7\xfc^\x8c\xa3r\xcc?Ee\x19\xcd\x04\x8a\x99\xd2\x08\xe7\x07+u\x03\x0c\xbcc\x0d\xad5}\n\x0d\xf0\x18u	\x15Fk\x17|>\xfd\xfc\x0f\xbc[\x1c@\xcf\xfa}I?\x83\xaf\xa6m\xe1\xea\xbd\xb3\x1cTe\x1e\xb4A\xb3\xab\xd0\x03\xb1\x88\xee`\xc8\xb6\x92\xf7{h*gm\xcf\x97\xef_\x10\xb2\x81\xe9\x8b\x10p}\x08 \xd4\xc8sl\x1c1\xa5\xfc\xc8\x918\xd9\x98\xbeg5\xc5cit\xc3\xb1#=\x81E+DS\x0c\x86x\x04'\xb1QrsL_\xd3}\x08H\x12\xae\xe2\x86\x15\x1e\xe7\xc4V6\x1ccp\xe8Ez\xcf\x18)ga\x14\x92\xb7\x83\xe6=\x80:~\x82\xf6N\"(H\xe2\x9e\xf2\xf7\x04N\xc3\xcc]\xa5\xa7\xd1f\x94\xf8\xc81\xeb)\xc7lEa\xe5\xc2\x9a\xb7\xe9e-\x0b\xed\xd6\xf4\xb2\xfa\xd6\xa2>\x0e\xc0\xa2\x0d\xd7Cx\xc1\x9f/\xff\xab\xeb>\x0e\xe3rK\xc5\x08k\xf1\xa0\x90\x81\xcb\xf8\x9a\x88\xcc~b\xd5\x02\xbe\x1e'\xdd\x9c\x88\x93	\xdfN_\x072\xcfr\xbe\xaeM\x87'\xdf\x1f\xcdP\\\xdc\x9b\xd0N\xf0\"\xd4\x86\x1ep\x98\xdb\xd5#\x19]1\xf1\x96\xed#}\xf7[\x12\xce\xc9F\x93\x88\x13\xbc!\x91\xbc\x15\x9c\xa9H\xaf\x94\xc5\xaa+<\xabN\x8bq}r\xa6\x06\xa6\xdb\xa9\xba\xe5\xc6\xd4\x0c\xa7\xb7R\x02.\xc1\xf3\xc6\xf9\x90\xb47\x80\xa3\x02\xc6\x11l-\n2\xfa\x86\xc3\xcbA\x08\xe4^\xfd\xd5\xbe\x82A{\xf5\xd7\xd5\xabbE\x16\xf5g]`\x17\x80XY\x93\xe2'\xd6{ \xdd\xfc\xa6\xfe\x02\x12\x17_e\x8b\x14\xf9&4\xd8\x02\x10-WW\xc2\xcb-\xdf\xca\xc2)\xdf\x84F\xb8+\x04\xa8\xces\xd7\xf3X\xd80u\xa6\xf5g\x83\x8ei\xbb:~K\xaf\xbe\xa3a\xc0\x1b\x9a\xc2C\xc7l\xb7=\x8bI\xbe?\xb2m\xa7\xf7\xb1\xd90\xf5x3\x0bg\xff|\xc3\xbdz\xfcK\x88\x0en\xb8jG$\xeb\x8em\x82J2\x1c\x13`\x94\xe4Z-\x86\xb2\xdd\xa0\xbag{\xd8\xdbZc\xfc\x97\x9bO#S\x00\x02\x08\x0dG\xb3\xcf<=$\xcf\xa9\xbe ?\x1b\x87d\xc1\x99\xae\x83g\x08\x03\x12\xa2\xf8\xba\xb7\x06\x91\xa2\x1d\xabs\xd8nf9\x94\x1d\x14\x17\xda\x1d\x9eU\xd3\xca\xc1\x9aPzi5\xdd\x1c@\xceig\xe8F\xcd\x94P\x14\xd1\xe6\xe2\x12\xb92\xdbY\xe2#Q\x1e\xba\x1e\xd2	\x96!\\\\\x18\xc8\x8cX\x8f\xc2\x1a+)\x0e\xf8\x89dm\x8b\x9d8\xa1\xa6\x93]\x02Fc\nmI\x89\x85J\x8f\xf8\xb1\xd4\x89G\x88\x19\xb7\xa9\xd3\x8cj\x93\x8a\xc2\xeb<4\xec\x98N\x85^\xf1\xd6\xa0\xca\xa8|\x97\xffa|yB\xa5\x04\xe0\x0e\xe0\x883F\x86\x8a\xb5\x98\xac\xcf\x12\x0dW\xa7\x9f\x13'\xb9\xff\xb82\xdd\xb7\x80Y\x07\xd4\xd7\xe8\xbdz\x97\xd3\x14 \x84^Ff\xec\xbc\x95\xf7\x9b\xf1v\xdd\xca\xfb\x1a\xa7\x13\xed\xab7\x1eY_N,\xadK\x01\x86\xcb\xb8\xb4tU\x9b\x033\xb1n\x18\xa1[\xb1\x97VtrnK\x97S\xd3\xd1^\xc37-\xaa\xeb\x8dTe\x0cTd\x88\xcb\xdc\x97(\xc6\xcfy\xb26\xb7\xef\xce\x8b\xf5z^\x0e\xb3D\x13\xdd\x96\xe50x\xb8\x80\x9e\x8de1\xc3\x8ez\xb6\xceh\xe4<\xe9\x95d\xf4\xee\xd3\xb6=?\xf8\x99U\x84\x01N`D\xe21=\nd(\x07OQ!\x95,\xe0\x02\xbc\xfeO\xfa\x7f[\xd4\x02\xfc\x04 \xe8\xddr\x89\xa0\xb1+lA\xf4\x07\xf1\xc4/\xc5\xc38wL/\xe3\x8d\x89\xd5N\xc1\xf2\xfdw\xab\x9d\"\xa1_(w\x9a\xb1!\xa7\x1c\xe0R\xea\xffS6f\xc7\xfb\xbc\xda)&8+\x84QV\xdeoY\xcf\x8ez\xb4\x1e\x05\xc1\x80\xd1$\xed\xfa\x86\x1e\xce\x8b?\x92\xe2\xe6T\xbbg\x9d\x03\xc3\xe2\x19\xa9X\xc4\x8a\x8a;3\x1d|h8F\xcb\xd1\xfc\x9b\x84\x96\x1d\xa3\x96\x91Y\xbb\xd6\xf8g&`@\x11\xc2\xc7\x91R\xc8\x07\xca:\xbd9\xa3\xf1\xa93\x15C\x16\xd5z\x13o\x91\xde\xaf\xa1P\xb1\x90s\xc2\xa2\x10S\x14\xe4\nS\x17\x92\xb6\x14\x10~\xd6\xc1E\x8et\xcfx#\xef79\xfa3=Y\xee\xd5\x944\x9e\x16\xe6\xc7\x9b\xbe\x92\xc8\xad\x12Z\x17\xbcg\x93*Hk\xe9\xaa\xc19\xc93\"\x13\x0b\xbc\xea\xe6\x98<\x9e\x81)4\x95\x91|\xda&:_o3(\xcd\xba\x08\x9d\x90-a\xd6\xe4W\x83\xa0\x12\xa9\x02\x8f%\xa9\xa7\xd8\xbd\x94\x86\xb1\x9b\xd9\x0b\xe4Wc7((\xce'?\xbe\xfb\x94v\x0dP\x05\x8b\na\x10\xe2\xff\xd1R\x1f>\xc1\xbc\xc6^\xe1\xd2W\xfe__\xe9\x11\xaf\x07\x93	\xfa\xb7\xf8yT|\xccB\x8d\xc0\xe2\xcb\x17\x7f?z\xf9baz\xe4\x83c\xe1\xb6\x8e\xe5*\x0b\xc4\xfa\x81\xc0\"\xaa<\xda\xd62\x96=\xc7\x86\xd0\xb9K\xb8[\xee\x8b\xdf\x89\xb43\xd6C\xc6\xd9\x8d\xc4Xb\xb4\x84\x8bOx\xb5\xf2\x1c\x94\xed\xb1h\xff\xc8X\xc1\xd6\xc5\xd0T!Z\xaaen\x1b\x8d\xfa\xe2e\x90\xfb\xc2\x8b\xf1Im\xf11\x84\x08)l\\,\xfdczl-\xaf\xd0\x9fb	Th\xbe\xb6	&\\d \xce\x8dd\xb9\xeb\x95\xf0<\x8aj%\xf4uy9\xbf\xd0\x8a\x11:\xb2\x0d\xd57:\x98\x9d\x93\xdf\xb8\x93\x08o\xfd&U:\xa1\x9e=`\xfa5v^\xb3~\x9b\x0e\xea\xcb\x0e~8\xa0{\xf4\x14B4\xd4\xc9\x86\x90\xa6\xc7l\xb3\x86Y\xfb\x8d;N\xe3\xef\x02\x15@iO\x9c\xab@\xa4\x83\xdb\xbc\xb8\x0e\xc3\x8aS\x1ed\xe6:V\xe6\x17E\xf9\x81\xda\"\x85\x1a\xe8\xcaQ\x0b\x0cf\xb0\xe3\x9d\xd0\x02\xc1\x86N\xa2\x115\xd2\xf0	\xb0O\xad!}\n\x8e\x1d\xbd\xc1\xe9\xc3L\xe0M#\x8fY\x89\xa0HwYDc\xabf\xbb\xec\xb9ri}\xee\x819\xf9yY\x11\xea\xf8_\x1f\xd1\xf5\x15\xfe\xfdxq\xfe\x1a\x12	6b\xf2\xebH\x9e\xe2\xa4\xf0\x90\xde\xb1\x9f^\xd9\xbe\x7f\xf3\xe6\xe1\xbb7o\x8e\xa6\x01i\x9e\xcb\x98s\x1aE?\x9da\xf3\xb8\x02\xc8K\x06Y\x1f'Fa1C\xcd\xe3b\xafpvV3\x8b\x1aO\xddp\x81\x82a\xc7\xb8\x86v\x1f\x05\xc3P\xfb\x0e\xe2\x06\x91\xd3\x0e\xbf\xb0-4\x8b\xca\xa7\x8ac\xdf&\xbcb\x95kz\x17\x95\x82\xbc+\xccz\x97~Fz\x8a\x05\xdc%\x80>;\xc8\\\xa9\xb7\xa8\x8c^\xcbf\xb0\x11\x86\xc0\x80x\xb6c\xbb}6r\x19\n\x9404\xfe:4\x16\xa0\xd1D\xf2R\x88M\xaa\xcf\x16	\x80Z\xd4\xc2\xa3\xb2x\xf1\x1e\xff\xa7\"H\xc5\xc5\xa4\x12\xf1\x98f\x851\xd9\xf9\x15c&s\\\x12K\xd0lI+\xb9\xfa8h\xb0\x19 ]|2\xa6\x9f\xec\n#|\x0bW\x1as\xb79\x05\x19\x0b|\xc0y.\xebI=C\xb5\xf9\x1cU\xfc\xfc\xcb\xbb\x9f\xe8\xf3\xf9\xf9\xa2\xa8?\xc1\x84\x8f\xf8\x95mNx\xac^\\\xbc\xcf_\xac\xbd\xf4A\x8f.\xe2oN\xd5\x87\x0b\x14\x0f7\x91\xc2E\xf8)Tz\xdc\x89s\x11\xb1f5\x0b\xc7.c\x01QF\xcf\xf0\xa4\"\xf2[s\x18-us\xe8V\xe1\x84\x0e\xdd\x9fo\xde\x9e\x1f\xde\xbcy\xf8\xe1\x7ft{\x94iLx\xd8\xc0{i\xf1l\x9f:by\xd9b\xc8}aU\x84\xf1$\xfa^X\x91\xcb\x01\xf7!\xefE\x81r?\x1fp{\x0b\xa2\xe7\xa8h\x02\xb1\x00_s\x0d\\\xd8\"\x88!`*H\xc7\x99V\x83R\xae\xcd\x871\xb9\xc5\xc5\xe7P	\x96\x9aw\xfa\xca\x8cH\xde\xe2\x0f\xc8)\x9e\xa4O\xd0Xl\x1f\xc1V\x8c\x08\x9a\x19[\x06\xdb\x8c\x8a\x8a|\xf8d\xd2\xe5=Dna\xb9\x96\x96+\x7fq\x0d\x1a7\xe9\x0b\x7f\x97tq=\x1f\x11\x03\xba\xfdQ	\xabJ\xa9)\\\xfe\xad1\xca\xcb\x1e\x03\xcf\xa5BuX\xaa\xba\xc7p7\xac\xee\xb9\xf2G\xc1\xbe\xe0\xef\x96\xc2N\"\x0e\xc6\xba\x12\x8eC\x96\x8a\xda6\xa7\x84k\xd9\x1dS\xd4'\x07PaP\xc2\xe2\xaf\x9a,\xd2\\\xa3\x93\x83Z8\xf6g\xa9\x8a\xe4--\xfe\x84\x10\xbf(*A\xf7\xa7\xa8\xa3\xb9;h/\xfex\x05\x99\xcbx\x9a\xae\x15\xb5\xd9a\xcc2\xfe\xca\xc9nmvgRO\x1d\xb4\x92ICr\xe0\x11&!\xf1\x98\x90M\xd3\xa7!1m\x1d-L\xca&\x9e\xd9\x97$\x1aP\xfa\x0c\x03\xbec\x9b)<\xeah~\x8d\xd6\\\x05\xe8\xdc\x0b?\xe4\xdd=\xeaj\xb4\xeb_\xb4\xe4\x0d\xd2\xa6\x15\xb2=M\x88 y}\xe2\x0d*\xbe\xe1\xadE\x08o\x16c>\x0b\xba#\x8e\xb8\xf0\x11q\x0bhA\x1a\xf3\xd8\x87\xcd\xdd\xe2\xff\xd02\xe8\xe4[\xbf\x84\x1f\xa1-D\xfc\xd1\x82G5\xa1\xaf\xa9\xe5\xe5\x8b\xbf\xbf\xfc\xfb\xcb\xff\x1a\x00PK\x07\x08\x91\xf4o\xe56\x14\x00\x00\xb67\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x001\x8dS]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pl_PL/common.jsonUT\x05\x00\x01NV\xd6j\x8cW\xcd\x8e\x1b7\x12>k\x9e\xa2\xd0\xc0\xc0	`L\xee\xbei4\xb2\xad\x8d4\x12\xd4\xb2\x07;\x9b=P\xcd\x92\xc4\x11\x9b\xd5K\xb2\xa7\xd3\x1d\xf82\xc8 \xcf\xb0\xeb\xc7\xf0-\xc8\xcd\xa3\xf7Z\xf0\xa7\x7f\x94\xf8\x90\x93X_}U,\xb2\xab\x8a\xa5_.F\xc9\x8et\xcel\xf2\x06~\xb9\x18\x8d\x92-S\ny\xf2\x06\x92\xeb\xf1\xed\xed\xf4\x06\xae\xff	\xaf.\xcd+x\xbb\\\xc3\xa5\xf1??%\x97\xe6\xa7$y\xed\xf8\x1c%\xda`p3\x9dO7\xbd\xc5\x99~\x8d\xcc\x90\xfa\x06\xcb;t\x8b@\x179\xdb\xe3M\xefs\xb6\x18\xbf\x9b\xc27={jZ\x90\x90\xa8\x87\xe4t\xb5\x9c\xcd\xa7\xeb?\xd3\x15V+2\xd6\xcc\xd4\xe6\xa0\x91y\x83K\x0e\n+(\xc8\xd8\xef\xcc\xf7 \x14X\xaf\xbb\n;8\xdc\x8c\x15\x9f\xb9\x9d\xcc2\x176\x9e\xf4\x92w6Lq\xb8\xe4\xe0\x83q>(\xb2z\x07\xdf\xb6;\xe7\x95z\x8f\xdc\x85\xe7\xbc\xaf\x96\xe9\x06V\x1f\xd6\xef\xfa#\xfc\xe5\xda5r\xa11\xb3\xb3Uk1]\xc3zz3[O'\xee\x1bl\x96\xf1+\x9d_Bk\xd6_\xc1\xe6\xfdz:\xbe\xf9\x1b\xa6\xe6\xc08U\xd7\xa2M\x8f\xf4\xfd\xf8fy\x07\xd7\xb3\xbf\x93%\xe1Z\xe7\x94\x1d7\xb4\xdfK\x1cn}i\xcec,U\x9f\x83\x1fn\xcf\xb30x{\x14X!OY\x8e\xfd\xf1SX\xbe\x85\xcd\xfb)\xa4\xe3\xc5\x14f+\xb8\x9b\xae\xa7\xf0q6\xbd\xeb\xa3K.F\x9f^_\x8c\x92B\x96\x9aI\xd3\xa6<gu\xf2\x06\xfeu1r\xe9\xda\x08<\xfd\xea\xb7\x19%\\\x89\xe4b4\xfa\xb7\x93\x92\x03\x95\xba\xa3\xed\x897B\xbd\xfc7\x12\x83\xd8ss\xa1J\x8b\x1d\xdb\x8b\x1d\xd9K\x03.){\x18P\xd1\x88\x97\xe7\xac\xe3\x06\x11{\xbe\xc1\x8c\x14\xef\x0c\xa2\x18\xe8A0=\xb9F\xd6\x07\xad\xe9\x18y\x92\x85\x00\xda\xfbpi\xda\xde\x06\xe3\xb9\xf0\x85:\xf6\x0bg\x90\xb0=9\xc4b^F@\x85b\x1e+RuN\xa5	pF\xcaj\x96\xf9,\x9e\xb4\xeb3M(\xa5\xa1\x1e\"\xe4i\xf8s\xc1\xfc\xe1\x92\xa5\xad\xbe~\xd1\xcd\x10\xeem\xa7\x9evfy\x10\xdc]x\xf2\xe1\xa8\xeb\x87\xd8K|\x88U\x10\x1e\x98\x12\x96\xb4\xdfz\x81\x82S\x84Kco\xa9rh\xa1\x1b\xe4\x90\x1d*!_\x9e\x83VRv\x0c\xb9\x1aW\x1e\xcd\x999:\x83\x053G\xd8	\x89\x8a\xe5\xd8\xab6\x1b\xa7\\c!Y\x86^\xff\xca\x80\xa3@%\xec\x01\x845p`\xe6\x00\x8fL\x96\xad\x19q\xd4\xac\x0b\xaf\x95\x82\x92*\x85!\xeekb\x9a\xc3\xd2\xc9\xb1&\x11\xc7R\xba\xedVtd\xa7?\xa02Mm\xecQD\xbf\xe6\x10\x8e\x16\xb4\x11\x0b\x1d\xd3\x19\xa5\x05=\xc8\xd6\x97\xf5\x85\x99Z\x91\x1d]9$\xa1P\xc1\x04\xc0SJ\xd5_H\xb7\xf6\x9a\x9aJ\x07~\xb7\xa9\xbfo\x8b\xcc\xd4*\x8b\xa9\x97\x10?=\xbd<g\x0d\xa9x\xedVW\x0c\x02\x86\xaa\x8b\xb6V\xd9A\x93\x12\x0d\xcb\x1eX 6=H\x15k\xcd\xb7\x9a\x1d\xe1\x8c\xee\n\xd5\xd5ibE\x8em*gL\xa2\xe2\x83\nHm\x9d5}}\xcfK[\xc7\x8aX0\xdd`[u?V\x02\xb3A\x1fX\xb0\x87\xa8\x994\xa8\x9d2\x8asQ\xf4B*P\x17\x03\xab;\xdd\xa0\x19\xc8+v\xfa\x9d7\x02\xb5\x12m\x1d\xce\x85\xb1T\xb0\xb6|\xdf\xe92\xf6\x9f\xae\x80+\xc4c\x17\xfe\xad@\xa7\x97,\xf2W\xa4<\xc2NO\xd8\xba\xbc\xb3\xa4;\xe1\xf4?M\xbceO\x9a\x8ai\xdb\xe9V\xe2\xe5\xb9\x97R\xda\x92e\xc3\xa6P\x8a\xae#\x14\x85\xacc\x9e\x8d\xdd\x1a,\x01\x93\xd2\xbf\x81\xb1p\xb7.5\xbb\x1cm1k)w\xe0-\x03\xfe\xf5\xcb\xe9)\xf6\x02\xa62\xf4Y;\xa1\x9d\x12\xb1Z3f\x99\xa4\xbd\x83\x7f\x8c\xcb\xc0\x96\xc2\xbd\x1a\x93\xde\xc8\x01.\x84\xe8g0f\\\xb7Q\x84	\x02\xb6}0\x9c\x94\xcb\x8b\xe4\xc6\xfdz\x13\xcc\xe9\xc1\x9d1\x99\xfa\x85\xc7\\\xb5n\x88\xe6L\xef=\xfb\xad\x90\x08\x96\x08\xa4G\"G	s\x08m\xe1\x9e\x1d\xe9\xf4\xeb \xb1\xf7D{\x89)\xa9p\x10)\x8eJ<\xbc\x86\xd3\x1f\xb8\xad\xa1\xaaMS\x1e\xd9\xcboP\x082\xa8\x8e\xf1MHD^\x90\xb6\x13\xd2\xba,|\xf3\x9cy\x00vLH\xe4W\xe0\xc3\xc8\x82:\xce&\xc1\xe4&\x9e*\xf2M\x99eh\xcc\xae\x94W\xb09 \x14l\xef\xba\x8e\x94\xa0\xa8\x02\x8d\x92\xba\xd9F\x14\xef\x99q\xefN2[\xf9~\x14;\x1e3>\x80\xa5\xb1\xcc*\xd1\xb5AfE\xe8\xf8\xf3v\xdd5\xc8\xc1,\x11ZFx\xec\xc1\xf5\x8a\x9e\x85|C\xd7]B\xfc\x03\x8d\xc5\xd3gP\x0c\x0c\xcb\xeb\x1c8\xc9\xf8Yr\xa6\x8f)\xa2\xdfl\xc1\xf4\x11\x98\x01\xe3d\xef*w\xad\xfb\xa30\x82T;.\xf9n\x0e\x8f\x1e\x1b\x90\x02\x10\x9a\xf1G\x81U\x9f\xb1\xb0\xd3\x94\x83=\xa0\xdb\x1b\xdd\xac\xc0L\x10Qbf1\x0ci\xad+\xbd\xc7\xfe\x84\x0b'\x82P\x96\xe2)#\x8b\x1e\x87$z\xc4sui\xec\x82\xd9\xcc_\xf7\x8a8S\xe8\xae\xfc\xf4\xc4 /M\xf3\xf2\x0c\xdb\xfa\xe57\xb0\xec(BL\xdd\xc4\xda;\xbd\xa5\xaa\x06\x8bnR\xf7\xca\x02\x15\x17j\xbfF7\x0c9\xc2*\x00\xa0\x03\x12\xe7O\xa1\xec\x86&}i\xad\x1c\xe2\x8a\xa7\xc5\xfa95\xbe\xa6\xce\x95\x13\x7f\x08\xf2\x0f\x9b\xcd<\x92\xdc\x90\xda\xcf\xf1a\x05;\xd2\xe05\x81\xf4\x9f\x92\xe2\x8c|O\xc6\xba\xc6t\xfa\x0c\x0d\xcbj\xeb\xdaw\xddN\xa1\xe7N\xceg\xd3\xeb\xba?\xf5:\xce\xab\xfe\xe3m\xeb\xb3[m\xf9.Z\xd4gl\xd7\x98P\x9f\xd3\xdag9P\x0cXW\xd1\xfe*\xfc\xa7/0\x13;\x81\x1cJ\x1d[\x8a\xc6\x9d\xc6P\"K~\xfa\\	l\x9fP\x8d\x85\xf4o\xe4\x92\x17T	\xe4\xa7\xdf;\x05i\x9f\x94\xf7\xfb\xd3\x13\x9d>\xb7\xb0-\xb5?\xef\x8a*\xfd\xf5K\xfc\x84\xba\x94\xe8\xdf\xf5{f\x18\x8fwc\x90\xe9\x90(w\xa1[\xc4\xf6\xd8\x8ev\xee\xd9\x8e\xcb\x88\x1b\x97\xf8\xd3\x9f\x0b\x11\xff\x05\xcdi/\x14D\x1c0*\"\xd9\xa6\x92*7`8f\x8a\x16\x8c\xa4\n\xdc\xfc\x11\x19\x07\xaan\xc9\x8a\x0cc\xb8\x82q\xca\xc5\xe0\x91.\xa4\x18\xfc}H\x9d\x08\xb4\xdb\x85\xa2p\xff\xa6\x86\xdf\xc8u\x912\x04\x1dVa\x93r\xfb\x80aJL\xe3\xb2\xc5s\xe1\xe1{f+\x81\xba\xbb\xd8\xa0yK\xba\xcf\xf7\xd4C>\xff\x86)\x1fw\x7f\x03I\x0c\xd2{\xb6\x872\xdf*&\xa4\x08\x8dy!\x94`\xb6\xd4\xb5\x9f*\x04^]\xc5\xb6\x18\xa6\xa0I\xed^\x9d\xc1\x14\x94\x05 R\x8a\xf8\xa6\xed\xbf~\xd1m\x0b/U\xfb.t}\x89\x99\x06\x94\xc0\xa6{#\xeaAc)U\xd5v\x84\x0fq\x19g,/\xf47\x1c\xb5g\xd7Z\x16\xae\x93\xbbW\xc13\xbc\xe4'\xcd\xe8\xc3\x03+M{\x8d\xc6\xb4\xb3\xad\xeb7\x8a\xba\x93\xfei\x9b\xbb\xe1&\x17\xa3O\x17\x9f.\xfe?\x00PK\x07\x08\x84sE\xe2\xe1\x06\x00\x00\x1d\x10\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pl_PL/server.jsonUT\x05\x00\x01*b2h\xbc{\xcbn$\xb9\xb1\xf6Z\xfd\x14\x81\xfa1\x7fo\xca\x92\xc63\x0b\xa3\x17\x06ti\xf5\xc8\xd3\xbaX\xa5\xb6`\x1b6\x10\x95\x19U\xc5*&\x99C2\x95\xca4\xbci\xcc\xc0\xcf\xd0\x98\xc7\xe8\xed\xecZ\xf5^\x07A23\x99%\xf5\xb8q`\x9f\x95*\x83\xc1\xe05n_P\xffx\xb17YhS\xd8\xc9+\xf8\xc7\x8b\xbd\xbd\xc9\xd9\xd1\x1f'\xaf\xe0\xaf/\xf6\xf6\xf6&\xd7\xa8H\x82P\xcc\x80Y\xb3V\xcdd\xea\x1b\xeeJa\x1bhk\xf1\xf8S\x8b\x8a\xa0\x05\x85\xeb\xac}\xfc\xb0\xfd9\x13\xb4\x86\x16s\xacQ5\x85\x80\xb2q\xa8\x04\x16\x02\x04\x08\xe5I\x9d\xc05\x16b\xf2bo\xefo,t\xf2\x87\xeb\xd7onWU1W(\xa4\xed\xe7\xc0dp\x03\x9dy\xf7&\x05-\xab\x0c\xa1\xb2d\xe1\x8e\xe6\xd7	\x07\xcc\x1b\xc8i\x81\x95t\xfb\xf0Z\xe1\\\x12\xb8\x95\xb0 \x16\xd0\xe8\njT\x0e\x9c\x06[\x95\xa56\x0e\xb4\xcc\xc9\xc0\xdc\xe8\xda\x92\xb1S\x98\xe1\x02\x8d\x00m\xe0\xa8,%AN\xf7\"#\x0b\xe8\xc0\xad\x08\xe8\xa1$e	\xf4\x02\x94\x1e\x86\x05gP\xd9\x12\x0d\xa9\xac\xd9\x1fVu9;\xbb\xeb\xd7r\xa9\x1d\x8b'8\xd3\x06\xee\xb4\xd9\xc4\xd5\x1ck49\xa0\x94\xba\xb6P\xa0##P\x82[\xa1\x03aAi\x07\x16\x17\xc4\x93\x9e\x13\xdc\x0b\xaa)\x07\xa1\x00\xa1\xd6f\x03\xa4\xee\x85\xd1\xaa \xe5\x86qQ\xd6\xd8\xd8\xb7:\xdb\xf4\xa3\x1fy\x120\x8dE\x1dk\xe7t\x11g\xe0\x8963ZJ\xa1\x96\xdc\\\xe2\x92`\xeey\x80\xeeIA\xbd\"\x05\x0e\xe7<\xa5\x95\xc8sR\xc9hJ\xf3\xc9Z\x1a\x06\xeb)a\x80SaK\x89\x0d/\x12Jm\x1d\x19\x0bh!t\xd4\x95MdU\xb9\xd0\x7f\xd2\xb2*\x12iL\x83\xfb@\x0c\x02\x03\x07\x1f\x83\xef\xc0\xfbQTVd\x80*\x87{\x91\x93\x06\x1e\x90\xccH\xb4\xd3K\xb1\x18\xc4*\xc1\xbb\x9d\xc3\x9b\xf33H\xee\x1e3\xf7\xad\xbeq\xb8^\x83\xb4\xf9\xf2\xa2r\xc3\x1c\xf9\x03\x8e1\xdb,\x8d\xaeT\x0e\x7f\xe29DQ\xbe\xcd\xad\x08\xe6C\xbb\x9fc*-t\xe8\xc4}F\xd2Q^\x08\xf5\x1b[R&\x16\x82r(\xa8 \x0b\xa5()\x07\xeb\x0c\x8a\xe5\xca\x81PN\xf3]7Ph%\x9c6\xc90|\xd5^?\x94\xc24\xfdP'-Z\xa8\x9b%n\x7fV\xe2\xf1C&\x10\xf2V\xe0\xf6}\x15\xa7\xffVd\xed\x1c!Wb\n\xa5\x86\x8d\xfb\xf4\xd14\xd9*r\xc1\x9cZ\xd0y\xa9kAy+\xa0\xd5\x96U\x9e\xa0\xb2\x15\xcbs\xcd\xce\xf0\x97\x98\x1c\xed%\xb6\xf5\xeex\x81\xa6tMK\xdd5\xed\xc3\x85\xde\xfeB\xd0b-\xc8\xe0\xe3\xbf \xd7\xf0\xf5!\x14\xb8}\xcfs\x91\xc2\x91\x81\xed{\xcc\xc4\xf6G\xbb\x11\xd9\n\x04H\x9ex\xa2\x8d~\xf5\xb7\xc2\xc9a\xfc\xdb\xc6U\xdb\xf7;\x13\x88\xc4\xf1\x0c\xa6\x90\xe9\xed\xcf\xb0\xc6\x0d\xbc\xe4\xbbQ\xc1\xff\x87\x0bTK\xfdr\x1fNy.\x87\xd0*\xdc|\xfaX\x8fF\xcc\x87\x8d>\xe6\x0f&\xefMn\xe9\xc1\xc1\\\xe7\x0d\xdf`\xbe\x19\xac\x14C\xb7\x0cK\x97\xad\xb0\xefy\x12\xbfC\xe7k]\x1a\x9eJ\xb5\xfd\xa5q\x1b]+\xb1A\xd0P7%m\xdf+AJ\x10\x04	\x0d\x94\xa6m\xc0\xa1\xdf\x10\xd3R\xd6b\xb6\xf2k0\xb4&\xbe1\xd9\x1aA\x80\xab\xb5i}GG\x05\xba\xea\xc9Tnqiw\xa7\x03\x8e\x89qA\xb8\xb4l7\xac\xaeLF\xb0\xd0&N\x01\xc1jY9\xa1\x95\xdd\x87+E\xdc\x89m\x88A\x95\xebB6\x90\xad\xb4%\xe5{\x10OnI\x8a\x8cW\xcb(`\x1fn\xe8\x87J\x18\xcay\x80L+\x87l\xfd\x1cHB\xeb\xe0\x1b\xc8\xc5bA\x86\xd8\xae\xe3\xd2&\xbb\x9f\xad\xd0\xcc2m\x86\xe3>Y\xa1\xc1\x8c\xaf\x8a-\xb1\x00\xeb\x1b;}w\xc2\x13k\xf2Z\xa4\x17P\xe8\\,\x1a\xb6\x88\x08Y\xdf\x93\x07\xf7\xe7\xb5\x0fG\x0b\x16E\x0f\x19Q\xcel|\x92R\x14\xc2\xf9_\x95%\x03\xb5\x90\x12\x14\x85\xc9[-\xef	\xb0_Y2\xd5\xca:]\x9c\xccf\xfdT\xe3\xb4\xdeY2\xbf\xc9i!\x14\xe5p2\x9b\x81\xa9$Y\x90\x1as\xcaA+p\xba\xec.\x91%I\x19\xef\x9c[QA\xcf\x08\xbf\xd5\xcber\xf9O\xfc\xa0,5\x0e\x16\xday\xe2,2\x1b\x9a{Q\xd1\xb5\xa6\x13=\xd5E\xb3\xfdY\xaa\x06\xack\xe4d:\"\nb\xa5\xcc5\x87\x03\xbe}\nk\xda\xfe,Ez{\x81\xd9\xeaf.\xc8\xb4\xc4a\x02-\xf5\x93!\xdf\xa2Z\xf63\x1f\xc6\\?~h\x9b\xce\x93&\xa3\xb2\xf8\x1a\x07\x86D\x9c\xad\xd0d+q?l\xc4iB\x0b\xf3O\xb8\xf6\xb5Y\x82(\xd8!Zb\xaeD\x96\xb0\x1c`\xdc\xe8\xb9v\x83z\\\x1b\xf6\x98\x0e2\x83\xb5d\x0f4\x1d\x91\xb1r:\xf8\x9d\x9a\xe6V8\xea\x19\xa7`\xabl\xc5\x8e1\x0c\x04\xa4\x96B\xf1\x8e\xe4\xf4\xe0\xdb\x17F\x17\x80YF\xd6\x86\xfb&,x\xab\xb6\xffdR|q|d1L\xec4L\x17\x94V\xbfA\xf6$\xa1/d\x86\x905t<Qv\xcf\x0d\x0f\xa6+\xe5\x00K4.\x8c\xcfw\xfb\xa5\xef\xff\xb2o\xf6\x0dA\x8eZ\x82\xa2:\x88N\xfce\xae\xd5\xb0\xe1gB	\xbb\x82k\xb6z\xdc\xba79\x91\x9a\xc3\xaa\x92\xd4\x8e-$\x0e5\xba~\xafsRp\x83\xb9\xe8\\\xeb@`s\xed}>O`\x8eJQ\xe2\xf7\xbcJ\xcfQ\xca^\xd2\xd5\xe0\xb1\xfe\xdf\xef|K\xe7\xec\xac\xc3\xd4\x9fmtA*o\"\xd7S?T\xe0\xc66\x05\xfaK\xf7\xf5\xe1a\xdaU\xc0o\x0f\x9fu\x0bT\xa0\x90\xaf\x8d\x19\xd6\xc5\x04xm\x8c6v\xac\x8b\x9e\x95\x97D\xbe\xf1\x19!\x17(\x86eyA\xdd\xdep\x8f \xe0\x99n\xd7h\x93+\x8b\xd6\xd6\xda\xe4O{B\x19\x9b\x9e\x13\xa1\x8d\xebE\xf8\x91\xc1\x92\xb9'\x03\x1cZ?#+i}F\xdc\xac\x9a?+\xed\xf3\x82l5\xcfu\x81B\xa5\xd2\x1eV\xc4.b\x10\xf5\xf0] p\xf3\xd0\xfe+jM\x0f%\xaa\xfc(\xb9-\xaf=\x05\x8e\xa4\x84s\xee\xd2\x1dR\xa4sX\xeb-\x84\xdd\xe7|\xe4b\n\xd7\xa7gS\xb8\xb8\xfe\xc6\xc7\xa3>\x16\xd3u\xb8\xd9\x168Kx\xe9\x00\x17\x0bo\xad\xf7\xe1\x92j\xdf\x14e0\x03\xa0\xb4\x9a\xb3\x0dT9\x8d\xf6\xfe\xa1\x1c\xed\xfaC\xba\xd5\xfe\x03t\xc9\xca\xec\xdd\xf1B\xc8\xc4\x0f,\x88r\x0eA\xfd\xe6\xf6k\xe3\xfb\x03\x1bv\xab\x1b\xa7\xeb.B\xd9\xa1rl\xb3\xce\xab\xf5\xe3OY\x03V<~\x00\x85\xb0\xfc\xf4\x91\x0d\xb6uF\xab4\xebY\xc8Q\xb4\xc0\xf6\xc34\x10\xa8\xe3|\x80WM\x06\xb2\x94\x85\x9d\x1a\xd3\x13\xdb\xb1\xd0&\xa3\x9c\x93\x8a~\xd6wMQ\xd9V+\xf4I\x84(t\xad\xb7??\xfe+\xca\xbfk\xb6\xef\x1f\x7f\xca81m\xeb&\xf55\x9f>\xd6SpF\x94\x1b\x8e\xbe\x04\xf0V\x10\x84\xfd\xc7\xd4\xb6/QJ2\xcd\x85\xce\x07\xb3\xf5&\xd0\xc0\x13\xc3HWJ6`W\xba\x8e\x87\x1b\xe3\x13\xd6\xd8p\x9a\x89D\xa9\xe7(O\xbf\xc8g@`\x96\xcd\x7f\xd9yD\x17\x94\x1c\xdeR\xeb4Fx\x13>\xb9\xa9\xfb\xf8\x8c3\x8cYa\xb7W\xdf\xf9\xcfWp\x18\xfb\x9eHB\x13S\xc7\xdd\xf3]\x89\x9c\x8e\xd9\xef\xe7iw\x82S\x92\xc4ke?\xd1\xa9\x9co\xe8\xf2H\x1b\xd2\xe4\x15\xde\x13\xcc\x89\x14\xe4\xa1\xc7\xb0\x1c\x16=\xca\xc2}\x7f\xa6\xf0=;@)\x0fR\xc1\xc1kq\x90\xba\xa4\x1c0I\xbf9@\xf5	w\xef\x03}g(\xc8\xa1\xef5\x1e\xf3\x86\xb2\xcaXqOrH\x00z\x9a\x9f;\xef\x85P\xcbg\x975\x0d\xeb2TJN\xf3\x9c\x06L\xb7\x0eL'I&\xd9\x15/\xd5\xa7\xb1\xc3\xb5\xf2\x8bM\xb2\xd7\xb1\xf6!\xfcu\xb6\xd2\xf5\xdf`^9\xa7\x15G\xb6\xa5\xc4\xcc\x07\x7f\xcf\xa5\xbc+mD\xcb\xf7[^\xea\xfa:\xb8\xdaa\xa8\xbe\xf1\x19Olwp\x80g8@(\xc9\xc1\x8eA\xb7\"\xc3\xebW`\x1df\x1bJw\xb6\x1f\x84\xaf\xc4g\x86\xef\x9a\xb8\xc7\xde\xe4\x9b\xdfe+T\xa0X\xbf\xe5R\xe0 L\x0c\xb7\xed\xad^\x8a.\xfcI\x18X\x81\xbf\xd3\xec\x82:Fo\xfd\xc1\xd3\xc0\x1b\x7f;\xc4M\x1d\xc8\xe1\xbbAi\x88\xb1\x1ao\xceV\xcc\xbf#\xf7FkwuO\xc6\x88\xc4\xc0\x04\xf1Fk\x07\xbak\x0b\xcb8\x8f\xf0\x15\x87MN\xc3*q\x17\xfeB\"X*\x91\x13'\x90:\xf3\xe1\x1cH\xb1\xe1l\xe3\xe4\xf4r\n\xa4\xd8\xd0r\xe8\xb6\xa8\xa4\x0cC`\x9e\x1b\xb2\x16Vdh\x1f\xde\x12k\x11\x15\xa5kx\x04N\x02\x98=F\xfc\x1d\xf3>\xbc~\xc0\xa2\x94\xf4\n^\xae\x9c+\xed\xab\x83\x83\xe8\xfa\x02*\xc7~\xf5\xe5\xceZ\xc7IXX\xe4\x97$`\xac&|\x89P\x85\xb5\xfe'3.Q\x8c\x1c\xe9y\x918\xd2\xf3b\xe4H\xfd\x06\x8f]i\xb8\xacgb\xe4\x89G\xb7!\x86\x06\xd1\xa5\x0be\x83*\x12x\x94\xd0\xf9\xb5\xf9\xe8\xc0\x90\x15-\xf9H\xda\xe4\x11~\xb3\xe4x\xe1\xc96\xfe\x90\x0f\xc1\xd1\xf9\x1fO\x8f\xe3\xb5`\xfa\xafD2k\xf4\x00\xd0`\x10\xfe\xd0\x11\xa6\xe9g\x1f\xc6\x9f\x9f\xda}\xe8x C\x05\x9a\xfd[\xb0\xaa~\xcai\x04*\x85\xda\x0c\xa2\xdf\n\xb5\x11\xd0R\xad\x1e?8\xd3\xaa\xee\\Ou\x8e\xeb)\xc3A\xdb\x1fAVs\xa0\xbcq\xd5\x1a\xe4.?\xd4>C\xac\xe0\xf7\xbf\xff\xfd\xc1\xb7\xac\xb5x0\x0cV\xa0\xcd\xf4\xb0\xdd\x17\xe1s\x14+\x07\x16\xc8\x83\x1e\xeev\xf5\xd7\xae\xef\x1f{zbw\xdd\x91\xddI'&\xed\xee\xaa\x04C\xb85\xc487\xe4\x12!\xd7F[\x8f@Ey\xd7\x8c\xb7\x94\x82Lm[ZC-\xda&\x13\xbcs\x01\xf5\xae\xd6i,\xc2\xa8\x8d\x0b\xd2\x18\xf9\x18\x0b\xe4\x00\xcb\xd1:DW\"\x89\xe2\n|\xf8\xcekH?\xa3\xb7\x1er\xa8\x1b\xab7\xdaOM\xcf\x0d\xb6\x9b\x0e/\xba\xe82\x14\xecy\x1e\xff\xc5\xc8\x10\xd9f\xfb\x1e\x15\xe3g\xa1\xc7\xa7\x8f\xf5h\x9c\x99h\x87u\x87Q\x8cn\x0b\x81\xa6\xfa\xdc\x18M\xc7\x01\xb5i\xab\x0c9\x97\xefx\xa1n\x0cn\x7f\xd1\xaa\x81\x1a\nZ\xe2\x1c\xd7\xe8\xd2\x18\xa2\xc0\x87;\x91\xbb\xd5\xce\xa0\xb6%\xf3o\xd7\xd63}\xd1\xe2hY\xdd\xfe\xa9\x1f\xe6\"|\xc63d\xa4:\xe0S!\x1e\xe8\x00\xd7,\xa0\xb6\xec\xaa\xbcyf>\xb6\xf8\xa9m\x0f\x82g+m\\V\xb9\xff\xc6\x00:g|,U\xea\x8b\x814\x1d\x13\xc6\x8a=\xf0y\xd5N\xb5z\xca\x8ez\x80\xe6U\xceZ\xc4&\xa8b\x87\xd3\xd1\xe7\x0d+\x880P(bl9\xf3\x06cX\xbcJ\xa1\xdd\xd4\xa7\x9e\x17\xe2\xf1\xc34@\xc7\x04\xf3\xc7\x0fy;`4\xec+[\x1f\x13\xf0M|\x12\x88+\xaa\xfb\xf4\xb4\xdbN\x9f1u\xc4]\xb7\xcd\x1d\x18XH\x99\x13\xa4\xe1\x8a1\x06\x15S\xaed\xee\xda\x89l\x98\xfd\xd5r\xfb^[\x8f\x89\xc6\x01\xbe7\x9f>\xba\x0d&\xf5+(\xf5\x06[^\x02>I\x87\xc6\x82\x17\"\xb8\xe4^\xfc)\xd9\x0d#w\x97Icwzo\xc8A\x1e\xdbU\xda\x1e\x8a0?T\xda1\xf4g\xd8\xe77*\xabY\x85\x18R\xc5\xb9\xaeBm\xcb\xa1IV\xa6e\xfed\x03\xafd\xdeg\xf6q\xd8\xa4C\x0c=N\x9e\xc1~\xbb\x90\x05b#\xdc\x0eY\xdd\x05\x96%;0\xbd\xe8\xe3h\xcd\xa1\xb4\xdd1\xb0\x1d\"\xcc\xa1\x11;\xf0\xa8^\x94?\xc1\xb2t\xcd\xa1\xe10\xeb\xf0\x19\xcf\x91?\xc6\x17\xdc\xe3]\x9cis}\xc9'\x05>\xd8	\x80\x19O\x82\x03\xec\x88yi\xe5\x8c\x96v\x1f.*\xeb\x9e\xc2\xcaZ\xa5IQ\xbfU\xddL\xae?\xb7w|\xa9N\"\x9e6\x0e{\xf8\n\xf6P\xdb\x17\x85?=\x9e\x86\xfd}\xfdOF@<\xd5s\x1f\xc7\x84X\xa5\x9fi z\x9d\x01\xf6\xeaO\"\xdd\xc8\xc0\x1e\x9cr\xaf\xb1P\xa9<\xc6\x97\xfe\x93\x9b\xf8x3)\xb2\xcd>\xdc\xf1\xc5\x8dhi>\x05\x85\xf7b\x89\x8e\xfc\x99\xb8\xd5X\x92P\xd6\x11\xa6\xb7\xa04\x95\xa2\x1d,\xf3\x9d\x0f'B\xdd\xa6K\x92G4_\x97\xda\xfe\x82\xb9\xea\xac\xca\xa7\x8f5\xe8\x1c.\xb9\x92\xb5#\xfcve\x08\x13\xa44H\xf2\xb5\x90\xb1\xf0@\xfab\xd9M\xd5\x8b\x9c\xc9\xca\x01\xf9\x82t\x87h\x9dj\x8f\x03\xd9\x04\x9d6\x0ce\xf6}n\x0e\xf0`\xc0:\xe3\xd73\xc9S\xda\x9f\xcb*\\b\xfbNW\x89\xe6\xdcx:\xb0\x81\x86\x95o\xe1\xe1:\xbal@\xab\x8c\xb8\xd2k\x1a\xf8\xfa\xb7\x80\xd6\x8a%\xd79|\x07V\x9c\xe8\x03\xd8\xf5!8\xbf]\xc9\xa8s.h\x0d\x83Q~p,+\x02O\x1d'G\\\xc6a\xf72\xe7v\xc7e0\xb1\xe0\xdc\xba@\xc7V\xad\x16n\x05/\xffn^r\x05\xfe\xe5\xdf\xe7I&\xc1C2\xda\xd2\x0fs\xdb\xc8\x8d\xe6:\xa0\xce\xb3\xb6q\xd5.\xf6S\xe8\xed/R\x04\\\xc8O\x9f\xab\x10i\x02hh)xQ\xc9\xc4#aW\xab\x0dIt\xe2\x9enE\xe2\xe0n\"\x11\x98j\x1d\x16eg\x08\xfb\x16\x1e\x15\\\xdf\xcc\xc9\xd3\xfe+x\xf9\xb5?\x03\xc0\xa5\x1e\xad\xaf$L7\xd1\x7f>\x9dI)\x9b\x9bQ\xf8\xf7\xd7\x1b\xa6\xfd\x0d\xd0Ah\xe8\x82\x80{\x02\xdf\xd4\xe5\xf7Q\xd9\x0c3\x81\xcfGb\xe1\xa8\xc4e\x12`\x1a\xad\xdd\xbb\x9b\xb7\xc3L8\x1de\xc2t\xf4\xd9u\xf6\x19\x8e7\xaaI\xa5\x8e\xd1\x12\xab\x8b1V\x04\xa5\xd1\x1cA\x19\xaf\xf7\xb56\x9bD\xc9}]\xab\x1f\xf3/h\xb1\xaf\x96\x86\x8f\xa8\xd8\xd5\xe0u9c\xd0\x1b\x06\xde[\xe6\x18d\xd94\xd2\x9f\xf1G\xa7p\xecPa^\x15\xa5\x9fz\xb8\xc7\xdd\xbe$\x954\xbf\x9c\x0e\x88\xec\x8dv2[\x8br8\xaacjKAY\xcbA\xe8\xa7\x8f\x1d\xfc>\xfb\xf4QB\x8b\xf3\xd8\x88\x8c\x9db\x0f=\xee\xc3%\xae%\x95\x82\xd6S\xd8\xfeB\xf3\x06\n^\x1eB\xa6\xf99M\xa1\xf8%\xcd\xb7\xa1z F#W\x19)\x1c\x8c\xc4\x8c	\x97GWq\xd8\xae}?\xd3\xc5h\xf7\x13\x11\\>\xd2j\xe7\x1d\xc0]\xf2\x04\x80O\xcc\xaeE\x80\x85\xa3\xe0\xf0N\x80\x8d'f+\xff\x12`\x8d\x1bQ\x04\x1e\xb0\x8f?u\xe0d\x93\xb5|&u#\xf5R\xd7\xfe\xa0\x92\xb1WX\x88\xb1\x95\x9b\x0d\xa4\xe9\x0e\xe1Wm\x9d-\xb5\x90ix\x10\x92\xb9YG\xee\xce\x9c\x8dl\xe4}\x82\xc9Z\x87\x8b\xc5\xf8I\xc0\x8cI\x10hc`\xc7\xe3\xf8\xbe\x078nfc\xd8\x15\xefaE\x98\xa7\xb3s\xf4\xe0\x9e1W\x8e6\xd6\xed\xa2\xd4C.\xc6\xb9\xa2\x14\xe3D,Ts{1\xfe+n\x94/\xf8\xfa\xb2\xf0N\xc97\xdc\xecp\xc2\x17\xf8\xd0\xf7\xbe\xc0\x07Q\xf8\xd7ElN\xb9\xc0 L\xe3-T\x14yY\x15s2\xac\x0796\xd6\xdbc\x8e*\xbb\xf8\xc3\xc2\x9c\x16\xdaP\xef\x048\xf0|\x02\xba\x8eF\x17C\xd4{!\xd4\xff\xc5\xe8\xa3\xe3|\xee\x85I\x0c\xe5\xa7\xa0Kau\x8d\xa0F\xcfP\x86u\xf8zx\xbcP\xcf_\xb3q\xe5\xbc\xbff\x8a\x0f\xe4\xb9r&?\xb9\xa0B\x0f\xe6\xc9\x13\xf6\x992\x1d\x7f\x03*Q\x10?\xcb\"Rv\xa5\xdd\x13=\xe6\x07\x06\xc7ov\xcb\xf9\xc3\xd3\xa1\xf1\xdcb9\x9fm}\xf2\x10iXl\x90\xf6\xc5(\xc9s\"|*\x12c\xa9+\xe5}O/\xea\x8e\xdb\xe2\xb1yP\x94\xc1\xed\xce\xc2\x1f\x85\x9a\xb8\xc8P\xca\x86\xa1\xc6\xee|\x9d\x06/\x94\xf2\xa7]\x87ai^\x8caZ.\xc2\xfd\x1b\x94\xd6\xb3<\x01i{\x17f\xe1Y\xa87FpitZk\xb3\x19U\x89\xf8i\x1f\xbf\x1d\xe9N\x94Ax\xdb\x01\x811\xb5f!\x96s\"\xf3\xfcFF\x99;\x0f6\xfe\x03\x92\x1b.(\x0e\xf6\xe0\xcf\xe1s\x9a|<u\x1a/\xf6\xfe9}\xb17\x89ph\xf7TT\x85\x82\xfedn0\xbc\xbf\x98\x84\xab\xca\xb4\\\x97h\xab5\x07g\xb41\xa8\x82\xe2M\xea\x88\xed\xa4\xed	\xb63\x89\x03Ym\x1c\xaf\xbe\xb3\xec\x93c\xef\xb0;3\x11<\x11\xc3\x04\xce;\xc9\xa1\xe2\x9e4W\xdd\x93\xa6\xe0\xbe\xbaGl\xbb\xbc\x1d9\x81\xbf\xf8\x04&\x0e\xfb*\xca\xe4j\xf9\xe9\xa3\x8cp\xe6d\xd6\xbdq\x99\xdc5\xb6\xad6\x82cJ\n\x02\xe2*\xff\x82s\xac\xe3\xb0\xb3\x0d\x9b\x9a\xa6\x17KE)9\xf1\xe96q\x8e\xea:U\xb8?\xeb*\xa9f\xf9\xd2H\x1e\xf0\xe7\x83\xaf\xec\x01\x030_Y\x1f\\\xb1yYh~3\xca\xae\xd1\x10Z\xad^u\xe7\xc8~\x8a\xf1\x1c\xff\xf8\xc8[Yb\x8d\xfb\xcarT-\x14|e\xf7#\xebQ\x8a:s\xb7Pr\x9f\x06_w~\xcd\x06\xf6\xab\x88\xf9\xc6\xd3\xa9D7\xf9\xf0Xxr\xde\x01\"q\x8bbJ\xceM\xdfk~\x8b(\xa0E\xd3>\xfe\x94\xb7~\xaf\x82-\xe6\xd7\xbf<\x87	\xe69\xb3z\x988R|\xf6\xe1\xfd0\xb7\x1c\xf9\xcf\xe0x\x03\xc3\x1c\xfd-;F\xd5\x7f+2\xb3\x922\xdeX^\x14\x95\xceBU\xb2\xfd\xe0G\x19\xfc\x8cx\n\xd7\x97o\xa6\xfe=\xa76A\xfd\x19\xd3\x0f>\x0e\nv\x8cU\x01\xb9(\xc8\xc3\xf8\x96\xbd\xd07\x87\x87\x0f_\x1f\x1eN\xfbf\xee\x01V\xb4\x14[\xe1\xfbc\xaf\xd2\x8a\x13\xedJ\xe5\xfbqBl\xf7&\xc7\xcdd\xe79\xdf\xe8%\x1f\xbfOSKJ\xb0\x99\xc9_\nA\xdb\x1fa\x85v\xfb^\xf7L\xc6\x97\xd0\xb9\xfbQ\xc1\xbfx\xec\xfe=\x9a\x1dE!\xf3\xee\x8d\xe1$\x93\xbc\x8d\x19\xf7:\x89?\x83<\xae\xc3\x06*\xff\x084\xad\x16bY\x99\x90d\xc7\x83\xf3\x14\xff>\xb0\xf3\x8bc\xde\x99\xbf)O\x98-\x99\x9aL\xbc\xfe>r\x1e\xa4\xdeF\x9d\xa4\xb1\xc8\x1c\x1d\xbfu\x9c\x9c\xf2\xdf\xf8\xe6KR\xd2/\xd4\x81CT\x9e2t\xce\xaac\xf0\x96+e\x888`\xd7^v(\xe0$\xafL\x07\xc8MN\xbb\xdf\xdd\x03\x0b6\xff<\x1f\x1f\xc0\xc4\xb7\x1e\xfd\xe3\x89\xb8`\x87\x9b(jQe\x9b\xabpS\xcf\xde\x9d|\x0fWgg\x93\xa4\xde\xcf\xfco\xc2\xaf\xa1\xea89?\xed\xbeH9\xe1\x9aNj<t!%-C\xdf\xf3\xf0\xd3#T\xfc\xc6\xdbw\xe3\xe7\x7f\xddU\xf2G)\xc2\xabn\xa6w\xef&\x07N\xa1\x96Ot\x03\x9f(\xc2\xaf\xe9\xc1\xb7\x87\x87\x0f\xdf\xfe/\xf4\x803\x00\x8f\x82O\xee8\x1b\xa8\xa2\x82\x07rx`\xd3\xb5@KP\xdb\xb6\xb1\xce?V\xad\x82\xc1\xa0\xed\x8f\x93\xe7P\xd4I\n\x9c>\xe5\xb8\xbde\xc9g\xfcl\xc4c~\x8c\x93R\xee\xfd\xad\xcf=9\x92\x08\xf9\xe9\xbc\x92\xd2\xaeD\xdc\xd7\xc1\xc5M\xdeY\xc7o\xbcz\x07\xa2ku\xa9{\x10jr\xc9\x91\xba\xb6\x02s\xb4\x11\x0bJ\x9e+Oz\x80\x8dy{\xf0yRVf\xd9_\xcak\xfe\xf0w\xf2 \xb9\xb8\x86ra(s\xe7\xd7\xdc\xf5&~\xb1\xe5?\xbf\x1es\x84@k\x97+b31\xd1c\x1f~\xab\xb5t\xa2d\xc63!C\x19\x987\xc02\xbb\xad\xe6k\xca\xdc4\xbeF61\xf5\xf5\xb8\x0f\xa9\x0cm\xf8g\x03\x0eR\xacD\xbb\xe2'N\x9d}5\xb4\xac$2\x18Yr\x1d\x99w.\x9e\xbb%w\x1c\x0b\xff\xaf`2#\xd7U\xf9\xfb\xe6\xb7\xe1^v\xcd\xf1\x9a\xfa\x987QF\xbb\xe2\xb7\xa3\x9e)\xfc\xear\xc8\\\xd7\xc7>\xb1\x88\x0d0\x8f\xf5\xfb>x\xf0\x9d\xb4\xe1Jc\x04\xed\xca\xa8\\1H\xef\x8dG\x8c\xefS\xeb\x11w\x85e\xdcr\xe7\xd8\xb1Q\xde\x9e\xce\x1c\xba\xcaB\xa9C\xfa6\xdc\x11f\xe8m\xf5;%~\xa8\x88\xf5V\xf9w^\x80\x19\xa3E\x07N\xf3\x1b\x89\xf3k\xf0\xc0u\x90\xcc\xc9\"K\xee\x01\xb3\x89\x8fv\x98\xd2G=\xae)\x03\x85\xff\xfa^\x95\x8a\x8e\xf0\x9d\xff\xe1i!Z\xf6f9\xdc\x0f\xb8\x8b\x94\x17{\xff|\xf1\xcf\x17\xff3\x00PK\x07\x08\x0b{\x92T\x82\x13\x00\x00\xf23\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x001\x8dS]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pt_BR/common.jsonUT\x05\x00\x01NV\xd6j\x8cWA\x93\xdb\xba\x0d>\xdb\xbf\x02\xa3\x99\x9d$3\x99}\xf7\xdc\xbck\xed\x8b3\xeb\xb5k)\x9by\xd3\xd7\x03-\xc16c\x8aTH\xca^\xf7M\xfeH\xffA{mO\xbd\xee\x1f\xeb\x80\x04%9\xcd\xe1\x9d\x0c|\xf8H\x82\x10\x00\xc2\x7fL'\xd9\xce\xd8F\xf8\xec\x03\xfc1\x9dL\xb2\xad\xd0\x1a\xeb\xec\x03dw\xb3\xa7\xa7|\x0ew\xbf\xc1\x9b\x1b\xf7\x06\x1eV\x1b\xb8q\xe1\xe7\xf7\xec\xc6\xfd\x9ee\xef\x89_\xa3B\x1f\x17\xcc\xf3\xc7\xbc\x1cV\\\xd97(\x9c\xd1?a\x85\x0dI\x88t\xd9\x88=\xce\x87=\x17\xcb\xd9\xaf9\xfct\xe7@-Z#\x15\xda1\xb9X\xaf\x16\x8f\xf9\xe6G\xba\xc6\xf3\xda8\xef\x16\xba<X\x14a\xc1M\x0d\x1a\xcf\xd0\x1a\xe7\xdf\xbaw 5\xf8`\xbb\x8d'\x10\xeef\xba^\xd0In\xd5H\xcf7\xbd\xa9\xfb5B\xd7pSCp\x86\xf60\xcc\x1a6\xf8\xf9\xbak^g\xf7X\x93{\xb4\xfbzU\x94\xb0\xfe\xbc\xf9u\xb8\xc2\xff\x85\xddb--V~\xb1N+\xf2\x0dl\xf2\xf9b\x93\xdf\xd37(W\xfc\x95\xae\x83\x90\x96\x0d!(?n\xf2\xd9\xfcO,u\x07Q\x9b\xf3\x9dL\xe9Q|\x9c\xcdW_\xe0n\xf1g\xb2$\x86\xf5\xd1T\xc7\xd2\xec\xf7\n\xc7G\xdf\xb8k\x1f;=\xe4\xe0\xe7\xa7\xeb,\x8c\xbb\x9d$\x9e\xb1.D\x83\xc3\xf5\x0bX=@\xf91\x87b\xb6\xcca\xb1\x86/\xf9&\x87\xe7E\xfee\xf0.\x9bN\xbe\xbf\x9fN\xb2VuV(\x97R\xbe\x16\x97\xec\x03\xfcu:a\xf9}\x92\\6\x9dL\xfeFjv0\x9d\xedIA!8\xe2#Z#u\xe7\xb1'\xb2J\xa6d\x1b\x93\x8d\xf6\x87\x81\x1b4\xa6\x92<b:\xac\x8c\xae{*\xabdJ\xb6\x11\xf9\x82bp5(\xef{1\xd2R\x18(;S\x10D\xdd\xc8P\x9f\xb3 \xd0\x92L\xec\x0d!\xf4\x13u\x1dKx\xa6_\xff\xa3e\xc3he\xb4\xb7\xa2\n\xa9{\x9f\xe4+K\xac\x9f\xb1\x1d\x18\n4|iE\xb8]\x96\x07I\xda1>,\x8e\xd6\xab\xa5\x07YS\xb4\xb3\xdcQ\x80\x90\x17\xc6\x8b\xa4[|\x15Zzc\xc3\xf9K\x945\xbb\xfd\xb5s\xfe\xc9\x9c\xf9\x82V@\x83.]I\x99\xea\x18s\x94\xa5\x806\xc2\x1d\x89\xbe\x14\xee\x08;\xa9P\x8b\x06\x07SY\x92q\x83\xad\x12\x15\x06\xfb\x1b\x07D\x81\xb3\xf4\x07\x90\xde\xc1A\xb8\x03\x9c\x84\xea\xd22S\xa3\x15\xbdsI\x8bFs\xd6\x18\xbd\xbe3\xc2\xd6\xb0\"\x9dk\x11q\xa6\x14\x1d\xf7\x8c\x16\xbc\xa9\x0d\x87\xd2\x1d\xe2\x8d\xf2\x17\xb9Mat\xb1E\x12\x9b\xbbe\xe4\xfaP\x89\x85\x97\xd5\x91\xf2?\x8b\x95	.\x02\x81\xd2\xe9!\x12\xbd\x1c,\x17\xd3\x11\xf8\xb6\xec\xde\xa5\xaar\x17]q\xdee\xb5\xa4\x0f\xa2\xb1\xea[!\xabR\xef\xd9\xab\x8b\xae\xae\x94D\xac\x91\xb5i(\xbd\xcc\xcb\x06S\x92VB\xa1\xaeG\xe9\xfdIh\xce\xee\x07<\xb1\xb4\xec3~\xb6M\xd2RH\x96>ui\xc5\xa7N\xb14\xe3\x04\x9fd\x05z\x96V]\x92\x9eL\xday\x8e\x7f\x1f\xca\xec\x8cx\xec\xfd\x98\x9b\x869\x05\xeeY*9\xd0\x93\xec/\x9d\xe8\xa5\xe4G\x81/I\x12\xdbqUv\xb2/\xc9\xb6U\x17\xfe\xce3\x92\xc1\x1b\x10J\x85\xb7\x87?\xf8\x96R\xa3\xcf\x91\x84yo\x1a\x027\xa6\x16\xed\xeb?#\\	]aH\x9a\xfb q\x98\xb2Jx\xa1\xcc>\x1a\xa2\x18q%\xa9]\xdf\x0f\xcb\x08 \x1fx\xa7\xd1\xfb~\x97\xdc\x88O7l\x07oj\xa3\xe9\xfbes\xfa\x0dK\xb01_\xe9\x92Y\x1e\x84\x80Q\xb9\x94\xc6<\n\xbb\x0f\xec\x07\xa9\x10\xbc1\xa0\x02\xc2\x1c-\xdd\x81\xdf\x0e\xb4\x8d\xd4\"\x15\xf3\xde\x98\xbd\xc2\xc2\xe8=;\xfa\xadCh\x85\x15\xd0\xa2\xfb\xd6I',\xbc\x8d\xa4w \xa0y\xfd\xaf\x93\x95\xe0n\xd1\xb4\xc6\xfa{cm\xd7\x86&\xb6\x08\x00\xec\x84TX\xdfBp\xa5\x8af\x1e\x0c\xe2\x929\xdf\x8c\xf9\xae\xab*tn\xd7\xa9[(\x0ft\xfc\x9eJ_)\xd0\xe6\x0c\x16\x95\xe9\x07\x0b\xd9~\x14\x8eZ\x7f\xb6X\x87\xa6\xc0mG\xb8\xe0\xc0\xeb?\x94\x97M\xaaie*\xe1e\xec\xbc\x8fIN\x96\xe3\xe8%\x8f\xf5\x1b\x9fZ\xa0\xc2\x1dXX\x97\xe6\xaeO\x8b\xd2\x8a\x93\xa8\x0d\x08\x03v\x9c!\x8d\xb0\xc7\x021\x9c\xb4\x14\xf6\x08\xc2\x81#=\xec\xd3P\xef|\x96N\x1a\x9d&\x95\xd0N\xe1\x14\xb0\x11)\x02\xb1\x1f>K<\x0fI\x0b;k\x1a\xf0\x07\x04G\x8dq\xb1\xa6#\x82\x8a*4\x8b\x90\xdbi+\xbb\xc7\xe1zKRAjo\xf8\x8a\xcc2\xa71\xc9\x9c\xf0\xda\xdc9\xbf\x14\xbe\n\xc1^\x0b\xe7\xce\xc6\xd6\x0e\x08\x86&\xe0i8\x1c6y2'\x03\xfe\xf5\xdf\xad\xac8\xbdZ\xd4\xb5\xd4\xfb\x0d\xd2\xe4A\x94u\x04\xc0F\x84\x87=\xa9}i\xee\x87rZ\x13B\x05\x93\xb0a(\xe4G\x8c\xb6\"\xf5\x97\xa8\xffR\x96\x8fL\xa2\x89p\x18\x9a\xa3\x04;c!X\"\xe9[gx }6\xd5\xeb\xbf`g$\x10\xd6\x97\x85\xfda\x83\xeb!\xf0\xee2\xdcy\xc3\x83a\xf8T\xdb\xcbU\x0c\xd3\xd0H\x9e\xa2\xbdb\xd3\xd7B{MK\xef`\xa48\xf0T\xc1!\x0c\xe1C\xb7X\xc9\x9d\xc4\x1a:\xcb-\xc4\xe2\xceb,\x87\x0d\x8b\x8c\xb7\xea\x92B\x94\xba\x95E*N~i\x8d\x1d\xe1\xbe\xb3\x1c+o\xac\xee\xf1NaxD7A\xe0\xe7SX\xce\x08\xee\x0e	\xa7)\"\xb0\x0b\x16\x19w\x94\xe1\xf9K+\xf9\x9f\xc6\xa3\xd9K\x0d\x8c\x03\xb2\x81\xc9\xbeP\xe6L\x8f91\x0b\xf4\xe0\x949\x03\xbd\xf5\xcc8\x98\xf3\x93\xf1\xb2\n\x04\x96\xa2\xa5Ur4\x9b\x17\xa4\x82\xd9\xedb\xda\xd3_\x95\xf1wq^\xf8.z\x1b\xa5\xb8G\xb7\xfd\x8aq\x1a+XLx#\x13\xdc\xc8+\xf4\xc1\xd8!\xb7\xa39\xe4\xda8\xbd\xf9dj\x1f#\x1f\xfc\xa1k\xb6ZH%c\xeb-G\xfa\xed-\xb7\xbb8i\xdc_\xe8E\x19M\x1aU\x04\x98\xd2FK\xcb\xa9\xdb\xe9\xd4\xeeS\xb3\xf9\xcdtp\x10'\x04\xa1a\xb0\x8e\x1aF\xa7\xcf\xa9\xd2?\xb3\xc8[\x05e\x88+[\xaf\x82\xd9\xb5\xd4\x9f\xa9\xd7\x07F\xd0\xc2\x10\xc7{\x04`m\xcd\xde\xa2\x0b1\x8f\x08\xd6\xfd-\x7f8\xe4\xcb\xf8\x88\xe9\xe4\xfb\xf4\xfb\xf4\x7f\x03\x00PK\x07\x088M\x868.\x06\x00\x00n\x0f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pt_BR/server.jsonUT\x05\x00\x01*b2h\xbc{\xddn\x1c\xbb\x91\xf0\xb5\xfc\x14\x85\xf9p>\xdf\xcc\x91t~\x02\x04\n\x10@\xb2,\x1f%\x92\xachtb\x04\xf9\x01j\x9a5\xd3\x8c\xd8d\x9bd\xcfhN\x90wI\xb0\x17A\xf6vw\x9f@/\xb6\xa8\"\xbb\x9b=\x92\x9d`\xf7do\xeca\xb1XUM\x16\xeb\x97\xfa\xd3\xab\x83\xd9\xca\xf9&\xccN\xe0O\xaf\x0e\x0ef\x17\xa7\xbf\x9a\x9d\xc0o_\x1d\x1c\x1c\xcc.-Oa\xd4\xceB\x8b\x96\x0cDz\x8c\xb3\xb9L\xbe\xb5\xd1k\n\xb0r\x1ebM\xb0Dk\xc9\xbf\x0ep\xe1\xe9cG6\x9a\x1d\x9c\x86\x07R\xf0\xab\x8e\x02\xd3\x08`t\x88\x80V\x81.(7N\xa1\x99\xbd:8\xf8=\x13\x9e\xfd\xe2\xf6\xed\xbb\xfb\xbak\x96\x16\xb5	\x83,\x0c\x868\xc2\x19\xf7`\xd6\xd0\xba\xab\x10\xba@\x01>\xd0\xf2\xb6\xc0\x80\xe5\x0e\x14\xad\xb03\xf1\x10\xdeZ\\\x1a\x82X\xeb\x00z\x05;\xd7\xc1\x16m\x84\xe8 tm\xeb|\x04g\x14yXz\xb7\x0d\xe4\xc3\x1c\x16\xb8B\xaf\xc1y8m[C\xa0h\xa3+\n\x80Q>\x97\x1e[\xb2\x81\xc0\xad\xc0\xba\x91-D\x8f6\xb4\xe8\xc9V\xbb\xc3\xf1\xabn\x16\x17\x1f\x86o\xb9q\x91\xc9\x13\\8\x0f\x1f\x9c\x7f\xc8_s\xe6\xd0+@c\xdc6@\x83\x91\xbcF\x03\xb1\xc6\x08:\x80u\x11\x02\xae\x88\x85^\x12l4m\x89w\x12\x10\xb6\xce?\x00\xd9\x8d\xf6\xce6d\xe3\xc8\x17\xcd\x16w\xe1\xcaU\x0f\x03\xf7\x055\xad'\x16t\x83\x9e\x85\xf7Na\xfb\xf4\xf7,\xc4\xbd\xc7\x0d\x82\x83Pyg\x0c(\x84\xf6\xe9\xafkm\xb1@\x85\x86B\xe3\xa0r\x0d \xe0R\xa6V\x9dU\xae\xf8b\xb4\xce\xee\x1a\x1dh`|j\x9d\xd5\x8d\xfe\x01}fu\xedB\xf4\x08\xd1)\x17\xc0\x05h]\x88\xa8\x9c\xa7\xc0\xa4\x1d\xa0}\xfaO\xab\x1b\x17\n\xaa\x9d\xd2\xee\xd7\xcetMA\x97a\xb0I\xc0D9a\xf0\xe1\xc8\x02\xde\xa5\xa6\x0b\xba\x12\xe5\xdbhE\x0eZ\x83;\xf2\x13\xd2\xd1\xad\xf5j {\xad\xad\xc6\xd8y\x0c\xa0\x08\xde]^\x00Z\xdd\xa0\xc2^\xf9>\x830\x10]\xae\xaf\xbb8\x8a\xca\x038\xc3\xeaa\xed]g\x15\xfc\x9aE\xe9\xc9\xf1\x1c\xab\xd6r\x9c\x17QKjiAO\xee\x13\x94NU\xa3\xed\x97\xa1\xa5J\xaf4)h\xa8\xa1\x00\xadnI\x01\xef\xb8^\xd7\x11\xb4\x8d\x8e/\x82\x87\xc6Y\x1d\x9d/\xd8\xb0\x1e\xbe}l\xb5\xdf\x8d\xac\x18\xc6Z\xaf\xfd\x0e\xa2\x1ev\xfa\xa6k\x96\xe4y\xa7\x15\xee\x02lu\xac]\x17\xc1\xd2V\x8e3\xc0\x92V\xce\x13 \x08UVdE\x86\"\xa9=~7X\x1ci\xe2fqd\x83\xe98y\x7f\x98\xb6\x10;\x847h\xa1r6\xa2\xb6\xd0\xb5|3\xbe:\x06\xe3\xb6\xe4+\x0c\x04W\x18\xb5\x05C1\x92\x0f|\x95\xadH\x1b\n5\x15B\xf7:\x9a}\xe6Q`\xf9N\xf0\xefg\xec\xe7\x10\xba\xaa\x06\x0c\xf0\xfa\xd4\xea\xa6\x83\xff\x0f\xd7h\xd7\xee\xf5!|\x9fe9\x86\xaaF\x8f\x95\xf07\xce\xae'\x9cU\xb9\xbdj\xd73\xa3\xc7\x08K\xa7v=C\xde\xc7qY\x85m\xacj\x1cV\xbe\xc9\xe3|\xf4\xe1\x81\xad\xa1\x0f\xcc\xbfrM\xcb[\x0d\x08y\x95X\xeb\x8a\xbclX\xc4\xf0\xc06\xf9\x81\xc0\xd3Z\xb3f\x88A\xe6;\x12kO\xa8\xa0\xf2$\xb0g\xec\xefq\x1d\xf6E\x80\xc8\xc0\xfc\x11\xb8\x16\x11\x82\xeb|E\x89m\x16!8\xd31\xcdp\x08\xef-\xf1\"V\n\x8fV\xb9\xc6\xec\xa0\xaa] ++\x08\xab\x1a\xd6d\xc9c$\xd5\x7f\xc3!\xdc\xd1\xc7N{R\xcc\xa0?}\x8c`\x08C\x84o@\xe9\xd5\x8a<\xb1y\xc7uy\xd6|\x16\x8b\xca\xf9\xf1\xa8\xdf\xf4\xa7\x03\xa1\xc5\x06\x82L\xe6\x9d\xb4Q\x0bpKr_\xdc\x8a=\x95^\xed\xb4]\xf3\x86\x0e+\xc5\x02\xf3\x19\x1d\xc2\xe9*\x92\x07z\xac\x88\x14\xa3\xb1\xb6\x1a\xdd\xe8(\xbf\xf8\\`\xab\x8d\x01KI\xf8\xe0\xcc\xa68\x9dR\xd4.D\xd7\xbcY,\x06Q\xb3X\xdf\x07\xf2_*ZiK\n\xde,\x16\xe0;C\xac[\xa8H\x81\xb3\x10]\xdb+N C\x15\xef\\\xac\xa9\xa1\x17\x88\xdf\xbb\xf5\xbaP\xfc7\xc2\x94\xa9ffi\x9e\x05g\x92\xd58=\x90\xca\x1e\xb6\x14\xf4<\x812\xd3L\x88\x05\xe0\xe32\x0eU\xe1\x99\xe7\xb0\xad\xc9\x82u\x96X	\x02E\x9e\xe4\xcd\xaa\x8c\x9e8\xb2\xcc\xe8\n\xed\xfa\x19'\x83v\xdd\xe1\xbagv\x95\x87\xd0b\xf5\xf0\x02\xd3R\xfa\xd0\xa1\xafj\xbd\x197\xe1\xbc\x80%\xe1\xd5\x089t~\x0d-\x85\x8f\x9d\x0e\xc8n\xe1\xb2\xc15\xd9\xc2\x8b(\x1d8\xd4\xb8sK\x17\xc7\x1br\xebi\xc3\nYy\xdc\x1a\xf6:\xf3	\x18\xbb\xe8\xd8\xdf+\xd8\xd22\xe8H\x03\xe2hc\x02\xb1\x0c@v\xady\xb3\xac\xa2G\x99_y\xf6\xc2UE!$\x95\xd3![\xc7gB\xb1\xee\x88e\x1d\x05;O\xe2\xf2	|\x89\xec6\xb2\x99\x1e.\xfeD\xd0\x00hw\x80U\xe5:\x1b\x01[\xf41\xf1\xe7\x13{-\xeb_\x0f\xd32\x91\xe8\xd8\xf5h\xb4\xcb\xbdrv\xdc\xf7{\xf2\x8d\xb6\xe8\xe5*e\xb6\x17\xc4\x06\xc3	\x08pI>\x16\xce\x90\x14\xd9a\xf5[E\x16\xeeP\xe9\xde\x9b\x8e\x00\xb0n+\xde\x9e\xc5HQjA\x84\xef\xf6\x12\x8d\x19(\xfd\xbf\x9f\xf2\x10\xd0\x86\xedxPW\x1c\xb8r4\x91\xa0C\xd0\x9b\x91k\x0c5[\xdb\x06\xed'\x9c\xd2\xf1\xb0\x94\xad\xeb\xd7\xc7S\xcf\x10]DS\x9c\x175\xa8\xcd[\xef\xc7\xcfc\x00\xbc\xf5\xde\xf90\xbd\x9b\x82\xca_F2\xf9\x02\x91k\xd4\xe3\xd7	\xa1~\x8bxE\"\xf0\xc2\xb2[\x0c\x85\xfeb\x08[\xe7\xd5\xf3\x95\xd0\xe6\xa9\x97H8\x1f\x07\x12\xc2\x19\x02\xf9\x0d\xf1\x19\xfb\xf8\\\x8ar\xf6\x05r\x8bn\xf9\"\xb5O\x13\n\xddR\xb9\x06\xb5-\xa9=\xd6\xc4g3\x92z\xfc.\x01xz\x9c\xff\xc7W\x9d\x1e[\xb4\xea\xb4\xd0\x9d\xb7\x02\xd1\x1e\xee\x9d\xc20\x18\x87,\x9fL\xb2\x15\xe49\x0c\xa0\x13\xc1C\xce\\\xae\xe7p{~1\x87\xeb\xdbo\x80\x0dw\x07\xad\x7f\xfa\x8f\xd6k\x97\xc3(\xfb\xf4o\x0e\x02\xff\x83+\xe2(9\x1c\xc2\x8d\xdb\xe4\xa8Y\"P\xa1\xd7@\xc4f\xf9\xf4\xf7&!'\x19\xb5*chzl'\x07#\xc3!(\xef\x87\xe0\xda\xa7\xbf=\xfd\x17\x07\x8e\xe8\x11\xd0\x7f\xec\xf4\xa6\xb8~+\"\xc5\x91\xaa\x9c\xc4\xb0\x03\x17\x19\x9a\xce!\x93d\xbb\x03=~\x9aa\xb3\xact\xe0k\xc9\x0e\x94\xed\x07;\xae\xfd\xeb\xb92\x938\x83\xcd\x8e\xdfA\x82\xce{\x03&Dx\x17\xc8CU\xa2\xb0;dx\xf1\xed+\xe7+R\x9c\x89\x8c\"\x0b\x08\x18\xb6k\\\xd7\xdf\xaf\xde2\x8a\xd7\xe6H4\xcc!z\xddVN\x11\xdbA\x95>\xe4%&k4\x86\xfc\xee\xda\xa9\xd1\xbc\xbdK0\x10 c\x1d\xcc\xde[\xb3\x83P\xbb>R\xce\xa1\x0c_f9\xcaB\xec\xb5qK4\xe7\xff\x94o\x81\x84lv\xffb'\x93]Uq\xb5\xd6\xce\x95\xe1\xc4\xbb4\xe4\xa9~\xf0y\xdfYk5\xb1\xe9\xa1rIwO\xe08\x93\xb9\xd2M\x8b}\xae\x18\x80\x06\x94	\x15:\xd3\xd6\x92\x1a6\xff;\xad\x08\xceS\xda\x01\xb7\xbc\xd9\x99\x9cL\xb0\x05\x175I\xa9v\x8d\x1b\x82%\x91}\x9e\xa8\xd4Z\xd1$\x93\x97\xf5\x0ca-8Bc\x8eJ\xc2\xe2J\x03G\xb8kR\x80E\n\xcf\xfeC\x92\xf6\xc1{\xcabh(\xa2\xac\x9a\xf2\xbc\xa3\xaa\xf3Ao\xc8\x8c\x19\xc3\x00\x13\xd9\xa1\xd6\x1cq\xbe\xf8Y\xf3\xf4]\x9eZ\xc3\xd9`t\x80\x8c\xce\x0eR\x96\xfa\x9e\x92\xd9M\xd9J\x19fT4\xf9\xd8\xa1\xd2Q\xdc\x13\xb9\x80\x08\xbf]\xd4n\xfb{Xv1:\xcb\xb7\xba5X\xe5\xb4iX42p^\xff\xc0\x1aon\xdc\xf66\xb9\xe7\x91\xd50\xf9\x82\xf7\xde\xe7\xfc\x02\x06hk8L\xf2\x18kbW\x8d\x16B\xc4\xea\x81\xd4K\x12\xb0J\x94\xecy,\xb6t\x94#\xf3\xbc\xe1\x19\xb3\xd6\x08\xca\xc17?\xadj,r#=j\xdc\x95[k\x9b\xd7\x14\x08|\xad\xbfs\xec\xb3zD\xf1\nb\xaa{\x17\xd3\x00:\xb8E\xed\xf7\xeb$\xad\x7f\xfa\xfbF\xe3h\xebm`\xd46\xa1N\x99\xdc9\x17\xdfo\xc8{]\xd8 !\x0f\xde\xb9\x08\xae\x9fK\x1c.sML\x87\x9a\xf5\xa3f\xb5\x10:!_}\x08\xc4n \x12\x18WId\x98\x12G\x847\xe77s \xcb\xc6\x97\xad\xf8\xaa3&\xb1@\xa5<\x85\x005y:\x84+\xe2kEM\x1bw\xcc\x81S\nF\xcfa}\x8f|\x08o\x1f\x91\x93\xd6\x13x]\xc7\xd8\x86\x93\xa3\xa3$\xc7a*\xf5\xb1W~\xbd\xf7\xad\xd3\x94.}\xe4?\x93\xce\xb1\xf2\xb3V\xa1M\xdf\xfac\xe6o\xba\x99\xf8\xd8\xcbf\xe2c/\x9b}\x1f\xab\xe8\xb9\x87M:|\xa1GW=V\x9c\xf2\xb9\xb1\x894\x12\"G\xdd\x8a\n5=\xca\xc9\xef\xec\xad\x14b\xf1\x04\xbe\xfa\xfa'\x8f_}\xfd\x939\xc7\x06hkW\xb0\x83V\x90\xdc\xcf~g\x175\xfa\xb6@nP\x8a5\x11M\x8d\n\x7f\xf6;\xcb\xdc\xac\"\x7f\x92\xed\xee\xf3X\xe6g\x85\xf4\x1f\xd5\x18\xae]\xfe\xea\xfc,\x8b\xcc\xf0\x7f\x1c[\xfd\x11\xa5&5\x1a\x9f_\xf4\x80y9\x1c\x92\x8d\xcb\xf3p\x08=\x0eTh\xc1\xb1wM\x16\\N\xba\x0c\x8d\x8d\xb6\x0f#\xe9\xb7\x8f\xd1\x93E\x03	\x9c\xf5E\xa99xj\xdc\x86\xb8pDJG\xa0\xc7H\x9e\x11\x7f\xfe\xf3\x9f\x1f}\xcbw\xff\xe8\xcb\xb8k\xb9|\"\x05\x87\xaat\xd9\x0d\x86\xca\x15G\x97\x86\x93\xd8=\xa1\xf4q\xd0\xfeRQ\xe4a}^)\xc0\xfe\x02!{\xac\x9eL\xb9<vE\x8d\xe3Z\x86\x12]pF\x9d\xe9H\x01~,K\xf0\x8f\xec\xd0\xfb8DJ\xd0\xc5Jvq+\xedC\x84\x8d\x0ez\xc2\xef\xf1;\xb9T\x03\xcb$e-\xc0t\x812\xdbk|\xd4M\xd7\xf4Sn\x05]\x9bK\x17\xfb!O\x83\x8f\x0b\xfd\xc3\xb3{\xad\x7f\xa0\x17)\xca\xc4sz\xec\x86\xae\xcf&\xb2~\xd0*\xd6{d\xb7\x0c{\x91n\x9a\xf9\xac\xa0\xb4\xee\xee\x7f=\x10\xbcN\xc3\x1c{\xb1\x7fJ\xa5\xad\x14\x0d\xf4U\xd9*\x95vE>\xb6\xc5\x8c\xc7n\xb4\x0c~\x13\xe1E\xed|\xac\xba\xf8\xaf`\xe0\x14\x97\xd6\xcakv=\x82\xe6S\xc0\xf4\xaa\x8dxr\xd9\xca{6g7\x9d\xe3\xf1\x14(+-\x86\xb6c\xef\xd2\xc3S\x99G{h,q\x01\xba\x92+<~\xbc-\xcb\xc17{\x85`\x0eab\xe4\xea`tc\xa9\xb9XL\xdb!c\xed\xb7\xed\x86\xb6C\xae\x9a\xbfm\xba\x80\xab\xac\x03\xb2\xdb\xb8\xb2\x10q\xba\xf4\x04]\x03v\x80\x8fK]\xd4U!j\x1a\xa6erve\xb3\x0b\x0d\xb7N\x02kr\xbe\xf5\x1c\x18\xc6!\xff\xc9\x85\xbb\x96\x8bY\x13\x0e+\x9d\xbc\xee\x84\x0f\x03s\x8e&\xf1mx\x88\xae\xcd\xac\xef\xc9\xd6\xdc\x97\x99`Y\xb6\xfc\x82\xc5\x81\x03\xdf\xfb\x8f\x9d\xe3d\x12\\\x07\x1f;\xd6S\xee\xfc\xecl\xb5\xc5XII\x83\x9e\xfeV\x06\x17\xce\xa8g;\xfb\xde\xa8O\xefl\x1fh\xbcy\xa1n\xdc\x07(\x90'\xe1~\xcc\xeb\xae\xb1m\xd99\xbb\xd5\x10F;\x8e\xa4\xc3\x9e\xf1\xeb\xab\xc9l\x9dx\xeb\xf2\xfd\"\xf5\xac\x08\xe6\xb6\x1c\x19\x8eR\xa7aN\xc5x0\xd5p)\x94AZ\x94r\x02	mR\xa5\x8d\x85\xe0\xf8:\x17\xcb\x9c\x8d\xde\x99p\x08\xd7]\x88\xcfK\xd2\xce\x96Y\xd2\xb0U\xbd$\xb7\x9f\xd2JV\xc07\xb9\x107\x0drXY\x87\x1a\xdd?\x15\xec\x0c\x858\x1c.\xcc\x8f\x19\xef\xb0\xa8\x97\x12\xafH@;\x06\xc2	(I\x17\\i\xfb\x002\x1f\xc6\xd2bF`\xdfKJ\xee\x16t\x1cd\x88\x082\xe4)>\xde\xca\xe8\xea\xe1\x10>p\xc58\x97Y\xd5\x1c,n\xf4\x1a#\xc9\x99\xc4zJI\xdb\x10	K-h}gi\xaf\x08z\xcb\xb0\xacfy\x07S\xb28\xa8\xde\x90\x17r\x13\xb6F%u\xd0~\x1fS9\xf0F\x1a`{\x8c\xee\xa5\x8d\xb2\xcf)f\xe8\x84U\x06~\x82\x17\x7f\x8d\xd8\xf2glv\xdd@}a\xba\x08$\xad\xee>\xd2<w\xf6u\x04\x0c\x0f\xa3`\x9e\x8b\xa2\xc3\x9a\xbb#<\x1a\xab\xa6y\xf4BJU\xae\xe7N\x0d\x9b\xe4\xef\\W\\\xa8;\x81K\xa9\x04j\x99I\xdfw\xd7wv\x9c\xad\x08hC~\x07_}\x0d\x18\x82^s\xeb\xc4\xf6\x16=\xfb\x06\xfeJ\xcc\xdbQp]r_ldF\xea\xe8\xcct\x04\xf7\xe3\x8b\x84>\x1f\xe4\xce\x10WY\x97<\xcf/\x16\xb8\xe5\x9fL0\xe7\xff\xdc\xa2\x84\xd7\x7f\xf0\xaf9\xae{\xfd\x87e\x91N0K\xae\xca\x14l\x90\x9b*C-\xa5/\n\xb5\xe5\xfd+\xd7s\x1b\xad\xc8\xee\xeez\xc0\xfe\xd5\xf6d0\xea\x0d\xdd\xeb\xc2\xcd\xbd\x0d\x11\x9b6%x\x91\x9a\xd6AF+\xe2\xfdObp\xeatx\x02\xaf\xbf\x82\xdaq\xbd.\xfa\xa7\xbf\x86\xc9\xb7\xb5\x84\xe5\x06\xca\xf0\xb9\\\xad\xd9\xddM\x82\xb9\xdf\xf2\xddE\xff{x\xfa\x0b(\xedI\xc7\xbe\xd9x-\xc11,]\xcc\xf9k\xc2\x04\xce\x14\x01{\xe4\xe2	A!\x8ds\xf1\xfb\xbb\xabQ\x1cNJ\x190\x9f\x0c{\x87(1W\xee\xf9\x0e\xdd?.\xa2\x04\xd7\xe4\xd9\xbev\xd5z\xc7\xa1U\xea\x80n\x9d\x7f(.\xbf\xf4\xcaF\x9e2*\x9f^\xc8\xfc\x0b\xa5I\x86s\xf7\x0f\x0dl\xb5Un;\x92\x0ce\x90\xbe\x18\x9bP\xe7|\x89\",\xbb\xa6\x95/\xc8\x0d\xd5\xe8\xf6}\xbd|U_D\x1clz!t@3\x1e\xdb\x82k5\x04\x02\xcb1\x06\x9a\x98\xb6\"M\xf55J\xb9\x02Cp\x95\xbb\xa8L\x19\xee\x88[\x15d9\xeaNoI\x86\xe6\xe9\xb7\x9fkX\x07\xec*\xb28\xda\x8e\x05\x03nN\xdfgI\xfa\xf9\xc3\xca5\x9f/\xf4\x05nV9\xbb\xf7\xc4\xe04\xbb\xdf<\x9b\x1f\x1bd\xe2|Q\xd82\xb0\x91\x85\xceFm8\n\xc8>{\xc9\x9d)O}\x89SWh\xcc\x0e\x8c\x93\xba\x9b\xeb\x8a\\%\xd4\xd8\xe8\xa9\x05\\\x8c\xa0\xf9\x1e\xe0\xb3v0\xb4N\x9b2\xa2H\xa9\xc4\xa2\x07\x97\x068\xe3>\xcb\x1dB\xc4\xd5j\xfa\xfa`\xc1 \xb8/^\x1f\xf4vM\x1ek\xc8\x8a\xf48\xa1\xd7N1F5\xa1*\xa5c\xbb71el(_2e+m('7\x85[I\xcd\xdbqm\xd3\xdf\xf9\x05\xf7\x945\xf7k\x1d[ \x9c\xb6\x83\x93\x96\xa7s\xbd\xc6\xc7\x81\x80\xe4}\xf2\x96\x89M\xec\xff\xea\x19I\xbeH/\xbd#\x99p\xd7c\xac\xcce\x93\xff\x03\xee\x93c|\xfe\x88$%\x03\x8aB\xe5u\xcb\xb6?\x9fb6q{e\xdf(\xad\xf7\xacL\xc3\x97\xe41X\xcc\xdd\x9eL\xfb4\xea\x0d\x0eZf?\xd11\x8d\x1e+j\xdch\xaf\x04p\xc8\x90\xf9t,O\x9d\x88\xdf\x80\x11\xd9Ps\xd9Z\xda\xcf\xa3||\xf9\xce\xde\x0d\x94.\xf8\xf5\x17\xb4\xe4\x83\xb3h\xf4\x0f\xa8\xfa\xdb\x94D\xcb\xef\xc3\xa6\x18/z\x86D\xf8\xd3\xc5\x8f&\xb9\x97. w{\x9aLx\\/\x99K\x8e\xbb\xde\xdb;\xf6g\x03\x9d\x0f<\x975H\x1a7\\\x13\xef-\xcc\xe9\xc4x\xa0\x1a\x1e\xc1\xb0\x1f\xe1\x85\xa4\x9e/\x1d\xd9\xd2\xb2\xf9Le\x97[|E\xb1\xf6\x93u\xdd)\x1e\xdb\xea\x8f\x1dy\x98\xd0\xda\xaf\x12\x83\x84\x08\x93\xc7w\xec\xf7&\xed'~e\xc8\x1e\xac?i\xae\xe5\xe7\x0e\xe4\x90\xa3\xb39\x08\xc9\xaa\x8eO\xd0\x9e\xd3\xdc{4\xf2#P\xde!7\x9a\x86\x8d\xfbM\x1a\xa6=J\x83\x89\x8b\x97\x85\xaf\x0e\xfe<\x7fu0s-\xfb\xb4\xe1\xf5*?$\x99\x9d\xc0\xcc\x92\xad\xbbt9fI\x89\x19\xba\xe2\x878.ku\x9a\xdc\xe6b\xd0\x0c\xff\xd8I\xec\xf2\xf4\x170\xe8\xd7\x9d\xe7`EX\x04\xe7#\xefeo\xe8gg\xe2\xd3\xfb'p\xb3+~u$\xda0\x9a\x94Y\x9f\xb6\x15 Q\xc5\xd4\xa0L\x0b/\xd8\xf2\xa6\xf1+\xd9\x89Y\xc4\xa1\xdd2{G>w\x1df\xa7-\xfa\xa7\x7f\xb7\x95NFxv\x9b}k\x1a\x9d\xeb\x0dy\xee dd\xae\xd7J\xbb7\x91\xa4\xa65\x9c\x1e\xf5[\xb4D{[\xde\xad\xdf\xb8\xaehyI\xffD\xa5\xa2\xff\xd1\x17\xe1\x88\xeb4_\x8c\xef\x1dV\x8e\x1f\xa7\xb27\xf4\x84\xc1\xd9\x93\xfe\x94\xd85q\xd9G\x9e7\x89y'\xbe__\xc8\xab;m\xe1\x8bp\x98Q\xd9\xcb{\xeeU\xf1\xbd\xe2e\xa9\x89?O\xee\xed\xf2\x96-\xfb\x17\xb9X\x9bO\xa0\xd3\xbd\xf0\xe9ur\xf90Y\xa8\xcer\xe6\xces}\x14\xc1\xe1\x8f\xd8Th\xd0\xb2\xa9\xec\x8b\x9f3T\x9c\x9e\xceN\x95\xca\x8b%\x13\x11\xbf+p\x19&G\x9b\x10\x96(\xeas\x86\x99\x9bl\x92_\xb4T\x85\xcc\x91\xda\x18\x8a\xb7\x1e\xfcXy\x0e\xb77\xef\xe6\xf2n\x94_\xf9\xf2\xbdfW\x9b\xbc\x0b4\xb9\xbc\xa8tC\x92\x0d\x07\xf6\x03\xdf\x1c\x1f?~u|<\x1f\xa6y\xc5P\xda\xfc\xe6\xf8\x18~y&\x1ff9\x17\xef\xac:\xcc\x02\xb1\x89\x9b\x9d\xedf{/\x04'\x8f\x03\xf9\xf9\x9b]SQ\xbe\xe1\xe7ovM\xd3\xe2\x0d\xa3y\xe9\xb43\x81\xd3\x86\x7f\xb1lE\\X\xc6\x1d\xcb\xfe\xe1\xe2\xac2\xbc\x91\x15\xafz\x93\x7f&\xb6\x86\x90=\xd8\xec\x8d\xfcH0gWz\xdd\xf9\x94\x89\xcbd\x0f\xc9\x8ep\x8a\xb6\x10\x1d\x99\xe2%\xbd\xc9\x88|\xdd\nb2,))\x8c\xfcdrv\x8e9{\x99%\xff=,)\x13\xfe\x12\xa1wD=\x82\x18\xa2\x12!\xd7\x0d\xfb\xf9\xa1h8S\x9d\xefKv\xb3\xf3\xfew\xffpD{\xb1'\xdc\xfe\x93\x9f\x02\xef\x9fH\xb0\xa0\xfdC\x8a<\xd3U\x0f\xef\x93z^|\xff\xe6\x97\xf0\xfe\xe2\"M\xa4^?/x\x97~	T\x1a\x91\xb3\xcb\xf3~D6\xea(:r\xd9\xffN3\xc6\xd0:-\xbfL?'m\x82\x19\x07\x85\xbd\n\xf1\xde\x1b]<\xa8\xeb\xd5l\xc0\xd4v\xfd\xecN\xe0\xb3\x0b\xf09\xfd\xff\xf6\xf8\xf8\xf1\xdb\xff\x81\xfe\x1b\xb7\xe6(\xff\x04\xb8\xef\xca\xbf\xb2L\xfc;\xbd\xcf\xe1	\xce\x04\xa4j\x97\x9f\xf2'\xac\xbeD\xda\x1f\xd5M9~\x86q\x7f\xcf\xc4\xe4\xc9\x88\x90\xe2Z)\xa9\xf1\xc9-\x87\x07))]v\xc6\x84ZgYF?5{\x9f\x7f\nm\xb7\xb57n(G\xb1\x1d\x05%%\x1b\xb7\xe5\x87\xb8\xbb\xb2*%E6\xc6\xba\x1dt\xac\xed\xfcz\xd0\xc0[\x1e\x88\x02\x1e\x15Z\xeaIiOU\xbc\xbc\xe5\xa5wy\xc4v\xfd\xf2v\x8a\x91\x82\xa6}\xac\\\x88\x11\xcc\x14\x04\xde;g\xa2n\x19\xf1B\x9b\xd4\xf8\xe5\xef\x96\xa2~\xe8\x96\x7f\xa4*\xce\xf3\x0bf\x9fm\xb0\x14y\xc8\xf2\xabl\xf9\x9b\x05\x0e0\x82\xc1PS8\x84^Y<\xad;\x83\\\x90l\xb9s\xcc\x1b\x96\x0f8P<\xcb\xbd\xff\x13\x98-(\xf6\x8d\xfea\xfa*)`?\x9d\xf5Q\xe2\xd8\xe2\xe6\x85\x1a9{g\xa4\xf4\xab\x80\x9ei;N\xc02\xb7\xef\x07\xf7/\x8b\xf8\x0fE\xfa\x18p\x99\xed^\x8e\xba\x07C\xd1\xc7\xe8\xc5\x19\xe4M\xc9\xde\xa5\xb31\xfbj\xd6\x9el\xd4,U,&\xbb\x9d\x98\xdf \xc9\xf4`\x89\xbf\xb7\xfac'\xcd9F\xe5\x12W\xc5I\xc4\x91\xbc\x1dd\xbfYD\x16\x9c\xfc1\xdd\xa186\x930\x84!C8\xc2\x8dK\x81\xf0\xff\xb2\xaa\xb3\xd9\xd1}/?\xc6h:Y\xde\xa4\x1e 14\xf9\xd9\xab\x83?\xbf\xfa\xf3\xab\xff\x1e\x00PK\x07\x088,\x08\xa1\xf6\x12\x00\x00@4\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x001\x8dS]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/ru_RU/common.jsonUT\x05\x00\x01NV\xd6j\x8cW_o\x1b\xc7\x11\x7f&?\xc5\xe2\x00\xc1	`$\xef~\xd3\x1f:& Y\x04I\xdb(\x9a>\xacy+\xf2\xac\xe3-{\xbbgF\x08\x0cP4b\xb7\x95a\xa7N\x81\x06\x85c\xd7H\xd0>\x96\xa6E\x8b\"E\xea+\xcc~\x85~\x92bfwyGUh\xf3t\xbb\xb3\xf3ogg~3\xf7m\xb9\x14\x1c\xc8\xb4\xcbup\x8b}[.\x95\x82\x87<ID\x18\xdcb\xc1\xd6\xe6\xdd\xbb\x95\x1d\xb6\xf5\x1bvcC\xdd`\xb7\xf7\xeblC\xd1\xe7\xeb`C}\x1d\x047\x91?\x14\xb1\xd0V`\xa7\xb2[i\xe6\x12k\xe7u\xc1\x95L\xae\xe1\"\x85\xb8\xb0\xecQ\x97\xb7\xc5N\xae\xb3\xba\xb7\xf9U\x85]\xab\x99X\x1b=\x19\xc5\"-27j\xfb\xd5\xddJ\xfd*{\"\xfa5\xa9\xb4\xaa&\xcdN*8	l\x84,\x11}\xd6\x93J\x7f\xa6>gQ\xc24\x9d}a- ]m&a\x15-\xa9\xfdn\xa4\xddM7\xc2\x95\x0cOB\xb6\x112r\x06uH\xc7\x95+\xb8^n\x9d/K\xdb\"D\xf7P{m\xbf\xd1d\xb5{\xf5\xaf\xf2+\xfcW\xd8S\x11F\xa9h\xe9j\xcdKT\xea\xac^\xd9\xa9\xd6+\xdb\xf8\x06\xcd}\xf7J\xebA\xf0by\x08\x9aw\xea\x95\xcd\x9d_!\xaa:<\x94\xfd\xad\xc8\xa7G\xe3\xce\xe6\xce\xfe\x03\xb6U\xfd5Yb\xc3\xba+[\x87M\xd9n\xc7\xa2hzC\xad\xfb\x98%y\x0e\xde\xbb\xbb\x9e\x85V\xdb\xe3H\xf4E\xd8\xe0]\x91_\xbf\xc1\xf6o\xb3\xe6\x9d\nkl\xeeUX\xb5\xc6\x1eT\xea\x15v\xbfZy\x90{\x17\x94KOn\x96KA/\xceR\x1e+\x9f\xf2!?\nn\xb1\xdf\x96K\xa5R\x00\xa70\x81\x85yA\x86h\xbb\x80	\x9c\x07\xe5R\xe9wH\n:2KW\xdc\xe69\x8c\xcc\xb1\xe3\xb5\x1bX\xc28\xe7\xeeFI\xa6E\xae\xfd\x02\xa6\xb00O\xcd\x10F\xc1\xcd+\xa4\x82\x94Lt\xa7(41\xc7\xe6\x95y\x16\xdc\xbcB\x80I\xd1\x98\x12-\x99\x84+9\xb7\xb52v\xa3r\xe6#\xc1\xf3{\xc0GX\xc2\xa9W?\x87\x89\xf3\xc6\xc7\x0b\xd3\xd8G\x8b\x87\xdd\x88\n\x19\xbe\x87S{!\x12\x0cx[\"\xd9\x0ca	\x17\xe6\xa9#&\xd23/`	\x0b\x98\xc2\x85=i\xc9D\xa7\xbcE\x19\xbf\xed\xd7k'\xb6\xec\xf0\x1c\xde\xc3\x18&f`\x03e^0\x98\xc2\x19,\xe1\x83\x19\xc0\x08>\xe1\x93\xc1\xd4\xbc\xb2\xe2\xe2\x9b\x1e\xa70\x04\xf0w\x18\xc1\xd9\xbah\x91\xa7`\xe0\x1a\xce\xffi\xa4\x13\x85\xc2y63\x03s\x92\xabv\xc1\x19[C\x8fx\x12i\x99\xda[\xbc\xc3\xc8\xc0\xd2\xfc\x91\xe20s\x1c\x99\xd2we\x7f\x15\xba\xb9y\x013X2\xf3\x1c#iyb\xd9:\xb45\xe3VD\xedru\x88b{\\\x1d\xb2\x83(\x16	\xef\x8a\xfc\xa8\xd9\xc4\xc3\xba\xe8\xc5\xbc%\xe8\xfc\x86b\xc8\xc2\xfa\x91\xee\xb0H+\xd6\xe1\xaa\xc3\x1e\xf38\xf3b2\x14)_\xf9\xfb\x06\xb3\x82\xe2>B_\xcc\xc0r\xc9~\"\x1c\xc7k\x98\xc3\x88j\x06\x93\xe6\x19\x83SX\x9ac\x98\xc1\xd4\x81\x86\x10\x9bq\xec\x02\x85W\x1f\x9a\x01f\x17>\xe1\xd8\x1c\xc3\xc4c\x8b\x0d\x00EhFO1\xca#\xaa,\xc6;-\x97\xb0\x84s2\xe7\xdc\xd1\x84(\x0d\x1d\xb5\x0e\xb1\x8e\x03xg\x060\xc5g\x81	\\\xc24W\x94%y Wkr\xe0HfH\xfc\x0c^\x9b\x93\xcf=L\xa8\xa3\xa4\xe5J$@\xd7a\x06s\xf3\xd2<7\x7f\xf6)O\xe5>\x81S\xacj\x9b\x1f\xfeF\xe6\x18i\xe6;3pY\x8f7z\x96'\xe95\xe7S\xe2\x1d\xc3h\xa5\x1d\xe3~\xfc\x7f8\xcbT\xcf\x81\x8e\xba\xc2Wh\x8b\xc7\"	\x8b\xe5\xfd/X\xb8\x84,\x05\xf0\x0b\xa1\x86\xab\xf570rq,aA_\xe6\x9b70\x82s\xbf\xf9\xd1\xbc\x84Eq3\xf7'\xdf\xc3\x18>\xfa\xcd{\x8c\xb8\xdf\xbc\x85\x99\x19\xfa\xcdO\xb0tW/\x05\xf0\x17\x98\xc0,\x87\xa2\xbe\x10\x87\xb9\xaf\xaf1\x7f<\xe7;X\xe4\xb8\xf4\x1a\xb3\xc7o\xde\x9bA~\xf2O3\xcc\xaf\xf4\xce\x0cs_\xdf\xc3\x87u,\xcb\xa2\x15\x90\xf5z\xf1\x91\xcb\xceM\\3-\x19\x8fcj\xed\x8a\xec\x04\x0f%O\xa9\xee\xb6h\xe1hZ\xcb.\x12\xe1'\x84\x08\x07Z<i	\x9b\xe9o\xcd\x101\x1b\x16\xc5\xdckq\xcdc\xd9&\x86\xbfav\xc3\x08\xe6\xb0t\xb1\x0bZq\x84\xbdq{\xa5e\x1b	\xe8\x91S\\\x18\xa6\xb6\xbcS\xf03\x9c\x92\x1a\xb2\xb3*>\x8f\xbd\xa1L0'\x02\xf8\x81\xb2\x17s\xcb\xe1\x89\xe8\xcaG\x18\x87\xa0B\x0b\xe2F\x80hJ\xb9\xcb\xd36I\xdd\x8eb\xc1\xb4\x94,&\x8a\xe3I\"\xd5\xb1H\x04\x7f\x85\x91\x85V\xf3\x07\xca}\xa7\xbb-e;\x16\x0d\x99\xb4]\x88\x10;/\xd0E\x988P\x83\x0f\xe6\x84\xc1\x14\x1f\x1a#\x81\xae_b\x8f\x83\x85y\xe9\xc7\xc0\x9eL\xf5\xb6L\xd3\xacG\x1d\x02~\x84\x0b,}30C\x86=\x99\x99\xa7\xf6\xf2\xd8	\xbf`\xf0\x0b\xa6+\xcc\x192\xc1\x18Q\x06>\xc1)\x96\xaa\x1b\xe6\xac\xca\x1d\x1f\x935}g\xf9UH\x82\xc1{\xcc5,E\x98\x9ag0b\xf0\x81\xecM\x90\x1b}E\xfd(\xf5\xd1\x0c\xccS\xd7\x81F\xdeR\xef\x0eW\xd8\xbf\x83j\x8d \xd6\x818W\xda\x83\x9c9F\x04\xa3\xe9b\xea\xaa\x0c\xa1\x9d\xeb\xc8\xb6\xcb]\xbf^\xc1\x7fab#\xbcs\x93*CD\xcb\xb9D\xd8\x94[y~\xe2\x13\xad\xe1 \x831\x99<\xf39\xd2\xe5\xe9aC\x082\xba\xc7\xd3C\xc6\x15S\xb8\xb7\xa7\"\n\xe5\xfdHE2\xf1\xc3\xe9\x1e\x92\xd8c\xa2\x15\x98,\xc1\xb6\x9c\xfb\x91\xe8\xe7\x85\xc4\x0eR\xd9e\xba#\x98\xc2\xdeS\xad\xa1	\xda\x8aX\xb4\xb4\xb0#\xb1W\x95\xb6E~\xd3=\xdc\xb2(\xd1\xd2\xdd\xd6q\xc9\xc7E&\xf9X\xac\x1fgJ\xefq\xdd\xea\xb8`\x8f\x08W\xe70\xa5\x02\x819|\x82\x859a\x84\xddc\xb8\xa4\xfeU\xe86\x89\xe8\xe7\xca\x11\xb7`lN\xe0\x9cQBL\x1c\xe6\x04=\x91\x84Q\xd2\xae\x0b\x9cE\x91\xb3f	,\xb5\x147\xfeG\x89n\xca\xedB\xe9\xbfs\xb9sn\x86\xe8\xd0\x8c\xc1\xac\x08\x06\xfeY\x08\x82\n\xe3	\xa5\x8c\x19\x9a\x93/	<\x06(\x0d\x0b\xec\xb3_6\x9b\xbbN&K\xdb\"\xff\xcf\xb2+v SF'\x96\xe9\xf7\x99t\xff0\xf0\x1a\xbb\x0bC\xc8\x87%\xb5\xa6a\xa1\x05\xcd}\x07O\xaf(\\\xff\x8f\xd8:\xcacUw\xff\x16\xf4\xf4\x0f\x8f\xd6\xde\xc4\xffw`\x16\x89t\x8d\x1b\xaf*\xd2u\xb5~t\xb1,\x8ai\x9e\xb6\x85F4\xa4\xc4\xe9\x89Vt\x10\x89\x90e\xa9\x03\xc6T\x1c\xa4\xc2\xd6\x1c\xbc\x85\x0f4k\x8e\x8b\xad?\x15\xbd\xf8h\x85\xceX\xeb\xc3+\xc72]U'|r\xe0<\xb6\x89\x81\x10\xe3\xed\xe8,MV\xc0\x86\x08\xe0\x12\"\xcdb\xa1\xae\x9bal\x84\x11_\xa68,\xb9\x81G\xf0t\x95\xa0K\x0b\x85\xfe\x04\x87x\xd2\xd4pKGWXr\x95oz\x91\xfb\xdb\xc5nk\xc7\x03\xf3\x8a\xd0\xd4\x0c\xb1\xaf\x16\x8d\xe8F,\xfb{\xd2\x8e\xaa\x0d\xa1\x99\x8ae\x9f\xe1\x94\xe7\x94vd\xff\xae\xd4QK\xf8\xc8\x99?\x99W0&p*L3\xaa\x17G\x85\x7f\xc6\x06n\x99<8\xb0\xb5\x89\xbf\xd0\xc5\xc7V\x9a\xeb\xcc\xde\xc0\xae\xac\x8e\xec\xe1#a\xc7\xfd\x86[zz7\xd2\xab\xa7Y\x05+\x7f\x1c\xcbq[\xa6y\xb55\x88D\xc9],8\xe7\xc5-\x168g\xc9\x82\xeed\xdd\x87	\x8f\xe2\xc8\xf5\xa4\x1f\xa8_a!\xba\x99\xcc\xbe\xd1\x04\xc6\xe6\x85y\xf9\xef\xc1?\x8a\x83\xe5\xf6\x11\xf6f\xd2i\x81\xb7e	\x8e\xa5\xe7\xe6`\xec\x83\xdf\xf9\x1fX\xdf'=h\xc2\xcf\x08\xbcTn\xf8d\xd4\xf1\x16\xae\x83\xb8\x16\x8a}\x07q	\xce\xa9\x85!\x97\xd7\xd6\xf7`v\xcf-\x8b\xf4\xfcU\xdc\xe9\xdaSd\xbdX\xf2\x10[9q\xd0\x8e~\x05\x9c\x0e\"\xd4R\xd9N\x85\xa2\x17+4\xb53\x84\xa6U0\xae\x18{P4U.=)?)\xffg\x00PK\x07\x08\x06^\xcd\x00\xf3\x07\x00\x00L\x12\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/ru_RU/server.jsonUT\x05\x00\x01*b2h\xcc[[\x8f\x1b\xc7\x95~\x96~E\x81\x8b\xc0\x0e\xc0Hr\xec\x87\xc0\x0f\x01t\xb5\xb5\xb1\xa4\x89F\x8e\xb1\x08\x12\xa0\xd8]$;jv1\xdd\xcd\x191A\x80\xb9\xd8\x96\xb3\xa3xl%@\x8c\xc4\xb6\xe2$\xc0>\x04\x8b\xe5\x8c\x86\x1e\x8aCr\x00\xef\x1f\xa8\xfa\x0b\xfbK\x16\xdf\xa9\xaa\xeej^FR6\x8b\xdd\x17iX\x97S\xa7\xaaN\x9d\xf3\x9dK\xff\xf2\xfc\xb9ZS\xa6\x9d\xac\xf6&\xfb\xe5\xf9s\xe7j7.\xff\xb0\xf6&\xfb\xf1\xf9s\xf6\xef:\xfd\xa5\xfe\xac\x86j\xac\xb7\xf5\x0eC\xe3\xf9s\xe7~\x82\x8e\xda?\xaf]\x7f\xeb^\xbb\xd7i$<\x8a\xb3b\"\x9aY^\xb6c\xec\xb9ZG\xb4z\x01g\xbdLd\xec=\xd1X\xf3F\xb0F\x9f\x85\xa2\xc9{q~\x81]Ox#\x16,oG\x19\x8b\x9a\xac/{l\x93'9\xcb%\xcbz\xdd\xaeLs&\xe3P\xa4\xac\x91\xca\xcdL\xa4Y\x9d\xad\xf3&O#&Sv\xb9\xdb\x8d\x05\x0b\xc5F\x14\x88\x8c\xf1\x9c\xe5m\xc1\xc4\x83\xaeH2\xc1d\x93%\xb2\\\x96\xe5)O\xb2.OE\x12\xf4/\x94\xbb\xba\xbd~\xe3\xbdb/\xb7e\x0e\xf2\x82\xdd\x90){O\xa6\xf7\xedn\xaeH\x9e\x86\x8c\xc7\xb1\xdc\xccX\x87\xe7\"\x8dx\xcc\xf26\xcfY\x94\xb1D\xe6,\xe3M\x01\xa6\x1b\x82mDbS\x84,J\x18g\x9b2\xbd\xcfD\xb2\x11\xa52\xe9\x88$/\xd7\xe5\xf1&\xefg\xef\xc8\xe0~\xb1\xba\xfa\xbd\x1a\xa8\xb1\xdeRCu\xaaFzG?b\xeaPM\xd5H\x1d\xeb]\xcb\x89z\xac\xb7\xd5P=UGj\xc0\xd4\xa9\xdeR35Q\x03\xbd\xa3\xf7\xd4!\xfd\xff\x88\xa91s\xb3\x18\xaeQo\xa9\x01\x1a\xf4\x87z\x97a\xa2\xfaZ\x0d\x99\x1a\xeamu\xa2FXc\xacN\xd4@\x1d\xa91\x88N\xd5\x90\xf8\xd8Q#Z~\xe01\x9d\xc8\xa4\xdf\x892Q\xf2\xfc\x89\x9a\xaa\x19\xa8\xab	\x18U\x03\xfd\xa1\x1a\xe9}\xc7\xee\x97zG\xcd\xd4\x01\xb1\xf0\xb5\xe3\xefPo\xab\xa1\xfe\x80\xa9S5\x03\x83jH\xfb8dj\xe0Q\x1b\x80\xa2\xb7v/\x8c\xe4\x8fd\xdc\xeb\x94\xab_F\x1b\xdb0\x8dfE3\x02\xb7O\x13p\x0d\x9d^\x16\x05\x8c'!\xdb\x88B!Y7\xe6}\x91f>\xe9\\\xb6\xa2fA\x966E\x1b2|\x11[S\xbd\xa7\x86\xec\xad\x9b7\xbeC\xc7>T\x87\xfa\x91\xfe\xb8V\x7f\xe9\x19\xc5\xb2\x8d\xd6\xad^^n\x06?\xd8\x15\x1e\xdco\xa5\xb2\x97\x84\xecG`\xd6\xd2\xa7\xbe\xbc-X\xa3\xec\xa7\xcd\xf8\xd4\xcc\x04Gn\x05\xa5\xcba'J\xbe\x93uE\x105#\x11\xb2\x8e\xe8\x88\x8cu\xa3\xae\x08Y\x96\xa7<j\xb5s\x16%\xb9\xc4[LYG&Q.So\x19<\x85\xeb\x0f\xbaQ\xda/\x96R\x8f\xe9@&z\x9f\xa9\xafI\n\xa6\x90\xab#\xdc\xae\x1a\xab\x91;\xa4\x7fS#\x92\xb9\x19\xa4\x10b\xf6\x8c\xa9\x035T\xc7\xa5$\x90\x14\x1ca\xc4@\x1dBv\xf4.I\xec\x89\x1a\x82\xa8\xde\xf7\xc9V\x99\xba\xcd=\xc9P\x9f\xa9Iup\xbd\xd2\x0e1;T3\xf5\xcc\xa3Wgj\xa2fx\x1cz\x87\xe9m5SG$\x99\x85\xdc\x82\xaf\xd7.1<\x16z\x1dS3\x0f\x92|\xa0w\xd5\x18\xbc\x8f\x18=\x80\xf7\xf5\x16\xc3O\xc8\xd1\xd7\xb4\xd7	\xa3Cz\x8a3\xa079\x9c\xe3\xff^\x94\xc7\xde\x06\xa0\x0c\x9e\xaa\x99:\xb1\x9c\x8e\x97lf\xc9\x18\xda\x98\xde\x9b\xdf\xd8T\x0dH\x08!\xd5C\xbd\xc5\xbe\xf9[!\xb2C\x86\xcb\xc2{\x9b\xaa\xa7j\xf0\xcd	\x9d\x034\xc06\x86\xeb]5a\xaf]\xba\x84\x13\xc1\xecC\xb7\x9e\xcf~X\n\xc3\x15\xfc0\xfc\xdd\x13\x0fr\xd6\x90a\x1f\xcf\x11\xd2\xdb\x95\x99\xa7\x02\x03\xde\xcd\x836/f\xaa?\x10\x93\x0f\xd5\xc0\xdb\x1f\xe9\x87\x81:T'z\xdf\xdc\xc2)\x18\xd0\x8f\xd41v\x8a\xabQC\x12\x8fg\xd0d\xe0\xee\xc8)\xcf\xb1\xa1g\xb4\xde\x89\xb9\xf6\xa1\x1a\xab\x19	\xd6\x96\xde\xc3\xcd\x1dA\x0ei\x95C5R\xcf\x96\x9c\x95\xf91\x7f}\xa4\xe8pr#\x9c\xccL\x1d\x93\x9c\xe2HG\xcc\xdc/\x1a\x16v{\x8f\xb7J\xdby\xd5\x9c\x00\xcb\xd1h\xcf\x8c\xb72\x18\x92L\xf6\xd2@\xb0\xa6L\x99='\x96\xc9\xb8\x97G2\xc9.\xb0;\x89\xc0$X\x9f\x94'\xa1\xec\xc4}\x16\xb4e&\x12\x9a!x\xd0f-\x91\x88\x94\xe7\"t\x04.\xb0\xbb\xe2\xe7\xbd(\x15!\x16\x08d\x92s\x18\xa9\x9c\xc5\x82g9{\x9d\x85Q\xb3)R\x01\xf3\xcb[\x99g$\x836O\xd7\x03\x99\x96\xe2y\xb5\xcdS\x1e\xe4\"eY\x97wXF\x9dV\xc3$yD\x8d\x9b\x82\x94\x89l\xb2\x8e\x0c\xa3f?JZ\x8c\xb3\xa0\x98\x89\xc5I$.\xb0\xcbM\x90\x12\x0f\x02!B\x0c\x83\xb0\xc4Q'\xca\xe9\xaf^&R\xb6\x19\xc51K\x84a>\x93\xf1\x86`\xbc\xd8\x99\xc7j/\xcbe\xe7\xea\xfaz\xc1\xaaeK}i\xf56.\xfcD\xef\x93\xc2\x82J_)P\x13vu}\xddh{\x08\xe0\x08\xef\xben\xa5\x01\xa2\xa1\xa6\xf3T\x0e\xf1\xb6 Tx\x81\x07\xd6\xee\xe2E>c$\xa6\x13\xbd\xb7\x84\xd3{\xb2\xd5\xf2_\xfe\x93e\xfc\xe8GF\xd9\xa8g\xe0\xca\xed\xe9\x93\xc2N\x17\x86\xe7\x8cG2G\xa3\xe0\xc4\xa21\xff\xd0\x08\x04N\x00\x07N\xd5\x8cA\x07\x10S\x0f-\x92(\xec\xdegz\xbb\\K\xefb\x8fj\xa0\xf7WM\xb3\xc7\xa0\x06\x0b\x8b\xbf\xc3\x93Vy\x04\xff\xa1\x8e\xf5\x9e\x1a\xaf\"s\xc6\xeaF\xf5\xadZ}\xdf\xd0\xf5\xb7\x9e\xf5x\x1a\xb4\xa3\x8d\xf2\x02\xaeymf\xa1\xb0l\xb9 \xd3\x96\x11\x19\xd82\xcb!4\x8d\xde26\x01\x7f\xab\x89\xb7B\x94\x01\xe2\xde\x95\x0d\x99\x97/_}J\x8a}<wot\x89\x07FA\xa9C\xb7\xcd\xdf[\xe5=\xc4\n\xe6~\xcd\x98\x81\x9a\x90\xf2.\xd8\xb1\xca\x7f\xe2\x13\xa2Ad\x8b\xf5\x8e\xdeU\xa7\xcc7%\xc3\x05F\xdf\xcdDJx\xd7c\xf6\xcf\xf6\x86\xc7\xc60\x1f\x01\x1a\xa9\xe9\x82\xb94*\xb0\x80\xa1K\xcd\xd5\xdc>\x08\n\xaaI\x9d\x11\xf0\x05\x96\x1dz+\xa8A})eg\xe4\x86\x9e\x91\xf36\"\x13\xef5\xe1\xf0\xcc\xbb\xfc\xc8\x1d\x9e\x05\x1b\xfe\xf1\x8e\xc9\x1cP/\x0e\xdf\xfdt\xc2D\xc3\x8b\x05D(\x92b\x81\xeb\xa1H\xd8]\x1eF\x0e\xab\x95\x0d,\x91\x9b\x846\xa1\xd2\x1a<I\x84\x07\xa4H96x\x1c\x17\x94\xfe\xe9{\xf8i\x18\x00\xc7;z\xcf\x92T_\x91G\x00H2\xf6\xfa\x1dZ:\xc1\x83\xc3\xdd\xc3lO\xd5\x91\xdec\x86\xd6\x0b\"\x9aK\x0b4G\xec\xbb\x97\x96\x98|\x0b\xdd\x819\xbc\x8dtx\x14_O\xd3\xf2D\xd0\xc0\xae\xa7\xa9L\x0b\xabF*\x8e\xd1P\x1c\x86\xa0\xce\x0b\x8bDn\xf1\xa8<\x10\"d)\x109C`\xc9\xb45\x9e\x95\xe2\x8a\x1f\x9b2\x0d\x17g\xb2\xae\xedZFB\xa6yA\x82Vf\x99H7D\xca\xe0\x85.\xa1\xe5\xf5.!\xb7\xdek,\xa5\xb6\x9aP\xd6k\x84\xb2\xc3\xa3\xc4\xa7\xf6\xa0-`\xa6KR\x0f\xde6\x0d\xe8.\xfb_Z+\x89\x07]\x9e\x84\x97=\xe9S\x7fR\x03uL\x82\xb7\xa5\xa6z\xd7s\xd5\x18a\xfa\xd2\x8d\xb3P\xbcV\xff;'\xb2W\x01\xc4\x00\xd7N\xf0\x13\xa2N\xa8\x0b\x91\x82[u\xb6v\xedF\x9d\xddZ{\xbd\x80V\x07\x16\x9e\x0d\x0b\xa7\xca=a\xbd\xf7m\xff\xb0\xba\xfe\x1d\xaa\x7f'\x08\x8b\x91\xa4\x9a}E[\xab?w\x08qd\xa1\xfaL=\x83\xaa\x06\xa2\xd7\xef\xab\x81z\xa6N\xcae\x9bB\x84p\xcb\xe8\x96\xcb\xe3\xfc\xd2\xecZ\xef\x10\x9d}\xec\xe4\x90\xecO\xb1\xf8\x135\xd3\x0f\xa1\xa0\xeb\xccj\xfc\xc2O.\xed(\xde\x1ci\xaf\x0f\xac\x17\xa1\x0e,\xaa\x18V}\x88f\\A\x96\xb2\x97\xe4i\x9f\x99V\x0c8W\xbb\x16ePF\x04\xb9D\xca\x02\x7f\x08\x93	\xb5{^qS\xa6\x81\x08/'\xb2\xd4u\xea\xaft\x98\xdb\xa5\xd5\xc2\x8d\x18\x83\xef\xbb\xeeS{;\xc5V\x11\x06\x18\xab\x13\xfd\xb1~\xe8\x94\xb0\x85P\xa4\xe2q\xc6#uJ:\x0c\xda\xcb\xda4\x9c\x0eb\x18\xbb\x15\xff\xb0\xe4\xb0\xc5\xe3X\xa4\xfd[2\xf4\xf4\xfd\x9f\xd4\x90\x9c\xd0	\x837\x03\xcf\x00\xe7T:\x80@W0\xd1\xc7^\xc4\x84\xd4^a\xe0\n\xd9bz{\x85\x04WC\x13\xadX6x|m\xa9\xa1_K\xc5\x06\x10u\x90\xf2\xcdX\xa4\x193\x83c\xe7#\xb9~\xde\xcb%\"K!\xdb\x14\x8d,\xcaE1\xa3\xce\xb2^\xd0f<c\x99\x00\x08a\"iE\x89`Q\x12\x8a\x07\xd4\xdfLe\x87\xf1 \x10Y\x06\xe5J\xc14K\xc5\xd3%-)}\x98\xf9\x96\xf9\x89.\xf7\xe3\xa5pM;\n}3\xa8\xberFS\xcd\xded\x97,]\xf5%]8n\xce\\;\xbd6g\xc9\xf4v9\xa7jS\xdaQ(\xaeDI\"\xc2\xe2b\xdf\x8eB\xc1\xae\x89X\xc0\xa3Y#Q\xc5P\xdb\x01\xc3I\xf2k\xa2rm\xbe!XC\x88\x84\x85fF\x85oQ	\xfa\xd1|\xb4\xe0\x0d\\\xe4q|\xd1'L\xa1\x85\x0c\xceVK\x84\x8c{\xd1>8Z\x14\xdf\xa3\xe3\x87\x9fB\x93YG\xe4\x9cfU\xd7\xbc+\x82^\x9aE\x1b\".}\xe5\xa2\x8dxg\xed\x08\xce\xcf\x19\xdbJE7F\xd0&\x97\x8cc4\x90\x06\xcdL\x1d\xa1\xb8_]\x95\x02\xb6\xd9\x92[\x02\xd2Y\x12\xc7Z\x1a\xaf\x1b\xe3A\xe3y\xea]\xf6\xe3\xf2\x01\x19=\xf9\x13R\x94\x00\x1aC\xba\xe7a\x95n\xc9\x8dL\xa3_\xc0\xe7\x8co\xcb\xcd5\x03\x8a\n\xbe\xde.:\x97`\xa6y\xfd\xb5d\x04\x8b\x92\x18o\"\xe5y[\xa48\xac\x84e9\x0f\xee\x0b\xff\x16\x8aE >\xfe\xf2\xea\xb7\xd0k\xf6\xa9O\xa1\x92I\x1bL+\xf8\x8f\xcc\xe8S\xcb\x8b\xfa\xc2\x86.\xcdH\x84\x05\xac\xd3\xf3\xfa\xf7\x826O\xcaU\xa3R\x84\xd5\x1fI\xceGjj\xa9x\xa3:\xbc%\xde\x96\x00\x08\xc5\xe8?\xc1\xfe\x14\xafj\xb4\xc2\x0e[\x0e\xe9\x16`\xbd\xc9\xbf\xadxI\x1e\x9d\x12B/Uk.\xd41O\xcbWv\xc4\xe9])\xf3;\x1b\"M#_\xf5~aE\x00\xa6\xe0\xc8b\x0fs\x86\xfa\x03s\\+v\xe08\xfd\x1dAY\xea;&\x81\xfau\x01\x19\x96O\xb4\xb2\x07\xeb	V\xe9\xd2\x80\x83\x8b\x05\xd5\x90\xbd\xba\x18\xd01\x90\xf9\xea\xb5\xdb\xdf\x86u5\x876\x82k\xca(\xc43\xb31\x01'\x00\x03u\x04\x89\xd6\xdbK\x82C\xdf\xfc\xad\x9d\xe7\xdd\xec\xcd\x8b\x17\xe9`\xb2\x0b&\xf3\x01@\xf6\x8d\x07\x12\xa8\xb3\x1aB\xb9\x89\xa6\x17\n\x9f\xe0\x89\xe39\xf0\x84\x11\x9d\x7fd\xbc$\xeaTA\xd3gj\xe2\xf0P\xad\xbe\xd0T\x05EC\xd8\x89\x91:.@\x91\xef\xd7\x9b\x17y#\xf2\x00\xd9\x82@\xd7\xeaU\xe8\x89G8\x80\xc5x\xae\x9c\x1eZ\xa4i\x03s6\xe8\xe7\x059f\xc5=\xd2\xdb\xf5\x19\xfbyXB\xf3\x9b?\xbcv\xc5r\x81\xf6\x97\xc6\xd1?\xe3\x14\x1f\xf74,\xd4\xe3D\xcd\xf4\xaf\xe9\xe1x\xc1\xdaO\xd4\x18\xb3\xf5.4\x0c@\xce\xe9\xdcHx^\xafb\xaez\n\xf8]E%.\x0e\xeeEA\x17\x00p\x1c%\xf7=N\x1e\x031\xea\x8f\x88\x8f!2A\xdbzO\x9d\xf8,\xfd\x0e*\x80\xd4\x06Y\xe7z\xb1J	\xd2\x90!r\xc1L\x13l\xf2q4\x9e\xcf\xaaE\xc0?@\xdd\x80}\xff\xfb\xdf\xbf\xf8\x06\xb4\xe2\xc5\xf2\x16:<\x0b\xa4'\x1b\x9f\x1b\xd9P3\xbds\xa6-\x9a\x94\xe3\xfcK5\xe4\xe8I\x154\x9dt}\xb6\\\x84VR\xca{^\xa0S=V\xc7\xd8\xb1I\\\xa8a51\x00\x8d2+\xe5\xf835\xd5\xef\x93\x14{\xb9\xa3\xe2\xbe\x96\x04\xf5J\x8d{\n\xcdD\x9e\xf6\x04\x87\nmg5\xd2\x0c\xbf}\x1e&\xd5\xe4\x84\xe1\x81\x1a\x07\xea\xd9\xfc\xb1<x\x9b\x02\x0d\xe5~>/C\xfb\xce\xcc\x19\x00\x7f\xa8\xf7@\x17\x04V<\xbbZ\xfdeH\x1c#9\xa1\xb7\xf4n\xe1\xd0\xd87\xb9\x9c\xb6\xcf\xf3z\xf4\x0bq&\xc7\xc6\xa8\x94vb\xeb\xe59^B\xe2\xe58\x867\x08#\xa0\x9e\xaa\x019et\xf6\xfa\x83\xcaN\xde\x8b\xc2\xbc\xfd\xfc\xc3\xa7\xd8\x14\x8c\xca\xf4\xef>\xfc*\x89\xbf\xfb\xf0E\xabw\xefG\x05\xc3\xb7\xccO\xeb\xaa\x00|\x99t\x83\x81\xc5.\x8d\x18\x98\\$P\x18\x93\x1b\"\xc58x\x9a~\xb8\xcb\x10^o\xcb4\x0fz\xf9\xff\xc6\x022D\xba\xa3\xa2\x8b?/rx\x03\x97\xf2Y\xa9\x8d'\xf3c\x17\xf5\xf1*\x15\\wN\xb9\xd3\x9ax\xb1# \x12\xfa}\xf3\xdaB\xa6\xdb\x8bZ$\x8bi\xcb3\x15 Rr\xfbL\xef\x16QP?\x91^I\xcb%b\xb3\x08\x85\xb9\xf3\x06nu\xf9\xc1S@4cb\xec\x8a\x1eWb\x13 y\xc5\xbcJ\xf8\xf4K/^\xfa\xa8\x92\x80\xb4\x9b\xf6\xa8\xca<\n\xbc\xdd\"F\xf2\xafz\x9f\x02A$\x8a\xa5*\xfd\x83\x0d\x9e\xc0\x92\x13\xf0-5\xab\xc9\xbc\xa1L`Zjd\x9c\x93E\x8bj\xb8\"\xa0b\x07\xdbx\xcabu\x84\x97\x9c\x01\xa7\xcd(\xe0\xc8\xb7\x95\xfc\xfe\xc5bE\xd8\xed\"#\xed8\x86\xe1?\xd1\xbbH7\xd0\xb5\xeb\xdde\xa3\x9d\xc2\xc7\x1e|kjh\xe1.\x91g*\x0c\xc2P\x1d\x81el\xff\x03\x98\x005-\xab,\x90\xb8,9\x96q\xb8x\xdb_\x91J\xdaz\xfe}K\x0b\xe6\xaf.\xc9R:\xa0\xcf\\\xba\xf2^\x19S\xba\xc5\xbb]@S\xd9,<e	g\x99\xfe\xefe\x02\x1e3\xc0\xa9\xcb]\xc2\xc7\xa6\xdfE\x01\x02M\xf3\x00\xa9\xdc\x84CWn\xe1\xb1\xadM\xa1$\xaf\xfep\xe5\xfb=,\xc7\xe9G\xfaC5\xb4\x95\x04d\xe3G\xecU\x1bp\xa2Xc5\x1b\xa2fE\x1e\x82\xe9\xf7AQ\x8dq\xbe\x08\xf3x$\xea\xf4\xb7:1j\x93r>jl\x1c\x8d\x1d\xdc\xeb\x01\xb8\x80\x0e\x19\xa9\xa9\xf7\xbe]\xc8\xb9\xdc\xd1\x933\xde\x1d\xa0\xfe\xd5T\x90\xdcU\xdd\x05<G\x16\xd8\xae\x17r\x1b\xcc`\xf8\x0d,\x11\x9b\xff\xf0L+\xe8\xdd$\x90\x7f\x9d\x82\xc9g\xe0\xfc\x12\x17\xda\x1a\x85A\xa1\x1c\xca\x1c\xc727\xb5\x987V\xa3\xf9yE\x88\xeaD\x8d\xd4X\xef\xd6IK\x90\xff0,\x9f\x8f\x0bK\x90j\xd6\xdb\xb8\xa81\x9e\xa6\x8d`X%2\xf1b\x8cz\xd7\xdbc\xdaK\xc4|6\xec\x13S\x99\xa2fEl\x8b\xea\xa6H\xa8\xb0A\xb7\xa1\xbfT\xcdE!H\xd6i]\xa8C\x00\x0e\x9fbK\x14\x1c? \xb0>[\xa1\xdb\x89\xb1{\xedT\xf0\xe7sVT#x)\xc5*on\x04$\xf8\xf0\x7f\xcaY\xbfW0\xb4\x1e\xf7r&\xa8\xc4\xcf%>\xae\xc9\xe4\x95\x9c\xf1\xec~9#E\xae\xac\x98s\xf7\"\xbfX&\xd3\xec\xaf%1\x1f\x7f>* P\xfe\xf3\xb6\xecy\xaa\xe3.\xb53\x18X\xd6\xa6\x1e\x1c\x9dk\x8f\xfbL&\x81`bC\xa4}\xf6\xdaw\x19\xcf\xb2\xa8\x85\x92\x04\x9a@!6\x1b)\xa7B\x85\x9c\x0e\xdb[\xb5\x81\xf2\x96r1\x11^\xbc\x12\xf7\x04\xa3\xd6j\xc0\n\x15\x17(?k\xa0?GQL\xd4D\xf8\xb0\xc3s\x0437\xa3\xbc\xcd^\xf9i\xfa\nj\x1a_\xf9i\xe3\x15o\x11\xc1\xc3;I\xdc_\x9e\x8aE|\xdc\x99\xbc3r\xc50\x85\xae\x88a\xacwW\xa0\x85T\xb4\"\xe4\x05\x8a\x1d!s:W\xf3R\xf1i\xec\x8a>\xb31\xcf\xa3\x0dq/\xf2\x11\x0d\xe0\x01\xe5\x03\xf0\x04\xbd\x18\x0d\xf2?\xael\xacV\x7f\xf1\xb1\x95\x07\xb14 \xf3\x1a#\x1b\xbcm\xfa\x80\x84\x8f\xfcPL*\xba\x82{\xc8\x06v\x9b\x1e4m\xb0LZTf\xc4\xfd\xbb\x157\xea\xc7\xb4/ \x89\x9d\x9f\xc0@\xbb\xf3-J\x97\x9e,\xd3?\xd5P\xaa\x97t\x1d\x903a\x89\xe8]\xfd\xb1\xdd\x82\x8d\x99\xafB)\xa9\x94\xf9\xbbw\xdf)\xf7\xf2\x07\xda\x05\x12E\xf0$\x9f1t\xd6Wv\x01\xb6b\xfc\x11AXt\xcd\xd4\x01\x0c\x1a\x19\xb1\xc9\x19US\x85\xaf	\x8c\\\xc6K\xd4\xe0\xf9y\x83\xb4\x17\x8b\xf2\x8d\xaa'\xf6\xe0\x80z\xca\xc3\xf3\x1b\xab&xI\xa2\xcc\xd4\xdf\x1c:\x08\xef\x9cZ\xf2\xa4\x11\xaa\x9e\xfai\xb2\xcc\x8f\x10\xa8\xaf\x0c\x15\xb7\xee\x17\x90J\xb8r\x88\x81\x0d*\xaa\xd1\xa7\x10{\xd2\x03\x9c:R\xa7\xc4\xd5S\xb0\xad\xdfW#\xfd\x10V\x07;\x07T\xdf\xae`.\xf5\x95\xf9Y\x9c\xeeSz\xc2C\xaf\xa4\xac\x9a\x0fs\x19y\xca\xab!/h@\xe0\xb8n\xe22\x18\x03`\x83\xbc\xbf\xa9\xbfq\x16\xc4\xb4\x0e\xd5\x90\xbd\xb14\x95O\x1c\xc0f\xfa\x16/\xe3\xbd@$\xbc\xd4\xc7\xebh\xb8}\xf9\x8e=#\xd7\x7f!\x90\x9d\x97\xca\x17e\xc8N\xc9du\xe9\xa8\xde%cbtN\x81\x88\xc18^\x10\xd8?\xb3\x90\xd4/\x1a\x85k\xacw\xbc{0\xce\xaf\xf5\xf6O\\\x14^\x8d\xbcu\xe0\x19\x83\x80\x17\xa4\xf3#)Y\x9bw\xa2\xaa\xa5Z/\x9b\xeas\x0dg\xda\xab\xac+\xa3\xb8\x82qQ\xde\x81\x1c\xf3	\xc4@\xef-\x0df\x94QrH\xa9\x8d\xb3\xdb\xc0\x1d\xd3\xdb\xf3\x14\xce\x08$\x94\x8c\xe4\xbc\xd9\x9c+;\xfd\x9c\xf4\x11\xbd\xe4E\x8fxp\xa6g\xba0\xbe\xa86\xb3\x17\xbb\x83+fVcP\x9b\xf70a\x17\xcf0u;\xee\xa3\x843\x0c]\x19\xf48&\x05[\x84\xa5+\x00%o\x8b\x8eX(tsd\x1f\xbb\xca=\xfb\xfa\xf1\xa2\xf4n\xa9\xf0<\x910\x88\xc0\x08\xf4-\xfe\xc0?\xc3\xc5\x10\xcd\xbc\x11+\xeb\xa4\x9d\x86Q\x83\x17\xa8\x93^\xee\xf3W\xbcE\x17\xc2\xb5\xc0@M\xfc\x15\x963\x1fy>\xee\xe7\xd0\x08\xff\xbfY\xafJ\xec\x8b\x14J\x1b\xe3\x07\xdba\x04R\x1d\x9f5\xa9<$*[Z7\xefu\xc5s}N\xb8\xee\xf1\\\xddC\xf5\xa5\x16F\xc0+:s\x91:wH\xfe\xceS\x1e\x88\x8e,\xa5\x97\x1a.\xa0\xa5^\xfd\xbd\xa8\x9a\x07\xf6V\x87\xdf\xc1.\xc9jM\xf5GX\xd8W\xff(\xb5\xbd\xf2VA\xdf\x065V\xd7\xa2\"(\xa3\xa6K\xabA\x9f\x1f\xfd^ Sl\xd4\xb0\xb1<\x9c\xffW\x0c\xb5\xd7\xb6\"%\xea\xa9\x95M\x9e\x07m\xeb(\xddI\xee\x8a\xae\xa7a\xdeC\x9f\x05\xf6T\x01\x83\xfc\xba+\xcc\xb8l\n2\xa2\x80\xc7q\x9f\xf10\xb4\x03\xe1\x19\x10Q\x11.N-\x97\x15\x8d\xces\xd3\xb9(w\xb2\xb73\x97p\xd5\x1f\x9f\xe5\x15\xd3\xc8\x035\xc1\xe1\xad\x9aO\xe8`~\xc1\xa5\x87E\xb5\xc9\x035FC\x19\xe2 G\xf4\xc0\xd5D\x1e\x16R\x8c9^\xf1\xdf\xa6L\xef\xaf\xac\xbfA\xfd\xe964\xa7=\xd2\xb2FD?Z\xca\x8b\xde/\xcaY_@\xe8\x16\x98\x98/\xa4\xfe?`\xa5\xcfQ\x95S\\\xfa\xbf\x98\x9ffM\xf3\xc3\xa4pm-\x0fM<\x7f\xeeW\xf5\xf3\xe7j\xb2\x8b\xc8N\xf1q^b\xaaXk\xd0\xa26'V\xcb\x82TP\xcdM\x8d\x18\x034|\xaa\xa6^\x8a\x89\xe9\xdf\xe0\xc2\xf1\xd8\xed'c\xb5M\x9b\x81X=\xa5L\x1c\xe0\xdd\x10/\x99Ls\\\xab\xc3(\x95O|\n\x80\\\xab\xcfw\x9d\xdaD\xd9\xd0~\xe0C\x8a\xccss\x16fx\xd5\xbde\x96\xa3b\x01\xcb\xd9.|\xe1w\xcf\x99x\xc8C-\xe7E\xc5\x0b\x90\xca\xb6\xd5\x16\xf8wh\xe9#.\n\x8fcd\x7f?q\x1e\xcc\x8a'b\x87\xb9\x12I\xe8\xee\x87\x95\xf8\xaf-!\xd1\xfbP\xf4PKc\xf83\x84\xcf>\xa2\xea	\xc3\x99\xe8tc\x9e\x8b\xe2\x92\x1b<Y\xab8$\x8f\x81\xddL\x80ed\xac\x14N\x1bG9E\x0fP\xdd\xc5oe\x17\x97\xa7+&\xec[\x99\xd5\x07@F\x80\xbft\xabo:\xa1\x7f\xac\x06\xfa#\x9b\xac`6B4\xd4\x0f\xf5\xa7z\x87\xa6\xda\xd0\xf3!\xfbVv\xa1:\xe7\xe6\x1a\xfb\xaf\xad\xdfQ\xbb'\xb2\xbd\xc8m\x04_\x8a\xbe\xc9\x8a\xafHk<\xa0\xf2B\xb4\xa9\xbf\x14\xdeqa\xd9\xe7\x81\xf6\xcc\x16\xb2;Oof\xd1n\x8d\x87\x88+.d\xaam'El\xd6\x81bi\xd0\x17t=\x10\xee\xa2\xcap\xf1\x98\xac\x0c6\xb8yG\x9f\xe2,\x8a\xa6D\xa4\xeb]\x11\xe0~\xb0s\x88&(\xe0\x0b\xae\x99\x175,\x02zT<\x8d/`\xebl\xed\xf6[u|\x1e\xe8\x02\xf8\xa4\xdc}\xf1\xa4j\xc2\xc9\"4t\xf5\xfa\x03J\xf2\"ao\x0fIM\xd8\xeb\x97.\xfd\xe7\xef_\xbbt\xa9\xee|\x97\x89\xfdV\x12Ib\xf4\xb2\x1f\\\xa1jI\x8b\xb1\x8e\xd5\xa1\xe1\xd2\xee\x08\xb6\x0eo\xc0\xea\x0f\xfb\xb9\x0c5V?\xb6\xc2'>IKx\x19\x04h\xeb\x89\x95q\x9b\xc1\xae\x86\xad1%\xbd\xean\xf9r\x07\x7fQ\xd8\xd9}\xe0C\xe9:\xf7\xd9\x17}\x07f\xb9\x88qq\x01\xb8\xb8j\xff\xb4\xed\x82\xa7\x96\xe1\x87V:\xbd\xdb\x0ed\xd2\x8cZ\xbd\xd4\xc4c\xdd\x85\xdb\xe0\xc8\xcc]\xb8\x95 \xbd;7i\x9d\xaa\xb5W\xcdrq\x107\x0b\x91so\x9d\xaf\x9c\x92Z\xbaF\xc8s:\xd2k\xf8\xdf~U\x12\x0b\x7f\xbe\x8d\xb6\xae\xe0\xd1\x94(:\xb0\xb30z\x99B\xb2z\xcc\xcc\xb4\x89\xba\x85\x89\x16@\xda\xa1\xbd\xd4%\xb3j\xd7\xdc\xdf\xael:JI%\x11\x80#l\x06\xdf\xbd\xb0:\xae\xc0\x19{|^Ys\xad\xd9\x0b\xee\xdf1\xef\xf1\xc6\xbbW\x7f\xc0\xee\xdc\xb8a:L\xdd+\xda\xd5o\xe9A\x1c\xb8H\x0d\xb2S\xb5\xb2V\xaev\xf3\x9a\xfb%\x92<\xca\x8d\x0c\xff\x91\xd4Y\xb5\xb0\xb8\x16\xc5\xb1h\x19\xa27\xcd\x9f\xf4Y\x1b>\xb4&\n\xb1\xe4\xe1\xd5\x8a\xcc#\xde1\xc6\x11b\x9b\x05\xfa\xae8\x8ejT|9\xe8\xec\x03\xe8DI\xabP\x0d\x97\x83@t\xf3\x8cqz\xf0\xf8V\x1c\x8f\xbd\x19\xc5\xc2\x04s;\xfcA\xd4\xe9uX\x18uD\x92\xc1\xaa\xe3a\xbcq\xe9\xd2\x837\xf0\x96]7M\xc8\xa2_\xd0\xe7\xca\xf61#N\x9c\xa0`\xab\x97\x84\x17\xdc\xea-I)s\x98\xda=\x13\x9f\xf3{L\x15\xbf\xb3N^t\xc3b7\xbd\xad\x86\x8ba\x94\xf9\x14\xe7\xd2\xec\xa6\x1a.\x8e\xbdw\x0f\xab\xdd\x90\xf8<\x11\xf5\xa9Y?	DH\xa9#\xca\xfa\x01\x12\xb3Lv\x04k\xf4\xe28kG\xf62J|\x03):\xd5\x1f\x96\xac\xc8\xcd\xe4\xb6,\xd2,\xd6\n\x16\x9f\x08\x98\xac\x1e\x10\xc0\x10\x0e\xf0\x08Q\xbd#\xfbY\xdd\xa2\xc5\xe8\xba\xd7\xf0\xc4\x17\xffn/m\x15\x0fe\x0d?H+\x99\xa2\xbd\x9a\x8d\xf2\x86Q*\x82\xfc\xe6\x1a\xa6\xdf\xb5\xbfX\xa3\xcfn\xaeUG\x18wb~\x94M\x10\xd0H\x83\xee\xeeI\x19\xe7Q\x17\x03\xd5_a\x16\xf4#\xab\xac\x1c\x92w\xee\xad\xcbf\xd9\xcf\xe3\x86\xf5\xb9\xef\x8f\xb1m\xfd1izW7\xef\x7f`\xcd^- \xcbD\x9d\xe8O\x91\xd5\xa2(\xb2: @\xb8\xadN\xf4o\xf4G\xd4=\xfa\xb6Md\x9e\xda\x80\x1b\n\n\xf6\xec\xd7\xadz\x17\x0f\x02qb\x1bT\xd5{s\x95\"v{\xf9\x15[\x9a\xbb\xc48\xbbj\x08\x00WWqQ\xcbD\xfe\x8eyE\x98\xb2.rf\x1f\x15\xe3I\xd4\xf1\xd4Q\xd6\xe6\xa1\xdc\xa4A\xe6/\xaf\xf5\n\x05*l\x07kDV\x839\xb4j\xad\xd6\xb2\x8f9\xe6\x0e\xda\xce3\x8e}\xa9y+q\xb8B;\xac.\xc7\xa9e\xbd\xc6\xcfD`\xde\xa6\xfdb\xd2\x92\xee'd\xe0l\x06\xdeD\xbe\xe8N\x87\x14\\\x9f\xce\xd1\xe9'AaJ\xdfM\xa2\x9f\xf7\x044Y\"\x02$\x88x\x80\xb4\xca\xc5\\\xa2>\xfa\xe6\x1a3\xd0\x8a&\"jV\xac^\xc6\xc7j\xb9\xc9\xc1xP\xdb\xb6\xf7\xbb\xc2\x8e\x1f\xa9S\xd3\xd6K\x1c\x182\xf8\xd6\xabe\xb1.\x04\xb9\xbbd<\x8d\xe8\xb3\xf7l\xcb\xf9s\xbf:\xff\xab\xf3\xff=\x00PK\x07\x08\xe3k\xc5-%\x17\x00\x00YD\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x001\x8dS]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/sk_SK/common.jsonUT\x05\x00\x01OV\xd6j\x8cW;\x8f\xdbH\x12\x8e\xa5_\xd1 0\xf0.\xb0\x98\xcd\x9diF\x9a\x1d\xf9F\x0f\x88\x1a\x1b\xe7\xdb\x0bZ\xec\x1a\xb1\xc5f\x17\xaf\xbbI\xad\xb4p~\x89\xff\xc0E\xa7\xc0\x81\x03G\x97\x0dpN8\xfa_\x8b~\x89\x92\xd7\xc1F\xaa\xae\xfa\xaa\xfa\xc1\xaf\x1e\xfa\xbd\xdfK\x9eP\x95\xd4$\xaf\xc9\xef\xfd^/YQ)\x81%\xafIr3\x98NGCr\xf3w\xf2\xeaJ\xbf\"w\xb3\x05\xb9\xd2\xee\xe7\xd7\xe4J\xff\x9a$?Y<\x03\x01\xc6;\x0cG\x0f\xa3e\xe7qa_\x00\xd5(\xbf\x83r\x01\xad\xe0\xe1\xbc\xa4k\x18v1\xc7\x93\xc1/#\xf2\xdd\xc8\x0e\x9aV\xc8\x05\xa8sp:\x9f\x8d\x1fF\x8bo\xe1\x12\xb6s\xd4F\x8f\xe52W@\x9d\xc3\x15#\x12\xb6\xa4Bm~\xd0?\x12.\x89q\xb6k\xbf\x83\xd5\xeb\x81dc\xbb\x93\x9e\x95\xdc\x84\x9b^\xb1\x93\x0f\x95\x8c\\1\xe2\x0ecc`@u\x01\xbe\xefw\x89\xab\xd5\x1a\x98=\x9e\x8d>\x9f\xa5K2\x7f\\\xfc\xd2]\xe1O\xcf\xae\x80q\x05\x99\x19\xcf\xa3\xc7hA\x16\xa3\xe1x1\xba\xb5\xdf`9\x0b_\xe9\xf2\x11\xa2[\xf7\x04\xcb\xfb\xc5h0\xfc\x0b\xae:\xa7\x0c\xb77<\xd2#\xbd\x1f\x0cg\xef\xc8\xcd\xf8\xaf\xb0\xc4?\xeb\x03f\xc5\x12\xd7k\x01\xe7[_\xe9\xcb3\xd6\xb2\xe3\xe0\xe3\xf4\x92\x85>Z\xc3a\x0b,\xa5%t\xd7O\xc9\xec\x8e,\xefG$\x1dLFd<'\xefF\x8b\x11y;\x1e\xbd\xebN\x97\xf4{\x1f~\xea\xf7\x92J\xd4\x8a\n\x1d)\xcf\xe8.yM\xfe\xd1\xef9\xba\x1e\xff\xed6\xe9%L\xb6_\x92~\xaf\xf7O\xbbLr\xac\xd5	\x95#\xe3\x92\x06\\\x8e\xac\xfd\";d\xc9em\xe0\x84-\xb9l\x9fM\x04\xfb\xd5\xee\x0c\x8d\xd2\xe4\x1d\x184\xa7Y\xc4\xba\x056\x1dXC\x86\x92\x9d\xd0a\xe9\xd1~\xa1;\xf0\x0ehwb\x85E\xc0),B\xc8\xf8\x1a\x96\xa4\xf1-(+\xb9K\xd3\x81\x13\xacKB\xd7h5\x95\x8at\xa5\xd2\xa7\xf2\x1b+\xecJ\xac\xb5\x07f(\x8d\xa2\x99#\xf1m\x94/,>\x93\xac\xfd}	\xf2x\xe0\x04W\xaa=\xec\x8b\x9d\xc7\xc1o\x15u\x17LFN\xc2\x86\x1e?\x9d\x9b\xba\x00\x1ePo\xbe\x89\x90sf\xdf>I\xb3\xfc\xcc\xd9\xdf\xa9\xf1\x90\x0d\x95\xdc\xa0r\xc7h\xff\xc3\xd4\xf1\xeb\xaa=(\x1e\x8c\xb56S\xdcZ[\xa5\xdaC\x03\xc4\x80\xa2{o\x14\x98\x15\x9e\xbbAr\xda\x92\xea\xc2\xe2'T\x17\xe4\x89\x0b\x90\xb4\x84\xce\xb4\\Z\xe3\x02*A3p\xf6W\x9aX\x08\xd9r\x93\x13n4\xc9\xa9\xceICE\x1d\xdd\x90\x81\xa2\xf1\x8c\x13\xbbj\x0f\x06\xe3\x19q+!\x98\xe8\x86\x1bx\xf9J\x18\xea\xf8\x86\x1a` \x84\xdd\xf3=\xae\x14\xdd\xf3\xe3'\xd2\x1c\x0f`N\x80\x1c\xb7\x17\xe6\xa0\xf6\xa5\xd4:\x86\xaa\xea\xf5\xc6eljxV\xd8<I\xe6\n*\xc9\x89\xf6\n\x07\xa9e\xf72'\xd9YvX[\xe5\x0f\xcb\xdd\x8f1\xfb\xf4Nf\x81\x97	\xb2\n7 \xdb\xff\x87z\xa9x\xd5\x1e6\x94h\x1a\xce\xb4\x93Y\xaeP\xf2}\xbd\x81\x93v\x8f\x9d\x1e\x1bzrg\xf0'}\xdf%Cbx	\x91\xe2\x19\x15 \xd9Yn\xbc\xa12\xe4\xc6\x1d\xac\x824\xa1*H\x83*J\x93\xf6\xb0	\xe2\x9b\xf69\xfa\xbci\x9fE\x10\x07\xf5:H)TA\x9a\x15&HSl\x824\x84\xacK\xd3-@q:\xca\x14\x18\xbc|\x8d\xc5b\x8e\x92\x818e\xee\xa3\xc1.\x8dS\xa3\x80E\xe0\xf1\xbf\xa6Q\xe6d\x9bs\xda-R\\\xa1\xa1\xe7	_\xf3S\xb6W\x95\xd8\x05\xb2\x0c\xacL\x0c\x12*\x84\xebn!\xa9WH\x95\xa3\xfc\x8d\x13\x82\xce\x18,\xadr\x88\xa2\xf6\xba\x8c\xca\x0c<\xedT}<\x9ch\x95QC\x05\xae\xad\xe1oVl\xff\xb7\x0e\x0e\x82\xdbvp{\xf2\xbb\xb5\n{\x82\x10\xeal~\xb8\x89\x87x_\xd2==~r|\x0f\x1b3\x94\xf6\xdb&C\xfb\xeb|\xa0\xc4\x8d\xbdc2r\x82\xd3\xd9\xbc[\">P\xb5v\xe8;.\x80\x18D\"\x9c&`$\xd7\xb9O\xf0{4\xd8\xa0\xd7\xaf\x11\xd7\x02R\x94\xfe\x16\x82\x17\x92\x93J\x01iv\xeb\xf6y-@r \x15h\xc9_>\xc6$\xe3e\x85\xca\xdc\xa2Ru\xe5\n\xe2\xd8)\xc8^\xecr*\xae\xc9\x1c\x8f\x87\x02\x99\xe5>\xd1\xed\xf3\nU\x18:\xbc\xe30\\jJ\xfd\xda\xd1\xfc\xf35IM{P\xb2\xb0\x19B$}\xf9\xd8~1\x94\xec%6ut\xaf\xee\xa9\xb6\x0d%\x19\xcf]]	\x95\x8b\x86\xd9\x02\xb5\x00&\xdb\xcf\xa7\x82F\x0d\xf7\xd5\xfc!\xca\xd1RtSB\xc8\xf9zO\xcbB\xd6\x86\x03iD{(B\x0f\x0c\xd5p\x897'^\xbc\xf7\xc0\xf63\x91\x94\xe8\nY\xe0cRRU\xa4\x00n\xc7	U\x05\xa1\x9ah\xbb\xf6V\xe0\x0c\xdfr\xcdQ\xc6ihbU\xa4q\xba3\x90W\xf8\xda\xfa\x96\xc3\xb6\xa3-yRX\x12\x93\xdbzQ\x82\x1d\x05\xa8\xf6K\x10\x90\x19\xf03X\x0c\xa5\xd6\xd0]sb\x97\x84K\x83a\x10\x0c(l\xceA\xd8\xc0\xa5\xb9\xd6fBM\xe6^\xfd\x1e\xb4h\x0f\xf6\xf3\x94\xb5\xe6\x94\xecs<\xefb\x12\xb6]\xa4)6\xed\xe7\xf0\x92\x81j\x15H\xc6\xe5z\x01v\xc6\xb1\x98\xb9W\x10\xe55a\xac\xe4\xd2,\xf1\xb6\xcb\xac\xb9\xd5\xd8\xd4\x89\xban\xfc\xec:\xe6\\\xd0\xb5m#\xcd\xcf3\xdft\xb1\xf9y\xb9|\x08X;\x82vS\xba\x97\xc8\x13*\xe2,\x1e\xf4\xaf\x1a\xc3\x04<\xe5P\x18$\xc7O\x94d\xdc\xb2S\x04\xfe\xa9oBD\xad\x1fWov\xdd\xed\x17A\xe7\xbe\xdcjw\xf1\xe2\x11oI\x00\xea\x02mK\x13\xa8KXl\xb1\x1e\xa2\x89\xb19\xed\xde\xc3}\xf7\n2\xfe\xc4\x81\x91Z\x85\xaa\xa2\xe0I\x81O\x93\xd9Jbs\xaaV\n*\xe1\xba\xdc\x8cU\xd8\x00;M\x10\nl\xfaZ\xcb\x94\xe6\xa2=\xe83\x17S+\xc7\xe7i{h\x14\x0d\xd4R\xb5\x00\xd7\xa3\xe7\x8a6\x9c\x89\xf6\x10Z\x1aP\x15\xa8\xf2\xf2\x95v\x1b\xc4\xf9\xcd\xb6\xdf \x06\xbd\xb6\xd4\x1f\xfdV\xf1\xf07'\x05\xe6*N\xb3\xab\xd4\xf1@E\xa0\x8e\x06\x93\n\xdc\xdai\xc1\xa3\x0c\xd1\x02\xb7\xc4\x0e\x13\x01\x91\xe3v\x8a\x86g\x0e\xf0X\xe1\x1e\x95\xb4\xa1\x82\xb9\x12\xfc\xec\x9fAj\x97\x04\x9f\x9e|B\xd8?J\xe7\x9fH\x1bjj\x7f\\/\xf9\x18\xf5j\x03~\x02L\x83\x18\xf5%w\xea\x19C-\xba[;\xfd\x1d\xaa\x8e\xf1\xa9S9\xea\x9d\x93>\xec\xfd\x9a$\xe1\x88.\xae\xc9\xebr%)\x17\xdc\x17\xe7\x193\x82\xbe|,\xea\x0d\x94\xd7\xd7\x81\x93~\x82\xb9\xdd\xd9\x8e\xe3\x02\xb85\xc9\xbc\"@*kyk?M\x18ib78\x15\xa2\xf6\xa0\x89\x04\x86\x05\xca\x97\x8f\xaepW>\xa3\xa2\xc76\x16\x81\xc7 \x9e\xeb\xbb\x87\x0d\xd6\x8b\xd7\xac+\x81\x94\xd9\xa6\xe4\x10n\xe5f\xc5\x10\xc3)\xe6\n\xd7\n\xb4{tt\xcfh{B\xbc\xe57\xbb\xbc;\xdf\xa3\xdf\xfb\xd0\xff\xd0\xffc\x00PK\x07\x08\xa8\xab\xe7m\xec\x06\x00\x00\xee\x0f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/sk_SK/server.jsonUT\x05\x00\x01*b2h\xbc{Oo\x1c\xb9\xb1\xf8Y\xfe\x14\x85\xf9a\x7f\xbe(\xb26\xbb\x87\xc0\x87\x00\x96e{\x95\xb5dE\xa3\x95\x11\x07	\xc0\xe9\xae\x99\xa6\x9aM\xf6\x92\xec\x1e\xf5\x04\xb9\xbf\xc33\xf2\x15\xde\x00\xf1a\x0f:\xbdwZ\xc0\xba\xb4\xe6{=\x14\xfftsF\xf2\"\x08\x92w\xd24Y,\x16\xc9\xfa_\xa5\xbf<\xd9\x9b\xcc\x95\xae\xcc\xe49\xfc\xe5\xc9\xde\xde\xe4\xf5\x8b\xdfO\x9e\xc3\x1f\x9f\xec\xed\xedMN$M1\xcb\x95\x84\x9aI\x14`\xf1\xc6N\xf6\xdd\xe4+i5G\x03s\xa5\xc1\x16\x083&%\xea\xa7\x06^k\xfc\xb1AiE\x07/L\x899\xfc\xbeAC8\x0c\x08n,0\x99\x03O0W*gb\xf2do\xefO\x84x\xf2\xbb\xf3Wo.\x8b\xa6\x9aI\xc6\x85\x19h\xa1a\xb0\xe38\xc1\xeeM*\\4\x19\x83\xc6\xa0\x81\xf78;O `\xd6A\x8es\xd6\x08{\x00\xaf$\x9b	\x04[p\x03|\x0e\x9dj`\xc9\xa4\x05\xab\xc04u\xad\xb4\x05%r\xd40\xd3jiP\x9b}\x98\xb29\xd3\x1c\x94\x86\x17u-\x10rly\x86\x06\x98u\xc7\xc5\x9b\x1a\xa5APs\x90j\xdc\x16\xacf\xd2\xd4L\xa3\xcc\xba\x83\xf1Tg\xd3\xd7\xef\x87\xb3\x9ca[\xa8\\\xf6?A\xae\xa0\xd6\xfd:\xc3p\x9eceJ\x06\xb5j\xd5\xfd]s\x8dP1\x8b\x9a\xf7k\x01\xa5U\xba\xff\x0c\x92#\\#\xccpU\xe3\xfdGBQk\\\xa1f\x9bO\xd0B\xadY\xa6Z\xa9*\xa8\xb52Vc\xde\xdf\x8e40\xb1d\x9dy\xab\xb2r\xa0\xe4js\x97w\xb0bU\xff\xb9d\xd7P\x82\xa9U^6\x81\x1a\x02\x05\x93i%\x04\x97\x0b\xba\xac\x9a-\x10f\xcaZU\x01\xb6(aY\xa0\x04\xcbf\xc0\x0d\x14<\xcfQ&\xfbI%\xbb\x8a\x1b\x1c\xb6{\xe1GV\xcdu\xd8\xe1\x83\x9ai\xb6\x82v\xb3F[\xf6\x9f\xb3\x02j\xcdM\xcd\xb1e\x16\xef\xefT\x0b\xacT@\x88\xfa\xcf\x95\xec\xb2\"\xc1\xde\xe4\\])\xd1T	~\x1a\x83\xd6\x0f\xfa\x1d<\x04=\x93[\x00\\B\xd5\x18\x9e96ly\x8e\nj\xc1:\xd4&Em\xd5\x82\xcfG\xb4\x92W\xaaet\xdboN^C\xcdD\xa6$f\x85\xed\xc2&\x04\xd0\\?\x98\x1c\x10\xce\x16\xa7\x8d\x1d\xc9\xa4\x0f8bY\xb9\xd0\xaa\x919\\\x11\x19\x01\x95\x9b#\x06\x9b\x8d\xf3\x8e\xcc\x14\x9b_\x10\xd1}\x01\xd3\x8b\xbc\xe2\xf2W\xa6\xc6\x8c\xcf9\xe6Pa\x85\x06j^c\x0e\xc6j\xc6\x17\x85\x05.\xad\"q\xd0P)\xc9\xad\xd2\xc96\x8a\xe9\xfc\xd5M\xcdu7lu\xff\x9f\xcc\x10\xebsbZ\x8e\x90+S\xc6K8W\xf7\x1f\xd1B.\xfb[\xe2O\x90\xaa\x0d/\xda\xdf\x9a\x1a\xdbR\x11\x83bn\xfb\xcf\xd5>H\xdc\xdc\x81a\x0e\x03\x83U\xc56w\xb8\xb3\xf7\x19K\x9e\xf6\xac_\xafT\xbb\xb5a\x18\x92\xaa\xc5k?q\x00\xa7\xfd\xffl\xee\x10\xd4\xcc\xb0B\xb5$\x15\x15\xbb\xe1U\xbf\x16\x12\xe1\xebC\xa8\x98\xe8\x7f\x02\xc1,\x97\xa6$\xe9\xe9oM\x85\x92\x01\x138Sp\xff\xb1\xbf5\x82%\x82\xebn\xe1\x92[1\x92r\xc9m\xff\xb3P\xe5\x161\xc3`B\x8ec^\xc9j\xdd\xdf\x96\x82\xe5\xf0\xf4\\	ny\xc9\xe0\xff\xc3T3\xc9\xf1\xe9\x01\x9c\xa6\xf4\x1d\xc2J\xb2R\xb5[\x04\xe4\xe3\xfd_\xa2\x88\x8cB?\x9d>nh\xcb\xfe\xa7\xc21\xf2\xa2_\xdbf\\\x9c\xb1\xdaf\x05\x1b\xd6\x7f\xcf\xea\xfb\x8f,`8W\x9b;\xcerv\x0d\xcd\xe6\xae\xbf\x1dEn\xd6A\xdb\xd5Br\xc1\xa1\xa4\x15\x0d=\x1c4\xfa\xfe#\xb7\xfdO@\x07-\xfc\xe9\xae\x114.\xb8\xb1\x8e!\x180h;\xdb\xf6kw:hE\xbf.Q>\xa0\xe7\x92-F\xcd\xfe\xd2\xd3\x08\x96\x06\xc3\xd9\xd8\xc2\x90\xba1\xaa\xd1\x19:\x13\x13N\x02F\x89\x86\xcc\x869\x80w\x12i\x11\xa9\x1e\xcdd\xae*\xd1AV(\x83\xd2\xad@\x96\x15\xb0@\x89\x9aY\xcc#\x82\x03\xb8\xc0\x1f\x1b\xae1\xa7\x0d2%-\xe3\x12\x98\x05\x81\xccX\xf8\x06r>\x9f\xa3F2\x0ela\x92\x87\xc8\n\xa6\xa7\x99\xd2##\xbc,\x98f\x99E\x0d\xa6f\x15\x187\x195\x82\xe5np\x89N\xce\xd4\x9c\xec\x1c\x9fw\xa4H\x19d\xc3J\xda\x1cje\xec\x01\xbc\x98\x13*\xbc\xc9\x10s\x02#- x\xc5\xad\xfb\xd5\x18\xd4\xb0\xe4B\x80DO\xbcQ\xa2E`\xc3\xc9\x12R\x1bcU\xf5r:\x1dH\x0dd\xfd`P\xff*\xc79\x97\x98\xc3\xcb\xe9\x14t#\xd0\x80P,\xc7\x1c\x94\x04\xabj\xa2\x9564(0\xa3\x9b\xb3\x05V\xf8\x08\xf2K\xb5X$bq%\x98\xb1\xa4#i\xdf\xc0a\x1ak\xc9\xa1V\x81\xc1\xc8z\xb5\x01\xacP\x0ep@\x1bluJ\xf4\x15\xe9\x0f\x95\xaf8\x03\xdb\xffTE\xbe\xbd\xa4\xdf\xde \xfe\x0c\x92\x91\xccZ\xb6\xf9\xb4\x0f%\xde\xff\x0dJ\xc1\xe9\xf1\x1ckK\x02\xc0U\xab\x04\x17\x0fvz\xcb\xe4\xe2\xc1V\xfd-\\\xb3UW\x86\xad~G\xbf\xa3\xed\x0d\xc7\xd8|rr\xdd\x06\xe2R\x0b\x9b\xa3i\x98\xce\n\xde\x8e\xd7r\x9c\x8cy\xa4	\xd4\x81\xd2\x0b\xe0\x15YU\x83\x04\x95\xe0\xe2\x86|\x96\x0b5Sv\x14\x96\x0f\xac\xec\xd7\x9b;X\xe2,\xd3l)P\xab6\xa0=\xd7d\x91-\xb0\xc6*\xf2\x1br\x022\xdc\"\x04H\xb3\x0f\xa6\xc9\n`&l\x06(\x17\\\"p\x99\xe3\x8dsz\xe6ZU\xc0\xb2\x0c\x8d\xf1\x1c\xc8\x0d8\x0dx\xf0\x800b\xa5#\x9aJ\x88\xd3\xcdf\x9d(\x00\xa7\xdd9\x96 \xf1W\x8c\xacQ\xc5\x03\xad\x1f\xd8L\xf7k\xc9\xc1\xaa\xaaq\n\x87\x95\xfd\xe7R\xdd\xdf\xb5X\x02\x97\xfdg\xe8\x7fv\xe6\xc4\xd9	\xb7\x18*U(1\xa0\xdf|\xf2z/h\xe1\x91<%\x93\xcbW\xa5\x92\xf7\x1f\xf9\xe6ST\x8e\x03\x01\xb6U\x9a\xc6\x15\xfd@\xda1B\x0c\xa8\x90\xdc\x99\x88\xeaU\x8e\x12.X\xce\xa3\x02\x1e\x07@\xaa%\xadv\xc2\xed\xfd\xe0\x04	\xc9\xff\x8c	1`\xfa\x7f\xbf\xa1OPy\xadZ\xbc\xff[\xf4\xfd\xde\x92sL~\x8a4K\xd4\xa3c\x1d\xc0\x0bf\n\xc8TU1\x99\x1f\xc0K&\x07\xe5\xd5\xd4\xa4\xca\xbe><\x1c\x96\x92o\xf3\xeb\xc3\xc3\xc3Q\xcf\x90:\xb5L$\xcf\x88\x15\xe3\xe2\x95\xd6\xe3\x01i\x00^i\xad\xf4\xa0\x87\x9d\x84\x83\x03\xa5\xb3\xa1\x9b|\x04\xc9)\xe3\xe3\xf9\x1c\xa2\x80\xc1\xa1\xf3\x08\x1eYv\xce\xcc\xc8=\xf4\xb1T:\x7f\xb8\x12\xea0\xf5\x18\n\xa5\xed\x80\xc2\xed\x0c\x06u\x8b\x1a\xc8\xab\x7f\x04W2\xfb\x08\xbai3{\x14\xdb\x97\x11\x99f\x96\xab\x8aq\x99b\xbb)\x90\xdefDu\xf3\x9d\x1f\xa0\xe9q\xfe\x17\xc4\x1foj&\xf3\x17	\xdb\xbcr#\xf0B\x088\xa1%\xf1\x91\xc28\xf1\x88\xd3$\xe6\x80B\xa1\xd3}8?~\xbd\x0f\xa7\xe7\xdf8vp>\x9eZJgk\x0cP\x80\xf2\xd4\x02\x9b\xcf\x9d\x8e?\x803\\\xba\xa9\x80\x83\x00\x80	\xa3\xc8\xdbc2\xc7\xad\xbb\xbf\xa9\xb7n\xdd}:ok\xb2\xbf;\x02\x92\x19\xcbZ\xf4\xda\x00L\xff\xf3L\xe9\xc4C\x99#\xe6\xe4\xea\xba\xcb\x1e\xcfJ_ \x19\x98\xba\xff\xbb%5\xde\xf6\x7f_\xcdb`B\xba\x07\xe2\xca\xf0\x1cVA\xce\x0dI!\xd9T\x12\x1c\xb2e\xbb\xd28\x17\xa9\xeb\xf1\xbd\xe6\xd7R\xb5\x82]\xab\xfb\x8fe\xb7\x13\x94\x84\xe1\x06J\xcd\xae\xb9\xec\xa2\x82`	\xf5Jg\x98SX3\x90~\xd5\xc9\xfeg\x8b\xb2_C\x08\x80l4\\^?6\x9b;\xee}-\xe7\x81V\x04\xbaO\xe6D\xa0Ue\xff\xdfy\x07\xcc\x9f\xa9\xa3\x90\xce+%\x95\x9a\x86\x05\x13\x02uw\xaa\xf2Q\xd3]\xe0\xe6\x8eW\xb0 \xffV\xf3\xa8S||\xd5\xdf\x82@9:\xe2\x1d\x18P\xa4\x80W%\xabx\x82W\xa8\x19\x13\xc7\x8f\x1a\x9eh]\xa2-\x01\x0f,\xe2\xa5\xfd\xbb\xacO\xb0a	\xf7-\x94J\xdd\x8e7\xfe\x93\xa6\xe2\xc7\x17,j\x88O\xe3\x8dM3\x8a\x10$\x99\xf1\xe7p\x18\x10\\u\xf7\x1f\xb9\xb1d\x18Vj%\x9dW7\x80\x0d\x8f\xd1\x8e\xd4\x14<\xc7#.%\xe6\x03\xe2\xefx\x8ep\x8c\x02\xc9y:W\xc6FIu\x13$\xa7$h\x06l\xc1,\x14\xac\xa5p\x1e%\xe4~\xc56\xea\xad\xbc\x81[O#\xe4\xa9=cB<K\x11;;m\xc8#^`NF^*\x0b\x86\xcd\xbd\xff\xbcT\xba\xf4\xe6\x9dD\xc3-\x86\n-s\xab\xb6\xf7\xbc\xc0\xac\xd1\x86\xb7(\xc6\xc0c\x18s\xb4S\xa8\xcf\xe5\xe2\x17\x8e\xa5\xb1\x16\x14tZ\x05,$\x06\xdc4\xe8\x88H$6\x9b.\xd1\xe5|F~\xf3\xafCF\xbe_\x17\xf7w,\x8f\x8cv\x1c\x84\x9c\xc1\x1f\xa7\x85Z\xfe	f\x8d\xb5J\x92\xd0\xd7\x82e.\xd4\x1f\xd21IP_(\xcdW\xe4\xee\x8b3\xb5<\xf7\xc6z\xd8\xed\xbba\xf2\x11[nvv~\x04\x02\xb8\x14\xc4\xcb\x9a\xd9\x02)\x1f\xc6$\x18\xcb\xb2\x12\xd3\xbb\x1d6!\xa6\xf8\xc2\xf6q\x8aV\xecM\xbe\xf9MF\xa8\xa42\x96\x89\x05O4\x0f\x1f\xf9\xed\xadZp\x19\x16$\x00d\x0b\xbeSd\xbb\"\xa0\xb7\x14\xfeZ\x83\xfcwP\xab\x1c\xcaF\xaf\x94V\xd5\xae\xd6\x88w\x1f\xa1\x9bm\xe8\xed\xbd.\x94\xb2\xefZ\xd4\x9a'j\xc9Y*\xd0JYPq\xceor\x12\xd2p\xdc\x14\xc4%Eb{\x1c\x9b20X3\x8a\xdd@\xa8\xcc'!\x05/)\xe0yy|\xb6\x0f()Z\"f\x9e7B\xf8-X\x9ek4\x06\n\xd4x\x00o\x91d\x0b\xab\xdav\xb4Cc|b%\x04\x1a\x11\xf8\x00^\xdd\xb0\xaa\x16\xf8\x1c\x9e\x16\xd6\xd6\xe6\xf9\xb3g\xc1\x8e\xfa\xec\"\x19\xe9\xa7;g\xdd\x8e\x03\xfd!\xff\x91\x18\x90D\x80\x18\x8bI\x7f\xd6\x7fe\xd0\xc7\xab-\xab|R\xedZ\xe5\x93\xeaQ\xab\xbcz\xc4*{v~\xcd\xb7\x8c|\xe4\x9dm\xbf#\xf8\x0b\\\x1a\xd2ND\xbe\xcb~ZwV\xe7zh4|\x85\xa4\xd8\x95\xceC\"\xd1\xa0\xa5\x8bH\xae\xf5\xc7|\xf4\xbcN~\x7f|\x14v\xa1\xf1_p\x93\xae\x99\xcbZ\x8dz\xe3wq \x06p\xee\xd3m\xdeH\x0b'\xc7\xe6\x00\"\x0cdL\x82\x92\xa2\x0b\xba\xd7\x91\x9c\xba\xb7\x82\xcbrD\xfd\xea\xc6\xa2\xa6\x00W\xe5%[\x8d\x86\x8f\xe7.\xfa\\UlEW\xeb3I\x98\xf3p\xd5\x18\x97\xfd\xf6\xb7\xbf}\xf6-\xc9\xf3\xb3\x88a8}\xc5L\xa6\xc6\xcb>\xf5\x9f[N\xb8\x07\x89>\xce\xeeR\xc7\x82\xc3\xfa\xb0\xd2\x0dF\xd6gdp\"\x9at\xb9m\x92\x94\xc6\xa9\xfbt\xa1\x05\xca\xe8>\xfb:\xc0\x98\x85\xa0\x1f\xc1.\xc7 \xc4@\xb5\xb5\x92,\xd4\x9ckc\xa1\xe5\x86o\xedw\xf3\x9d\x13\x87a\xcb\xb7.\xc5!\x19l\xd6\xfd\xad.\x9b\xa8l\xa2\xd7\xe4\x12dM\x05\xc5\x90Ii\xea\x90\xb0\xf0\xbc\xb7\x85|\xcaW\xf8\x10u\x8b\xf7w\xa52\xa3\xde\x1b\xa2\xe6!\xfd\xf6\x08P\x03-\x9c\x1em\xa1\x7f\xcfs[<\x82\xbf\xff\xbcY\x97\xdd\x97H_\xd2\xaa_\xa6\x1c\x17\xcd\xe5\xd5\x80\xf8\xd4\x7f\x06\x0e#s\xe73\\\xde\xc8\xc7\xa4n\xe63\xc3d}\x9cv%8r\x80S\x87\xd7#\x9e\x16J\xdb\xac\xb1\xff\x8e\x0dTN\x19\xb6T\x06O\xc7\xa1\xfd\xed\x81m9\x1c\xe1\x9c$\xa6B\xb8O\xb6\xd7\xfd\x8c\xf1lNn\x99\\4d/\xe2\xf8\xac#^\xe4\x1a*\x89\x94\xbf\xce\x9c|\x8f\x87\x97i\x06\xf9\x14e\x0c\xdd)\xb3Lr\xc1\xac\xa5$\xa1U C\xf8\x930\x93\xc4\xe5\x10\x92\xc6ksQR\x1c\xdc\xb5\xb8\xb4@\x99\xf1\x8e\xcf(\x01\x1e}\xc6\x00\xfd.\xa6\x1d\xe4\xd6\xe4\xb8\xa9\xb2<\x1bi~\xb7\x92\xfd\xbaB98\xf4\xdf\xeb~mK\x16\x0bhT\x02Z\x83\xa1\x1aR\xcb`\xe5\xbc}\x8a'H\x9cZ\x9d\x15\xcdnv\x84\xf0\xcf\xb97\xa7\xc3.\xc7hJ\n\x96\xce\x92\xc9\xf8to(\xa5\x1f\xe6\xd3\xc5\xc6\x97~~l\x14y\xb9\xf4\xb2`:\x99-\x99\xcd\n\xca\xc8\xb2\x99j|}\xcd2\x9d\x1cP\x89\xfc\xc1\xad\xbe\x13\xf9\x10\xe2\x87m\x93\x05\xc1mx\xf9H\xea8\xba\x1b\x10s\xc8\x97c\x0e\xf9\x94\xd55\x19\x1b5\x1f<cE\xce\xb1\xd9Q\x881\xa1L\x1a\x8b\x14[\x90-\xcc\x1f$\xbe\xd4\x92\\\xbd\x91j\xff\x19\xde\x95>\xb6\xb9\xdb%\xc7(\xe4&\x0evn\xbesT|v\x8d\x88 \x97\xd9\xed\xe1\x94\xa8V\xc2\x1c\xc0ic\xec\xc3\xac\xb4\x92i\x004\\U\xa4\xe4\xfcKwG\x1c\xfdR\xa3{\xd0m\x97\x85\x18\x15\xb20\xf5\x0f\xb9.\x1e\x98|\x97AX\xfe\x95\xde\x0b\x91z\xe2\xbc\x0e\xef\xa5\x0e\x94\xfaA\xe7\x17\xc3[.Kp\xf3\x86\xf87Z'Z\x05d\xab1w\xaa\x01\x1a\x99\x07\xdf\xd0}\xd2\x14=o&xV\x1e\xc0{b\xdc\x90^\xcd\xf7A\xb2\x96/\x98E\xf7&\xb6\xd8\xc6\xc4\xa5\xb1\xc8R.\xa8u#q'\xf1yNc\x81\xcd\x02U>\xfe\x1bXo\x08\xf5((+X\x0eLv\xa3\xd2q\x11\xda\x19\xe4\xacK\xf4\x8f\xdb\xe8\xb2\xd0\xc8\x92\x14\xab\xdf\xc9\x86\xd1\xad\xad\xc2\xe0\x17\xf6\xa2\xd38=\xfe`\x9b\xae\x19\xb0OEc\x01]\xad<f\xbc\x8e\x95\xcb\x13\x99r$LS\xcesXs\xf1\x8c=\x1b\x93\xa2\xe1\xeb\x91\x18)]O\xc5\x1a\xd2\xc1\xdf\xa9&\x11\xa8\x0b7\x0e\xa4\xb4\xa1p3\xfe|\x17\xb1\xb8\xa3d\x86Tv\xd6\x1d|\xfdk`\xc6\xf0\x05UOd\xd4\xe6\xc1.\xd0)Y\xb8\x8ed\xd7\xd9%5/\x0cdc\xfe\xecH4\x08\x97cKC\x0c-\xa98D\x8e\xeb\x8c\xe6\xa9\xc4F=\x03N\xdfZRvKn\x0bx\xfag\xfd\x94\xd4\xde\xd3?\xcf\x92\xe0\x80\xb6|'\x93\xb8\xf9-J\xd2\xc5\xbeF1\xaaq\x97\xc6w\xde\xe2P\xef\xa6\xc9\x04\x0f\xd5\xd4\x92\xd0\xed\"\x0e\xec\x8a\xb8F*f\xb6x\xc9\x13Sw\x11\x06\x81F\x8deU\x1dye\x98\xa1\xab\x02;LS\x14t\xf0\x1c\x9e~\xedn\x1e\xd8Bm\x9d\xaaF\x96^\x9d\xfb|HI-\xba\x8b-\xd7\xee\x8f\x174\xf6'*\xaf\xf9\x89\xe8\x0e\xb4\x08n*\x06\xefA\xf24\x01\x81\x0b$B\x11\xaaf\x8b\xf4Z\x94\xb2?\\\xbc\x1d)\xa1\xb8\x92\x06\xf6\xb7>\xe3b\xe7\x1e:	L\xaa~$jFU\xdbI\"\xea\x97 _\xca\xe5\xc9]\xae$\x91xW#\x1b\xf6<\xd7\xac\xedo\xed\xe0\x9e:U\x10\xeah\x0fs\x904N\x95?&`\xc9e\xae\x96#Z\x93z\xecS\xfa\x88\x12\xe7\x94\xc4\xac\xa9jw\n\xcf\xc8\xf1\x8a\x92\x02\x9d;\x19\xe9\xb5:U\xe6	\xe1\x86\x89\xf1\xd5\x8eb\xcb\xc8\x1a\x8c\xba\xbf\x0b\x9bM\x99\xb0\xfeJ(/\x83`5\xaf3E\xf9\x1c\x99\x8f^U\xa8\xa2\x12v\xb8@*B \xa5\x84\x89\xa6\x19\x8ef\xea[_p\xa0\x8c\xb2\xa6\xa2\xa2\\l\x11\xd3d(\xd9\xa88\xa64p\xf6\xe2]\xa0$\xce\x1fd\xaa\xdaz\x9b\x04\x05\x95\xa5\x94\xdc\xe9I\xb8\xeaj\xbdY\x93\xfc\x80\xa1\x8a\x0dg\xaeZ\xed\xebG\x01\xb9\xeb[h\xa1V\xf7\x1f-B\xbe\xf9\x0f\x95\x15T/\xcc;\xeaA\xd8\xc9\xc8\xd2J\xdb\xc5:\x1a\xcf\xc8\xaf\xcf\x0b\xd1\xafM\x9a\x801\x05\xab\xf8\xb6*\x9c\x8eC\xfb;\x03\xbf\xa8\x10M\xad\xb8H]\x0b\x1f\xb8M\xe3p\xaa\x89\x03\xec\x83\xd0\xc7X6\x9fo\xf7)\x84\xfe\x08\xad\x86\xf4\xe8\x90\x0e\xb6\xad\xbanh\x86B\x9cB\xb0\x96\xdf\x7f,1\xfa\xaaI\"\x80\xb4\xdf\x03\x85\x96t\x81\x8d\x8aL\xb9Z\x9f\xf0\xef\xe0\xf2	i\x9a\xd4\xd7\x90#\x92K\xf7\x15\xee\xc8\x95\x99]1z\xa7\xd0\xec\x19\xdf\xbf\xf6)\xbb\x19V\xbb\x90\x8d\x89(\x18\xae\x1d\xa5s\xba,\xa0<k\xaa\x19j\x12\x13\xb2\xa8N_\x933:\x9a\xdb\x19\xce\x95\xc6\xc1H\x90\xbf\xfa \xfb\xba\xb5;\x1f\x9d\xe5S.\xff/v\xdfz\xc9G;N|(@\x96\xbd&\xfa\xd5\xae\xabo]\xf1.0\xd1@\xfe\xb4V\xd7\x02\xf5n\xa4\xea*\xf5\xfd\xcf\x9bO`v\x00\xa0\xa5X\x85Z\xca|\xac\x92\xa8c\xabY\x86\x95\x1a\xb5\x98\x1b8\xa0\x91\xfd\xedo`\x92WH\xbdd\x88\xd2\x14\xca>\x90mjo8z3`z\xe9:\x18\x92&\xa9\xed\x8a\xa4op\x08\xedh#\xcc\x0e\xb6\x7f8)\xf2\x18\n\x17\xc9\x04\xf7\xeb\x9dt\xd6j@\xf5\x9e\xe6\xc2\xf3\x19\xd2\xc0\x94\xee\x8e	\xa1\x17Qo0!:\xca2\xc6w&\xcbB\x0b1\x7f\xb8t\xdc\x16g\xd5\x97\xb3\xb6T\xd8{,c\x1b=\x17?\xaf\xb1\xe5\xb8t\x84\x15\x84j\xb0~\xc6W\x0e\xc1m0:\xd2\xc1\xe5K\xbd\\2\x81[\xf5\xa5\xf7T?\xa0X?\x9c\x92j\x11&h!\xe7,\x05\x87\xdaPl\xa5\x1f\xbf\xd1\x80s\xa7o\xe4\xdc7-\xf6\x9fA\xbb\x1a\xd6?\xbfAG\x85\xcaQO\xfc\xc1\x7f\xee'\x1f\x0f\x0d\xcb\x93\xbd\xbf\xee?\xd9\x9b\xa8\x9a\xac\xdb\xd0\x05+}\x13\x81\xffK\x08&\x9euilN\x0d9*\xf0\xb2\x9f\\\x86\xacP\x9c\xf3\xdf\x01\xb5Q\xda\xd2\xb1\xa3z\x9f\x1c\xeb\x85l,\xf7\x0e\x04Eb\x02\xa9\x19\xb4Pc\x85\xae\xf1s\xdelu\x943@9.\xb8\xffh\x9b\xd0>@\x0d\x9e\x01\x8d\x1bM4/]\xfa\xc4\xb2\xa1\xa62\xb9\xda\xacQ\xcd0\x93\xfdO~\xcd\xe6\xbfl\xff\xd9W\xea'W\x9d\xcb\xf4\xf7\xeb\xd6i\xf0\xed4\xd9\xe4C\xbf\x9e\xb16\x100-5\xb3e7l\x81U-(~\x8a\x977c\xf2<\x15\xbc?\xa8&)s\xb9\x8aI\xees\xfc\xcf\xbe2\xcf(\x89\xf3\xd5\xd8\xed0WB\xa8%YI\x8d\xcc(\xf9<\xbe\x1f\xd5\xaf)'\xe4Z\xa0\x9c\xd6Eb\xf0\xaf\x0cy\xe1\\\xc2W\xe6 \x80\xbeH\x13\xcd\xb4\xcc\x97\xf0\xf7\xa9\xde\xa0\xe1\xe4\x9c\x14\xeeW!\xcd\x1b\xde\xa8\xe1\x91x\xdf\xff\x1cR\x9e\xd4\xd3\xe6\x19~\x12B{\x9a\x9b\x86t\x8b\xf3\x13\x1a`A\xf1\xd2\xe6\x13\x96\xe7\x04\x12\x92\xc2a\xcc\x05*S2\xd0aN\xb3\xfb\xbf\x812,Gil\xeb\xb3Rtm4}\xc4\x02S\xb9\x8b\xd2\xd3\x1a3\xbaY:\x15\xd6\xd6$\xdd\x1e\xd4\x12\xbd\x0f\xe7go\xf6]\xe7\xa9\xd2^\xfc\xe7\x9c\\Q2;\xbe\xf7\xb1\xa9 \xe7\x15\xba\x90\xd9\x90Y\xfa\xe6\xf0\xf0\xe6\xeb\xc3\xc3\xfda\x9aV\x80\xcb\xd1\xfbY\xf8\xfe\xc8\x89\xb4\xa4\x80\xbd\x91\xf9A \x88\x14\xe0\xe4(\x9c4t\x9d\xd1P\xd2UH]rr\x81I\x8ag\xf2\x81\xf2W\x9bOP\xa0	\xcd\x8b\x04\xa4_\xc6\xeb|Q\xd1/\xda:\xe9V	\xee3\x19L\x98\xa9P\xfc\x9bd\x82\xee2\xa3=_\x86\x9fa\x1c\x19\x99\xb8\xa1|\x1b\x86\x95\x9c\xf3E\xa3}\xb8N\xf3g\xae\x19\x81\xa8\xa17kv\xc0\xa6\x8eO\xb6\xe1<\xef\x04@J\x81\x8c\xc8\xae\xba\x98\xccK\x90\xe5\xcc\xba;9f\xa1\xec?\xf1\x9e\xc5\xb0\xeaC(\x13\xa4k\x1cD\xb4V\x03\x84\x97AU\xa6@!\xc18\xc0\x04\xa3\x1c@\x1a\x1d\x13{\x93\xe3\xf8;vl\x90\xfa'\xc2\x9cG\xd3\\\x07\xb6\x8eM\x1443\xf5\xbd\x16k\xdfk\x11\xa8\x9f7Y\xf9\xce3\xee\xeb\x1f^~\x0f\xef^\xbf\x9e$\xcd\x024\xfeF\xa8\x99k\xb0\xf5\x13\xae2999\x8e_(-\xb7\x8euN\xfc\xef\x80\x99\x0b\x81\x0b\x8f\xe1\x0c\x05.\x08E\xe7;w=\x00\xa5\xcaCb\x8fV\x7f/xI\xcd\x84\x1a\x87\xa6?\xd2T\xaeA5<8\xad\xe0r\xf1@f\xd8\x03\x01\xf9%\xf9\xf8\xf6\xf0\xf0\xe6\xdb\x7fB>\x84Z(\x97b\x9f\xbc\x0b\xe1B\xd4\x00~\xc67\xf3$\x93TzKZ\xdfWLsR	Q\xa5\xa7\xe9VZ\xf8C\xadVJ\xcb\xd1\x12\xa4\x00\x97\x97\x04\xf2\x9a\xfaQ\\b\x91\x92\xb1\x98;c\xecbZ\xf27|\xdc;k\x840\x05\x0f<3\x1a\xbe\xc9\x95\xba\xbf\x9b\x05ISKy\xa6\x86,\x17\xfd\xc7\x82o\xdb\xbc\xdd\xacCke\xda\xfc\xed\xb2w\x04w\x9e\xb2c\xdd\xe8\xc5\xc0\xb0\xe7\xf4\xe1\xe4\xd9WW'!g\x90s\x8a\x17O\xcei\xf5E\xf8\"\x93pr\xbe\x0d\xe1=\xb1]\xa8\x90\xe4	\xd1!\x19\xf5K\xa5\x84\xe55\x01\xbe\xe6\xc2\x97\x88\xe9\xec\x86\xc0M3\xbb\xc6\xcc\xee;\x95B\n\xd3\x87\xd0.\x81\x842c\x06\xe9_S\x9c\xf3b\x043\x05\xf5RE\xbd\xabq\xd1\x08F\xc9\xce\x9aj\xccti\xe1\xdd\x0d\xda\xa3\xd0(0\xa8\x8e\xd0\x18\x10n\xd3\xa0}\xebY3\x81 \x97\x98\x0cL\x93\xb0s\x10\x0dS0J\x15\x90L\xfa_\xc9\xe8\x11\x97\xe3\x04\xccB\xe5\x7f\xf02h\xd1\x07Eq)\xfdG\x08\xf5_K\xca\x8b\xe5\xf7w\x11\xb7\x8f*\x07m\x13\x02\x04H^%\\\x13\xa1:\xd7\x98W\x18\x1e\x94x\x8a\x06\xa7t\xc0Z\xf3Z]\x8f\xccH\x93\x83N\xffA\xf2\x1f\x1bW\x1c\x94\x94\x0d\xc8\x81e\x94\x8fz\xe6\x9a\x10\xc9\x04\xbb<\xb9\xc7\xea\xa2\xc9\xe70\x19\x12q\x13\x17\xca=\x07\xe7\xfe\x04\x98\xaev#\x97]\xed\x07\x1a\x19\xcc\xe5\xbb|\xc6d\xf8\x7f\x12\xef\xb3{\x0d\xee\xf9\x05\xde\x87\x91'{\x7f}\xf2\xd7'\xff;\x00PK\x07\x08\x12\xca\xb2\xa0\xa5\x14\x00\x00\xee4\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x001\x8dS]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/tr_TR/common.jsonUT\x05\x00\x01OV\xd6j\x8cW\xcdn#9\x0e>\xdbOA\x14\x10\xcc\x0c\xd0\xc8\xdc\xfb\xe68\xd5\x89\xb1\xf91\\\xeen4v\xf6\xa0T\xd1\xb6\xda*\xa9VR\xc5\xe3\x1e\xf4y_b19\xeeur\xe9\xd3\xde\xe2\xbc\xd7\x82\x12U?\xb3}\x98\x93\xc9\x8f\x9f(JER\xf4o\xd3I\xb61\xb6\x16>{\x0b\xbfM'\x93\xecAh\x8dU\xf6\x16\xb2\x8b\xd9\xdd]~	\x17\x9f\xe0\x873\xf7\x03\xbc\xbb_\xc1\x99\x0b?\xbfdg\xee\x97,{C\xfc\n\x15\xfa\xb8\xe02\xbf\xc9\xd7\xfd\x8a\x91}\x85\xc2\x19\xfd\x1dVpHB\xa4\xcbZl\xf1\xb2\xf7\xb9\xb8\x9d]\xe5\xf0]\xcf\x81Z4F*\xb4Cr\xb1\xbc_\xdc\xe4\xab?\xd35\x1e\x96\xc6y\xb7\xd0\xeb\x9dE\x11\x16\x9cU\xa0\xf1\x00\x8dq\xfeG\xf7\x13H\x0d>\xd8\xce\xe3\x0e\x84\xbb\x99\xae\x16\xb4\x93\xbb\xaf\xa5\xe7\x93\x9eU\xdd\x1a\xa1+8\xab \x04C>\x0c\xb3z\x07\xdf_7\xe6\xb5v\x8b\x15\x85G\xde\x97\xf7\xc5\x1a\x96\xefWW\xfd\x11\xfe\xef\xda-V\xd2b\xe9\x17\xcb\xb4\"_\xc1*\xbf\\\xac\xf29}\x83\xf5=\x7f\xa5\xf1%\xa4e\xfd\x15\xac\xafW\xf9\xec\xf2/,u;Q\x99\xc3\x85L\xe9Q\\\xcf.\xef?\xc2\xc5\xe2\xafdI\xbc\xd6\x1bS\xee\xd7f\xbbU8\xdc\xfa\xcc\x8dclu\x9f\x83\xef\xef\xc6Y\x18\xbd=J<`U\x88\x1a\xfb\xe3\x17p\xff\x0e\xd6\xd79\x14\xb3\xdb\x1c\x16K\xf8\x98\xafr\xf8\xb0\xc8?\xf6\xd1e\xd3\xc9\xd77\xd3I\xd6\xa8\xd6\n\xe5R\xcaW\xe2\x98\xbd\x85\xbfO'\x93I\xb6}\xf9\xaf\x0e\x9bDQ\xa1\xcd\xa6\x93\xc9?\x08\xc9v\xa6\xb5\x1d\xd1	\xe1\x99H\xe2\x88XK\xddz\xec\xa8\x95\xd8\xcb\xbd\xc8\xde\x0c\x95\xceim\xb4\xdfuTqd\x9a8\xf6\x14\x87\xa5\xd1U\xc7a\x95\xf7\x0e\x8a\xeb\xc9G\x14}\x90\xc7\xd3\xb3bb\x10\x89\x95\xee\x80R3\xdd\x80\xa8j\x19\x8as\x16\x04Z\x91\x89\xad!\x84~\xa2\xaec\xfd\xce\xe87 \xa5\xd1\xde\x8a2\xe4\xec<\xc9#K,\x9c\xa1\x1d\x18\n4\xfc\xb5\x11\xe1`\xd9\x15j\xf9\xfa\xa4\xd0\x0f\x0d\xfd\xea<\x10Gkw\xb2\xa2\x1b\xce\xae\xe4\x17\x85\xdc;B|)\xfe\xcfBKol\xd8\xfd\x16e\xc5\xc7\xf8\xdc:\x7fg\x0e\x84\xbe>\xc9\xba\x92\x11V\xa6\xdc\xc7\xacd)\xa0\xb5p{b\xde\n\xb7\x87\x8dT\xa8E\xcd\x9b\x91i\xbd&\xe3\n\x1b%J\x0c\xf6\x1f\x1c\x10\x05\x0e\xd2\xef@z\x07;\xe1v\xf0(T\x9b\x96\x99\n\xad\xe8\xe2JZ4\x9a\x83\xc6\x18\xf0\x85\x11\xb6\x82{\xd2\xb9\xfa\x10gJ\xd1v\xd7\xd88\xa9%l_\xbe9\xdf\x99w\xf1HW#0\xf6FZ\xc4m2\xe2>\x94`\xe1e\xb9\xa7\xc4\xcfbI\x82\x8b@\xa0\xb4\xba\xbf\x90N\x0e\x96\xa3i	\xfc\xb1@\xfdS\xaa'w\xd4%\xe7\\VIW\x1a\xad\xb1\xec\x9a \xabRo9\xd6\xa3.GJ\"V\xc8\xda4\xe4r\xe6e\x8d)CK\xa1PW\x83\xd4\xbe/SE\xbd\xfe\xde>\xb0x+,Kw\xd2\xb1t\xdb\xd5\xd4\xb5\xf8\xc2\xd2\x1ak\x96f\xa7\xa7\x96\xc5\xfc\x98j%\xdfK\x96\xfe&\x92\x9b\x99\x15}\x91\x1d\x10\xf7]$\xcb\xce\xed\xf2Kj	\x85H\xbe^\xfee_\x9fX^\xf6\xe2<\xed:\xf7nX\x96\xad\xecj\xb2i\xd4\x91\xbf\xf9\x8cd\xf0\x06\x84R\xe1\xe5\xe1\x02z\xa04\xe9\xf2%a\xde\x9b\x9a\xc0\x99\xe2r*\x85.1$\xcf\xe9\x8f\xc6shY)\xbcPfK\xf0\x9c\xc5\x88+Imz\xde-\x9a\x13@\xbb\xb3\x9f\xc1\xbb~\x91\x02\x88O6<\xf4qTF\xd3\xd7\xcb.\xe97,\xc1\xda|\xa6\xe3ey\x10\x02FE\xb36\xe6F\xd8m`\xbf\x93\n\xc1\x1b\x03* \xcc\xd1\xd2\xedx(\x91\xdes\xc9n\x8d\xd9*,\x8c\x0eGx\xfd]\xd8\xfd\xe9\xf9xz\x86h\xa8\x04\x08+|-\xf6 _\xfeCO\xfb\xe9y\xaf\xc4\xf1\xf4\xcc\x0dB\xd6\x8d\xb1~n\xacm\x9b\xd0\xc3\x16\x01\x80\x8d\x90\n\xabs\x08\xa1\x94\xd1\xcc\x03A\\r\xc9'c\xbek\xcb\x12\x9d\xdb\xb4\xea\x1c\xd6;\x84Fl\xa9\x01(\x05\xda\x1c\xc0\xa22\xdd@!\x9bk\xe1\xa8\xdbg\x8beh\x0d\xdc|\x84\x0b\x01\x14\xa9\xb3*S\n/c\xbf\xbdIr\xd7\xa7\x06\x8fw\xac\xdc\xf8\xba\x02\x95l\xcf\xc2jm.\xfa\\x}\x12\xa7'\xba\x9c\x97o\xbaB+U\xea|\xb5\xb0\xfb\x021\xecu+\xec\x1e\x84\x03Gz\xb4R\xe7\xfc \x9d4:\x8d'\xa1\x99\xc2c\xc0\x06\xa4\x08\xc4\x96\xf8A\xe2\xa1\xcfU\xd8XS\x83\xdf!8\xea\x8d\x8b%m\x11TT\xa1O\x84\x94N\xae\xec\x16\xfb\x03\xde\x92\nR{\xc3\x87d\x96y\x1c\x92\xcc#\x8e\xcd\xad\xf3\xb7\xc2\x97\xe1\xa6\x97\xc2\xb9\x83\xb1\x95\x03\x82\xa1\x0ex\x9a\x08{'\x9fPK\xd8\x1b\xdd\xf2\xe4\x86\xba\x92z\xbbB\x9a5\xc8\xbe\x8c\x00\xd8\x88\xf0x'\xb5_\x9bT=\xc4\"\x84J%a\xfd\x18\xc8\x8fW 9\xef~\x8e\xfa\xcf\xeb\xf5\x0d\x93h\x06\xec\xc7\xe4(\xc1\xc6X\x08\x96H\xfagkx\x04\xbd\x90V\x82\x93_*\xd4 \xd4\xe9Y\xfb\xd33\x1cE\xe3O\xcfi\xd4\x1b\xbb\x1a\x0f\x80\x17\xc7\xfe\xe8+\x1e\n\xc3\x17{8\x8e\xae2\x0d\x8c\x143\xda\x11\x9b>\x1a\xda1-\xbd\x88\x91\xe2\xc0S\x15\x87\x0b	\xdf\xbb\xc1Rn$V\xd0Zn#\x167\x16cI\xacXd\xbcQ\xe1q\x9a\xe3\xa3h\x94\xe8PcC\x12\x9e\xfep\x8d<\x1a\xdd[|k\xc3i\xaf\xd0J\xb8|\xf9\x96\x0e\xdc*\x0cO\xea*\x08\xfc\x98\n\x1b\x93\x83\xba:Cq\x8e\xa2\xb7\x92E\xc6\x1d\xe5y\xfek#\xf9O\xc6\x8d\xd9J\x0d\x8c\x03\xb2\x81\xc9\xbeP\xe6@\xaf:1\x0b\xf4\xe0\x949\x00=\xfa\xcc\xd8\x99\xc3\x9d\xf1\xb2\x0c\x04\x96\xa2\xa5Qr0\x96\x17\xa4\x82\xd9lb\xf2\xd3\xbf\x94\xe1gq^\xf86\x1c\xab\x88R\xf4\xd1>|\xc68\x8f\x15,&\xbc\x96	\xae\xe5\x08}gl\x9f\xe4\xd1\x1c\x92n\x98\xe7\xbc\xf3[\xc88\xc0\xe0\xd5\xef\xda\xfaA\x0b\xa9d\xec\xc0\xeb\x81~~\xce\x1d/\xce\x1a\xf3#=*\x83Y\xa3\x8c\x00S\x1a\xb2\xbc\xfc;\xf5\x80V\xa7\x8e\x9fZ\xce'\xd3\xc2N<\"\x08\x0d\xbdu\xd06Z}H\xf5\xfe\x9eE\x9ec\x82\xd2\xdf+[G\x97\xd96\xd4\xa2\xa9\xdd\x07F\xd0\xc24\xc7>\x02\xb0\xb4fk\xd1\x85;\x8f\x08V\xdd)\xff\xb4\xc9\xc7\xe1\x16\xd3\xc9\xd7\xe9\xd7\xe9\xff\x06\x00PK\x07\x08\x1a\xb0\x8d\x8eg\x06\x00\x00i\x0f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/tr_TR/server.jsonUT\x05\x00\x01*b2h\xbc;Mo#\xb7\x92g\xcf\xaf(h\x91\x9d\xb7\x80b;/9,\xe6\x10\xc0\x9f3~\x19{\x1c\xcb\x93A6\xc8\x03J\xcd\x92\xc4'6\xd9!\xd9\x96{\x1e\xf2O\x16\xf01\xd7\xf8\xf2N\xb9Y\xf3\xbf\x16E\xb2\xbb\xd9\x92f6\xd8\xcd{'\x8bUdU5Y\xacO\xfa\xef\xcf\xf6F3cK7z\x01\x7f\x7f\xb6\xb77:?\xfav\xf4\x02~x\xb6\xb7\xb77\xba\xd0\x8cB/\x8d\x86\n5)\xf0t\xefG\xe3\x80<\xd3\xdeJr03\x16\xfc\x82`\x8aZ\x93}\xee\xe0\xdc\xd2O5i\xaf\x1a8rK\x12\xf0mM\x8ei8P\xd2y@-@f\x94K#P\x8d\x9e\xed\xed\xfd\xc8\x84G\x7f\xb9>{y\xbb\xa8\xcb\xa9F\xa9\\'\x0b\x83\xc1\xf7p\x9e\xbb7*i^\x17\x08\xb5#\x07\xefhz\x9d\xcd\x80i\x03\x82fX+\xbf\x0fg\x1a\xa7\x8a\xc0/\xa4\x039\x83\xc6\xd4\xb0B\xed\xc1\x1bpuU\x19\xeb\xc1(A\x16\xa6\xd6\xac\x1cY7\x86	\xce\xd0J0\x16\x8e\xaaJ\x11\x08\xba\x93\x059@\x1f>\x97\xee+\xd2\x8e\xc0\xcc@\x9b\x9e-x\x8b\xdaUhI\x17\xcd~\xffUW\x93\xf3w\xdd\xb7\\\x19\xcf\xe4	\xce\x8d\x85w\xc6.\xd3\xd7\x1c\x1b\xb4\x02P)\xb3rP\xa2'+Q\x81_\xa0\x07\xe9@\x1b\x0f\x0eg\xc4BO	\xee$\xad\x88w\x12\x10V\xc6.\x81\xf4\x9d\xb4F\x97\xa4}\xcf\x17\xd5\n\x1b\xf7\xda\x14\xcb\x8e\xfb+\xb2\xf0\x1eK\xd4\x80\x1f\x1ep\xfd\xb0~\x14\x08KT\xa3\xf1\xa7\xd1=MmtSJG\x1d\xc9#m\xb4,\xa1\xc1\xaa'\xb2$'\x81\xa7\xca\x12\xe6O\xffp\x9elF\xa2\x16\xd2|gT]fD\x18\x06w\x11\x18\xc9\xc4\x19\xbc\xc7a\x01\x7flY;Y\x04\x1d\xba\x93\x82\x0cT\n\x1b\xb2.'\xed\xcd\\\xce:\xb2\xaf\xd0\xd2\x92\xbc\x92\xf0\xf2\xe2\x1c\x96O\xbf=\xfd\xf2\xf4\xdb\xd2\x92\x93\xa5\"+\x13\xa7\x9d8X\xc4\xb5@\xdeI\xdd\xb3\x98\xce/k\xdf\x0b\xce\x038\xc6b9\xb7\xa6\xd6\x02\xbec\xc1\x12\xdd\x80c}\x99\xf6\xf8 xN-.h\xc9}\x84\xd2\x91(\xa5\xfe\xdcUT\xc8\x99$\x01%\x95\xe4\xa0\x92\x15	p\xde\xa2\x9c/<H\xed\x0dk\xb7\x85\xd2h\xe9M\xb6\xe5SV\xae\xb3\xfbJ\xda\xa6g\xc50Vei\x1b\xf0\xb2\xdb\xf7\xab\xba\x9c\x92\xe5}\x17\xd88XI\xbf0\xb5\x07M+\xa8\x8c\xf3\x0e\xa643\x96\x00!Pe\xed\x14\xa4\xc8\x93\xd8\xe0w\x85\xd9\x01Gn\x1a{6\x18\x0f\x97\xf7\x87i\x07b\xfbp\x82\x1a\n\xa3=J\x0du\xc5\xea\xfe\xc5!(\xb3\"[\xa0#x\x8d^jP\xe4=Y\xc7\xf7S\x07i]v\xdb\x02\xa1[\xe9\xd5&s\x1f`<go\x14\xf0[\xec\xc7\xe0\xeab\x01\xe8\xe0\xf9\x91\x96e\x0d\xff\x0e\x97\xa8\xe7\xe6\xf9>\xbcM\xb2\x1cB\xb1@\x8bE\xe0\xaf\x8c\x9e\x0f8\x8b|{E\xd32\xa3{\x0fS#\x9a\x96!\xefc\xbf\xac\xc0\xca\x17\x0b\xecV\x9e\xa4q:z\xb7d\x13g\x1d\xf3/LY\xf1V\x03BZ\x15LpA6l\x98G\xb7dC\xbb$\xb04\x97\xac\x19\xc1\xca\xf2\x8d\xf1\x0bK(\xa0\xb0\x14`[\xecoq\xee6E\x00\xcf\xc0\xf4\x118\x0f\"8S\xdb\x82\"\xdb$\x823\xaaf\x9an\x1f\xdeh\xe2E\xac\x14\x16\xb50\xa5j\xa0X\x18G:\xac ,\x160'M\x16=\x89\xf6\x1b\xf6\xe1\x86~\xaa\xa5%\xc1\x0c\xda\xd3G\x0f\x8a\xd0y\xf8\x12\x84\x9c\xcd\xc8\x12\xdbl\x9c\xe7g\xcdg1)\x8c\xed\x8f\xfa\xa4=\x1dp\x15\x96\xe0\x022\xed\xa4\xf62\x00W\x14\xee\x8b\x99\xb1\xfb\x91\xb3F\xea9oh\xb72\x98U>\xa3}8\x9ay\xb2@\xf7\x05\x91\xe0i\xac\xadJ\x96\xd2\x87_|.\xb0\x92J\x81\xa6(\xbc3\xea.;\x9d\\\xd4\xdayS\x9eL&\x9d\xa8I\xac\xb7\x8e\xec\xe7\x82fR\x93\x80\x93\xc9\x04l\xad\x88u\x0b\x05	0\x1a\xbc\xa9Z\xc5q\xa4\xa8\xe0\x9d\xf3\x0b*i\x07\xf1[3\x9fg\x8a\x7f\x12\x982\xd5\xc4,\xe2Yp&Y\xf4\xe8\x8eTr\x9b\xb9\xa0\xa7\x11\x94\x98&B,\x00\x1f\x972(2w;\x86\xd5\x824h\xa3\x89\x95\xc0\x91g$oV\xa1\xe4\xc0;%F\xafQ\xcf\xb78)\xd4\xf3\x1a\xe7-\xb3\xd7i\x08\x15\x16\xcb\x1dLs\xe9]\x8d\xb6X\xc8\xbb~\x13N3X\x14^\xf4\x90}c\xe7\x10\x8c>\xa0\xc5\x123R\xd2q\xdcpc\xa6\xc6\xf77\xe3\xda\xd2\x1d+baq\xa5\xd8\xf7\x8c\x07`\xac\xbda\xe7-`ES'=u\x13{\xdb\xe2\x88y\x03\xe9\xb9\xe4M\xd2\x82\xee\x03~fM	X\x14\xe4\\T5\xe9\x92U\xdc\x12\x8au&X\xd4^\xb0\xd3(.\xef\xfc\xe7\xc8\xee\"\x99\xe7\xee\xc2\x0f\x04u\x80\xbaaf\xa6\xd6\x1e\xb0B\xeb#\x7f>\xa9\xe7a\xfd\xf3\x0e\x1d\x10\x91\x8e\x9e\xf7\xc6:\xf3\xbb\xc2\xe8~\xbf\x8f\xa5\x976\xb1[b\x85\xd9\xf1\x90 \xdd\xcd;\x13\xa4\xe1\x06\x85l\xfde\x0f\x00mV\xc1\xbb3\xc3\x18\\fD\xf8\xf6NQ\xa9\x8e\xd2\xbf\xfd'\x0f\x01\xb5[\xf5G\xf2\x9a\xe3M\x8e\x1e\"\xb4\x8bU\xd3\xe4\x05\xba\x05\xdb\xd3\x12\xf5G\xdc\xcea\xb7\x94\xed\xe7\x9f\x0f\x87\xb6\xdf\x1b\x8f*;\x19*Q\xaa3k\xfb\xcfc\x00\x9cYk\xac\x1b\xde\xbe0\x95\xbf\x8c\x02r\x07\x91K\x94\xfd\xd7\x05B\xed\x16\xf1\x8aH`\xc7\xb2kt\x99\xa6\xa2s+c\xc5\xf6J\xa8\x12j\x17	c}G\"p\x06G\xf6\x8e,p\xa0\xbc\x83V\x86\xddAnROwR\xfb8!WO\x85)Q\xea\x9c\xda\xfd\x82\xf8lzR\xf7\xaf\"\x80\xd1=\xfe\xe3\x97\x99\xee+\xd4\xe2(\xd3\x99\xe3\xa7\xdf\xfc\xd3o\x1a\xba`\x90\xfd\x92\xfc\xf0\xa0\xa8\xfd\xca\xefIK\x98K+\xa4\"\x0b\x02\x17R\xc1\xf4\xe3\xab\xa4\x1dH\\\xe5\x1by\xba~\xfc\xf0\x80v\xfd\x08]\xa8}\xd4\xa0U\x01$\x8ck\xb0\x89\xb8\x8e\xc0\x8cHp\xd8\x186\xad\x13\xfa<A\xe3\x96%Jl\x0c\xa0\x9d\x1f1l#\x85t|\x83\xd8\x9b\xf1\xa5f/\xb2y\x93fj\xe0\xf4\xd9\x16\xd8\x06\"t\xdcZ\x95@\x84\xbd!Y(\xf2)\xec\x9b\x18\x9e\xd9\x81\x99\xb1\x05	\xce\x08z\x91\x03\x08\x18\xd6\x94\xa6n\xafBk\xae\x82\x0b\xe5\xb0\xd0\x8d\xc1[Y\x15F\x10\x1b'\x11?d\x17\x939*E\xb6\xb94\xa2\xb79/#\x0c\x02\x90g\xed\x8d\xdeh\xd5\x80[\x986lMq\x05\xdf;Y\xe2\x9c2\xb1\xe7\xcaLQ\x9d\xfe.\x83\x0fq\xb2j\xfe\xc9\x96?\xf9\x8fL\xa7\xe6\xc6\xe4\xbe\xfde\x1c\xa6\x14&\x0cv;\xb2\x85\x14\xb9\xd9})\xdf+\xf9\x02\x0e\xdb\x95<\xec\xd4\\\x82\xa7R\xbeWYl\xb1\x90\x82\x8e\xa5\xd6$\xba\xdd~%\x05\xc1i\x0c\xfa\xe1\x9aw7\x11\x0b\x08\xb6\xaeA/b\xf6\xba\xc0;\x82)\x91\xdeN\x13\x98\xf4 9\x0e\xeb\x19\xc2\xc7~\x80J\x1d\xe4\x84\x83Cs\x1c_\xceI\x00fY1\xdb\xf6\x90\x07w>,,\x86\x92<\x86UC\x9e7T\xd4\xd6\xc9;R}\xbc\xde\xc1\x82\xec\xb0\x90\x1c\xef}\xe2\xb3,U\x8aS1o\x00y6\xfb\xae\xb0\xd2\xb6\x84T3\xe4\x1a\n\x1b\xbdb\x85o\xedj\x07\xd9\xbd\x08\x17\x0e\xe1\x87\xc9\xc2\xac~\x84i\xed\xbd\xd1|\x8b+\x85E\xcaY\xbaE=\x03c\xe5{\xd6pueV\xd7\xd1s\xf6\xac:\xe4\x0e\xc7\xba\xc9y\xc7\x0c\x90Zq\xacb\xd1/\x88\xbd(jp\x1e\x8b%\x89]\x12\xb0F\xe4\xec\xcf4/nUl4\xde\x05\xec\xc8\xc8^\xcd^\x9b\xb9\xd4iz6\x81/\xef+\xc3N\xa4\x9d\xf8\xf4\xdf\x8em\xb9\xa0fI\xba\xb7\xe3\xf0\xa7\x1b6\xee\xff\x91H\x9c\xa3%x\xfa-L%\x98\x93\x12r\xfd\xc0\xe1\xd7N\x1f0\xe4wc\x8c\x7fsG\xd6\xca\xcc\xe8\\\xb0$`\x8d\xf1`Z\\du\x91\xcaL\xd2-XA\x16\xac\x17\x81\x8eKw\x1d\x1cU\xc8I\x10(S\x84\xf8,\xa6m\x08'\xa7Wc \xcd\xd6\x96\xcd\xf6\xacV*\xb2@!,9\x07\x0b\xb2\xb4\x0f\xaf\x89\xaf\x15\x95\x95o\x98\x03\x07\xf4<=\x05\xd5\xed\xe4}8\xbbGN\x19_\xc0\xf3\x85\xf7\x95{qp\x10\xe5\xd8\x8f\xd53\xf6\x98\xcf7\xbeu\x98P\xc5\x8f\xfc=\xc9\x14k?\xab\x15\xea\xf8\xad\x7fd\xf6$\xcb\x81?]\xff\xfa\xf4\x0b\xbb\xec\x8fyS\xc1\x15\xac\xac\xae\x18\xf5\xf7\\\xf6\x1e\xf9eR\x92V\x1d\xd7\xbf\xaa%\x97\xaa\xb4 >\xc7\xa5\xdc\xa5\x14\xc0\xb9]\xf4\xdb\xc8\x93-\xc1\xd44\xb5W\xa8\xc5\xfa1s\xac\xf2'\xd1\xc7=\x17\xdf\x9e\x1e'.\x0c\xffx\x90\xf27\x0ce\x9b\xdeD\xfc\xa5\x05\x8c\xf3a\x17\x97_\x9c\xba}h\xe7@\x81\x1a\x0c\xfb\xbchf\xc3q\xe4\xb1\xa5\x92z\xd9\x93>\xbb\xf7\x964*\x88\xe0t\xa8B\x8c\xc1Ri\xee\x88k+$\xa4\x07\xba\xf7dy\xe2\xd7_\x7f}\xf0U\xb1@}\xf0\xb9o*\xbe4!'/rGZ\xa2+L\xbf\xc9\x97q8\x08~\xe3\x946:\xd9\\\x1a\xb4\xad[\x9fV\x06`\xab\xe5\xc8n\xa5%\x93/\xf7uV\x06\xb8\x0c\xc3\xe0\xf39\xe9LtB\xe1\xb9\xcf\xdc\xf9Gr\xb3mt\x10J\xaf\xd9J\xf6C3i\x9d\x87;\xe9\xe4\x80\xdf\xfd\xab\xa0\xf9\x1d\xcb(\xe5\"\x00\xa3\x96'\xb6\x97x/\xcb\xbalQf\x06u\x95\xb2\xfb\xcd@\xa4\xc4\xfb\x89|\xbfu\xf9\xe4{\xdaI1 \xb6\xe9\xb1\xb3\xb8<\x1e\xc8\xfaN\n\xbf\xd8 \xbbb\xd8N\xba\x11\xf3IAi^\xdf~\xd7\x11\xbc\x8c\xc3\x14\x11\xb1\x17\x89\xd5\x9f\xe8\xb2\xdb\xc2e\x11\xab\x9fA>6\x98<\x8f\x9d]n\xfe#\xe1\xc9\xc2X_\xd4\xfe\x9f\xc1\xc0\x08\xae>\xe5\xd7\xec\xb2\x07\x8d\x87\x80\xe1U\xeb\xe7\x85\xcb\x96\xdf\xb31;\xd3\x14%\xc7\xf0U\xc8`\x0dkv\x01-<VB\xa4\x85R\x13\xd7h\x8bp\x85\xfb\x8f\xd7y\xc5\xf4j\xa3V\xca\x81\x86\xf7\\@\xf3\xa6\xaf\xc6f\x8bi\xd5\xa5|\xed\xb6]\xd1\xaaK\xf6\xd2\xb7\x0d\x17p!\xb2\x9d\x1c\xf3\x9dd\x02G\xe3mX#\x01\x9f~\xc9\x08\x18/\x8bL\xe08\x8c\x0b\xc3	\xe6\xad\x1eTP\x92s\xac\xcf\xe9\xees\x0c\xe7\xbb\xdc$U\xb8*\xae\xfa\x0c8\xccdt\x90\x1d\x9fKt\x18\xdd8\xd4\x0dgP!\x8dJ|\x8f\xd4\xfaQ\xfb\xf5#\xf7#\xd6\x8fJ\xac\x1f\xb9\xc9\xa1\x05\xc2\x1d5\x08\xae\xd1\xc5\n}\xb1\x80)~xP\x98\xe13Z\x03\xdfa\x94\xd8\xda\xd77J||_\xdbX\xe0dGa\xb5\x8d! !\xe1\xb6\xcf\xb5.\xb1\xaa\xd8\x7f\x9aY\x17\xe9\x1a\x0ev\xdd\x86\xe9k\xcb\xadl\x9bx\xcb\xd2\xed\"\xb1U-2+\x8e\xdez\xa9\xe30\xa5G<\x18\xeaw\xa8(A\\\x14\xc3\xf6\x10}\xc4\x92\x14\x0b\xc1!p\xaa*\x19\xed\xadQn\x1f.k\xe7\xb7k\xb6F\xe7\x99K\xb7U\xad$\xd7\x1f\xd3I\xbe)'\xa9b5\x8cCXU\xbbb\xd6\xef\x8aG\xba\x8a\x15v\xd7\xe5\x8f\x0cIX\xd4\x8b\x10W\x9c\x85\xe2B'i\x04\x86\xbc\x08^K\xbd\x84\x80w}\x0d.M`\xcfK\"\x18\x07\xa89\xee\x08\"\x84!\xa3\xf8x\x0b%\x8b\xe5>\xbc\xe3\x92j\xaaG\x8a1h\xbc\x93s\xf4\x14\xce\xc4/\x86\x94\xa4v\x9e0\xd7\x82\xca\xd6\x9a6\xaa\x85\xd7\x0cKj\x96v0\xe6s\x9d\xeau\xa9\x1b\xb7\x1e\x17(B\xc1\xb0\xdd\xc7XM\xbb\n\x1d\xa2\x0dF\xb7\xa1\xcf\xb0\xc9\xc9'\xe8\x80U\x02~\x84\x17\x7fM\xb0\xe4[l\x9a\xba\xa3>Q\xb5\x07\n\x0d\xde6\x92;5\xfa\xb9\x07t\xcb^0\xcb5\xc5n\xcd\xcd\x01\x1e\xf4E\xc74\xda\x91\xf6\xe4\xeb\xb9\x95\xc1\x06\xf9\x95\xa9\xb3\x0bu\x13\xe0\xa1|\x01\x8b\x80\x89\xdfw\xd3\xb6>\x8c.\x08\xe8\x8el\x03_\xfc\x19\xd099\xe7\xde\x82n\xedy\xf2\x0c\xfc\x95\x98\xb6#\xe3:\xe5\xc6Q\xcf\x8c\xc4\xc1\xb1\xaa	n\xfb>|\x9b\xb3q\xeb\x84\x8b\x94S\xc6s\x9f\x9e\x1b\xdd\xd1\xf4r\x8a\xce=<x\xfeW\xfb\x9c\xa3\xba\xe7\x7f\x9df\x11?\xb3\xe4JI\xc6\x06\xb9\xeb\xd0\xd57\xdaBM\x95\xdf\xbf|=\xf7\x99\xb2\\\xec\xa6\x05l^mK\n\xbd\xbc\xa3[\x999\xb9	\xa2?\xf8/,\xb1\xbd\x1b\xe1\xf7\xfa\x11\xbe\x00\x87\xe8\xe1\xe9\x1f\xba\xa0Fp\x81b*\xb7[\xc9\x96*\xc2|\x8b\xc2p\x9bs\xa5\x9a\x9bA\xb0\xf6\xc3	\xdda\xa5\xf0Gp\xb8~\x00\x8f\x16g\xbem\xb9%\x1c\xf8\xfa\xc3C\xad1NA\xe5\xb1M\x102\x01\x8c\xf1oo^\xf7\x12p2\xc8\x80\xf1`\xd8z\xb7\x10F\xa5Ng\xd7\xf3\xe2\xe2\x853e\xc2\xb6E\xa2\xca\x1a\x8e\x96b\xdfoe\xec2\xbb\xd1\xa1C\xd4\xf3\x0c\xa3\xfc\x15A\xc0\xef\xa8\x012\x9c{^\xa8`%\xb50\xab\x9e\xa4\xcb\xe3\xeeI\xdfz9\xe5\x9b\xe1aZ\x97U\xf8\x82\xd4F\xf4f\xd3q\x87\xafj\xabu\x9d\xa1\xce\x84v\xa8\xfa\x93\x9aP\xc1Qv\x80\xa5\x80\x01\x95\x8f[\x11Qm10\xe8u\x17/\xa5\xde!S\x86\x1b\xe2\xf2=i\x0e\xa4\xe3\xb3\x88\xaee\xf8\xd5\xa7\xda\xb4\x0e\xeb\x824\xf6\x06a\xc2\x80\xab\xa37I\x92\x16\xbf_\x98rwE\xcdq\x8b\xc6\xe8\x8d\x86\xfaQ\xf2\xa5	\x9bZ\xeb\x89(k=_s\xb6\x98Pk/\x15\xbb\xf4\xe4\x80\xa7\xdc\x8f\xb1\xd4\xd6\x10e\x81J5\xa0L\xa8s\x99:K;\xdc\x02K94g\x93\x1e4\xde\x00|\xd2\xa8\xb9\xcap\xe5\xa5\xd7\xa3\x98\x15LZpnM\xd3\xdc\xad4\xc0y\x9c\xcd\x86\xbd\xf6	\x83\xe06\xeb\xb5\xb7F*<M\x08+b+\xbe\xd5\xca`Y\x16\x84\x83\x8b\xc5Fl`\x97\xd8\xea\xed\xb2K3\xa9(\xe5)\x99\x8f\x88\xad\xca~m\xd9\xde\xed[*C\xad\xddQ\x1e\xc9F\xad\x8e\xe7y\x89\xf7\xdd\xc2\x90\xba\x85g8l'\xff_\x8f%\xd2\xc5\xd9\xf5Zb\xc0]f\x81\xae\xd4\xff\n\xee\x83\xe3\xdb~*\x11#yA\xae\xb0\xb2b\x03\x9eN/\x99\xb4\x8d\xf2\xaa\x0f\x0d\xe6\xa4D\xdd\x97\x84:\\\xabE-\xdd\xa4S\xb4\xcc\xab\xcd\xdebA\xa5\xe9mQ\x00\xec3d<\x1c\x03j\xbeS\xae\xb0D\xda-\xb8\x14\x1c\x1a\xab=-\xbe`\xc7/;J\xdf\xc8\x0f\x0f\xd2\x91\x02\xb4K\xb6\xef\xad\xcb\xd9\x82\xaf\x1fS\x95g\x93\xd4\xce\xea\xc4Q\xa2\x06\xf2\xe9\x17\x99:C\x1b\xea\x152\x8e\x14\x18\xbd\xd17\xec\x8e:*\xef\x18\x97\xce't;\xb8\xb0\xdcZ\x8d\xa3\x81A@\xd1=\xe3`\x9f\xc0\x0bIl/\xed\x84^\xd1\xb4\xfc]\x85\xd2?\xbd\xa3\xe9\xe5\xff^&\xe5Y\xc3N\x19\x1b\xe1`\x1fC\xee\xc4\xd9\xe1\xfaq	F\x95\xa8\xd6\x8f\xc3\x92\x18\xbb\xb0A\xcbf\xfd\xeb\x87\x07vFu\xe2{\xd3\xd7\xdb\xb8\x01a\xc7\xdc\x8a\x08w\x95\xabni\x93\xd7\x8f\xe0\xd6\x8f\xb3\xf5#W\xfb\xb6\x89o<\x7f\xf8#Y4\xc8\xed\x9an'\xbf\x8f\xc3\x94%\x87\xc1\xc0\x7f\x87\x85\xcf\xf6~\x1e?\xdb\x1b\x99\x8a\x1dV\xf7\xca\x92\xdfF\x8c^\xc0\xa81\xf1\xed\xdf(\xaa0\x83f\xfc\xb0\xc4$\x9d\x8e\xc8P\x8ba\\\xbb\xe9%5\xc4\x9b\xb0~H\x1b\x1cx8c=ook\xceG\xc7\xc1c\xb7\xb6b\xf4\x9a_\xd2\x04\xfd\xe8\xdfz\x8d\xdaL+\x03\x05\xe5\x8c}\xbe\xb8\xf0\x9c\xedk\x1c?\x0b[1\xf2\xd8u1\xb8\x8cJ\xb1\x06;\x9a\xf8\xd4\xa5N\xb7\xfd\xc8F\x9b;:[?(\xae\x13\xc6\xd17\xebG\x87\x8dQqs#A*+\xc5\xf9L\xbbCS\xd4\xd7\xf9M\xfb\xde\xd4Y\x1b)4%D,\xa4\x1f|\xe6\x0e\xb8\xac\xf2Y\xdf\xdf\x9f\x19~C\xc9\x1e\xcf\x12:\xa3_\xb4\x87\xc4\xee\x87\xab4\xe1\xc1N0\xa6\xc4\xf7\xed\xb3\xf0\x8eLj\xf8\xcc\xed\xa7\xa9\xec\xc9-\xf7\x7f\xf8\x9e\xf1\xb2\xd8\xb4\x1eG\x17vq\xcdv\xf4\xb3T[M\xfb_\xcbV\xf8\xf8\x886\x7f?\x1b\xa8\x8eR\xaa\xcd\xb86R\xe0\xd0&\xd8O(Q\xe3\x9c\xc2\xf3\xcd8Yp>9:\x12\"\x8dC\xea\x10|k\x80\x87at\xa6q\xc2\x14\x83\x02\x1d'\xab\xc6cMvRQ\xe1\x12G\xaa\xbc\xcb\xde6\xf0\x9b\xda1\\_\xbd\x1c\x87\xb7\x91\xfc\x18\x95\xa6\x97\xc1\x9d\xc6G\x7fP\xa6j\xa0\x90%\x85\xf4\xd5\xb1\xcd\xff\xf2\xf0\xf0\xfe\x8b\xc3\xc3q\x87\xe6\x15]%\xf2\xcb\xc3C\xf8\xe68|\x98\xe6\xe4\xb9\xd6b?	\xc4&ot\xdc\x8c6\xde\xbc\x0d\x9e\xbb\xf1\x83.=\xa7\xac\xde\xc2\x0f\xba\xf4\x9c\x86\xd5\x16\x9efC\xbb\x9a	\x1c\x95\xfc\x8be\xcbb\xbe<\xb6\x98\xb6O\xf1F\x85\xe2\x8d,x\xd5I\xfa\x19\xd9*B\xf6V\xa3\x93\xf0#\xc2\x8c\x9e\xc9ymc\xea\x1c\x90-$9\xbd\xe1\xb4I\xd0\x91\xe1\xbc\xa87i\"_\xb6\x8cX\x18\xe6\x94\x04z~\x048:\xc5\x94\x89\x8cb\xa4\xd0-\xc93\xf4|B\xeb\x96\xda	\xc1\x0e\xe5\x13R\x99\xaf\xc5sl\x90\xd0\xb5mkk\xa3\xd3\xf6w\xc0\xc4\xfb\x11t\xe7,\xfd\x1c\xbcK`D\xfb\x1a!a\xeab\xf9&\xaa\xe7\xf9\xdb\x93o\xe0\xcd\xf9yD\xc4\x869/x\x19\x7f\xf5}\xbe\xd1\xc5i;\"\xed\xa5\x0f:r\xd1\xfe\x8e\x18\xa5h\x1e\x97_\xc4\x9f\x83\xaa\xfe\x88\x03\xbfV\x85x\xef\x95\xcc\x9e\x88\xb5j\xd6\xcd\x94z\xbeu'p\xeb\x02|J\xff\xbf:<\xbc\xff\xea\xff\xa0\xff\xca\xcc9\x92\x7f\x01\xdc\xd6\xe4_I&\xfe\x1d\xdf\xa50\x82\xa3\xfdPfK/\xceG\xbb\xca\xa0\xa3\xab\xbc,\xba5\xe3\xf6\x96\x89\x85w\x17\x81\x14\xd7<I\xf4\x8fH9\\\x88	\xe7\xb4V\xca-d\x92\xa5wS\xa37\xe9g\xa0mV\xfa\xcat\xf5#\xb6\xa3 B\x8d\xc5\xac\xf8ii\x93\x97\x91BU\x8cg]w:V\xd5v\xdei\xe05\x0f\x82\x02\x1edZjIHK\x85\xbf\xb8\xe6\xa57i\xc4v\xfd\xe2z8#\x06Q\x9b\xb3R\xe5$\xe5f\xec~o\x8dQ^V<\xf1\\\xaa\xd8L\xe5\xef\x0e5xWO\xffF\x85\x1f\xa77\xb96\xd9\xe0P\x95!\xcd\xef\x8c\xc3\xd3zVs\xa7\xd0-\xc8\xedC\xab,\x96\xe6\xb5B\xae V\xdc\x8d\xe5\x0dK\x07\xec\xc8\x1f\xa7\x86\xfa\x0b\x18M\xc8\xb7\xdd\xf3\x0e\xfd:*`\x8bN\xfa\x18\xe2\xd8\xec\xe6\xb9\x05rf\xce\x93\xe2\xaf\x0cz,u\x8f\x80i\xea\x8ew\xce?,\xe2\xffghc\xc2i\xb2{)\xf2\xee\x0cE\x1b{gg\x906\x85I|ct\x9d\x80\x8d.\x92E\xd3T\xb0\x8c\xecs|z\xc5\x13\xd0\x9d\x19~\xab\xe5Ouh\xa4\xf1T.H\x15\x9c-\x1c\x84\x87r\xec4\xb3\xa0\x82\xb3;\xa6\xdb\x95\xb2F!\x02aH\x17\x89p\x931@\xf8oXU\xeb\xe4\xe5\xde\x86\x1f}h\x1d\xcdn\xd4\x0d\x08\x015\xd9\xd1\xb3\xbd\x9f\x9f\xfd\xfc\xec\x7f\x06\x00PK\x07\x08\xf5\x0cE\x94w\x12\x00\x00\xe42\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x001\x8dS]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/uk_UA/common.jsonUT\x05\x00\x01OV\xd6j\x8cW\xddo\x1b\xc7\x11\x7f&\xff\x8a\xc5\x01\x82\x13\xc0p\xde\xf5\xa6\x0f:&\xa0\x0f\x82\xa4m\x14M\x1fVwKr\xad\xe3-{{'F\x08\x0c\x88\x12\x9c\xb6\x80\x11\xb5\x8a\xa1\x02	\x1cW}i\x1f\x19}T\x8c%Q\xff\xc2\xec\x7fT\xcc\xec\x1e\xf7Nu\x83\xbcH{3\xbf\xd9\x9d\xd9\x9d\x8f\x1f\xbf\xa9\xd7\x82\x9eJ\x87<\x0b\x96\xd97\xf5Z-\xd8\xe1I\"\xa2`\x99\x05\xab+[[\x8du\xb6\xfa;\xf6hI?bO\xb7\xdblI\xd3\xbf\xaf\x82%\xfdU\x10<F|$b\x91Y\x83\xf5\xc6F\xa3\xeb-*\xfa\xb6\xe0Z%\x9f@\xd1\x86\xb8\xb0p9\xe4}\xb1\xee\xf7ln\xae|\xd9`\x9f\xdc\x99\xa0\x9d\x91\x92\xb1H\xcb\xe0Nk\xbb\xb9\xd1h?\x84'b\xdcR:\xd3\xcd\xa4;H\x05'\x83\xa5\x88%b\xccFJg\x9f\xe9\xcf\x99LXF\xba'\xf6\x04\x94\xeb\x95$j\xe2Iz{(3\x17\xe9R\xb4\xb0\xe1I\xc4\x96\"F\xce\xe0\x1e\xca\xa1\xfc\x06\x9f\xb6\xab\xe2\xf2\xb4/\"t\x0fwomw\xba\xac\xf5\xbc\xfd\xa5\x0f\xe1\x7f\xae=\x15\x91LE\x985[\x85E\xa3\xcd\xda\x8d\xf5f\xbb\xb1\x86o\xd0\xddv\xafT\xbd\x84\xc2\xcc_A\xf7Y\xbb\xb1\xb2\xfe\x1bL\xf5\x80Gj\xbc*\x8b\xf4\xe8<[Y\xdf~\xc9V\x9b\xbf%K\xec\xb5n\xa8p\xb7\xab\xfa\xfdX\x94\x8f^\xd2U\x1f\xf3\xc4\xe7\xe0\xf3\xadj\x16\xda\xdd\xf6\xa4\x18\x8b\xa8\xc3\x87\xc2\x87\xdfa\xdbOY\xf7Y\x83uV6\x1b\xac\xd9b/\x1b\xed\x06{\xd1l\xbc\xf4\xde\x05\xf5\xda\xeb\xc7\xf5Z0\x8a\xf3\x94\xc7\xbaH\xf9\x88\xef\x07\xcb\xec\xf7\xf5Z\xad\x16\xc0%\\\xc1\x9dyK\x07\xd1\xe7\x9d9\x0d\xea\xb5\xda\x1fP\x10\x0cT\x9ez\xec\x05\xcc\xe1\x12fp\x07\xd3\xe0\xf1C\xd1\xcc[\x0de\x92gbag\xde\xc09\xcc\xe0\xa6bY\x16\x96,U\x92\x0d\xfc\x81\xb7\xe6\xd4L\xcc\xb1\xf9\xd6;\xe8E%7\xb5\x08U\x12-\xec\xdc'\xaa\n\x9d\xf6\xe0}\xc1}L\xe6\xc0\x9c\xc2G\x075\x070\x87\x8f.\x92\xe2\xee0\xa5\x8b\x9b\xe3\xd1PRQ\xaf\xd0\x02\xad\x02\xdeW(1\x870\x87[s\xe4\x84\x89-~\xf8+\xdc\xc1\x1c\xef\x14n\xad&TI\x96\xf2\x90\x12\x7f\xadXW4\xb6\xfa\xcaz\xe6D\x04\x13_\x8f8\xc5\x1a\xc0?`\x0e\xd7\xf8*\xe6\x00\xee\xcc\x919\x84Y\x19\xe37j\x90Me\x9b\x81\x8c\xf0\x85\x0283o`\x0e\xe70\xf5\xe66\xc6\"\x96W<\x91\x99J\xc9\xa3M!#\xe5\xc4\xb9\xce\xb6\xd4\x18\xa5\xe6/0\x87_0R\xab\x8aU\xb8kS\xde\xadH:\xe4z\x17\xd1\x9b\\\xef\xb2\x9e\x8cE\xc2\x87\xc2\xab\xba]T\xb6\xc5(\xe6\xa1 \xfd#\xcd\x10\xc2\xc62\x1b0\x99i6\xe0z\xc0\xf6x\x9c\x17f*\x12)_\xf8V|Y\xa5\x1a'\xc2:\xbd\xaax\x1a\xb1m\xfcv\xa5-\xc4J\x1c\xe3q\xf0\x81^|\n\xd76~\x06\xe7f\x02WE\x07P\xe3O\x81\x9c\xd6vb\xda\xe5\x0c\xee\xe9\x06n\xe0\xca\x1cXuFu\xdf\xc9d\xb8\x8b\xd5\x16\xd8>\xc0\xb4\x15\x10$O\xfcE-\xd6\xa4\xd9W9\n?\x83\x13\x98}^T\xb1\xdeOB\x97\xb7\x01\x9c\x9bS\xb8|d\xde\xc1%V\xa3\xbfz\xb87\x070\xb3rsd\xde\xc1-\xcc\xcd\xc4\x95O`&X\x83\xe6\x0d%:&\xe5u\x81\xf9\x7fz\x9b\x1a~\x7f\xb2\xbc\xfeU\\\x9d\xea,\xc8\xe4P\x14u\x13\xf2X$Q\xa9\xec\xe0\xcc\x9c\x9a?Q\xeb9v\xc5\x07?\x9a\xef\xa8\x88.\xdcY\xb5\x00\xfe\x86\xf7	Wp]\xc6\xfd\x80\xc1\x9b\xc3\xb2\xe8\x9f\xe6\x00\xa6p^\x16\xfd\x8bL+\xa2\x1fa\x06\xf7e\xc1\x19a*\xa2\x13\x12]\x99IYx\x8a\xd1\x99\xc3\x07\xee\xc2\xccL\xc8\xe1{\x98\xc2\xa5o\x89\xdf\x9b\x03s\xe4\xfa\xea\xb1o;c!v}\xfc\xef\xe1\xb2\xc0\x7f\x80\xbbbyb\x0e\x8b\xe5\x99K$\x0c\xc5K?\x94\x00\xf0s\xb9O\xe5r\xd1\xa4F\xa3x\xdf\xe5\xf7\n\xaeY\xa6\x18\x8fc\x1a\xe1\xae\x89\xec`I,j\xa3\x90e\x99\x1a\xa2\x10\xde\xf9\x07\x0fy\x12\x8a\xd8e\xf9G\x98\x9a\x899\xaav\x8b\x90g<V}\x82\xfc@e4\x85\x1b\x98\xc3\x85S\xc7\x12\xa7\xe0\xdab\x9f5\x14\xa0On\xeb\x12mZ-\xdc\xb2\x8c\x88\xedx\xef\"\x95`:\x05\xeb\xf8\x9fL\xc4P\xbd\xc2\xa0\x83\x06-H\x86m\xa3\xab\xd4\x06O\xfb\x84~*c\xc12\xa5XL\x12\x87I\xa4\x1e\xd8\xfe\x04\xdf\xc3\x9c\xde\xf0\x1c\xe6\x8e\x04\xf5\x95\xea\xc7\xa2\xa3\x92\"\xa2\x1b\x9c\x10\x98\xe5\xe6\xd0\xbcep	7\xe6\x98\xc1\x859\x82\x0b\xb8A\xc9=\x8e$\x04\x14\x94n\xa4\xd2lM\xa5i>\xa26\xdf$\x01\xebq\x19\x8b\xe8	#\x9fB\xabvgZ\x93u\x17\xa2\xc3\xeb<\x0c\x85\xd6\xbd<~\xc2\xba\x03\xc1F\xbc\x8f\xbd0\x8eY\xa2\xc6,\x15\xb1Z\x1079z\xc65\xce\xcd\xa0\xd9\xa2.\xe9\xfa0\xd7\xe4\x00\xfcD\x99\x8ae\xbc\xf02V!\xcf\xa4\x9dR\x1b\xc5z\xd1\xbdK|\xc9\xf6-Kh\x186,\x8f\x12QW\xad\xfa\xac\xf9\x80\xad\x07\xce\x1f\x99c\xec\x94\x98Bx[\xf4\xa7`\x0c\xc1\x90\xa7\xbb\x1d!\xe8\xd8M\x9e\xee2\xae\x99\xc6o\xdat\x88\xe3\xe5\x85\xd4R%\x059\xa4\x89\xc3\xf6HV\x02Y\x81\x9d\x19/\xa4\x18\xfb\x04g\xbdT\x0dY6\x10L\xe3\xf0h\xb6\xf0\x08\xfa\x14\xb1\x083a)i\xb1U\xda\x17>\xd6M\xfcd2\xc9\x94\x8b\xd7\xa1\xd4^\x19\xa4\xf6DU\x9d\xebl\x93g\xe1\xc0\x0d\x94)\xf5\xc8\x1bs\xca\xe0\x16\xa6\xd8\xd4\xcc[f&\x98&p^4\x0b?l\x131\xf6\x9b\xc3{l50\x83_\x989\xa4\xd6w\xe9\x98\xb5H\"\x99\xf4\xdb\x02\xb9 \"[V\xc0R+q\xf4[&YW\xad\xf9\x82l\xa1\x04k\xad\x90y\x9a\xee\xc8\x00n\x85\x9f_\xd8\xef/\xba\xdd\x0d\x07B\x8e\xee\x7f\xc6\xd8\x15\xeb\xa9\x94\x91\xc6\x82\xfe\x98+\xf7\x13\x01N\xb070\xf8\xd9\x1ca\xf53\x1aDs\xf3-\xcc\xcc\xe1\xc3!\x92>\xd8\xb5\xca\xd5W\xf7\xfd}\xb4\x1d\x7f\xa7\xe7\xdd\xd9\xaf\xdc{\xc1\xed\xd1}\x91V\xd0\xd8\xe9DZ\x85\x15\xfc\xc2B4\xcb\xb0#\xd0\xddPr\x8cD({RD,O]KJE/\x15\xb6\xaa\xe0't\x1eY\xac\x7f\xb7T\x8cb\x9a\xecp\x82\xb3\x98\x18\x00\x0e\xa6I\x05\xa2R\xcab\xf8;\xa5\xc5\x15\xa2\xcc\x01\"\xca\xa8,O\x13\x97<x\xc8\x95\xa7sHu\x9d7y,\xf4\xa2\xcc\xa6\x8e<\xbb\xaa\xd2\x82\xa7\x8b\xfc\x9b\x9b?\x9b#\xc7i\x17\xecw\x99\x05\x1d\xb7t\x16\x1a+\xaa\xf1\xf5H\xba\x1f\x93\x1b\xaa/\x13\xe6\xe4L8\x85\x03g\x9dX\x8d\x91`!\xb2#2\xa6c5f\xc8\xbf\x1cb\xa0\xc6[*\x93\xa1(\x9c\xc0\xbb\xc0\xea\x87[dE\xd4y\\$z\x14\xcb\xd2\x0f\xb2\x0e~2\xd5\xeb\xd9\xc2\xc3\xdf\xa7\xe5W\xd6\x19\xcfr\n\xbccW\xf6\xbc|\xe7\x95\xb0$\xba\xe3\x96\x85|(I\x0c\xefq*\xe3{\xc0M\x85\xb6\x11\xe2\xa9J})uHD\xa9]\xae&\xe7\xc52\x0b\x9c\xb3tB6\xc8\x87;	\x97\xb1t\x03\x02\xbb\xde\x15v\xbd\xef\\\x92\xdf\x99\xe3'\xae/[\x12\xb8\xb6\x8f3\xb0D\x02C+p\x90\x11j\xe0\xdf0\x85{\x1c\xb0\x057,\xc6T\xd1\x08\x91\x0b\xda\x8e\xf2\x0e\xa9\x08\x83;\"FS\xf8hN\xe1\xce\x92)j\x1c\x94c\x13G\x14\x82<\x19\x17\xbd\xe9\xb9[\xba\x03\xe8\xc3\xbf\x83\xd3V.?\x1f\xe1\x90\xc1\x81E\x08\xfa\"j\xee\xf6 A+U\xfdThz#r\x88\xae\x81\xe6\xcd\x7f\x8a\x97\x7fR\xdc\xc8\x83C_\x96\x8f\xac\xd7^\xd7_\xd7\xff;\x00PK\x07\x08\x9cX\xe9T\xa3\x07\x00\x00\xa3\x11\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/uk_UA/server.jsonUT\x05\x00\x01*b2h\xbc{\xddn\x1c\xc7\x95\xff5\xf5\x14\x07\xf3\x87\xc1\xff\x02\x13R\x8e}\x11\xe8\"\x80>m%\xa6\xc4\x88r\x8c H\x80\x9a\xee\x9a\x99\n{\xba&]\xdd$'A\x00\x89\x8cl\x05\x10\xac\xc0\xf1R\x80\x93\xd8\xf1b\x81\xdd\xcb\x11EJ4ER\xafP\xfdF\x8b\xdf\xa9\xea\xee\xea\xe1\x90\xab\xddMr5S_\xa7NU\x9d\xefs\xfa\xb7\x97\x16:}\x9d\x8dL\xe7\n\xfd\xf6\xd2\xc2B\xe7\xd6\xd5\x9ft\xae\xd0\xcf/-,,t\xec\xa7\xf6\xa4\xfc\xbd=-\x1f\xd8c;-?-w\xedw\xf6\xc4\x1e\x92}n_\xdbS{D\xe5\xb6=\xb0G\xe5\xc3r\xbb\xdc\xe9t\xdd\xa2gvj\xdf\xd8\xc3\xf2!&\xee\xdb\xd7\xe5S\xcc\x9f\xda\x13{b\x0f\xca\x07\xe5\x0e\x95\x0f\xfd\x84\xa3r\x87\xec\xbf\xdb?\xda\xaf\x00iJ\xe5\xeey\x1b\x1e\xdbS\xbbo\xa7\xf6u\xf9\x04\x1d\xe5#\xb2{\xe5\xae=\xb2\xa7\xf6\xa4sia\xe1\x17\xd8\xbc\xf3\xa3\xd5\x9b\x1f\xdc\x1f\x16\xa3^*Tb\xeas\xa0\x9b\xf2\xa6\x1fs\x17:#9(\"A\x85\x91\x86>\x91\xbd\xd5`\x06\xf5&\x14\xcb\xbe(\x92|\x89n\xa6\xa2\x97H\xca\x87\xca\x90\xea\xd3D\x17\xb4)\xd2\x9crM\xa6\x18\x8fu\x96\x93Nb\x99Q/\xd3\x9bFf\xa6Kk\xa2/2E:\xa3\xab\xe3q\")\x96\x1b*\x92\x86DN\xf9P\x92\xdc\x1a\xcb\xd4H\xd2}Ju\xb3-\xe5\x99H\xcdXd2\x8d&K\xcd\xa9\xee\xac\xdd\xfa\xa4>\xcb\x1d\x9d\x03\xbc\xa4[:\xa3Ot\xb6\xeeOsM\x8b,&\x91$z\xd3\xd0H\xe42S\"\xa1|(rR\x86R\x9d\x93\x11}	\xa4{\x926\x94\xdc\x941\xa9\x94\x04m\xeal\x9dd\xba\xa12\x9d\x8ed\x9a7\xfb\x8adSL\xccG:Z\xafw\xe7\xc7\xdd\xb3/\xed>\x1e\xf7M\xf9\xc0\x1e\xda\xbd\xc5\xf2\xa9}U\xee\xd8=;-\xb7\xd1\xbfoO\xc9\xee\xdb\x13;\xf5\xc8\xd9\xaf\xec\xa9}\x8d\xa1={d_\xdb\xa9\xdd\xb7GvJ\xa0\x08;\xb5GXf\xf7\xb0\xa0\xfbVP\x1b\x14S\x9dNF\xca\xc8\x06\xc3?\xda\x13\x10E\xb9k\x8f\xcb\xdd\x10\xaf\n\x97o@\xb9v\xdaFy\xaf|X\xee\x82\xaa\xde\xd8\xd3\xf2!\xc8\xba|P\xee\xda=*\x9f\xda#\xb2\xd3\x16\xd0\xbd\x00\x81\"V\xfa\xa7:)F\x0d\nW\xd1G\x1b\xae\xd3m\xeaf\xe0\xc1y\x01n~T\x18\x15\x91Hc\xdaP\xb1\xd44N\xc4Df&\x04\x9d\xeb\x81\xea\xd7`q2 `Oq\xd3\xf6\xa4\xdcuwu\x80\x17\xf8\x9c>\xb8}\x0b\x07\xebt[\x93\xc33\xce\x99\\o\xd6\x1b\xac\x14ys\x044\xe8\x9a\x88\xd6\x07\x99.\xd2\x98~\n\x14=h\x1e\xcb\x87\x92z\xcd8\x1f!\x84\xe6\x16T\xe0\xce\x81t5\x1e\xa9\xf4{f,#\xd5W2\xa6\x91\x1cICc5\x961\x99<\x13j0\xccI\xa5\xb9\x06\xd3e4\xd2\xa9\xcau\x16l\x03\x9a\xbf\xb95V\xd9\xa4\xd9\n}\xe00\x95M(W\xf5\x0b\xdc)F=\x99\xe1\x05b11\xb4\xa9\xf2\xa1.rJ\xe5&\x8d\xb5\xc9\x0d\xf5d_g\x92\x041T0M,\x13\x99\xcbxf\xbf;\"xj\xfbW\x10\x12\xde\x032\x0e\x82r\xbf!\xb4`\x0c\xd4\xb3gO\xcbgL\xc6\xe5c{d\x0f\x97\xc8\xfe\xc5\x9e\xda\x97\xf6\x80\xec\x1eK3\xc8\xbc?\x84L\xf4\xeeeB\xa7}m\x0f\xecI\xf9\x04\xab@\xa3\xaf\xdd\x1c{R>\x0c:\xcb]G\xb63\xf8\xdeWy\x12 \x0c\x06~\xc1\xdc\x082\x82\x0co0\xeat\xcf\x9d3\xef\x008\xd6\xd4\xb3\xab\xe7jZ\xac\xa9\xf4\xc0\x89t\xfb\x17f\x9e\x17v\xba\xb8D\xf6\xaf8\xec\xf3r\x17r\xbc|l\x0f\xe8\xdd\xcb\x97\xa1\x0e\x0e\xed1\xc0\xdb\xd7m\xee\xea\xe98|\xd9x\xe21\xbc/\xb7r\xc2 \xde\x13\xa4\x88'l\xce\x1d\x89q\x1e\x0dE\xbd\xd2~\xc5x~\xd6\xc8\xa3o\xec!\xb0s|q\xc4\x0fw\x08\xb6\xf7\xec\xf2\x19\xb3\xbe;\x1a1\xd7\xbc(wy\xfc\x81\xd3\x83\xe53\xaf\xb0 *\xf0\xb0\x98s\xc2<Yn\x97\x8f\xb8\xb9_\xee\x9cA\xe9\xbe\x184j\xe9\xbaC\x93rt\xfa\x83\x89\x81\x81\x8c6\xba\xc8\"I}\x9d\x91?\x0c\x19\x9d\x14\xb9\xd2\xa9Y\xa2\xbb\xa9\xc4\"\xd0h&\xd2X\x8f\x92	ECmd\xca+\xa4\x88\x864\x90\xa9\xccD.\xe3\n\xc0\x12\xdd\x93\xbf.T&cl\x10\xe94\x17\x90\xff9%R\x98\x9c\xde\xa3X\xf5\xfb2\x93\xd0lb`\x02\xfd\x13\x0dE\xb6\x16\xe9\xac\xa1\xa2\xebC\x91\x89(\x97\x19\x99\xb1\x18\x91\xe1A\xcf\xd3i\xae\xb8sS2\xfb\xea>\x8dt\xac\xfa\x13\x95\x0eHPT\xaf\xc4\xe6\xfcnKt\xb5\x0fPr+\x922\xc64\xbch\xa2F*\xe7\x7f\x85\x91\x19m\xaa$\xa1T:\xe4\x8dN6\xc0\xa9\xd5\xc9\x02T\x0b\x93\xeb\xd1\xf5\xb5\xb5\x1aU\x8f\xd6\xc7Ff\xdf\x8be_\xa52\xa6\xebkk\x94\x15\x894\x94h\x11\xcb\x98tJ\xb9\x1eW\xc4dd\"#\xdc\\>\x94#9\x07\xf8}=\x18\x04<u\x9d7\x05T\xbf\x99\x1b\x07\xe2\x00\x195\xc35(o\\\x84\x88\xda/\xed\x01\x8c-\xfb\xba\xdc\x06c\x81\xc0\x0e\xc0\xfd\x1e\xa8\xfd7\xd7\xa4\xf2\x0fP\x84\xaf\xec\xd4\xab\x81m;\xb5/\xcb\x9d\xf2\xcbr\xbb|R>\x84\xb9\xf5\x063\xf6\x1bx\xe5N\x97\xecQ\xad\x83\xe7\x92\xbcW\xc7\x90E\xac\x1d\x99mO\xc1\x9c\xa0\xef\xcf\x97\xce \xff\x91H\x07\xe7c_\xe9\xa9\n{\x88:\x88B\x87=\xa4\xdd\xbe=\xb5\xcf\xc1P\xf6\xa5\x9d^\x8c}\xb8\xb7)D\x16\x0d\xd5Fs\xff7\x82>\xbf\xdb7\x90Q\xe5\x0e$\xd7\xab`\x1b\x96\xa2\xeez\xe2f\xd1\x92\xce\x06\xc1\x0e\xca\xc0\xde\xbb\xa7{:oxu5\x93\x1b`\x8d(\x13\x9b	\xd4t\xb7\xd5-\x8a\\\xc3\xe8\x8aiS\xf6\x8c\xcae=\xb1K\xa6\x88\x86$\x0c\x19\x89\xfdH\xa6\x03\x95JRi,\xb7x\xbc\x9f\xe9\x11\x89(\x92\xc68\xe2W\xc6\xe9\xa0\xf0\xca\x1dR\xa0bVp\x0db7\xdc\x08\xa5:\xfd\x9e\x80>\xf5\xfa+\xca\xa4\x80\xb8h#jH\xa4\x13l\xa6\x8b4'1\x16Y\xee\xf6\x07\xa3-\xf2\xfa\xc5z\x98\x07\x1c\x9ct\xc0\x9a\x92A\x07&J\xac\xd3\xe6\x19X\xb5\x1c\xb1	\xff\x19\x8b\xd7\xc3\xda\x9a\xeat\xc3\x19\x0f\xaaQG\x05u\x87\xfd\xaeYPo!c\x99\xd6[\xdc\x8ceJ\xf7D\xac*[\xa4\xe9\xa0To\xb2\x0d\x05\\{\"Me`(\xb0(\xea\x89$\xa9!\xfd\xbf\x1f\xa0Y\xa1\xf0\x06\xc4\x89\x7f\xe5\xae\x07l\xbf\xf5.\nk\xc8\x99Y\xf6\x00\xa8:\xd7\xc6\x03*\x1f\xd9\x83\xf213\x16x\xd6\x9e\xd8\xfdP\xbf\x1f;\xf5Q\x1d\x1c\x0624\xdf\\\xc0`h\xfa\xfee\x0c\xbf\x02+\xf1\x9d\xee\x11s\xe4\x0b\xb8@\xf64\xa0\x0c9\x12*\xb9\x99e\xcd\x1d\xa1\x83nf\x99\xceL[\x1e\xf1T\\\x8f\xe4\xc19@V\x84j\xae\x88\x01U\xf7\x8c\x15\x0e\xc0\x9ce\xab\xc2\x04\x9c\"\x8c\xd9\xd4Y|v%\x8d\xfd\xd0<\x10:\xcbk\x10\xbc3\x19\x99m\xc8\x8c\xe0`\xcd\x81\x15\x8c\xce\x01\xb7V\xf4\xe6B;\x1f\x90)z\xb1\x1e	\x95\x86\xd0\xb6\x86\x12j\xb2\x01\xb5\xf5\xa1\xebxk1S\x81h\xcb\x18\xb95\x16i|5\xa0G\xfb7{j_\xc1\xea*\x1f\xd8\x93r\xc7Y&_\xc0+!\xfb\xac-\xc2\xecI\xf9\xb4\xd3\xbd`\x19;3g$\x1f\x96-\xc1\xdd\x9e\xda\xef\xd8\x19\x0b<\xed\xedr\x07>\xf0J\x97Vo\xdc\xa2r\x97VV\xdf\x83yw\x00\xeb\xa7\xde\x01\x94Y~^	\xea\xa5\xb9\x88\x11\\zg-\x1e\x86\xfe\xd4\xb4|\xc4\x94\xcd\x1c\xf2\x92\xec\xf3r\x07\x16\x12\x80\x9d\xdd\x03\x8c\xf2\xb0|\xdaz\x8aqH!\xf6_\xed\x11G\x11\x18\xabNwNg\xe8\xf9\x9c\xd8\xa9\xb7\xdeN\xedw\xb0t\x9d[W\xfe\xde\xddE\xb3M_\xca\x18n\x0d\xd3L\xf38\xcf\xbc\x8dw\n\xd5\xecUT\xf9\x18\xc7\xa9\xb6\xfe\xa6\xea8W\xb7\xfaH\xc8\xab\x16\xa8'\xf6\x14W\x8b\xb7\xda\x83\x17\x8d\x88H\x80M\xd22\x17!\xb3\xb3	\xb9\xden%\xfd!\xf3\xd8\x8e\x92\x19E\xe1\x14X5\xe8\x0f\xe4u_g\x91\x8c\xaf\xa6\xba\x11\xa9\xf0R\xd8\x00w\x91\x95S\n\x1dhtT'\xfc\x82\x8d\xf4C\x18\x07\xe5\x97\x88\xd4\x1c\x97O\xe7\x1e\x16\xb6s\x17f0\x1c\xe87\x98\x01\xa7\xc8{\x030a\x0e<\xfdUW\xe2e>H\xa4Au \x92Df\x93\x15\x1d7\x1a\xe6\x03\xd7G\xdc\xe9\xd0\xba\x9b&\x132C]yq\xde\xae\x85\x94S#1\x90\xc1\xe1\x07\x89\xee\x89\xe4\xc6[\xa9wr\x93\x93\xc9?X\xcf{k! \xf4\x81\xd6\xa1mi\xffT\xee\xd8\x17\xf6\x00\xcf\xd3\xa6\xb5s\x8c\x1b\xb0\xdf\x8bz\xcdn\x03x\xa8\xe2P\x95\xdao\xcbGU$\xc1\x9e^\xa1\xcb\x15\xf4\xafY{7\xca\xaa|\xd8L,w\x9b\xd7:l\x81\x96\xd7T\x9a\xca\xb8~\xaa\x0fU,\xe9\x86s\xa0i\x15O\xe3\xe1\xf3\x004&\x93\xa6\x0bP\x0d\xc5\x86\xa4\x9e\x94\xe9Y\x97{\xa8b\xd9\x8a\x7f\xf1z\xf4\x80\xbc\x97E\x92,\x87\x80\x9d\x81\x02\xe7h c\x12A\xe0\x0b\x8e\x11\x87\xbajs\x87\x17\xd3H\xe6\x82W\xb5\xf7\xbc'\xa3\"3jC&\x8d\x03Z\xf71\xee4TpV.8V&\xc7	\xc2\x1a\xb9&\x81\xd9\xb0UxeV\x01J&\xed]9v\xd9P\xa5\xfd\x86M#\x7f\xfd\xb3A\x9c\x0b\xe3YGxUp_\xb9C?_\x1b\xea\xcd_\x80X\xa6\x95!R>	\xc3A\x01\x12:S\xbf\x01\x0b%w\xf4\xe6\xaa\xb3\xa6jt>\xac\x07\xe7\x18[\xb3\x12i\xce\x0cRi\x02\xd37\x13\xf9Pf\xb8\xa3\x94L.\xa2u\x19\xcf\xc3\x00T\x13no\xff\xe4d\x0d\xeb\xb5\x13\x88\x93*\x1a\x1c\x18\x8e\xf6\xd0\x9e\xd8\x17\x1e\x17\x96\xca\xbe\xcb\x07\xf2\xe0X\xbd\xf7\x83h(\xd2\xe6\xd0\xaa!\xdb\x8f\xf4@U&s0\x01\x92\xe4C\x0d\xfb\xa1\x9a\x18*^F\x85\xd5\xeb\x1co\xc3\x03\x9b\xaf*\xbd\xde\xc3o\xb9=\xe3\x04\xe1\xe9\xa1\x82\xe1\xe6\x1d\xd8\xfd:\xbe`\x8f\xeda\x10\x7f\xa1F\x8b\x04R\x841\xbe\xa7u~wCf\x99\nd\xe8m\x8cP\xa6uN\xba\x1a\xc3\x92\x85\xcem\x1f\xdbVf\x08\x92\x1d\x82R\x19\x8e\xf1\xa2\x8b\x8c\x1c\x0b\xc4\x14(\xd1\x11;\x17\x94\xa8u8\xe2\xd7o\xdc\xe9\x92L\xa1\x82\xe0H\xf4\x8b$q[\x888\xce\xa414\x94\x99\\\xa2\x8f$\x18]\x8e\xc6\xf9\x04;\xc0?\xc6t\xef\x0cW\x93\x97\xe8\xe6\x96\x18\x8d\x13y\x85\x16\x87y>6W\x96\x97\x1d\x1eK.d\x0f[jq\xe6\xac\xed\xf8\x84;\xe4\xdb\xc4&\xc0\x8f b\x91\xba\xb3\xfe=\x83\x11j\xd4\xb6Y>\xb5\xc7\xb3\x16K\xd0u\xb1\xbdb_\xd5\xd6Jh!8\x8e\xba\xa5\x02\xcb\x88	\xb3|l\x0f\xcb\x07oe,\xceP,\x931\xc8\xec\x15d\x05\xe7Z P<\xa9B|<\x00\x95\xc3\x0d9\xb0/!e|\xbc\xbfmc\x9d\xda\xa3\xf0&~\x1d7\xf6\xf8\xed\x9f\xdc\xb8\xf6V\xaa\x0c7CX\xda6\x9e\x7f%8\x0c\xdc\x88\xc9\x1fU\x1d\xdd\xb0Y\xbb\xb1\xb7o\x98%\xaa\xe6P$R\xd20\x1a\x9c\xaaa\x02\x08]\xa1D\xa5\xeb\x81\x04\x06\xdb\"1qR\xee\x96\x8fk\xf5g\x0f9\xdc\xd9\xb2\xc4\xbf\xf4\x19\xaa&\xa5`\x0f}\xca\xea)w\x80\xc3q\x8f`\xe6)\xeb\xe8z*\xde`\xcf\xedaO\xe6\xeeB?\xfc\xe1\x0f\x97\xdf\x87\xdcZnp\x1d	\x13\xe9\xe6\xe9W\\\xb3\xe5\xf3\xb9)\x14;\xa9<\xbb\x94\xf9\xa4^\xefWrg\xc5\x9f\x02*\xba\x02\x13.\xcf\x8b \x1e\xb8\xc2M6\xbe\x90A\xf2pR$\x16\x9b\x10\x1e\xfex{\xa72\xd38S\x15\xac\x84N\xef\xab\xcc\xe4\xb4\xa1\x8cj\xed\xb7\xf5!\xf3l\xbd\xa5\xfd\xb3\xcb\xf0\x94\xdb\x88X\xb1\xa7^\xdfe\xf9y\x9b\x9e=B\x1c\x8a>\xf2\xd1\xe6JyL\xc3\xe5\xd3\xf9\xec\xd0\xd8\xee\xad\x98\x1b\x83g'gfQ\xf9\xa4\x85\xf9\x9a\xfa\x8d\x9c\x87w\xc8S\xe5\xce<(\x17\xe0\x0d\xa57\xcb\x943Hp\xa4\xef\xd8\x1e\xd8\x17vj\x9fCx\xe0\x88\xe5#\xfa\xff+\xd7\xfe\xa5\x85\xe1'*\xce\x87sQtr\x04J\xf5p\xee\xdd\\|\xb5\xc1\xf2\x7f\xc4\xd5\xcaAq\xff\xa75\xda+\xae\xe9\x0dv\xd8 .8\xee\x8c\xc2*\xcd\x14\xb9\\\x15\x8c\x11V\x80\x98\x07\x17*\x0c\x179\xc0kC\x9d\xe5Q\x91\xff#6\xd01\x82\xf3\xa1\x10[i\xba\xba\xed\x8e\xb6 k\xe6\xb1(\x0b\xa5X\x17\xa6\x98w\x05\x11\xe8\x8b\xc1\xf6\xd0n\x05Tz\xd5\xdf\x9b\x80\x13UF\xa3T\"\xa3\x16\xb1\x80l\x0e\x9f\xb6\xf2[\x9f\xda\xe3\xc5&\xda\xe0Z\xde\xee\x98\xeb\xb6\xa5r\xb3\x0e\x00U\xf7vGn\xd6\xa1\x1f\x0f\xa9\xbd\x00\xc9\x9bj\xb2\xfd+\xcbB\x907\x9bo\x08\x06z\x1a\xfb\xa2\x1d.\xac\xc3\x0cs\xe3\x86\xa9\xceU\x14\x1c\x04Y\xdf=\x1f\x85;j\xfc\xf6\xafjg\xfc\xc8\x1e\x9cW\x7fp@A\xec\x0f\xf1\xbd\xd75\xfd\xfa`|\xf9\xd4\xee\xc1\xbf\x0f-8\xa6\xf9=.yx\xc4E\x0f\xe566\xc3\x1e\x08A\xb4Q\xed+gQ\x9dA\xf8\xcc\x8e\x0c\x979\xff\xb9=\x85\xaf\x06A\x80\xe0\xc6\xae}]\x9d\xeakx\xdf\xe0\xc5P\xc9\x9cw\x84:1P~\xca._\xed\x14\x02\xfbi\xf9\xb8~ib1\xc2)\x01\xe4\x1e\x91\xe5\x82!\xbe\x0b\xe6\xc5]U\x81\x91\xcf \xa4\xd8\x1ax\x12\xd0\x95N\xe23\x94q7\x89\xcf\xa7\x8c\xca<\xbd>'uV\x99\xb5T\xe5\xd0\xee71\x91\x151\x1e\xc3\xa4\xd3\xfd\xda\x1d\xd4\xf0\x08\xcd\x8cN\xab\x12jP:\xd0M^@\xc8\xf8L\xf4]o\xc2}i\xb0vM\x1f\x80@\xa3\xcd\xa2\x1c\xa1'\xb7\xc8\xf9\xb6l\x10\xbb\x10?\x90\x80\x9f\xe8\xa3\xf4:\xcd3\x9d\x98%Z)L~6+\xa7\xd306P_U\x85\xc9\xeay\\\x05f\xbf\xee3\x00m\xd3\x18\xccV'\x07\xde\xcaD\xae3\x00\xa2\xce\x96\xff=\xadd\xa0z\x9b\xcd\xd8\x9b\x1c\x15\xad1u\x9d\x1c<\xa0\x8fT\xbaN<n\x9a\x9c\x86\x9f\x00\x8bM\xc6,\xdf\xa8Hc\xef\x83p\x13Cx\xde(Q\xd1\xfa\x12}2D\x88\xc1\x05\x80\xe2.\xa5bC\x0dD.\xf9M\xf2a\x1b\x92JM.EH\x05\xe3\xacH\xe5L\xf6e\x15}\x9e\xcc\xfc\x0d\xba\xa0GMzu|\x03%8C\x11s\x02\xa6\xbaG\xc3a\x89;\\\x920\xb3\xd1\xfda&E\x90\xe7q;\xe5\xbe\xb7\xb5\x95\xef<g/\x9c\x86\x95\xd1\x99m&E\x0d}-)r\x92\\\xe8T\xc5\xc8o\xe8t1'a\xd6\x1b\xc42$Z\xea5\xf7\x96\xc5r\x93\x89\xf1\xad9~\x7f\xb8\x1e\xc9j\x14P|\xa8\x8b\x80\xa1\xeeq?A\xf3\xd0\x90G\xdc\xf9\xeeU\xc9m\x9dF\x92\xe4\x06b\x7f\xef~\x9f\x841j\x80\xec1/\x00?y\xe5\x86S\n\x7f\x1d\xc1\xae=\x94\x0b4\x9b\xc9x\xf9ZRH\xe2^\x7fVg\x1e\x13\x92\xe3\xa8\xc8\xe9a<G\x91\x81\xea\xe3\x89F\"G\x1c\x0bE#\xb4\xf8\xcbl\x11\x95]\x8b\xbf\xec\x05N(\xb6D,\xb2\xde\xc6\xfe\x99\xdd\xf4\x03\xf2\xc9\xb0V	\xd2L,u\xb6\x88\xc0\xcb\xd1\x87\xe5v\xbb\xd8(\x93\x03\x85s\x06g\xf1\x1d\xb3\xfc\x9f\xc9D\xe4jC\xdeW\xa12w\n\xf4\x04\xd2\x1cr\x1b\xa8!\xf6\xcbz\xc6Y\x8a/\x9b\x04\xd8[Mn!\n\xc7}\xe9\n-\"P\x83\x90/|Lx=\xa7\xf6\xb8\xdci\xdd\xd5X\x8a\xf0A\xb8y\xf6\x08\xe3dr\xafe\xf3\xff\xdc#\xe55\x19.\xc8\x1e\xfe\x02\xfa\x08\xd1\x126 +\xec\x9d\xe9\xb0\xd3\xe4DZ\x01\xb1\xf9p\xear\x16@:\x0d\xf0\xd5:\xff\xf8\xdeG\x0d\xc2\x08\x9e\xa0\xa3\xdbjV\xe5\x03\x1cG`\xf6\x0fJ.\xc0\xe7F\x8f\xfch\x15#\x1eg\x1a\xd6h\xc6\x12hSg\xeb\x81\xb8\xe1\x02\x85zO\x0e\x05\x02/\xf6<;\xdd9\x9dA\x05R\x95Xl\xec\xed o\xe3\xdd\x82\xb0\x9e\x92_\xa8\xaa\xa8\xc4c7h\x98\xd0C\\C\xa3\x12\x0f,\xd1z\xc5h\xcc\xa7v\\WI\xd2\xa0\x9a\x82o\xa2\xcaE\xd4\x9a'8\xa8\x11IC\x0c\xf6\xdbr7\x8cu\xfbf\xed\x82=\xb7\x07\xf6\x95}c\x0f\xca\xcf\xbc\xf3\xd5N4\x80\x0e}\xaa\x01\xd9\x0b\xa4\x1b\xd8F\xe1\xe9\\\xbe\x84\xc08\x17\xa2V\x95<Kd\xfff\x0f|\xe6\x16V\xd6~`\x02!\xf8\n6!\xfb\x9c\xdeo\x97*\x85&\x9c\x11E$S\xd1\x08\xc75t\xdc\xb9z\xf7\xed3\x85T\xc1X\x8a\xf4(\x80\x8c\xb2\x00\x9d\xceT\xb9\xd9\xff\x003\x12\xce\x8bR\xa4\x87\xf6\x00)\xbf\xf2Y\xa7\xdb\x1a\xde\xc3\xbd\x9d\x94O}\"\x0ef'\x8c\xb8#X\xc0\xcc\x1c\xdb>\x11\x07\x13wj\xf7\x1c\xb3:Y\xe5n\xac\xf21Q.\xf6\x82MW\xdcP\xc0\x1cf(F\xaa\xad\x18\xd6\x9a.L	;.T\x0ff\xacU\x12\x1aZ.\x12\xb1VuW\x84\x07\xbd\xe4\xe7\xfa\x00e\x80N.\xfa\xfdv\xc9\xdb\x1a\xba\xc8\xf5\xb5\xc5=W\x15\xf2\n\xca1\x0c\xfdQU\x93\xd1P\x8a8\xc4\x0e\xea\xe0\x1c	_\x17A\xcf\x97\xef\xb3\xb5:\x8d\xf3PE\xf4@\xb4\xf3\xf3\x86P\x13\x01\x0e\\\x90T#\xe0+\x82\xc2]\xd9U\x86\xaa\xe1\"'\xc6\xec8\x0c\x18:6u\xd4\xb4\"\xb6jX+bK\x8d\xb8`\x18\x9a\xec\xffT?\xe9%\xc1\xbc\x02\xca\xd6\xee\xaaqvVT\xfa\xcf\xd8\xbdE\x16u\x95\xe3yU\x90\xa1k\x08'\xab.\xe2\xf0\xcdW~=Xc\xaf]G\xd9\x1c\x98+\xc1<\x05\xcf\xcd\xeb\xb8\xa7\xae\xd4\xfe\x05\x11\x96oXfA\xa0\x1d5Z\xed\xcd[\x02\n\xc3&y&\"9\xd2\x8dT\xe7\x8e%\xf4t\xdbm\x12\xa9\x1aI2Q&ej\x86H\xa5q\x0dS\x03\x0b\xb5y\xd7>\xa8!\xd9/\xa0\x88`0\xf0\x15\xa1\xc0\xc0\x9e\x9c\xf5|/:\xd1\xde[A\x98A`n\x1c3@\x86\xb3\xf2ST\x05\xc0s\xadJ\xcf\xa7\xff=\xf8M\x91GCo\x8a\xdfM\xef\xc1$\xa9\xb7\xf9\x04c\x9e\xde8\xd9\x8e|_\x950\xbe\xea\n\xc2T$\x92d\x82\xccFE\x98\xb9&\x06*\xe3\xb3K\xebSm\xca\xde\xe8\xad\xf2M\xa8\xd9\xf0;\xe2/\x88\xeflV\xe9\xf3\xffQV)\xc0Bg\xeb\xad\xfc\xbb\xfd[;\xea\x00r|	Q\xd7\xe9\x9e\xc9W\xa2 \xf1\xfc\xe4\xc2\xbcW>\xbb\xf1L\x9d\xe5?k\xfb\x89@\xce\xbe\xbe\xfa\x9f\xb9&\x86\xaaF\xcb\x8ac\xbc/-\xfc\xae{i\xa1\xa3\xc7\xf0\xb8\xeb\x8fiRW\x1f\xd7\xb1/\xd9\xdcBJ\xf6\x85\xaf\xa8\xe88\xae\xe2\xd170\xd6\xca]\xfb\xc2\x9e\xd4i^\xa4T\xf0i\x0dX\xf8\xc4\x7f\\\xd3\xd9\xf4q\xdas\x974\x01WN\xfd3NFg9\x9e\xb1\xd2\xab\xb5\xf1\x80\x90\xf0\xb1}S\x01\xaf\xba_Q\x15\xd8\xe3\x1b{\xe2\xcc\xa6\x99J\xb5r\xb7\xb5\xe8\xac\x0b\xe3a~\xe5\xec7\xd4\xb0Uy\xe7\x19H(\x079wr\xa8!;\x97\x98<:\xb9\xa8S\xe4\x9c\x13\xf6\x02\xb8\xfa\xda\x04&$\xd8\xbc2\"/\xae\xd2rSn\x15\xa9G\xe1?}B\xf7\xc8;l\xd5\x9er4N\x10*\xa8^\xb6'\xd2\xd5P\xe6\xfcL\x17A\x19\x03'\xbcc\x976]~\xc7,#\xe8\xfa\x8ea'\x00\xf6E_\xe33\x1d\x98@\x99\x14F\xa7W*\xe2\x82=\xd2\x13\xa9\x0b\x9d\xb0\x16\x94\x10,\xef\x188\x9c*\xa5w\xcc\x92\x9fz5\x8at\x86\xfa\x03\x08\x14,s\xf5m]g\xd3\xdc^\x85\x02|\xc7,\x85\xb4Y\xa8\ny\xf7\x8dW\xc7}y\xe5\x0b	:>\x84\xc5\x03\x7fl\x99\x88\x95\xbf\x07\xdbo\xdf\xbet\xff\xcb\xed\xd9/\x1e:\"F\xf0\xa6\xce\x84\xd5\x0eo\xc79\xecl\x87a\xc2Un:\xc3\x8b\x8f\x83\xdb\xc4\xc05\xe1\x9f\x81\xef/[\x1b\xcb\x08\x17\x8e\xc3\xcaqn\xa8\x18\xe3\xb4(\x9c\xc4\x17]]Z\xbd\xf3A\x17\xdf\xbd\xe0v \xfe\xa8\xafP\xd4\x0d\xeb\x80F0h\x8a\x11\xc5j$9hd`=\xbcw\xf9\xf2\xd6\xbb\x97/w\xeba\xac \xa3~#\xfd(\xfd\xf8\x1aG\x00R\x84\xac\x8a4^\xf2\x08A\xecw\xaeM:3\xdf\x17T5\xfc\xbe\x7f(\xd2\x81\x0c\xa2\x9c02\xc2,\xaa}\x03-dO\x1b\xe2\x8c\x86\"\xe3\xc2.\x00\xbb:\xc2?`R\x97\xc9\x9b\x96M\xda\xab>\x81\xe8D	.5\xc2\xaa\xeb\xfe\xafC!\x91\x02\x86F\xe7:\xffq}:\xed\xabA\x91\xb9\xe0\x15\x06Q\xf4\xc5\"\xf0q]\xb6\xc5R\xc7?h\xb93\xb3p\x8d\x89\xeb\x82\x95\xec\x81<p\xd1n\xbf)\xa2\x86\xc1\x8e\xdf\xd6\x02\xe2p\xee^\xb1\xc8\xf1\xc1F\xe7\x06~}\xa9w\"\x03\x08a\\-\x9cP\xe9\xfej\x02\x8b\xe6p\x82O/T\xe3\xb0\x17\xfdp\x91U1\xf7\xce\x8d\xea?\x8f8\xd6c\xda\xbb\xe9\xff\xb6\xca\x031\xe0c\x08\xc8\xe3V6M\xa7_D\xebw\x1d\x91\xdf\xfa\xf8\xfa\x8f\xe9\xee\xad[n\xc0\x95\x93a\xd9\x07\xee_Sx\xd2\xb9}\xa3j\xc94W9S\x9a\xfd\x9a\xe32\xcf\xfdW)^\x1c\xfayI\"\x07\x0e\xd8m\xf7\xb7\x95x\xed\xe0\x9b\x86\x8a,\x99<T\xb4\x0e\xbeA\x7f\xf5\xc9D3S\xa5\x833|&\xce0\xd5E<\xf5\xfe\xe5\xcb[\xef\xff/x*\xd1\x03\xcd\xd95Xj\x87\xf6\xbbF^\xb8\x11W\x1e\x1b\x0c\xfa\xbcS\xf3\xa9^]y\xf8\xc0\x9e\xc2\xc9\xed\xccK\xa7t\xee\x84\xed33\xee\xdf\xc7&\xb7P\x18\xc9qy3I#\x19#O\xe0\xa220\xd1\\\xe4\xa6W$\x89\x19*O<\x8d\xa6\xef\xd8\xaf\xed\x1b\xce\x7f<\xf3C\x9b\xe9\x1d]\x07\x8a\xf9\x00\xfe\xd3\n\xb8\xd6_\xc2)\xa3\xda\"@\x08\xa3\xe2\x05\x7f1cO\xb0\xab5\xa5\x8e\x8blP\xd3\xf1*\x1aL\xc6\xcb\x01\xadg2V\x99\x8c\xf2\xdb\xabXz\xcf\xb7\xa0xn\xaf\xb6g8svv\x96\x8f\x9a\xfa\x98\x03\xec\x9a\xfbZ'\xb9\x1ac\xe2-\x95\xb8\xda\x1e\xdc\x06\xa7\x10M\xd1\xfb\x95\x8c\xf2\xae\xff\n+\xf3a\x1e\x8e\xc8\xca4\x12\xc6}^\nf1\x890Ci\x96\xa8\x12\xe3\x99\x1c\x14\x89@\xf6`\x8c\xe2 \\\xa3?\xb9\x91\xf95_Mv\x85:k2\xafJ\xc7\xea\xe1\x8f\x1c\xc9V\xc3\x9e\x82\xd9Q	\xf8\xd7\x0cE\xac7y\x92\xfb\x17\xf4^c\xbf\xd3\x0fP\xcf\x97{\xd5VR\xc3\xda\xe0\xc0\x99\xc2\x1c&\xb5\x03\xc46a[z{\xc3\x87\"j9\xe4\xbd\xbdP\x10\xf9\xdbb\xd8\xa1\xd3\xde\x01\xb5q/\xc4#\x97a\x97\x0f\x01\xda}S\xcb\xd5\xc0\xf5\xbcZM|\x9c\xaa_\x17\\C\x91\xba\xef\x94D\x84X\xefr\xaeQ\x9aw{\x958\x17\xe5\x16\"j\x81\x0d\xea`w\x87\xbf\x89DO\xe5]w\xf2\xc9\xd8\xf5\xe0\x97W\x15\xa9\xd7\xc8\x1f\xf3\x9f\xc6\x15rJ\xc0Q\x10\xb1\x03$\xb3\xce\xa5\x85\xdf]\xfa\xdd\xa5\xff\x1a\x00PK\x07\x08~\xcd\xb4,\x84\x16\x00\x00J>\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x001\x8dS]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/zh_TW/common.jsonUT\x05\x00\x01OV\xd6j\x8cW\xddO\x1b\xc7\x16\x7f\xb6\xff\x8a\xd1JQ^\"\xf5\x9d\xb7\x10\x88\x8a\x04\x0d\xc2\xbe\x95\xaen\xef\xc3\xc4;\xd8\x1b\xd6;\xbe\xbb\xb3\xb8\xa8\x8adH\x8cmp\xc0i\xc0	\xb1	\x84@q\x93\xcbg{k\x97\x90\xf0\xbf\x14\xcf\xac\xf7)\xff\xc2\xd5|\xec\x87\xd3H\xed\xdb\x9c\xdf\xf9\x9d3_\xe7\x9c9\xf3C2\xa1\xcdb;\x0f\x896\x02~H&\x12\xda}hYH\xd7F\x806\xd8{\x07n\xdepn\x02z\xba\xe8\xaf5?]\xd6\xe9\xda\x0em\xed\x8e\x80\x1b\xceui\x91m-\xf9\x9b\xdd\x11\xf0\x9dv\xc3\xf9N\xd3n%\x13	MG&\"\x9f[W\xdf\xfa[\xfbC\xfa\x19\x04\x1dlq\xd6\xd8\xf8\xe4xz|\x0c\x8c\xfeS\x92\xef\xde\x9b\x11\x03I7\xf20\x8b\xc6\"\x9f\xb4\xdd\xf4j\x95/z\x16\xd4T\x01\x1b&\xb2\xbfHf\x9d\xad~o\x85\xaeT\xfc\xd2:\xebl\x0d:/\xa4\xa1\x85\x8a\xd3\xd8!\xce\x84\x95\xce\xd9\x08\n\xd3\x1b:\xa0\xb5\nk\x9e\x0e\xce/Y\xb3B\xdb\x9dAgm\xf0\xae\xd9\xef\x9d_\x97\x96\xa4]\x81\x1b\xdd\xb6\xf4	>\xb1s/o\x90`\x91\xdds\xaf\xbd\xe8m\x1e\x00\xe9F\xf9\xf8\xb1.\xe4\xcb\xffy\xe5C\xafV\x899\xf9K[\xc5u\xed,\xd2\xf9Z\xf9,R\x13\x1e\x05\xeb\x95\xfd\xad\xfd\xd8\x15\xc5o\xc5F\xbaa\xa3\x0c\x99\x98\xe6\x96\xd3\xf7R\xe9\xf1\x1903>613~\x87\x1f~\xfa\x9e\xa2\x07\xd70l\x16\x9dK\xfa\xeb\x99\xf1\xdbc\x7f\xc3\xd4\xc9A\x1d\x17G\x8d?\x85\x92\xff\xf2l\xf0|\xfd\xef\x84\x12\x11\xb3N\xe2\xcc\\\x1ag\xb3\xa6\xf2\x13\\\x03\xed\x9e\xf3\xbd\xdfp\xc0\xa7\xcb\xba\xb7q\x16\x0b\x1a\xd7\xfaB\x08\x0f\x0e\xdf\xf8[\xfb2\x90\xa5\xffy\x03\x15\x91\x9e\x82y$\x8f\x85;\x00l\xe7\xc0k\xaf\xf6/\x96\xbdV\x8f6\xea`b\x1ax/\x1f\xcb\xb3\xd6\x92\x89\x87\xb7\x92	\xad`\xba64\x9d _t\xb8\xa0\x8d\x80\x7f%\x13\x89\x84\xc6\x9e\x1f\x08\xe7r\x94L$\xfe\xcd%-\x87];\xe4\xd0\xd3u\xb6\xb5\xa4\xdd\x8a\x0b!3oX.A\x11\xb7\xba\xec7^\x04\\)D\\l\x91\\He\xed\xaa\xe2\xf1QHrP\x06[z\xc8\xf2\x0e\x7fT,>\nY\x0b\x08\xc6\x16\xf8\xfb\xaf\x8a\xc3G\x9c\x13l\x9cG|\xb0m\xa8\xe7\x0d\x91\xc1\xde\xf1k\xaf\xb1L\x9f\xbd\x16F\x1a\xccb\x8e\xd2\xda\x13%[2\xd1i\xfd\x8a6\x14\x96\xc1\x16\xb1aF\xc41\xdb\xf8\xcd\xeb\x1d\x0f\xe32\xa5\"\xad\xccd\xc9A\xdf\x17\xa0\xd8\x90F\xcf6\xfd\xe6j\x1c\x8d\xec\xa4.n\x973t~\xae\x9a\x0c@U^\xe4\xca\xda\x1d)>\x80\x96A\xb0-'\xde\xbe\xa2\xab[t\xe5g\xaf\xb1\xac\xb4\xaeC\xbe\xc1E\xae\xf4\xd6?\x86F&\xce\xcc\xc9\xd8\xf4\xd7\x9a\xf4\xf8\xa5D\xf3\xd0\x99\xe3\xcc)\xe8\xcc\x81Y\xc3D\x16\xcc\xa3H\x95Ns\xe5\x0c*\x980\x83\x84\xfe\xa6\x038\x05\x14\x0d\x92\x03\x06q@\x0e:90\x0fM70\xc3:\xb2aly\xfd\xde{\xa9\xc1E\x0b\xc9E{\xedU\xb6}\xc5\x9e-\xb2vmP*\xabTD\xe8\xb6i\xf2\xf9dp\xd3r\xc7\x7f\xa4\x16\xef\xe4\xe4\x86\xfc\xd7'\xde\xfe\x85\xc2d\x0d\xe5\x06\x7f.\x96D\xa4b\x8a\x18\x999\x1e\xf5\x9a\xf7\xe1\xd8\xdfU\x05\xd1\xb5\xa2\xb3\xe0\xe9\x16$\xda\x02v9\xf4\xe9\xb2\xda\xff\xb0\xfb\xe9\xb2\x16\xe4\x91\xb3`eT\xd0i\xac\xd9\xf5\xba\xea\xec\xfc\xd2\x1b\xaf\xfb\xb2\xdf;\x92\"m\xd4\xd9\xd1A$v\xcf%\"E\x95\xd7\x12I\x8a|\xd3\x88\x91GA\x98f\xa0\x89,=\x16\xdd\xd7\x8f\x0f\xa3T\xe9_\xd4cB\xaf\x16	\xb4\xd5\x8ai.6b\x9a\xf2QL\xd3{\x14\xd7\xbc\x8bi~\xdf\x8ei\x9e,\x0e	\xfd^iX\x96\xeb\x08\xf3\xb1\x88\xd0\\\xb8b\xf6b\x87\xb5wb\xa5E\xc8\xfd^iX\xbe\xa8\x0f\xcb\xbd\xda\x90L[\xada\xfd\xc5\xc6\xb0\xbe|\x14\xcfu\xd7\x08\x13\xbdP0\x17T\x04\xdd\xe6c@0\x80\xa6	d9\xe0>\xb4\xfb\x18\xda\"\x07F\xc5@a\x84\xe0<\x07Y\xbd\xe6W7U\x8aC+\x83D4\xd2\xf5&\xfb\xad\x1a\xa0\x04\x9a8\xcba\xafu\xec\xd7\x1f+\xd84x\xf5\xbf\x13\xda\xf8\xef_\xb1g+\xfd\xf7\x07qc\xd9S\x8c\x06K\x90M\x81L\x05\xd5t`\x8b\xc7\x83F\x8f\xeb\xac\xdaP\x05#\x8f\x1f\xf0-j\xe3b 0\x9e\x87i\x8c'\xa1\x9d\x15|\xf6v\x83\xbd\xae\xd2\xfd\xb7t\xff0`X\x86\x93C\xfa\xe7\xde\xb2\x18gM\x94\xc2V6Z%\x90 `Gu\xd6:\x0f\xfa\x99\x02\xb6\xc9\x1dl\xdbnAT?Z?\xa1\xe5\x03\xba\x7f\xc66\x9f_\x97\x96\xd4\x8c\xdds\xd6x\xc2NJa\xaf!\xed\xc6\x82}\x08#Vm\xd0\x95\x9d\xeb\xd2\x92\xbf\xbb\xe8o\xef\xd1\xd3\nk?\xf2+OX\xf3\x94m\xfe\xea5\x96#\xeb\xc2\xd7\xd0\xe1/\x8561-\xea\x8a\x84M\xe8\x885\xb0v\x89~\xac+\x0cg 1d\xc5\x9e\x0c\xc6\x81f.j\x04h\xb5\xc2\xd6[\xe1k\x1c/}\xb2\x08\xa4\xf1hx\xffRK\xdb\x1d\xd6.\xf5{\xab\xfe\xf6\x9e*i\xd0\x9eK!$&\x9b\x82\xf6\x1c\x80\x0ep\xb8,\xb5\xc8\xd0\xf1\xb7\x86c`+\xe8ydQ\x1e\xfc\xd4\x1c\xa8gL\x92\xe6\x05I\xd6\xd4o\x0dT\x8c\xa2\x13\xcc\xda8\x0fH\x0e\x01\x87\x17\xd7\x89i>\x85\x10\x91\x892\x04\xe9\"\x88\x03Wv\x16E;\x9c\xe2\"0,\x82\x81\xecD\x14\x0b\xcf\xc7Ix\x1e\x0d\xab]\x87LA\x92\x11\xa7MO\x96\xbd\xbdKzU\xf6w\xab<\xe3;o\xc2\x8e3r\xc1\x9b\xcc\xe0\x18U\x9f\x87,\xdd\xb0\xb23\x88w(\x9c2-\x01`KD5\x8e\x86E\xd2\xf8N\x948\xac^\xa1\x8d\xa7\xf1\xf4\xe1{s\xa27Qv1_\xc9G\xf1\xabtzRy\xe2me\xd4\x90\xcb\x11\x98\xc56\x10\x1aI\xfa\x8f\x8bUw\xdb\xff\xb0;\xd8{G/7\xbd\x8dN\xffB\xbd\x8dvh.\xdb\xba\x00\x95m\xe7\xe8B\xb4]\xfa\xf1U\x145\"X\xe9\xe9\x1am<\x8d\xbfJA\xbb\xca\xaf\x1d\xf1\xca\xad\xcd(D\\\x17\xb2\x87\xdd\x07\x8f\xa9\xa48\x80\xf0\xfc%\xbcJ\x89\x9b.\xa0\x8c1k \x1d\xb8\xb6\x19\x18\xce\xdaH&D<_\x02e\xc1\x14O\x1bm\xbdb\x95Z\x08b\x9b(\x94\xee\x9e\x05(qm\x11\xbd\x83\xab\x0d\xdaz\xa5P\xd7DN\xf4\xa8\x0e~Z\xa7A\xb7\xef h\xcb\xd0`\x8d6=U\xbd\x8bl\xd1\x84IJ\x0d\x15\xee\xf0\xb8\x1e\xff\xbe`\xa8\x1f\x8d\xb7\xf5\x9e\x96\x0fX\xfb\xd1\xe0\xe73\xda=\xf7\x17\xd7X{' \x93\x94\x89\x8bSXv9)D\x80c\xe2\"\xe0m\x83b\xe4p\xf1\x1bL\x8c\x8c \xd0\xf2\x7f\xe9\xd3\x15\xa5)\x98F\xac\xc9Oq\x11\xe0\xd9Y\x19\xfd\x16*\x0e\x85\xb8C q\xe5j\xe5H\xfap\xef?@\xb2\xa3K\xa9a\x80\xe7\x0d\x01\xb3\xf5F\xffB}\xd6$z\x17\xdbQ\x90\xa7\x04$\xe2.\x1e\xe7j\xe6\x11\xa0\xa9\x05\n\xaf$\xe7\xe6\xef[\xd00\x0dYqU\x8f\xb8\xb1\xe7m\xec\xf4{G\x7f\xac\x9e\xfc\xb1z\xa2\xa8\xa2c\xb9\xb3\xc0\xdf\x12\xe1F\xc8 #\x01E)\xa8:\xd8\xef\xad\x84\xd5\xc9\xb5\x82j\x1f\x94\x9f\xfe\x87]\xd6\xae\xf5{%\xfe7l\xbf\x95\xcfI\xf4=PMP1H\xff\x7f\xa8a\x1c\x8fNY\xbe`\xfe\xf36\xfb%\xfa\\*n\xc1\xc4P\xbfk\x98\xe2\xa6\xf8\xa7u\xe9\x17\xf92\xc4\xf5\xd36\xce\xda\xc8\x117A\xbb\xe7\x92\x16\xdf\xf9g\x13~>U2\xf10\xf90\xf9\xff\x01\x00PK\x07\x08\xf4zy\xc9\x85\x07\x00\x00\x00\x10\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/zh_TW/server.jsonUT\x05\x00\x01*b2h\xb4z[S\x1bI\xd2\xe8\xb3\xfd+*tb\x8e_8\xb6gg\x1e6\xe6a#|\x19\xcf\xf8\x9c\xb1\x875\xde\x9d8\xb1\xb1\x1b\xd1H\x0d\xea\xb5\xe8\xd6\xd7\xdd2f76B\x12\x08IHB\xd8F\xc2\x1227\x83\x10\xc6\x80\x00\x1b\x04\x12\xf0c\xe8\xaa\xee~\xe2/|\x91U\xd5\x17\xc9\xf2\xecF|\xbb/\nuVfVVUV^\xeb\xef\xd7\xaf\x05F\x14uL\x0b|\x87\xfe~\xfd\xda\xb5\xc0\x83;\xbf\x0f|\x87\xfet\xfd\xda\xb5k\x01\xe3b\x95$\xf6\xec\xb7k\xe4\xed\x85Y\x9d\"\xe54\xdeY\x08\x0c\xd01\xdcjY\xf5\x1c.\x15\xed\xd5<\xce,X\xab\x0d\xc0\xd8\xda\xc6')\xfc*\xdf\x9f\xf2\xfa\xb5k\x7f\x06\xea\xc0\xff\x1d\xfc\xfe\x87\xa7\xe1\xd8\xd8\xb0,H\x11\xcd\x9d\x0f\xc0H\xf7\xe0\x80{-0&\x8e\xc6\x82\x02\x8ai\xa2\x86~\x11\x87\x07}\x18hx\x02\x85\xc4\x11!\x16\xd1o\xa2\xefea8\"\"=,iH\x1aA\x13J\x0c\x8d\x0b\xb2\x8et\x05i\xb1hTQu\xa4DB\xa2\x8a\x86Ue\\\x13Um\x00\x0d	#\x82*!EEw\xa2\xd1\x88\x88B\xe2s)(jH\xd0\x91\x1e\x16\x91\xf8\"*\xca\x9a\x88\x94\x11$+\xde\xb4HW\x05Y\x8b\n\xaa(\x07'nz\xabz<\xf4\xe0\x17w-\x8f\x15\x1d\xd8\x8b\xe8\x81\xa2\xa2_\x14\xf5\x99\xb3o\xa9\x84\xd5\xd8'\x999\xe3\xf4\x14\xa76\xf1\xee\x89Y\x9d2k9\xf2\xf6\xc2\xe3$D\xc6\x85	\xed'%\xf8\xcc\xe5G\x9a-;\xb1b\xcf\x96\xf1n\x15\xd7\x1a\xa4\x167Z9\xfb\xed\x9a\xc3\xb6ph\x9c]\xd8\xd5}k\xa1h\x9cN\x93F\xc5\xdc_\xbf\xea\xe4\x8d\x93eR\x9bdd\xa4}\x8es%\x9ci\xda+	\xfb\xed\x1a>-\xd9\x93\x0d\xdf\xa4\xb2\"O\x8cI\x9a\xe8\xce\x89\xf3\x17x\xae\x80\xf3eg\x92f\x9ad\xe3\xa4\x965+\x1dRN[\xf1\x94\xbd\xbag\xae\x9f\x9a\xc9S\x86\xeac\x16\x0bI\xca\x1f\x95Hl\xcccg/\x1f\xda\xe9\"\xe7e/\x1f\x92F\x12\xbf\xca\xe3\xb3}3\x9b&\xafv\xc8\xfc9\xae4\x8c\xd6\x8eY\x9db\xa8\x97\xf1\xa4\x9f\xa3\xae\x8cJ#.7\x9c+\x99\xa5m\xf4\xc3\xc3\x07\xc8l\xed\xe2\x9a+\xe4gp\x97\xc5\xf0\xe8\xa3\x98\xee[^3mM\xe6Ie\x8f\x89`\xbf\xad\xd9\xcb\x87\x81\x81_\x19\xf3q\xfa\xa3\x14\x12\x15\x97\x95\x1f\x97s0wW\xcd\xb9i\xfcz\x1573x\xff\x83Y\x9d\xb2.\x8e\xf1\xe2\x8a\xb9\xf8\xd1\xea\xb4\x8c\xb3\x15\x80,\xad\xe1\x93\x92\x8f\xad\"\xa8\xa1\xef_D%u\xc2e\xcdt\xc3\x8e\x9f\x93\xda2\xa9$\xed\xf2kG\xc4Z\x03g\xde\xdb\x95u\x86\x81\xb3\x05r\xf0\x8a\xd4\xb2F\xbbm\x9c\x95H\xb9i\x1d\xc0)\x99\xd5)\xbc\xbeEJ\xad\x9ei\x1e\x0b\xbe\xa3\xe1,\xe6\nfc\x9f\xb3'\xe5&\x83\x02\x03:p\x19O\x92Z\x1c\xafWqq\x0f\xe7Sxn\x1b}}\x1b\xe1x\x0e7\x8bxo\x9b\xe4\xb2F+\x81w\x16\xc8^\x81d\xca\xa4\xd4\xc2;\x0b]GH\x97\xf7T\xd2#\xbd\x13\x93F\xc5^\xcd\xf7\x99\x98\x0d\\u\xf2x\xb2H\xde\xec\xa1\x1bwdi,\x86\xfe7z$\xc8\xa3\xca\x0dW\"\xf4\xf5m&\xc9\xce\x82\xf9\xa1\xde3i\xc8\xdbL\x9c\xda$e\xe7\x84\xbc\xfdaPw\x7f\x82BT\x0f\x86\x05\x97\xca\xdeZ\xb0v\xb2\xe6Z\xc7!\xac'\xc8~\xd2\x9co\x90\xcc\x11\xde\xcd\x93\xcc\x9c\x8ba\xb47\xec\xf8\x81\xb5\x9a7\xb3'x\xb7jV\xa7\x8c\xd3\x1c\x99L\xb9K\xb0\x1am<=\x03\xfb\xf3f\x8f]\xa3\xcf\xe6}*\x8cz6\xd1\xe5\xcc\xae4\x97\x00\xe6.w\xdc1k\xf3\x1d\xd9?%\xe5\x13\xb2\x9a\x01sKQas\xf6\x8a8\x9e3\xe7\x97If\x0en\x95\x83\x8f\x9bi\xbb\xda [\xcbv\xa2E^\xa7\x8dV\x1c\xc7s.\x15[\x1e;a+}\x88\x9b/\xd17\xb0\xb9F\xab\x80\xe7\xf2~\xfe>\xd1\xc3\x82:\x14TT\xefd\xf1\xce\x02NM\x92\x8bm\xb3\xd2\xc1\x99iP@Wo\xd9\xce\xc3U?nX\x9d=6?#\x001\xdf\x1c02\xf2a\xc6N\x17@\xa2\xa3\x94\x9d\x98\xb5+s8s\x84\xcfA\x1f`\x032Gv-n\xd5\x13l\xf5\xee\xe2\xbaN?\x18\xd3te\xec\xde\xd0\x90+\x97#\xc5\xcc\x8a\xd5\xc9\x82%\xcd\xc6\xedD\xcbh\xb5\xed\xd5\xbcq\x923Z3`\x90){+\xfd\x1e\xce\xf0|\x0b\xdd\x1b\x1aBV\xbd\x88\xb3\xe9>\xac\x9f*\xa3\xa3>\x95\xee\xa2rf\xcb\xa4Iq\xb1\x9b\x1f\xe8\xc6\xd9\x859\xef3\xc1\xdc\x97\xf9\xc5\xb5W\xe6\xac\xc6\x0e\x13\x8f3c 2\x99\"\x87	\xa3\x95c+\x01n\x14	6\xa7t\x84w\xd7H\xe6\xc8\xdc\xdec6\xc1j\xec\x98g\xbb\xa4\x92\xfcl\xae\x9f\x04y\xd4\x15\x9dq\xb6\xde/Y\x8dx\xff\xc9\xacz\xc2\x9d\x8f\xe1\xe1|\xca\xcfT\x8b	j0,=\xf7\xf6\xe3\xbe\x0f\xc6x\x86<\xc8ME\x1dE\xb8V\x06\x170W\xc3\xcd\x9c\x8f\x97\xa4\x81C\x7f\xa2\x0c+\xba\xef>\xbc9 ;kf\xe6\x83\xb5|\xe0\x88HA\xb0\xb9\xb9\x92yt`nW\xc8\xcck\\,\xe3\xe3\x0d\x9c:\xbe\xeadp=\xc9\x98\xe3N\x89\xbc\x9e5?\xae\xe1N	W\x1aW\x9d\xac\xd5x\x8fKE\xb2\xc3\x8dh\x97\xeep\x01\xfe\xa0\x89\xea]\xb0\\\x9e\x10f=Av\xd6\xec\xb7K\x9e\x91\xcf~\xc2\xedS\xee\xc7\xfdb\xa1\x1bBhL\x92o \xdc:$\x99#\xe3$\x87\xd7\xcbpX\xd4J3 #\x06\x83\xfby(\x10Rdo+\x99\xb1a\xb7\xc7\x99e\xa1f\x97\xb3$\xfb\xda.\xe7\xe0L\x0e\xbaM\x8a\x18\x12e\x97\xfc\xfb\x90(\xa3'BHR\x02\x03=\x00$+\xe3(\x1a\x11&$y\x14\x0d\x0b\xb2,\xaa\x9e\x0c\xa24\x1a\xd6\x87\x85H\xc4\xe5\xf4\xbf~\x0b\x9f\xc8\xdc\x99'\xab\x99\xc0\x80\x1f\x16\x16\xb40\"\xf9\xb4\xd1^\x87\xe5P\x0c\x16 \xf6\xf5\x1f\xd4ls\xacWy\xf4\x9b\xdb_4\xe4\xe2\x98 E\xbeWUo9\x00@v~\xcfz\xef\xd8Ev\xcf\xf0\xde\x94\x1dO\xb0\x01D\xb1\xfa2z$H\xde\x8a(\x1a\xe7\xc2)\xc5/R\x0e\n\x9a\xa7\x0cxo\xdas\x0d~R\xb8\xe1l\xb0\xef\xf4\x83\x8a\xaa\xbbL\xe8\xf4\xc8\xe8\x9c\x92Z\x01W\x1av\xbcj5f\xf0\xf2J\x1f\x89<,\xb0\x95\x0eb\xdf)\x86b\xc3_\x9a\xe1\x9f1\xc6;s\xe6\xd1\x01^^\xeef\xfc\",\xca\xba y\\_\xfc\xc8\x00\x8c\x9d3\xfe+\xd7Z|\x11\x15\xe4\xd0\x1d\x9f2\xe1\xfd\x92]\xce\xb1\xc8\x92\x99\x82\xc0\xc0\x17\x06.\xe3\xc9_\xc4\xe1G\x97\xf1\xc4\xe0\xfd\x07\x08\x14\xe6\xd1\xe07\xc8ho\xe0\xe2\x8cq\xb6\x026\xe0x\xdf\xbd\x06F\xab@j\x93\xb8\xb8\x803M|\xb6o/\xc3\xfd\xee\n\x8c(S\x16#[k\xdbL\x90\x9e\x05G\xfd\xc7\x84\xf3{8}\xea\x88\xd7L\xdb\x89\x96\xbd\x92bP\x9ci\x92\xf7\xa0\xed\xdeZGD14,\x04\x9f\xd1\xbd\xf7\xd6[,\xd8\x1b9{\xf2\x93\xb9\xeb\x04[,\x8e\xc6\xb5\x86\xbd\x92\xb4'\x1b,\x8f\x02\xff\xe8\xb8\"N\xb3\xd8\xc6;s\xf6\xe4'\xa3}\xe4\x9b%\xe2\x0f\x18p-G\x16\x9c,\x8d\xc7\xe7N\xb8N\x97\x0c\xc3>\x1f<\xe3c\xa4\xa8A1tGV<\x93\x81;\xc7\xe0si\"\xc0y\x9a\xf5\x849\xef\x91{\"\xce\x15.\xe3	]\x95\xa2A%$jp:\xfd\xe5\x1d\x15\"\x11Q\x9dx\xa4\x84|\x96\xadV\xc6\xa7\xdb\xa4\xb1\x8a;N\x86\x80'yz\xc1l\x05;\xab>&n4\xa2\x0c\x0b\x91\xfb}\xbd\xc5\xa0*>\x17e\x1d\x05Ua<\"\xaa\x1ab\xc8\x91\x89\xc0@\xd7\xb8\x10\xd3\x951A\x17Ch\\\x1c\xd6$]t)\x06\x90\x16\x0b\x86\x91\xa0!M\x04\x9f\x85DyT\x92E$\xc9!\xf1\x05\x1d\x1fQ\x951$\x04\x83\xa2\xa6\x81\xf1\xa4\xf9'\xe7\xe2K\x0eG\x15\xc5\x1f$\xfc\xc0>a\xc8\xf9\xf8\x82\x1b\x0cK!\xbf\x0dg)\xdeU\xa7\x8ansj\xd2J\xd9\x95u\x06\xef\xb3=a)$\xde\x95dY\x0c\xb9\x9b\xcdp\xf1\xf1\x01\xcf\x1f\\\xa2\x81/\x0c\xb3\xbb\xd9\xeb[\x80sW\xce\xcb\xf8\"\x80!\x96\xdb\xe2Z\x03\xdd\x12\"\x91[\x9c5>_b\xdf\x08B=\xea\xea\x8c\xd6\x0e\xa3\xb3\xd6\xb6I\xa3b5\xde\x98\xc9S$+:\xd2\x84\x11\x11\x8d(*\x1aW\xd4g\xa8\x8fo\x04\x01\x9e\x88\xc1\x98\xaaI\xcf\xc5\x88\x17\xe5\xdb\x89%\xeb\xe2#\x13\x97\xf1vVF\x07\x18\x88\x9b\x95\xc5%\x92\xceBNL\x81nJ\xd0o\xad\xb4b\xe1\xe9\x16\xdfq\x7f\xee\xc9\xf4\xf5\xaa\xd3v\xfe\x9c\x91|\xd6\xce\x94\x8c\xf3i\xa3\xfd\x8e,^pl\xefp\x15U\xfa\x9b\"\xebB\xe4\xb12>\xc8\xdc\xaf;\xc1\x8f\xee`\x1f\xef\xecTG\xeeK\x1a\xb8\xed~\x18H\x92#\xa0\xaa\xaa\xa0\x87E\x15\xe9aAF\x9a.\x04\x9f\x89\xa1~\x12\x0c*\x9a\xee\x9f\x1e\x8c\xd0\xdcK\xbe\x11l}\xdf\xfc6\x08LH\xfa\xd8\xca\xcc\x18'\x90\xd8x\x9c$O\xc1\xccJ\x1b\xa768\x91\x0fcL\x18\x15\x7fT\x9e\x8b\x9e\x0f\xe7:\xdf~i'f\x99\xf9\xe5Tf\xe9\x88Cg\xdb\x90\xf6r\x03	7\x04\xa2\xd4\xfaY\x0f\xdb'\x8a\xa2\xff\xfc\\TU\xa9\xdb\xaa\x00\xf7\x95\x13sq\xd7\xceOY\xebi\xbc\xb7\xcd'\x80\xa0p\xa9f\x9c\xad\xe0V\x9e\xd4\x16q3\xcdd\xb1\x1a\x0b\xe6\xee*)wXV\xe2&=\xc6Y\xc1<\xdb\xf5R\xd1{\xf7\x1f_u\xf2V\xa7\x85S\x1b\x10\x97\x95>Bf\xb4r\xc2\xf0\xc0\x94\xc7+\xd6\xbb\xd5\xcbx\xd2,U\xcc\xadS\xa3\xbd\xc1\xc2}\x1e\xd1S4\x18\xdd\x9b2\xcesW\x9d\xea\x8d\xb0\xaeG\xb5\xefn\xdd\xa2\xdb\xa4\xdddu/\xf0\xa47z\xd6\xda\x93hQ\xb1\xfb$Z\xf0\xdd\x9a\x84et>\xb9\xd6\xf3\xdf\x99\\Ic\x9f9G\xf7\xd4\xf1\xf9\x12\xf3\x86\xe0\x1cS\x1b\xccQ\xfa\xd6AU\xf3\x81\xe4s\xad\xfe\xd3g\x1ff\xe6\xc8q3P\x14\x02\x1f1Y4Zu\xb2rB^W\xad\xc6\x0e\xde\xadZ\xef/H\xe9#^\xdf\xc4\xcd\xa2\x8f\xfd\x7f\x85\xbc\xc8\xe7\xe1\xef\xef\xdf\xe5|\x01\xfe+\xb1\xc9_\x05Y\xd2\x15\xd5\xbb\xe3\xe4\xed\x05\xceU\xf0\xcc\x9697\x1d\x18\xf8\x0c\x04B\xb5\x0e\xad\xca2zx\x1fB\x0b\x1f6.\xbe\xc7\xc5=\x88L\xa8\x11e\xeb\xe8\n-\"\x92\xfc\xcc\x9b	\xaf\x97\xed\xc9\x86]\xcc\x90Yg\x0bI\xb9\x89\xd7\x96.\xe3	\xc6\x82d\xca,]f\xa8\xe8w\xbf\xfb\xdd\xado\xe1>\xde\xfa?\xf6\xea\x12^\xca\xe1\xe2\xa4\x15\x9f\xf4V3&hA\xc5\xb7\xc1sYsc\xc3\xccnu\xc7\xca\x0e\x94\xdd\xb0^\xea\x87\xa0\x8b.\x0bNi\xd5\x13L\x95\xa1\xf0\xe7\xd0\xc3^\xd0M\xf5\xb3\xd0c\xbe\x8a\x80\xbf\xe8\xe9\xc8Pk\xf0L\xbe^&\x1fVy6VI\xe2\x973\xe6\xf2\x86\xb5u\xc0\xf29\x16\x01t\xd1\xfb&y\xf1#MP\xdcy\xb8\x95\xd8~\x83O\xebL\xad\xf9lFk\x06'\x0f\xdd\xab@\x93\x91M\x86\xd7\xc5oH\xfa\x9b'5Cg\n\xf6\xcf\xb91\xbc\xabN\xe6\xd1\xdd\xabN\xb6\x8b\xeb/RH\x0f\xf7\xb2\xdd\xfb\xf0/	\xc9\xf0|\xec\xc4\xd1\xd8\xd3?\xba\xcc\x1e\xb1O\xae6\xb4\x94\xcakwN\x15\x8a\x95{X\x81\x12lS\xba`\x96g\xdc\xa2k/\xe3\xa1\xb0\xa2\xea\xc1\x98\xfe\x9f\x98@	\x89\xaa\xd0{\xc9\x8cV\xdb\x91\x1e\"\x82v\xcf\xc52\xb3\x19\xa3\xd5\xeew\x9f\x12\xb8\x99\xb0g\xcbn\x84\x0bAg\xbc\nncf\xcbj\xbcA\x0f\xef#\\\x88\xe3\xcc\xb4\x8b\xd1u\x07e\x7f\x11\xb4\xab\xfci\xd5\x13ve\n\xcf\xac@X\xefO\x19\x18\x92\xbb\x1eY\x1c\x87lp\\Q=\xf7\x07\x17\xd7\x9f\x14vc+\x9a\xb7\xaf.gg\xf54\x8f\xf7\xa0\x1e\xa1\xa2KA\x9f\xa8\xa9\x0f\xf8\xe5\x0c'b\xe5|\x969\xf0\x80\xbf:e6W\xcd\xe5\x1d\xd6\x87!\xf1M\xab1C\x12{\xdd\xfcF\xa4\xa0\xa0K\xbe`\x9f\xac\xe6\x81Q\xbcj.\xbbf\xbc\xd6\x80\xc4\xa8S\xa2\xd7\xb4\x8c\x0b\x87\x90\xf5\x94sx3\x87\xb4	98.\xe8\xc10\"\x95\xa4Y8\xc0\xc5r\x17\x07w2%\x12\xfal\x8f\xac\xcc\xcc\x17\xf6H\xe1^\xfc^\x9fj\xa8U\x9f\xb6^\xe7\xfa\xd7D\xc9\xdb\x0b+\x93f \xd0\x9ff\x81Fv\xd0\x9a2\xce\xa7A\xfer\x87\xdf\x07\xa7\xbc\xdc\xb7\x80\xda\xa5 \xca\xb8,\xfaT\x95Q\x92\xd7	\x08\x87\xe3\xa9\xc0@?h\xaf\xf2v\xd3p-\xde\xcd\xe3T\x83\xd9;W\x1c\x16\x92\x92\xd9M\x9c9\xba\x8c'\xf1E\xca^\xc9\xb0\n,\xb3\x80\xe0\xcb\xe3\xdd	j\xb4wc\xbf\xb0\xabQE\xd3\xef\xa9\"=\xf0\xee\xf8\x81\xa9\x1aT\xaf\xb6s\xfd\xca\xb5t\x00f\xce\xa6\xdd[\xf0\xef\x8c\"@\xb2\x874\x1c\xf8\x9e\x96\x06\\\xc1H\xb9\x03\xc6\xfeS\x9eE\x03<\x9e\x8f\xbf3?\xcd9\x1b_:\xb2\xdbK\xe4\xf5\x8c\x7f\x0c\xdcG\xada\xb4r\xa4|\xc2\xe8\x19\xdcMs\xe0LJG\xbcpVIBH\x97M\xe3\xe6,\x9e{	\x89\xc0g\xc8\x9e\"G\xd5\x98,\xf6\xd4\x02y\x92\xe4\xaf\xfcq\x7fM+\xaf\xee]\xb6\x13\xf3\xe81\xc2\xeb[}\x12\x19\xca\xf7iX\x15\x05_\x91\x91\x07\x0e\x8dYk\xbbl\xb4\x0e\xfa\xf0f5\xc4\xcf\xd9{4\x9e\xe4\x131\x97\xb1U\xda\xc2[u\\Z\x86n\xa0[	2Z\x05(\xee\x96|Q\x94JK\x85\x0e\xd9\x93[\xc2-\xaft\xc8\xbf\xfa\xe4\x1d~z9\xa4\x8cA\xa3\xe9G%\xe6\xbbEO(\x1c\x81\xf1Ea:\x02\xd39\xf0\xc8\x04R\xe4\xa0\x88\xc4\xe7\xa2:\x81\xbe\xfe\x0d\x124M\x1a\x95\x91\xc0\x08t\x05	\x08tFT\x91\x04P\x9d\xee\x9bo\xd6\xe1\xa7\xe2\x0b\xcf\xc4\x9a\x1fS\xb7\xac\x85\x02\xea\xea^s3\xf9\x11\xfc\x85\xb5Pp\x9b\xdb,)\xb0\xb6\x0e\xd8\xa79\xdf@7\xfe\xa2\xde@$SF7\xfe2|\x03\x91\x95\x0e\xee\x14\xa1%\xea\xcd'\n\xa1\x9fe_\xfa\x89\xe7\xf7\xac]\xb7~N\xd5\xcc\x7f\xc7\xfc\x94\xa3\x92\xa6\xfb\xb2!\xd6\x1c\xe2\x94~\xbc\x88\xa0K\xcf\xc5\xa7\x92\xcf[\x99\x8b-0q\xb4#H2N\xdf\xb2\x07z\x19O\x1a\xe79\\O^u\xaa\x97\xf1\xfc\xd7\x087\x8b\xa4\x92\x04\x03\x94-\\\xc6}\x0d[U\x8c\x8a\x82\xb7kv\xba`\xad;^\xa9\x0b+2\xf1\xa4+\xda\xfa\x13^\\\xb2\xea\xd3\x7fF\xd0\x8f,\x1e\xdaIG~\xc8\xa8\xe8\x10\xcb\x81\xcd\xcdvW\xf7\xb9x\x88\x13\x1f}\x9c\x15E\xff\xc3\x93\x9f\\\x01\xc8\xca	\x82o\x1eq\x1etp\xad\xcc\xf4\xda\xcc\xd2\x1e\x17\x1b\xbf\x89\xa0\xe3y\xba\xe8\x8f\xe7Iq\xce8_\xc4\xa5if{\xf0\xee$\xc9\x16\x98\xd9\xb5\x139\xe3\xac\xd6u\xa7\xd5XD\xf4T\x937y\xdc8\x17\xfc\x1f\x05\x91\xc6\xaa\xf9i\xca|\xbf\x80\x8b\xef\\'\x0b.\xa7\xee\xbb0\x9a?X\xb6\x12e\xe3\xd4\xad\x15\xd6\x1a\xec\xf4\x99&\xc0\x19\xb0\xfb\xd6\xe4F\xd5^I\xe2L\xd3\xbd\xb9`c\xa9C\xef\x92U\x13\"\xde\x11\xe1\xdd,N5\xec\x933>\x85}r\x06&\xb7\xdca\x03fu\n9\xc58\xa8\xc5\xb1\x80\xc8\xfcP\x07\xfbC;\x82\xe0b\xda\xa7\xd6N\xd3.\x1d\xe3\xd3:s4P|\xf9\xf6K%xM\x88\x05EY\xf0\xfa\xdeC\x00x|\xe7g.\x823~3\xa8\x8c}\xa1\x9c\xa5A\x99L\x91{\x9a\xdc\xac\x0fBj\x93\xd6\xd6>\x04\\\xac\xd5]:\xe6|y\x0d\x94\"\xf1>O\xa5\x8d\xd3\x90S\xe3l\xa1O\x87[\x0b\x0bcR\xb7\xf9\x1a\xf2@\x03=\x80_5bZT\x91\"\xfe\x18\x80'\x043i;^d\x05+\xce\x10g6\xdc*\x02iT \xdb\xf0#y\x1cuad\xa4\xbb\x0d\xce2G+ql\x9c9eV\xb7D\xcbz\xe0P&\xa3j\xc7^\x0et\x11\xb8\x9cu\xf1\x85\xdem\x8a&Sve\xae\xdb\xf4Q\x83\xc4rs\x101y\xe8\xa3\x0f\x8b>\x0b\xd3\xddt\xa4\x9db\xda\xb8\xe4p\x1f\x15\x98@v\xa2\x8f\x84\x17\xde\xa2h~\xe5\xaa\xb4w\xae\xde\x13\x86n\x17\x07\xcd\xb1l\xa1\xc7o\xf69\xdd\xae	%_\xfcZ\x8b\xe3f\xf1?1a\xd7a\xf5y\xb3\xe0\x86p,\xec&\xc5\xa2u\xd1$\xf1M\xfe\xb0\xc1cD[\xc5CL\xa5\xbe\xa4Q^H\x0b\x99Z9\x87K\xcbn8\xc2\x1b\\\x8e^\x01\xaa\xc7\\\x15\x82\xe2\x98\xe2\x1d \x05\xdc\x04\xc8@\xf77\xc2\xb9\x12\xe9l\x93\xcc{\\+\xf7\x16Ab\xd0\xef\xfc\xc1e\xe26\xad\xd9\x1b\x97\xfe\x0dm>\xe6\x8a\xc2x\xf4-\x1e\x98\xf3\x0d\xe3\xac\xc6\x08\xfa\xd4\x0chB\xc1C\xa1\x9f\xe5'b\xd4\xaf\xcd\xb5\x06\xf7(\x95\xa4\xbdP#\x87\x0d\xf7\xac\x03\x03\x9fa\x80\xe0\xb9\x12\x18X\x07\x89\x1c\xb7YB\xc7\x88aS\x9d!\xa3\xb5\xe3I?.\x0e\x8fu\x97)\xa1\xe9\x84\xfe\xd5*%\xbcR{\x84\xa0\xccW?\xbb\x8c'\x99\x17b\x99\xdde<\xcf\xcd\xa2\x8f\xd7e\xbc\xd0ee\xc7\x15\xf5Yws\xe4x\xc38\xabu5Gx\x8d\x9e\xf2\x82\x9c\x97^j\xfej\xa1\xe7,\x1cv=\xcf\x14\xfegL'\x04\xe8\x7f\xb8\xfb\xf3\xff\xd9'\x0c9\x1f\x9f\xdb\xff\xeb\xd7\xfe1p\xfdZ@\x89B\x0e\xe2>8\x94Y\x83;`N\xadR\xfa\x80\x16TE\xda\xf0\x08\x98\x1f\xeax.\xc3\x1fI\xd1\xb1q^C\xe1CnY\x842\xd6\x14U\x87}sLu\xc0^I\x82\xfd\xf4\x1eMQ\x0bq\x9e\xf7\x94\x88[\"\x9e\xe4\xf81Yc\x80\x94Z\xce\x93\xb5\x003\x99\x1cr\x9d\x9eU@\x17\xdc\x86@\xc0h\xc5\xad\xcc\x07\x86k\xaf5\xc8J\x87\xf3\xf6\xef\x03\x85@\xf2x\xf4\xce\xacN\xf1\xcf\xc21>\x7f\xe9\xb2\x14\xc7\xa2\x11A\x17\xdd\x0d\x1a\x16\xe4A\xff=\x82\x925\xb4U\xbe\xd2n!\xc8\xcdi\x01\x84\xe6[\xfb\xe8+\x0d\xb2\x99\xc5\x153	\xbe\x11\x1e\x87\xcc.\xe3\xc5\x95\xabN\x95\x9f\x0d\xf3\x1e\x8c\x06\x9e\xf3\xd5&\x81\xd7W\x1a\x8dp\xbf\xd2\x10>\xcf\xb3\xa7f\xa0\x91\xbc&A\xab\xad$\xf3\x12\xc7_B\x1d\xdc\xe9>_u\xf2\x8c\x1bz8\x88\xa0\"\xfe\x95\xe6\xa81?\xe9\x98\xe4\xac\x81\xbd*\xe5\x0fJ)\xdf\x80\x10\x0c*1\x19\xc2\x19\xf0\xfeVe\x19\xbf\xcas3J\x1fUp\xac\x10\xe4\x83\xbc\x14\xcaA4\x1d\x18\x02'J\x87\xf2i\xf2\xf1\xdc\xccfp\xae\x02\xe5\xc1\xd7\\\x8d\x86\x05\xaaCl\xa9.H\x16\xd5\xa1\xa8\x18\x84\xcd\x0d\xb8\x8f\xd9\xc8\xec\x06..8o\xc8\x10<C\x85\x0e\xf3c\xf8\xfd\xe1\xe1\x03\xba7\xf4NC)\xba}t\xd5\xc9S\xd2M\xdc<\xc5{-\x88\x98\xbe\xb9}\xfb\xc5\xd7\xb7o\xbb#\x0c\x91\xa2\x14\xf98\xfa\x7fw\xe1\x90\xa6V\xad\xc4\x81\xbd|\xe8\xeco`\x18\xec[\xc0\x9c\xdf\x0f\xf4\xbc=\xebyv\x06O\xac\xe4Q\xd1WH	\x90\xc5\x8fd\xfe\xc4\x97\xf0\x03\x8ez\xcf\xd9\xd6;c\xf0\x0f^\xaf\x02X\x08\xea\xd0\x00\x95d\xfa\xb4\x15\x12(D\x9f\xc6\xd1\x9d	F`W\x830\xeb=\xfe\x97\xc3E\x01\xfc\x14o2r\xa0\"\x8fH\xa31\x95e\xc20j\xa7\xa0\x99\xe1\xcb\x81=\x9c!Qe\xfd\x1e\x8e\xe4\xaa\x0f\xe7\x05%	\x8f\x11\xbb\x87~F!A\x87\x87x\x01\xeb0M\xca\x15\x0e\x13#\xa2\x9f\xa87\x03\xe7\x08\x8e\xfb\xe1a\x86\xefi\x01G\xe0\xa58'\x0c\xf1:]\x81PLuJc\x01_$\n\xcf\x01$U\xa4\xdac'fIm9\xd0\xd5\xe7\x078\xeb\xd4sx,\xf8\xecg\xae\xa6\xeds\xa7\xbd\xc1;\xd6\x149\xd50\xe7&\x03^\xff,\xf0\xf0\xbe\xf3%\xca\xba\xa4S\xe5\xb0N\xb7q\x86_\x08)\x12\x11G\x19\xb1\xfdv\x89\x1c\x96\xa0\xc4\xc1\xeb\xe9\x81\x88\"\x84x\xd9\x0c\x10py\x97\xbc\x9e\x81 \x99>\xd9\xea\xd1'@\x96\xe4Q\xef:\xd0[\xd0\xab\xd9\xdf\xde\xbe\xfd\xe2\xdb\x7f\xaa\xd9\xc8h\xcd\xbb\xaa\x0d&\xa1\xcf\xb5q5>\xa2\x8c*\xb4\xb6L\xfb\x84\xe9S?\x94\xbd\x0b\x81\xc0\x87\xf7\x9c\xdf\xbd5\xcfv\xe1Q\x9e\x0f\xb5\xa7x\x19\xf0U,\xbb\xc6\x9e>\x85\xd1\x07\xf0\xaa\x01\xc1s%(X\x8a!xI\xaejPG\x80\xb0\x15i\xca\x98\x88\x86c\x91\x88\x16\x96t\xc6\xc3\xf3P\x01\xde\xb7rj\x81\x8f\x15\xb7\xfa\x03\xa6\x94\x97xhi\x8f\x15c\xfcZ\x18\xe5\xea\xe5W\xachL\x1du\xf5\x8e]*\xc4\xc6o\x99\xa9MW?U1$\xa9bP\x7f8\x08\x0c\x9e\xf0/x\xee\xfep\xb0\x1b\x83\x85J\xbdX\xbc&B1\xd9\xbb\x85\xa7\x8a\x12\xd1\xa5( \x1a\xed\x0d\x16\x97B\xf9\x9c>}\x85\xcan>e\xbd;\xb1^.\xe0b\x81\x94k\xe6q\xd5\xadS\xb1\x92\xb7q>\x0d*\x7fq\x0e\x8d.\xa6*;\xef\xacz\xd1Zm\xd8\x89y\xdc)\xba\xe7\xab\x89\xfa]\xde\xa3\xfe\x0e\x05\xf8\xd3C\xfa\xa2\xc5\x11H\xff\x89\xe9\x9e7\xce4\x14\x12\xbb\xd26\xc7\n\x0b!e\x1c0\x86\xd8?\x1f\xf4.\x0d\xfa\x9d\x0e<\x7fE\xe3\xfa\x7f\xa0!\xb3\xaf\xf0\xa9\x97\n\x18m\xae\x1e<\x9b\xf3L\x03\x8d\xa4\xfd\xa6A\x8b\x0d\xffU\x0cR\xf5\xe4\xd1;c>!S\xf3h\xc7\xdf\x91\xd9\x0d3\x17')~\x9ba\xc4\xb5\xb9F\xab\xc0J'p	\xc8\xc7\xb6uZ\xb8e\xb6\xcepj\x1fAa\xf1\xb8J\x9d\xa5/\xaa\x80\x94\x0d\xf8\xfar\xb4\x80\xce\xea<\x01\x7f<\xa2OD\xe9\xc2X\xab\x8f\xc1b2wr\xd6\xe6;\xbb\xb2\xeewu4\x8cfv\x97i\x08\xfa\x85C\xae_\xfb\xc7\xf5\x7f\\\xff\xef\x01\x00PK\x07\x08\xc7\xcc\xae\xb0R\x15\x00\x00\xf81\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00$\x00	\x00sql/functions/assert_can_perform.sqlUT\x05\x00\x01*b2hl\x90\xcdj\xc30\x10\x84\xcf\xd2S\xec\xc1\xe0\x04\x92@\xcf\xa6\x85>I\xd8HcG \xaf\\\xed:\xcd\xe3\x17\xff\xe4P\x88.\xd2J\xfbiv\xe6|\xa6oUT#\x0e\xa1\xccb\x14XhB\xedK\x1di,\x11\x95-\x15!\x0e\xcb\xe6C\x05\x1b\xa8\x9fe\xad\x89W\xf8\x1aX\xae;tx}dx\xda\x89n\x85k\xdc\xcf\x19\x0fd\xd2\x91sNbG_as\x15\xa5GI\x91X\xa9i|D\xc8\\\xe1\xdd2\xc6\xad\x94\xdc\xf9\x1b\x86$\xde)2\x82\x11\x9eIM\xe9\xb0\x97\x1f\xde-\xab\xafe$5\xee{\xd2\xed\xe6\xf7\x8e\n\xd2\xcb\xa6\x9f\x84\x0e-\xe7\xdc\x9e\xdeL\xbc\xf5\x1c7\xce\xb1D\xd2\xcbT4\xad\x06\xbf>\xdf\x11\xab\x93\x7f\xc0\xcb\xf5\xdb\xf6\xfdq\x91Hbe\xc9\xb8\xf3.\xf5$e\x0b\xdc\xee\x10\xef\\\xe5\xa4 <\x03\xa6U\xbc\xe5\x10\xa0J\x11\x92\x10\xdb\xce;H\xa4\xd4w\x1e\x12;\xdf4\x94Y\x86\x99\x07\xd0\x94\xa7A\x7fr\xe7\xff\x06\x00PK\x07\x08A\xbd\xd5h\x02\x01\x00\x00\xd2\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x89S]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00sql/functions/board_files.sqlUT\x05\x00\x012P\xd6j\x8c\x90\xb1n\xe30\x10Dk\xf2+\xa6Pa\x15\x16l\xe0:\xc3\xc5uW_>\xc0X\x91+\x93\x01\xbddv\xa9\x04\xf9\xfb\xc0\x8e\x1d\x04H\x93v\x86|\xf3\xb0\xdb-\xfes_U\x0c=1\"\xc7\xb5\x95\x1c\xa8s\xc4\xd3\xbf\xbf{$\xb2\xc4\x86\xba`\xc9\x85\x0d\xca\x0b+K\xe0\x88\xf9\x1d\xadZ7T\x01a\xae\xa4\xd1\x07e\xea\x8c\xaaPn\x85\x02cY%\xf4\\\x05s%\x8d\xa7\x1bes{\x8cW\xd2\x90H7\xfb\xdd8z}h\xd0\\\x18\x1bK\xb4\xc7\xad\xfd\xb3\x1bG\x90a\x18\xbc3.\x1c:\xdat\xad\xbds\x8b\xd6\xcb]\xa2y\xe7\xde\x12+\xa3M\x9f\xf8\xe3\xf7\xc9{F\x12\xef\xbf\x91\x0dR;d-\xc5\xbbUr\x95/>\xfd\xe0\x9f\xa8w\n\xe9\xc2\xd2\x0d\xe4\x9d{\xaeY\x1e\xcb\xd7\x03\xb4)G\x1cA\xd35;\xe5\xf8\x1b\x9b\x83\x1f\x06\x14\x92\xf3Jg\x86\xbd\x14X\xa7\xb9\xf0\xc1\x7f\x0c\x00PK\x07\x08\xc5\xf1\x04l\xf1\x00\x00\x00\x97\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00n\x83S]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00sql/functions/bump_limit.sqlUT\x05\x00\x01\xf0D\xd6jD\x8f\xbdn\x840\x10\x06k\xfb)\xbe\x82\x02\xa4\xc3\"\xf5)M\xeaTQ\xfahm\x16p\xe4\x1fb\xaf\x95\xd7\x8f\x80\x8b\xae\xfc\xa6\xd8\x99\x1dG|\xb0\xb4\x92*dc\xd8\x16w\x04\x1f\xbd /\x17\xc9T\xe6c\x10d+L\xb3\xc1\xe7\xc6\x98y\xa1\x16\x04\xb1UA$q\x9b\x1eG\xb8\x1ccN\xe6\xad\xc5\xfd\xfd8b\xb4+L\xc2\xc8\x05\x85\xf7@\x8e\xb1\xb4\xe4\xc4\xe7t\xba\xbeNW\x9fwX\xbf\xfa$\x83.\x8f\x9ak\x83*\xbaN\xab\xca\x81\x9d\xc0e\n\\\x1d\xf7\xa9\x85\xe0\x97\xde\x1a\xfb\xaf\xbaa\x1anx\x99\xa6i\xd0J-%\xc7Go\x85h\xa5\xbe\xb3O\xd7/\x15\x16\x87\xdd\xf8\x19\xaf\x10sB\xad\xd4\xef\xc6\x85!\x17~\xb6\x99\xbc\xdfu\xd7!PZ\x1b\xad\x8c\xfa\x13P\x85l\xe0\xbb\xfe\x1b\x00PK\x07\x08T\xaa\x0b\x7f\xd4\x00\x00\x00>\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00n\x83S]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00sql/functions/bump_thread.sqlUT\x05\x00\x01\xf0D\xd6jl\xcfMN\xc40\x0c\x05\xe0\xb5}\n/\xbah%\xc4\x01(=\xcb(M\xdc\xd6Rj\x87\xfc0s|\x04\x1d4\x1d\xc1\xf6%y\xef\x8b\xcf\xec*\x93e\xca\x9c\xa2\xf3LKS_\xc5\x94\xe6\xb6\xa7K\xdd2\xbb\xd0[\xa2YV\xd1\xfar\x8feg\x9a\xcd\"M\xb4\xb8Xx\xc0\xcc\xb5e-\xf4i\x12\xd0\x15\xea:\x0c\xec\xa3\xcb\x8c\xa0v\xbd4\x95\xdb\xbd\x84\xde&\xe2[\xcd\xce\xd7\x9e\x93\xf9\x8d\x96l;\xa9]\xfba\x18q\xe6U\x14\xa1\xa5\xf0M;\x04\x05\x01\nW:\xc2c\x7f\xa2\xdf^\x04\xb8n\x9c\x99$\xd0D\x96F\x04Y\xce\x1fx}\xa8\x9d\x06JV\xea\xc5[\xd3\xda\x9f/Y\x1a\x10\x00\xde\x8f\x97Qv\xf9\xe7\xbcn\xac\x08\x7fu?\xbc\xc7\xcc\x13\xee\xac{.\x1c\x11X\x03\xc92\"k\x18\xb1\xeb(:]\x9b[\x99RLk\xf9\x88#~\x0d\x00PK\x07\x08\xa3\x18\x8d\x04\xe4\x00\x00\x00\xa5\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00nzS]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1f\x00	\x00sql/functions/delete_images.sqlUT\x05\x00\x01\x005\xd6j\xcc\x94A\x8f\xdb.\x10\xc5\xcf\xf0)\x9eV9$R\x12i\xf5\xbf\xad\x95\xc3_jU\xf5V\xa9\xc7\xaa\xb2&fl\xa3\x12\xa0\x80\xb7\x9bo_\x81\xb17\x9b\xb6\xa7^\xea\x9b\x99ax\xef7\x0c]`J\x0c\x17\x10\xd8\x1b\xea\x18\xfdd\xbb\xa4\x9d\x85b\xc3\x89[}\xa1\x81\xe3V+\x9c\xf5\xa0m\xda\x83\xba\xceM6!\xf1K\xda\xe3|m\xb5\xc7\xd99\xc3dw2p\x9a\x82\x8dxvZ\x81\"6\x1b\xa9\xb83\x14X\x8aDa\xe0\xd4\x9e\x1d\x05Uv7\xeb\xdaZ\xfef\xc9C[\xce\x0bZ\xc5\x1a\xfd\xf2\xb5\x91g\x1e\xb4\x95\xe2p\xc0\x07N\xf0.&\xcc\x15\xc9*|\xfc$Ed\xc3\xdd\x1ciKd\xeb\x8fZ\xed\xf6\xf0\xc7R39\xd4SKt\xbf\xfci/\x85\xe8\x83\xbb\x94\xad\x11\xf9\xf7\xc7\xc8\x81\xf3F\x85\xd3[\"G\xad\x1a)\x8a\x8e\xffc\xe4\x900E\x0e\xe8h!\x87\x99\x1c\x9c\x9d\xf5I\xe19\xf4.\\@%\xbf\xed\xc8\xb6ui[\x99\xee\xef\x94=>=\xc5\x0b\x19\xa3m\xda5R\n\xddW\xde\xd9\xeb*\x1b:\xc2\xba\x04;\x19\x834\xb2\x95\xa20{:\x81B\xa0\xebv!r\xd4J\x8a\xf2\xdd\xd9\x14\xe2\xc6\xaa\xc7\xe9\xb5v\xcd\x17\xf9\xc0{\xa2\xafy\xd5\xe0\xfc\x1d\x0exo\xe3\x14\xb8\x88\"\x13\x98\xd4\xb5BY\xce/\xf5\xb6\xfe\x18Gz\xbc\x95\xbf\x84\x85\x0b\xe0\x17\x1dSDU\xbfFn\xd5\xb7\x94\x12u\xe3\x85m\x8a\xa0\xdb\x94\xb9qt,\x9aK\xf7\xb2\xfb\xddn\xd7H\xc1&\xb2,:?'m\x0c,\xb3Br\xe8F\xee\xbeA\xf7\x99au\xc6\xb3k\x8c\x14AvniN\x9d\xdd\xfc\x1d\xe7\xdf]\xa9\x7f\x02\x8fU\xd0}\xben\x87\x03\xde\x15\xa3\x85H1\x1f\xa5\xe8]`\xea\xc6\xa5\xf7ZA\xdb\x19\x002\x0d\xe3\\\xb6:y\x95_\x96\xd9;\xd5k\x169\xa1x:\xadv\xde\xe0XK6R\x88:F\x7fhv5\xf4\xab\x9175\xb4\xcd\xa36\x0f\xfd\xc5\xa9\xd6\xb8\x01\xdbt\xf5\xbcG\x1d\xb1\xbaq\x8f\x87\xf3\xf5a\x97K>\x93\x998b\xfb\xdf\xfd4\xae\x95\xd77p\xc1\x95=7\x92\xadj\xe4f\x03Cv\x98\xf2E\xf1\xc6\x0f\xf1\xbbi\xe4\xcf\x01\x00PK\x07\x08a\xd4H\xde0\x02\x00\x00g\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00sql/functions/delete_posts.sqlUT\x05\x00\x01*b2h\xa4\x92A\x8b\x141\x10\x85\xcf\xc9\xafx,s\x98\x81\x9e\x01=n\xb3\x07A\x11o\x82G\x91&\x9dT\xf7\x14f\x92\x98\xa4W\xe7\xdfK\xd2\xe9\xd9u\xf0 \x98[RU\xaf\xbez)\x1dIe\x82\x8f\x88\x14\xac\xd2\x84iq:\xb3w0d)\xd3\x10|\xcai\xcf\x06#\xcf\xecr\x07\xa5\xb5_\\F\xa6_\xb9\xc3x\x1d8`\xf4\xde\x92r\x07\x19)/\xd1%<{6P	\xbb\x9d4\xa4\xad\x8a$EVq\xa6<\x8c^ES\xab{)\x8a\xfap\x13\xefoI\x1c\xc0\x8e\xca\x03\x9b\xd4\xa2_\xbf\xf5r\xa4\x99\x9d\x14\xc7#>RF\xa9\xc6\xaa\xa7\x9c\xc1\xa7\xcfR$\xb2\xa4\xd7\xc8\xdai\x1fNl\x0e\x1d\xc2\xa9jf\x8f\xd7\x1c\xddv\xe3 \x85\x98\xa2\xbf\xd4\xd2\x84r\xfdy\xa6H\xa5\xd0\xe0\xe9\x0f;NlzY)\xde\xa5D1cI\x14\xa1\xd5fZ\xd3\xf0n\x85\x93\"P\x9c|\xbc@\xd5\xf4A+7\xb4\xa7}\xb3\xf3\x06R+:\xbcy|L\x17e-\xbb|(\xbdxjV\x97Ao\xcc\xe0\x04\xe73\xdcb-\xf2\x99\x9c\x14\xd5\xb0\xc7'\xa8\x18\xd5u\xbf\xd9qb#E=w3\n\xf1j\xce\x80\xa7\x17\xed\x96/J\xc3{;_\xf2\xda\x80\xeb9\x1e\xf1\xc1\xa5%R\x85R6\x922\xd7\xe6\xc9\xd6\xbf\xea\x950\xa7\xa1E\xd6/:\xf4R\x90M$\xab\xce\x97\xcc\xd6\xc2\x11\x19d\x0f}&\xfd\x1d<\x95\x19[gZ\xa9pV	#\xd1f\xbd\xf9?\x07\xfe\xf2\xd3\xff\xc4\xed\x0cxj;\xf1\xbe\x82T\xd4\xdaE\x8a\xc9GR\xfa\x8cm\xdb\xd9\xadp(\xa4\xd6\xfb\x82\xc1\xae\xac\xc6\xba\xa1\x17o\x06\xebg\xec\xf35P\x87\xb6\x12\xad\xba\xc3\xc3x}8\x14\xacge\x17J\xd8\xbf\xbd\xdf\x9e[j\xdb\xae\x8d\xb1\xf4\xea%9\xd3\xcb\xdd\x0eV\xb9yQ3!\xd80\xa7\x1f\xb6\x97\xbf\x07\x00PK\x07\x08\x99\n\xf8;\xdc\x01\x00\x00\x0f\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00n\x83S]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00sql/functions/image_count.sqlUT\x05\x00\x01\xf0D\xd6jl\x8e1n\xc30\x0cEg\xe9\x14\x7f\xf0\x10\xb7\x88\x81\xceA.\xd1\x0b\x04\x8cD\xdb,dJ\x95(\x14\xbd}\xe1\xa4\x83\x0bt\xe4\x7f\x8f\xf8\xff|\xc6;[\xaf\xda`+C\xfbv\xe7\x8a<c\x96\xc4\x0d\xa2 \xd8Z\x99\"DC\xeaQt\x01\xe97(F1\xc9J	dFa\xddX\xad\xf9P\x99\x8c\x91+*\x97D\x811w\x0d\xbb\x08\xd9h\xe1[\xc8]\xed\x94\x0b\xee\xb2\x88\xda\xe8\xebo\xfd\xf3\x065\x0c\x83w\x8d\x13\x07\xc3\xd3.S[\xe9m\xc4+N\xde\xb9\xbf\xece\xdc#7\xd7\xbc\xa1\xe4f\xb7\xc3\x1a\xd0\x83}d\xd1\x07k\xa0\x82\xac\xa02I\xc4\x154\xed\xe9M\xe2C\xfbZ\xb9\xf2\xcer\xc1\xf5\xb8v\xca\xc5;7\xfaCK\xc3\x1e=?\xfe}\xb8\xf8a@\"]:-\x8c\xf6\x99\xd0\x8c\xee\x89/\xfeg\x00PK\x07\x08^\xa6m\x94\xda\x00\x00\x00r\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00y\x8cS]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00sql/functions/insert_image.sqlUT\x05\x00\x01\xf6T\xd6j\x8cV]\x8b\x1c\xb7\x12}\x96~E]X\x98\xe9\xeb\xdea}\xb9\x84\xb0\xcd\x18\x82!`H\x02y\x0e\xa1QwW\xcf\xc8VKmIm\xef\x06\xff\xf8P%\xf5\xd7\xda\xe3\xe4ea5\xd2\xa9SuNU\xf5\xfd=\xbc\xb3\x01}\x0c\xa0\x07uA\xd06:\xc0'\x1d\xa2\xb6\x17\x18]\x88\xa0l\x07\x1e\xe3\xe4m\xbe\xf3>8{\x82w=\xc4+\xe6+\xc6\xa3\xea\x9e\xe5\xfd=\\U\x00\x95o\x96|\xc3\xe2gp\x16A\x07P\xe3\x88\xb6\xc3\x0e\xa2[\x1e\x1f\x02\xa8\x18U{\x1d\xd0\xc6P\xc2d\x0d\x86@?\x13\xdc\xfa\x13\x18=\xe8\x08.\x85m\x9c\xf2\x1dAzT\xed\x15\xbb\x13\xbc}n\x8dn\xe9M\xbc\x12\x9b\x00\xca#\x8c~\xb2)\xde\xa0> x\xe7\x06\xe8\x9dg\x8c^\x1b,\xc1\xc5+\xfa\xe5\x8d\xc7\xf7\xd8F\xd0\xb1\x04g[\xa6@WS\xe2\xff\xc0\xe0gm0P\xce*B\xa7;\xb0.\xc2U}BB\xd0\x9e\xa0\x06\x8c\xaaSQA\x88^\x8f#v%\x93LA\xe9?\xbd\xcd.\xd3\xbc\x18\xd7(\x03\xad\xb3\xbd\xbeL^E\xed,\x81y\xfc8i\x8f\x01t\xd2h\x01o\x95\x85\x06\x97\x18\xd0{J\x9a\xc8e\xee\xdaC|\x1e13f\x9e\xcf\x18\xc1c\x8f\x1em\x8b\x1d8\xbb\xe11L!B\xafc2\x87\x8e\x01Bt^]\xb8:\x1f'\x17\xd5I\xb6\x1eUD\xa2\xecq4\xaaE\xe8'\xdb\x12U\xd0l\xb0\x9aKx$\xbb\xd4\xba\x83F_\xb4\x8d%D\xf7\x01-\xb4W\xe5\x8f?\xfeP\x94RX5 |R\x9e\x8f\xfe\xf7\xf0P\x94\x10F\xa7\x0dzh\x9c3eJ\xab^\x92\xe5C)\xf8tT\x8dA\x08\x832F\xdb\xf8\xc7\x9f\x85L\xbe\x0dl\xd9\x06T\x80\xbb;\xd9ak\x94G)\x98\x11\x91\xe1X\xff\x7f(*)\xae*$\xa6\x0c\\I\x91\x8c\xb1R\xae\xa4\x18\xd4S\xba\x13\xf6g\x1b\x1b\xaf?\xa4C\xdc<g\xaa3<+M\xe8s\xca\xaf\x99\x06Wu}2\xba\xb0\xa4\x05\x8fgx\xa8\xa4`\x1fq^\x95l\xf0\xa2\xad\x14\x01\x0d\x99w<\x85\xabzM\xd6$e\xeddL	\xe3\xc9\x8dI\xbf%\xc32\x9b\xbe\xd6\x9d\x14\x82MB\xea\x04\x18\xa5\x10\x9f\xafH\xdds\xd2\x1d\x9cw\n\x9e\xb2\x82\xf4\xc4y\x98\xc6NE\xac\xa4\xd0\xfd\x8a\xcc\xa1'c\xc8CV\n\xe1\x95\x0e\x08\xf8\xd4\xe2\xc8\x8e8\x10\x06\x93\xeb\xddd\xbbC%\x05\xda\x0et_I)F\xf4\xbd\xf3C\xea\xdc:Q<.LK\x88~\xc2\xf2\x9b\x94\x8aj)Asb\x81~\xa1\x86-\xe1\xc2\xe6\x0c\xf1\xd8\x9c\x06\xf5\xf4\xd3*S	\xaf\xc9sB4'V\xe5\xd7\xd9U\xce\xef#\xecMW\x12~G\x7fs#\xfcNrI!\xb8\xc0\xab=Jxa\x8b\xec\xde\x12f\xd9\xcb\xd4?s\xfd\xe7)\x14\xa5\x10\xef\x9d\xb6\xe9^\x80\x86\x1a\x92b\xc2\x19\xe2\x89\x0f\x17\x8db>\x9e+\x94\xb4\xd8x\xf4?gx\xe0\x01\xc1%\xa9[7\xd9\xb8\x16\xb4\x807\xe7\xed\xed[\x92m'`\x9e\xb9{\xdd\x96nz<\xc3\x140E\xaf\xb9\xbf\x8f\xbbZ\xf2QAR\xdf\xdf\xc3[b\x83\x1d\xa8>\xa2\x07\xe3\xda\x0f\xb4w\xe6\xddPBp4\xf8\xda\xc9{\xb41k\xa2\x9dM\xc3= \xae\xae_\xdc\xf7\xf8H]\xf2\nR\xa2\xff-\x92\xed\xe7>\xdcZ}\xd7\xb1j\xa9\xa8\x9a=~\xc3\xfa\xa9\xc2Kc\xe7\xfam\xb1n\x15q\xbds\xbb\x92\xd4Il7\xd6\x8cWq\x80\xa3\x14s\x9e\xcc>\x8b\xa5\xa5\x98I\xeb\xd4\xf4\xe7\xac2\xf7\xb4\x10\x04\xa1O\xce\xd3\xecQ\xa6^\xe6\xc2d\xcc\xe6w\xda\x0d5m\x048\x83\xb2\xcf{\xb9\xd6\xc9ZHQ\xdcLm\x19\xc8\xd4\xd6\xf3\xeay\x99\x17\x9b\x9d\x0d)Sl\xba\xbcIq\x97#\xdb\xbc&n\xe18\xf7K\x01=\xf3NB\xf5\xdf\xc8\xb9\xc8\xc8[\xb8\xd6)\x83\xa1\xc5c\x98\x86\xa3>\x05\xfd\x17\x16%<\xd0U!\xbe\xaa\xe7\xbe\xa0\xda\xc2qf\x95\xc2}\x8f\\\x82\x144>\xbe\xc5\x0d\xde\xcc\xfd~\xab\x8cy\xa0\xe4J\xd1\xc8\xc4n\xdfi\xfbI\x9bq^&:\xa8\xa7#\xdbX\x13,'\x0b\xaf\x80\xb2\x89\x8e:K\x8a\xef\xb5\xc1\xbf\xef\x03\x91\xac\xb2\xe0\xee[j\xde\xf6%\xccLJ\xa0\xaa\x94@[~Y\xec\\\xb4O\xcaL\x18`\xef\xbd\xed\xfbrq\xf6\x8b\xe9\xcfX\x04!v\xc738\x95\xce\x04\x94B\xa4]E\\\"\x17 `\x84\x17\"e\x1c\n\xfe\x1b}\x88\x9co\x85\xca\xe8//\xe4\xe3M[~g\x88\xac;/\xcb\x17]\xcd\x0b\xfd\xa8\xf3\xd0\xa2]$\xbf\xb6\xe8\xce\xa0+\xf9J\x8a\xfc\x9dN\xef\xe0\xcb\x97\xf4yP7\x936]\xed\x1a\xfa\xb2\xa5\xb68\xe8\xee\xc0\x92pm\xe9 \xd3>,\x92\xf0)\x15\xf6\x90\xb5\xa2\xffg\x11\xd3\xe3\xa2\x92h\xbbJ\xde\xdd\x81Q\xf62\xd17\xd3h\xc6K\xf8h*\xf9\xf7\x00PK\x07\x08`$\xb9l\xcd\x04\x00\x00X\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00sql/functions/is_deleted.sqlUT\x05\x00\x01*b2hL\x8e1n\xc30\x0cEg\xf1\x14\x7f\xf0\x90,\x01\xda\xd5\xc8Y\x02Y\xfaQ	\xc8\x92*\xd1h{\xfb\"\xb6\x81\x96\x1b\xf9\x1e\xc0\x17:\xbd\x11\xb5\xa3\xb3e\x1f\x88\xe7V\x82i-\xd0\xf1\x88\xcc4\xc6\x8bF,\x9a\xb4\xd8U:m\xebe`\xa95\xc3\x0fL\x93D\x86\xec;\xc5\x9d\xfa\xcefY\x98\xb4\x88\x1b\xcc\x0c\x06~\xeb\xb0\x81\xcb\xb9\xbe\x89{\xcd\xb3\xd7\x15\xad\x0e{\xac5\xb2\xfb\xfdq[\x0f\xf8\xf5\xc1N\xb4\xf5\xb6\x0b\x1aq\xff\xd7t\xd3xX\xce\x97\xf8\x92\xec\xa7\x11w\xbc_\xc59-Vq\xc6\xcf\xe2\x8e\xe6\xbf\x03K\x9ce\x9a\x90}I\x9bOD\xcb-\x8d\xcf<\xcb\xef\x00PK\x07\x08)\x9e\xae\xd8\xb0\x00\x00\x00\x0f\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00sql/functions/post_board.sqlUT\x05\x00\x01*b2h<\x8eAN\xc50\x0cD\xd7\xf6)f\xd1\xc5\xff\x9b^ \xe2,(m\xfcS\xa3\xe0\x04\xc7\x15\x1c\x1f\xa9E\xec,\xbf\xd1\xcc\xdb]r\x08^\xa7\xed\xa1\xdd0\xfa\x8c\xf7\xadg/\x0f-\xd8\xb4\xaa\xc5\x93]\xe2t\x9b\x08\xf9	\xe4\x89e\xe1\"{\xcb.LW\xf8\"\x897\xa9jLS\x9a\xec\x81Xo\xa6\x16\x1d\xd7\xc9D/\xef\x9f\xd7\xca\xc4`\xa2\x8f\xae\x868\\r\x99\x08t\xc3#V-x\xc3X\xfbx2\xd1\xf7!.\x18\x7f\xcf\x7f\xbdUKb\xba\xc5\xee\xf2\xc4b%\xf1\xb2\xa0e\xabg\xae\x82\xd1F\x9d_-\xf1\xef\x00PK\x07\x08\xad\x9f\xd7\x04\x9f\x00\x00\x00\xe7\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00sql/functions/post_count.sqlUT\x05\x00\x01*b2hL\xcd1\xae\xc3 \x0c\xc6\xf1\xd9>\x85\x07\x86\x977\xe4\x02\xa8g\xa9\x88\xe3P$jS0\xea\xf5+5\x19:\xfe\xbf\xe1\xfbq\x97\xe4B\xc7T\xf6bJ\xcd\x86\xdf\xd9\xa6\xfa\x9f5\xdaJ.\xea\x0bv\xf1\xd9u\\MiP\x08\xb8\x0b\xd7\xd4\x05\x81\xaf=\xe2&\xb9(\xc2\x90*\xect\xde\xfc/T\xd4\x8d\x18\x01\x8en\xcf/1\x10\xe0\xfd\x90.g\xad\xd6\xe8\xf6c\xaf\xd6\"\xc2\xa9\x12G\x14\xdd#\x86@5i\x9e)\x0b\xb5\xda\xf2x\xd5\x88\x9f\x01\x00PK\x07\x08\xbaki\xf9\x88\x00\x00\x00\xc0\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x19\x00	\x00sql/functions/post_op.sqlUT\x05\x00\x01*b2h4\xcd1\x8e\x021\x0c\x85\xe1\xda>\x85\x8b\x14\xbb\x0d\x17\x888\x0b\xca$\x9e`)\xd8&\xf1\x88\xeb#\xc1P>=\xe9\xff\xea\xe4\x12L\xfb\xa15\xc4\x94\xdcV\xdc\xcc\xff\xa4\xd1&]4\xfeqr\x1cS\xd7\xb9\xa9,J	\x1b\xd7Q&#\x98\x9fG\xc6\x8d\xbb(\xc2\xe2\xc15\xc8\x9cD\xc3\xc8\x1c\x01\xf6i\x8fO{!\xc0\xeb\xce\x93I\x1a]\x7f\xdcEZF\xf8Bd\x9e\x91\xb5eL\x89F\xd1~\x94\xce\xe4\xc3\xfbz\x8e\x8c\xef\x01\x00PK\x07\x08Q\xa7\x96\xff\x83\x00\x00\x00\xb1\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00n\x83S]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00sql/functions/prune_thread.sqlUT\x05\x00\x01\xf0D\xd6j\x9cU\xc1n\xdc6\x10=\x93_\xf1\n\x18\xb0\x14K\xaa]\xa0=x\xe3\x9ezk\x80^z\x0b\x82\x05W\x1aIl\xb9\xa4JRq\xb6\xf0\xc7\x17CJ\xf2\xae\xdd6Fn\x129|\xf3\xf8\xe6q\xa6\xae\xf1\x0b\x19\x8a\x14\x10G\x823\x1d\x85\x08O\x93\xd1\x14\xe0z(\xb4\xa7\xd6\xe8\x16q\xf4\xa4\xba\n\xb3\x8d\xda@G\x8c*\xc0;wD\xef<\x94uq$/\xeb\x1a\x93\x0b\x11\xceW\xd0=\xf4Q\x0d\x04\x1d\x10(VP\xd6\xc5\x91<zm\xa8\xc1\xef#\xe5\xd8?\x89&\x8e\xb1\xf4\x99<&?[\xea\x1aY\xd7\xf8\xa0C$K>@y\x82uQ\xf7\x9a:f\xc5\\s`\x82\x08\x8dl=\xa9Hp>\x91W-\xa1\x9fm\x1b\xb5\xb39p\x9f\xf9\x17n\xc2A\x0f\xda\xc6j!wp\xce\xe0\x01\xbd2\x81*)\x12\x99\x1c\x81\x07\xdc\x96\xd2S\x9c\xbd\x0d\xf8\xect\x07\x15pu%;j\x8d\xf2$\x85\x0e\xfbE\x1dF\xd9IqT_\xf6	6,\x18;)\xe8KK\xe1\xec_w\xeb\xcf\xc7O;y\xa0A[)\x02\x19j#b\x93\xf1*\x1c\x9a\x84\xf3A\x1fu\x84\xb6\xd1aKV\xe19\x8d\x14\xa2\xf7\xee\xb8T' J!\xfep\xda\xe2\xe0\x94\xe7Dp\x96\xb1:< 6iQ\n\xf18\x92'\xc4\xbc|.O\xe3\xa6\x9d\x14\xbag\xb1\xd1:e(\xb4T\x9ceN2\x95\xac\xbf\x95Bdm\xf8\x8e\xb6\x83\xeew2\x9d\xbd\x00L\xb7X\xe3u\x7fF\x9d\xe5]7\x9e\x916(\xb1\nw\xff\x90+\xb5o\xddlc\xf1\x82n\x89\xfa\x1c\xf3\x06w\xbb\x9ch9\xfd\xfe\xff\xb3H!\xea\x1a\xbf]\xda\xfeQ\xc71\x994\xacv'\xeb\xe6aYKf\xec=\x11+\xb9\xd4My\xafN{5\x0cE\xcf\xa2:\xdf\x91\xc7\xe1\x04\xfe+\x97\xf2u\\\xac\\\xad\x82\xbf\xd6\xb3S\xa3\xbb*-\x880\x1f\x8b\xa9I\x99K8~\x0d\xc5\x06\xc5a|\xd9e\x9f\xad8yj\xa9\xd3v\xc8\xa7\xcf\xa0\xff\x0d\\\x88bj\xc2\xa8\xee\xd2[s\x11v6\xa6\xbc\xbfg\xa7\xdf,\x94\xcehe\xb5\xdf\x95\xdbz\xc6\xe7\xe7\xb6W1\xaav<\x92\x8d\x01\xea9 \xdbJ5)&{\xab\xd1\xdd\xba_2\xe5t7\xf9\x120`Z\xd72\xc6\xd4\xb8\xe9\xb55\xd7\x18\xa1l\x97\xf4\xc0w\xdf\x10\xc3/<#%F\x93\xbc\xc8\x9b\x08\xe2g\xdc\xca\x8d\xb2\xdc\xb6\xfbf\x93\x1c\xef\x17\x87\xb1\xfbM\xa0\x0b\xbf\xf2\x9d\xfe\xc3\xae\x0cV\xe30\x1f\xa7\xbd\xe1\xb7\xfd\xda\xcf\xdf`\xe1W.\xf4\x17.\xf4ot\xe1\x99\x8d.\xaa\xf2\x86\x9a|\xbd\"o\xa8\xc7\x85\xd7\xf3\xb1\xa4\xd1\xa2\xf4V\x11\x7f\xd6qX'\xee\xa8\xec\xe9\xd9\x98\xb5\xa1l\xfddm'Rti\xceasq.\xfdz\xb7dWeO\x85\xeeB\xc9\xe1u\x8d_y\x16\xe4\xb1\xd3*\x1e%\x01\x8fd\x0cf\xcb\xc2\xa6\x19\xa4N\xc6\xa9\x0eA\xffMH\\\xa5\xe0q\xa8\xa1-n\xd14(rk0d\x8782x\x85;~\xc6w%\xbe\xc7\x0f?\xfe\x04\xe3\x92i'\xf2\xbd\xf3GL\xc3>e<\x15\xd7\xa9\x06\xfb\xa4Uw\x9d:\xc4\x0b\xaf\xe0\xe9	\xd7\xd55\xef<=-=(\xba}\x88^\xdb\x81s}\xd4x\x97\x92\xdc\xe0\x0e\xf7(4\x7f\x94y\xedS\xc5g\xcbr\xd1\x92i\xec$\xd9n'\xaf\xae`\x94\x1df\x9e\x8e\x93\x99\x86\xf0\x97\xd9\xc9\x7f\x06\x00PK\x07\x08\xa3\x92e\xd5\\\x03\x00\x00)\x08\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00nzS]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00sql/functions/spoiler_images.sqlUT\x05\x00\x01\x005\xd6j\xc4T1\x8b\xdc<\x10\xad\xa5_1\x1c[\xd8\xe0[8\xf8\xaa3[|UH\x17\xb82\x043+\x8d\xed!ZI\x91\xe4\xcb\xed\xbf\x0f\x92\xb5>\xdf\x12\xd2\xa4\x88;\x8ff\x9e\xde\xbc7#\x15\x08\x13\x81\x0b\x10\xc8\x1bT\x04\xe3bUbg!z\xc7\x86\xc2\xc0\x17\x9c(6\xac\xe1\xcc\x13\xdb\xd4\x01*\xe5\x16\x9b \xd1[\xea\xe0|\x1d\xd8\xc3\xd99Ch[\x19(-\xc1Fxu\xac\x01#\x1c\x0eR\x932\x18H\x8a\x84a\xa24\x9c\x1d\x06]\xaa\xfb-\xb6\xc1\xefB\x1e\xd8R\x0e\xb0\x8e\xf5\xf4\xeb\xb7^\x9eib+\xc5\xe3#|\xa2\x04\xde\xc5\x04+\"Z\x0d\x9f\xbfH\x11\xc9\x90ZO\xd6\xbb\x1a\x7fd\xddv\xe0\x8f\x0539\xd83\xe9n\x7f\xec\xa5\x10cp\x97R\x1a!\xff\xfe\x9c)P.\xd4p\xba\x93\xe4\xc8\xba\x97\x85\xc7\xff1RH\xb0D\n\xa0p\x93\x0e\xd6<pv%(\x85\xa70\xbap\x01,\x05\x83B;\xd4PSE\xdd\xc8\x94\x8a\x0e\x9e\x9e\x9f\xe3\x05\x8da\x9b\xda|\x1b\x8fU\xf0\xdc\xec\xc6\x1b8\x82u	\xecb\x0c\xa4\x99\xac\x14E\xb4\xe7\x13`\x08xmn\x92\x1cYKQ\xbe\xbb>\x85\xd8\xf5\xea\xe1\xf4\x8e]\xf3E\xbe\xf0^\xd2\xf7\xbc\xda\xe0{j\xe3\x8fq\xc6\xa7=\xb3\xdb\xb1p\x01\xe8\x8d\xb3\xc4\x95\xd8v\xb2'6`J\xa8\xe6\x0b\xd9\x14\x01\xf7)\xab)x,t\x8a3\xb9\xb1\xb6m{)\xc8D\x92\"\xbb\xf2\x92\xd8\x18\xb0D\x1a\x92\x035\x93\xfa\x0e<fy*iZ\x1b\x82\x19#\xa0]\xdd\xca\xa9\xd5\xbe\xbf\xd3\xf0\xb7\xf3rk\xe2\x9f\nd5\xf0X'\xf7em\xb5\x88\xb2\xb2\x94bt\x81P\xcd7gY\x03\xdbU\x02\xc8z\x18\xe7r\xb3\x8b\xd7\xf9\xe5\xc8<b\xde\xf3\x1c\x13\x91\xd26\xfc'Ha!\xb9\xb1\xa9\x9al\xa8\xfdG\x90\x8fnW\xc3\xff\x84\xb7\xb7\xff\x03(\xdb\xbc\\\xeb\x9e_\x9c\x1e\x8c\x9b\xa0IWO\x1d\xd4\xa5\xaa\x85\x1d<\x9c\xaf\x0fm\x86|E\xb3P\x84\xe6\xbf\xfb\xfd\xdb\x90\xb7g\xaf\x0c\x99\xd5E\x87^\x92\xd5\xbd<\x1c\xc0\xa0\x9d\x96<?\xde\xf8)\xfe0\xbd\xfc5\x00PK\x07\x08x\xabd\xe0\"\x02\x00\x00[\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00!\x00	\x00sql/functions/use_image_token.sqlUT\x05\x00\x01*b2h\\P\xbdN\xf40\x10\xac\xbdO1E\xa4\xbb\x14\xf7\xe9CB\x08\xc9\xa2\xa3\xe1\x01\xa8O\x8b\xbdI,|\x9b\xe0\x1f\xe0\xf1Q\x9c\x808\x1ak5\xb33\xb3\xe3\xd3	\xcfY2\xea\x02V\x84\x0b\x8f\x02\x8eqv\\\xc2\xac(\xf3\xab(X=\x92\x94\x9a4\xa3L\xb2\xad\x1d2\x9e\x1e\xc9%\xe1\"\x18\xaa\xba&\xa8Y\xce\x8d>7\xe9\xb1\xbdp\x13\xa7\xe3\xfd]\xdf\xd3\xb7MCn\xff\xf7\xe0\x8c\xae#/.r\x122y\xe2\x9b\x1f\xd2\xd2\x8b\x8cA\xc9x\x89\xb2\xa6\xa4\xf9\x82_\xee\x99\x8c\xf9\x98$\xc9\x15\xf8\xafqx\xf8{\xcb\x86\x931\xdb\x0dA\xc7kY\x8b\x0eZf\xac\x93%\x13\x866!dh\x8dq\xad\xde\xe4\x1c\xb2@>\x9d,\xad\xf2!\xe8;\xc7\xe0\xf7\xdfk)\x07KF\xd4#\x0c\x96\xf6\xbc\xddU\xd4[\xea:D\xd6\xb1\xae\xfbK\\\xc6\xfc\x16-}\x0d\x00PK\x07\x08w\x97\xe0l\xe6\x00\x00\x00\x8b\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x86~S]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x15\x00	\x00sql/triggers/bans.sqlUT\x05\x00\x01\xac<\xd6j\x04\xc0\xc1Q\xc40\x0c\x05\xd03\xae\xe2\x1fr\x80\x0b\x0dd\xa9%\xa3u~\xbc\x9e\x11\xb2\x91d\xd2\xfe\xbe\xea\x94$\x86\xc39U*q-\xab\xd9\x87A\xae\xa4\x1fO\xb18\xba\x05=?\xbf\x8a3\x97[ \xbd\xb7F\x87\x04\xb6\xad<\xd9\xba\x15\x008\xa9L\xe2\xf2\xf1\x0b\x95\xc8#\x86\xfe\xf3<\xaa\xcc\xac/	\xdc/:\xd1'\x1e\x8f\x1f\x18\xef\xef>\xf7\xf2\xe1\xcc\xe5\x06[\xaa{\xa1\x9d{\xd96\xa8X[\xd2\x88\xa9\xb3\xc5\x9f\xee\xe5=\x00PK\x07\x08Y\xe0\xda\xea\x84\x00\x00\x00\xad\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00sql/triggers/boards.sqlUT\x05\x00\x01*b2h\xac\xcfAj\xc40\x0c\x05\xd0ut\x8a\xbf\x08L\x02\x99\xd2}N\xd0S\x04O\xac\x18\x83+\xbb\xb2\xdc\x92\xdb\x17\xe2\x0b\xb4\x90\xad\x90\xbe\xde\xdf\x95\x9d1\xb2B\xb9$\xb73\x8e&\xbb\xc5,p\x87\xb1n\xaf\xec\xd4\xd7-Je\xb5i&ek*\x15\xa61\x04V\xb8\x8aq\xa4\x17\x87(4\x14\xd6#\xeb'J\xd8$[<\xce\xe9q\xddo\xadxg\xec\x1f\x0b\x84\x7f\xde\xa2\x9fW\xa2\xe1\xf9\xc4\x87DC9\x1b\xbe]jLC\x7f\x83(\x96\xaf\xf1\x14\xfd\x82\xb2\xe7&6\xf7\x9d\x8a\xa9G,x\xbfR:\x08\xd2RZ\x89\xc5\xaf4\x8eHNBs\x81QR	\xf5+\xadD\x7fm\xda\xa9w6\xbd\x9d\xe89\xf1-\xc4\x9c\xfc\xff\x88\xbf\x03\x00PK\x07\x08\xae\x07\x84\xd3\xc0\x00\x00\x00/\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00sql/triggers/claude.sqlUT\x05\x00\x01*b2hT\x8f\xc1N+1\x0cE\xf7\xf9\x8a\xbb\x98E+=\xcd\x0f\x8c\xde\n\x95\x15\x08	\xd8\x8f2\x89'5J\x1d\xe3$\xf4\xf7\x11i\x85\x84w\x96\xcf\xb5\x8f\x83\x91o\x84b0\xd2\xec\x03a\xef\x12\x1a\x17\x81\xdf\x1b\xd9\x1a\xb2\xef\x91\xd6\xae\xd17:\x1c\x9dQ\xeb&\x15\xcd8%2\xf8\x8air\x91B\xf6F\x0e\x00\xbe\xd6\xa2\xd88\xb1\xb4\xc5m\x94X\x1c\x00\xbc\x9d\x9eN\x0f\xef\xd0\xb9(XZ\x19\xdc\x08<\xbe\xbe<\xe3vg\xf4\xbf\xc5\"d\xf8(,\xd0\xbee\x0e\xb3\x96\xda*\x14E\xee\x81\x99#\xfeC\xe7\xbb&\xc7\xb1\xe1z&\xa3?\x84\xd0u\xe6\xb8\xb81V\xb2\xbd\xd8\x05[\xbf\xe8\xda\xceF>\x1e~l\xfea\xf7\xb9\xd2q\x19\xd4\xedSH\xcfyq$qq\xd3\x84\xec%u\x9f\x08\x9a5\xd5\xcf\xbc|\x0f\x00PK\x07\x08'J\xc7d\xd7\x00\x00\x00A\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xcf\x80S]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00sql/triggers/mod_log.sqlUT\x05\x00\x01\x06@\xd6jL\x92Q\x8e\xdc,\x10\x84\x9f\xe1\x14\xf5\xafF\xb2W\xf2\xae\xfewk_r\x82\xdc\xc0\xc2\xd0f\xd0\xe2\x86@\x93\xd1\xdc>\xc2\x9eI\xe6\xadM\xab\xaa\xba>\xd9\x162BH\x05\x85r4\x96\xb05\xb6\x12\x12\xc3lBe\xd9\x93[b\xf2K\xe0JE\xc6w]HZ\xe1\n)\xc1{*0\x15\x97\x8bvd\xa3)\xa4U\xcaX\x83\x0f,\xb3^\xc9\x07\xd6*l`\xba}\xe6Te	\x0e\xff}\xe1\x7f\xc8\x95X+u\x9a\"\xb0$\x1c\xfb=9*\xe6\x88\x1f\x1f\x82	r\xcf4\xe1m\xbd\xbfM\x88\xc4^\xae\x13\x9c\x11\xf3\xae\x95R\xbfMlT1\xbeDL\xe8\x1f\xa7\xaaO\xa7\xb2OOu\x9f\x0f\x87Y+\xd5\xb2\xeb\x08z\\\xed\x8e\x95\x04\x8f;\xc8\xe1\x0bR\x1a\xf5\xf7\xdb\x95\n!\xf4\xa7\x97\xb0\xbe9\x91\x04\xf6\xa7\xc9g\xcag\xa5\x94\xbb\xff\xc7\x07~\xb4\xf8\xfd\xf4\xec\xdd8I\xd8\x02UlD\xae\"0V#\xf6J}\xacB\xa6\xbb\x86\x0d\xb6\x95B,K%\x91\xc0~\x1cv\xf2\xcd\x9a\xcf\xb5\xc5\xef\x17T\xc3t\xdcx\xe0\x08\x15.T	l\x05[I;\x86\xc4\xc3\x13\xb7\xcaT\xb6Tvd\xbf\x1c'\xdc\xc7\xe1\x15;\xb9a\xea&\xca&\xb6F\x96[\x1d\x87i\x98\x90\xf2\x89,\xb8\xf7\x83\x18\xb1C\xd8f}\x96\xfb\xd9\xc1am{\x86\\\x0b\x19W\x918\xde\x91\x18\xc7\xdf\xd5\x0b\x1bv\xb01\xd5VH\xff;\xa3k\x96S3\xf6\x8c\xa3\xc5\xac\xff\xfa?\xc0\x82[\x8c\xb3&v\xb3\xbe\\\x10\x0d\xfbf<!\xc7\xec\xeb\xaf8\xeb?\x03\x00PK\x07\x08\x9d\x08\xc5\x08\x92\x01\x00\x00\xc5\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00n\x83S]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00sql/triggers/posts.sqlUT\x05\x00\x01\xf0D\xd6j\x94\x94?o\xdb<\x10\xc6g\xf1S<\xafa@2\"\x0b\xc8\xbb\n\xc9\x92.\x1d\nth\xd1Q \xc5\x93L\x84&U\xf2T\xc5E>|AI\x8e\x9d\x14MQ/\x86\xee\x0f\x1f\xde\xef\x8e\xd7\x06\x92L\xf0\x01\x81\x06+[B7\xba\x96\x8dwP\xd4\xf9@\xcd\xe0#\xc7\xc6\xb8H\x81\x8b\x9d\x08\xc4cp\x11\x1cL\xdfS\x80\x8c\xd8n\x85\xa6\xd6\xca@\"c\xdfh\xb2\xc4\xd4\xa8\x13\x98\x9e\xb8\xbe\xb6\x05\x92\xd1\xbb\xd5\xae\xa87Nd\xfb=>\xc9GB\xf0\xfe\x88\xce\x07\xf0\x81\xe0hB\x12\x86qhO\xad5-\xf8\x10H\xea(\xb2\x81B\xe7\xc3\x11C\x18\x1d5\x8b\xb9p4U~\xd8\xd5\x17\xb7\x1a\x8f\xc3ko	\xe79\x1d]E\xd9S\x8a\xdd\xefqs[BQ+\xc7x\xad\x1a\xe7\xd0\xa5h\xd28\x11_\xe9\xf6\x8d\xf3l\xbaS\x91;\x9af>\x8dq\xabT^\x8a,K\x12~\xc0\xf33\xf22O\x7fsL\xebG\xc7\xe7\x8b\xe2\x06\xb7\xbbZ\xcc\xe5\x7f\x98\xe1\xccA%L\x87\x8f\x9f\xa1\xacl\x1f\xad\x89LZd\x91,\xb5\x0cU\xa9S	U\xad\x10\x8dc\x8f\x0b\xda\xe4{\x0bZdY\x17\xfc\x11J\xba\x08%\xb2$\xf6 ]\xceH\xd5&\xbdFy\x19t\xb1\xbbb\xf0[\xdd\xd9t\xa0@(\xe6P\xdc\xa1X\xef\xc3\xd5l\x11\xd9\xfc\x9b\x85\xd6\x1e\x81W\xe3\x92\xc9\x95Iyk\xe5\xc9\xe5\x03\xce\xa7\xe5\xd2\xda|6J\xa7\xa1*3\xe0\xfe~	6\xc3\xc5\xcc\xa7\x81p\x87<\x1e\xa4\xf6S~q\xd0\xd3`\x02E\xdc\xc3\xf9\xa9\xd8A2\xd8\x1c	?\xbd#\xe4_\xbf<\xe4\xb5\xc8L\xf7\x8a\xd4\xb9\xbfn\xb46\x8d[\x02\xb5\xdf\xe3\x9b\xb1\x16\x9d4\x16\x9e\x0f\x14&\x13\xe9\xc2\xe5\x91^\xd2\xa6`\x98\xc9!\xf1\x97\xca\xd2\x8a)\x12\xa3\xf5.r\x90\xc6q\\\xf8\x1e\xbd\xa6 \xd3{Z\x07E7]:JSG!\x90\xaeE\x96-\xb8\x97\x86\xbeIB\xb1f\x95H\x04Jl\xd4iSb\xa3%\xcb\xcdL\xed\x87\xb4#E\xccset\x89\xff\xcb\xbf\x0cE\x9a\xfbyBW\x15J\xbd\xe10R-2r\x1a\xa6Kc\xb9<\xf3\xd4\x86Z\x90\xd3\xb5\xd8na\xa5\xebG\xd9\x13\x06;\xf4\xf1\xbb\xad\x85xg}\xc8\x8e)\xac\xdbc\x1c\xb4d\xfa\xe3\xf6X7\x81\xe9\x92^E\xda\xb0q=\xfe\xbb\x83\xb7\xfa\xe5s\xed\xd3\xbf<\xefs9/\xd5\x8c\xd6\xbeW\xce\xaf\x01\x00PK\x07\x08\xc9\x80t\xc2i\x02\x00\x00\x0d\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00sql/triggers/threads.sqlUT\x05\x00\x01*b2h\xb4\x91Aj\xf30\x10\x85\xd7\xd6)\xe6\x07\x83\x13H\xfe\x0b\x98\xde\xa170\xb2\xf5\xa4\x08\xe4\x91:\x1a\xb5\x14r\xf8\xe2:]t\xe5v\xd1\x95\xd0CO|\xdf\xcc\"\xb0\n\xcaB\x82\x92\xec\x02\xf2\x8d\x17\x8d\x99\xc9z\x85Lz\x13XW\xa7\xc8\x15\xa2\xa7\xb3\x11h\x13\xae\xa4\x12C\x80\x90\xad\xd4\xf7fF\x88l\xba\x02\xf1YV\x9a\xdbZ\x1e\xd5\x13\xe3\xed\x7ft\xe7\xd1t{\x95\xb8\xa54\x1a\xb0\x1bM\xdfS\xb2\x1c\x9a\x0d\xa0\x92J\xa8/i4\xe6\xc7P\xad8\xab8\x82\xba^\xe9Y\xf0\nV\x8a\xec#G\x05	\x96&u\xd3\xccL\x1aWT\xb5k\xa1\xfd\xc3j\xba\xe8i\xe3\xde\xef\xd3\xf6\x80\xfe=QN\xee[\xa47\xb0\xe9\x0e\xac\xc1\x8e\xa2\xff\x0b}\x87\x84c\xfd/\xba\x12&\xce\x1a\xfd\xfbi\xd8\xe7\xf7\xe8\xbb\xe1\xf2)6g+\x8e\xeew\x1a.\xc3vl\xd9\xaf\xf6\xf61\x00PK\x07\x08\x0eg\xacZ\xea\x00\x00\x00L\x02\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9Z]12\x95\x96^\x00\x00\x8f^\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x00\x00\x00\x00assets/loading.gifUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x001\x8dS]G\\\x81M\xed\x05\x00\x00@\x0f\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xdf^\x00\x00lang/en_GB/common.jsonUT\x05\x00\x01NV\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xc4\x8aS]6\"s\xf0\xd6\x17\x00\x00fD\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x19e\x00\x00lang/en_GB/server.jsonUT\x05\x00\x01\xc1Q\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x001\x8dS]LAB~$\x06\x00\x00^\x0f\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81<}\x00\x00lang/es_ES/common.jsonUT\x05\x00\x01NV\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x97\xd3;\x8f\xdb\x12\x00\x00\xbf4\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xad\x83\x00\x00lang/es_ES/server.jsonUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x001\x8dS]=)\xd4\x0d\xbb\x06\x00\x00\xe4\x0f\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xd5\x96\x00\x00lang/fr_FR/common.jsonUT\x05\x00\x01NV\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9ZV_2\xc0\x91\x12\x00\x00D5\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xdd\x9d\x00\x00lang/fr_FR/server.jsonUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x001\x8dS]\xfeL{\xac\xb5\x06\x00\x00\xf3\x0f\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xbb\xb0\x00\x00lang/nl_NL/common.jsonUT\x05\x00\x01NV\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x91\xf4o\xe56\x14\x00\x00\xb67\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xbd\xb7\x00\x00lang/nl_NL/server.jsonUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x001\x8dS]\x84sE\xe2\xe1\x06\x00\x00\x1d\x10\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81@\xcc\x00\x00lang/pl_PL/common.jsonUT\x05\x00\x01NV\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x0b{\x92T\x82\x13\x00\x00\xf23\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81n\xd3\x00\x00lang/pl_PL/server.jsonUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x001\x8dS]8M\x868.\x06\x00\x00n\x0f\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81=\xe7\x00\x00lang/pt_BR/common.jsonUT\x05\x00\x01NV\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9Z8,\x08\xa1\xf6\x12\x00\x00@4\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xb8\xed\x00\x00lang/pt_BR/server.jsonUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x001\x8dS]\x06^\xcd\x00\xf3\x07\x00\x00L\x12\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xfb\x00\x01\x00lang/ru_RU/common.jsonUT\x05\x00\x01NV\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9Z\xe3k\xc5-%\x17\x00\x00YD\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81;	\x01\x00lang/ru_RU/server.jsonUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x001\x8dS]\xa8\xab\xe7m\xec\x06\x00\x00\xee\x0f\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xad \x01\x00lang/sk_SK/common.jsonUT\x05\x00\x01OV\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x12\xca\xb2\xa0\xa5\x14\x00\x00\xee4\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xe6'\x01\x00lang/sk_SK/server.jsonUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x001\x8dS]\x1a\xb0\x8d\x8eg\x06\x00\x00i\x0f\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xd8<\x01\x00lang/tr_TR/common.jsonUT\x05\x00\x01OV\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9Z\xf5\x0cE\x94w\x12\x00\x00\xe42\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x8cC\x01\x00lang/tr_TR/server.jsonUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x001\x8dS]\x9cX\xe9T\xa3\x07\x00\x00\xa3\x11\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81PV\x01\x00lang/uk_UA/common.jsonUT\x05\x00\x01OV\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9Z~\xcd\xb4,\x84\x16\x00\x00J>\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81@^\x01\x00lang/uk_UA/server.jsonUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x001\x8dS]\xf4zy\xc9\x85\x07\x00\x00\x00\x10\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x11u\x01\x00lang/zh_TW/common.jsonUT\x05\x00\x01OV\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9Z\xc7\xcc\xae\xb0R\x15\x00\x00\xf81\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xe3|\x01\x00lang/zh_TW/server.jsonUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9ZA\xbd\xd5h\x02\x01\x00\x00\xd2\x01\x00\x00$\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x82\x92\x01\x00sql/functions/assert_can_perform.sqlUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x89S]\xc5\xf1\x04l\xf1\x00\x00\x00\x97\x01\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xdf\x93\x01\x00sql/functions/board_files.sqlUT\x05\x00\x012P\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00n\x83S]T\xaa\x0b\x7f\xd4\x00\x00\x00>\x01\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81$\x95\x01\x00sql/functions/bump_limit.sqlUT\x05\x00\x01\xf0D\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00n\x83S]\xa3\x18\x8d\x04\xe4\x00\x00\x00\xa5\x01\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81K\x96\x01\x00sql/functions/bump_thread.sqlUT\x05\x00\x01\xf0D\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00nzS]a\xd4H\xde0\x02\x00\x00g\x05\x00\x00\x1f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x83\x97\x01\x00sql/functions/delete_images.sqlUT\x05\x00\x01\x005\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x99\n\xf8;\xdc\x01\x00\x00\x0f\x04\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81	\x9a\x01\x00sql/functions/delete_posts.sqlUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00n\x83S]^\xa6m\x94\xda\x00\x00\x00r\x01\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81:\x9c\x01\x00sql/functions/image_count.sqlUT\x05\x00\x01\xf0D\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00y\x8cS]`$\xb9l\xcd\x04\x00\x00X\x0c\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81h\x9d\x01\x00sql/functions/insert_image.sqlUT\x05\x00\x01\xf6T\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9Z)\x9e\xae\xd8\xb0\x00\x00\x00\x0f\x01\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x8a\xa2\x01\x00sql/functions/is_deleted.sqlUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9Z\xad\x9f\xd7\x04\x9f\x00\x00\x00\xe7\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x8d\xa3\x01\x00sql/functions/post_board.sqlUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9Z\xbaki\xf9\x88\x00\x00\x00\xc0\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x7f\xa4\x01\x00sql/functions/post_count.sqlUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9ZQ\xa7\x96\xff\x83\x00\x00\x00\xb1\x00\x00\x00\x19\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81Z\xa5\x01\x00sql/functions/post_op.sqlUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00n\x83S]\xa3\x92e\xd5\\\x03\x00\x00)\x08\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81-\xa6\x01\x00sql/functions/prune_thread.sqlUT\x05\x00\x01\xf0D\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00nzS]x\xabd\xe0\"\x02\x00\x00[\x05\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xde\xa9\x01\x00sql/functions/spoiler_images.sqlUT\x05\x00\x01\x005\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9Zw\x97\xe0l\xe6\x00\x00\x00\x8b\x01\x00\x00!\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81W\xac\x01\x00sql/functions/use_image_token.sqlUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x86~S]Y\xe0\xda\xea\x84\x00\x00\x00\xad\x00\x00\x00\x15\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x95\xad\x01\x00sql/triggers/bans.sqlUT\x05\x00\x01\xac<\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9Z\xae\x07\x84\xd3\xc0\x00\x00\x00/\x02\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81e\xae\x01\x00sql/triggers/boards.sqlUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9Z'J\xc7d\xd7\x00\x00\x00A\x01\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81s\xaf\x01\x00sql/triggers/claude.sqlUT\x05\x00\x01*b2hPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xcf\x80S]\x9d\x08\xc5\x08\x92\x01\x00\x00\xc5\x02\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x98\xb0\x01\x00sql/triggers/mod_log.sqlUT\x05\x00\x01\x06@\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00n\x83S]\xc9\x80t\xc2i\x02\x00\x00\x0d\x05\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81y\xb2\x01\x00sql/triggers/posts.sqlUT\x05\x00\x01\xf0D\xd6jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{\x02\xb9Z\x0eg\xacZ\xea\x00\x00\x00L\x02\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81/\xb5\x01\x00sql/triggers/threads.sqlUT\x05\x00\x01*b2hPK\x05\x06\x00\x00\x00\x00,\x00,\x00\xb1\x0d\x00\x00h\xb6\x01\x00\x00\x00"
+		fs.Register(data)
+	}
+	
\ No newline at end of file
@@ -3,12 +3,14 @@ package templates
 import (
 	"fmt"
 	"html"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/bakape/meguca/auth"
 	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/imager/assets"
 	"github.com/bakape/meguca/lang"
 	"github.com/valyala/quicktemplate"
 )
@@ -18,11 +20,11 @@ var GetPostModLog func(id uint64) (mLog []auth.ModLogEntry, err error)
 
 // Extra data passed, when rendering an article
 type articleContext struct {
-	index, sticky, locked, rbText, pyu bool
-	omit, imageOmit                    int
-	op                                 uint64
-	board, subject, root               string
-	backlinks                          backlinks
+	index, sticky, locked, rbText, pyu, renditions bool
+	omit, imageOmit                                int
+	op                                             uint64
+	board, subject, root                           string
+	backlinks                                      backlinks
 }
 
 // Map of all backlinks on a page
@@ -38,6 +40,35 @@ func imageName(fileType uint8, name string) string {
 	return html.EscapeString(string(append(buf, ext...)))
 }
 
+// Returns the path to the served source file of an image. Transcoded
+// renditions are preferred, if enabled.
+func imageSource(img common.Image, renditions bool) string {
+	if renditions && img.Rendition != 0 {
+		return assets.RenditionPath(img.Rendition, img.SHA1)
+	}
+	return assets.SourcePath(img.FileType, img.SHA1)
+}
+
+// Returns the srcset attribute value listing the standard and any additional
+// thumbnails of an image of type thumbType
+func thumbSrcset(img common.ImageCommon, thumbType uint8) string {
+	sizes := make([]int, 0, len(img.Thumbs))
+	for s := range img.Thumbs {
+		sizes = append(sizes, int(s))
+	}
+	sort.Ints(sizes)
+
+	var w strings.Builder
+	fmt.Fprintf(&w, "%s %dw", assets.ThumbPath(thumbType, img.SHA1),
+		img.Dims[2])
+	for _, s := range sizes {
+		fmt.Fprintf(&w, ", %s %dw",
+			assets.ThumbVariantPath(thumbType, img.SHA1, uint16(s)),
+			img.Thumbs[uint16(s)][0])
+	}
+	return w.String()
+}
+
 // Renders the post creation time field
 func formatTime(sec int64) string {
 	ln := lang.Get().Common.Time
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/bakape/meguca/assets"
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/util"
@@ -410,6 +411,14 @@ func (c *bodyContext) parseFragment(frag string) {
 
 		// Strip leading and trailing punctuation and commit separately
 		leadPunct, word, trailPunct = util.SplitPunctuationString(word)
+
+		// Custom board emoji
+		if leadPunct == ':' && trailPunct == ':' &&
+			assets.Emoji.Has(c.board, word) {
+			c.parseEmoji(word)
+			continue
+		}
+
 		if leadPunct != 0 {
 			c.byte(leadPunct)
 		}
@@ -427,8 +436,8 @@ func (c *bodyContext) parseFragment(frag string) {
 			if c.state.quote {
 				goto end
 			}
-			if m := common.CommandRegexp.FindStringSubmatch(word); m != nil {
-				c.parseCommands(string(m[1]))
+			if h, bit := common.MatchCommand(word); h != nil {
+				c.parseCommand(h, bit)
 				goto end
 			}
 		case '>': // Links
@@ -476,6 +485,19 @@ func (c *bodyContext) parseFragment(frag string) {
 	}
 }
 
+// Render a custom board emoji
+func (c *bodyContext) parseEmoji(name string) {
+	c.string(`<img class="emoji" src="/assets/emoji/`)
+	c.string(c.board)
+	c.byte('/')
+	c.string(name)
+	c.string(`" alt=":`)
+	c.string(name)
+	c.string(`:" title=":`)
+	c.string(name)
+	c.string(`:" loading="lazy">`)
+}
+
 // Parse a potential link to a post
 func (c *bodyContext) parsePostLink(m []string) {
 	if c.Links == nil {
@@ -604,170 +626,39 @@ func (c *bodyContext) parseEmbeds(s string) bool {
 }
 
 // Parse a hash command
-func (c *bodyContext) parseCommands(bit string) {
+func (c *bodyContext) parseCommand(h common.CommandHandler, bit string) {
 	// Guard against invalid dice rolls
 	if c.Commands == nil || c.state.iDice > len(c.Commands)-1 {
 		c.writeInvalidCommand(bit)
 		return
 	}
 
-	formatting := "<strong>"
-	inner := make([]byte, 0, 32)
 	val := c.Commands[c.state.iDice]
-
-	// Protect from index shifts on boardConfig.pyu toggle
-	if !c.state.pyu {
-		switch val.Type {
-		case common.Pyu, common.Pcount:
-			c.state.iDice++
-			c.writeInvalidCommand(bit)
-			return
-		}
+	if val.Type != h.Type() {
+		c.writeInvalidCommand(bit)
+		return
 	}
 
-	switch bit {
-	case "flip":
-		var s string
-		if val.Flip {
-			s = "flap"
-		} else {
-			s = "flop"
-		}
-		inner = append(inner, s...)
-		c.state.iDice++
-	case "8ball":
-		inner = append(inner, html.EscapeString(val.Eightball)...)
+	// Protect from index shifts on boardConfig.pyu and disabled command
+	// toggles
+	disabled := !config.GetBoardConfigs(c.board).CommandEnabled(h.Name())
+	switch val.Type {
+	case common.Pyu, common.Pcount:
+		disabled = disabled || !c.state.pyu
+	}
+	if disabled {
 		c.state.iDice++
-	case "pyu", "pcount":
-		switch val.Type {
-		case common.Pyu, common.Pcount:
-			// Protect from index shifts on boardConfig.pyu toggle
-			if !c.state.pyu {
-				c.writeInvalidCommand(bit)
-				return
-			}
-			inner = strconv.AppendUint(inner, val.Pyu, 10)
-			c.state.iDice++
-			break
-		default:
-			c.writeInvalidCommand(bit)
-		}
-	case "autobahn":
-		c.string("<strong class=\"dead\">#")
-		c.string(bit)
-		c.string(`</strong>`)
+		c.writeInvalidCommand(bit)
 		return
-	default:
-		if strings.HasPrefix(bit, "sw") {
-			c.formatSyncwatch(val.SyncWatch)
-			c.state.iDice++
-			return
-		}
-
-		// Validate dice
-		m := common.DiceRegexp.FindStringSubmatch(bit)
-		rolls := 1
-		if m[1] != "" {
-			var err error
-			if rolls, err = strconv.Atoi(m[1]); err != nil || rolls > 10 {
-				c.writeInvalidCommand(bit)
-				return
-			}
-		}
-		sides, err := strconv.Atoi(m[2])
-		if err != nil || sides > common.MaxDiceSides {
-			c.writeInvalidCommand(bit)
-			return
-		}
-
-		c.state.iDice++
-		var sum uint64
-		for i, roll := range val.Dice {
-			if i != 0 {
-				inner = append(inner, " + "...)
-			}
-			sum += uint64(roll)
-			inner = strconv.AppendUint(inner, uint64(roll), 10)
-		}
-		if len(val.Dice) > 1 {
-			inner = append(inner, " = "...)
-			inner = strconv.AppendUint(inner, sum, 10)
-		}
-
-		formatting = getRollFormatting(uint64(rolls), uint64(sides), sum)
 	}
 
-	c.string(formatting)
-	c.string(`#`)
-	c.string(bit)
-	c.string(` (`)
-	c.N().Z(inner)
-	c.string(`)</strong>`)
-}
-
-func getRollFormatting(numberOfDice uint64, facesPerDie uint64, sum uint64) string {
-	maxRoll := numberOfDice * facesPerDie
-	// no special formatting for small rolls
-	if maxRoll < 10 || facesPerDie == 1 {
-		return "<strong>"
-	}
-
-	if maxRoll == sum {
-		return "<strong class=\"super_roll\">"
-	} else if sum == numberOfDice {
-		return "<strong class=\"kuso_roll\">"
-	} else if sum == 69 || sum == 6969 {
-		return "<strong class=\"lewd_roll\">"
-	} else if checkEm(sum) {
-		if sum < 100 {
-			return "<strong class=\"dubs_roll\">"
-		} else if sum < 1000 {
-			return "<strong class=\"trips_roll\">"
-		} else if sum < 10000 {
-			return "<strong class=\"quads_roll\">"
-		} else { // QUINTS!!!
-			return "<strong class=\"rainbow_roll\">"
-		}
-	}
-	return "<strong>"
-}
-
-// If num is made of the same digit repeating
-func checkEm(num uint64) bool {
-	if num < 10 {
-		return false
-	}
-	digit := num % 10
-	for {
-		num /= 10
-		if num == 0 {
-			return true
-		}
-		if num%10 != digit {
-			return false
-		}
+	if h.RenderHTML(c.W(), bit, val) {
+		c.state.iDice++
+	} else {
+		c.writeInvalidCommand(bit)
 	}
 }
 
-// Format a synchronized time counter
-func (c *bodyContext) formatSyncwatch(val [5]uint64) {
-	c.string(`<em><strong class="embed syncwatch" data-hour=`)
-	c.uint64(val[0])
-	c.string(` data-min=`)
-	c.uint64(val[1])
-	c.string(` data-sec=`)
-	c.uint64(val[2])
-	c.string(` data-start=`)
-	c.uint64(val[3])
-	c.string(` data-end=`)
-	c.uint64(val[4])
-	c.string(`>syncwatch</strong></em>`)
-}
-
-func (c *bodyContext) uint64(i uint64) {
-	c.string(strconv.FormatUint(i, 10))
-}
-
 // If command validation failed, simply write the string
 func (c *bodyContext) writeInvalidCommand(s string) {
 	c.byte('#')
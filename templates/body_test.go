@@ -3,6 +3,7 @@ package templates
 import (
 	"testing"
 
+	"github.com/bakape/meguca/assets"
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
 	. "github.com/bakape/meguca/test"
@@ -21,6 +22,12 @@ func TestRenderBody(t *testing.T) {
 	config.SetBoardConfigs(config.BoardConfigs{
 		ID: "a",
 	})
+	assets.Emoji.Set("a", []assets.File{
+		{
+			Name: "kek",
+			Mime: "image/png",
+		},
+	})
 
 	cases := [...]struct {
 		name, in, out string
@@ -337,6 +344,16 @@ func TestRenderBody(t *testing.T) {
 			in:   "https://www.bitchute.com/video/z0f4Wgi94eo",
 			out:  "<em><a rel=\"noreferrer\" class=\"embed\" target=\"_blank\" data-type=\"4\" href=\"https://www.bitchute.com/video/z0f4Wgi94eo\">[BitChute] ???</a></em>",
 		},
+		{
+			name: "custom emoji",
+			in:   "foo :kek: bar",
+			out:  "foo <img class=\"emoji\" src=\"/assets/emoji/a/kek\" alt=\":kek:\" title=\":kek:\" loading=\"lazy\"> bar",
+		},
+		{
+			name: "unknown custom emoji",
+			in:   ":lel:",
+			out:  ":lel:",
+		},
 	}
 
 	for i := range cases {
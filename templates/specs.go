@@ -123,6 +123,43 @@ var specs = map[string][]inputSpec{
 		{ID: "flags"},
 		{ID: "NSFW"},
 		{ID: "rbText"},
+		{ID: "serveRenditions"},
+		{ID: "stripMetadata"},
+		{
+			ID:       "maxAttachments",
+			Type:     _number,
+			Min:      1,
+			Max:      common.MaxNumAttachments,
+			Required: true,
+		},
+		{
+			ID:   "slowMode",
+			Type: _number,
+			Min:  0,
+			Max:  common.MaxSlowMode,
+		},
+		{
+			ID:       "bumpLimit",
+			Type:     _number,
+			Min:      1,
+			Max:      common.MaxBumpLimit,
+			Required: true,
+		},
+		{
+			ID:   "imageLimit",
+			Type: _number,
+			Min:  0,
+			Max:  common.MaxBumpLimit,
+		},
+		{ID: "preModThreads"},
+		{ID: "preModReplies"},
+		{ID: "preModImages"},
+		{
+			ID:   "newPosterPosts",
+			Type: _number,
+			Min:  0,
+			Max:  common.MaxNewPosterPosts,
+		},
 		{Type: _hr},
 		{ID: "pyu"},
 		{
@@ -148,6 +185,15 @@ var specs = map[string][]inputSpec{
 			Type:      _array,
 			MaxLength: common.MaxLenEightball,
 		},
+		{
+			ID:   "disabledCommands",
+			Type: _array,
+		},
+		{
+			ID:        "reactions",
+			Type:      _array,
+			MaxLength: common.MaxLenReaction,
+		},
 	},
 	"createBoard": {
 		{
@@ -237,6 +283,25 @@ var specs = map[string][]inputSpec{
 			Min:      1,
 			Required: true,
 		},
+		{
+			ID:       "modLogExpiry",
+			Type:     _number,
+			Min:      0,
+			Required: true,
+		},
+		{
+			ID:       "reactionScore",
+			Type:     _number,
+			Min:      0,
+			Required: true,
+		},
+		{
+			ID:       "pollDuration",
+			Type:     _number,
+			Min:      1,
+			Max:      common.MaxPollDuration,
+			Required: true,
+		},
 		{Type: _hr},
 		{ID: "emailErr"},
 		{
@@ -277,6 +342,11 @@ var specs = map[string][]inputSpec{
 		defaultThemeSpec,
 		{Type: _hr},
 		{ID: "JPEGThumbnails"},
+		{ID: "stripMetadata"},
+		{
+			ID:   "fetchDomains",
+			Type: _array,
+		},
 		{
 			ID:       "maxWidth",
 			Type:     _number,
@@ -3,6 +3,7 @@ package templates
 import (
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/lang"
+	_ "github.com/bakape/meguca/parser" // Registers hash command handlers
 	"github.com/bakape/meguca/util"
 	"testing"
 )
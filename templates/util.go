@@ -2,12 +2,47 @@ package templates
 
 import (
 	"fmt"
+	"github.com/bakape/meguca/auth"
 	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/lang"
 	"html"
+	"net/url"
 	"strconv"
 	"time"
 )
 
+// DateFormat is the format of dates submitted by HTML date inputs
+const DateFormat = "2006-01-02"
+
+// Moderation log entry types and their language keys in display order
+var modLogTypes = [...]struct {
+	typ common.ModerationAction
+	id  string
+}{
+	{common.BanPost, "ban"},
+	{common.ShadowBinPost, "shadowBin"},
+	{common.UnbanPost, "unban"},
+	{common.DeletePost, "deletePost"},
+	{common.DeleteImage, "deleteImage"},
+	{common.SpoilerImage, "spoilerImage"},
+	{common.LockThread, "lockThread"},
+	{common.DeleteBoard, "deleteBoard"},
+	{common.MeidoVision, "meidoVisionPost"},
+	{common.PurgePost, "purgePost"},
+	{common.RedirectIP, "redirectIP"},
+	{common.RedirectThread, "redirectThread"},
+	{common.AcceptBanAppeal, "acceptAppeal"},
+	{common.RejectBanAppeal, "rejectAppeal"},
+	{common.AddModNote, "addModNote"},
+	{common.MoveThread, "moveThread"},
+	{common.MergeThread, "mergeThread"},
+	{common.SplitThread, "splitThread"},
+	{common.SetSlowMode, "setSlowMode"},
+	{common.SetCyclic, "setCyclic"},
+	{common.ApprovePost, "approvePost"},
+	{common.RejectPost, "rejectPost"},
+}
+
 // CalculateOmit returns the omitted post and image counts for a thread
 func CalculateOmit(t common.Thread) (int, int) {
 	// There might still be posts missing due to deletions even in complete
@@ -132,3 +167,50 @@ func pluralizeTime(num int64, singular, plural string) string {
 	}
 	return fmt.Sprintf("%d %s", num, plural)
 }
+
+// ModerationActionLabel returns the localized name of a moderation log entry
+// type
+func ModerationActionLabel(t common.ModerationAction) string {
+	ln := lang.Get()
+	for _, m := range modLogTypes {
+		if m.typ != t {
+			continue
+		}
+		if s := ln.UI[m.id]; s != "" {
+			return s
+		}
+		return ln.Common.UI[m.id]
+	}
+	return ""
+}
+
+// Encode a moderation log filter into query parameters
+func modLogQuery(f auth.ModLogFilter, format string) string {
+	q := make(url.Values)
+	for _, t := range f.Types {
+		q.Add("type", strconv.Itoa(int(t)))
+	}
+	if f.By != "" {
+		q.Set("by", f.By)
+	}
+	if f.Post != 0 {
+		q.Set("post", strconv.FormatUint(f.Post, 10))
+	}
+	if f.IPHash != "" {
+		q.Set("ip", f.IPHash)
+	}
+	if !f.From.IsZero() {
+		q.Set("from", f.From.Format(DateFormat))
+	}
+	if !f.To.IsZero() {
+		// The upper bound is exclusive
+		q.Set("to", f.To.AddDate(0, 0, -1).Format(DateFormat))
+	}
+	if f.Page != 0 {
+		q.Set("page", strconv.FormatUint(uint64(f.Page), 10))
+	}
+	if format != "" {
+		q.Set("format", format)
+	}
+	return q.Encode()
+}
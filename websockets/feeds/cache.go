@@ -35,11 +35,13 @@ func newThreadCache(id uint64) (c threadCache, err error) {
 	for _, p := range thread.Posts {
 		if p.Time > threshold {
 			c.Recent[p.ID] = cachedPost{
-				HasImage:  p.Image != nil,
-				Spoilered: p.Image != nil && p.Image.Spoiler,
-				Closed:    !p.Editing,
-				Time:      p.Time,
-				Body:      p.Body,
+				HasImage:    p.Image != nil,
+				Spoilered:   p.Image != nil && p.Image.Spoiler,
+				Attachments: len(p.Attachments),
+				Closed:      !p.Editing,
+				Time:        p.Time,
+				Body:        p.Body,
+				Reactions:   p.Reactions,
 			}
 		}
 		if p.Moderated {
@@ -68,22 +70,24 @@ type syncMessage struct {
 	Moderation map[uint64][]common.ModerationEntry `json:"moderation"`
 }
 
-type PendingTikToks uint8
+type FetchState uint8
 
 const (
-	None PendingTikToks = iota
+	None FetchState = iota
 	Loading
 	Done
 	Error
 )
 
 type cachedPost struct {
-	HasImage       bool           `json:"has_image"`
-	Spoilered      bool           `json:"spoilered"`
-	Closed         bool           `json:"closed"`
-	Time           int64          `json:"-"`
-	Body           string         `json:"body"`
-	PendingTikToks PendingTikToks `json:"pending_tiktoks"`
+	HasImage    bool              `json:"has_image"`
+	Spoilered   bool              `json:"spoilered"`
+	Attachments int               `json:"attachments,omitempty"`
+	Closed      bool              `json:"closed"`
+	Time        int64             `json:"-"`
+	Body        string            `json:"body"`
+	FetchState  FetchState        `json:"fetch_state"`
+	Reactions   map[string]uint64 `json:"reactions,omitempty"`
 }
 
 // Generate a message for synchronizing to the current status of the update
@@ -1,8 +1,10 @@
 package feeds
 
 import (
-	"github.com/bakape/meguca/common"
+	"net"
 	"sync"
+
+	"github.com/bakape/meguca/common"
 )
 
 var (
@@ -108,14 +110,25 @@ func GetSync(cl common.Client) (synced bool, op uint64, board string) {
 	return
 }
 
-// GetByIPAndBoard retrieves all Clients that match the passed IP on a board
+// GetByIPAndBoard retrieves all Clients that match the passed IP or are
+// contained in the passed network in CIDR notation on a board
 func GetByIPAndBoard(ip, board string) []common.Client {
+	match := func(s string) bool {
+		return s == ip
+	}
+	if _, n, err := net.ParseCIDR(ip); err == nil {
+		match = func(s string) bool {
+			parsed := net.ParseIP(s)
+			return parsed != nil && n.Contains(parsed)
+		}
+	}
+
 	clients.RLock()
 	defer clients.RUnlock()
 
 	cls := make([]common.Client, 0, 16)
 	for cl, sync := range clients.clients {
-		if cl.IP() == ip && (board == "all" || sync.board == board) {
+		if match(cl.IP()) && (board == "all" || sync.board == board) {
 			cls = append(cls, cl)
 		}
 	}
@@ -35,14 +35,25 @@ type moderationMessage struct {
 	entry common.ModerationEntry
 }
 
+type pruneMessage struct {
+	ids []uint64
+	msg []byte
+}
+
+type reactionMessage struct {
+	message
+	reaction string
+	count    uint64
+}
+
 type syncCount struct {
 	Active int `json:"active"`
 	Total  int `json:"total"`
 }
 
-type pendingTiktokState struct {
+type fetchStateUpdate struct {
 	message message
-	state   PendingTikToks
+	state   FetchState
 }
 
 // Feed is a feed with synchronization logic of a certain thread
@@ -71,12 +82,16 @@ type Feed struct {
 	setOpenBody chan postBodyModMessage
 	// Send message about post moderation
 	moderatePost chan moderationMessage
+	// Set the count of a reaction to a post
+	reactToPost chan reactionMessage
+	// Remove posts pruned from a cyclic thread
+	prunePosts chan pruneMessage
 	// Let sent sync counter
 	lastSyncCount syncCount
 	// Let sent sync counter
 	binaryMessages chan []byte
-	// Tiktok state
-	updatePendingTiktokState chan pendingTiktokState
+	// URL fetching states of posts
+	updateFetchState chan fetchStateUpdate
 }
 
 // Start read existing posts into cache and start main loop
@@ -138,11 +153,12 @@ func (f *Feed) Start() (err error) {
 				src := msg.post
 				f.modifyPostImmediate(msg.message, func(p *cachedPost) {
 					*p = cachedPost{
-						HasImage:  src.Image != nil,
-						Spoilered: src.Image != nil && src.Image.Spoiler,
-						Time:      src.Time,
-						Closed:    !src.Editing,
-						Body:      src.Body,
+						HasImage:    src.Image != nil,
+						Spoilered:   src.Image != nil && src.Image.Spoiler,
+						Attachments: len(src.Attachments),
+						Time:        src.Time,
+						Closed:      !src.Editing,
+						Body:        src.Body,
 					}
 				})
 				// Post can be automatically deleted on insertion
@@ -157,8 +173,12 @@ func (f *Feed) Start() (err error) {
 
 			case msg := <-f.insertImage:
 				f.modifyPostImmediate(msg.message, func(p *cachedPost) {
-					p.HasImage = true
-					p.Spoilered = msg.spoilered
+					if p.HasImage {
+						p.Attachments++
+					} else {
+						p.HasImage = true
+						p.Spoilered = msg.spoilered
+					}
 				})
 
 			case msg := <-f.spoilerImage:
@@ -170,13 +190,27 @@ func (f *Feed) Start() (err error) {
 				f.modifyPostImmediate(msg, func(p *cachedPost) {
 					p.Closed = true
 				})
-			case msg := <-f.updatePendingTiktokState:
+			case msg := <-f.updateFetchState:
 				f.modifyPost(msg.message, func(p *cachedPost) {
-					p.PendingTikToks = msg.state
+					p.FetchState = msg.state
 				})
 			case msg := <-f.binaryMessages:
 				f.sendToAllBinary(msg)
 
+			case msg := <-f.reactToPost:
+				// Posts not in the cache are read from the database by newly
+				// synced clients and only need the message propagated
+				if _, ok := f.cache.Recent[msg.id]; !ok {
+					f.bufferMessage(msg.msg)
+					break
+				}
+				f.modifyPost(msg.message, func(p *cachedPost) {
+					if p.Reactions == nil {
+						p.Reactions = make(map[string]uint64, 4)
+					}
+					p.Reactions[msg.reaction] = msg.count
+				})
+
 			// Posts being moderated
 			case msg := <-f.moderatePost:
 				f.modifyPost(msg.message, func(p *cachedPost) {
@@ -187,12 +221,22 @@ func (f *Feed) Start() (err error) {
 					case common.DeleteImage:
 						p.HasImage = false
 						p.Spoilered = false
+						p.Attachments = 0
 					case common.SpoilerImage:
 						p.Spoilered = true
 					}
 				})
 				f.cache.Moderation[msg.id] = append(f.cache.Moderation[msg.id],
 					msg.entry)
+
+			case msg := <-f.prunePosts:
+				f.startIfPaused()
+				for _, id := range msg.ids {
+					delete(f.cache.Recent, id)
+					delete(f.cache.Moderation, id)
+				}
+				f.cache.clearMemoized()
+				f.write(msg.msg)
 			}
 		}
 	}()
@@ -319,6 +363,27 @@ func (f *Feed) _moderatePost(id uint64, msg []byte,
 	}
 }
 
+// PrunePosts removes posts pruned from a cyclic thread and propagates the
+// removal to clients
+func (f *Feed) PrunePosts(ids []uint64, msg []byte) {
+	f.prunePosts <- pruneMessage{ids, msg}
+}
+
+// ReactToPost sets the count of a reaction to a post and propagates it to
+// clients
+func (f *Feed) ReactToPost(id uint64, reaction string, count uint64,
+	msg []byte,
+) {
+	f.reactToPost <- reactionMessage{
+		message: message{
+			id:  id,
+			msg: msg,
+		},
+		reaction: reaction,
+		count:    count,
+	}
+}
+
 // UpdateBody sets the body of an open post and send update message to clients
 func (f *Feed) UpdateBody(id uint64, body string, msg []byte) {
 	f.binaryMessages <- msg
@@ -348,26 +413,26 @@ func (f *Feed) SendClaudeComplete(id uint64, isError bool, response *bytes.Buffe
 	}
 	f.binaryMessages <- message
 }
-func (f *Feed) GetPendingTiktokState(id uint64) (p PendingTikToks, ok bool) {
-	//return f.cache.Recent[id].PendingTikToks
+func (f *Feed) GetFetchState(id uint64) (p FetchState, ok bool) {
+	//return f.cache.Recent[id].FetchState
 	var post cachedPost
 	post, ok = f.cache.Recent[id]
 	if ok {
-		p = post.PendingTikToks
+		p = post.FetchState
 	}
 	return
 }
 
-func (f *Feed) UpdatePendingTiktokState(id uint64, state PendingTikToks) {
+func (f *Feed) UpdateFetchState(id uint64, state FetchState) {
 	stateUpdate := struct {
-		ID    uint64         `json:"id"`
-		State PendingTikToks `json:"state"`
+		ID    uint64     `json:"id"`
+		State FetchState `json:"state"`
 	}{
 		id,
 		state,
 	}
-	msg, _ := common.EncodeMessage(common.MessageTiktokState, stateUpdate)
-	f.updatePendingTiktokState <- pendingTiktokState{
+	msg, _ := common.EncodeMessage(common.MessageFetchState, stateUpdate)
+	f.updateFetchState <- fetchStateUpdate{
 		message: message{id, msg},
 		state:   state,
 	}
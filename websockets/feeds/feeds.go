@@ -47,17 +47,19 @@ func addToFeed(id uint64, board string, c common.Client) (
 		feed, ok = feeds.feeds[id]
 		if !ok {
 			feed = &Feed{
-				id:                       id,
-				send:                     make(chan []byte),
-				insertPost:               make(chan postCreationMessage),
-				closePost:                make(chan message),
-				spoilerImage:             make(chan message),
-				moderatePost:             make(chan moderationMessage),
-				setOpenBody:              make(chan postBodyModMessage),
-				insertImage:              make(chan imageInsertionMessage),
-				messageBuffer:            make([]string, 0, 64),
-				binaryMessages:           make(chan []byte),
-				updatePendingTiktokState: make(chan pendingTiktokState),
+				id:               id,
+				send:             make(chan []byte),
+				insertPost:       make(chan postCreationMessage),
+				closePost:        make(chan message),
+				spoilerImage:     make(chan message),
+				moderatePost:     make(chan moderationMessage),
+				reactToPost:      make(chan reactionMessage),
+				prunePosts:       make(chan pruneMessage),
+				setOpenBody:      make(chan postBodyModMessage),
+				insertImage:      make(chan imageInsertionMessage),
+				messageBuffer:    make([]string, 0, 64),
+				binaryMessages:   make(chan []byte),
+				updateFetchState: make(chan fetchStateUpdate),
 			}
 
 			feed.baseFeed.init()
@@ -220,9 +222,15 @@ func ClosePost(id, op uint64, links []common.Link, commands []common.Command, cl
 
 // Initialize internal runtime
 func Init() (err error) {
-	return db.Listen("post_moderated", func(msg string) (err error) {
+	err = db.Listen("post_moderated", func(msg string) (err error) {
 		return handlePostModeration(msg)
 	})
+	if err != nil {
+		return
+	}
+	return db.Listen("posts_pruned", func(msg string) (err error) {
+		return handlePostPruning(msg)
+	})
 }
 
 func GetNekoTVFeed(id uint64) (f *NekoTVFeed) {
@@ -240,28 +248,50 @@ func GetNekoTVFeed(id uint64) (f *NekoTVFeed) {
 	return newFeed
 }
 
-// Separate function for testing
+// Separate function for testing. Bulk moderation sends the IDs of multiple
+// log entries in one message.
 func handlePostModeration(msg string) (err error) {
-	arr, err := db.SplitUint64s(msg, 2)
+	arr, err := db.SplitMinUint64s(msg, 2)
 	if err != nil {
 		return
 	}
-	op, logID := arr[0], arr[1]
+	op, logIDs := arr[0], arr[1:]
 	return SendIfExists(op, func(f *Feed) (err error) {
-		e, err := db.GetModLogEntry(logID)
+		log, err := db.GetModLogEntries(logIDs)
 		if err != nil {
 			return
 		}
 
-		msg, err := common.EncodeMessage(common.MessageModeratePost, struct {
-			ID uint64 `json:"id"`
-			common.ModerationEntry
-		}{e.ID, e.ModerationEntry})
+		for _, e := range log {
+			var msg []byte
+			msg, err = common.EncodeMessage(common.MessageModeratePost,
+				struct {
+					ID uint64 `json:"id"`
+					common.ModerationEntry
+				}{e.ID, e.ModerationEntry})
+			if err != nil {
+				return
+			}
+			f._moderatePost(e.ID, msg, e.ModerationEntry)
+		}
+		return
+	})
+}
+
+// Separate function for testing. Large prunings are split over multiple
+// messages.
+func handlePostPruning(msg string) (err error) {
+	arr, err := db.SplitMinUint64s(msg, 2)
+	if err != nil {
+		return
+	}
+	op, ids := arr[0], arr[1:]
+	return SendIfExists(op, func(f *Feed) (err error) {
+		buf, err := common.EncodeMessage(common.MessagePrunePosts, ids)
 		if err != nil {
 			return
 		}
-
-		f._moderatePost(e.ID, msg, e.ModerationEntry)
+		f.PrunePosts(ids, buf)
 		return
 	})
 }
@@ -39,8 +39,12 @@ func (c *Client) runHandler(typ common.MessageType, msg []byte) error {
 		return nil
 	case common.MessageSpoiler:
 		return c.spoilerImage()
+	case common.MessageReaction:
+		return c.reactToPost(data)
 	case common.MessageMeguTV:
 		return feeds.SubscribeToMeguTV(c)
+	case common.MessagePollVote:
+		return c.votePoll(data)
 	default:
 		return errInvalidPayload(msg)
 	}
@@ -55,8 +59,8 @@ func (c *Client) runHandlerBinary(typ common.MessageType, msg []byte) error {
 		return c.spliceText(data)
 	case common.MessageBackspace:
 		return c.backspace()
-	case common.MessageAttachTiktok:
-		return c.attachTiktok(data)
+	case common.MessageAttachURL:
+		return c.attachURL(data)
 	case common.MessageNekoTV:
 		return feeds.HandleNekoTV(c, data)
 	default:
@@ -0,0 +1,49 @@
+package websockets
+
+import (
+	"errors"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/websockets/feeds"
+)
+
+var errNotSynced = errors.New("not synced to a thread")
+
+// Cast a vote in a poll of a post in the thread the client is synced to and
+// propagate the new tallies to all clients in the thread
+func (c *Client) votePoll(data []byte) (err error) {
+	c.incrementSpamScore(config.Get().CharScore)
+
+	var req common.PollVote
+	err = decodeMessage(data, &req)
+	if err != nil {
+		return
+	}
+
+	synced, thread, _ := feeds.GetSync(c)
+	if !synced {
+		return errNotSynced
+	}
+
+	p, changed, err := db.VotePoll(req, thread, c.ip, c.captchaSession)
+	switch {
+	case err != nil:
+		return
+	case !changed:
+		// Closed poll or repeated vote. Can be caused by network latency -
+		// NOP it.
+		return
+	}
+
+	msg, err := common.EncodeMessage(common.MessagePollVote, struct {
+		ID    uint64   `json:"id"`
+		Votes []uint64 `json:"votes"`
+	}{req.ID, p.Votes})
+	if err != nil {
+		return
+	}
+	feeds.SendTo(thread, msg)
+	return
+}
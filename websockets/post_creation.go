@@ -71,6 +71,26 @@ type ReplyCreationRequest struct {
 	Name, Password, Body string
 }
 
+// SlowModeError is returned, when a client attempts to post in a thread
+// before its slow mode cooldown has expired
+type SlowModeError struct {
+	Remaining time.Duration
+}
+
+func (e SlowModeError) Error() string {
+	return fmt.Sprintf("slow mode: wait %d seconds", e.Seconds())
+}
+
+// Seconds returns the remaining cooldown rounded up to whole seconds
+func (e SlowModeError) Seconds() uint64 {
+	return cooldownSeconds(e.Remaining)
+}
+
+// Round a cooldown up to whole seconds
+func cooldownSeconds(d time.Duration) uint64 {
+	return uint64((d + time.Second - 1) / time.Second)
+}
+
 // ImageRequest contains data for allocating an image
 type ImageRequest struct {
 	Spoiler     bool
@@ -94,25 +114,20 @@ func CreateThread(req ThreadCreationRequest, ip string) (
 	if err != nil {
 		return
 	}
-	post, _, err = constructPost(req.ReplyCreationRequest, conf, ip, 0)
-	if err != nil {
-		return
-	}
 	subject, err := parser.ParseSubject(req.Subject)
 	if err != nil {
 		return
 	}
-	//Check if user has posted before allowing him to create a new thread
-	count, err := db.CheckIpPostCount(ip)
+	held, err := needsReview(conf, ip, req.ReplyCreationRequest, true)
 	if err != nil {
 		return
 	}
-	if count == 0 {
-		log.Warnf("IP address %s tried to create a thread but has NOT posted before", ip)
+	if held {
+		err = holdPost(req.ReplyCreationRequest, conf, ip, 0, subject)
 		return
 	}
-	if count == 1 {
-		log.Warnf("IP address %s tried to create a thread but has only posted once", ip)
+	post, _, err = constructPost(req.ReplyCreationRequest, conf, ip, 0)
+	if err != nil {
 		return
 	}
 
@@ -159,12 +174,14 @@ func insertImage(tx *sql.Tx, req ImageRequest, p *db.Post) (err error) {
 
 // CreatePost creates a new post and writes it to the database.
 // open specifies, if the post should stay open after creation.
+// cooldown is the slow mode interval the poster has to wait before posting in
+// the thread again.
 func CreatePost(
 	op uint64,
 	board, ip string,
 	req ReplyCreationRequest,
 ) (
-	post db.Post, msg []byte, err error,
+	post db.Post, msg []byte, cooldown time.Duration, err error,
 ) {
 	_, err = db.IsBanned(board, ip)
 	if err != nil {
@@ -183,13 +200,43 @@ func CreatePost(
 	//	return
 	//}
 
-	// Assert thread is not locked
-	locked, err := db.CheckThreadLocked(op)
-	switch {
-	case err != nil:
+	err = assertNotLocked(op)
+	if err != nil {
 		return
-	case locked:
-		err = common.StatusError{errors.New("thread is locked"), 400}
+	}
+
+	threadSlowMode, err := db.ThreadSlowMode(op)
+	if err != nil {
+		return
+	}
+	slowMode := threadSlowMode != 0 || conf.SlowMode != 0
+	checkSlowMode := func(tx *sql.Tx) (err error) {
+		var remaining time.Duration
+		cooldown, remaining, err = db.SlowModeCooldown(tx, op, board, ip,
+			threadSlowMode, conf.SlowMode)
+		if err == nil && remaining > 0 {
+			err = SlowModeError{remaining}
+		}
+		return
+	}
+
+	held, err := needsReview(conf, ip, req, false)
+	if err != nil {
+		return
+	}
+	if held {
+		// Open posts can not be reviewed before they are complete
+		if req.Open {
+			err = PendingReviewError{}
+			return
+		}
+		if slowMode {
+			err = db.InTransaction(false, checkSlowMode)
+			if err != nil {
+				return
+			}
+		}
+		err = holdPost(req, conf, ip, op, "")
 		return
 	}
 
@@ -201,9 +248,16 @@ func CreatePost(
 	post.OP = op
 
 	// Must ensure image token usage is done atomically, as not to cause
-	// possible data races with unused image cleanup
-	if hasImage || post.Moderated || post.ID == 0 {
+	// possible data races with unused image cleanup. The slow mode cooldown
+	// must likewise be checked in the same transaction as the insertion.
+	if slowMode || hasImage || post.Moderated || post.ID == 0 {
 		err = db.InTransaction(false, func(tx *sql.Tx) (err error) {
+			if slowMode {
+				err = checkSlowMode(tx)
+				if err != nil {
+					return
+				}
+			}
 			err = db.InsertPost(tx, &post)
 			if err != nil {
 				return
@@ -250,16 +304,39 @@ func (c *Client) insertPost(data []byte) (err error) {
 	if err != nil {
 		return
 	}
-	// Replies created through websockets can only be open
-	req.Open = true
-
 	_, op, board := feeds.GetSync(c)
 
+	// Replies created through websockets can only be open, unless submitted
+	// for review in one piece
+	if !req.Open {
+		var held bool
+		held, err = needsReview(config.GetBoardConfigs(board).BoardConfigs,
+			c.ip, req, false)
+		if err != nil {
+			return
+		}
+		req.Open = !held
+	}
+
 	startTime := time.Now()
-	post, msg, err := CreatePost(op, board, c.ip, req)
+	post, msg, cooldown, err := CreatePost(op, board, c.ip, req)
 	log.Info("CreatePost took: ", time.Since(startTime))
-	if err != nil {
-		return
+	switch err := err.(type) {
+	case nil:
+	case SlowModeError:
+		// Not fatal. Let the client display the remaining cooldown.
+		return c.sendMessage(common.MessageSlowMode, err.Seconds())
+	case PendingReviewError:
+		// Not fatal. Let the client submit the post for review or display it
+		// as held.
+		if err.ID != 0 {
+			conf := config.Get()
+			c.incrementSpamScore(conf.PostCreationScore +
+				conf.CharScore*uint(utf8.RuneCountInString(req.Body)))
+		}
+		return c.sendMessage(common.MessagePendingReview, err.ID)
+	default:
+		return err
 	}
 
 	// Ensure the client knows the post ID, before the public post insertion
@@ -277,6 +354,12 @@ func (c *Client) insertPost(data []byte) (err error) {
 		c.post.init(post.StandalonePost)
 	}
 	c.feed.InsertPost(post.StandalonePost.Post, msg)
+	if cooldown != 0 {
+		err = c.sendMessage(common.MessageSlowMode, cooldownSeconds(cooldown))
+		if err != nil {
+			return
+		}
+	}
 	conf := config.Get()
 	c.incrementSpamScore(conf.PostCreationScore +
 		conf.CharScore*uint(c.post.len))
@@ -301,6 +384,19 @@ func getBoardConfig(board string) (conf config.BoardConfigs, err error) {
 	return
 }
 
+// Assert thread is not locked
+func assertNotLocked(op uint64) error {
+	locked, err := db.CheckThreadLocked(op)
+	switch {
+	case err != nil:
+		return err
+	case locked:
+		return common.StatusError{errors.New("thread is locked"), 400}
+	default:
+		return nil
+	}
+}
+
 // Construct the common parts of the new post for both threads and replies
 func constructPost(
 	req ReplyCreationRequest,
@@ -353,19 +449,8 @@ func constructPost(
 		post.Flag = geoip.LookUp(ip)
 	}
 
-	if utf8.RuneCountInString(req.Body) > common.MaxLenBody {
-		err = common.ErrBodyTooLong
-		return
-	}
-
-	lines := 0
-	for _, r := range req.Body {
-		if r == '\n' {
-			lines++
-		}
-	}
-	if lines > common.MaxLinesBody {
-		err = errTooManyLines
+	err = validateBody(req.Body)
+	if err != nil {
 		return
 	}
 
@@ -426,6 +511,23 @@ func constructPost(
 	return
 }
 
+// Validate the length of a post body committed in one piece
+func validateBody(body string) error {
+	if utf8.RuneCountInString(body) > common.MaxLenBody {
+		return common.ErrBodyTooLong
+	}
+	lines := 0
+	for _, r := range body {
+		if r == '\n' {
+			lines++
+		}
+	}
+	if lines > common.MaxLinesBody {
+		return errTooManyLines
+	}
+	return nil
+}
+
 // Trim on the last dot in the file name, but also strip for .tar.gz and
 // .tar.xz as special cases.
 func formatImageName(name *string) {
@@ -11,30 +11,29 @@ import (
 	"github.com/bakape/meguca/websockets/feeds"
 	"github.com/go-playground/log"
 	"math"
-	"os"
-	"path/filepath"
 	"sync"
 	"unicode/utf8"
 
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/imager/assets"
 	"github.com/bakape/meguca/parser"
 	"github.com/bakape/meguca/util"
 )
 
 var (
-	errNoPostOpen      = errors.New("no post open")
-	errEmptyPost       = errors.New("post body empty")
-	errTooManyLines    = errors.New("too many lines in post body")
-	errSpliceTooLong   = errors.New("splice text too long")
-	errSpliceNOOP      = errors.New("splice NOOP")
-	errTextOnly        = errors.New("text only board")
-	errHasImage        = errors.New("post already has image")
-	preImageJson       = `{"type":"image","source":{"type":"base64","media_type":"image/webp","data":"`
-	postImageJson      = `"}}`
-	pendingTiktoks     = map[uint64]feeds.PendingTikToks{}
-	pendingTiktokMutex = sync.Mutex{}
+	errNoPostOpen     = errors.New("no post open")
+	errEmptyPost      = errors.New("post body empty")
+	errTooManyLines   = errors.New("too many lines in post body")
+	errSpliceTooLong  = errors.New("splice text too long")
+	errSpliceNOOP     = errors.New("splice NOOP")
+	errTextOnly       = errors.New("text only board")
+	errHasImage       = errors.New("post already has image")
+	preImageJson      = `{"type":"image","source":{"type":"base64","media_type":"image/webp","data":"`
+	postImageJson     = `"}}`
+	pendingFetches    = map[uint64]feeds.FetchState{}
+	pendingFetchMutex = sync.Mutex{}
 )
 
 // Error created, when client supplies invalid splice coordinates to server
@@ -215,10 +214,12 @@ func (c *Client) closePost() (err error) {
 			var (
 				from = links[len(links)-1].ID
 				img  *common.Image
+				att  []common.Image
 			)
 			if !lastThreeDigitsMatch(from) {
 
-				img, err = db.TransferImage(from, c.post.id, c.post.op)
+				img, att, err = db.TransferImage(from, c.post.id,
+					c.post.op)
 				if err != nil {
 					return
 				}
@@ -238,11 +239,13 @@ func (c *Client) closePost() (err error) {
 					msg, err = common.EncodeMessage(
 						common.MessageStoleImageTo,
 						struct {
-							ID    uint64        `json:"id"`
-							Image *common.Image `json:"image"`
+							ID          uint64         `json:"id"`
+							Image       *common.Image  `json:"image"`
+							Attachments []common.Image `json:"attachments,omitempty"`
 						}{
-							ID:    c.post.id,
-							Image: img,
+							ID:          c.post.id,
+							Image:       img,
+							Attachments: att,
 						},
 					)
 					if err != nil {
@@ -285,18 +288,18 @@ func (c *Client) closePost() (err error) {
 		img, err = db.GetImageByPost(id)
 		var ext string
 		if err == nil {
-			var file string
+			var key string
 			if img.Size < 20e6 && (img.FileType == common.PNG || img.FileType == common.JPEG || img.FileType == common.WEBP) {
-				file = fmt.Sprintf("images/src/%s.%s", img.SHA1, common.Extensions[img.FileType])
+				key = assets.SourceKey(img.FileType, img.SHA1)
 				ext = common.Extensions[img.FileType]
 			} else {
-				file = filepath.Join("images/thumb/", img.SHA1+".webp")
+				key = assets.ThumbKey(common.WEBP, img.SHA1)
 				ext = common.Extensions[common.WEBP]
 			}
 			//imgSha1, err := db.GetPostSha1(id)
 			//if err == nil && imgSha1 != nil {
 			//
-			fileData, err := os.ReadFile(file)
+			fileData, err := assets.ReadFile(key)
 			if err == nil {
 				image = &fileData
 			}
@@ -324,63 +327,76 @@ func (c *Client) closePost() (err error) {
 	return
 }
 
-func handlePostCommand(id uint64, op uint64, input *common.PostCommand, feed *feeds.Feed) {
-	result, ok := pendingTiktoks[id]
-	if ok && (result == feeds.Done || result == feeds.Loading) {
+// Fetch the file linked to in a post command and attach it to the post in the
+// background
+func handlePostCommand(id, op uint64, board string, input *common.PostCommand,
+	feed *feeds.Feed,
+) {
+	pendingFetchMutex.Lock()
+	if pendingFetches[id] == feeds.Loading {
+		pendingFetchMutex.Unlock()
 		return
 	}
-	feed.UpdatePendingTiktokState(id, feeds.Loading)
-	pendingTiktokMutex.Lock()
-	pendingTiktoks[id] = feeds.Loading
-	pendingTiktokMutex.Unlock()
+	pendingFetches[id] = feeds.Loading
+	pendingFetchMutex.Unlock()
+	feed.UpdateFetchState(id, feeds.Loading)
 
 	go func() {
-		log.Info("Proceeding with tiktok download")
-		token, filename, err := imager.DownloadTikTok(input)
+		state := feeds.Done
+		err := attachFetched(id, op, board, input, feed)
 		if err != nil {
-			log.Error("Error downloading tiktok: `", input.Input, "`")
-			log.Error("Error: ", err)
-			feed.UpdatePendingTiktokState(id, feeds.Error)
-			pendingTiktokMutex.Lock()
-			delete(pendingTiktoks, id)
-			pendingTiktokMutex.Unlock()
-			return
-		}
-		formatImageName(&filename)
-		hasImage, err := db.HasImage(id)
-		if err != nil || hasImage {
-			return
+			log.Errorf("fetch: %s: %s", input.Input, err)
+			state = feeds.Error
 		}
+		feed.UpdateFetchState(id, state)
+		pendingFetchMutex.Lock()
+		delete(pendingFetches, id)
+		pendingFetchMutex.Unlock()
+	}()
+}
 
-		var msg []byte
-		err = db.InTransaction(false, func(tx *sql.Tx) (err error) {
-			msg, err = db.InsertImage(tx, id, token, filename,
-				false)
-			if err == nil {
-				db.BumpThread(tx, op)
-			}
-			return
-		})
-		if err != nil {
-			log.Error("Error downloading tiktok: ", *input)
-			log.Error("Error: ", err)
-			feed.UpdatePendingTiktokState(id, feeds.Error)
-			pendingTiktokMutex.Lock()
-			delete(pendingTiktoks, id)
-			pendingTiktokMutex.Unlock()
-			return
-		}
-		feeds.SendIfExists(op, func(feed *feeds.Feed) error {
-			feed.InsertImage(op, false, common.PrependMessageType(common.MessageInsertImage, msg))
-			return nil
-		})
-		feed.UpdatePendingTiktokState(id, feeds.Done)
-		pendingTiktokMutex.Lock()
-		delete(pendingTiktoks, id)
-		pendingTiktokMutex.Unlock()
+// Fetch the file linked to in a post command and insert it into the post
+func attachFetched(id, op uint64, board string, input *common.PostCommand,
+	feed *feeds.Feed,
+) (err error) {
+	conf := config.GetBoardConfigs(board)
+	if conf.TextOnly {
+		return errTextOnly
+	}
 
+	token, name, err := imager.FetchURL(input, board)
+	if err != nil {
 		return
-	}()
+	}
+	formatImageName(&name)
+
+	// Attachments might have been added during the fetch
+	count, err := db.ImageCount(id)
+	if err != nil {
+		return
+	}
+	if count >= conf.AttachmentLimit() {
+		return errHasImage
+	}
+
+	var msg []byte
+	err = db.InTransaction(false, func(tx *sql.Tx) (err error) {
+		msg, err = db.InsertImage(tx, id, token, name, false)
+		if err == nil {
+			db.BumpThread(tx, op)
+		}
+		return
+	})
+	switch err {
+	case nil:
+	case db.ErrAttachmentLimit:
+		return errHasImage
+	default:
+		return
+	}
+	feed.InsertImage(id, false,
+		common.PrependMessageType(common.MessageInsertImage, msg))
+	return
 }
 
 // Splice the text in the open post
@@ -511,26 +527,29 @@ func (c *Client) insertImage(data []byte) (err error) {
 		return errNoPostOpen
 	}
 
-	hasImage, err := c.hasImage()
+	count, err := db.ImageCount(c.post.id)
 	if err != nil {
 		return
 	}
-	if hasImage {
-		// Can be caused by network latency - NOP it
-		return nil
+	if count == 0 {
+		// Allow respoilering, if the image has been stolen
+		c.post.isSpoilered = false
 	}
 
-	// So the poster can reupload a new image, if
-
 	var req ImageRequest
 	err = decodeMessage(data, &req)
 	if err != nil {
 		return
 	}
 
-	if config.GetBoardConfigs(c.post.board).TextOnly {
+	conf := config.GetBoardConfigs(c.post.board)
+	if conf.TextOnly {
 		return errTextOnly
 	}
+	if count >= conf.AttachmentLimit() {
+		// Can be caused by network latency - NOP it
+		return nil
+	}
 
 	formatImageName(&req.Name)
 
@@ -540,10 +559,17 @@ func (c *Client) insertImage(data []byte) (err error) {
 			req.Spoiler)
 		return
 	})
-	if err != nil {
+	switch err {
+	case nil:
+	case db.ErrAttachmentLimit:
+		// Lost a race with another insertion - NOP it
+		return nil
+	default:
 		return
 	}
-	c.post.isSpoilered = req.Spoiler
+	if count == 0 {
+		c.post.isSpoilered = req.Spoiler
+	}
 	c.feed.InsertImage(c.post.id, req.Spoiler,
 		common.PrependMessageType(common.MessageInsertImage, msg))
 
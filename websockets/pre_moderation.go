@@ -0,0 +1,208 @@
+package websockets
+
+import (
+	"database/sql"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/parser"
+	"github.com/bakape/meguca/util"
+)
+
+// PendingReviewError is returned, when a post of a new poster is held for
+// review by staff. ID is 0, if the post has to be submitted for review in one
+// piece instead of being allocated as an open post.
+type PendingReviewError struct {
+	ID uint64
+}
+
+func (e PendingReviewError) Error() string {
+	if e.ID == 0 {
+		return "post must be submitted for review"
+	}
+	return "post held for review"
+}
+
+// Returns, if a post of ip has to be reviewed by staff before publishing.
+// thread specifies, if the post is the OP of a new thread.
+func needsReview(
+	conf config.BoardConfigs,
+	ip string,
+	req ReplyCreationRequest,
+	thread bool,
+) (
+	held bool, err error,
+) {
+	switch {
+	case thread:
+		held = conf.PreModThreads
+	case conf.PreModReplies:
+		held = true
+	case conf.PreModImages:
+		held = hasImage(conf, req.Image)
+	}
+	if !held {
+		return
+	}
+
+	count, err := db.CheckIpPostCount(ip)
+	if err != nil {
+		return
+	}
+	if count >= int(conf.NewPosterPosts) {
+		return false, nil
+	}
+
+	// Staff are never held for review
+	staff, err := isStaff(conf.ID, req.SessionCreds)
+	return !staff, err
+}
+
+// Returns, if creds belong to a logged in staff member of board
+func isStaff(board string, creds auth.SessionCreds) (bool, error) {
+	if creds.UserID == "" {
+		return false, nil
+	}
+	pos, err := db.FindPosition(board, creds.UserID)
+	if err != nil || pos == common.NotStaff {
+		return false, err
+	}
+	return db.IsLoggedIn(creds.UserID, creds.Session)
+}
+
+// Returns, if the request has a file to attach on the board
+func hasImage(conf config.BoardConfigs, req ImageRequest) bool {
+	return !conf.TextOnly && req.Token != "" && req.Name != ""
+}
+
+// Hold a post for review by staff. Returns a PendingReviewError with the ID
+// of the held post on success.
+func holdPost(
+	req ReplyCreationRequest,
+	conf config.BoardConfigs,
+	ip string,
+	op uint64,
+	subject string,
+) (
+	err error,
+) {
+	err = validateBody(req.Body)
+	if err != nil {
+		return
+	}
+	p := auth.PendingPost{
+		OP:      op,
+		Sage:    req.Sage,
+		Board:   conf.ID,
+		IP:      ip,
+		Subject: subject,
+		Body:    req.Body,
+	}
+	if req.Password != "" {
+		// Allows reclaiming the post, once approved
+		err = parser.VerifyPostPassword(req.Password)
+		if err != nil {
+			return
+		}
+		p.Password, err = auth.BcryptHash(req.Password, 4)
+		if err != nil {
+			return
+		}
+	}
+	if !conf.ForcedAnon {
+		p.Name, p.Trip, err = parser.ParseName(req.Name)
+		if err != nil {
+			return
+		}
+	}
+	if hasImage(conf, req.Image) {
+		p.ImageToken = req.Image.Token
+		p.ImageName = req.Image.Name
+		p.Spoiler = req.Image.Spoiler
+		util.TrimString(&p.ImageName, 200)
+	}
+
+	id, err := db.HoldPost(p)
+	if err != nil {
+		return
+	}
+	return PendingReviewError{id}
+}
+
+// ApprovePendingPost publishes a post held for review on approval by staff
+// member by. Returns the published post and, if it is a reply, the message
+// inserting it into its thread's feed.
+func ApprovePendingPost(id uint64, by string) (
+	post db.Post, msg []byte, err error,
+) {
+	p, err := db.GetPendingPost(id)
+	if err != nil {
+		return
+	}
+	if p.State != auth.PostPending {
+		err = db.ErrPostReviewed
+		return
+	}
+	conf, err := getBoardConfig(p.Board)
+	if err != nil {
+		return
+	}
+
+	// The poster may have been banned or the thread locked during the review
+	_, err = db.IsBanned(p.Board, p.IP)
+	if err != nil {
+		return
+	}
+	if p.OP != 0 {
+		err = assertNotLocked(p.OP)
+		if err != nil {
+			return
+		}
+	}
+
+	req := ReplyCreationRequest{
+		Sage: p.Sage,
+		Body: p.Body,
+	}
+	post, _, err = constructPost(req, conf, p.IP, p.OP)
+	if err != nil {
+		return
+	}
+	if p.Name != "" || p.Trip != "" {
+		post.Name, post.Trip = p.Name, p.Trip
+	}
+	post.OP = p.OP
+	post.Password = p.Password
+
+	err = db.InTransaction(false, func(tx *sql.Tx) (err error) {
+		if p.OP == 0 {
+			err = db.InsertThread(tx, p.Subject, &post)
+		} else {
+			err = db.InsertPost(tx, &post)
+		}
+		if err != nil {
+			return
+		}
+
+		// The image token expired, if the image is gone
+		if p.Image != nil && !conf.TextOnly {
+			err = insertImage(tx, ImageRequest{
+				Spoiler: p.Spoiler,
+				Token:   p.ImageToken,
+				Name:    p.ImageName,
+			}, &post)
+			if err != nil {
+				return
+			}
+		}
+
+		return db.ApprovePendingPost(tx, id, post.ID, by)
+	})
+	if err != nil || p.OP == 0 {
+		return
+	}
+	msg, err = common.EncodeMessage(common.MessageInsertPost, post.Post)
+	return
+}
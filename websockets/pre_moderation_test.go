@@ -0,0 +1,102 @@
+package websockets
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	. "github.com/bakape/meguca/test"
+	"github.com/bakape/meguca/test/test_db"
+)
+
+func TestPreModeration(t *testing.T) {
+	test_db.ClearTables(t, "boards", "pending_posts")
+
+	c := db.BoardConfigs{
+		BoardConfigs: config.BoardConfigs{
+			ID:             "a",
+			Eightball:      []string{"Yes"},
+			PreModThreads:  true,
+			PreModReplies:  true,
+			NewPosterPosts: common.NewPosterPosts,
+		},
+	}
+	if _, err := config.SetBoardConfigs(c.BoardConfigs); err != nil {
+		t.Fatal(err)
+	}
+	err := db.InTransaction(false, func(tx *sql.Tx) error {
+		return db.WriteBoard(tx, c)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = CreateThread(ThreadCreationRequest{
+		ReplyCreationRequest: ReplyCreationRequest{
+			Name:     "name",
+			Body:     "first",
+			Password: "123",
+		},
+		Subject: "subject",
+		Board:   "a",
+	}, "::1")
+	held, ok := err.(PendingReviewError)
+	if !ok || held.ID == 0 {
+		t.Fatalf("thread not held for review: %v", err)
+	}
+
+	post, msg, err := ApprovePendingPost(held.ID, "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg != nil {
+		t.Fatal("feed message for thread")
+	}
+	thread, err := db.GetThread(post.ID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, thread.Subject, "subject")
+	AssertEquals(t, thread.Name, "name")
+	AssertEquals(t, thread.Body, "first")
+	hash, err := db.GetPostPassword(post.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash == nil {
+		t.Fatal("no post password")
+	}
+
+	_, _, err = ApprovePendingPost(held.ID, "admin")
+	AssertEquals(t, err, db.ErrPostReviewed)
+
+	t.Run("open reply", func(t *testing.T) {
+		_, _, _, err := CreatePost(post.ID, "a", "::1", ReplyCreationRequest{
+			Open:     true,
+			Body:     "a",
+			Password: "123",
+		})
+		AssertEquals(t, err, PendingReviewError{})
+	})
+
+	t.Run("locked during review", func(t *testing.T) {
+		_, _, _, err := CreatePost(post.ID, "a", "::1", ReplyCreationRequest{
+			Body: "a",
+		})
+		held, ok := err.(PendingReviewError)
+		if !ok || held.ID == 0 {
+			t.Fatalf("reply not held for review: %v", err)
+		}
+
+		err = db.SetThreadLock(post.ID, true, "admin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _, err = ApprovePendingPost(held.ID, "admin")
+		if err == nil {
+			t.Fatal("reply approved in locked thread")
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package websockets
+
+import (
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/websockets/feeds"
+)
+
+// Request to react to a post in the thread the client is synced to
+type reactionRequest struct {
+	ID       uint64 `json:"id"`
+	Reaction string `json:"reaction"`
+}
+
+// React to a post and propagate the new reaction count to all clients in the
+// thread
+func (c *Client) reactToPost(data []byte) (err error) {
+	c.incrementSpamScore(config.Get().ReactionScore)
+
+	var req reactionRequest
+	err = decodeMessage(data, &req)
+	if err != nil {
+		return
+	}
+
+	synced, thread, board := feeds.GetSync(c)
+	if !synced {
+		return errNotSynced
+	}
+
+	valid := false
+	for _, r := range config.GetBoardConfigs(board).Reactions {
+		if r == req.Reaction {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return common.ErrInvalidInput("invalid reaction")
+	}
+
+	count, changed, err := db.ReactToPost(req.ID, thread, c.ip, req.Reaction)
+	if err != nil || !changed {
+		// Unchanged on repeated reactions. Can be caused by network latency -
+		// NOP it.
+		return
+	}
+
+	msg, err := common.EncodeMessage(common.MessageReaction, struct {
+		reactionRequest
+		Count uint64 `json:"count"`
+	}{req, count})
+	if err != nil {
+		return
+	}
+	return feeds.SendIfExists(thread, func(f *feeds.Feed) error {
+		f.ReactToPost(req.ID, req.Reaction, count, msg)
+		return nil
+	})
+}
@@ -443,15 +443,27 @@ func (c *Client) setLastTime() {
 	c.lastTime = time.Now().Unix()
 }
 
-func (c *Client) attachTiktok(data []byte) (err error) {
+// Fetch a linked file and attach it to the open post
+func (c *Client) attachURL(data []byte) (err error) {
 	size := len(data)
+	if size < 2 {
+		return errInvalidPayload(data)
+	}
+	has, err := c.hasPost()
+	switch {
+	case err != nil:
+		return
+	case !has:
+		return errNoPostOpen
+	}
+
 	rotVal := int(data[size-2]) * 90
 	commandData := common.PostCommand{
 		Input:    string(data[:size-2]),
 		Rotation: rotVal,
 		HD:       data[size-1] != 0,
 	}
-	log.Info("Got attach tiktok command for id ", c.post.id)
-	handlePostCommand(c.post.id, c.post.op, &commandData, c.feed)
+	handlePostCommand(c.post.id, c.post.op, c.post.board, &commandData,
+		c.feed)
 	return
 }